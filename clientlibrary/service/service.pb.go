@@ -25,246 +25,271 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 func init() { proto.RegisterFile("pb/protos/service/service.proto", fileDescriptor_93a29dc403579097) }
 
 var fileDescriptor_93a29dc403579097 = []byte{
-	// 3820 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x9c, 0x5b, 0x6f, 0xdd, 0xc6,
-	0xb5, 0xc7, 0xb3, 0x5f, 0x4e, 0xce, 0x61, 0x4e, 0x72, 0x4e, 0x99, 0xd6, 0x4d, 0xdd, 0x44, 0x76,
-	0x1c, 0xdb, 0x92, 0x2d, 0x69, 0x4b, 0xb1, 0x9c, 0x4b, 0x2f, 0x40, 0x21, 0x4b, 0x96, 0x2d, 0xc4,
-	0xb7, 0x6a, 0x4b, 0x36, 0x10, 0xa0, 0x40, 0x29, 0xee, 0xf1, 0x16, 0x2b, 0x6e, 0x0e, 0x43, 0xce,
-	0x96, 0xbc, 0x5b, 0xb4, 0x68, 0xd1, 0xa2, 0x45, 0x8b, 0x16, 0x2d, 0x7a, 0x79, 0xea, 0x5b, 0xdf,
-	0xfa, 0x4d, 0xfa, 0x98, 0xc7, 0x3e, 0x15, 0x45, 0xf2, 0x45, 0x0a, 0x72, 0x16, 0xe7, 0xb2, 0x38,
-	0x6b, 0xc8, 0x9d, 0x27, 0x1b, 0x7b, 0xfd, 0xd6, 0xfa, 0xcf, 0x70, 0x6e, 0x6b, 0x66, 0x48, 0x05,
-	0x97, 0xf2, 0xe3, 0x8d, 0xbc, 0xe0, 0x82, 0x97, 0x1b, 0x25, 0x2b, 0xce, 0x92, 0x98, 0x35, 0xff,
-	0x0e, 0xeb, 0x9f, 0xc3, 0x97, 0xa3, 0x6c, 0x2e, 0xe6, 0x39, 0xbb, 0xf8, 0x86, 0x26, 0x63, 0x3e,
-	0x9d, 0x46, 0xd9, 0xb8, 0x94, 0xc8, 0xc5, 0x0b, 0xda, 0xc2, 0xce, 0x58, 0x26, 0xe0, 0xf7, 0x5b,
-	0xff, 0xfa, 0xfb, 0x20, 0x78, 0x6d, 0x27, 0x4d, 0x58, 0x26, 0x76, 0xc0, 0x23, 0xfc, 0x38, 0x78,
-	0x75, 0x3b, 0xcf, 0xef, 0x31, 0xf1, 0x94, 0x15, 0x65, 0xc2, 0xb3, 0xf0, 0x9d, 0x21, 0x08, 0x0c,
-	0x0f, 0xf2, 0x78, 0xb8, 0x9d, 0xe7, 0x43, 0x6d, 0x1c, 0x1e, 0xb0, 0x4f, 0x66, 0xac, 0x14, 0x17,
-	0xaf, 0xfa, 0xa1, 0x32, 0xe7, 0x59, 0xc9, 0xc2, 0xe7, 0xc1, 0x97, 0xb6, 0xf3, 0x7c, 0xc4, 0xc4,
-	0x2e, 0xab, 0x2a, 0x30, 0x12, 0x91, 0x60, 0xe1, 0x72, 0xcb, 0xd5, 0x06, 0x94, 0xc6, 0x4a, 0x37,
-	0x08, 0x3a, 0x87, 0xc1, 0x2b, 0x95, 0xce, 0xc9, 0x4c, 0x8c, 0xf9, 0x79, 0x16, 0xbe, 0xdd, 0x76,
-	0x04, 0x93, 0x8a, 0x7d, 0xc5, 0x87, 0x40, 0xd4, 0x67, 0xc1, 0xff, 0x3e, 0x8b, 0xd2, 0x94, 0x89,
-	0x9d, 0x82, 0x55, 0x05, 0xb7, 0x7d, 0xa4, 0x69, 0x28, 0x6d, 0x2a, 0xee, 0x3b, 0x5e, 0x06, 0x02,
-	0x7f, 0x1c, 0xbc, 0x2a, 0x2d, 0x07, 0x2c, 0xe6, 0x67, 0xac, 0x08, 0x9d, 0x5e, 0x60, 0x24, 0x1e,
-	0x79, 0x0b, 0xc2, 0xb1, 0x77, 0x78, 0x76, 0xc6, 0x0a, 0xe1, 0x8e, 0x0d, 0x46, 0x7f, 0x6c, 0x0d,
-	0x41, 0xec, 0x34, 0x78, 0xdd, 0x7c, 0x20, 0x23, 0x56, 0xd6, 0x1d, 0xe6, 0x06, 0x5d, 0x67, 0x40,
-	0x94, 0xce, 0xcd, 0x3e, 0x28, 0xa8, 0x25, 0x41, 0x08, 0x6a, 0x29, 0x2f, 0x95, 0xd8, 0x8a, 0x33,
-	0x82, 0x41, 0x28, 0xad, 0x1b, 0x3d, 0x48, 0x90, 0xfa, 0x7e, 0xf0, 0x7f, 0xcf, 0x78, 0x71, 0x5a,
-	0xe6, 0x51, 0xcc, 0xa0, 0xb1, 0xaf, 0xd9, 0xde, 0x8d, 0x15, 0xb7, 0xf7, 0xf5, 0x2e, 0x0c, 0x14,
-	0x4e, 0x83, 0x50, 0x19, 0x1f, 0x1f, 0xff, 0x80, 0xc5, 0x62, 0x7b, 0x3c, 0xc6, 0x4f, 0x4e, 0x79,
-	0x4b, 0x62, 0xb8, 0x3d, 0x1e, 0x53, 0x4f, 0xce, 0x8d, 0x82, 0xd8, 0x79, 0x70, 0x01, 0x89, 0x3d,
-	0x48, 0xca, 0x5a, 0x70, 0xdd, 0x1f, 0x05, 0x30, 0x25, 0x3a, 0xec, 0x8b, 0x83, 0xf0, 0x4f, 0x07,
-	0xc1, 0xd7, 0x1c, 0xca, 0x07, 0x6c, 0xca, 0xcf, 0x58, 0xb8, 0xd9, 0x1d, 0x4d, 0x92, 0x4a, 0xff,
-	0xdd, 0x05, 0x3c, 0x1c, 0x4d, 0x39, 0x62, 0x29, 0x8b, 0x05, 0xd9, 0x94, 0xd2, 0xdc, 0xd9, 0x94,
-	0x0a, 0x33, 0x46, 0x41, 0x63, 0xbc, 0xc7, 0xc4, 0xce, 0xac, 0x28, 0x58, 0x26, 0xc8, 0xb6, 0xd4,
-	0x48, 0x67, 0x5b, 0x5a, 0xa8, 0xa3, 0x3e, 0xf7, 0x98, 0xd8, 0x4e, 0x53, 0xb2, 0x3e, 0xd2, 0xdc,
-	0x59, 0x1f, 0x85, 0x81, 0xc2, 0x4f, 0x8c, 0x36, 0x1b, 0x31, 0xb1, 0x5f, 0xde, 0x4f, 0x26, 0x27,
-	0x69, 0x32, 0x39, 0x11, 0x6c, 0x1c, 0x6e, 0x90, 0x0f, 0xc5, 0x06, 0x95, 0xea, 0x66, 0x7f, 0x07,
-	0x47, 0x0d, 0xef, 0xbe, 0xc8, 0x79, 0x41, 0xb7, 0x98, 0x34, 0x77, 0xd6, 0x50, 0x61, 0xa0, 0xf0,
-	0xbd, 0xe0, 0xb5, 0xed, 0x38, 0xe6, 0xb3, 0x4c, 0x4d, 0xb8, 0x68, 0xf9, 0x92, 0xc6, 0xd6, 0x8c,
-	0x7b, 0xad, 0x83, 0xd2, 0x53, 0x2e, 0xd8, 0x60, 0xee, 0x78, 0xc7, 0xe9, 0x87, 0x66, 0x8e, 0xab,
-	0x7e, 0xa8, 0x15, 0x7b, 0x97, 0xa5, 0x8c, 0x8c, 0x2d, 0x8d, 0x1d, 0xb1, 0x15, 0xd4, 0x8a, 0x0d,
-	0x03, 0xc5, 0x1d, 0x1b, 0x0d, 0x93, 0xab, 0x7e, 0x08, 0x62, 0xff, 0x66, 0x10, 0xbc, 0x05, 0xb6,
-	0xbb, 0x59, 0x74, 0x9c, 0xb2, 0x07, 0x3c, 0x8e, 0xd2, 0x47, 0x4c, 0x9c, 0xf3, 0xe2, 0x74, 0x34,
-	0xcf, 0xe2, 0x70, 0xcb, 0x19, 0xc7, 0x0d, 0x2b, 0xf1, 0xdb, 0x8b, 0x39, 0x19, 0xe9, 0x01, 0x54,
-	0x54, 0xf0, 0x1c, 0xa7, 0x07, 0x4d, 0x0d, 0x04, 0xcf, 0xa9, 0xf4, 0xc0, 0x46, 0x5a, 0x51, 0x1f,
-	0x56, 0xb3, 0x9b, 0x3b, 0xea, 0x43, 0x73, 0x3a, 0xbb, 0xe2, 0x43, 0xf4, 0xec, 0xd2, 0x74, 0x26,
-	0x9e, 0x3d, 0x4f, 0x26, 0x47, 0xf9, 0xb8, 0xea, 0x52, 0x37, 0xdc, 0xbd, 0xc5, 0x40, 0x88, 0xd9,
-	0x85, 0x40, 0x41, 0xed, 0x77, 0x83, 0x60, 0xc9, 0x1e, 0x1a, 0x7b, 0x05, 0x9f, 0x3e, 0x60, 0x93,
-	0x28, 0x9e, 0xc3, 0x58, 0xbc, 0xed, 0x1b, 0x04, 0x98, 0x56, 0x85, 0x78, 0x6f, 0x41, 0x2f, 0x28,
-	0xcf, 0x77, 0x83, 0x40, 0xce, 0xed, 0x8f, 0x73, 0x96, 0x85, 0x97, 0xad, 0x20, 0x30, 0xe9, 0x57,
-	0x16, 0x25, 0xf3, 0xb6, 0x87, 0xd0, 0xcd, 0x24, 0x7f, 0xaf, 0x97, 0xfe, 0xd0, 0xe9, 0x51, 0x9b,
-	0x88, 0x66, 0x42, 0x08, 0x2e, 0xe8, 0xe8, 0x84, 0x9f, 0xbb, 0x0b, 0x5a, 0x59, 0xfc, 0x05, 0x05,
-	0x42, 0xa7, 0x9b, 0x50, 0x50, 0x57, 0xba, 0xd9, 0x14, 0xc3, 0x97, 0x6e, 0x62, 0x06, 0x02, 0xf3,
-	0xe0, 0xcb, 0x66, 0xe0, 0x3b, 0x9c, 0x9f, 0x4e, 0xa3, 0xe2, 0x34, 0xbc, 0x49, 0x3b, 0x37, 0x8c,
-	0x12, 0x5a, 0xed, 0xc5, 0xea, 0x19, 0xdd, 0x14, 0x1c, 0x31, 0x3c, 0xa3, 0x5b, 0xfe, 0x23, 0x46,
-	0xcd, 0xe8, 0x0e, 0x0c, 0x37, 0xea, 0xbd, 0x22, 0xca, 0x4f, 0xdc, 0x8d, 0x5a, 0x9b, 0xfc, 0x8d,
-	0xda, 0x20, 0xb8, 0x05, 0x46, 0x2c, 0x2a, 0xe2, 0x13, 0x77, 0x0b, 0x48, 0x9b, 0xbf, 0x05, 0x14,
-	0x03, 0x81, 0x8b, 0xe0, 0x2b, 0x66, 0xe0, 0xd1, 0xec, 0xb8, 0x8c, 0x8b, 0xe4, 0x98, 0x85, 0xab,
-	0xb4, 0xb7, 0x82, 0x94, 0xd4, 0x5a, 0x3f, 0x58, 0xa7, 0xcf, 0xa0, 0xd9, 0xd8, 0xf6, 0xc7, 0x25,
-	0x4a, 0x9f, 0x9b, 0x18, 0x06, 0x41, 0xa4, 0xcf, 0x6e, 0x12, 0x57, 0xef, 0x5e, 0xc1, 0x67, 0x79,
-	0xd9, 0x51, 0x3d, 0x04, 0xf9, 0xab, 0xd7, 0x86, 0x41, 0xf3, 0x45, 0xf0, 0x55, 0xf3, 0x91, 0x1e,
-	0x65, 0xa5, 0x52, 0x5d, 0xa7, 0x9f, 0x93, 0x81, 0x11, 0x49, 0xae, 0x07, 0x07, 0xe5, 0x38, 0xf8,
-	0xff, 0x46, 0x59, 0xec, 0x32, 0x11, 0x25, 0x69, 0x19, 0x5e, 0x77, 0xc7, 0x68, 0xec, 0x4a, 0x6b,
-	0xb9, 0x93, 0xc3, 0x43, 0x68, 0x77, 0x96, 0xa7, 0x49, 0xdc, 0xde, 0x91, 0x80, 0xaf, 0x32, 0xfb,
-	0x87, 0x90, 0x89, 0xe9, 0x85, 0x46, 0x55, 0x43, 0xfe, 0xe7, 0x70, 0x9e, 0xe3, 0x85, 0x46, 0x97,
-	0x50, 0x23, 0xc4, 0x42, 0x43, 0xa0, 0xb8, 0x3e, 0x23, 0x26, 0x1e, 0x44, 0x73, 0x3e, 0x23, 0xa6,
-	0x04, 0x65, 0xf6, 0xd7, 0xc7, 0xc4, 0x40, 0x61, 0x16, 0x5c, 0x50, 0x0a, 0xfb, 0x99, 0x60, 0x45,
-	0x16, 0xa5, 0x7b, 0x69, 0x34, 0x29, 0x43, 0x62, 0xdc, 0xd8, 0x94, 0xd2, 0x5b, 0xef, 0x49, 0x3b,
-	0x1e, 0xe3, 0x7e, 0xb9, 0x17, 0x9d, 0xf1, 0x22, 0x11, 0xf4, 0x63, 0xd4, 0x48, 0xe7, 0x63, 0xb4,
-	0x50, 0xa7, 0xda, 0x76, 0x11, 0x9f, 0x24, 0x67, 0x6c, 0xec, 0x51, 0x6b, 0x90, 0x1e, 0x6a, 0x06,
-	0xea, 0x68, 0xb4, 0x11, 0x9f, 0x15, 0x31, 0x23, 0x1b, 0x4d, 0x9a, 0x3b, 0x1b, 0x4d, 0x61, 0xa0,
-	0xf0, 0x8b, 0x41, 0xf0, 0x75, 0x69, 0x35, 0xb7, 0x20, 0xbb, 0x51, 0x79, 0x72, 0xcc, 0xa3, 0x62,
-	0x1c, 0xbe, 0xeb, 0x8a, 0xe3, 0x44, 0x95, 0xf4, 0xad, 0x45, 0x5c, 0xf0, 0x63, 0xad, 0x76, 0x94,
-	0x7a, 0xc4, 0x39, 0x1f, 0xab, 0x85, 0xf8, 0x1f, 0x2b, 0x46, 0xf1, 0x04, 0x52, 0xdb, 0x65, 0x5a,
-	0x7f, 0x9d, 0xf4, 0xb7, 0x33, 0xfb, 0xe5, 0x4e, 0x0e, 0xcf, 0x8f, 0x95, 0xd1, 0xee, 0x2d, 0xeb,
-	0x54, 0x0c, 0x77, 0x8f, 0x19, 0xf6, 0xc5, 0x49, 0x65, 0x35, 0x2a, 0xfc, 0xca, 0xad, 0x91, 0x31,
-	0xec, 0x8b, 0x13, 0xca, 0xc6, 0xb4, 0xe6, 0x53, 0x76, 0x4c, 0x6d, 0xc3, 0xbe, 0x38, 0xee, 0x40,
-	0xdb, 0x79, 0x9e, 0xce, 0x0f, 0xd9, 0x34, 0x4f, 0xc9, 0x0e, 0x64, 0x21, 0xfe, 0x0e, 0x84, 0x51,
-	0x9c, 0xfd, 0x1c, 0xf2, 0x2a, 0xb7, 0x72, 0x66, 0x3f, 0xb5, 0xc9, 0x9f, 0xfd, 0x34, 0x08, 0x4e,
-	0x18, 0x0e, 0xf9, 0x0e, 0x4f, 0xab, 0xed, 0x5c, 0xfb, 0xbc, 0x4d, 0x79, 0x6a, 0xc2, 0x9f, 0x30,
-	0x20, 0x52, 0x9f, 0x0b, 0x37, 0xd9, 0x73, 0x54, 0xb0, 0x3b, 0xf3, 0x07, 0x49, 0x76, 0x1a, 0xba,
-	0xd7, 0x46, 0x0d, 0x10, 0xe7, 0xc2, 0x4e, 0x10, 0x67, 0xe9, 0x47, 0xd9, 0x98, 0xbb, 0xb3, 0xf4,
-	0xca, 0xe2, 0xcf, 0xd2, 0x81, 0xc0, 0x21, 0x0f, 0x18, 0x15, 0xb2, 0xb2, 0xf8, 0x43, 0x02, 0xe1,
-	0x9a, 0x0f, 0x60, 0xd7, 0x45, 0xce, 0x07, 0x68, 0x9f, 0xb5, 0xdc, 0xc9, 0xe1, 0x1e, 0xda, 0xa4,
-	0xeb, 0x7b, 0x4c, 0xc4, 0x27, 0xee, 0x1e, 0x6a, 0x21, 0xfe, 0x1e, 0x8a, 0x51, 0x5c, 0xa5, 0x43,
-	0xae, 0xb6, 0x1b, 0xd7, 0xdd, 0xfd, 0xa3, 0xb5, 0xd5, 0x58, 0xee, 0xe4, 0x70, 0xba, 0xbe, 0x3f,
-	0xad, 0x9f, 0x99, 0xb3, 0x93, 0x4b, 0x9b, 0x3f, 0x5d, 0x57, 0x0c, 0x2e, 0xbd, 0x34, 0x54, 0x8f,
-	0xd3, 0x5d, 0x7a, 0x6d, 0xf7, 0x97, 0xde, 0xe2, 0x40, 0xe4, 0xcf, 0x83, 0xe0, 0x92, 0xa9, 0xf2,
-	0x88, 0x57, 0x63, 0xe4, 0x69, 0x94, 0x26, 0xd5, 0x16, 0xfd, 0x90, 0x9f, 0xb2, 0x2c, 0xfc, 0xc0,
-	0x53, 0x5a, 0xc9, 0x0f, 0x2d, 0x07, 0x55, 0x8a, 0x0f, 0x17, 0x77, 0xc4, 0xfd, 0x44, 0xd2, 0x47,
-	0x25, 0xdb, 0x89, 0x4a, 0x62, 0x26, 0xb3, 0x10, 0x7f, 0x3f, 0xc1, 0x28, 0x56, 0xd3, 0xb3, 0x44,
-	0xfb, 0x5c, 0x1c, 0x13, 0x9e, 0x73, 0x71, 0x02, 0xc5, 0x29, 0xa2, 0x06, 0xe0, 0x68, 0x7a, 0xcd,
-	0x1f, 0x05, 0x1d, 0x4b, 0xaf, 0xf7, 0xa4, 0x5b, 0xfb, 0x6f, 0xc5, 0x8c, 0xaa, 0xfe, 0xda, 0x51,
-	0xf4, 0x91, 0xd9, 0x6f, 0x57, 0x7b, 0xb1, 0xee, 0x0d, 0xff, 0x01, 0x4b, 0xa3, 0x7a, 0x2e, 0xf7,
-	0x6c, 0xf8, 0x1b, 0xa6, 0xcf, 0x86, 0xdf, 0x60, 0x41, 0xf0, 0x67, 0x83, 0xe0, 0xa2, 0x4b, 0xf1,
-	0x71, 0x5e, 0xeb, 0x6e, 0x76, 0xc7, 0x92, 0x24, 0x71, 0xf0, 0xef, 0xf7, 0x80, 0x32, 0xfc, 0x28,
-	0x78, 0xa3, 0x31, 0xe9, 0x7b, 0x01, 0x28, 0x80, 0xbd, 0x9c, 0xab, 0xf2, 0x63, 0x4e, 0xc9, 0x6f,
-	0xf4, 0xe6, 0x75, 0xa6, 0x6c, 0x97, 0xab, 0x44, 0x99, 0xb2, 0x8a, 0x01, 0x66, 0x22, 0x53, 0x76,
-	0x60, 0x78, 0xc9, 0x6c, 0x90, 0x6a, 0x9c, 0xb8, 0x26, 0x1b, 0x15, 0xc2, 0x1c, 0x25, 0x2b, 0xdd,
-	0x20, 0xee, 0x3b, 0x8d, 0x19, 0x12, 0xd4, 0x9b, 0xbe, 0x08, 0x28, 0x49, 0x5d, 0xed, 0xc5, 0xea,
-	0xeb, 0x87, 0x56, 0xc5, 0xf6, 0x58, 0x24, 0x66, 0x45, 0xeb, 0xfa, 0xa1, 0x5d, 0xee, 0x06, 0x24,
-	0xae, 0x1f, 0xbc, 0x0e, 0xa0, 0xff, 0xab, 0x41, 0xf0, 0xa6, 0xcd, 0xc9, 0x26, 0x56, 0x65, 0xb8,
-	0xe5, 0x0b, 0x69, 0xb3, 0xaa, 0x18, 0x5b, 0x0b, 0xf9, 0xb4, 0x36, 0x43, 0x66, 0x47, 0xde, 0x3e,
-	0x8b, 0x92, 0x34, 0x3a, 0x4e, 0x99, 0x73, 0x33, 0x64, 0xf5, 0x4d, 0x85, 0x7a, 0x37, 0x43, 0xa4,
-	0x4b, 0x6b, 0x96, 0xac, 0xc7, 0x9b, 0x91, 0x44, 0xaf, 0xd1, 0xa3, 0xd2, 0x91, 0x43, 0xaf, 0xf7,
-	0xa4, 0xf5, 0xa5, 0xa5, 0xfe, 0xd9, 0x7c, 0x00, 0xce, 0xdc, 0x1d, 0x7c, 0x8d, 0x9a, 0x78, 0x73,
-	0x77, 0x27, 0x0e, 0xc2, 0xa2, 0x39, 0xbd, 0x32, 0x85, 0xab, 0xd1, 0xb5, 0xd6, 0x19, 0xc8, 0x1c,
-	0x62, 0xeb, 0x3d, 0x69, 0x50, 0xfd, 0x71, 0xf0, 0x46, 0x5b, 0x15, 0x56, 0xa3, 0x8d, 0xce, 0x50,
-	0x68, 0x41, 0xda, 0xec, 0xef, 0xa0, 0x93, 0xfd, 0xfb, 0x49, 0x29, 0x78, 0x31, 0x1f, 0x9d, 0xf0,
-	0xf3, 0xe6, 0xd5, 0x0f, 0x7b, 0x9a, 0x00, 0x60, 0x68, 0x10, 0x44, 0xb2, 0xef, 0x26, 0x5b, 0x52,
-	0xfa, 0x15, 0x91, 0x92, 0x90, 0x32, 0x88, 0x0e, 0x29, 0x9b, 0xd4, 0x93, 0x64, 0x53, 0x2b, 0xfd,
-	0x3e, 0xcb, 0xb2, 0xbb, 0xa8, 0xed, 0x77, 0x5a, 0x56, 0xba, 0x41, 0xbd, 0x01, 0xdb, 0x4b, 0x52,
-	0xf6, 0xf8, 0xf9, 0xf3, 0x94, 0x47, 0x63, 0xb4, 0x01, 0xab, 0x2c, 0x43, 0x30, 0x11, 0x1b, 0x30,
-	0x84, 0xe8, 0x45, 0xa4, 0x32, 0x54, 0xbd, 0xb3, 0x89, 0x7c, 0xad, 0xed, 0x66, 0x98, 0x89, 0x45,
-	0xc4, 0x81, 0xe9, 0xcd, 0x4b, 0x65, 0x3c, 0xca, 0xeb, 0xe0, 0x97, 0xdb, 0x5e, 0xd2, 0x42, 0x6c,
-	0x5e, 0x6c, 0x42, 0x27, 0xe1, 0xd5, 0xef, 0xbb, 0xfc, 0x3c, 0xab, 0x83, 0x3a, 0x2a, 0xda, 0xd8,
-	0x88, 0x24, 0x1c, 0x33, 0x10, 0xf8, 0xa3, 0xe0, 0xbf, 0xeb, 0xc0, 0x05, 0xcf, 0xc3, 0x25, 0x87,
-	0x43, 0x61, 0x5c, 0xd7, 0x5d, 0x22, 0xed, 0xfa, 0x06, 0xb8, 0xfa, 0x75, 0x94, 0x47, 0x31, 0x3b,
-	0x2a, 0xa3, 0x09, 0x43, 0x37, 0xc0, 0xb5, 0x8b, 0xb6, 0x12, 0x37, 0xc0, 0x6d, 0x4a, 0x1f, 0x80,
-	0x3f, 0x8a, 0xce, 0x92, 0x89, 0x9a, 0xb3, 0xe4, 0x10, 0x2c, 0xd1, 0x01, 0xb8, 0x66, 0x86, 0x06,
-	0x44, 0x1c, 0x80, 0x93, 0x30, 0x68, 0xfe, 0x69, 0x10, 0x5c, 0xd6, 0xcc, 0xbd, 0xe6, 0x5c, 0x62,
-	0x3f, 0x7b, 0xce, 0x9f, 0x25, 0xe2, 0xa4, 0xda, 0x08, 0x97, 0xe1, 0xfb, 0x54, 0x48, 0x37, 0xaf,
-	0x8a, 0xf2, 0xc1, 0xc2, 0x7e, 0x3a, 0x0b, 0x6b, 0xce, 0x2b, 0xe4, 0x54, 0xbf, 0x57, 0xf0, 0xa9,
-	0xf4, 0x40, 0x59, 0x98, 0x3a, 0xd6, 0xc0, 0x1c, 0x91, 0x85, 0xf9, 0x78, 0x63, 0x29, 0xa7, 0xd4,
-	0xeb, 0x05, 0xec, 0x56, 0xbf, 0x88, 0xd6, 0x32, 0xb6, 0xb5, 0x90, 0x8f, 0xbe, 0x5a, 0x57, 0x05,
-	0x49, 0x79, 0x86, 0xaf, 0xed, 0x75, 0x94, 0xca, 0x48, 0x5c, 0xad, 0xb7, 0x20, 0x3d, 0xc9, 0x35,
-	0x26, 0xb9, 0xc9, 0xdf, 0x4e, 0x53, 0x34, 0xc9, 0x29, 0x57, 0x05, 0x10, 0x93, 0x9c, 0x13, 0x04,
-	0x9d, 0x83, 0xe0, 0x95, 0xaa, 0x71, 0x9f, 0x14, 0xec, 0x2c, 0x61, 0xf8, 0x8e, 0xd3, 0xb0, 0x10,
-	0xb3, 0x85, 0x4d, 0xe8, 0x71, 0x78, 0x94, 0x95, 0x79, 0x1a, 0x95, 0x27, 0x70, 0xc7, 0x66, 0xd7,
-	0xb9, 0x31, 0xe2, 0x5b, 0xb6, 0x6b, 0x1d, 0x94, 0xde, 0xb8, 0x37, 0x36, 0x35, 0x21, 0x5d, 0x77,
-	0xbb, 0xb6, 0x26, 0xa5, 0xe5, 0x4e, 0x4e, 0x4f, 0xfe, 0x77, 0x52, 0x1e, 0x9f, 0xc2, 0x2c, 0x6a,
-	0xd7, 0xba, 0xb6, 0xe0, 0x69, 0xf4, 0x8a, 0x0f, 0xd1, 0xf3, 0x68, 0x6d, 0x38, 0x60, 0x79, 0x1a,
-	0xc5, 0xf8, 0xf6, 0x57, 0xfa, 0x80, 0x8d, 0x98, 0x47, 0x31, 0x83, 0x8a, 0x0b, 0xb7, 0xca, 0xae,
-	0xe2, 0xa2, 0x4b, 0xe5, 0x2b, 0x3e, 0x44, 0xaf, 0x24, 0xb5, 0x61, 0x94, 0xa7, 0x89, 0x40, 0x7d,
-	0x43, 0x7a, 0xd4, 0x16, 0xa2, 0x6f, 0xd8, 0x04, 0x0a, 0xf9, 0x90, 0x15, 0x13, 0xe6, 0x0c, 0x59,
-	0x5b, 0xbc, 0x21, 0x1b, 0x02, 0x42, 0x3e, 0x0a, 0xfe, 0x47, 0xd6, 0x9d, 0xe7, 0xf3, 0xf0, 0x92,
-	0xab, 0x5a, 0x3c, 0x9f, 0xab, 0x80, 0x97, 0x69, 0x00, 0x15, 0xf1, 0x49, 0x54, 0x0a, 0x77, 0x11,
-	0x6b, 0x8b, 0xb7, 0x88, 0x0d, 0xa1, 0x97, 0x39, 0x59, 0xc4, 0x99, 0x40, 0xcb, 0x1c, 0x14, 0xc0,
-	0xb8, 0x0a, 0xbb, 0x44, 0xda, 0xf5, 0xf0, 0x92, 0xad, 0xc2, 0xc4, 0x5e, 0xc2, 0xd2, 0x71, 0x89,
-	0x86, 0x17, 0x3c, 0xf7, 0xc6, 0x4a, 0x0c, 0xaf, 0x36, 0x85, 0xba, 0x12, 0x9c, 0x51, 0xba, 0x6a,
-	0x87, 0x8e, 0x27, 0xaf, 0xf8, 0x10, 0x9d, 0xf6, 0xd4, 0x06, 0xe3, 0x36, 0xc4, 0x55, 0x1e, 0xc7,
-	0x65, 0xc8, 0xf5, 0x2e, 0xcc, 0x78, 0x19, 0x49, 0x49, 0x3c, 0xe4, 0x67, 0xec, 0x90, 0xdf, 0x7d,
-	0x91, 0x94, 0x22, 0xc9, 0x26, 0xb0, 0x34, 0x6d, 0x11, 0x91, 0x5c, 0x30, 0xf1, 0x32, 0x52, 0xa7,
-	0x93, 0x5e, 0x21, 0x51, 0x59, 0x1e, 0xb1, 0x73, 0xe7, 0x0a, 0x89, 0x23, 0x2a, 0x8e, 0x58, 0x21,
-	0x7d, 0xbc, 0xde, 0x6c, 0x2b, 0x71, 0x78, 0xbd, 0xf7, 0x90, 0x37, 0xc9, 0x0a, 0x15, 0x0d, 0x83,
-	0xc4, 0xb6, 0xc3, 0xeb, 0xa0, 0xf7, 0x02, 0x4a, 0x5f, 0x77, 0xd2, 0x15, 0x22, 0x4e, 0xbb, 0xa3,
-	0xde, 0xe8, 0x41, 0x3a, 0xa4, 0xf4, 0x95, 0x1e, 0x25, 0xd5, 0xbe, 0xd1, 0xbb, 0xd1, 0x83, 0x34,
-	0x36, 0xee, 0x66, 0xb5, 0xee, 0x44, 0xf1, 0xe9, 0xa4, 0xe0, 0xb3, 0x6c, 0xbc, 0xc3, 0x53, 0x5e,
-	0xa0, 0x8d, 0xbb, 0x55, 0x6a, 0x84, 0x12, 0x1b, 0xf7, 0x0e, 0x17, 0x9d, 0x18, 0x98, 0xa5, 0xd8,
-	0x4e, 0x93, 0x09, 0xde, 0xfd, 0x58, 0x81, 0x6a, 0x80, 0x48, 0x0c, 0x9c, 0xa0, 0xa3, 0x13, 0xc9,
-	0xdd, 0x91, 0x48, 0xe2, 0x28, 0x95, 0x7a, 0x1b, 0x74, 0x18, 0x0b, 0xec, 0xec, 0x44, 0x0e, 0x07,
-	0x47, 0x3d, 0x0f, 0x67, 0x45, 0xb6, 0x9f, 0x09, 0x4e, 0xd6, 0xb3, 0x01, 0x3a, 0xeb, 0x69, 0x80,
-	0x3a, 0x9b, 0xa8, 0xcd, 0x87, 0xec, 0x45, 0x55, 0x9a, 0xea, 0x9f, 0xd0, 0x31, 0xe5, 0x54, 0xbf,
-	0x0f, 0xc1, 0x4e, 0x64, 0x13, 0x2e, 0x0e, 0x55, 0x06, 0x44, 0x64, 0x87, 0xf1, 0x78, 0xdb, 0xdd,
-	0x64, 0xa5, 0x1b, 0x74, 0xeb, 0x8c, 0xc4, 0x3c, 0x65, 0x3e, 0x9d, 0x1a, 0xe8, 0xa3, 0xd3, 0x80,
-	0xfa, 0x44, 0xdf, 0xaa, 0xcf, 0x09, 0x8b, 0x4f, 0x5b, 0x6f, 0x28, 0xd8, 0x05, 0x95, 0x08, 0x71,
-	0xa2, 0x4f, 0xa0, 0xee, 0x26, 0xda, 0x8f, 0x79, 0xe6, 0x6b, 0xa2, 0xca, 0xde, 0xa7, 0x89, 0x80,
-	0xd3, 0xbb, 0x3b, 0x65, 0x85, 0x9e, 0x29, 0x9b, 0x69, 0x95, 0x88, 0x60, 0x42, 0xc4, 0xee, 0x8e,
-	0x84, 0xf5, 0x31, 0x2c, 0xd6, 0x7c, 0xd8, 0x7e, 0x67, 0xaf, 0x15, 0xe5, 0x21, 0xfd, 0xce, 0x1e,
-	0xc5, 0xd2, 0x95, 0x94, 0x7d, 0xa4, 0x23, 0x8a, 0xdd, 0x4f, 0xd6, 0xfa, 0xc1, 0xfa, 0xbe, 0xde,
-	0xd2, 0xdc, 0x49, 0x59, 0x54, 0x48, 0xd5, 0x75, 0x4f, 0x20, 0x8d, 0x11, 0x67, 0x7e, 0x1e, 0x1c,
-	0x4d, 0x61, 0x96, 0xf2, 0x0e, 0xcf, 0x04, 0xcb, 0x84, 0x6b, 0x0a, 0xb3, 0x83, 0x01, 0xe8, 0x9b,
-	0xc2, 0x28, 0x07, 0xd4, 0x6f, 0xeb, 0x43, 0x09, 0x26, 0x1e, 0x45, 0x53, 0xe6, 0xea, 0xb7, 0xf2,
-	0xc0, 0x41, 0xda, 0x7d, 0xfd, 0x16, 0x71, 0x68, 0xc8, 0xef, 0x4f, 0xa3, 0x89, 0x52, 0x71, 0x78,
-	0xd7, 0xf6, 0x96, 0xcc, 0x4a, 0x37, 0x88, 0x74, 0x9e, 0x26, 0x63, 0xc6, 0x3d, 0x3a, 0xb5, 0xbd,
-	0x8f, 0x0e, 0x06, 0x51, 0xe6, 0x54, 0xd5, 0x56, 0xee, 0x47, 0xb6, 0xb3, 0x31, 0xec, 0xc2, 0x86,
-	0xc4, 0x43, 0x41, 0x9c, 0x2f, 0x73, 0x22, 0x78, 0x34, 0x3e, 0x9a, 0x13, 0x3a, 0xdf, 0xf8, 0x50,
-	0x07, 0x70, 0x7d, 0xc6, 0x87, 0x0b, 0x06, 0xcd, 0x1f, 0xc2, 0xf8, 0xd8, 0x8d, 0x44, 0x54, 0xed,
-	0xa3, 0x9f, 0x26, 0xec, 0x1c, 0xb6, 0x71, 0x8e, 0xfa, 0x36, 0xd4, 0xb0, 0xc2, 0xf0, 0x9e, 0x6e,
-	0xa3, 0x37, 0xef, 0xd1, 0x86, 0xec, 0xbc, 0x53, 0x1b, 0xa5, 0xe9, 0x1b, 0xbd, 0x79, 0x8f, 0x36,
-	0xbc, 0x07, 0xdf, 0xa9, 0x8d, 0x5e, 0x86, 0xdf, 0xe8, 0xcd, 0x83, 0xf6, 0xcf, 0x07, 0xc1, 0xc5,
-	0x96, 0x78, 0x95, 0x03, 0xc5, 0x22, 0x39, 0x63, 0xae, 0x54, 0xce, 0x8e, 0xa7, 0x50, 0x5f, 0x2a,
-	0x47, 0xbb, 0x40, 0x29, 0x7e, 0x3d, 0x08, 0xde, 0x74, 0x95, 0xe2, 0x09, 0x2f, 0x93, 0xfa, 0x46,
-	0x73, 0xab, 0x47, 0xd0, 0x06, 0xf6, 0x6d, 0x58, 0x7c, 0x4e, 0xfa, 0x3e, 0xc8, 0x42, 0xf5, 0xcb,
-	0x80, 0x6b, 0x9e, 0x78, 0xed, 0x77, 0x02, 0xd7, 0x7b, 0xd2, 0xfa, 0x82, 0xc4, 0x62, 0xcc, 0x9b,
-	0x19, 0x5f, 0xab, 0x3a, 0x2f, 0x67, 0x36, 0xfb, 0x3b, 0x80, 0xfc, 0x2f, 0x9b, 0x9c, 0x1e, 0xeb,
-	0xc3, 0x20, 0xb8, 0xd5, 0x27, 0x22, 0x1a, 0x08, 0x5b, 0x0b, 0xf9, 0x40, 0x41, 0xfe, 0x3a, 0x08,
-	0xae, 0x38, 0x0b, 0x62, 0x5f, 0x0e, 0x7e, 0xa3, 0x4f, 0x6c, 0xf7, 0x25, 0xe1, 0x37, 0xbf, 0x88,
-	0x2b, 0x94, 0xee, 0xb7, 0xcd, 0xd6, 0xba, 0xf1, 0xa8, 0x5f, 0xd8, 0x7e, 0x5c, 0x8c, 0x59, 0x01,
-	0x23, 0xd6, 0xd7, 0xe9, 0x34, 0x8c, 0xc7, 0xed, 0x7b, 0x0b, 0x7a, 0x41, 0x71, 0x7e, 0x3f, 0x08,
-	0x96, 0x2c, 0x18, 0xbe, 0x26, 0x31, 0xca, 0xe3, 0x8b, 0x6c, 0xd0, 0xb8, 0x40, 0xef, 0x2f, 0xea,
-	0x46, 0x8d, 0x64, 0x03, 0xae, 0xbf, 0x1b, 0xda, 0xea, 0x19, 0xd8, 0xfa, 0x92, 0xe8, 0xf6, 0x62,
-	0x4e, 0x50, 0x96, 0xbf, 0x0d, 0x82, 0x6b, 0x16, 0xab, 0x0f, 0xb1, 0xd1, 0x79, 0xc8, 0xb7, 0x3c,
-	0xf1, 0x29, 0x27, 0x55, 0xb8, 0x6f, 0x7f, 0x31, 0x67, 0x7d, 0x0f, 0x6c, 0xb9, 0xec, 0x25, 0xa9,
-	0x60, 0x45, 0xfb, 0xe3, 0x55, 0x3b, 0xae, 0xa4, 0x86, 0xf4, 0xc7, 0xab, 0x1e, 0xdc, 0xf8, 0x78,
-	0xd5, 0xa1, 0xec, 0xfc, 0x78, 0xd5, 0x19, 0xcd, 0xfb, 0xf1, 0xaa, 0xdf, 0x83, 0x5a, 0x7c, 0x9a,
-	0x22, 0xc8, 0x33, 0xe1, 0x5e, 0x11, 0xed, 0x23, 0xe2, 0x5b, 0x8b, 0xb8, 0x10, 0xcb, 0xaf, 0xe4,
-	0xea, 0x57, 0x96, 0x7a, 0x3c, 0x53, 0xeb, 0xb5, 0xa5, 0x8d, 0xde, 0x3c, 0x68, 0x7f, 0x02, 0xfb,
-	0x1e, 0xb5, 0xd8, 0xf0, 0xa2, 0xfe, 0x70, 0x79, 0xd5, 0xb7, 0x78, 0x54, 0x11, 0xcc, 0x96, 0x5f,
-	0xeb, 0x07, 0x13, 0xd5, 0xad, 0x08, 0x68, 0xf4, 0x61, 0x57, 0x20, 0xd4, 0xe4, 0x1b, 0xbd, 0x79,
-	0x62, 0x91, 0x93, 0xda, 0xb2, 0xb5, 0x7b, 0x04, 0xb3, 0xdb, 0x7a, 0xb3, 0xbf, 0x83, 0x7e, 0xf5,
-	0xa1, 0x25, 0x5f, 0xb7, 0x73, 0xe7, 0x13, 0xb4, 0x5a, 0x79, 0xbd, 0x27, 0xed, 0x4b, 0x6e, 0xcc,
-	0xe5, 0xbd, 0x2b, 0xb9, 0x71, 0x2e, 0xf1, 0xb7, 0x17, 0x73, 0x82, 0xb2, 0xfc, 0x71, 0x10, 0x5c,
-	0x22, 0xcb, 0x02, 0xbd, 0xe0, 0xfd, 0xbe, 0x91, 0x51, 0x6f, 0xf8, 0x60, 0x61, 0x3f, 0x28, 0xd4,
-	0x5f, 0x06, 0xc1, 0x65, 0x4f, 0xa1, 0x64, 0xf7, 0x58, 0x20, 0xba, 0xdd, 0x4d, 0x3e, 0x5c, 0xdc,
-	0x91, 0x5a, 0xec, 0x4d, 0x7c, 0xd4, 0xfe, 0x58, 0xd4, 0x13, 0x7b, 0x44, 0x7f, 0x2c, 0xda, 0xed,
-	0x85, 0x0f, 0x7f, 0xaa, 0x94, 0x04, 0xf6, 0x45, 0xae, 0xc3, 0x9f, 0x3a, 0x63, 0x41, 0xfb, 0xa1,
-	0xe5, 0x4e, 0xce, 0x25, 0x72, 0xf7, 0x45, 0x1e, 0x65, 0x63, 0x5a, 0x44, 0xda, 0xbb, 0x45, 0x14,
-	0x87, 0x0f, 0xcd, 0x2a, 0xeb, 0x01, 0x6f, 0x36, 0x79, 0x37, 0x28, 0x7f, 0x85, 0x78, 0x0f, 0xcd,
-	0x5a, 0x28, 0xa1, 0x06, 0x19, 0xad, 0x4f, 0x0d, 0x25, 0xb2, 0x37, 0xfb, 0xa0, 0x68, 0xfb, 0xa0,
-	0xd4, 0xd4, 0x59, 0xfc, 0x9a, 0x2f, 0x4a, 0xeb, 0x3c, 0x7e, 0xbd, 0x27, 0x4d, 0xc8, 0x8e, 0x98,
-	0xb8, 0xcf, 0xa2, 0x31, 0x2b, 0xbc, 0xb2, 0x8a, 0xea, 0x25, 0x6b, 0xd2, 0x2e, 0xd9, 0x1d, 0x9e,
-	0xce, 0xa6, 0x19, 0x34, 0x26, 0x29, 0x6b, 0x52, 0xdd, 0xb2, 0x88, 0xc6, 0xc7, 0x85, 0x5a, 0xb6,
-	0x4e, 0x2e, 0x6f, 0xfa, 0xc3, 0x58, 0x39, 0xe5, 0x6a, 0x2f, 0x96, 0xae, 0x27, 0x74, 0xa3, 0x8e,
-	0x7a, 0xa2, 0x9e, 0xb4, 0xde, 0x93, 0xc6, 0xe7, 0x76, 0x86, 0xac, 0xea, 0x4f, 0x1b, 0x1d, 0xb1,
-	0x5a, 0x5d, 0x6a, 0xb3, 0xbf, 0x03, 0x3e, 0x25, 0x85, 0x5e, 0x55, 0xed, 0x8a, 0xf6, 0x92, 0x34,
-	0x0d, 0x57, 0x3d, 0xdd, 0xa4, 0x81, 0xbc, 0xa7, 0xa4, 0x0e, 0x98, 0xe8, 0xc9, 0xcd, 0xa9, 0x62,
-	0x16, 0x76, 0xc5, 0xa9, 0xa9, 0x5e, 0x3d, 0xd9, 0xa4, 0xd1, 0x69, 0x9b, 0xf1, 0xa8, 0x55, 0x6d,
-	0x87, 0xfe, 0x07, 0xd7, 0xaa, 0xf0, 0x46, 0x6f, 0x1e, 0x5d, 0x64, 0xd7, 0x54, 0xbd, 0xb2, 0x5c,
-	0xa5, 0x42, 0x58, 0x2b, 0xc9, 0xb5, 0x0e, 0x0a, 0x9d, 0x58, 0xca, 0x61, 0xf4, 0x2c, 0x19, 0x4f,
-	0x98, 0x70, 0xde, 0x20, 0x99, 0x80, 0xf7, 0x06, 0x09, 0x81, 0xa8, 0xe9, 0xe4, 0xef, 0x23, 0x26,
-	0x0e, 0xa3, 0x62, 0xc2, 0xc4, 0xfe, 0xd8, 0xd5, 0x74, 0xe0, 0x6c, 0x50, 0xbe, 0xa6, 0x73, 0xd2,
-	0x68, 0x36, 0x50, 0xb2, 0xf0, 0xc5, 0xed, 0x4d, 0x5f, 0x18, 0xf4, 0xd9, 0xed, 0x6a, 0x2f, 0x16,
-	0xad, 0x28, 0x5a, 0x30, 0x99, 0x26, 0xc2, 0xb5, 0xa2, 0x18, 0x31, 0x2a, 0xc4, 0xb7, 0xa2, 0xb4,
-	0x51, 0xaa, 0x7a, 0x55, 0x8e, 0xb0, 0x3f, 0xf6, 0x57, 0x4f, 0x32, 0xfd, 0xaa, 0xa7, 0xd8, 0xd6,
-	0x85, 0x67, 0xa6, 0xba, 0x8c, 0x38, 0x81, 0xad, 0xb2, 0xa3, 0x6f, 0xd7, 0x1f, 0xa1, 0x61, 0xd0,
-	0x37, 0xeb, 0x50, 0x0e, 0xc6, 0xe7, 0x15, 0x8a, 0x6b, 0xee, 0x64, 0xf3, 0x9c, 0x45, 0x45, 0x94,
-	0xc5, 0xce, 0xad, 0x69, 0x1d, 0xb0, 0x45, 0xfa, 0xb6, 0xa6, 0xa4, 0x07, 0xba, 0x4e, 0xb7, 0x3f,
-	0x1f, 0x73, 0x0c, 0x05, 0xf5, 0x9d, 0x96, 0xfd, 0xf5, 0xd8, 0x8d, 0x1e, 0x24, 0xbe, 0x4e, 0x6f,
-	0x00, 0x75, 0x28, 0x2f, 0x45, 0xdf, 0xf5, 0x84, 0xb2, 0x51, 0xdf, 0x36, 0x98, 0x76, 0x41, 0x9d,
-	0x5a, 0x25, 0xb8, 0x4c, 0x7c, 0xc4, 0xe6, 0xae, 0x4e, 0xad, 0xf3, 0xd3, 0x1a, 0xf1, 0x75, 0xea,
-	0x36, 0x8a, 0xf2, 0x4c, 0x73, 0x1f, 0x74, 0xdd, 0xe3, 0x6f, 0x6e, 0x7d, 0x96, 0x3b, 0x39, 0x34,
-	0x72, 0x76, 0x93, 0x33, 0xeb, 0x0e, 0xc3, 0x51, 0xd0, 0xdd, 0xe4, 0xcc, 0x7d, 0x85, 0xb1, 0xda,
-	0x8b, 0xc5, 0x57, 0xf5, 0x91, 0x60, 0x2f, 0x9a, 0x3b, 0x74, 0x47, 0x71, 0x6b, 0x7b, 0xeb, 0x12,
-	0x7d, 0xa5, 0x1b, 0xd4, 0xef, 0x5b, 0x3e, 0x29, 0x78, 0xcc, 0xca, 0x72, 0xa7, 0xea, 0xb6, 0x29,
-	0x7a, 0xdf, 0x12, 0x6c, 0x43, 0x69, 0x24, 0xde, 0xb7, 0x6c, 0x41, 0x10, 0xfb, 0x7e, 0xf0, 0xf2,
-	0x03, 0x3e, 0x19, 0xb1, 0x6c, 0x1c, 0xbe, 0x65, 0xbf, 0xe1, 0xc8, 0x27, 0xc3, 0xea, 0x67, 0x15,
-	0x6f, 0x89, 0x32, 0xeb, 0xd7, 0xd1, 0x76, 0xd9, 0xf1, 0x6c, 0x72, 0x58, 0x30, 0x86, 0x5e, 0x47,
-	0xab, 0x7f, 0x1f, 0x56, 0x06, 0xe2, 0x75, 0x34, 0x0b, 0xd0, 0xab, 0xa4, 0x8a, 0x57, 0x25, 0xa2,
-	0xf8, 0x75, 0x2f, 0xed, 0x53, 0x5b, 0x89, 0x55, 0xb2, 0x4d, 0xe9, 0xc6, 0xab, 0x6d, 0xf5, 0x1b,
-	0xcf, 0xa3, 0xd9, 0x74, 0x1a, 0x15, 0x73, 0xd4, 0x78, 0xd2, 0xd7, 0x04, 0x88, 0xc6, 0x73, 0x82,
-	0x3a, 0xa9, 0xaa, 0xcd, 0xf2, 0xc5, 0xb0, 0xfa, 0xcf, 0x38, 0x95, 0x82, 0x17, 0xf8, 0x6a, 0x4d,
-	0x86, 0xc0, 0x10, 0x91, 0x54, 0x91, 0x30, 0x6a, 0x8a, 0x27, 0x49, 0x36, 0x71, 0x36, 0x45, 0x65,
-	0xf0, 0x36, 0x05, 0x00, 0x7a, 0x7a, 0x94, 0xcf, 0x4a, 0xfe, 0xbd, 0x10, 0xf8, 0x06, 0xcc, 0xf9,
-	0x0c, 0x4c, 0x82, 0x98, 0x1e, 0xdd, 0x24, 0x92, 0x7a, 0x9c, 0xb3, 0x8c, 0x8d, 0x9b, 0x97, 0xb7,
-	0x5c, 0x52, 0x16, 0xe1, 0x95, 0xc2, 0xa4, 0x9e, 0x2f, 0x1e, 0x32, 0x51, 0x24, 0x71, 0x39, 0x62,
-	0xe2, 0x49, 0x54, 0x44, 0x53, 0x26, 0x58, 0x51, 0xa2, 0xf9, 0x02, 0x90, 0xa1, 0xc5, 0x10, 0xf3,
-	0x05, 0xc5, 0x82, 0xe0, 0x77, 0x82, 0xd7, 0xab, 0x89, 0x84, 0x65, 0xf0, 0x27, 0x1a, 0xef, 0xd6,
-	0x7f, 0xbd, 0x34, 0xbc, 0xa0, 0x62, 0x8c, 0x44, 0xc1, 0xa2, 0x69, 0x13, 0xfb, 0x35, 0xf5, 0x7b,
-	0x0d, 0x6e, 0x0e, 0xee, 0xbc, 0xfd, 0x8f, 0xcf, 0x96, 0x06, 0x9f, 0x7e, 0xb6, 0x34, 0xf8, 0xf7,
-	0x67, 0x4b, 0x83, 0x3f, 0x7c, 0xbe, 0xf4, 0xd2, 0xa7, 0x9f, 0x2f, 0xbd, 0xf4, 0xcf, 0xcf, 0x97,
-	0x5e, 0xfa, 0xf8, 0x65, 0xf8, 0x2b, 0xaa, 0xc7, 0xff, 0x55, 0xff, 0x2d, 0xd4, 0xad, 0xff, 0x04,
-	0x00, 0x00, 0xff, 0xff, 0x42, 0x15, 0xa7, 0xd4, 0x69, 0x55, 0x00, 0x00,
+	// 4211 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x9c, 0x5b, 0x6f, 0x1c, 0xc7,
+	0x95, 0x80, 0x3d, 0x2f, 0xeb, 0xdd, 0xf6, 0xda, 0xbb, 0x3b, 0xde, 0xd5, 0x7a, 0xb5, 0x36, 0x75,
+	0x27, 0x29, 0x91, 0x1c, 0xd2, 0xa2, 0x7c, 0xd9, 0x4d, 0x80, 0x80, 0x22, 0x45, 0x89, 0x30, 0x29,
+	0x29, 0x1c, 0x4a, 0x02, 0x0c, 0x04, 0x48, 0xb3, 0xa7, 0x34, 0xd3, 0x61, 0x4f, 0x57, 0xbb, 0xbb,
+	0x67, 0x28, 0x26, 0x48, 0x90, 0x20, 0x37, 0x24, 0x48, 0x90, 0x20, 0x97, 0xa7, 0xbc, 0xe5, 0x77,
+	0xe4, 0x07, 0xe4, 0xd1, 0x8f, 0x79, 0x0c, 0xec, 0x3f, 0x12, 0x74, 0xd7, 0xe9, 0xba, 0x9c, 0xaa,
+	0x53, 0xdd, 0xe3, 0x27, 0x09, 0x3c, 0xdf, 0x39, 0xa7, 0xee, 0x75, 0x4e, 0x55, 0x4d, 0x07, 0x57,
+	0xb2, 0xd3, 0xcd, 0x2c, 0xe7, 0x25, 0x2f, 0x36, 0x0b, 0x96, 0xcf, 0xe3, 0x88, 0x35, 0xff, 0x0e,
+	0xea, 0x3f, 0xf7, 0x5f, 0x0f, 0xd3, 0x8b, 0xf2, 0x22, 0x63, 0x97, 0xdf, 0x51, 0x64, 0xc4, 0xa7,
+	0xd3, 0x30, 0x1d, 0x15, 0x02, 0xb9, 0x7c, 0x49, 0x49, 0xd8, 0x9c, 0xa5, 0x25, 0xfc, 0xfd, 0xee,
+	0x4f, 0xff, 0xd2, 0x0b, 0xde, 0xda, 0x4d, 0x62, 0x96, 0x96, 0xbb, 0xa0, 0xd1, 0xff, 0x34, 0x78,
+	0x73, 0x27, 0xcb, 0x1e, 0xb2, 0xf2, 0x39, 0xcb, 0x8b, 0x98, 0xa7, 0xfd, 0x1b, 0x03, 0x70, 0x30,
+	0x38, 0xce, 0xa2, 0xc1, 0x4e, 0x96, 0x0d, 0x94, 0x70, 0x70, 0xcc, 0x3e, 0x9b, 0xb1, 0xa2, 0xbc,
+	0x7c, 0xd3, 0x0f, 0x15, 0x19, 0x4f, 0x0b, 0xd6, 0x7f, 0x19, 0xfc, 0xc7, 0x4e, 0x96, 0x0d, 0x59,
+	0xb9, 0xc7, 0xaa, 0x0a, 0x0c, 0xcb, 0xb0, 0x64, 0xfd, 0x15, 0x4b, 0xd5, 0x04, 0xa4, 0x8f, 0xd5,
+	0x76, 0x10, 0xfc, 0x9c, 0x04, 0x6f, 0x54, 0x7e, 0x26, 0xb3, 0x72, 0xc4, 0xcf, 0xd3, 0xfe, 0x35,
+	0x5b, 0x11, 0x44, 0xd2, 0xf6, 0x75, 0x1f, 0x02, 0x56, 0x5f, 0x04, 0xff, 0xfa, 0x22, 0x4c, 0x12,
+	0x56, 0xee, 0xe6, 0xac, 0x2a, 0xb8, 0xa9, 0x23, 0x44, 0x03, 0x21, 0x93, 0x76, 0x6f, 0x78, 0x19,
+	0x30, 0xfc, 0x69, 0xf0, 0xa6, 0x90, 0x1c, 0xb3, 0x88, 0xcf, 0x59, 0xde, 0x77, 0x6a, 0x81, 0x90,
+	0x68, 0x72, 0x0b, 0xc2, 0xb6, 0x77, 0x79, 0x3a, 0x67, 0x79, 0xe9, 0xb6, 0x0d, 0x42, 0xbf, 0x6d,
+	0x05, 0x81, 0xed, 0x24, 0x78, 0x5b, 0x6f, 0x90, 0x21, 0x2b, 0xea, 0x01, 0x73, 0x9b, 0xae, 0x33,
+	0x20, 0xd2, 0xcf, 0x9d, 0x2e, 0x28, 0x78, 0x8b, 0x83, 0x3e, 0x78, 0x4b, 0x78, 0x21, 0x9d, 0xad,
+	0x3a, 0x2d, 0x68, 0x84, 0xf4, 0x75, 0xbb, 0x03, 0x09, 0xae, 0xbe, 0x1d, 0xfc, 0xdb, 0x0b, 0x9e,
+	0x9f, 0x15, 0x59, 0x18, 0x31, 0xe8, 0xec, 0x5b, 0xa6, 0x76, 0x23, 0xc5, 0xfd, 0xbd, 0xdc, 0x86,
+	0x81, 0x87, 0xb3, 0xa0, 0x2f, 0x85, 0x4f, 0x4e, 0xbf, 0xc3, 0xa2, 0x72, 0x67, 0x34, 0xc2, 0x2d,
+	0x27, 0xb5, 0x05, 0x31, 0xd8, 0x19, 0x8d, 0xa8, 0x96, 0x73, 0xa3, 0xe0, 0xec, 0x3c, 0xb8, 0x84,
+	0x9c, 0x1d, 0xc6, 0x45, 0xed, 0x70, 0xc3, 0x6f, 0x05, 0x30, 0xe9, 0x74, 0xd0, 0x15, 0x07, 0xc7,
+	0x3f, 0xec, 0x05, 0xff, 0xe3, 0xf0, 0x7c, 0xcc, 0xa6, 0x7c, 0xce, 0xfa, 0x5b, 0xed, 0xd6, 0x04,
+	0x29, 0xfd, 0xbf, 0xbf, 0x80, 0x86, 0xa3, 0x2b, 0x87, 0x2c, 0x61, 0x51, 0x49, 0x76, 0xa5, 0x10,
+	0xb7, 0x76, 0xa5, 0xc4, 0xb4, 0x59, 0xd0, 0x08, 0x1f, 0xb2, 0x72, 0x77, 0x96, 0xe7, 0x2c, 0x2d,
+	0xc9, 0xbe, 0x54, 0x48, 0x6b, 0x5f, 0x1a, 0xa8, 0xa3, 0x3e, 0x0f, 0x59, 0xb9, 0x93, 0x24, 0x64,
+	0x7d, 0x84, 0xb8, 0xb5, 0x3e, 0x12, 0x03, 0x0f, 0x3f, 0xd0, 0xfa, 0x6c, 0xc8, 0xca, 0x83, 0xe2,
+	0x51, 0x3c, 0x9e, 0x24, 0xf1, 0x78, 0x52, 0xb2, 0x51, 0x7f, 0x93, 0x6c, 0x14, 0x13, 0x94, 0x5e,
+	0xb7, 0xba, 0x2b, 0x38, 0x6a, 0xf8, 0xe0, 0x55, 0xc6, 0x73, 0xba, 0xc7, 0x84, 0xb8, 0xb5, 0x86,
+	0x12, 0x03, 0x0f, 0xdf, 0x0a, 0xde, 0xda, 0x89, 0x22, 0x3e, 0x4b, 0xe5, 0x82, 0x8b, 0xb6, 0x2f,
+	0x21, 0xb4, 0x56, 0xdc, 0x5b, 0x2d, 0x94, 0x5a, 0x72, 0x41, 0x06, 0x6b, 0xc7, 0x0d, 0xa7, 0x1e,
+	0x5a, 0x39, 0x6e, 0xfa, 0x21, 0xcb, 0xf6, 0x1e, 0x4b, 0x18, 0x69, 0x5b, 0x08, 0x5b, 0x6c, 0x4b,
+	0xc8, 0xb2, 0x0d, 0x13, 0xc5, 0x6d, 0x1b, 0x4d, 0x93, 0x9b, 0x7e, 0x08, 0x6c, 0xff, 0xb2, 0x17,
+	0xbc, 0x07, 0xb2, 0x07, 0x69, 0x78, 0x9a, 0xb0, 0x43, 0x1e, 0x85, 0xc9, 0x63, 0x56, 0x9e, 0xf3,
+	0xfc, 0x6c, 0x78, 0x91, 0x46, 0xfd, 0x6d, 0xa7, 0x1d, 0x37, 0x2c, 0x9d, 0xdf, 0x5b, 0x4c, 0x49,
+	0x0b, 0x0f, 0xa0, 0xa2, 0x25, 0xcf, 0x70, 0x78, 0xd0, 0xd4, 0xa0, 0xe4, 0x19, 0x15, 0x1e, 0x98,
+	0x88, 0x65, 0xf5, 0xa8, 0x5a, 0xdd, 0xdc, 0x56, 0x8f, 0xf4, 0xe5, 0xec, 0xba, 0x0f, 0x51, 0xab,
+	0x4b, 0x33, 0x98, 0x78, 0xfa, 0x32, 0x1e, 0x3f, 0xcb, 0x46, 0xd5, 0x90, 0xba, 0xed, 0x1e, 0x2d,
+	0x1a, 0x42, 0xac, 0x2e, 0x04, 0x0a, 0xde, 0x7e, 0xdd, 0x0b, 0x96, 0xcc, 0xa9, 0xb1, 0x9f, 0xf3,
+	0xe9, 0x21, 0x1b, 0x87, 0xd1, 0x05, 0xcc, 0xc5, 0x7b, 0xbe, 0x49, 0x80, 0x69, 0x59, 0x88, 0x0f,
+	0x16, 0xd4, 0x82, 0xf2, 0x7c, 0x33, 0x08, 0xc4, 0xda, 0xfe, 0x24, 0x63, 0x69, 0xff, 0xaa, 0x61,
+	0x04, 0x16, 0xfd, 0x4a, 0x22, 0xdd, 0x5c, 0xf3, 0x10, 0xaa, 0x9b, 0xc4, 0xdf, 0xeb, 0xad, 0xbf,
+	0xef, 0xd4, 0xa8, 0x45, 0x44, 0x37, 0x21, 0x04, 0x17, 0x74, 0x38, 0xe1, 0xe7, 0xee, 0x82, 0x56,
+	0x12, 0x7f, 0x41, 0x81, 0x50, 0xe1, 0x26, 0x14, 0xd4, 0x15, 0x6e, 0x36, 0xc5, 0xf0, 0x85, 0x9b,
+	0x98, 0x01, 0xc3, 0x3c, 0xf8, 0x4f, 0xdd, 0xf0, 0x7d, 0xce, 0xcf, 0xa6, 0x61, 0x7e, 0xd6, 0xbf,
+	0x43, 0x2b, 0x37, 0x8c, 0x74, 0xb4, 0xd6, 0x89, 0x55, 0x2b, 0xba, 0xee, 0x70, 0xc8, 0xf0, 0x8a,
+	0x6e, 0xe8, 0x0f, 0x19, 0xb5, 0xa2, 0x3b, 0x30, 0xdc, 0xa9, 0x0f, 0xf3, 0x30, 0x9b, 0xb8, 0x3b,
+	0xb5, 0x16, 0xf9, 0x3b, 0xb5, 0x41, 0x70, 0x0f, 0x0c, 0x59, 0x98, 0x47, 0x13, 0x77, 0x0f, 0x08,
+	0x99, 0xbf, 0x07, 0x24, 0x03, 0x86, 0xf3, 0xe0, 0xbf, 0x74, 0xc3, 0xc3, 0xd9, 0x69, 0x11, 0xe5,
+	0xf1, 0x29, 0xeb, 0xaf, 0xd1, 0xda, 0x12, 0x92, 0xae, 0xd6, 0xbb, 0xc1, 0x2a, 0x7c, 0x06, 0x9f,
+	0x8d, 0xec, 0x60, 0x54, 0xa0, 0xf0, 0xb9, 0xb1, 0xa1, 0x11, 0x44, 0xf8, 0xec, 0x26, 0x71, 0xf5,
+	0x1e, 0xe6, 0x7c, 0x96, 0x15, 0x2d, 0xd5, 0x43, 0x90, 0xbf, 0x7a, 0x36, 0x0c, 0x3e, 0x5f, 0x05,
+	0xff, 0xad, 0x37, 0xe9, 0xb3, 0xb4, 0x90, 0x5e, 0x37, 0xe8, 0x76, 0xd2, 0x30, 0x22, 0xc8, 0xf5,
+	0xe0, 0xe0, 0x39, 0x0a, 0xfe, 0xbd, 0xf1, 0x5c, 0xee, 0xb1, 0x32, 0x8c, 0x93, 0xa2, 0xbf, 0xec,
+	0xb6, 0xd1, 0xc8, 0xa5, 0xaf, 0x95, 0x56, 0x0e, 0x4f, 0xa1, 0xbd, 0x59, 0x96, 0xc4, 0x91, 0x9d,
+	0x91, 0x80, 0xae, 0x14, 0xfb, 0xa7, 0x90, 0x8e, 0xa9, 0x8d, 0x46, 0x56, 0x43, 0xfc, 0xe7, 0xe4,
+	0x22, 0xc3, 0x1b, 0x8d, 0x2a, 0xa1, 0x42, 0x88, 0x8d, 0x86, 0x40, 0x71, 0x7d, 0x86, 0xac, 0x3c,
+	0x0c, 0x2f, 0xf8, 0x8c, 0x58, 0x12, 0xa4, 0xd8, 0x5f, 0x1f, 0x1d, 0x03, 0x0f, 0xb3, 0xe0, 0x92,
+	0xf4, 0x70, 0x90, 0x96, 0x2c, 0x4f, 0xc3, 0x64, 0x3f, 0x09, 0xc7, 0x45, 0x9f, 0x98, 0x37, 0x26,
+	0x25, 0xfd, 0x6d, 0x74, 0xa4, 0x1d, 0xcd, 0x78, 0x50, 0xec, 0x87, 0x73, 0x9e, 0xc7, 0x25, 0xdd,
+	0x8c, 0x0a, 0x69, 0x6d, 0x46, 0x03, 0x55, 0x21, 0x9b, 0x6a, 0x46, 0x1e, 0x9d, 0xf5, 0x6f, 0x50,
+	0xad, 0xc3, 0xa3, 0x33, 0x22, 0x64, 0xb3, 0x20, 0x67, 0x4d, 0x76, 0xf2, 0x68, 0x12, 0xcf, 0xd9,
+	0xc8, 0x53, 0x93, 0x06, 0xe9, 0x50, 0x13, 0x0d, 0x75, 0x0c, 0x88, 0x21, 0x9f, 0xe5, 0x11, 0x23,
+	0x07, 0x84, 0x10, 0xb7, 0x0e, 0x08, 0x89, 0x81, 0x87, 0x9f, 0xf4, 0x82, 0xff, 0x15, 0x52, 0x3d,
+	0xbd, 0xd9, 0x0b, 0x8b, 0xc9, 0x29, 0x0f, 0xf3, 0x51, 0xff, 0x7d, 0x97, 0x1d, 0x27, 0x2a, 0x5d,
+	0xdf, 0x5d, 0x44, 0x05, 0x37, 0x6b, 0x95, 0xad, 0xaa, 0xd9, 0xec, 0x6c, 0x56, 0x03, 0xf1, 0x37,
+	0x2b, 0x46, 0xf1, 0xe2, 0x54, 0xcb, 0x45, 0xca, 0xb0, 0x4c, 0xea, 0x9b, 0x59, 0xc3, 0x4a, 0x2b,
+	0x87, 0xd7, 0xde, 0x4a, 0x68, 0x8e, 0x96, 0x0d, 0xca, 0x86, 0x7b, 0xc4, 0x0c, 0xba, 0xe2, 0x78,
+	0xa7, 0x39, 0x62, 0xf9, 0x98, 0xc9, 0xfa, 0x17, 0xee, 0x9d, 0x06, 0x41, 0xfe, 0x9d, 0xc6, 0x86,
+	0xd5, 0x21, 0xa6, 0x40, 0xf6, 0xe3, 0x74, 0x74, 0xcc, 0xb2, 0x24, 0x8c, 0xf0, 0x21, 0x26, 0x98,
+	0xd0, 0x00, 0xe2, 0x10, 0xd3, 0x09, 0x92, 0xad, 0x2a, 0x57, 0x13, 0x7f, 0xab, 0x5a, 0x2b, 0xca,
+	0xa0, 0x2b, 0x4e, 0x78, 0xd6, 0xb6, 0x03, 0x9f, 0x67, 0xc7, 0x96, 0x30, 0xe8, 0x8a, 0xbb, 0x3c,
+	0x1f, 0xf1, 0x51, 0xfc, 0xf2, 0xa2, 0xd9, 0x52, 0x49, 0xcf, 0x06, 0xd6, 0xee, 0x19, 0xe3, 0x78,
+	0x5a, 0xee, 0x64, 0x59, 0x72, 0x71, 0xc2, 0xa6, 0x59, 0x42, 0x4e, 0x4b, 0x03, 0xf1, 0x4f, 0x4b,
+	0x8c, 0xe2, 0x78, 0xf5, 0x84, 0x57, 0xd1, 0xb0, 0x33, 0x5e, 0xad, 0x45, 0xfe, 0x78, 0xb5, 0x41,
+	0x70, 0x88, 0x77, 0xc2, 0x77, 0x79, 0x52, 0x25, 0xe0, 0xf6, 0x09, 0xa9, 0xd4, 0x54, 0x84, 0x3f,
+	0xc4, 0x43, 0x24, 0x9e, 0x04, 0xc3, 0x49, 0x98, 0xb3, 0xfb, 0x17, 0x87, 0x71, 0x7a, 0xe6, 0x9e,
+	0x04, 0x1a, 0xe0, 0x9f, 0x04, 0x26, 0x88, 0xf3, 0xaa, 0x67, 0xe9, 0x88, 0xbb, 0xf3, 0xaa, 0x4a,
+	0xe2, 0xcf, 0xab, 0x80, 0xc0, 0x26, 0x8f, 0x19, 0x65, 0xb2, 0x92, 0xf8, 0x4d, 0x02, 0xe1, 0x5a,
+	0x65, 0x21, 0x4f, 0x26, 0x57, 0x59, 0x94, 0x19, 0xaf, 0xb4, 0x72, 0x78, 0x84, 0x36, 0x09, 0xd6,
+	0x3e, 0x2b, 0xa3, 0x89, 0x7b, 0x84, 0x1a, 0x88, 0x7f, 0x84, 0x62, 0x14, 0x57, 0xe9, 0x84, 0xcb,
+	0x04, 0x71, 0xd9, 0x3d, 0x3e, 0xac, 0xe4, 0x70, 0xa5, 0x95, 0xc3, 0x09, 0xd6, 0xc1, 0xb4, 0x6e,
+	0x33, 0xe7, 0x20, 0x17, 0x32, 0x7f, 0x82, 0x25, 0x19, 0x5c, 0x7a, 0x21, 0xa8, 0x9a, 0xd3, 0x5d,
+	0x7a, 0x25, 0xf7, 0x97, 0xde, 0xe0, 0xc0, 0xc9, 0x1f, 0x7a, 0xc1, 0x15, 0xdd, 0xcb, 0x63, 0x5e,
+	0xcd, 0x91, 0xe7, 0x61, 0x12, 0x8f, 0xc2, 0x92, 0x9d, 0xf0, 0x33, 0x96, 0xf6, 0x3f, 0xf2, 0x94,
+	0x56, 0xf0, 0x03, 0x43, 0x41, 0x96, 0xe2, 0xe3, 0xc5, 0x15, 0xf1, 0x38, 0x11, 0xf4, 0xb3, 0x82,
+	0xed, 0x86, 0x05, 0xb1, 0x92, 0x19, 0x88, 0x7f, 0x9c, 0x60, 0x14, 0x7b, 0x53, 0xab, 0x84, 0x7d,
+	0x93, 0x81, 0x09, 0xcf, 0x4d, 0x06, 0x81, 0xe2, 0xa0, 0x5e, 0x01, 0x70, 0x99, 0xb0, 0xee, 0xb7,
+	0x82, 0x2e, 0x12, 0x36, 0x3a, 0xd2, 0xd6, 0x89, 0x89, 0x64, 0x86, 0xd5, 0x78, 0x6d, 0x29, 0xfa,
+	0x50, 0x1f, 0xb7, 0x6b, 0x9d, 0x58, 0xf7, 0x11, 0xcd, 0x31, 0x4b, 0xc2, 0x7a, 0x2d, 0xf7, 0x1c,
+	0xd1, 0x34, 0x4c, 0x97, 0x23, 0x1a, 0x8d, 0x05, 0x87, 0x3f, 0xea, 0x05, 0x97, 0x5d, 0x1e, 0x9f,
+	0x64, 0xb5, 0xdf, 0xad, 0x76, 0x5b, 0x82, 0x24, 0xae, 0x6a, 0xfc, 0x1a, 0x50, 0x86, 0xef, 0x05,
+	0xef, 0x34, 0x22, 0x75, 0x93, 0x03, 0x05, 0x30, 0xb7, 0x73, 0x59, 0x7e, 0xcc, 0x49, 0xf7, 0x9b,
+	0x9d, 0x79, 0x95, 0x7f, 0x98, 0xe5, 0x2a, 0x50, 0xfe, 0x21, 0x6d, 0x80, 0x98, 0xc8, 0x3f, 0x1c,
+	0x98, 0x8a, 0x55, 0x1b, 0x21, 0xdc, 0xa4, 0xee, 0xf3, 0x7c, 0x1a, 0x96, 0x28, 0x56, 0x95, 0x06,
+	0x0c, 0x88, 0x88, 0x55, 0x49, 0x18, 0x6f, 0xd3, 0x0d, 0x58, 0xcd, 0x4d, 0xd7, 0x02, 0x27, 0x0d,
+	0xe9, 0x33, 0x73, 0xb5, 0x1d, 0xc4, 0xe3, 0xb5, 0x11, 0x43, 0xaa, 0x71, 0xc7, 0x67, 0x01, 0xa5,
+	0x1b, 0x6b, 0x9d, 0x58, 0x75, 0x49, 0x65, 0x55, 0x6c, 0x9f, 0x85, 0xe5, 0x2c, 0xb7, 0x2e, 0xa9,
+	0xec, 0x72, 0x37, 0x20, 0x71, 0x49, 0xe5, 0x55, 0x00, 0xff, 0x3f, 0xef, 0x05, 0xef, 0x9a, 0x9c,
+	0x18, 0x56, 0xb2, 0x0c, 0x77, 0x7d, 0x26, 0x4d, 0x56, 0x16, 0x63, 0x7b, 0x21, 0x1d, 0x2b, 0xad,
+	0xd5, 0x27, 0xcf, 0xce, 0x3c, 0x8c, 0x93, 0xf0, 0x34, 0x61, 0xce, 0xb4, 0xd6, 0x98, 0x0f, 0x12,
+	0xf5, 0xa6, 0xb5, 0xa4, 0x8a, 0xb5, 0x32, 0xd7, 0x73, 0x5c, 0x4b, 0x19, 0xd6, 0xe9, 0x95, 0xc0,
+	0x91, 0x31, 0x6c, 0x74, 0xa4, 0xd5, 0xd5, 0xb6, 0xfa, 0xb3, 0xde, 0x00, 0xce, 0x7c, 0x01, 0x74,
+	0xb5, 0x9a, 0x78, 0xf3, 0x05, 0x27, 0x0e, 0x8e, 0xcb, 0x26, 0xf3, 0xd4, 0x1d, 0x57, 0xb3, 0x6b,
+	0xbd, 0xd5, 0x90, 0x3e, 0xc5, 0x36, 0x3a, 0xd2, 0xe0, 0xf5, 0xfb, 0xc1, 0x3b, 0xb6, 0x57, 0xd8,
+	0x01, 0x37, 0x5b, 0x4d, 0xa1, 0x4d, 0x70, 0xab, 0xbb, 0x82, 0xcb, 0xfd, 0x2e, 0x4f, 0x8b, 0x32,
+	0x0f, 0xe3, 0xb4, 0x2c, 0xaa, 0x1c, 0x86, 0x74, 0xaf, 0x71, 0x03, 0x3d, 0xa3, 0xd9, 0xea, 0xae,
+	0xa0, 0xf2, 0x9b, 0x47, 0x71, 0x51, 0xf2, 0xfc, 0x62, 0x38, 0xe1, 0xe7, 0xcd, 0xfb, 0x24, 0x73,
+	0x95, 0x02, 0x60, 0xa0, 0x11, 0x44, 0x7e, 0xe3, 0x26, 0x2d, 0x57, 0xea, 0x1d, 0x53, 0x41, 0xb8,
+	0xd2, 0x88, 0x16, 0x57, 0x26, 0xa9, 0xd6, 0xe8, 0xa6, 0x56, 0xea, 0xd1, 0xd5, 0x8a, 0xbb, 0xa8,
+	0xf6, 0xc3, 0xab, 0xd5, 0x76, 0x50, 0xe5, 0x9c, 0xfb, 0x71, 0xc2, 0x9e, 0xbc, 0x7c, 0x99, 0xf0,
+	0x70, 0x84, 0x72, 0xce, 0x4a, 0x32, 0x00, 0x11, 0x91, 0x73, 0x22, 0x44, 0xed, 0x9b, 0x95, 0xa0,
+	0x9a, 0x1c, 0x8d, 0xe5, 0x5b, 0xb6, 0x9a, 0x26, 0x26, 0xf6, 0x4d, 0x07, 0xa6, 0xf2, 0xb5, 0x4a,
+	0xf8, 0x2c, 0xab, 0x8d, 0x5f, 0xb5, 0xb5, 0x84, 0x84, 0xc8, 0xd7, 0x4c, 0x42, 0xe5, 0x1d, 0xd5,
+	0xdf, 0xf7, 0xf8, 0x79, 0x5a, 0x1b, 0x75, 0x54, 0xb4, 0x91, 0x11, 0x79, 0x07, 0x66, 0xc0, 0xf0,
+	0x27, 0xc1, 0x3f, 0xd7, 0x86, 0x73, 0x9e, 0xf5, 0x97, 0x1c, 0x0a, 0xb9, 0x76, 0xa7, 0x7c, 0x85,
+	0x94, 0xab, 0x67, 0x0a, 0xd5, 0x5f, 0x87, 0x59, 0x18, 0xb1, 0x67, 0x45, 0x38, 0x66, 0xe8, 0x99,
+	0x42, 0xad, 0xa2, 0xa4, 0xc4, 0x33, 0x05, 0x9b, 0x32, 0xdb, 0xf5, 0x98, 0xd5, 0xa9, 0x97, 0xa3,
+	0x5d, 0x85, 0xc4, 0xd7, 0xae, 0x92, 0x50, 0x9b, 0x40, 0x33, 0x18, 0x76, 0x13, 0x16, 0xa6, 0xb3,
+	0xec, 0x49, 0x9e, 0x4d, 0xc2, 0x14, 0x9f, 0xb9, 0xcb, 0xce, 0x36, 0x29, 0x62, 0x55, 0xa4, 0x69,
+	0x15, 0x59, 0x3d, 0x0e, 0xe7, 0xf1, 0x58, 0x2e, 0xfe, 0x62, 0x31, 0xc1, 0xa7, 0x80, 0x8a, 0x19,
+	0x68, 0x10, 0x11, 0x59, 0x91, 0x30, 0xf8, 0xfc, 0x7d, 0x2f, 0xb8, 0xaa, 0x98, 0x87, 0xcd, 0x71,
+	0xd6, 0x41, 0xfa, 0x92, 0xbf, 0x88, 0xcb, 0xc9, 0x61, 0x9c, 0x9e, 0x15, 0xfd, 0x0f, 0x29, 0x93,
+	0x6e, 0x5e, 0x16, 0xe5, 0xa3, 0x85, 0xf5, 0x54, 0x08, 0xdd, 0x1c, 0x36, 0x89, 0x3d, 0x73, 0x3f,
+	0xe7, 0x53, 0xa1, 0x81, 0x42, 0x68, 0x79, 0x26, 0x85, 0x39, 0x22, 0x84, 0xf6, 0xf1, 0x5a, 0x4c,
+	0x44, 0x79, 0xaf, 0x23, 0x81, 0xbb, 0xdd, 0x2c, 0x1a, 0xf1, 0xc0, 0xf6, 0x42, 0x3a, 0xea, 0x5a,
+	0x44, 0x16, 0x24, 0xe1, 0x29, 0x7e, 0x25, 0xa3, 0xac, 0x54, 0x42, 0xe2, 0x5a, 0xc4, 0x82, 0xd4,
+	0x72, 0xdd, 0x88, 0xc4, 0x09, 0xcd, 0x4e, 0x92, 0xa0, 0xe5, 0x5a, 0xaa, 0x4a, 0x80, 0x58, 0xae,
+	0x9d, 0x20, 0xf8, 0x39, 0x0e, 0xde, 0xa8, 0x3a, 0xf7, 0x69, 0xce, 0xe6, 0x31, 0xc3, 0x4f, 0x0a,
+	0x34, 0x09, 0x31, 0x3f, 0x4d, 0x42, 0xad, 0x28, 0xcf, 0xd2, 0x22, 0x4b, 0xc2, 0x62, 0x02, 0x57,
+	0xda, 0x66, 0x9d, 0x1b, 0x21, 0xbe, 0xd4, 0xbe, 0xd5, 0x42, 0xa9, 0x53, 0x97, 0x46, 0x26, 0x97,
+	0xd6, 0x65, 0xb7, 0xaa, 0xb5, 0xbc, 0xae, 0xb4, 0x72, 0xaa, 0x6f, 0x77, 0xf9, 0x74, 0xca, 0x88,
+	0xd7, 0x55, 0x20, 0xf3, 0xbf, 0xae, 0xb2, 0x20, 0xcb, 0x36, 0x3c, 0xb3, 0x71, 0xdb, 0x46, 0x0f,
+	0x6c, 0x6e, 0xfa, 0x21, 0x95, 0x22, 0x81, 0xa8, 0x3e, 0x75, 0x3f, 0x66, 0x05, 0x4f, 0xe6, 0x6c,
+	0x84, 0x52, 0xa4, 0x46, 0xdb, 0x60, 0x88, 0x14, 0x89, 0x62, 0xad, 0xca, 0x38, 0x9f, 0x8a, 0x35,
+	0xda, 0xde, 0xa7, 0x62, 0x16, 0xa4, 0x62, 0x09, 0x10, 0xd5, 0xb1, 0xf6, 0x35, 0xa7, 0x92, 0x11,
+	0x5f, 0x5f, 0xf7, 0x21, 0x6a, 0xf7, 0x3c, 0x09, 0x8b, 0xb3, 0xda, 0xa4, 0xb9, 0x7b, 0x56, 0x7f,
+	0x36, 0xed, 0x5d, 0x21, 0xe5, 0xda, 0x1a, 0x10, 0x16, 0x67, 0xea, 0xf1, 0xc1, 0x0d, 0x5b, 0xc3,
+	0x7e, 0x74, 0x70, 0xd3, 0x0f, 0xa9, 0xa0, 0xa7, 0x12, 0xe9, 0x8f, 0x0c, 0x6e, 0xd9, 0x8a, 0xae,
+	0xc7, 0x05, 0xcb, 0x6d, 0x98, 0x6a, 0xe0, 0xfb, 0x09, 0x8f, 0xce, 0x20, 0xea, 0x31, 0x1b, 0xb8,
+	0x96, 0xe0, 0xb0, 0xe7, 0xba, 0x0f, 0x51, 0x71, 0x4f, 0x2d, 0x68, 0x6e, 0xad, 0x5c, 0x3a, 0xf8,
+	0xc2, 0xea, 0x86, 0x97, 0x41, 0xc5, 0x85, 0x29, 0xe9, 0x2a, 0x2e, 0x9a, 0x90, 0xd7, 0x7d, 0x88,
+	0x8a, 0x50, 0x6a, 0xc1, 0x30, 0x4b, 0x62, 0x1c, 0xa1, 0x08, 0x8d, 0x5a, 0x42, 0xac, 0x80, 0x26,
+	0x81, 0x4c, 0xd6, 0xb7, 0x7b, 0x4e, 0x93, 0xb5, 0xc4, 0x6b, 0xb2, 0x21, 0xc0, 0xe4, 0xe3, 0xe0,
+	0x5f, 0x44, 0xdd, 0x79, 0x76, 0xd1, 0xbf, 0xe2, 0xaa, 0x16, 0xcf, 0x2e, 0xa4, 0xc1, 0xab, 0x34,
+	0x80, 0x8a, 0xf8, 0x34, 0x2c, 0x4a, 0x77, 0x11, 0x6b, 0x89, 0xb7, 0x88, 0x0d, 0xa1, 0x26, 0x96,
+	0x28, 0xe2, 0x0c, 0x4f, 0x2c, 0x28, 0xc0, 0x8c, 0x9a, 0x58, 0xba, 0x5c, 0x6d, 0x22, 0xa2, 0x57,
+	0x58, 0xb9, 0x1f, 0xb3, 0x64, 0x54, 0xa0, 0x4d, 0x04, 0xda, 0xbd, 0x91, 0x12, 0x9b, 0x88, 0x4d,
+	0xa1, 0xa1, 0x04, 0xd7, 0x28, 0xae, 0xda, 0xa1, 0x1b, 0x94, 0xeb, 0x3e, 0x44, 0xcd, 0xd8, 0x5a,
+	0xa0, 0x5d, 0x83, 0xbb, 0xca, 0xe3, 0xb8, 0x05, 0x5f, 0x6e, 0xc3, 0xb4, 0x17, 0xae, 0xd2, 0xc5,
+	0x11, 0x9f, 0xb3, 0x13, 0xfe, 0xe0, 0x55, 0x5c, 0x94, 0x71, 0x3a, 0x86, 0x00, 0x6c, 0x9b, 0xb0,
+	0xe4, 0x82, 0x89, 0x17, 0xae, 0xad, 0x4a, 0x2a, 0x0e, 0x44, 0x65, 0x79, 0xcc, 0xce, 0x9d, 0x71,
+	0x20, 0xb6, 0x28, 0x39, 0x22, 0x0e, 0xf4, 0xf1, 0xea, 0x6c, 0x4e, 0x3a, 0x87, 0x73, 0xc9, 0x13,
+	0xde, 0x84, 0xe4, 0x94, 0x35, 0x0c, 0x12, 0xc7, 0x04, 0x5e, 0x05, 0x95, 0xbb, 0x4b, 0xff, 0x6a,
+	0x90, 0xae, 0x12, 0x76, 0xec, 0x81, 0x7a, 0xbb, 0x03, 0xe9, 0x70, 0xa5, 0xde, 0x72, 0x50, 0xae,
+	0xec, 0xa7, 0x1c, 0xb7, 0x3b, 0x90, 0xda, 0x39, 0x9f, 0x5e, 0xad, 0xfb, 0x61, 0x74, 0x36, 0xce,
+	0xf9, 0x2c, 0x1d, 0xed, 0xf2, 0x84, 0xe7, 0xe8, 0x9c, 0xcf, 0x28, 0x35, 0x42, 0x89, 0x73, 0xbe,
+	0x16, 0x15, 0x15, 0xfe, 0xea, 0xa5, 0xd8, 0x49, 0xe2, 0x31, 0x3e, 0xad, 0x30, 0x0c, 0xd5, 0x00,
+	0x11, 0xfe, 0x3a, 0x41, 0x15, 0x4b, 0xea, 0x7e, 0xea, 0xc7, 0x4d, 0xcb, 0xb4, 0xb6, 0xf1, 0xbe,
+	0x69, 0xa5, 0x95, 0x73, 0x8c, 0x54, 0x71, 0x64, 0x52, 0xc6, 0x51, 0x98, 0x88, 0x4a, 0x6d, 0xd2,
+	0x56, 0x0c, 0xb0, 0x75, 0xa4, 0x3a, 0x14, 0x1c, 0x8d, 0x79, 0x32, 0xcb, 0xd3, 0x83, 0xb4, 0xe4,
+	0x64, 0x63, 0x36, 0x40, 0x6b, 0x63, 0x6a, 0x20, 0x6a, 0xcc, 0x13, 0xf6, 0xaa, 0x2a, 0x4d, 0xf5,
+	0x8f, 0xab, 0x31, 0xab, 0xbf, 0x0f, 0x40, 0xee, 0x6b, 0x4c, 0xc4, 0xa1, 0xca, 0x80, 0x13, 0x31,
+	0x2a, 0x3d, 0xda, 0xe6, 0x58, 0x5c, 0x6d, 0x07, 0xdd, 0x7e, 0x86, 0xe5, 0x45, 0xc2, 0x7c, 0x7e,
+	0x6a, 0xa0, 0x8b, 0x9f, 0x06, 0x54, 0x37, 0x9b, 0x46, 0x7d, 0x26, 0x2c, 0x3a, 0xb3, 0xde, 0xbf,
+	0x99, 0x05, 0x15, 0x08, 0x71, 0xb3, 0x49, 0xa0, 0xee, 0x2e, 0x3a, 0x88, 0x78, 0xea, 0xeb, 0xa2,
+	0x4a, 0xde, 0xa5, 0x8b, 0x80, 0x53, 0x07, 0x25, 0x52, 0x0a, 0x23, 0x53, 0x74, 0xd3, 0x1a, 0x61,
+	0x41, 0x87, 0x88, 0x83, 0x12, 0x12, 0x56, 0x79, 0x0f, 0xf6, 0x79, 0x64, 0xbf, 0x36, 0xb7, 0xac,
+	0x1c, 0xd1, 0xaf, 0xcd, 0x29, 0x96, 0xae, 0xa4, 0x18, 0x23, 0x2d, 0x56, 0xcc, 0x71, 0xb2, 0xde,
+	0x0d, 0x56, 0xef, 0x96, 0x0c, 0x9f, 0xbb, 0x09, 0x0b, 0x73, 0xe1, 0x75, 0xc3, 0x63, 0x48, 0x61,
+	0xc4, 0x3d, 0x84, 0x07, 0x47, 0x4b, 0x98, 0xe1, 0x79, 0x97, 0xa7, 0x25, 0x4b, 0x4b, 0xd7, 0x12,
+	0x66, 0x1a, 0x03, 0xd0, 0xb7, 0x84, 0x51, 0x0a, 0x68, 0xdc, 0xd6, 0x27, 0x95, 0xac, 0x7c, 0x1c,
+	0x4e, 0x99, 0x6b, 0xdc, 0x8a, 0x53, 0x48, 0x21, 0xf7, 0x8d, 0x5b, 0xc4, 0xa1, 0x29, 0x7f, 0x30,
+	0x0d, 0xc7, 0xd2, 0x8b, 0x43, 0xbb, 0x96, 0x5b, 0x6e, 0x56, 0xdb, 0x41, 0xe4, 0xe7, 0x79, 0x3c,
+	0x62, 0xdc, 0xe3, 0xa7, 0x96, 0x77, 0xf1, 0x83, 0x41, 0x14, 0x9e, 0x55, 0xb5, 0x15, 0x49, 0xcf,
+	0x4e, 0x3a, 0x82, 0x54, 0x6f, 0x40, 0x34, 0x0a, 0xe2, 0x7c, 0xe1, 0x19, 0xc1, 0xa3, 0xf9, 0xd1,
+	0x9c, 0xd4, 0xfa, 0xe6, 0x87, 0x3c, 0x7a, 0xed, 0x32, 0x3f, 0x5c, 0x30, 0xf8, 0xfc, 0x2e, 0xcc,
+	0x8f, 0xbd, 0xb0, 0x0c, 0xe7, 0x31, 0x3b, 0x7f, 0x1e, 0xb3, 0x73, 0xc8, 0x15, 0x1d, 0xf5, 0x6d,
+	0xa8, 0x41, 0x85, 0xe1, 0xc4, 0x71, 0xb3, 0x33, 0xef, 0xf1, 0x0d, 0x29, 0x40, 0xab, 0x6f, 0x94,
+	0x0b, 0x6c, 0x76, 0xe6, 0x3d, 0xbe, 0xe1, 0x68, 0xa9, 0xd5, 0x37, 0x3a, 0x65, 0xda, 0xec, 0xcc,
+	0x83, 0xef, 0x1f, 0xf7, 0x82, 0xcb, 0x96, 0xf3, 0x2a, 0xd0, 0x8a, 0xca, 0x78, 0xce, 0x5c, 0xf1,
+	0xa2, 0x69, 0x4f, 0xa2, 0xbe, 0x78, 0x91, 0x56, 0x81, 0x52, 0xfc, 0xa2, 0x17, 0xbc, 0xeb, 0x2a,
+	0xc5, 0x53, 0x5e, 0xc4, 0xf5, 0xcb, 0x8e, 0xed, 0x0e, 0x46, 0x1b, 0xd8, 0x97, 0x15, 0xf9, 0x94,
+	0xd4, 0xf5, 0x84, 0x81, 0xaa, 0xa7, 0xe6, 0xeb, 0x1e, 0x7b, 0xf6, 0x8b, 0xf3, 0x8d, 0x8e, 0xb4,
+	0xba, 0x35, 0x35, 0x18, 0xfd, 0xb6, 0xd8, 0xd7, 0xab, 0xce, 0x0b, 0xe3, 0xad, 0xee, 0x0a, 0xe0,
+	0xfe, 0x67, 0x4d, 0xe2, 0x80, 0xfd, 0xc3, 0x24, 0xb8, 0xdb, 0xc5, 0x22, 0x9a, 0x08, 0xdb, 0x0b,
+	0xe9, 0x40, 0x41, 0xfe, 0xd4, 0x0b, 0xae, 0x3b, 0x0b, 0x62, 0x3e, 0x58, 0xf8, 0xbf, 0x2e, 0xb6,
+	0xdd, 0x0f, 0x17, 0xfe, 0xff, 0xab, 0xa8, 0x42, 0xe9, 0x7e, 0xd5, 0xe4, 0xef, 0x8d, 0x46, 0xfd,
+	0x53, 0xa3, 0x27, 0xf9, 0x88, 0xe5, 0x30, 0x63, 0x7d, 0x83, 0x4e, 0xc1, 0x78, 0xde, 0x7e, 0xb0,
+	0xa0, 0x16, 0x14, 0xe7, 0x37, 0xbd, 0x60, 0xc9, 0x80, 0xe1, 0x77, 0x90, 0x5a, 0x79, 0x7c, 0x96,
+	0x35, 0x1a, 0x17, 0xe8, 0xc3, 0x45, 0xd5, 0xa8, 0x99, 0xac, 0xc1, 0xf5, 0x2f, 0x5e, 0xb7, 0x3b,
+	0x1a, 0x36, 0x7e, 0x03, 0x7b, 0x6f, 0x31, 0x25, 0x28, 0xcb, 0x9f, 0x7b, 0xc1, 0x2d, 0x83, 0x55,
+	0xf7, 0x41, 0xe8, 0xd0, 0xe5, 0x6b, 0x1e, 0xfb, 0x94, 0x92, 0x2c, 0xdc, 0xd7, 0xbf, 0x9a, 0x32,
+	0xce, 0xd8, 0x65, 0x21, 0x9b, 0x23, 0x8b, 0x13, 0xc7, 0xcb, 0x1c, 0x64, 0xdd, 0x40, 0x3b, 0xad,
+	0xc0, 0x96, 0x8a, 0x7a, 0x22, 0x63, 0x80, 0xfb, 0x71, 0x52, 0xb2, 0xdc, 0xfe, 0xfa, 0x83, 0x69,
+	0x4d, 0x50, 0x03, 0xfa, 0xeb, 0x0f, 0x1e, 0x5c, 0xfb, 0xfa, 0x83, 0xc3, 0xb3, 0xf3, 0xeb, 0x0f,
+	0x4e, 0x6b, 0xde, 0xaf, 0x3f, 0xf8, 0x35, 0xa8, 0x3d, 0xb0, 0x29, 0x82, 0x38, 0xff, 0xee, 0x64,
+	0xd1, 0x3c, 0x0e, 0xbf, 0xbb, 0x88, 0x0a, 0x11, 0x05, 0x08, 0xae, 0x7e, 0x41, 0xda, 0xa1, 0x4d,
+	0x8d, 0x57, 0xa4, 0x9b, 0x9d, 0x79, 0xf0, 0xfd, 0x19, 0xa4, 0x5f, 0x72, 0xcf, 0xe3, 0x79, 0xfd,
+	0xe5, 0x8f, 0x35, 0xdf, 0x1e, 0x56, 0x59, 0xd0, 0x7b, 0x7e, 0xbd, 0x1b, 0x4c, 0x54, 0xb7, 0x22,
+	0xa0, 0xd3, 0x07, 0x6d, 0x86, 0x50, 0x97, 0x6f, 0x76, 0xe6, 0x89, 0xbd, 0x56, 0xf8, 0x16, 0xbd,
+	0xdd, 0xc1, 0x98, 0xd9, 0xd7, 0x5b, 0xdd, 0x15, 0xd4, 0xab, 0x30, 0xcb, 0x7d, 0xdd, 0xcf, 0xad,
+	0x2d, 0x68, 0xf4, 0xf2, 0x46, 0x47, 0xda, 0x17, 0x63, 0xe9, 0x51, 0x46, 0x5b, 0x8c, 0xe5, 0x8c,
+	0x34, 0xee, 0x2d, 0xa6, 0x04, 0x65, 0xf9, 0x5d, 0x2f, 0xb8, 0x42, 0x96, 0x05, 0x46, 0xc1, 0x87,
+	0x5d, 0x2d, 0xa3, 0xd1, 0xf0, 0xd1, 0xc2, 0x7a, 0x50, 0xa8, 0x3f, 0xf6, 0x82, 0xab, 0x9e, 0x42,
+	0x89, 0xe1, 0xb1, 0x80, 0x75, 0x73, 0x98, 0x7c, 0xbc, 0xb8, 0x22, 0x15, 0x73, 0xe8, 0xf8, 0xd0,
+	0xfe, 0xda, 0x82, 0xc7, 0xf6, 0x90, 0xfe, 0xda, 0x42, 0xbb, 0x16, 0x3e, 0x83, 0xaa, 0x36, 0x10,
+	0x48, 0xcf, 0x5c, 0x67, 0x50, 0xf5, 0xfe, 0x82, 0xd2, 0xb2, 0x95, 0x56, 0xce, 0xe5, 0xe4, 0xc1,
+	0xab, 0x2c, 0x4c, 0x47, 0xb4, 0x13, 0x21, 0x6f, 0x77, 0x22, 0x39, 0x7c, 0x76, 0x57, 0x49, 0x8f,
+	0x79, 0x93, 0x6b, 0xde, 0xa6, 0xf4, 0x25, 0xe2, 0x3d, 0xbb, 0xb3, 0x50, 0xc2, 0x1b, 0x04, 0xd6,
+	0x3e, 0x6f, 0x28, 0x9e, 0xbe, 0xd3, 0x05, 0x45, 0x59, 0x8c, 0xf4, 0x26, 0xef, 0x1d, 0xd6, 0x7d,
+	0x56, 0xac, 0xbb, 0x87, 0x8d, 0x8e, 0x34, 0xe1, 0x76, 0xc8, 0xca, 0x47, 0x2c, 0x1c, 0xb1, 0xdc,
+	0xeb, 0x56, 0x52, 0x9d, 0xdc, 0xea, 0xb4, 0xcb, 0xed, 0x2e, 0x4f, 0x66, 0xd3, 0x14, 0x3a, 0x93,
+	0x74, 0xab, 0x53, 0xed, 0x6e, 0x11, 0x8d, 0x4f, 0x2d, 0x95, 0xdb, 0x3a, 0xc6, 0xbd, 0xe3, 0x37,
+	0x63, 0x84, 0xb6, 0x6b, 0x9d, 0x58, 0xba, 0x9e, 0x30, 0x8c, 0x5a, 0xea, 0x89, 0x46, 0xd2, 0x46,
+	0x47, 0x1a, 0x1f, 0x1f, 0x6a, 0x6e, 0xe5, 0x78, 0xda, 0x6c, 0xb1, 0x65, 0x0d, 0xa9, 0xad, 0xee,
+	0x0a, 0xf8, 0xb0, 0x16, 0x46, 0x55, 0x95, 0x9c, 0xed, 0xc7, 0x49, 0xd2, 0x5f, 0xf3, 0x0c, 0x93,
+	0x06, 0xf2, 0x1e, 0xd6, 0x3a, 0x60, 0x62, 0x24, 0xcb, 0xa7, 0x85, 0xfd, 0x36, 0x3b, 0x35, 0xd5,
+	0x69, 0x24, 0xeb, 0x34, 0x3a, 0xf4, 0xd3, 0x9a, 0x5a, 0xd6, 0x76, 0xe0, 0x6f, 0x38, 0xab, 0xc2,
+	0x9b, 0x9d, 0x79, 0x74, 0x69, 0x5f, 0x53, 0xf5, 0xce, 0x72, 0x93, 0x32, 0x61, 0xec, 0x24, 0xb7,
+	0x5a, 0x28, 0x7c, 0xf9, 0x0d, 0x95, 0x83, 0x4c, 0x44, 0xfb, 0x15, 0xea, 0x36, 0x5d, 0x62, 0x0b,
+	0xf6, 0x85, 0x20, 0x3e, 0x25, 0x74, 0x8a, 0x2b, 0xe6, 0xf4, 0x8b, 0x78, 0x34, 0x66, 0xa5, 0xf3,
+	0x56, 0x4d, 0x07, 0xbc, 0xb7, 0x6a, 0x08, 0x44, 0xe3, 0x48, 0xfc, 0x7d, 0xc8, 0xca, 0x93, 0x30,
+	0x1f, 0xb3, 0xf2, 0x60, 0xe4, 0x1a, 0x47, 0xa0, 0xac, 0x51, 0xbe, 0x71, 0xe4, 0xa4, 0xd1, 0xd2,
+	0x24, 0xdd, 0xc2, 0xf7, 0x33, 0xee, 0xf8, 0xcc, 0xa0, 0x8f, 0x68, 0xac, 0x75, 0x62, 0xd1, 0xf6,
+	0xa6, 0x1c, 0xc6, 0xd3, 0xb8, 0x74, 0x6d, 0x6f, 0x9a, 0x8d, 0x0a, 0xf1, 0x6d, 0x6f, 0x36, 0x4a,
+	0x55, 0xaf, 0x0a, 0x58, 0x0e, 0x46, 0xfe, 0xea, 0x09, 0xa6, 0x5b, 0xf5, 0x24, 0x6b, 0x5d, 0x02,
+	0xa7, 0x72, 0xc8, 0x94, 0x13, 0x38, 0x3e, 0x70, 0x4c, 0xb4, 0xfa, 0x07, 0xca, 0x18, 0xf4, 0x2d,
+	0x81, 0x94, 0x82, 0xf6, 0xd3, 0x3b, 0xc9, 0x35, 0x97, 0xe1, 0x59, 0xc6, 0xc2, 0x3c, 0x4c, 0x23,
+	0x67, 0x9e, 0x5c, 0x1b, 0xb4, 0x48, 0x5f, 0x9e, 0x4c, 0x6a, 0xa0, 0x77, 0x0c, 0xe6, 0x4f, 0x8b,
+	0x1d, 0x53, 0x41, 0xfe, 0x86, 0xd7, 0xfc, 0x65, 0xf1, 0xed, 0x0e, 0x24, 0x3e, 0x15, 0x69, 0x00,
+	0x79, 0x51, 0x21, 0x9c, 0xbe, 0xef, 0x31, 0x65, 0xa2, 0xbe, 0x9c, 0x9c, 0x56, 0x41, 0x83, 0x5a,
+	0x46, 0xdb, 0xac, 0xfc, 0x84, 0x5d, 0xb8, 0x06, 0xb5, 0x0a, 0x96, 0x6b, 0xc4, 0x37, 0xa8, 0x6d,
+	0x14, 0x05, 0xbd, 0x7a, 0x52, 0xb6, 0xec, 0xd1, 0xd7, 0xf3, 0xb0, 0x95, 0x56, 0x0e, 0xcd, 0x9c,
+	0xbd, 0x78, 0x6e, 0xdc, 0xeb, 0x38, 0x0a, 0xba, 0x17, 0xcf, 0xdd, 0xd7, 0x3a, 0x6b, 0x9d, 0x58,
+	0xfc, 0x7c, 0x21, 0x2c, 0xd9, 0xab, 0xe6, 0x5d, 0x81, 0xa3, 0xb8, 0xb5, 0xdc, 0x7a, 0x58, 0xb0,
+	0xda, 0x0e, 0xa2, 0x20, 0x61, 0x2f, 0x0e, 0xc7, 0x79, 0x38, 0x55, 0xc7, 0xf6, 0xce, 0xd2, 0xd6,
+	0x8c, 0xe3, 0xd4, 0x7e, 0xbd, 0x1b, 0x8c, 0x6e, 0x74, 0x95, 0xcf, 0xc3, 0x30, 0x1d, 0xcf, 0xc2,
+	0xb1, 0xf3, 0x46, 0x57, 0x33, 0xd4, 0x60, 0xde, 0x63, 0x33, 0x27, 0x8e, 0xe6, 0x22, 0x40, 0xc7,
+	0x2c, 0xad, 0x82, 0xec, 0x55, 0xda, 0x8a, 0x20, 0x7c, 0x73, 0xd1, 0x22, 0xd5, 0x1b, 0xd9, 0xa7,
+	0x39, 0x8f, 0x58, 0x51, 0xec, 0x56, 0xeb, 0x41, 0x82, 0xde, 0xc8, 0x82, 0x6c, 0x20, 0x84, 0xc4,
+	0x1b, 0x59, 0x0b, 0x02, 0xdb, 0x8f, 0x82, 0xd7, 0x0f, 0xf9, 0x78, 0xc8, 0xd2, 0x51, 0xff, 0x3d,
+	0xf3, 0x65, 0x3a, 0x1f, 0x0f, 0xaa, 0x3f, 0x4b, 0x7b, 0x4b, 0x94, 0x58, 0x3d, 0xb0, 0xdc, 0x63,
+	0xa7, 0xb3, 0xf1, 0x49, 0xce, 0x18, 0x7a, 0x60, 0x59, 0xff, 0x7d, 0x50, 0x09, 0x88, 0x07, 0x96,
+	0x06, 0xa0, 0x62, 0x21, 0x69, 0xaf, 0x4a, 0x37, 0xf0, 0x03, 0x46, 0xa5, 0x53, 0x4b, 0x89, 0x58,
+	0xc8, 0xa6, 0xd4, 0xac, 0xa8, 0x65, 0xf5, 0x6f, 0x6e, 0x86, 0xb3, 0xe9, 0x34, 0xcc, 0x2f, 0xd0,
+	0xac, 0x10, 0xba, 0x3a, 0x40, 0xcc, 0x0a, 0x27, 0xa8, 0x66, 0x45, 0x2d, 0x16, 0x4f, 0x1d, 0xeb,
+	0xaf, 0x5d, 0x16, 0x25, 0xcf, 0xf1, 0xac, 0x10, 0x26, 0x30, 0x44, 0xcc, 0x0a, 0x12, 0x46, 0x5d,
+	0xf1, 0x34, 0x4e, 0xc7, 0xce, 0xae, 0xa8, 0x04, 0xde, 0xae, 0x00, 0x40, 0x8d, 0x75, 0xd1, 0x56,
+	0xe2, 0x05, 0x34, 0xfc, 0xf0, 0xda, 0xd9, 0x06, 0x3a, 0x41, 0x8c, 0x75, 0x37, 0x89, 0x5c, 0x3d,
+	0xc9, 0x58, 0xca, 0x46, 0xcd, 0x73, 0x44, 0x97, 0x2b, 0x83, 0xf0, 0xba, 0xc2, 0xa4, 0x5a, 0x88,
+	0x8f, 0x58, 0x99, 0xc7, 0x51, 0x31, 0x64, 0xe5, 0xd3, 0x30, 0x0f, 0xa7, 0xac, 0x64, 0x79, 0x81,
+	0x16, 0x62, 0x40, 0x06, 0x06, 0x43, 0x2c, 0xc4, 0x14, 0x0b, 0x0e, 0xbf, 0x11, 0xbc, 0x5d, 0xad,
+	0xd0, 0x2c, 0x85, 0x2f, 0x59, 0x3f, 0xa8, 0x3f, 0xf2, 0xde, 0xbf, 0x24, 0x6d, 0x0c, 0xcb, 0x9c,
+	0x55, 0x4b, 0x89, 0xb0, 0xfd, 0x96, 0xfc, 0x7b, 0x0d, 0x6e, 0xf5, 0xee, 0x5f, 0xfb, 0xeb, 0x17,
+	0x4b, 0xbd, 0xcf, 0xbf, 0x58, 0xea, 0xfd, 0xfd, 0x8b, 0xa5, 0xde, 0x6f, 0xbf, 0x5c, 0x7a, 0xed,
+	0xf3, 0x2f, 0x97, 0x5e, 0xfb, 0xdb, 0x97, 0x4b, 0xaf, 0x7d, 0xfa, 0x3a, 0x7c, 0x6c, 0xfe, 0xf4,
+	0x9f, 0xea, 0x4f, 0xc6, 0x6f, 0xff, 0x23, 0x00, 0x00, 0xff, 0xff, 0xed, 0xe4, 0x46, 0xda, 0x90,
+	0x5e, 0x00, 0x00,
 }
 
 // This is a compile-time assertion to ensure that this generated file
@@ -329,14 +354,18 @@ type ClientCommandsHandler interface {
 	ObjectSetLayout(context.Context, *pb.RpcObjectSetLayoutRequest) *pb.RpcObjectSetLayoutResponse
 	ObjectSetInternalFlags(context.Context, *pb.RpcObjectSetInternalFlagsRequest) *pb.RpcObjectSetInternalFlagsResponse
 	ObjectSetIsFavorite(context.Context, *pb.RpcObjectSetIsFavoriteRequest) *pb.RpcObjectSetIsFavoriteResponse
+	ObjectSetLock(context.Context, *pb.RpcObjectSetLockRequest) *pb.RpcObjectSetLockResponse
 	ObjectSetIsArchived(context.Context, *pb.RpcObjectSetIsArchivedRequest) *pb.RpcObjectSetIsArchivedResponse
 	ObjectSetSource(context.Context, *pb.RpcObjectSetSourceRequest) *pb.RpcObjectSetSourceResponse
 	ObjectWorkspaceSetDashboard(context.Context, *pb.RpcObjectWorkspaceSetDashboardRequest) *pb.RpcObjectWorkspaceSetDashboardResponse
 	ObjectListDuplicate(context.Context, *pb.RpcObjectListDuplicateRequest) *pb.RpcObjectListDuplicateResponse
 	ObjectListDelete(context.Context, *pb.RpcObjectListDeleteRequest) *pb.RpcObjectListDeleteResponse
 	ObjectListSetIsArchived(context.Context, *pb.RpcObjectListSetIsArchivedRequest) *pb.RpcObjectListSetIsArchivedResponse
+	ObjectMergeDuplicates(context.Context, *pb.RpcObjectMergeDuplicatesRequest) *pb.RpcObjectMergeDuplicatesResponse
+	ObjectFindReplace(context.Context, *pb.RpcObjectFindReplaceRequest) *pb.RpcObjectFindReplaceResponse
 	ObjectListSetIsFavorite(context.Context, *pb.RpcObjectListSetIsFavoriteRequest) *pb.RpcObjectListSetIsFavoriteResponse
 	ObjectListSetObjectType(context.Context, *pb.RpcObjectListSetObjectTypeRequest) *pb.RpcObjectListSetObjectTypeResponse
+	ObjectListModifyDetails(context.Context, *pb.RpcObjectListModifyDetailsRequest) *pb.RpcObjectListModifyDetailsResponse
 	ObjectApplyTemplate(context.Context, *pb.RpcObjectApplyTemplateRequest) *pb.RpcObjectApplyTemplateResponse
 	// ObjectToSet creates new set from given object and removes object
 	ObjectToSet(context.Context, *pb.RpcObjectToSetRequest) *pb.RpcObjectToSetResponse
@@ -362,6 +391,7 @@ type ClientCommandsHandler interface {
 	ObjectCreateRelationOption(context.Context, *pb.RpcObjectCreateRelationOptionRequest) *pb.RpcObjectCreateRelationOptionResponse
 	RelationListRemoveOption(context.Context, *pb.RpcRelationListRemoveOptionRequest) *pb.RpcRelationListRemoveOptionResponse
 	RelationOptions(context.Context, *pb.RpcRelationOptionsRequest) *pb.RpcRelationOptionsResponse
+	RelationConvertFormat(context.Context, *pb.RpcRelationConvertFormatRequest) *pb.RpcRelationConvertFormatResponse
 	// Object Relations
 	// ***
 	ObjectRelationAdd(context.Context, *pb.RpcObjectRelationAddRequest) *pb.RpcObjectRelationAddResponse
@@ -375,6 +405,7 @@ type ClientCommandsHandler interface {
 	ObjectTypeRelationList(context.Context, *pb.RpcObjectTypeRelationListRequest) *pb.RpcObjectTypeRelationListResponse
 	ObjectTypeRelationAdd(context.Context, *pb.RpcObjectTypeRelationAddRequest) *pb.RpcObjectTypeRelationAddResponse
 	ObjectTypeRelationRemove(context.Context, *pb.RpcObjectTypeRelationRemoveRequest) *pb.RpcObjectTypeRelationRemoveResponse
+	ObjectTypeConstraintsSet(context.Context, *pb.RpcObjectTypeConstraintsSetRequest) *pb.RpcObjectTypeConstraintsSetResponse
 	HistoryShowVersion(context.Context, *pb.RpcHistoryShowVersionRequest) *pb.RpcHistoryShowVersionResponse
 	HistoryGetVersions(context.Context, *pb.RpcHistoryGetVersionsRequest) *pb.RpcHistoryGetVersionsResponse
 	HistorySetVersion(context.Context, *pb.RpcHistorySetVersionRequest) *pb.RpcHistorySetVersionResponse
@@ -386,6 +417,8 @@ type ClientCommandsHandler interface {
 	FileDownload(context.Context, *pb.RpcFileDownloadRequest) *pb.RpcFileDownloadResponse
 	FileDrop(context.Context, *pb.RpcFileDropRequest) *pb.RpcFileDropResponse
 	FileSpaceUsage(context.Context, *pb.RpcFileSpaceUsageRequest) *pb.RpcFileSpaceUsageResponse
+	FileReport(context.Context, *pb.RpcFileReportRequest) *pb.RpcFileReportResponse
+	FileListCleanupOrphans(context.Context, *pb.RpcFileListCleanupOrphansRequest) *pb.RpcFileListCleanupOrphansResponse
 	NavigationListObjects(context.Context, *pb.RpcNavigationListObjectsRequest) *pb.RpcNavigationListObjectsResponse
 	NavigationGetObjectInfoWithLinks(context.Context, *pb.RpcNavigationGetObjectInfoWithLinksRequest) *pb.RpcNavigationGetObjectInfoWithLinksResponse
 	TemplateCreateFromObject(context.Context, *pb.RpcTemplateCreateFromObjectRequest) *pb.RpcTemplateCreateFromObjectResponse
@@ -397,6 +430,18 @@ type ClientCommandsHandler interface {
 	// UnsplashDownload downloads picture from unsplash by ID, put it to the IPFS and returns the hash.
 	// The artist info is available in the object details
 	UnsplashDownload(context.Context, *pb.RpcUnsplashDownloadRequest) *pb.RpcUnsplashDownloadResponse
+	// Comments
+	// ***
+	CommentCreate(context.Context, *pb.RpcCommentCreateRequest) *pb.RpcCommentCreateResponse
+	CommentUpdate(context.Context, *pb.RpcCommentUpdateRequest) *pb.RpcCommentUpdateResponse
+	CommentSetIsResolved(context.Context, *pb.RpcCommentSetIsResolvedRequest) *pb.RpcCommentSetIsResolvedResponse
+	CommentDelete(context.Context, *pb.RpcCommentDeleteRequest) *pb.RpcCommentDeleteResponse
+	CommentList(context.Context, *pb.RpcCommentListRequest) *pb.RpcCommentListResponse
+	// Tasks
+	// ***
+	TaskList(context.Context, *pb.RpcTaskListRequest) *pb.RpcTaskListResponse
+	TaskSubscribe(context.Context, *pb.RpcTaskSubscribeRequest) *pb.RpcTaskSubscribeResponse
+	TaskUnsubscribe(context.Context, *pb.RpcTaskUnsubscribeRequest) *pb.RpcTaskUnsubscribeResponse
 	// General Block commands
 	// ***
 	BlockUpload(context.Context, *pb.RpcBlockUploadRequest) *pb.RpcBlockUploadResponse
@@ -417,6 +462,7 @@ type ClientCommandsHandler interface {
 	BlockListDuplicate(context.Context, *pb.RpcBlockListDuplicateRequest) *pb.RpcBlockListDuplicateResponse
 	BlockListSetBackgroundColor(context.Context, *pb.RpcBlockListSetBackgroundColorRequest) *pb.RpcBlockListSetBackgroundColorResponse
 	BlockListSetAlign(context.Context, *pb.RpcBlockListSetAlignRequest) *pb.RpcBlockListSetAlignResponse
+	BlockListSetLock(context.Context, *pb.RpcBlockListSetLockRequest) *pb.RpcBlockListSetLockResponse
 	BlockListSetVerticalAlign(context.Context, *pb.RpcBlockListSetVerticalAlignRequest) *pb.RpcBlockListSetVerticalAlignResponse
 	BlockListTurnInto(context.Context, *pb.RpcBlockListTurnIntoRequest) *pb.RpcBlockListTurnIntoResponse
 	// Text Block commands
@@ -453,6 +499,7 @@ type ClientCommandsHandler interface {
 	BlockDataviewObjectOrderUpdate(context.Context, *pb.RpcBlockDataviewObjectOrderUpdateRequest) *pb.RpcBlockDataviewObjectOrderUpdateResponse
 	BlockDataviewObjectOrderMove(context.Context, *pb.RpcBlockDataviewObjectOrderMoveRequest) *pb.RpcBlockDataviewObjectOrderMoveResponse
 	BlockDataviewCreateFromExistingObject(context.Context, *pb.RpcBlockDataviewCreateFromExistingObjectRequest) *pb.RpcBlockDataviewCreateFromExistingObjectResponse
+	BlockDataviewConvertToTable(context.Context, *pb.RpcBlockDataviewConvertToTableRequest) *pb.RpcBlockDataviewConvertToTableResponse
 	BlockDataviewFilterAdd(context.Context, *pb.RpcBlockDataviewFilterAddRequest) *pb.RpcBlockDataviewFilterAddResponse
 	BlockDataviewFilterRemove(context.Context, *pb.RpcBlockDataviewFilterRemoveRequest) *pb.RpcBlockDataviewFilterRemoveResponse
 	BlockDataviewFilterReplace(context.Context, *pb.RpcBlockDataviewFilterReplaceRequest) *pb.RpcBlockDataviewFilterReplaceResponse
@@ -481,6 +528,7 @@ type ClientCommandsHandler interface {
 	BlockTableRowListClean(context.Context, *pb.RpcBlockTableRowListCleanRequest) *pb.RpcBlockTableRowListCleanResponse
 	BlockTableColumnListFill(context.Context, *pb.RpcBlockTableColumnListFillRequest) *pb.RpcBlockTableColumnListFillResponse
 	BlockTableSort(context.Context, *pb.RpcBlockTableSortRequest) *pb.RpcBlockTableSortResponse
+	BlockTableConvertToCollection(context.Context, *pb.RpcBlockTableConvertToCollectionRequest) *pb.RpcBlockTableConvertToCollectionResponse
 	// Widget commands
 	// ***
 	BlockCreateWidget(context.Context, *pb.RpcBlockCreateWidgetRequest) *pb.RpcBlockCreateWidgetResponse
@@ -498,6 +546,9 @@ type ClientCommandsHandler interface {
 	BlockRelationAdd(context.Context, *pb.RpcBlockRelationAddRequest) *pb.RpcBlockRelationAddResponse
 	BlockDivListSetStyle(context.Context, *pb.RpcBlockDivListSetStyleRequest) *pb.RpcBlockDivListSetStyleResponse
 	BlockLatexSetText(context.Context, *pb.RpcBlockLatexSetTextRequest) *pb.RpcBlockLatexSetTextResponse
+	BlockDiagramSetSource(context.Context, *pb.RpcBlockDiagramSetSourceRequest) *pb.RpcBlockDiagramSetSourceResponse
+	BlockDiagramSetLanguage(context.Context, *pb.RpcBlockDiagramSetLanguageRequest) *pb.RpcBlockDiagramSetLanguageResponse
+	BlockDiagramRender(context.Context, *pb.RpcBlockDiagramRenderRequest) *pb.RpcBlockDiagramRenderResponse
 	ProcessCancel(context.Context, *pb.RpcProcessCancelRequest) *pb.RpcProcessCancelResponse
 	LogSend(context.Context, *pb.RpcLogSendRequest) *pb.RpcLogSendResponse
 	DebugTree(context.Context, *pb.RpcDebugTreeRequest) *pb.RpcDebugTreeResponse
@@ -1396,6 +1447,26 @@ func ObjectSetIsFavorite(b []byte) (resp []byte) {
 	return resp
 }
 
+func ObjectSetLock(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcObjectSetLockResponse{Error: &pb.RpcObjectSetLockResponseError{Code: pb.RpcObjectSetLockResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcObjectSetLockRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcObjectSetLockResponse{Error: &pb.RpcObjectSetLockResponseError{Code: pb.RpcObjectSetLockResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.ObjectSetLock(context.Background(), in).Marshal()
+	return resp
+}
+
 func ObjectSetIsArchived(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
@@ -1516,6 +1587,46 @@ func ObjectListSetIsArchived(b []byte) (resp []byte) {
 	return resp
 }
 
+func ObjectMergeDuplicates(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcObjectMergeDuplicatesResponse{Error: &pb.RpcObjectMergeDuplicatesResponseError{Code: pb.RpcObjectMergeDuplicatesResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcObjectMergeDuplicatesRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcObjectMergeDuplicatesResponse{Error: &pb.RpcObjectMergeDuplicatesResponseError{Code: pb.RpcObjectMergeDuplicatesResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.ObjectMergeDuplicates(context.Background(), in).Marshal()
+	return resp
+}
+
+func ObjectFindReplace(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcObjectFindReplaceResponse{Error: &pb.RpcObjectFindReplaceResponseError{Code: pb.RpcObjectFindReplaceResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcObjectFindReplaceRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcObjectFindReplaceResponse{Error: &pb.RpcObjectFindReplaceResponseError{Code: pb.RpcObjectFindReplaceResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.ObjectFindReplace(context.Background(), in).Marshal()
+	return resp
+}
+
 func ObjectListSetIsFavorite(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
@@ -1556,6 +1667,26 @@ func ObjectListSetObjectType(b []byte) (resp []byte) {
 	return resp
 }
 
+func ObjectListModifyDetails(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcObjectListModifyDetailsResponse{Error: &pb.RpcObjectListModifyDetailsResponseError{Code: pb.RpcObjectListModifyDetailsResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcObjectListModifyDetailsRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcObjectListModifyDetailsResponse{Error: &pb.RpcObjectListModifyDetailsResponseError{Code: pb.RpcObjectListModifyDetailsResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.ObjectListModifyDetails(context.Background(), in).Marshal()
+	return resp
+}
+
 func ObjectApplyTemplate(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
@@ -1956,6 +2087,26 @@ func RelationOptions(b []byte) (resp []byte) {
 	return resp
 }
 
+func RelationConvertFormat(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcRelationConvertFormatResponse{Error: &pb.RpcRelationConvertFormatResponseError{Code: pb.RpcRelationConvertFormatResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcRelationConvertFormatRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcRelationConvertFormatResponse{Error: &pb.RpcRelationConvertFormatResponseError{Code: pb.RpcRelationConvertFormatResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.RelationConvertFormat(context.Background(), in).Marshal()
+	return resp
+}
+
 func ObjectRelationAdd(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
@@ -2136,6 +2287,26 @@ func ObjectTypeRelationRemove(b []byte) (resp []byte) {
 	return resp
 }
 
+func ObjectTypeConstraintsSet(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcObjectTypeConstraintsSetResponse{Error: &pb.RpcObjectTypeConstraintsSetResponseError{Code: pb.RpcObjectTypeConstraintsSetResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcObjectTypeConstraintsSetRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcObjectTypeConstraintsSetResponse{Error: &pb.RpcObjectTypeConstraintsSetResponseError{Code: pb.RpcObjectTypeConstraintsSetResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.ObjectTypeConstraintsSet(context.Background(), in).Marshal()
+	return resp
+}
+
 func HistoryShowVersion(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
@@ -2316,6 +2487,46 @@ func FileSpaceUsage(b []byte) (resp []byte) {
 	return resp
 }
 
+func FileReport(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcFileReportResponse{Error: &pb.RpcFileReportResponseError{Code: pb.RpcFileReportResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcFileReportRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcFileReportResponse{Error: &pb.RpcFileReportResponseError{Code: pb.RpcFileReportResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.FileReport(context.Background(), in).Marshal()
+	return resp
+}
+
+func FileListCleanupOrphans(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcFileListCleanupOrphansResponse{Error: &pb.RpcFileListCleanupOrphansResponseError{Code: pb.RpcFileListCleanupOrphansResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcFileListCleanupOrphansRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcFileListCleanupOrphansResponse{Error: &pb.RpcFileListCleanupOrphansResponseError{Code: pb.RpcFileListCleanupOrphansResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.FileListCleanupOrphans(context.Background(), in).Marshal()
+	return resp
+}
+
 func NavigationListObjects(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
@@ -2496,6 +2707,166 @@ func UnsplashDownload(b []byte) (resp []byte) {
 	return resp
 }
 
+func CommentCreate(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcCommentCreateResponse{Error: &pb.RpcCommentCreateResponseError{Code: pb.RpcCommentCreateResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcCommentCreateRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcCommentCreateResponse{Error: &pb.RpcCommentCreateResponseError{Code: pb.RpcCommentCreateResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.CommentCreate(context.Background(), in).Marshal()
+	return resp
+}
+
+func CommentUpdate(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcCommentUpdateResponse{Error: &pb.RpcCommentUpdateResponseError{Code: pb.RpcCommentUpdateResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcCommentUpdateRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcCommentUpdateResponse{Error: &pb.RpcCommentUpdateResponseError{Code: pb.RpcCommentUpdateResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.CommentUpdate(context.Background(), in).Marshal()
+	return resp
+}
+
+func CommentSetIsResolved(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcCommentSetIsResolvedResponse{Error: &pb.RpcCommentSetIsResolvedResponseError{Code: pb.RpcCommentSetIsResolvedResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcCommentSetIsResolvedRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcCommentSetIsResolvedResponse{Error: &pb.RpcCommentSetIsResolvedResponseError{Code: pb.RpcCommentSetIsResolvedResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.CommentSetIsResolved(context.Background(), in).Marshal()
+	return resp
+}
+
+func CommentDelete(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcCommentDeleteResponse{Error: &pb.RpcCommentDeleteResponseError{Code: pb.RpcCommentDeleteResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcCommentDeleteRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcCommentDeleteResponse{Error: &pb.RpcCommentDeleteResponseError{Code: pb.RpcCommentDeleteResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.CommentDelete(context.Background(), in).Marshal()
+	return resp
+}
+
+func CommentList(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcCommentListResponse{Error: &pb.RpcCommentListResponseError{Code: pb.RpcCommentListResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcCommentListRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcCommentListResponse{Error: &pb.RpcCommentListResponseError{Code: pb.RpcCommentListResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.CommentList(context.Background(), in).Marshal()
+	return resp
+}
+
+func TaskList(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcTaskListResponse{Error: &pb.RpcTaskListResponseError{Code: pb.RpcTaskListResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcTaskListRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcTaskListResponse{Error: &pb.RpcTaskListResponseError{Code: pb.RpcTaskListResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.TaskList(context.Background(), in).Marshal()
+	return resp
+}
+
+func TaskSubscribe(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcTaskSubscribeResponse{Error: &pb.RpcTaskSubscribeResponseError{Code: pb.RpcTaskSubscribeResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcTaskSubscribeRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcTaskSubscribeResponse{Error: &pb.RpcTaskSubscribeResponseError{Code: pb.RpcTaskSubscribeResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.TaskSubscribe(context.Background(), in).Marshal()
+	return resp
+}
+
+func TaskUnsubscribe(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcTaskUnsubscribeResponse{Error: &pb.RpcTaskUnsubscribeResponseError{Code: pb.RpcTaskUnsubscribeResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcTaskUnsubscribeRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcTaskUnsubscribeResponse{Error: &pb.RpcTaskUnsubscribeResponseError{Code: pb.RpcTaskUnsubscribeResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.TaskUnsubscribe(context.Background(), in).Marshal()
+	return resp
+}
+
 func BlockUpload(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
@@ -2856,6 +3227,26 @@ func BlockListSetAlign(b []byte) (resp []byte) {
 	return resp
 }
 
+func BlockListSetLock(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcBlockListSetLockResponse{Error: &pb.RpcBlockListSetLockResponseError{Code: pb.RpcBlockListSetLockResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcBlockListSetLockRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcBlockListSetLockResponse{Error: &pb.RpcBlockListSetLockResponseError{Code: pb.RpcBlockListSetLockResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.BlockListSetLock(context.Background(), in).Marshal()
+	return resp
+}
+
 func BlockListSetVerticalAlign(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
@@ -3456,6 +3847,26 @@ func BlockDataviewCreateFromExistingObject(b []byte) (resp []byte) {
 	return resp
 }
 
+func BlockDataviewConvertToTable(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcBlockDataviewConvertToTableResponse{Error: &pb.RpcBlockDataviewConvertToTableResponseError{Code: pb.RpcBlockDataviewConvertToTableResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcBlockDataviewConvertToTableRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcBlockDataviewConvertToTableResponse{Error: &pb.RpcBlockDataviewConvertToTableResponseError{Code: pb.RpcBlockDataviewConvertToTableResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.BlockDataviewConvertToTable(context.Background(), in).Marshal()
+	return resp
+}
+
 func BlockDataviewFilterAdd(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
@@ -3976,6 +4387,26 @@ func BlockTableSort(b []byte) (resp []byte) {
 	return resp
 }
 
+func BlockTableConvertToCollection(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcBlockTableConvertToCollectionResponse{Error: &pb.RpcBlockTableConvertToCollectionResponseError{Code: pb.RpcBlockTableConvertToCollectionResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcBlockTableConvertToCollectionRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcBlockTableConvertToCollectionResponse{Error: &pb.RpcBlockTableConvertToCollectionResponseError{Code: pb.RpcBlockTableConvertToCollectionResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.BlockTableConvertToCollection(context.Background(), in).Marshal()
+	return resp
+}
+
 func BlockCreateWidget(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
@@ -4236,6 +4667,66 @@ func BlockLatexSetText(b []byte) (resp []byte) {
 	return resp
 }
 
+func BlockDiagramSetSource(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcBlockDiagramSetSourceResponse{Error: &pb.RpcBlockDiagramSetSourceResponseError{Code: pb.RpcBlockDiagramSetSourceResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcBlockDiagramSetSourceRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcBlockDiagramSetSourceResponse{Error: &pb.RpcBlockDiagramSetSourceResponseError{Code: pb.RpcBlockDiagramSetSourceResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.BlockDiagramSetSource(context.Background(), in).Marshal()
+	return resp
+}
+
+func BlockDiagramSetLanguage(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcBlockDiagramSetLanguageResponse{Error: &pb.RpcBlockDiagramSetLanguageResponseError{Code: pb.RpcBlockDiagramSetLanguageResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcBlockDiagramSetLanguageRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcBlockDiagramSetLanguageResponse{Error: &pb.RpcBlockDiagramSetLanguageResponseError{Code: pb.RpcBlockDiagramSetLanguageResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.BlockDiagramSetLanguage(context.Background(), in).Marshal()
+	return resp
+}
+
+func BlockDiagramRender(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcBlockDiagramRenderResponse{Error: &pb.RpcBlockDiagramRenderResponseError{Code: pb.RpcBlockDiagramRenderResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcBlockDiagramRenderRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcBlockDiagramRenderResponse{Error: &pb.RpcBlockDiagramRenderResponseError{Code: pb.RpcBlockDiagramRenderResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.BlockDiagramRender(context.Background(), in).Marshal()
+	return resp
+}
+
 func ProcessCancel(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
@@ -4530,6 +5021,8 @@ func CommandAsync(cmd string, data []byte, callback func(data []byte)) {
 			cd = ObjectSetInternalFlags(data)
 		case "ObjectSetIsFavorite":
 			cd = ObjectSetIsFavorite(data)
+		case "ObjectSetLock":
+			cd = ObjectSetLock(data)
 		case "ObjectSetIsArchived":
 			cd = ObjectSetIsArchived(data)
 		case "ObjectSetSource":
@@ -4542,10 +5035,16 @@ func CommandAsync(cmd string, data []byte, callback func(data []byte)) {
 			cd = ObjectListDelete(data)
 		case "ObjectListSetIsArchived":
 			cd = ObjectListSetIsArchived(data)
+		case "ObjectMergeDuplicates":
+			cd = ObjectMergeDuplicates(data)
+		case "ObjectFindReplace":
+			cd = ObjectFindReplace(data)
 		case "ObjectListSetIsFavorite":
 			cd = ObjectListSetIsFavorite(data)
 		case "ObjectListSetObjectType":
 			cd = ObjectListSetObjectType(data)
+		case "ObjectListModifyDetails":
+			cd = ObjectListModifyDetails(data)
 		case "ObjectApplyTemplate":
 			cd = ObjectApplyTemplate(data)
 		case "ObjectToSet":
@@ -4586,6 +5085,8 @@ func CommandAsync(cmd string, data []byte, callback func(data []byte)) {
 			cd = RelationListRemoveOption(data)
 		case "RelationOptions":
 			cd = RelationOptions(data)
+		case "RelationConvertFormat":
+			cd = RelationConvertFormat(data)
 		case "ObjectRelationAdd":
 			cd = ObjectRelationAdd(data)
 		case "ObjectRelationDelete":
@@ -4604,6 +5105,8 @@ func CommandAsync(cmd string, data []byte, callback func(data []byte)) {
 			cd = ObjectTypeRelationAdd(data)
 		case "ObjectTypeRelationRemove":
 			cd = ObjectTypeRelationRemove(data)
+		case "ObjectTypeConstraintsSet":
+			cd = ObjectTypeConstraintsSet(data)
 		case "HistoryShowVersion":
 			cd = HistoryShowVersion(data)
 		case "HistoryGetVersions":
@@ -4622,6 +5125,10 @@ func CommandAsync(cmd string, data []byte, callback func(data []byte)) {
 			cd = FileDrop(data)
 		case "FileSpaceUsage":
 			cd = FileSpaceUsage(data)
+		case "FileReport":
+			cd = FileReport(data)
+		case "FileListCleanupOrphans":
+			cd = FileListCleanupOrphans(data)
 		case "NavigationListObjects":
 			cd = NavigationListObjects(data)
 		case "NavigationGetObjectInfoWithLinks":
@@ -4640,6 +5147,22 @@ func CommandAsync(cmd string, data []byte, callback func(data []byte)) {
 			cd = UnsplashSearch(data)
 		case "UnsplashDownload":
 			cd = UnsplashDownload(data)
+		case "CommentCreate":
+			cd = CommentCreate(data)
+		case "CommentUpdate":
+			cd = CommentUpdate(data)
+		case "CommentSetIsResolved":
+			cd = CommentSetIsResolved(data)
+		case "CommentDelete":
+			cd = CommentDelete(data)
+		case "CommentList":
+			cd = CommentList(data)
+		case "TaskList":
+			cd = TaskList(data)
+		case "TaskSubscribe":
+			cd = TaskSubscribe(data)
+		case "TaskUnsubscribe":
+			cd = TaskUnsubscribe(data)
 		case "BlockUpload":
 			cd = BlockUpload(data)
 		case "BlockReplace":
@@ -4676,6 +5199,8 @@ func CommandAsync(cmd string, data []byte, callback func(data []byte)) {
 			cd = BlockListSetBackgroundColor(data)
 		case "BlockListSetAlign":
 			cd = BlockListSetAlign(data)
+		case "BlockListSetLock":
+			cd = BlockListSetLock(data)
 		case "BlockListSetVerticalAlign":
 			cd = BlockListSetVerticalAlign(data)
 		case "BlockListTurnInto":
@@ -4736,6 +5261,8 @@ func CommandAsync(cmd string, data []byte, callback func(data []byte)) {
 			cd = BlockDataviewObjectOrderMove(data)
 		case "BlockDataviewCreateFromExistingObject":
 			cd = BlockDataviewCreateFromExistingObject(data)
+		case "BlockDataviewConvertToTable":
+			cd = BlockDataviewConvertToTable(data)
 		case "BlockDataviewFilterAdd":
 			cd = BlockDataviewFilterAdd(data)
 		case "BlockDataviewFilterRemove":
@@ -4788,6 +5315,8 @@ func CommandAsync(cmd string, data []byte, callback func(data []byte)) {
 			cd = BlockTableColumnListFill(data)
 		case "BlockTableSort":
 			cd = BlockTableSort(data)
+		case "BlockTableConvertToCollection":
+			cd = BlockTableConvertToCollection(data)
 		case "BlockCreateWidget":
 			cd = BlockCreateWidget(data)
 		case "BlockWidgetSetTargetId":
@@ -4814,6 +5343,12 @@ func CommandAsync(cmd string, data []byte, callback func(data []byte)) {
 			cd = BlockDivListSetStyle(data)
 		case "BlockLatexSetText":
 			cd = BlockLatexSetText(data)
+		case "BlockDiagramSetSource":
+			cd = BlockDiagramSetSource(data)
+		case "BlockDiagramSetLanguage":
+			cd = BlockDiagramSetLanguage(data)
+		case "BlockDiagramRender":
+			cd = BlockDiagramRender(data)
 		case "ProcessCancel":
 			cd = ProcessCancel(data)
 		case "LogSend":
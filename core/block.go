@@ -2,12 +2,15 @@ package core
 
 import (
 	"context"
+	"errors"
 
 	"github.com/globalsign/mgo/bson"
 	"google.golang.org/grpc/metadata"
 
 	"github.com/anyproto/anytype-heart/core/block"
+	"github.com/anyproto/anytype-heart/core/block/process"
 	"github.com/anyproto/anytype-heart/core/block/source"
+	"github.com/anyproto/anytype-heart/core/converter/diagram"
 	"github.com/anyproto/anytype-heart/core/session"
 	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
@@ -389,6 +392,62 @@ func (mw *Middleware) ObjectListSetIsArchived(cctx context.Context, req *pb.RpcO
 	}
 	return response(pb.RpcObjectListSetIsArchivedResponseError_NULL, nil)
 }
+func (mw *Middleware) ObjectMergeDuplicates(cctx context.Context, req *pb.RpcObjectMergeDuplicatesRequest) *pb.RpcObjectMergeDuplicatesResponse {
+	response := func(code pb.RpcObjectMergeDuplicatesResponseErrorCode, report *pb.RpcObjectMergeDuplicatesReport, err error) *pb.RpcObjectMergeDuplicatesResponse {
+		m := &pb.RpcObjectMergeDuplicatesResponse{Error: &pb.RpcObjectMergeDuplicatesResponseError{Code: code}, Report: report}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+	if req.TargetId == "" || len(req.SourceIds) == 0 {
+		return response(pb.RpcObjectMergeDuplicatesResponseError_BAD_INPUT, nil, errors.New("target and sources should be set"))
+	}
+
+	var report *pb.RpcObjectMergeDuplicatesReport
+	err := mw.doBlockService(func(bs *block.Service) (err error) {
+		report, err = bs.MergeDuplicates(*req)
+		return err
+	})
+	switch {
+	case err == nil:
+		return response(pb.RpcObjectMergeDuplicatesResponseError_NULL, report, nil)
+	case errors.Is(err, block.ErrIncompatibleMerge):
+		return response(pb.RpcObjectMergeDuplicatesResponseError_INCOMPATIBLE_OBJECTS, nil, err)
+	case errors.Is(err, block.ErrReadonlyMerge):
+		return response(pb.RpcObjectMergeDuplicatesResponseError_READONLY_OBJECT, nil, err)
+	case errors.Is(err, process.ErrQueueCanceled):
+		return response(pb.RpcObjectMergeDuplicatesResponseError_CANCELED, report, err)
+	}
+	return response(pb.RpcObjectMergeDuplicatesResponseError_UNKNOWN_ERROR, report, err)
+}
+
+func (mw *Middleware) ObjectFindReplace(cctx context.Context, req *pb.RpcObjectFindReplaceRequest) *pb.RpcObjectFindReplaceResponse {
+	response := func(code pb.RpcObjectFindReplaceResponseErrorCode, matches []*pb.RpcObjectFindReplaceMatch, err error) *pb.RpcObjectFindReplaceResponse {
+		m := &pb.RpcObjectFindReplaceResponse{Error: &pb.RpcObjectFindReplaceResponseError{Code: code}, Matches: matches}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+	if req.Find == "" {
+		return response(pb.RpcObjectFindReplaceResponseError_BAD_INPUT, nil, errors.New("find string is empty"))
+	}
+
+	var matches []*pb.RpcObjectFindReplaceMatch
+	err := mw.doBlockService(func(bs *block.Service) (err error) {
+		matches, err = bs.FindReplace(*req)
+		return err
+	})
+	switch {
+	case err == nil:
+		return response(pb.RpcObjectFindReplaceResponseError_NULL, matches, nil)
+	case errors.Is(err, process.ErrQueueCanceled):
+		return response(pb.RpcObjectFindReplaceResponseError_CANCELED, matches, err)
+	}
+	return response(pb.RpcObjectFindReplaceResponseError_UNKNOWN_ERROR, nil, err)
+}
+
 func (mw *Middleware) ObjectListSetIsFavorite(cctx context.Context, req *pb.RpcObjectListSetIsFavoriteRequest) *pb.RpcObjectListSetIsFavoriteResponse {
 	response := func(code pb.RpcObjectListSetIsFavoriteResponseErrorCode, err error) *pb.RpcObjectListSetIsFavoriteResponse {
 		m := &pb.RpcObjectListSetIsFavoriteResponse{Error: &pb.RpcObjectListSetIsFavoriteResponseError{Code: code}}
@@ -508,6 +567,32 @@ func (mw *Middleware) BlockListSetAlign(cctx context.Context, req *pb.RpcBlockLi
 	return response(pb.RpcBlockListSetAlignResponseError_NULL, nil)
 }
 
+func (mw *Middleware) BlockListSetLock(cctx context.Context, req *pb.RpcBlockListSetLockRequest) *pb.RpcBlockListSetLockResponse {
+	ctx := mw.newContext(cctx)
+	response := func(code pb.RpcBlockListSetLockResponseErrorCode, err error) *pb.RpcBlockListSetLockResponse {
+		m := &pb.RpcBlockListSetLockResponse{Error: &pb.RpcBlockListSetLockResponseError{Code: code}}
+		if err != nil {
+			m.Error.Description = err.Error()
+		} else {
+			m.Event = ctx.GetResponseEvent()
+		}
+		return m
+	}
+	if len(req.BlockIds) == 0 {
+		return response(pb.RpcBlockListSetLockResponseError_BAD_INPUT, errors.New("block ids are empty"))
+	}
+	err := mw.doBlockService(func(bs *block.Service) (err error) {
+		return bs.SetBlocksLock(ctx, req.ContextId, req.BlockIds, req.IsLocked)
+	})
+	switch {
+	case err == nil:
+		return response(pb.RpcBlockListSetLockResponseError_NULL, nil)
+	case errors.Is(err, block.ErrNotCreator):
+		return response(pb.RpcBlockListSetLockResponseError_NOT_CREATOR, err)
+	}
+	return response(pb.RpcBlockListSetLockResponseError_UNKNOWN_ERROR, err)
+}
+
 func (mw *Middleware) BlockListSetVerticalAlign(cctx context.Context, req *pb.RpcBlockListSetVerticalAlignRequest) *pb.RpcBlockListSetVerticalAlignResponse {
 	ctx := mw.newContext(cctx)
 	response := func(code pb.RpcBlockListSetVerticalAlignResponseErrorCode, err error) *pb.RpcBlockListSetVerticalAlignResponse {
@@ -779,6 +864,64 @@ func (mw *Middleware) BlockLatexSetText(cctx context.Context, req *pb.RpcBlockLa
 	return response(pb.RpcBlockLatexSetTextResponseError_NULL, nil)
 }
 
+func (mw *Middleware) BlockDiagramSetSource(cctx context.Context, req *pb.RpcBlockDiagramSetSourceRequest) *pb.RpcBlockDiagramSetSourceResponse {
+	ctx := mw.newContext(cctx)
+	response := func(code pb.RpcBlockDiagramSetSourceResponseErrorCode, err error) *pb.RpcBlockDiagramSetSourceResponse {
+		m := &pb.RpcBlockDiagramSetSourceResponse{Error: &pb.RpcBlockDiagramSetSourceResponseError{Code: code}}
+		if err != nil {
+			m.Error.Description = err.Error()
+		} else {
+			m.Event = ctx.GetResponseEvent()
+		}
+		return m
+	}
+	err := mw.doBlockService(func(bs *block.Service) (err error) {
+		return bs.SetDiagramSource(ctx, *req)
+	})
+	if err != nil {
+		return response(pb.RpcBlockDiagramSetSourceResponseError_UNKNOWN_ERROR, err)
+	}
+	return response(pb.RpcBlockDiagramSetSourceResponseError_NULL, nil)
+}
+
+func (mw *Middleware) BlockDiagramSetLanguage(cctx context.Context, req *pb.RpcBlockDiagramSetLanguageRequest) *pb.RpcBlockDiagramSetLanguageResponse {
+	ctx := mw.newContext(cctx)
+	response := func(code pb.RpcBlockDiagramSetLanguageResponseErrorCode, err error) *pb.RpcBlockDiagramSetLanguageResponse {
+		m := &pb.RpcBlockDiagramSetLanguageResponse{Error: &pb.RpcBlockDiagramSetLanguageResponseError{Code: code}}
+		if err != nil {
+			m.Error.Description = err.Error()
+		} else {
+			m.Event = ctx.GetResponseEvent()
+		}
+		return m
+	}
+	err := mw.doBlockService(func(bs *block.Service) (err error) {
+		return bs.SetDiagramLanguage(ctx, *req)
+	})
+	if err != nil {
+		return response(pb.RpcBlockDiagramSetLanguageResponseError_UNKNOWN_ERROR, err)
+	}
+	return response(pb.RpcBlockDiagramSetLanguageResponseError_NULL, nil)
+}
+
+func (mw *Middleware) BlockDiagramRender(cctx context.Context, req *pb.RpcBlockDiagramRenderRequest) *pb.RpcBlockDiagramRenderResponse {
+	response := func(svg string, code pb.RpcBlockDiagramRenderResponseErrorCode, err error) *pb.RpcBlockDiagramRenderResponse {
+		m := &pb.RpcBlockDiagramRenderResponse{Error: &pb.RpcBlockDiagramRenderResponseError{Code: code}, Svg: svg}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+	svg, err := diagram.Render(req.Language, req.Source)
+	if errors.Is(err, diagram.ErrNotSupported) {
+		return response("", pb.RpcBlockDiagramRenderResponseError_NOT_SUPPORTED, err)
+	}
+	if err != nil {
+		return response("", pb.RpcBlockDiagramRenderResponseError_BAD_INPUT, err)
+	}
+	return response(string(svg), pb.RpcBlockDiagramRenderResponseError_NULL, nil)
+}
+
 func (mw *Middleware) BlockTextSetStyle(cctx context.Context, req *pb.RpcBlockTextSetStyleRequest) *pb.RpcBlockTextSetStyleResponse {
 	ctx := mw.newContext(cctx)
 	response := func(code pb.RpcBlockTextSetStyleResponseErrorCode, err error) *pb.RpcBlockTextSetStyleResponse {
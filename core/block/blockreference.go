@@ -0,0 +1,39 @@
+package block
+
+import (
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/addr"
+)
+
+// maxBlockRedirects limits the chain of moves followed by ResolveBlockReference
+const maxBlockRedirects = 10
+
+// ResolveBlockReference resolves the reference in the form of <objectId>#<blockId> to the object and the block.
+// Blocks moved to other objects are followed to their new place. Block id is empty when the block is removed
+func (s *Service) ResolveBlockReference(ref string) (objectID, blockID string, err error) {
+	objectID, blockID = addr.SplitBlockReference(ref)
+	for i := 0; blockID != "" && i < maxBlockRedirects; i++ {
+		var movedTo string
+		err = s.Do(objectID, func(b smartblock.SmartBlock) error {
+			st := b.NewState()
+			if st.Pick(blockID) == nil {
+				movedTo = st.GetMovedBlock(blockID)
+				if movedTo == "" {
+					blockID = ""
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return "", "", err
+		}
+		if movedTo == "" {
+			return objectID, blockID, nil
+		}
+		objectID, blockID = addr.SplitBlockReference(movedTo)
+	}
+	if blockID != "" {
+		log.With("ref", ref).Warnf("too many redirects of the block")
+	}
+	return objectID, blockID, nil
+}
@@ -0,0 +1,93 @@
+package comment
+
+import (
+	"github.com/gogo/protobuf/types"
+	"github.com/samber/lo"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/block/simple/base"
+	"github.com/anyproto/anytype-heart/core/block/simple/text"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+// textBlockID is the id of the block that holds the text of the comment
+const textBlockID = "comment"
+
+// newCommentState builds the state of the new comment object with a single text block
+func newCommentState(commentText string, marks *model.BlockContentTextMarks) *state.State {
+	st := state.NewDoc("", nil).NewState()
+	st.Add(simple.New(&model.Block{
+		Id: textBlockID,
+		Content: &model.BlockContentOfText{
+			Text: &model.BlockContentText{Text: commentText, Marks: marks},
+		},
+	}))
+	st.Add(base.NewBase(&model.Block{
+		// This id will be replaced by id of the new object
+		Id:          "_root",
+		ChildrenIds: []string{textBlockID},
+	}))
+	return st
+}
+
+// newCommentDetails returns details of the comment for the block of the target object.
+// Replies have the same target as the first comment of the thread
+func newCommentDetails(target *model.Comment, threadID string, marks *model.BlockContentTextMarks) *types.Struct {
+	details := &types.Struct{
+		Fields: map[string]*types.Value{
+			bundle.RelationKeyType.String():           pbtypes.String(bundle.TypeKeyComment.URL()),
+			bundle.RelationKeyCommentTarget.String():  pbtypes.String(target.TargetObjectId),
+			bundle.RelationKeyCommentBlockId.String(): pbtypes.String(target.TargetBlockId),
+			bundle.RelationKeyIsResolved.String():     pbtypes.Bool(false),
+			bundle.RelationKeyMentions.String():       pbtypes.StringList(collectMentions(marks)),
+		},
+	}
+	if threadID != "" {
+		details.Fields[bundle.RelationKeyCommentThread.String()] = pbtypes.String(threadID)
+	}
+	if target.Range != nil {
+		details.Fields[bundle.RelationKeyCommentRangeFrom.String()] = pbtypes.Int64(int64(target.Range.From))
+		details.Fields[bundle.RelationKeyCommentRangeTo.String()] = pbtypes.Int64(int64(target.Range.To))
+	}
+	return details
+}
+
+// commentFromState converts the state of the comment object to the comment model
+func commentFromState(st *state.State) *model.Comment {
+	details := st.CombinedDetails()
+	c := &model.Comment{
+		Id:             st.RootId(),
+		ThreadId:       pbtypes.GetString(details, bundle.RelationKeyCommentThread.String()),
+		TargetObjectId: pbtypes.GetString(details, bundle.RelationKeyCommentTarget.String()),
+		TargetBlockId:  pbtypes.GetString(details, bundle.RelationKeyCommentBlockId.String()),
+		Creator:        pbtypes.GetString(details, bundle.RelationKeyCreator.String()),
+		CreatedDate:    pbtypes.GetInt64(details, bundle.RelationKeyCreatedDate.String()),
+		ModifiedDate:   pbtypes.GetInt64(details, bundle.RelationKeyLastModifiedDate.String()),
+		IsResolved:     pbtypes.GetBool(details, bundle.RelationKeyIsResolved.String()),
+	}
+	if pbtypes.Exists(details, bundle.RelationKeyCommentRangeFrom.String()) {
+		c.Range = &model.Range{
+			From: int32(pbtypes.GetInt64(details, bundle.RelationKeyCommentRangeFrom.String())),
+			To:   int32(pbtypes.GetInt64(details, bundle.RelationKeyCommentRangeTo.String())),
+		}
+	}
+	if tb, ok := st.Pick(textBlockID).(text.Block); ok {
+		c.Text = tb.GetText()
+		c.Marks = tb.Model().GetText().GetMarks()
+	}
+	return c
+}
+
+// collectMentions returns unique ids of the objects mentioned in the text
+func collectMentions(marks *model.BlockContentTextMarks) []string {
+	var ids []string
+	for _, m := range marks.GetMarks() {
+		if m.Type == model.BlockContentTextMark_Mention && m.Param != "" {
+			ids = append(ids, m.Param)
+		}
+	}
+	return lo.Uniq(ids)
+}
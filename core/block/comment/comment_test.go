@@ -0,0 +1,72 @@
+package comment
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func testMarks() *model.BlockContentTextMarks {
+	return &model.BlockContentTextMarks{Marks: []*model.BlockContentTextMark{
+		{Range: &model.Range{From: 0, To: 4}, Type: model.BlockContentTextMark_Mention, Param: "alice"},
+		{Range: &model.Range{From: 5, To: 9}, Type: model.BlockContentTextMark_Bold},
+		{Range: &model.Range{From: 10, To: 13}, Type: model.BlockContentTextMark_Mention, Param: "bob"},
+		{Range: &model.Range{From: 14, To: 18}, Type: model.BlockContentTextMark_Mention, Param: "alice"},
+	}}
+}
+
+func TestCollectMentions(t *testing.T) {
+	assert.Equal(t, []string{"alice", "bob"}, collectMentions(testMarks()))
+	assert.Empty(t, collectMentions(nil))
+}
+
+func TestCommentFromState(t *testing.T) {
+	t.Run("first comment of the thread", func(t *testing.T) {
+		target := &model.Comment{TargetObjectId: "page", TargetBlockId: "block", Range: &model.Range{From: 2, To: 7}}
+		st := newCommentState("alice, bold bob alice", testMarks())
+		st.SetRootId("comment1")
+		st.SetDetails(newCommentDetails(target, "", testMarks()))
+		st.SetLocalDetail(bundle.RelationKeyCreator.String(), pbtypes.String("profile"))
+
+		c := commentFromState(st)
+
+		assert.Equal(t, "comment1", c.Id)
+		assert.Empty(t, c.ThreadId)
+		assert.Equal(t, "page", c.TargetObjectId)
+		assert.Equal(t, "block", c.TargetBlockId)
+		assert.Equal(t, &model.Range{From: 2, To: 7}, c.Range)
+		assert.Equal(t, "alice, bold bob alice", c.Text)
+		assert.Equal(t, testMarks(), c.Marks)
+		assert.Equal(t, "profile", c.Creator)
+		assert.False(t, c.IsResolved)
+		assert.Equal(t, []string{"alice", "bob"}, pbtypes.GetStringList(st.Details(), bundle.RelationKeyMentions.String()))
+	})
+
+	t.Run("reply to the whole block comment", func(t *testing.T) {
+		target := &model.Comment{TargetObjectId: "page", TargetBlockId: "block"}
+		st := newCommentState("agree", nil)
+		st.SetRootId("comment2")
+		st.SetDetails(newCommentDetails(target, "comment1", nil))
+
+		c := commentFromState(st)
+
+		assert.Equal(t, "comment1", c.ThreadId)
+		assert.Nil(t, c.Range)
+		assert.Equal(t, "agree", c.Text)
+	})
+}
+
+func TestNewCommentState(t *testing.T) {
+	st := newCommentState("text", nil)
+	st.SetRootId("comment1")
+
+	root := st.Pick(st.RootId())
+	require.NotNil(t, root)
+	assert.Equal(t, []string{textBlockID}, root.Model().ChildrenIds)
+	assert.Equal(t, "text", st.Pick(textBlockID).Model().GetText().Text)
+}
@@ -0,0 +1,327 @@
+package comment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/anyproto/any-sync/app"
+	"github.com/gogo/protobuf/types"
+
+	"github.com/anyproto/anytype-heart/core/block"
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple/text"
+	"github.com/anyproto/anytype-heart/core/event"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	coresb "github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+	textutil "github.com/anyproto/anytype-heart/util/text"
+)
+
+const CName = "comment"
+
+var log = logging.Logger("anytype-mw-comment")
+
+var (
+	ErrBadInput = errors.New("bad input")
+	ErrNotFound = errors.New("not found")
+)
+
+// Service manages comments attached to the blocks of objects. Every comment is a separate object of the comment
+// type, so comments are synced and indexed as any other object and could be queried by subscriptions,
+// e.g. open comments of the current profile are comments with creator equal to the profile id and unset isResolved
+type Service interface {
+	CreateComment(req *pb.RpcCommentCreateRequest) (*model.Comment, error)
+	UpdateComment(req *pb.RpcCommentUpdateRequest) (*model.Comment, error)
+	// SetIsResolved resolves or reopens the whole thread the comment belongs to
+	SetIsResolved(commentID string, isResolved bool) error
+	// DeleteComment deletes the comment, deletion of the first comment of the thread deletes all the replies
+	DeleteComment(commentID string) error
+	ListComments(objectID string, includeResolved bool) ([]*model.Comment, error)
+
+	app.Component
+}
+
+type ObjectCreator interface {
+	CreateSmartBlockFromState(ctx context.Context, sbType coresb.SmartBlockType, details *types.Struct, s *state.State) (id string, newDetails *types.Struct, err error)
+}
+
+type ObjectDeleter interface {
+	DeleteObject(id string) (err error)
+}
+
+type service struct {
+	picker    block.Picker
+	deleter   ObjectDeleter
+	creator   ObjectCreator
+	store     objectstore.ObjectStore
+	sendEvent func(e *pb.Event)
+}
+
+func New() Service {
+	return &service{}
+}
+
+func (s *service) Init(a *app.App) (err error) {
+	blockService := app.MustComponent[*block.Service](a)
+	s.picker = blockService
+	s.deleter = blockService
+	s.creator = a.MustComponent("objectCreator").(ObjectCreator)
+	s.store = a.MustComponent(objectstore.CName).(objectstore.ObjectStore)
+	s.sendEvent = a.MustComponent(event.CName).(event.Sender).Send
+	return nil
+}
+
+func (s *service) Name() (name string) {
+	return CName
+}
+
+func (s *service) CreateComment(req *pb.RpcCommentCreateRequest) (*model.Comment, error) {
+	var (
+		target   *model.Comment
+		threadID string
+	)
+	if req.ThreadId != "" {
+		thread, err := s.getComment(req.ThreadId)
+		if err != nil {
+			return nil, fmt.Errorf("get thread: %w", err)
+		}
+		target, threadID = thread, threadIDOf(thread)
+		if thread.IsResolved {
+			// reply reopens the discussion
+			if err = s.SetIsResolved(threadID, false); err != nil {
+				return nil, fmt.Errorf("reopen thread: %w", err)
+			}
+		}
+	} else {
+		if req.ContextId == "" || req.BlockId == "" {
+			return nil, fmt.Errorf("%w: commented object and block are required", ErrBadInput)
+		}
+		if err := s.checkTarget(req.ContextId, req.BlockId, req.Range); err != nil {
+			return nil, err
+		}
+		target = &model.Comment{TargetObjectId: req.ContextId, TargetBlockId: req.BlockId, Range: req.Range}
+	}
+
+	id, _, err := s.creator.CreateSmartBlockFromState(
+		context.TODO(),
+		coresb.SmartBlockTypePage,
+		newCommentDetails(target, threadID, req.Marks),
+		newCommentState(req.Text, req.Marks),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create comment object: %w", err)
+	}
+	c, err := s.getComment(id)
+	if err != nil {
+		return nil, err
+	}
+	s.sendCommentSet(c)
+	return c, nil
+}
+
+func (s *service) UpdateComment(req *pb.RpcCommentUpdateRequest) (*model.Comment, error) {
+	return s.modifyComment(req.CommentId, func(st *state.State, tb text.Block) {
+		tb.SetText(req.Text, req.Marks)
+		st.SetDetailAndBundledRelation(bundle.RelationKeyMentions, pbtypes.StringList(collectMentions(req.Marks)))
+	})
+}
+
+func (s *service) SetIsResolved(commentID string, isResolved bool) error {
+	c, err := s.getComment(commentID)
+	if err != nil {
+		return err
+	}
+	ids, err := s.threadCommentIDs(threadIDOf(c))
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		_, err = s.modifyComment(id, func(st *state.State, _ text.Block) {
+			st.SetDetailAndBundledRelation(bundle.RelationKeyIsResolved, pbtypes.Bool(isResolved))
+		})
+		if err != nil {
+			return fmt.Errorf("set isResolved for %s: %w", id, err)
+		}
+	}
+	return nil
+}
+
+func (s *service) DeleteComment(commentID string) error {
+	c, err := s.getComment(commentID)
+	if err != nil {
+		return err
+	}
+	ids := []string{c.Id}
+	if c.ThreadId == "" {
+		if ids, err = s.threadCommentIDs(c.Id); err != nil {
+			return err
+		}
+	}
+	// delete replies first, so the thread is never left without the first comment
+	for i := len(ids) - 1; i >= 0; i-- {
+		if err = s.deleter.DeleteObject(ids[i]); err != nil {
+			return fmt.Errorf("delete comment %s: %w", ids[i], err)
+		}
+		s.sendEvent(&pb.Event{
+			ContextId: c.TargetObjectId,
+			Messages: []*pb.EventMessage{{
+				Value: &pb.EventMessageValueOfCommentRemove{
+					CommentRemove: &pb.EventCommentRemove{Id: ids[i], TargetObjectId: c.TargetObjectId},
+				},
+			}},
+		})
+	}
+	return nil
+}
+
+func (s *service) ListComments(objectID string, includeResolved bool) ([]*model.Comment, error) {
+	filters := []*model.BlockContentDataviewFilter{
+		{
+			RelationKey: bundle.RelationKeyCommentTarget.String(),
+			Condition:   model.BlockContentDataviewFilter_Equal,
+			Value:       pbtypes.String(objectID),
+		},
+	}
+	if !includeResolved {
+		filters = append(filters, &model.BlockContentDataviewFilter{
+			RelationKey: bundle.RelationKeyIsResolved.String(),
+			Condition:   model.BlockContentDataviewFilter_NotEqual,
+			Value:       pbtypes.Bool(true),
+		})
+	}
+	ids, err := s.queryComments(filters)
+	if err != nil {
+		return nil, err
+	}
+
+	comments := make([]*model.Comment, 0, len(ids))
+	for _, id := range ids {
+		c, err := s.getComment(id)
+		if err != nil {
+			log.With("objectID", id).Warnf("failed to get comment: %s", err)
+			continue
+		}
+		comments = append(comments, c)
+	}
+	return comments, nil
+}
+
+// checkTarget checks that the commented block exists and the range is inside its text
+func (s *service) checkTarget(objectID, blockID string, rng *model.Range) error {
+	return block.Do(s.picker, objectID, func(sb smartblock.SmartBlock) error {
+		b := sb.Pick(blockID)
+		if b == nil {
+			return fmt.Errorf("%w: block %s", ErrNotFound, blockID)
+		}
+		if rng == nil {
+			return nil
+		}
+		tb, ok := b.(text.Block)
+		if !ok || rng.From < 0 || rng.From > rng.To || int(rng.To) > textutil.UTF16RuneCountString(tb.GetText()) {
+			return fmt.Errorf("%w: range is out of the block text", ErrBadInput)
+		}
+		return nil
+	})
+}
+
+func (s *service) getComment(id string) (c *model.Comment, err error) {
+	err = block.Do(s.picker, id, func(sb smartblock.SmartBlock) error {
+		st := sb.NewState()
+		if st.ObjectType() != bundle.TypeKeyComment.URL() {
+			return fmt.Errorf("%w: object %s is not a comment", ErrNotFound, id)
+		}
+		c = commentFromState(st)
+		return nil
+	})
+	return
+}
+
+// modifyComment applies the changes to the comment object and notifies the commented object about them
+func (s *service) modifyComment(id string, modify func(st *state.State, tb text.Block)) (*model.Comment, error) {
+	err := block.DoState(s.picker, id, func(st *state.State, sb smartblock.SmartBlock) error {
+		if st.ObjectType() != bundle.TypeKeyComment.URL() {
+			return fmt.Errorf("%w: object %s is not a comment", ErrNotFound, id)
+		}
+		tb, ok := st.Get(textBlockID).(text.Block)
+		if !ok {
+			return fmt.Errorf("comment %s has no text block", id)
+		}
+		modify(st, tb)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	c, err := s.getComment(id)
+	if err != nil {
+		return nil, err
+	}
+	s.sendCommentSet(c)
+	return c, nil
+}
+
+// threadCommentIDs returns ids of the first comment of the thread and all its replies
+func (s *service) threadCommentIDs(threadID string) ([]string, error) {
+	replies, err := s.queryComments([]*model.BlockContentDataviewFilter{
+		{
+			RelationKey: bundle.RelationKeyCommentThread.String(),
+			Condition:   model.BlockContentDataviewFilter_Equal,
+			Value:       pbtypes.String(threadID),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return append([]string{threadID}, replies...), nil
+}
+
+// queryComments returns ids of the comments matching the filters, sorted by the creation date
+func (s *service) queryComments(filters []*model.BlockContentDataviewFilter) ([]string, error) {
+	filters = append(filters, &model.BlockContentDataviewFilter{
+		RelationKey: bundle.RelationKeyType.String(),
+		Condition:   model.BlockContentDataviewFilter_Equal,
+		Value:       pbtypes.String(bundle.TypeKeyComment.URL()),
+	})
+	records, _, err := s.store.Query(nil, database.Query{
+		Filters: filters,
+		Sorts: []*model.BlockContentDataviewSort{
+			{
+				RelationKey: bundle.RelationKeyCreatedDate.String(),
+				Type:        model.BlockContentDataviewSort_Asc,
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("query comments: %w", err)
+	}
+	ids := make([]string, 0, len(records))
+	for _, rec := range records {
+		ids = append(ids, pbtypes.GetString(rec.Details, bundle.RelationKeyId.String()))
+	}
+	return ids, nil
+}
+
+func (s *service) sendCommentSet(c *model.Comment) {
+	s.sendEvent(&pb.Event{
+		ContextId: c.TargetObjectId,
+		Messages: []*pb.EventMessage{{
+			Value: &pb.EventMessageValueOfCommentSet{
+				CommentSet: &pb.EventCommentSet{Comment: c},
+			},
+		}},
+	})
+}
+
+func threadIDOf(c *model.Comment) string {
+	if c.ThreadId != "" {
+		return c.ThreadId
+	}
+	return c.Id
+}
@@ -0,0 +1,65 @@
+package block
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/anyproto/anytype-heart/core/relation"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+// SetObjectTypeConstraints replaces the relation constraints of the object type. The constraints are not applied
+// to the existing objects retroactively, instead their constraintViolations detail is refreshed
+func (s *Service) SetObjectTypeConstraints(typeURL string, constraints []*model.RelationConstraint) error {
+	for _, c := range constraints {
+		if err := relation.ValidateConstraintDefinition(c); err != nil {
+			return err
+		}
+	}
+	err := s.ModifyDetails(typeURL, func(current *types.Struct) (*types.Struct, error) {
+		details := pbtypes.CopyStruct(current)
+		details.Fields[bundle.RelationKeyRelationConstraints.String()] = relation.ConstraintsToValue(constraints)
+		return details, nil
+	})
+	if err != nil {
+		return fmt.Errorf("set constraints: %w", err)
+	}
+
+	records, _, err := s.objectStore.Query(nil, database.Query{
+		Filters: []*model.BlockContentDataviewFilter{
+			{
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				RelationKey: bundle.RelationKeyType.String(),
+				Value:       pbtypes.String(typeURL),
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("query objects of type: %w", err)
+	}
+	for _, rec := range records {
+		id := pbtypes.GetString(rec.Details, bundle.RelationKeyId.String())
+		err = s.ModifyLocalDetails(id, func(current *types.Struct) (*types.Struct, error) {
+			details := pbtypes.CopyStruct(current)
+			if details == nil || details.Fields == nil {
+				details = &types.Struct{Fields: map[string]*types.Value{}}
+			}
+			objectTypes := pbtypes.GetStringList(rec.Details, bundle.RelationKeyType.String())
+			violations := s.relationService.ConstraintViolations(objectTypes, rec.Details)
+			if len(violations) == 0 {
+				delete(details.Fields, bundle.RelationKeyConstraintViolations.String())
+			} else {
+				details.Fields[bundle.RelationKeyConstraintViolations.String()] = pbtypes.StringList(relation.ViolationsRelationIDs(violations))
+			}
+			return details, nil
+		})
+		if err != nil {
+			log.With("objectID", id).Errorf("failed to refresh constraint violations: %v", err)
+		}
+	}
+	return nil
+}
@@ -1,10 +1,19 @@
 package block
 
 import (
+	"fmt"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/samber/lo"
+
 	"github.com/anyproto/anytype-heart/core/block/editor/dataview"
 	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/relation/relationutils"
 	"github.com/anyproto/anytype-heart/core/session"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
 )
 
 func (s *Service) AddDataviewFilter(
@@ -213,3 +222,66 @@ func (s *Service) ReorderDataviewViewRelations(
 		return dv.ReorderViewRelations(viewID, relationKeys)
 	})
 }
+
+// DataviewRecords returns the relations shown by the view and the records of the dataview block,
+// the records are queried with a one-off subscription
+func (s *Service) DataviewRecords(
+	contextID string,
+	dv *model.BlockContentDataview,
+	view *model.BlockContentDataviewView,
+) (relations []*model.Relation, records, dependencies []*types.Struct, err error) {
+	keys := make([]string, 0, len(view.Relations))
+	for _, rel := range view.Relations {
+		if rel.IsVisible {
+			keys = append(keys, rel.Key)
+		}
+	}
+	if len(keys) == 0 {
+		keys = append(keys, bundle.RelationKeyName.String())
+	}
+	fetched, err := s.relationService.FetchKeys(keys...)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("fetch relations: %w", err)
+	}
+	byKey := lo.SliceToMap(fetched, func(rel *relationutils.Relation) (string, *model.Relation) {
+		return rel.Key, rel.Relation
+	})
+	for _, key := range keys {
+		if rel, ok := byKey[key]; ok {
+			relations = append(relations, rel)
+		}
+	}
+
+	req := pb.RpcObjectSearchSubscribeRequest{
+		Filters: view.Filters,
+		Sorts:   view.Sorts,
+		Keys:    append([]string{bundle.RelationKeyId.String()}, keys...),
+		Source:  dv.Source,
+	}
+	if dv.IsCollection {
+		req.CollectionId = contextID
+	}
+	if dv.TargetObjectId != "" {
+		details, err := s.objectStore.GetDetails(dv.TargetObjectId)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("get target object details: %w", err)
+		}
+		if pbtypes.GetInt64(details.GetDetails(), bundle.RelationKeyLayout.String()) == int64(model.ObjectType_collection) {
+			req.Source, req.CollectionId = nil, dv.TargetObjectId
+		} else {
+			req.Source, req.CollectionId = pbtypes.GetStringList(details.GetDetails(), bundle.RelationKeySetOf.String()), ""
+		}
+	}
+	if len(req.Source) == 0 && req.CollectionId == "" {
+		return relations, nil, nil, nil
+	}
+
+	resp, err := s.subscription.Search(req)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("search records: %w", err)
+	}
+	if err = s.subscription.Unsubscribe(resp.SubId); err != nil {
+		log.With("subID", resp.SubId).Warnf("can't unsubscribe: %v", err)
+	}
+	return relations, resp.Records, resp.Dependencies, nil
+}
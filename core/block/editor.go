@@ -23,6 +23,7 @@ import (
 	"github.com/anyproto/anytype-heart/core/block/simple/link"
 	"github.com/anyproto/anytype-heart/core/block/simple/text"
 	"github.com/anyproto/anytype-heart/core/block/source"
+	"github.com/anyproto/anytype-heart/core/files"
 	"github.com/anyproto/anytype-heart/core/session"
 	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
@@ -296,6 +297,18 @@ func (s *Service) SetLatexText(ctx *session.Context, req pb.RpcBlockLatexSetText
 	})
 }
 
+func (s *Service) SetDiagramSource(ctx *session.Context, req pb.RpcBlockDiagramSetSourceRequest) error {
+	return Do(s, req.ContextId, func(b basic.CommonOperations) error {
+		return b.SetDiagramSource(ctx, req)
+	})
+}
+
+func (s *Service) SetDiagramLanguage(ctx *session.Context, req pb.RpcBlockDiagramSetLanguageRequest) error {
+	return Do(s, req.ContextId, func(b basic.CommonOperations) error {
+		return b.SetDiagramLanguage(ctx, req)
+	})
+}
+
 func (s *Service) SetTextStyle(
 	ctx *session.Context, contextId string, style model.BlockContentTextStyle, blockIds ...string,
 ) error {
@@ -442,9 +455,10 @@ func (s *Service) FeaturedRelationRemove(ctx *session.Context, contextId string,
 func (s *Service) UploadBlockFile(ctx *session.Context, req pb.RpcBlockUploadRequest, groupId string) (err error) {
 	return s.DoFile(req.ContextId, func(b file.File) error {
 		err = b.Upload(ctx, req.BlockId, file.FileSource{
-			Path:    req.FilePath,
-			Url:     req.Url,
-			GroupId: groupId,
+			Path:             req.FilePath,
+			Url:              req.Url,
+			GroupId:          groupId,
+			ImageEditOptions: req.ImageEditOptions,
 		}, false)
 		return err
 	})
@@ -453,8 +467,9 @@ func (s *Service) UploadBlockFile(ctx *session.Context, req pb.RpcBlockUploadReq
 func (s *Service) UploadBlockFileSync(ctx *session.Context, req pb.RpcBlockUploadRequest) (err error) {
 	return s.DoFile(req.ContextId, func(b file.File) error {
 		err = b.Upload(ctx, req.BlockId, file.FileSource{
-			Path: req.FilePath,
-			Url:  req.Url,
+			Path:             req.FilePath,
+			Url:              req.Url,
+			ImageEditOptions: req.ImageEditOptions,
 		}, true)
 		return err
 	})
@@ -477,6 +492,9 @@ func (s *Service) UploadFile(req pb.RpcFileUploadRequest) (hash string, err erro
 	}
 
 	upl.SetStyle(req.Style)
+	if req.ImageEditOptions != nil {
+		upl.AddOptions(files.WithImageEditOptions(req.ImageEditOptions))
+	}
 	if req.Type != model.BlockContentFile_None {
 		upl.SetType(req.Type)
 	} else {
@@ -513,9 +531,10 @@ func (s *Service) UploadFileBlockWithHash(
 ) (hash string, err error) {
 	err = s.DoFile(contextId, func(b file.File) error {
 		res, err := b.UploadFileWithHash(req.BlockId, file.FileSource{
-			Path:    req.FilePath,
-			Url:     req.Url,
-			GroupId: "",
+			Path:             req.FilePath,
+			Url:              req.Url,
+			GroupId:          "",
+			ImageEditOptions: req.ImageEditOptions,
 		})
 		if err != nil {
 			return err
@@ -12,6 +12,7 @@ import (
 	"github.com/anyproto/anytype-heart/core/block/restriction"
 	"github.com/anyproto/anytype-heart/core/block/simple"
 	"github.com/anyproto/anytype-heart/core/block/simple/base"
+	"github.com/anyproto/anytype-heart/core/block/simple/diagram"
 	"github.com/anyproto/anytype-heart/core/block/simple/latex"
 	"github.com/anyproto/anytype-heart/core/block/simple/link"
 	relationblock "github.com/anyproto/anytype-heart/core/block/simple/relation"
@@ -20,6 +21,7 @@ import (
 	"github.com/anyproto/anytype-heart/core/session"
 	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/addr"
 	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 	"github.com/anyproto/anytype-heart/util/pbtypes"
@@ -43,6 +45,8 @@ type CommonOperations interface {
 	SetFields(ctx *session.Context, fields ...*pb.RpcBlockListSetFieldsRequestBlockField) (err error)
 	SetDivStyle(ctx *session.Context, style model.BlockContentDivStyle, ids ...string) (err error)
 	SetLatexText(ctx *session.Context, req pb.RpcBlockLatexSetTextRequest) error
+	SetDiagramSource(ctx *session.Context, req pb.RpcBlockDiagramSetSourceRequest) error
+	SetDiagramLanguage(ctx *session.Context, req pb.RpcBlockDiagramSetLanguageRequest) error
 
 	SetRelationKey(ctx *session.Context, req pb.RpcBlockRelationSetKeyRequest) error
 	AddRelationAndSet(ctx *session.Context, service relation.Service, req pb.RpcBlockRelationAddRequest) error
@@ -194,6 +198,23 @@ func copyBlocks(srcState, destState *state.State, sourceId string) (id string, e
 	return result.Model().Id, nil
 }
 
+// setMovedBlocks saves references to the copies of the moved block and its children,
+// so references to the blocks of the source object remain valid
+func setMovedBlocks(srcState, destState *state.State, oldId, newId string) {
+	oldBlock, newBlock := srcState.Pick(oldId), destState.Pick(newId)
+	if oldBlock == nil || newBlock == nil {
+		return
+	}
+	srcState.SetMovedBlock(oldId, addr.BlockReference(destState.RootId(), newId))
+	oldChildren, newChildren := oldBlock.Model().ChildrenIds, newBlock.Model().ChildrenIds
+	if len(oldChildren) != len(newChildren) {
+		return
+	}
+	for i := range oldChildren {
+		setMovedBlocks(srcState, destState, oldChildren[i], newChildren[i])
+	}
+}
+
 func (bs *basic) Unlink(ctx *session.Context, ids ...string) (err error) {
 	s := bs.NewStateCtx(ctx)
 
@@ -211,10 +232,14 @@ func (bs *basic) Unlink(ctx *session.Context, ids ...string) (err error) {
 
 func (bs *basic) Move(srcState, destState *state.State, targetBlockId string, position model.BlockPosition, blockIds []string) (err error) {
 	if srcState != destState && destState != nil {
-		_, err := bs.Duplicate(srcState, destState, targetBlockId, position, blockIds)
+		roots := srcState.SelectRoots(blockIds)
+		newIds, err := bs.Duplicate(srcState, destState, targetBlockId, position, roots)
 		if err != nil {
 			return fmt.Errorf("paste: %w", err)
 		}
+		for i, id := range roots {
+			setMovedBlocks(srcState, destState, id, newIds[i])
+		}
 		for _, id := range blockIds {
 			srcState.Unlink(id)
 		}
@@ -365,6 +390,36 @@ func (bs *basic) SetLatexText(ctx *session.Context, req pb.RpcBlockLatexSetTextR
 	return bs.Apply(s, smartblock.NoEvent)
 }
 
+func (bs *basic) SetDiagramSource(ctx *session.Context, req pb.RpcBlockDiagramSetSourceRequest) (err error) {
+	s := bs.NewStateCtx(ctx)
+	b := s.Get(req.BlockId)
+	if b == nil {
+		return smartblock.ErrSimpleBlockNotFound
+	}
+
+	if d, ok := b.(diagram.Block); ok {
+		d.SetSource(req.Source)
+	} else {
+		return fmt.Errorf("unexpected block type: %T (want diagram)", b)
+	}
+	return bs.Apply(s, smartblock.NoEvent)
+}
+
+func (bs *basic) SetDiagramLanguage(ctx *session.Context, req pb.RpcBlockDiagramSetLanguageRequest) (err error) {
+	s := bs.NewStateCtx(ctx)
+	b := s.Get(req.BlockId)
+	if b == nil {
+		return smartblock.ErrSimpleBlockNotFound
+	}
+
+	if d, ok := b.(diagram.Block); ok {
+		d.SetLanguage(req.Language)
+	} else {
+		return fmt.Errorf("unexpected block type: %T (want diagram)", b)
+	}
+	return bs.Apply(s)
+}
+
 func (bs *basic) AddRelationAndSet(ctx *session.Context, relationService relation.Service, req pb.RpcBlockRelationAddRequest) (err error) {
 	s := bs.NewStateCtx(ctx)
 	b := s.Get(req.BlockId)
@@ -438,14 +493,14 @@ func (bs *basic) ReplaceLink(oldId, newId string) error {
 	s := bs.NewState()
 	s.Iterate(func(b simple.Block) (isContinue bool) {
 		if l, ok := b.(link.Block); ok {
-			if l.Model().GetLink().TargetBlockId == oldId {
-				s.Get(b.Model().Id).Model().GetLink().TargetBlockId = newId
+			if target, ok := replaceReferenceObject(l.Model().GetLink().TargetBlockId, oldId, newId); ok {
+				s.Get(b.Model().Id).Model().GetLink().TargetBlockId = target
 			}
 		} else if t, ok := b.(text.Block); ok {
 			if marks := t.Model().GetText().Marks; marks != nil {
 				for i, m := range marks.Marks {
-					if m.Param == oldId {
-						s.Get(b.Model().Id).Model().GetText().Marks.Marks[i].Param = newId
+					if param, ok := replaceReferenceObject(m.Param, oldId, newId); ok {
+						s.Get(b.Model().Id).Model().GetText().Marks.Marks[i].Param = param
 					}
 				}
 			}
@@ -465,6 +520,15 @@ func (bs *basic) ReplaceLink(oldId, newId string) error {
 	return bs.Apply(s)
 }
 
+// replaceReferenceObject replaces object part of the object or block reference
+func replaceReferenceObject(ref, oldID, newID string) (string, bool) {
+	objectID, blockID := addr.SplitBlockReference(ref)
+	if objectID != oldID {
+		return ref, false
+	}
+	return addr.BlockReference(newID, blockID), true
+}
+
 func (bs *basic) PasteBlocks(s *state.State, targetBlockID string, position model.BlockPosition, blocks []simple.Block) (err error) {
 	childIdsRewrite := make(map[string]string)
 	for _, b := range blocks {
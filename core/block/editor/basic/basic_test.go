@@ -262,6 +262,11 @@ func TestBasic_MoveToAnotherObject(t *testing.T) {
 			assert.NotEqual(t, wb.Id, gb.Id)
 			assert.Equal(t, wb.Content, gb.Content)
 		}
+
+		// References to the moved blocks are kept in the source object
+		assert.Equal(t, "test2#"+gotBlocks[0].Model().Id, sb1.NewState().GetMovedBlock("2"))
+		assert.Equal(t, "test2#"+gotBlocks[1].Model().Id, sb1.NewState().GetMovedBlock("3"))
+		assert.Empty(t, sb1.NewState().GetMovedBlock("4"))
 	})
 }
 
@@ -26,6 +26,13 @@ type detailUpdate struct {
 }
 
 func (bs *basic) SetDetails(ctx *session.Context, details []*pb.RpcObjectSetDetailsDetail, showEvent bool) (err error) {
+	keys := make([]string, 0, len(details))
+	for _, detail := range details {
+		keys = append(keys, detail.Key)
+	}
+	if err = bs.Restrictions().Lock.CheckDetails(keys...); err != nil {
+		return
+	}
 	s := bs.NewStateCtx(ctx)
 
 	// Collect updates handling special cases. These cases could update details themselves, so we
@@ -34,6 +41,12 @@ func (bs *basic) SetDetails(ctx *session.Context, details []*pb.RpcObjectSetDeta
 	newDetails := applyDetailUpdates(s.CombinedDetails(), updates)
 	s.SetDetails(newDetails)
 
+	if len(updates) > 0 {
+		if err = bs.relationService.ValidateConstraints(s.ObjectTypes(), s.CombinedDetails(), constraintKeys(updates)...); err != nil {
+			return
+		}
+	}
+
 	if err = bs.Apply(s, smartblock.NoRestrictions); err != nil {
 		return
 	}
@@ -55,6 +68,19 @@ func (bs *basic) collectDetailUpdates(details []*pb.RpcObjectSetDetailsDetail, s
 	return updates
 }
 
+// constraintKeys returns the keys whose constraints should be checked after the updates,
+// nil means all constraints, because the change of the type brings the constraints of the new type
+func constraintKeys(updates []*detailUpdate) []string {
+	keys := make([]string, 0, len(updates))
+	for _, update := range updates {
+		if update.key == bundle.RelationKeyType.String() {
+			return nil
+		}
+		keys = append(keys, update.key)
+	}
+	return keys
+}
+
 func applyDetailUpdates(oldDetails *types.Struct, updates []*detailUpdate) *types.Struct {
 	newDetails := pbtypes.CopyStruct(oldDetails)
 	if newDetails == nil || newDetails.Fields == nil {
@@ -170,6 +196,10 @@ func (bs *basic) SetObjectTypes(ctx *session.Context, objectTypes []string) (err
 		return
 	}
 
+	if err = bs.relationService.ValidateConstraints(objectTypes, s.CombinedDetails()); err != nil {
+		return
+	}
+
 	flags := internalflag.NewFromState(s)
 	flags.Remove(model.InternalFlag_editorSelectType)
 	flags.AddToState(s)
@@ -68,11 +68,12 @@ type File interface {
 }
 
 type FileSource struct {
-	Path    string
-	Url     string
-	Bytes   []byte
-	Name    string
-	GroupId string
+	Path             string
+	Url              string
+	Bytes            []byte
+	Name             string
+	GroupId          string
+	ImageEditOptions *model.ImageEditOptions
 }
 
 type sfile struct {
@@ -162,6 +163,9 @@ func (sf *sfile) upload(s *state.State, id string, source FileSource, isSync boo
 			SetName(source.Name).
 			SetLastModifiedDate()
 	}
+	if source.ImageEditOptions != nil {
+		upl.AddOptions(files.WithImageEditOptions(source.ImageEditOptions))
+	}
 	if isSync {
 		return upl.Upload(context.TODO())
 	} else {
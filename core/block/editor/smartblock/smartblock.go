@@ -54,6 +54,7 @@ const (
 	NoHooks
 	DoSnapshot
 	SkipIfNoChanges
+	NoAfterApplyHooks
 )
 
 type Hook int
@@ -621,6 +622,7 @@ func (sb *smartBlock) Apply(s *state.State, flags ...ApplyFlag) (err error) {
 		doSnapshot        = false
 		checkRestrictions = true
 		hooks             = true
+		afterApplyHooks   = true
 		skipIfNoChanges   = false
 	)
 	for _, f := range flags {
@@ -637,6 +639,8 @@ func (sb *smartBlock) Apply(s *state.State, flags ...ApplyFlag) (err error) {
 			hooks = false
 		case SkipIfNoChanges:
 			skipIfNoChanges = true
+		case NoAfterApplyHooks:
+			afterApplyHooks = false
 		}
 	}
 
@@ -650,6 +654,11 @@ func (sb *smartBlock) Apply(s *state.State, flags ...ApplyFlag) (err error) {
 			return
 		}
 	}
+	if checkRestrictions {
+		if err = s.CheckLock(sb.restrictions.Lock != nil, sb.restrictions.Lock.GetEditableRelations()); err != nil {
+			return
+		}
+	}
 
 	var lastModified = time.Now()
 	if s.ParentState() != nil && s.ParentState().IsTheHeaderChange() {
@@ -767,7 +776,7 @@ func (sb *smartBlock) Apply(s *state.State, flags ...ApplyFlag) (err error) {
 		sb.CheckSubscriptions()
 	}
 	afterReportChangeTime := time.Now()
-	if hooks {
+	if hooks && afterApplyHooks {
 		if e := sb.execHooks(HookAfterApply, ApplyInfo{State: sb.Doc.(*state.State), Events: msgs, Changes: changes}); e != nil {
 			log.With("objectID", sb.Id()).Warnf("after apply execHooks error: %v", e)
 		}
@@ -889,6 +898,17 @@ func (sb *smartBlock) injectLinksDetails(s *state.State) {
 	s.SetLocalDetail(bundle.RelationKeyLinks.String(), pbtypes.StringList(links))
 }
 
+// injectConstraintViolations sets the relations violating the constraints of the object type,
+// so the objects with validation errors can be found by the filter
+func (sb *smartBlock) injectConstraintViolations(s *state.State) {
+	violations := sb.relationService.ConstraintViolations(s.ObjectTypes(), s.CombinedDetails())
+	if len(violations) == 0 {
+		s.RemoveLocalDetail(bundle.RelationKeyConstraintViolations.String())
+		return
+	}
+	s.SetLocalDetail(bundle.RelationKeyConstraintViolations.String(), pbtypes.StringList(relation.ViolationsRelationIDs(violations)))
+}
+
 func (sb *smartBlock) injectLocalDetails(s *state.State) error {
 	if pbtypes.GetString(s.LocalDetails(), bundle.RelationKeyWorkspaceId.String()) == "" {
 		wsId, err := sb.coreService.GetWorkspaceIdForObject(sb.Id())
@@ -1030,6 +1050,7 @@ func (sb *smartBlock) StateRebuild(d state.Doc) (err error) {
 	if err != nil {
 		log.Errorf("failed to inject local details in StateRebuild: %v", err)
 	}
+	sb.injectConstraintViolations(d.(*state.State))
 	d.(*state.State).SetParent(sb.Doc.(*state.State))
 	// todo: make store diff
 	sb.execHooks(HookBeforeApply, ApplyInfo{State: d.(*state.State)})
@@ -1048,6 +1069,8 @@ func (sb *smartBlock) StateRebuild(d state.Doc) (err error) {
 	}
 	sb.storeFileKeys(d)
 	sb.CheckSubscriptions()
+	// the lock may be changed remotely
+	sb.updateRestrictions()
 	sb.runIndexer(sb.Doc.(*state.State))
 	sb.execHooks(HookAfterApply, ApplyInfo{State: sb.Doc.(*state.State), Events: msgs, Changes: d.(*state.State).GetChanges()})
 	return nil
@@ -1297,6 +1320,7 @@ func (sb *smartBlock) onApply(s *state.State) {
 
 	sb.setRestrictionsDetail(s)
 	sb.injectLinksDetails(s)
+	sb.injectConstraintViolations(s)
 }
 
 func (sb *smartBlock) setRestrictionsDetail(s *state.State) {
@@ -122,6 +122,7 @@ func newFixture(t *testing.T) *fixture {
 
 	restrictionService := restriction.New(nil, objectStore)
 	relationService := mockRelation.NewMockService(ctrl)
+	relationService.EXPECT().ConstraintViolations(gomock.Any(), gomock.Any()).AnyTimes()
 
 	fileService := testMock.NewMockFileService(ctrl)
 
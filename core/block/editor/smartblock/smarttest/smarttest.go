@@ -220,7 +220,8 @@ func (st *SmartTest) Apply(s *state.State, flags ...smartblock.ApplyFlag) (err e
 			addHistory = false
 		case smartblock.NoRestrictions:
 			checkRestrictions = false
-		case smartblock.NoHooks:
+		case smartblock.NoHooks, smartblock.NoAfterApplyHooks:
+			// the hooks are run after the apply regardless of their events
 			hooks = false
 		}
 	}
@@ -275,8 +275,11 @@ func (s *State) changeBlockDetailsUnset(unset *pb.ChangeDetailsUnset) error {
 func (s *State) changeRelationAdd(add *pb.ChangeRelationAdd) error {
 	rl := s.GetRelationLinks()
 	for _, r := range add.RelationLinks {
-		if !rl.Has(r.Key) {
+		if l := rl.Get(r.Key); l == nil {
 			rl = rl.Append(r)
+		} else {
+			// the relation format was changed
+			l.Format = r.Format
 		}
 	}
 	s.relationLinks = rl
@@ -540,6 +543,10 @@ func (s *State) fillChanges(msgs []simple.EventMessage) {
 			updMsgs = append(updMsgs, msg.Msg)
 		case *pb.EventMessageValueOfBlockSetWidget:
 			updMsgs = append(updMsgs, msg.Msg)
+		case *pb.EventMessageValueOfBlockSetSynced:
+			updMsgs = append(updMsgs, msg.Msg)
+		case *pb.EventMessageValueOfBlockSetDiagram:
+			updMsgs = append(updMsgs, msg.Msg)
 		case *pb.EventMessageValueOfBlockDelete:
 			delIds = append(delIds, o.BlockDelete.BlockIds...)
 		case *pb.EventMessageValueOfBlockAdd:
@@ -729,6 +729,22 @@ func TestRootBlockChanges(t *testing.T) {
 	assert.Equal(t, a.String(), be.String())
 }
 
+func TestState_ChangeRelationFormat(t *testing.T) {
+	newDoc := func() *State {
+		d := NewDoc("root", map[string]simple.Block{"root": simple.New(&model.Block{Id: "root"})}).(*State)
+		d.AddRelationLinks(&model.RelationLink{Key: "priority", Format: model.RelationFormat_shorttext})
+		return d
+	}
+	s := newDoc().NewState()
+	s.GetRelationLinks().Get("priority").Format = model.RelationFormat_status
+	_, _, err := ApplyState(s, true)
+	require.NoError(t, err)
+
+	d := newDoc()
+	require.NoError(t, d.ApplyChange(s.GetChanges()...))
+	assert.Equal(t, model.RelationFormat_status, d.GetRelationLinks().Get("priority").Format)
+}
+
 func newMoveChange(targetId string, pos model.BlockPosition, ids ...string) *pb.ChangeContent {
 	return &pb.ChangeContent{
 		Value: &pb.ChangeContentValueOfBlockMove{
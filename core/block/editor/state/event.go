@@ -9,10 +9,12 @@ import (
 	"github.com/anyproto/anytype-heart/core/block/simple/base"
 	"github.com/anyproto/anytype-heart/core/block/simple/bookmark"
 	"github.com/anyproto/anytype-heart/core/block/simple/dataview"
+	"github.com/anyproto/anytype-heart/core/block/simple/diagram"
 	"github.com/anyproto/anytype-heart/core/block/simple/file"
 	"github.com/anyproto/anytype-heart/core/block/simple/latex"
 	"github.com/anyproto/anytype-heart/core/block/simple/link"
 	"github.com/anyproto/anytype-heart/core/block/simple/relation"
+	"github.com/anyproto/anytype-heart/core/block/simple/synced"
 	"github.com/anyproto/anytype-heart/core/block/simple/table"
 	"github.com/anyproto/anytype-heart/core/block/simple/text"
 	"github.com/anyproto/anytype-heart/core/block/simple/widget"
@@ -96,6 +98,13 @@ func (s *State) applyEvent(ev *pb.EventMessage) (err error) {
 		}); err != nil {
 			return
 		}
+	case *pb.EventMessageValueOfBlockSetRestrictions:
+		if err = apply(o.BlockSetRestrictions.Id, func(b simple.Block) error {
+			b.Model().Restrictions = o.BlockSetRestrictions.Restrictions
+			return nil
+		}); err != nil {
+			return
+		}
 	case *pb.EventMessageValueOfBlockSetFile:
 		if err = apply(o.BlockSetFile.Id, func(b simple.Block) error {
 			if f, ok := b.(file.Block); ok {
@@ -283,6 +292,24 @@ func (s *State) applyEvent(ev *pb.EventMessage) (err error) {
 		}); err != nil {
 			return
 		}
+	case *pb.EventMessageValueOfBlockSetSynced:
+		if err = apply(o.BlockSetSynced.Id, func(b simple.Block) error {
+			if sb, ok := b.(synced.Block); ok {
+				return sb.ApplyEvent(o.BlockSetSynced)
+			}
+			return fmt.Errorf("not a synced block")
+		}); err != nil {
+			return
+		}
+	case *pb.EventMessageValueOfBlockSetDiagram:
+		if err = apply(o.BlockSetDiagram.Id, func(b simple.Block) error {
+			if db, ok := b.(diagram.Block); ok {
+				return db.ApplyEvent(o.BlockSetDiagram)
+			}
+			return fmt.Errorf("not a diagram block")
+		}); err != nil {
+			return
+		}
 	case *pb.EventMessageValueOfBlockDataviewTargetObjectIdSet:
 		if err = apply(o.BlockDataviewTargetObjectIdSet.Id, func(b simple.Block) error {
 			if dvBlock, ok := b.(dataview.Block); ok {
@@ -0,0 +1,103 @@
+package state
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/block/simple/text"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+	"github.com/anyproto/anytype-heart/util/slice"
+)
+
+// BlockLockFieldName is the field of the block locked by the user. The field separates the user's lock
+// from the restrictions of the layout blocks, like the header
+const BlockLockFieldName = "_locked"
+
+func IsBlockLocked(b simple.Block) bool {
+	return pbtypes.GetBool(b.Model().GetFields(), BlockLockFieldName)
+}
+
+// SetBlockLock locks or unlocks the block. The locked block can't be edited, removed or moved, the block with
+// the restrictions set by the layout can't be locked
+func SetBlockLock(b simple.Block, locked bool) error {
+	m := b.Model()
+	if IsBlockLocked(b) == locked {
+		return nil
+	}
+	if locked && m.Restrictions != nil && *m.Restrictions != (model.BlockRestrictions{}) {
+		return fmt.Errorf("block %s has the restrictions of the layout", m.Id)
+	}
+	m.Fields = pbtypes.CopyStruct(m.Fields)
+	if locked {
+		if m.Fields == nil {
+			m.Fields = &types.Struct{Fields: map[string]*types.Value{}}
+		}
+		m.Fields.Fields[BlockLockFieldName] = pbtypes.Bool(true)
+		m.Restrictions = &model.BlockRestrictions{Edit: true, Remove: true, Drag: true}
+	} else {
+		delete(m.Fields.Fields, BlockLockFieldName)
+		m.Restrictions = nil
+	}
+	return nil
+}
+
+// CheckLock checks the state doesn't change the blocks locked by the user. No block or detail of the locked object
+// can be changed except the editable details and the blocks bound to them
+func (s *State) CheckLock(objectLocked bool, editableDetails []string) error {
+	if s.parent == nil {
+		return nil
+	}
+	if objectLocked && s.details != nil {
+		if diff := pbtypes.StructDiff(s.parent.Details(), s.details); diff != nil {
+			for key := range diff.Fields {
+				if slice.FindPos(editableDetails, key) == -1 {
+					return fmt.Errorf("%w: object is locked, %s can't be changed", ErrRestricted, key)
+				}
+			}
+		}
+	}
+	for id, b := range s.blocks {
+		prev := s.parent.Pick(id)
+		if prev == nil {
+			if objectLocked {
+				return fmt.Errorf("%w: object is locked", ErrRestricted)
+			}
+			continue
+		}
+		// the locked block can't be removed or moved
+		for _, childID := range prev.Model().ChildrenIds {
+			if slice.FindPos(b.Model().ChildrenIds, childID) != -1 {
+				continue
+			}
+			if child := s.parent.Pick(childID); child != nil && IsBlockLocked(child) {
+				return fmt.Errorf("%w: block %s is locked", ErrRestricted, childID)
+			}
+		}
+		if !IsBlockLocked(prev) && (!objectLocked || isEditableDetailsBlock(b, editableDetails)) {
+			continue
+		}
+		if msgs, _ := prev.Diff(b); len(msgs) > 0 {
+			if IsBlockLocked(prev) {
+				return fmt.Errorf("%w: block %s is locked", ErrRestricted, id)
+			}
+			return fmt.Errorf("%w: object is locked", ErrRestricted)
+		}
+	}
+	return nil
+}
+
+func isEditableDetailsBlock(b simple.Block, editableDetails []string) bool {
+	keys := pbtypes.GetStringList(b.Model().GetFields(), text.DetailsKeyFieldName)
+	if len(keys) == 0 {
+		return false
+	}
+	for _, key := range keys {
+		if slice.FindPos(editableDetails, key) == -1 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,121 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/block/simple/text"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func newLockTestDoc() *State {
+	return NewDoc("root", map[string]simple.Block{
+		"root": simple.New(&model.Block{Id: "root", ChildrenIds: []string{"title", "first", "second"}}),
+		"title": simple.New(&model.Block{
+			Id:      "title",
+			Fields:  &types.Struct{Fields: map[string]*types.Value{text.DetailsKeyFieldName: pbtypes.StringList([]string{"name"})}},
+			Content: &model.BlockContentOfText{Text: &model.BlockContentText{}},
+		}),
+		"first":  simple.New(&model.Block{Id: "first", Content: &model.BlockContentOfText{Text: &model.BlockContentText{Text: "first"}}}),
+		"second": simple.New(&model.Block{Id: "second", Content: &model.BlockContentOfText{Text: &model.BlockContentText{Text: "second"}}}),
+	}).(*State)
+}
+
+func TestSetBlockLock(t *testing.T) {
+	s := newLockTestDoc().NewState()
+	b := s.Get("first")
+	require.NoError(t, SetBlockLock(b, true))
+	assert.True(t, IsBlockLocked(b))
+	assert.Equal(t, &model.BlockRestrictions{Edit: true, Remove: true, Drag: true}, b.Model().Restrictions)
+	assert.False(t, IsBlockLocked(s.ParentState().Pick("first")))
+
+	require.NoError(t, SetBlockLock(b, false))
+	assert.False(t, IsBlockLocked(b))
+	assert.Nil(t, b.Model().Restrictions)
+
+	restricted := s.Get("second")
+	restricted.Model().Restrictions = &model.BlockRestrictions{Remove: true}
+	assert.Error(t, SetBlockLock(restricted, true))
+}
+
+func TestState_CheckLock(t *testing.T) {
+	lockedDoc := func() *State {
+		d := newLockTestDoc()
+		require.NoError(t, SetBlockLock(d.Pick("first"), true))
+		return d
+	}
+
+	t.Run("locked block", func(t *testing.T) {
+		s := lockedDoc().NewState()
+		s.Get("first").(text.Block).SetText("changed", nil)
+		assert.ErrorIs(t, s.CheckLock(false, nil), ErrRestricted)
+
+		s = lockedDoc().NewState()
+		s.Unlink("first")
+		assert.ErrorIs(t, s.CheckLock(false, nil), ErrRestricted)
+
+		s = lockedDoc().NewState()
+		s.Get("second").(text.Block).SetText("changed", nil)
+		s.Unlink("second")
+		assert.NoError(t, s.CheckLock(false, nil))
+	})
+
+	t.Run("locked object", func(t *testing.T) {
+		s := newLockTestDoc().NewState()
+		s.Get("second").(text.Block).SetText("changed", nil)
+		assert.ErrorIs(t, s.CheckLock(true, nil), ErrRestricted)
+
+		s = newLockTestDoc().NewState()
+		s.Add(simple.New(&model.Block{Id: "new"}))
+		require.NoError(t, s.InsertTo("second", model.Block_Bottom, "new"))
+		assert.ErrorIs(t, s.CheckLock(true, nil), ErrRestricted)
+
+		s = newLockTestDoc().NewState()
+		s.Get("title").(text.Block).SetText("new name", nil)
+		assert.ErrorIs(t, s.CheckLock(true, nil), ErrRestricted)
+		assert.NoError(t, s.CheckLock(true, []string{"name"}))
+
+		// blocks are only read
+		s = newLockTestDoc().NewState()
+		s.Get("second")
+		assert.NoError(t, s.CheckLock(true, nil))
+	})
+
+	t.Run("details of locked object", func(t *testing.T) {
+		s := newLockTestDoc().NewState()
+		s.SetDetail("status", pbtypes.String("done"))
+		assert.ErrorIs(t, s.CheckLock(true, nil), ErrRestricted)
+		assert.NoError(t, s.CheckLock(true, []string{"status"}))
+		assert.NoError(t, s.CheckLock(false, nil))
+
+		s = newLockTestDoc().NewState()
+		s.SetDetail("status", pbtypes.String("done"))
+		_, _, err := ApplyState(s, true)
+		require.NoError(t, err)
+		s = s.NewState()
+		s.RemoveDetail("status")
+		assert.ErrorIs(t, s.CheckLock(true, nil), ErrRestricted)
+
+		// local details aren't the part of the object
+		s = newLockTestDoc().NewState()
+		s.SetLocalDetail("lastOpenedDate", pbtypes.Int64(1))
+		assert.NoError(t, s.CheckLock(true, nil))
+	})
+}
+
+func TestState_ChangeBlockRestrictions(t *testing.T) {
+	s := newLockTestDoc().NewState()
+	require.NoError(t, SetBlockLock(s.Get("first"), true))
+	_, _, err := ApplyState(s, true)
+	require.NoError(t, err)
+
+	d := newLockTestDoc()
+	require.NoError(t, d.ApplyChange(s.GetChanges()...))
+	assert.True(t, IsBlockLocked(d.Pick("first")))
+	assert.Equal(t, &model.BlockRestrictions{Edit: true, Remove: true, Drag: true}, d.Pick("first").Model().Restrictions)
+}
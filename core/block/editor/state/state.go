@@ -39,6 +39,7 @@ const (
 	DataviewTemplatesBlockID = "templates"
 	FeaturedRelationsID      = "featuredRelations"
 	SettingsStoreKey         = "settings"
+	MovedBlocksStoreKey      = "movedBlocks"
 	SettingsAnalyticsId      = "analyticsID"
 )
 
@@ -1701,6 +1702,16 @@ func (s *State) SetSetting(name string, val *types.Value) {
 	s.SetInStore([]string{SettingsStoreKey, name}, val)
 }
 
+// SetMovedBlock remembers that the block was moved to another object, ref is the block reference in form of <objectId>#<blockId>
+func (s *State) SetMovedBlock(blockID string, ref string) {
+	s.SetInStore([]string{MovedBlocksStoreKey, blockID}, pbtypes.String(ref))
+}
+
+// GetMovedBlock returns the reference to the block that was moved to another object or empty string
+func (s *State) GetMovedBlock(blockID string) string {
+	return pbtypes.GetString(s.GetSubObjectCollection(MovedBlocksStoreKey), blockID)
+}
+
 func (s *State) Store() *types.Struct {
 	iterState := s
 	for iterState != nil && iterState.store == nil {
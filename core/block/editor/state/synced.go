@@ -0,0 +1,72 @@
+package state
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/anyproto/anytype-heart/core/block/simple/synced"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+var ErrNotSyncedSource = errors.New("block is not a source of synced content")
+
+// SyncedSubtreeGetter returns the source block of synced content with all its descendants,
+// source block goes first
+type SyncedSubtreeGetter func(objectID, blockID string) ([]*model.Block, error)
+
+// SyncedSubtree returns copies of the synced source block and all its descendants, source block goes first
+func (s *State) SyncedSubtree(sourceBlockID string) ([]*model.Block, error) {
+	b := s.Pick(sourceBlockID)
+	if b == nil {
+		return nil, fmt.Errorf("synced source block %s not found", sourceBlockID)
+	}
+	if sb, ok := b.(synced.Block); !ok || !sb.IsSource() {
+		return nil, ErrNotSyncedSource
+	}
+
+	blocks := []*model.Block{pbtypes.CopyBlock(b.Model())}
+	for _, child := range s.Descendants(sourceBlockID) {
+		blocks = append(blocks, pbtypes.CopyBlock(child.Model()))
+	}
+	return blocks, nil
+}
+
+// ResolveSyncedBlocks fills children of synced references with the content of their sources and appends
+// the content to the blocks. References nested into the synced content are resolved as well.
+// References with unavailable source are left without children
+func ResolveSyncedBlocks(blocks []*model.Block, getSubtree SyncedSubtreeGetter) []*model.Block {
+	present := make(map[string]struct{}, len(blocks))
+	for _, b := range blocks {
+		present[b.Id] = struct{}{}
+	}
+
+	resolved := map[string][]string{}
+	// blocks slice grows while we iterate over it, so nested references are resolved too
+	for i := 0; i < len(blocks); i++ {
+		ref := blocks[i].GetSynced()
+		if ref == nil || ref.TargetObjectId == "" {
+			continue
+		}
+		key := ref.TargetObjectId + "/" + ref.TargetBlockId
+		childrenIDs, ok := resolved[key]
+		if !ok {
+			subtree, err := getSubtree(ref.TargetObjectId, ref.TargetBlockId)
+			if err != nil {
+				log.With("objectID", ref.TargetObjectId, "blockID", ref.TargetBlockId).
+					Warnf("failed to resolve synced block: %s", err)
+			} else {
+				childrenIDs = subtree[0].ChildrenIds
+				for _, b := range subtree[1:] {
+					if _, exists := present[b.Id]; !exists {
+						present[b.Id] = struct{}{}
+						blocks = append(blocks, b)
+					}
+				}
+			}
+			resolved[key] = childrenIDs
+		}
+		blocks[i].ChildrenIds = childrenIDs
+	}
+	return blocks
+}
@@ -0,0 +1,118 @@
+package state
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+func syncedBlock(id, targetObjectID, targetBlockID string, childrenIDs ...string) *model.Block {
+	return &model.Block{
+		Id:          id,
+		ChildrenIds: childrenIDs,
+		Content: &model.BlockContentOfSynced{Synced: &model.BlockContentSynced{
+			TargetObjectId: targetObjectID,
+			TargetBlockId:  targetBlockID,
+		}},
+	}
+}
+
+func textBlock(id string, childrenIDs ...string) *model.Block {
+	return &model.Block{
+		Id:          id,
+		ChildrenIds: childrenIDs,
+		Content:     &model.BlockContentOfText{Text: &model.BlockContentText{Text: id}},
+	}
+}
+
+func newSyncedTestState(rootID string, blocks ...*model.Block) *State {
+	s := NewDoc(rootID, nil).NewState()
+	for _, b := range blocks {
+		s.Add(simple.New(b))
+	}
+	return s
+}
+
+func blockIDs(blocks []*model.Block) []string {
+	ids := make([]string, 0, len(blocks))
+	for _, b := range blocks {
+		ids = append(ids, b.Id)
+	}
+	return ids
+}
+
+func TestState_SyncedSubtree(t *testing.T) {
+	s := newSyncedTestState("source",
+		&model.Block{Id: "source", ChildrenIds: []string{"synced", "other"}},
+		syncedBlock("synced", "", "", "item1", "item2"),
+		textBlock("item1", "nested"),
+		textBlock("nested"),
+		textBlock("item2"),
+		textBlock("other"),
+	)
+
+	t.Run("source", func(t *testing.T) {
+		blocks, err := s.SyncedSubtree("synced")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"synced", "item1", "item2", "nested"}, blockIDs(blocks))
+	})
+
+	t.Run("not a source", func(t *testing.T) {
+		_, err := s.SyncedSubtree("other")
+		assert.ErrorIs(t, err, ErrNotSyncedSource)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := s.SyncedSubtree("missing")
+		assert.Error(t, err)
+	})
+}
+
+func TestResolveSyncedBlocks(t *testing.T) {
+	sources := map[string]*State{
+		"checklist": newSyncedTestState("checklist",
+			&model.Block{Id: "checklist", ChildrenIds: []string{"dod"}},
+			syncedBlock("dod", "", "", "tests", "docs", "nestedRef"),
+			textBlock("tests"),
+			textBlock("docs"),
+			syncedBlock("nestedRef", "common", "footer"),
+		),
+		"common": newSyncedTestState("common",
+			&model.Block{Id: "common", ChildrenIds: []string{"footer"}},
+			syncedBlock("footer", "", "", "signature"),
+			textBlock("signature"),
+		),
+	}
+	getSubtree := func(objectID, blockID string) ([]*model.Block, error) {
+		s, ok := sources[objectID]
+		if !ok {
+			return nil, errors.New("object not found")
+		}
+		return s.SyncedSubtree(blockID)
+	}
+
+	page := newSyncedTestState("page",
+		&model.Block{Id: "page", ChildrenIds: []string{"title", "ref1", "ref2", "broken"}},
+		textBlock("title"),
+		syncedBlock("ref1", "checklist", "dod"),
+		syncedBlock("ref2", "checklist", "dod"),
+		syncedBlock("broken", "deleted", "block"),
+	)
+
+	blocks := ResolveSyncedBlocks(page.Blocks(), getSubtree)
+
+	assert.Equal(t, []string{"page", "title", "ref1", "ref2", "broken", "tests", "docs", "nestedRef", "signature"}, blockIDs(blocks))
+	byID := map[string]*model.Block{}
+	for _, b := range blocks {
+		byID[b.Id] = b
+	}
+	assert.Equal(t, []string{"tests", "docs", "nestedRef"}, byID["ref1"].ChildrenIds)
+	assert.Equal(t, []string{"tests", "docs", "nestedRef"}, byID["ref2"].ChildrenIds)
+	assert.Equal(t, []string{"signature"}, byID["nestedRef"].ChildrenIds)
+	assert.Empty(t, byID["broken"].ChildrenIds)
+}
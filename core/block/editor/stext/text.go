@@ -270,7 +270,9 @@ func (t *textImpl) flushSetTextState(_ smartblock.ApplyInfo) error {
 	if t.lastSetTextState != nil {
 		ctx := session.NewChildContext(t.lastSetTextState.Context())
 		t.lastSetTextState.SetContext(ctx)
-		if err := t.Apply(t.lastSetTextState, smartblock.NoHooks); err != nil {
+		// the before apply hooks update the blocks depending on the text, e.g. the formulas of the table cells.
+		// The after apply hooks may start the new state, which flushes the text again
+		if err := t.Apply(t.lastSetTextState, smartblock.NoAfterApplyHooks); err != nil {
 			log.Errorf("can't apply setText state: %v", err)
 		}
 		msgs := ctx.GetMessages()
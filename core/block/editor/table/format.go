@@ -0,0 +1,167 @@
+package table
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+// dateLayouts are the formats of the dates recognized in the cells
+var dateLayouts = []string{
+	"2006-01-02",
+	"2006-01-02 15:04",
+	"2006-01-02 15:04:05",
+	time.RFC3339,
+	"02.01.2006",
+	"02.01.2006 15:04",
+	"01/02/2006",
+	"01/02/2006 15:04",
+	"Jan 2, 2006",
+	"January 2, 2006",
+	"2 Jan 2006",
+	"2 January 2006",
+}
+
+// DetectFormat returns the relation format of the column by the texts of its cells, empty cells are skipped
+func DetectFormat(values []string) model.RelationFormat {
+	var filled int
+	is := map[model.RelationFormat]bool{
+		model.RelationFormat_checkbox: true,
+		model.RelationFormat_number:   true,
+		model.RelationFormat_date:     true,
+		model.RelationFormat_url:      true,
+	}
+	for _, v := range values {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+		filled++
+		if _, ok := ParseCheckbox(v); !ok {
+			is[model.RelationFormat_checkbox] = false
+		}
+		if _, ok := ParseNumber(v); !ok {
+			is[model.RelationFormat_number] = false
+		}
+		if _, ok := ParseDate(v); !ok {
+			is[model.RelationFormat_date] = false
+		}
+		if !isURL(v) {
+			is[model.RelationFormat_url] = false
+		}
+	}
+	if filled == 0 {
+		return model.RelationFormat_longtext
+	}
+	for _, format := range []model.RelationFormat{
+		model.RelationFormat_checkbox,
+		model.RelationFormat_number,
+		model.RelationFormat_date,
+		model.RelationFormat_url,
+	} {
+		if is[format] {
+			return format
+		}
+	}
+	return model.RelationFormat_longtext
+}
+
+// CellValue converts the text of the cell to the value of the relation with the format, nil is returned for the empty cells
+func CellValue(format model.RelationFormat, text string) *types.Value {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil
+	}
+	switch format {
+	case model.RelationFormat_checkbox:
+		v, _ := ParseCheckbox(text)
+		return pbtypes.Bool(v)
+	case model.RelationFormat_number:
+		if n, ok := ParseNumber(text); ok {
+			return pbtypes.Float64(n)
+		}
+		return nil
+	case model.RelationFormat_date:
+		if t, ok := ParseDate(text); ok {
+			return pbtypes.Int64(t.Unix())
+		}
+		return nil
+	}
+	return pbtypes.String(text)
+}
+
+// ParseNumber parses the number written in the cell, the thousands may be separated by commas or spaces
+func ParseNumber(v string) (float64, bool) {
+	v = strings.NewReplacer(",", "", " ", "", " ", "").Replace(strings.TrimSpace(v))
+	n, err := strconv.ParseFloat(v, 64)
+	return n, err == nil
+}
+
+func ParseDate(v string) (time.Time, bool) {
+	v = strings.TrimSpace(v)
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, v); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func ParseCheckbox(v string) (checked bool, ok bool) {
+	switch strings.ToLower(strings.TrimSpace(v)) {
+	case "true", "yes", "✓", "✔", "[x]":
+		return true, true
+	case "false", "no", "[ ]":
+		return false, true
+	}
+	return false, false
+}
+
+func isURL(v string) bool {
+	u, err := url.Parse(v)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != "" && !strings.ContainsAny(v, " \n")
+}
+
+// CellText formats the value of the relation to put it into the cell, name returns the name of the object by its id,
+// it is used for the tags, statuses, files and objects
+func CellText(format model.RelationFormat, v *types.Value, name func(id string) string) string {
+	if v == nil {
+		return ""
+	}
+	switch format {
+	case model.RelationFormat_checkbox:
+		if v.GetBoolValue() {
+			return "✓"
+		}
+		return ""
+	case model.RelationFormat_number:
+		if _, ok := v.Kind.(*types.Value_NumberValue); !ok {
+			return ""
+		}
+		return strconv.FormatFloat(v.GetNumberValue(), 'f', -1, 64)
+	case model.RelationFormat_date:
+		if v.GetNumberValue() == 0 {
+			return ""
+		}
+		return time.Unix(int64(v.GetNumberValue()), 0).UTC().Format(dateLayouts[0])
+	case model.RelationFormat_tag, model.RelationFormat_status, model.RelationFormat_object, model.RelationFormat_file:
+		ids := pbtypes.GetStringListValue(v)
+		names := make([]string, 0, len(ids))
+		for _, id := range ids {
+			if n := name(id); n != "" {
+				names = append(names, n)
+			}
+		}
+		return strings.Join(names, ", ")
+	}
+	if v.GetListValue() != nil {
+		return strings.Join(pbtypes.GetStringListValue(v), ", ")
+	}
+	return v.GetStringValue()
+}
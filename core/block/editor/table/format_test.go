@@ -0,0 +1,48 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func TestDetectFormat(t *testing.T) {
+	for _, tc := range []struct {
+		values []string
+		want   model.RelationFormat
+	}{
+		{values: []string{"1", "2.5", "", "1,000"}, want: model.RelationFormat_number},
+		{values: []string{"2023-10-19", "19.10.2023", "Oct 19, 2023"}, want: model.RelationFormat_date},
+		{values: []string{"https://anytype.io", "http://example.com/page"}, want: model.RelationFormat_url},
+		{values: []string{"yes", "no", "✓"}, want: model.RelationFormat_checkbox},
+		{values: []string{"1", "two"}, want: model.RelationFormat_longtext},
+		{values: []string{"", " "}, want: model.RelationFormat_longtext},
+	} {
+		assert.Equal(t, tc.want, DetectFormat(tc.values), tc.values)
+	}
+}
+
+func TestCellValue(t *testing.T) {
+	assert.Equal(t, pbtypes.Float64(1000), CellValue(model.RelationFormat_number, "1,000"))
+	assert.Equal(t, pbtypes.Int64(1697673600), CellValue(model.RelationFormat_date, "2023-10-19"))
+	assert.Equal(t, pbtypes.Bool(true), CellValue(model.RelationFormat_checkbox, "[x]"))
+	assert.Equal(t, pbtypes.String("text"), CellValue(model.RelationFormat_longtext, " text "))
+	assert.Nil(t, CellValue(model.RelationFormat_number, ""))
+}
+
+func TestCellText(t *testing.T) {
+	names := map[string]string{"tag1": "work", "tag2": "home"}
+	name := func(id string) string { return names[id] }
+
+	assert.Equal(t, "1000.5", CellText(model.RelationFormat_number, pbtypes.Float64(1000.5), name))
+	assert.Equal(t, "2023-10-19", CellText(model.RelationFormat_date, pbtypes.Int64(1697673600), name))
+	assert.Equal(t, "✓", CellText(model.RelationFormat_checkbox, pbtypes.Bool(true), name))
+	assert.Equal(t, "work, home", CellText(model.RelationFormat_tag, pbtypes.StringList([]string{"tag1", "tag2", "deleted"}), name))
+	assert.Equal(t, "", CellText(model.RelationFormat_shorttext, nil, name))
+
+	// the texts of the cells are converted back to the same values
+	assert.Equal(t, pbtypes.Int64(1697673600), CellValue(model.RelationFormat_date, CellText(model.RelationFormat_date, pbtypes.Int64(1697673600), name)))
+}
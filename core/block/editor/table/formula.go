@@ -0,0 +1,748 @@
+package table
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+// FormulaPrefix starts the text of the cell with formula, e.g. =SUM(B2:B9)
+const FormulaPrefix = "="
+
+// FormulaResultField is the field of the cell block where the result of the formula is cached,
+// the text of the cell keeps the formula itself
+const FormulaResultField = "formulaResult"
+
+// The values of the formulas which can't be calculated
+const (
+	formulaErrSyntax    = "#ERROR!"
+	formulaErrRef       = "#REF!"
+	formulaErrValue     = "#VALUE!"
+	formulaErrDivByZero = "#DIV/0!"
+	formulaErrName      = "#NAME?"
+	formulaErrCycle     = "#CYCLE!"
+)
+
+func IsFormula(text string) bool {
+	return strings.HasPrefix(text, FormulaPrefix) && len(strings.TrimSpace(text)) > len(FormulaPrefix)
+}
+
+// cellShownText returns the cached result of the formula in the cell, the text of the cell otherwise
+func cellShownText(cell *model.Block) string {
+	text := cell.GetText().GetText()
+	if !IsFormula(text) {
+		return text
+	}
+	if result, ok := cell.GetFields().GetFields()[FormulaResultField]; ok {
+		return result.GetStringValue()
+	}
+	return text
+}
+
+type valueKind int
+
+const (
+	valueEmpty valueKind = iota
+	valueNumber
+	valueText
+	valueBool
+	valueError
+	valueRange
+)
+
+// formulaValue is the value of the expression, the ranges are only accepted by the functions
+type formulaValue struct {
+	kind   valueKind
+	num    float64
+	text   string
+	values []formulaValue
+}
+
+func numberValue(n float64) formulaValue {
+	if math.IsNaN(n) || math.IsInf(n, 0) {
+		return errorValue(formulaErrValue)
+	}
+	return formulaValue{kind: valueNumber, num: n}
+}
+
+func textValue(s string) formulaValue {
+	return formulaValue{kind: valueText, text: s}
+}
+
+func boolValue(b bool) formulaValue {
+	return formulaValue{kind: valueBool, num: boolToFloat(b)}
+}
+
+func errorValue(code string) formulaValue {
+	return formulaValue{kind: valueError, text: code}
+}
+
+// cellTextValue returns the value of the cell without formula: the numbers are recognized as in the sorting of the table
+func cellTextValue(text string) formulaValue {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return formulaValue{}
+	}
+	if n, ok := ParseNumber(text); ok {
+		return numberValue(n)
+	}
+	return textValue(text)
+}
+
+func (v formulaValue) String() string {
+	switch v.kind {
+	case valueNumber:
+		// the results like 0.1+0.2 are rounded to avoid the artifacts of floating point numbers
+		return strconv.FormatFloat(math.Round(v.num*1e10)/1e10, 'f', -1, 64)
+	case valueBool:
+		if v.num != 0 {
+			return "TRUE"
+		}
+		return "FALSE"
+	case valueRange:
+		return formulaErrValue
+	}
+	return v.text
+}
+
+func (v formulaValue) toNumber() (float64, *formulaValue) {
+	switch v.kind {
+	case valueEmpty:
+		return 0, nil
+	case valueNumber, valueBool:
+		return v.num, nil
+	case valueText:
+		if n, ok := ParseNumber(v.text); ok {
+			return n, nil
+		}
+	case valueError:
+		return 0, &v
+	}
+	err := errorValue(formulaErrValue)
+	return 0, &err
+}
+
+func (v formulaValue) toBool() (bool, *formulaValue) {
+	if v.kind == valueText {
+		switch strings.ToUpper(v.text) {
+		case "TRUE":
+			return true, nil
+		case "FALSE":
+			return false, nil
+		}
+	}
+	n, err := v.toNumber()
+	return n != 0, err
+}
+
+// flatten returns the values of the range or the value itself
+func (v formulaValue) flatten() []formulaValue {
+	if v.kind == valueRange {
+		return v.values
+	}
+	return []formulaValue{v}
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// cellRef is the position of the cell in A1 notation: the column is the letter and the row is the number starting with 1
+type cellRef struct {
+	row, col int
+}
+
+// parseCellRef parses A1 style reference, the absolute references like $A$1 are accepted as well
+func parseCellRef(s string) (cellRef, bool) {
+	s = strings.ToUpper(strings.ReplaceAll(s, "$", ""))
+	i := 0
+	col := 0
+	for i < len(s) && s[i] >= 'A' && s[i] <= 'Z' {
+		col = col*26 + int(s[i]-'A'+1)
+		i++
+	}
+	if i == 0 || i == len(s) || col > 1<<16 {
+		return cellRef{}, false
+	}
+	row, err := strconv.Atoi(s[i:])
+	if err != nil || row < 1 || s[i] == '+' || s[i] == '-' {
+		return cellRef{}, false
+	}
+	return cellRef{row: row - 1, col: col - 1}, true
+}
+
+type tokenKind int
+
+const (
+	tokenNumber tokenKind = iota
+	tokenString
+	tokenIdent
+	tokenOperator
+	tokenEnd
+)
+
+type token struct {
+	kind tokenKind
+	text string
+}
+
+var formulaOperators = []string{"<=", ">=", "<>", "+", "-", "*", "/", "^", "&", "=", "<", ">", "(", ")", ",", ";", ":"}
+
+func tokenize(s string) ([]token, error) {
+	var tokens []token
+	rs := []rune(s)
+	for i := 0; i < len(rs); {
+		r := rs[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case unicode.IsDigit(r) || (r == '.' && i+1 < len(rs) && unicode.IsDigit(rs[i+1])):
+			start := i
+			for i < len(rs) && (unicode.IsDigit(rs[i]) || rs[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: string(rs[start:i])})
+		case r == '"':
+			var sb strings.Builder
+			i++
+			for {
+				if i >= len(rs) {
+					return nil, fmt.Errorf("unterminated string")
+				}
+				// the quote is escaped by doubling it
+				if rs[i] == '"' {
+					if i+1 < len(rs) && rs[i+1] == '"' {
+						sb.WriteRune('"')
+						i += 2
+						continue
+					}
+					i++
+					break
+				}
+				sb.WriteRune(rs[i])
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenString, text: sb.String()})
+		case unicode.IsLetter(r) || r == '$' || r == '_':
+			start := i
+			for i < len(rs) && (unicode.IsLetter(rs[i]) || unicode.IsDigit(rs[i]) || rs[i] == '$' || rs[i] == '_' || rs[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenIdent, text: string(rs[start:i])})
+		default:
+			var op string
+			for _, o := range formulaOperators {
+				if strings.HasPrefix(string(rs[i:]), o) {
+					op = o
+					break
+				}
+			}
+			if op == "" {
+				return nil, fmt.Errorf("unexpected symbol %q", r)
+			}
+			tokens = append(tokens, token{kind: tokenOperator, text: op})
+			i += len([]rune(op))
+		}
+	}
+	return append(tokens, token{kind: tokenEnd}), nil
+}
+
+// formulaNode is the node of the parsed formula, the function arguments are evaluated lazily to support IF
+type formulaNode interface {
+	eval(e *formulaEvaluator) formulaValue
+}
+
+type (
+	literalNode struct{ value formulaValue }
+	refNode     struct{ ref cellRef }
+	rangeNode   struct{ from, to cellRef }
+	unaryNode   struct {
+		op      string
+		operand formulaNode
+	}
+	binaryNode struct {
+		op          string
+		left, right formulaNode
+	}
+	callNode struct {
+		name string
+		args []formulaNode
+	}
+)
+
+type formulaParser struct {
+	tokens []token
+	pos    int
+}
+
+func parseFormula(formula string) (formulaNode, error) {
+	tokens, err := tokenize(strings.TrimPrefix(strings.TrimSpace(formula), FormulaPrefix))
+	if err != nil {
+		return nil, err
+	}
+	p := &formulaParser{tokens: tokens}
+	node, err := p.parseComparison()
+	if err != nil {
+		return nil, err
+	}
+	if p.peek().kind != tokenEnd {
+		return nil, fmt.Errorf("unexpected %q", p.peek().text)
+	}
+	return node, nil
+}
+
+func (p *formulaParser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *formulaParser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEnd {
+		p.pos++
+	}
+	return t
+}
+
+// acceptOperator consumes the next token if it's one of the operators
+func (p *formulaParser) acceptOperator(ops ...string) (string, bool) {
+	t := p.peek()
+	if t.kind != tokenOperator {
+		return "", false
+	}
+	for _, op := range ops {
+		if t.text == op {
+			p.pos++
+			return op, true
+		}
+	}
+	return "", false
+}
+
+func (p *formulaParser) parseBinary(operand func() (formulaNode, error), ops ...string) (formulaNode, error) {
+	left, err := operand()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op, ok := p.acceptOperator(ops...)
+		if !ok {
+			return left, nil
+		}
+		right, err := operand()
+		if err != nil {
+			return nil, err
+		}
+		left = binaryNode{op: op, left: left, right: right}
+	}
+}
+
+func (p *formulaParser) parseComparison() (formulaNode, error) {
+	return p.parseBinary(p.parseConcat, "=", "<>", "<=", ">=", "<", ">")
+}
+
+func (p *formulaParser) parseConcat() (formulaNode, error) {
+	return p.parseBinary(p.parseAdditive, "&")
+}
+
+func (p *formulaParser) parseAdditive() (formulaNode, error) {
+	return p.parseBinary(p.parseTerm, "+", "-")
+}
+
+func (p *formulaParser) parseTerm() (formulaNode, error) {
+	return p.parseBinary(p.parseUnary, "*", "/")
+}
+
+func (p *formulaParser) parseUnary() (formulaNode, error) {
+	if op, ok := p.acceptOperator("-", "+"); ok {
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return unaryNode{op: op, operand: operand}, nil
+	}
+	return p.parsePower()
+}
+
+func (p *formulaParser) parsePower() (formulaNode, error) {
+	base, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := p.acceptOperator("^"); ok {
+		exp, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return binaryNode{op: "^", left: base, right: exp}, nil
+	}
+	return base, nil
+}
+
+func (p *formulaParser) parsePrimary() (formulaNode, error) {
+	t := p.next()
+	switch t.kind {
+	case tokenNumber:
+		n, err := strconv.ParseFloat(t.text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q", t.text)
+		}
+		return literalNode{value: numberValue(n)}, nil
+	case tokenString:
+		return literalNode{value: textValue(t.text)}, nil
+	case tokenIdent:
+		if _, ok := p.acceptOperator("("); ok {
+			return p.parseCall(strings.ToUpper(t.text))
+		}
+		switch strings.ToUpper(t.text) {
+		case "TRUE":
+			return literalNode{value: boolValue(true)}, nil
+		case "FALSE":
+			return literalNode{value: boolValue(false)}, nil
+		}
+		from, ok := parseCellRef(t.text)
+		if !ok {
+			return literalNode{value: errorValue(formulaErrName)}, nil
+		}
+		if _, ok = p.acceptOperator(":"); !ok {
+			return refNode{ref: from}, nil
+		}
+		end := p.next()
+		to, ok := parseCellRef(end.text)
+		if end.kind != tokenIdent || !ok {
+			return nil, fmt.Errorf("invalid range end %q", end.text)
+		}
+		return rangeNode{from: from, to: to}, nil
+	case tokenOperator:
+		if t.text == "(" {
+			node, err := p.parseComparison()
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := p.acceptOperator(")"); !ok {
+				return nil, fmt.Errorf("missing closing parenthesis")
+			}
+			return node, nil
+		}
+	}
+	return nil, fmt.Errorf("unexpected %q", t.text)
+}
+
+func (p *formulaParser) parseCall(name string) (formulaNode, error) {
+	call := callNode{name: name}
+	if _, ok := p.acceptOperator(")"); ok {
+		return call, nil
+	}
+	for {
+		arg, err := p.parseComparison()
+		if err != nil {
+			return nil, err
+		}
+		call.args = append(call.args, arg)
+		if _, ok := p.acceptOperator(")"); ok {
+			return call, nil
+		}
+		// the semicolon is the separator of arguments in the locales with decimal comma
+		if _, ok := p.acceptOperator(",", ";"); !ok {
+			return nil, fmt.Errorf("expected separator of arguments")
+		}
+	}
+}
+
+func (n literalNode) eval(_ *formulaEvaluator) formulaValue {
+	return n.value
+}
+
+func (n refNode) eval(e *formulaEvaluator) formulaValue {
+	return e.cellValue(n.ref)
+}
+
+func (n rangeNode) eval(e *formulaEvaluator) formulaValue {
+	fromRow, toRow := n.from.row, n.to.row
+	if fromRow > toRow {
+		fromRow, toRow = toRow, fromRow
+	}
+	fromCol, toCol := n.from.col, n.to.col
+	if fromCol > toCol {
+		fromCol, toCol = toCol, fromCol
+	}
+	// the range may go beyond the table, e.g. to include the rows added later, such cells are empty
+	res := formulaValue{kind: valueRange}
+	for row := fromRow; row <= toRow && row < len(e.grid); row++ {
+		for col := fromCol; col <= toCol && col < len(e.grid[row]); col++ {
+			res.values = append(res.values, e.cellValue(cellRef{row: row, col: col}))
+		}
+	}
+	return res
+}
+
+func (n unaryNode) eval(e *formulaEvaluator) formulaValue {
+	v, err := n.operand.eval(e).toNumber()
+	if err != nil {
+		return *err
+	}
+	if n.op == "-" {
+		return numberValue(-v)
+	}
+	return numberValue(v)
+}
+
+func (n binaryNode) eval(e *formulaEvaluator) formulaValue {
+	left, right := n.left.eval(e), n.right.eval(e)
+	for _, v := range []formulaValue{left, right} {
+		if v.kind == valueError {
+			return v
+		}
+		if v.kind == valueRange {
+			return errorValue(formulaErrValue)
+		}
+	}
+	switch n.op {
+	case "&":
+		return textValue(left.String() + right.String())
+	case "=", "<>", "<", ">", "<=", ">=":
+		return boolValue(compareResult(n.op, compareValues(left, right)))
+	}
+	l, err := left.toNumber()
+	if err != nil {
+		return *err
+	}
+	r, err := right.toNumber()
+	if err != nil {
+		return *err
+	}
+	switch n.op {
+	case "+":
+		return numberValue(l + r)
+	case "-":
+		return numberValue(l - r)
+	case "*":
+		return numberValue(l * r)
+	case "/":
+		if r == 0 {
+			return errorValue(formulaErrDivByZero)
+		}
+		return numberValue(l / r)
+	case "^":
+		return numberValue(math.Pow(l, r))
+	}
+	return errorValue(formulaErrSyntax)
+}
+
+// compareValues compares the numbers by their values and the other values as case-insensitive texts
+func compareValues(a, b formulaValue) int {
+	an, aErr := a.toNumber()
+	bn, bErr := b.toNumber()
+	if aErr == nil && bErr == nil && a.kind != valueText && b.kind != valueText {
+		switch {
+		case an < bn:
+			return -1
+		case an > bn:
+			return 1
+		}
+		return 0
+	}
+	return strings.Compare(strings.ToLower(a.String()), strings.ToLower(b.String()))
+}
+
+func compareResult(op string, cmp int) bool {
+	switch op {
+	case "=":
+		return cmp == 0
+	case "<>":
+		return cmp != 0
+	case "<":
+		return cmp < 0
+	case ">":
+		return cmp > 0
+	case "<=":
+		return cmp <= 0
+	}
+	return cmp >= 0
+}
+
+func (n callNode) eval(e *formulaEvaluator) formulaValue {
+	if n.name == "IF" {
+		return evalIf(e, n.args)
+	}
+	f, ok := formulaFunctions[n.name]
+	if !ok {
+		return errorValue(formulaErrName)
+	}
+	args := make([]formulaValue, 0, len(n.args))
+	for _, arg := range n.args {
+		args = append(args, arg.eval(e))
+	}
+	return f(args)
+}
+
+// evalIf evaluates only the branch chosen by the condition, so the other branch may contain the errors
+func evalIf(e *formulaEvaluator, args []formulaNode) formulaValue {
+	if len(args) < 2 || len(args) > 3 {
+		return errorValue(formulaErrValue)
+	}
+	cond, err := args[0].eval(e).toBool()
+	if err != nil {
+		return *err
+	}
+	if cond {
+		return args[1].eval(e)
+	}
+	if len(args) == 3 {
+		return args[2].eval(e)
+	}
+	return boolValue(false)
+}
+
+var formulaFunctions = map[string]func(args []formulaValue) formulaValue{
+	"SUM": func(args []formulaValue) formulaValue {
+		return aggregate(args, func(nums []float64) formulaValue {
+			var sum float64
+			for _, n := range nums {
+				sum += n
+			}
+			return numberValue(sum)
+		})
+	},
+	"AVERAGE": func(args []formulaValue) formulaValue {
+		return aggregate(args, func(nums []float64) formulaValue {
+			if len(nums) == 0 {
+				return errorValue(formulaErrDivByZero)
+			}
+			var sum float64
+			for _, n := range nums {
+				sum += n
+			}
+			return numberValue(sum / float64(len(nums)))
+		})
+	},
+	"MIN": func(args []formulaValue) formulaValue {
+		return aggregate(args, func(nums []float64) formulaValue {
+			if len(nums) == 0 {
+				return numberValue(0)
+			}
+			res := nums[0]
+			for _, n := range nums[1:] {
+				res = math.Min(res, n)
+			}
+			return numberValue(res)
+		})
+	},
+	"MAX": func(args []formulaValue) formulaValue {
+		return aggregate(args, func(nums []float64) formulaValue {
+			if len(nums) == 0 {
+				return numberValue(0)
+			}
+			res := nums[0]
+			for _, n := range nums[1:] {
+				res = math.Max(res, n)
+			}
+			return numberValue(res)
+		})
+	},
+	"COUNT": func(args []formulaValue) formulaValue {
+		var count int
+		for _, arg := range args {
+			for _, v := range arg.flatten() {
+				if v.kind == valueNumber {
+					count++
+				}
+			}
+		}
+		return numberValue(float64(count))
+	},
+	"CONCAT": func(args []formulaValue) formulaValue {
+		var sb strings.Builder
+		for _, arg := range args {
+			for _, v := range arg.flatten() {
+				if v.kind == valueError {
+					return v
+				}
+				sb.WriteString(v.String())
+			}
+		}
+		return textValue(sb.String())
+	},
+}
+
+// aggregate calls f with the numbers of the arguments: the texts and the empty cells of the ranges are skipped
+// as in spreadsheets, while the arguments passed directly must be numbers
+func aggregate(args []formulaValue, f func(nums []float64) formulaValue) formulaValue {
+	var nums []float64
+	for _, arg := range args {
+		if arg.kind == valueRange {
+			for _, v := range arg.values {
+				switch v.kind {
+				case valueError:
+					return v
+				case valueNumber:
+					nums = append(nums, v.num)
+				}
+			}
+			continue
+		}
+		n, err := arg.toNumber()
+		if err != nil {
+			return *err
+		}
+		nums = append(nums, n)
+	}
+	return f(nums)
+}
+
+// formulaEvaluator calculates the formulas of the table, the texts of the cells are indexed by row and column.
+// The results are memoized, so every formula is calculated once
+type formulaEvaluator struct {
+	grid     [][]string
+	results  map[cellRef]formulaValue
+	visiting map[cellRef]bool
+}
+
+func newFormulaEvaluator(grid [][]string) *formulaEvaluator {
+	return &formulaEvaluator{
+		grid:     grid,
+		results:  make(map[cellRef]formulaValue),
+		visiting: make(map[cellRef]bool),
+	}
+}
+
+func (e *formulaEvaluator) cellValue(ref cellRef) formulaValue {
+	if ref.row < 0 || ref.row >= len(e.grid) || ref.col < 0 || ref.col >= len(e.grid[ref.row]) {
+		return errorValue(formulaErrRef)
+	}
+	text := e.grid[ref.row][ref.col]
+	if !IsFormula(text) {
+		return cellTextValue(text)
+	}
+	if v, ok := e.results[ref]; ok {
+		return v
+	}
+	// the formula which refers to itself directly or through the other cells can't be calculated
+	if e.visiting[ref] {
+		return errorValue(formulaErrCycle)
+	}
+	e.visiting[ref] = true
+	var v formulaValue
+	if node, err := parseFormula(text); err != nil {
+		v = errorValue(formulaErrSyntax)
+	} else {
+		v = node.eval(e)
+	}
+	delete(e.visiting, ref)
+	if v.kind == valueRange {
+		v = errorValue(formulaErrValue)
+	}
+	e.results[ref] = v
+	return v
+}
+
+// calculate returns the result of the formula in the cell as text
+func (e *formulaEvaluator) calculate(row, col int) string {
+	return e.cellValue(cellRef{row: row, col: col}).String()
+}
@@ -0,0 +1,139 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func TestFormulaEvaluator(t *testing.T) {
+	grid := [][]string{
+		{"Item", "Price", "Qty"},
+		{"Coffee", "3.5", "2"},
+		{"Bread", "1,200", "1"},
+		{"Water", "", "text"},
+	}
+	for _, tc := range []struct {
+		formula string
+		want    string
+	}{
+		{formula: "=SUM(B2:B4)", want: "1203.5"},
+		{formula: "=sum(b2:b4, 10)", want: "1213.5"},
+		{formula: "=AVERAGE(B2:B3)", want: "601.75"},
+		{formula: "=MIN(B2:C4)", want: "1"},
+		{formula: "=MAX(B2:B4)", want: "1200"},
+		{formula: "=COUNT(A1:C4)", want: "4"},
+		{formula: "=B2*C2+B3*C3", want: "1207"},
+		{formula: "=(1+2)*3-2^3", want: "1"},
+		{formula: "=-B2", want: "-3.5"},
+		{formula: "=0.1+0.2", want: "0.3"},
+		{formula: "=IF(B2>B3, \"expensive\", \"cheap\")", want: "cheap"},
+		{formula: "=IF(C4=\"text\", 1, 1/0)", want: "1"},
+		{formula: "=IF(B2>1; \"yes\")", want: "yes"},
+		{formula: "=CONCAT(A2, \" and \", A3)", want: "Coffee and Bread"},
+		{formula: "=A2&\"!\"", want: "Coffee!"},
+		{formula: "=B2<=3.5", want: "TRUE"},
+		{formula: "=B$2+$C$2", want: "5.5"},
+		{formula: "=B4", want: ""},
+		{formula: "=1/0", want: "#DIV/0!"},
+		{formula: "=A2+1", want: "#VALUE!"},
+		{formula: "=C4*2", want: "#VALUE!"},
+		{formula: "=Z100", want: "#REF!"},
+		{formula: "=SUM(B2:B4", want: "#ERROR!"},
+		{formula: "=UNKNOWN(1)", want: "#NAME?"},
+		{formula: "=SUM(B2:B9)", want: "1203.5"},
+		{formula: "=SUM(B2:B3)+1/0", want: "#DIV/0!"},
+		{formula: "=B2:B3", want: "#VALUE!"},
+	} {
+		t.Run(tc.formula, func(t *testing.T) {
+			g := append(grid, []string{tc.formula})
+			assert.Equal(t, tc.want, newFormulaEvaluator(g).calculate(len(g)-1, 0))
+		})
+	}
+
+	t.Run("formulas refer to other formulas", func(t *testing.T) {
+		g := [][]string{{"1", "=A1+1", "=B1*10"}}
+		assert.Equal(t, "20", newFormulaEvaluator(g).calculate(0, 2))
+	})
+
+	t.Run("cycles", func(t *testing.T) {
+		g := [][]string{{"=B1", "=C1+1", "=A1"}, {"=A1", "=B2"}}
+		e := newFormulaEvaluator(g)
+		for _, pos := range []cellRef{{0, 0}, {0, 1}, {0, 2}, {1, 0}, {1, 1}} {
+			assert.Equal(t, formulaErrCycle, e.calculate(pos.row, pos.col))
+		}
+	})
+}
+
+func TestParseCellRef(t *testing.T) {
+	for s, want := range map[string]cellRef{
+		"A1":    {row: 0, col: 0},
+		"b12":   {row: 11, col: 1},
+		"AA3":   {row: 2, col: 26},
+		"$C$10": {row: 9, col: 2},
+	} {
+		got, ok := parseCellRef(s)
+		assert.True(t, ok, s)
+		assert.Equal(t, want, got, s)
+	}
+	for _, s := range []string{"A", "1", "A0", "SUM", "A1B"} {
+		_, ok := parseCellRef(s)
+		assert.False(t, ok, s)
+	}
+}
+
+func TestEditor_CalculateFormulas(t *testing.T) {
+	s := mkTestTable([]string{"col1", "col2"}, []string{"row1", "row2", "row3"},
+		[][]string{
+			{"row1-col1", "row1-col2"},
+			{"row2-col1", "row2-col2"},
+			{"row3-col2"},
+		}, withBlockContents(map[string]*model.Block{
+			"row1-col1": mkTextBlock("10"),
+			"row1-col2": mkTextBlock("=A1*2"),
+			"row2-col1": mkTextBlock("5"),
+			"row2-col2": mkTextBlock("=B1+A2"),
+			"row3-col2": mkTextBlock("=SUM(B1:B2)"),
+		}))
+	result := func(s *state.State, id string) string {
+		return pbtypes.GetString(s.Pick(id).Model().GetFields(), FormulaResultField)
+	}
+
+	e := Editor{}
+	require.NoError(t, e.CalculateFormulas(s, "table"))
+	assert.Equal(t, "20", result(s, "row1-col2"))
+	assert.Equal(t, "25", result(s, "row2-col2"))
+	assert.Equal(t, "45", result(s, "row3-col2"))
+	// the formula is kept in the text
+	assert.Equal(t, "=SUM(B1:B2)", s.Pick("row3-col2").Model().GetText().Text)
+	assert.Empty(t, result(s, "row1-col1"))
+
+	t.Run("dependents are recalculated", func(t *testing.T) {
+		s.Get("row1-col1").Model().GetText().Text = "1"
+		require.NoError(t, e.CalculateFormulas(s, "row1-col1"))
+		assert.Equal(t, "2", result(s, "row1-col2"))
+		assert.Equal(t, "7", result(s, "row2-col2"))
+		assert.Equal(t, "9", result(s, "row3-col2"))
+	})
+
+	t.Run("result is removed with the formula", func(t *testing.T) {
+		s.Get("row1-col2").Model().GetText().Text = "3"
+		require.NoError(t, e.CalculateFormulas(s, "table"))
+		assert.Nil(t, pbtypes.Get(s.Pick("row1-col2").Model().GetFields(), FormulaResultField))
+		assert.Equal(t, "8", result(s, "row2-col2"))
+	})
+
+	t.Run("changed tables are recalculated before apply", func(t *testing.T) {
+		st := s.Copy().NewState()
+		st.Get("row2-col1").Model().GetText().Text = "7"
+		require.NoError(t, e.calculateChangedTables(smartblock.ApplyInfo{State: st}))
+		assert.Equal(t, "10", result(st, "row2-col2"))
+		assert.Equal(t, "13", result(st, "row3-col2"))
+	})
+}
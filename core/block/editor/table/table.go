@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/globalsign/mgo/bson"
+	"github.com/gogo/protobuf/types"
 
 	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
 	"github.com/anyproto/anytype-heart/core/block/editor/state"
@@ -16,6 +17,7 @@ import (
 	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pkg/lib/logging"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
 )
 
 var log = logging.Logger("anytype-simple-tables")
@@ -39,6 +41,7 @@ type TableEditor interface {
 	Expand(s *state.State, req pb.RpcBlockTableExpandRequest) error
 	Sort(s *state.State, req pb.RpcBlockTableSortRequest) error
 	CellCreate(s *state.State, rowID string, colID string, b *model.Block) (string, error)
+	CalculateFormulas(s *state.State, id string) error
 }
 
 type Editor struct {
@@ -62,6 +65,7 @@ func NewEditor(sb smartblock.SmartBlock) *Editor {
 	}
 	if sb != nil {
 		sb.AddHook(t.cleanupTables, smartblock.HookOnBlockClose)
+		sb.AddHook(t.calculateChangedTables, smartblock.HookBeforeApply)
 	}
 	return &t
 }
@@ -476,6 +480,98 @@ func (t *Editor) cleanupTables(_ smartblock.ApplyInfo) error {
 	return nil
 }
 
+// CalculateFormulas calculates the formulas of the table which contains the block with given id,
+// the results are cached in the cells and removed from the cells which don't contain formulas anymore
+func (t *Editor) CalculateFormulas(s *state.State, id string) error {
+	tb, err := NewTable(s, id)
+	if err != nil {
+		return fmt.Errorf("initialize table state: %w", err)
+	}
+	colIdx := tb.MakeColumnIndex()
+	rowIDs := tb.RowIDs()
+	grid := make([][]string, len(rowIDs))
+	for i := range grid {
+		grid[i] = make([]string, len(colIdx))
+	}
+	var cells []CellPosition
+	err = tb.Iterate(func(b simple.Block, pos CellPosition) bool {
+		if b != nil {
+			grid[pos.RowNumber][pos.ColNumber] = b.Model().GetText().GetText()
+			cells = append(cells, pos)
+		}
+		return true
+	})
+	if err != nil {
+		return fmt.Errorf("iterate cells: %w", err)
+	}
+
+	evaluator := newFormulaEvaluator(grid)
+	for _, pos := range cells {
+		text := grid[pos.RowNumber][pos.ColNumber]
+		fields := s.Pick(pos.CellID).Model().GetFields()
+		cached, hasCached := fields.GetFields()[FormulaResultField]
+		if !IsFormula(text) {
+			if hasCached {
+				cell := s.Get(pos.CellID).Model()
+				cell.Fields = pbtypes.CopyStruct(cell.Fields)
+				delete(cell.Fields.Fields, FormulaResultField)
+			}
+			continue
+		}
+		result := evaluator.calculate(pos.RowNumber, pos.ColNumber)
+		if hasCached && cached.GetStringValue() == result {
+			continue
+		}
+		cell := s.Get(pos.CellID).Model()
+		cell.Fields = pbtypes.CopyStruct(cell.Fields)
+		if cell.Fields.GetFields() == nil {
+			cell.Fields = &types.Struct{Fields: map[string]*types.Value{}}
+		}
+		cell.Fields.Fields[FormulaResultField] = pbtypes.String(result)
+	}
+	return nil
+}
+
+// calculateChangedTables recalculates the formulas of the tables changed by the state.
+// It also runs for the delayed text of the cells, so the formulas are calculated once the text is flushed
+func (t *Editor) calculateChangedTables(info smartblock.ApplyInfo) error {
+	s := info.State
+	var ids []string
+	s.IterateActive(func(b simple.Block) bool {
+		m := b.Model()
+		if m.GetTable() != nil || m.GetTableRow() != nil || m.GetTableColumn() != nil || isTableLayout(m) {
+			ids = append(ids, m.Id)
+		} else if _, _, err := ParseCellID(m.Id); err == nil && m.GetText() != nil {
+			ids = append(ids, m.Id)
+		}
+		return true
+	})
+	calculated := make(map[string]struct{})
+	for _, id := range ids {
+		if s.Pick(id) == nil {
+			continue
+		}
+		tb, err := NewTable(s, id)
+		if err != nil {
+			continue
+		}
+		tableID := tb.Block().Model().Id
+		if _, ok := calculated[tableID]; ok {
+			continue
+		}
+		calculated[tableID] = struct{}{}
+		if err = t.CalculateFormulas(s, tableID); err != nil {
+			log.Errorf("calculate formulas of table %s: %s", tableID, err)
+		}
+	}
+	return nil
+}
+
+func isTableLayout(b *model.Block) bool {
+	style := b.GetLayout().GetStyle()
+	return b.GetLayout() != nil && (style == model.BlockContentLayout_TableRows || style == model.BlockContentLayout_TableColumns)
+}
+
 func (t *Editor) ColumnCreate(s *state.State, req pb.RpcBlockTableColumnCreateRequest) (string, error) {
 	switch req.Position {
 	case model.Block_Left:
@@ -700,12 +796,15 @@ func (t *Editor) Sort(s *state.State, req pb.RpcBlockTableSortRequest) error {
 				if cell == nil {
 					return fmt.Errorf("cell %s is not found", cellID)
 				}
-				sorter.values[i] = cell.Model().GetText().GetText()
+				sorter.values[i] = cellShownText(cell.Model())
 			}
 		}
 		i++
 	}
 
+	// the numbers and the dates are compared by their values, not as texts
+	sorter.format = DetectFormat(sorter.values)
+
 	if req.Type == model.BlockContentDataviewSort_Asc {
 		sort.Stable(sorter)
 	} else {
@@ -751,6 +850,7 @@ func (t *Editor) CellCreate(s *state.State, rowID string, colID string, b *model
 type tableSorter struct {
 	rowIDs []string
 	values []string
+	format model.RelationFormat
 }
 
 func (t tableSorter) Len() int {
@@ -758,6 +858,21 @@ func (t tableSorter) Len() int {
 }
 
 func (t tableSorter) Less(i, j int) bool {
+	a, b := strings.TrimSpace(t.values[i]), strings.TrimSpace(t.values[j])
+	// the empty cells go first, as the empty texts do
+	if a == "" || b == "" {
+		return a == "" && b != ""
+	}
+	switch t.format {
+	case model.RelationFormat_number:
+		x, _ := ParseNumber(a)
+		y, _ := ParseNumber(b)
+		return x < y
+	case model.RelationFormat_date:
+		x, _ := ParseDate(a)
+		y, _ := ParseDate(b)
+		return x.Before(y)
+	}
 	return strings.ToLower(t.values[i]) < strings.ToLower(t.values[j])
 }
 
@@ -3,6 +3,7 @@ package table
 import (
 	"testing"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/anyproto/anytype-heart/core/block/simple"
 	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
 )
 
 func TestTableCreate(t *testing.T) {
@@ -864,6 +866,91 @@ func TestSort(t *testing.T) {
 					"row3": {IsHeader: true},
 				})),
 		},
+		{
+			name: "numbers are compared by value",
+			source: mkTestTable([]string{"col1", "col2"}, []string{"row1", "row2", "row3", "row4"},
+				[][]string{
+					{"row1-col1", "row1-col2"},
+					{"row2-col1", "row2-col2"},
+					{"row3-col1", "row3-col2"},
+					{"row4-col1", "row4-col2"},
+				}, withBlockContents(map[string]*model.Block{
+					"row1-col2": mkTextBlock("10"),
+					"row2-col2": mkTextBlock("9"),
+					"row3-col2": mkTextBlock("1,000"),
+					"row4-col2": mkTextBlock(""),
+				})),
+			req: pb.RpcBlockTableSortRequest{
+				ColumnId: "col2",
+				Type:     model.BlockContentDataviewSort_Asc,
+			},
+			want: mkTestTable([]string{"col1", "col2"}, []string{"row4", "row2", "row1", "row3"},
+				[][]string{
+					{"row4-col1", "row4-col2"},
+					{"row2-col1", "row2-col2"},
+					{"row1-col1", "row1-col2"},
+					{"row3-col1", "row3-col2"},
+				}, withBlockContents(map[string]*model.Block{
+					"row1-col2": mkTextBlock("10"),
+					"row2-col2": mkTextBlock("9"),
+					"row3-col2": mkTextBlock("1,000"),
+					"row4-col2": mkTextBlock(""),
+				})),
+		},
+		{
+			name: "dates are compared by value",
+			source: mkTestTable([]string{"col1", "col2"}, []string{"row1", "row2", "row3"},
+				[][]string{
+					{"row1-col1", "row1-col2"},
+					{"row2-col1", "row2-col2"},
+					{"row3-col1", "row3-col2"},
+				}, withBlockContents(map[string]*model.Block{
+					"row1-col2": mkTextBlock("2023-10-19"),
+					"row2-col2": mkTextBlock("2023-01-02"),
+					"row3-col2": mkTextBlock("2022-12-31"),
+				})),
+			req: pb.RpcBlockTableSortRequest{
+				ColumnId: "col2",
+				Type:     model.BlockContentDataviewSort_Desc,
+			},
+			want: mkTestTable([]string{"col1", "col2"}, []string{"row1", "row2", "row3"},
+				[][]string{
+					{"row1-col1", "row1-col2"},
+					{"row2-col1", "row2-col2"},
+					{"row3-col1", "row3-col2"},
+				}, withBlockContents(map[string]*model.Block{
+					"row1-col2": mkTextBlock("2023-10-19"),
+					"row2-col2": mkTextBlock("2023-01-02"),
+					"row3-col2": mkTextBlock("2022-12-31"),
+				})),
+		},
+		{
+			name: "formulas are compared by result",
+			source: mkTestTable([]string{"col1", "col2"}, []string{"row1", "row2", "row3"},
+				[][]string{
+					{"row1-col1", "row1-col2"},
+					{"row2-col1", "row2-col2"},
+					{"row3-col1", "row3-col2"},
+				}, withBlockContents(map[string]*model.Block{
+					"row1-col2": mkFormulaBlock("=A1*10", "200"),
+					"row2-col2": mkTextBlock("9"),
+					"row3-col2": mkFormulaBlock("=A3+1", "100"),
+				})),
+			req: pb.RpcBlockTableSortRequest{
+				ColumnId: "col2",
+				Type:     model.BlockContentDataviewSort_Asc,
+			},
+			want: mkTestTable([]string{"col1", "col2"}, []string{"row2", "row3", "row1"},
+				[][]string{
+					{"row1-col1", "row1-col2"},
+					{"row2-col1", "row2-col2"},
+					{"row3-col1", "row3-col2"},
+				}, withBlockContents(map[string]*model.Block{
+					"row1-col2": mkFormulaBlock("=A1*10", "200"),
+					"row2-col2": mkTextBlock("9"),
+					"row3-col2": mkFormulaBlock("=A3+1", "100"),
+				})),
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			tb := Editor{}
@@ -1112,6 +1199,12 @@ func mkTextBlock(txt string) *model.Block {
 	}
 }
 
+func mkFormulaBlock(formula, result string) *model.Block {
+	b := mkTextBlock(formula)
+	b.Fields = &types.Struct{Fields: map[string]*types.Value{FormulaResultField: pbtypes.String(result)}}
+	return b
+}
+
 func idFromSlice(ids []string) func() string {
 	var i int
 	return func() string {
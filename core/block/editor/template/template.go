@@ -174,6 +174,44 @@ var MigrateRelationValue = func(from bundle.RelationKey, to bundle.RelationKey)
 	}
 }
 
+// ConvertRelationValue sets the format to the relation links of the object and its dataviews and converts the relation value.
+// The value is left as is in case it can't be converted, the error is passed to onError then
+var ConvertRelationValue = func(
+	key string, format model.RelationFormat,
+	convert func(v *types.Value) (*types.Value, error), onError func(v *types.Value, err error),
+) StateTransformer {
+	return func(s *state.State) {
+		if link := s.GetRelationLinks().Get(key); link != nil {
+			link.Format = format
+		}
+		var dataviewIDs []string
+		_ = s.Iterate(func(b simple.Block) bool {
+			if pbtypes.RelationLinks(b.Model().GetDataview().GetRelationLinks()).Has(key) {
+				dataviewIDs = append(dataviewIDs, b.Model().Id)
+			}
+			return true
+		})
+		for _, id := range dataviewIDs {
+			pbtypes.RelationLinks(s.Get(id).Model().GetDataview().RelationLinks).Get(key).Format = format
+		}
+
+		val := s.Details().GetFields()[key]
+		if val == nil {
+			return
+		}
+		newVal, err := convert(val)
+		if err != nil {
+			onError(val, err)
+			return
+		}
+		if newVal == nil {
+			s.RemoveDetail(key)
+			return
+		}
+		s.SetDetail(key, newVal)
+	}
+}
+
 var WithDetailIconEmoji = func(iconEmoji string) StateTransformer {
 	return WithDetail(bundle.RelationKeyIconEmoji, pbtypes.String(iconEmoji))
 }
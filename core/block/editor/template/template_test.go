@@ -1,11 +1,15 @@
 package template
 
 import (
+	"fmt"
 	"testing"
 
+	"github.com/gogo/protobuf/types"
+
 	"github.com/anyproto/anytype-heart/core/block/editor/state"
 	"github.com/anyproto/anytype-heart/core/block/simple"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -63,3 +67,53 @@ func TestWithBookmarkBlocks(t *testing.T) {
 		assert.Equal(t, want, s.Blocks())
 	})
 }
+
+func TestConvertRelationValue(t *testing.T) {
+	newState := func(value *types.Value) *state.State {
+		s := state.NewDoc("root", map[string]simple.Block{
+			"root": simple.New(&model.Block{Id: "root", ChildrenIds: []string{"dataview"}}),
+			"dataview": simple.New(&model.Block{Id: "dataview", Content: &model.BlockContentOfDataview{Dataview: &model.BlockContentDataview{
+				RelationLinks: []*model.RelationLink{{Key: "priority", Format: model.RelationFormat_shorttext}},
+			}}}),
+		}).(*state.State)
+		s.AddRelationLinks(&model.RelationLink{Key: "priority", Format: model.RelationFormat_shorttext})
+		s.SetDetail("priority", value)
+		return s.NewState()
+	}
+	convert := func(v *types.Value) (*types.Value, error) {
+		if v.GetStringValue() == "" {
+			return nil, nil
+		}
+		if v.GetStringValue() == "high" {
+			return pbtypes.StringList([]string{"opt1"}), nil
+		}
+		return nil, fmt.Errorf("unknown priority")
+	}
+
+	t.Run("converted", func(t *testing.T) {
+		s := newState(pbtypes.String("high"))
+		ConvertRelationValue("priority", model.RelationFormat_status, convert, func(*types.Value, error) {
+			t.Fatal("unexpected error")
+		})(s)
+		assert.Equal(t, pbtypes.StringList([]string{"opt1"}), pbtypes.Get(s.Details(), "priority"))
+		assert.Equal(t, model.RelationFormat_status, s.GetRelationLinks().Get("priority").Format)
+		assert.Equal(t, model.RelationFormat_status, s.Get("dataview").Model().GetDataview().RelationLinks[0].Format)
+		assert.Equal(t, model.RelationFormat_shorttext, s.ParentState().Get("dataview").Model().GetDataview().RelationLinks[0].Format)
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		s := newState(pbtypes.String("low"))
+		var failed *types.Value
+		ConvertRelationValue("priority", model.RelationFormat_status, convert, func(v *types.Value, _ error) {
+			failed = v
+		})(s)
+		assert.Equal(t, pbtypes.String("low"), failed)
+		assert.Equal(t, pbtypes.String("low"), pbtypes.Get(s.Details(), "priority"))
+	})
+
+	t.Run("removed", func(t *testing.T) {
+		s := newState(pbtypes.String(""))
+		ConvertRelationValue("priority", model.RelationFormat_status, convert, nil)(s)
+		assert.Nil(t, pbtypes.Get(s.Details(), "priority"))
+	})
+}
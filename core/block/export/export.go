@@ -18,12 +18,18 @@ import (
 	"github.com/anyproto/anytype-heart/core/block"
 	sb "github.com/anyproto/anytype-heart/core/block/editor/smartblock"
 	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/core/block/simple"
 	"github.com/anyproto/anytype-heart/core/converter"
+	"github.com/anyproto/anytype-heart/core/converter/csv"
+	"github.com/anyproto/anytype-heart/core/converter/docx"
 	"github.com/anyproto/anytype-heart/core/converter/dot"
 	"github.com/anyproto/anytype-heart/core/converter/graphjson"
+	"github.com/anyproto/anytype-heart/core/converter/html"
 	"github.com/anyproto/anytype-heart/core/converter/md"
+	"github.com/anyproto/anytype-heart/core/converter/odt"
 	"github.com/anyproto/anytype-heart/core/converter/pbc"
 	"github.com/anyproto/anytype-heart/core/converter/pbjson"
+	"github.com/anyproto/anytype-heart/core/converter/pdf"
 	"github.com/anyproto/anytype-heart/core/files"
 	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
@@ -45,6 +51,9 @@ const CName = "export"
 
 const tempFileName = "temp_anytype_backup"
 
+// htmlIndexID is the id the name of the html site index is reserved for
+const htmlIndexID = "index"
+
 var log = logging.Logger("anytype-mw-export")
 
 type Export interface {
@@ -52,8 +61,19 @@ type Export interface {
 	app.Component
 }
 
+// dataviewQuerier queries the records shown by the views of the dataview blocks
+type dataviewQuerier interface {
+	DataviewRecords(
+		contextID string,
+		dv *model.BlockContentDataview,
+		view *model.BlockContentDataviewView,
+	) (relations []*model.Relation, records, dependencies []*types.Struct, err error)
+}
+
 type export struct {
 	bs          *block.Service
+	picker      block.Picker
+	dataviews   dataviewQuerier
 	objectStore objectstore.ObjectStore
 	a           core.Service
 	sbtProvider typeprovider.SmartBlockTypeProvider
@@ -68,6 +88,8 @@ func New(sbtProvider typeprovider.SmartBlockTypeProvider) Export {
 
 func (e *export) Init(a *app.App) (err error) {
 	e.bs = a.MustComponent(block.CName).(*block.Service)
+	e.picker = e.bs
+	e.dataviews = e.bs
 	e.a = a.MustComponent(core.CName).(core.Service)
 	e.objectStore = a.MustComponent(objectstore.CName).(objectstore.ObjectStore)
 	e.fileService = app.MustComponent[files.Service](a)
@@ -95,6 +117,9 @@ func (e *export) Export(req pb.RpcObjectListExportRequest) (path string, succeed
 	if err != nil {
 		return
 	}
+	if req.Format == pb.RpcObjectListExport_CSV {
+		docs = setsAndCollections(docs)
+	}
 
 	var wr writer
 	if req.Zip {
@@ -136,11 +161,18 @@ func (e *export) Export(req pb.RpcObjectListExportRequest) (path string, succeed
 				}
 			}
 		}
+		if req.Format == pb.RpcObjectListExport_HTML {
+			// the name is reserved before the docs are named, so the site index doesn't clash with them
+			indexName := wr.Namer().Get("", htmlIndexID, htmlIndexID, ".html")
+			if err = wr.WriteFile(indexName, bytes.NewReader(html.ExportIndex(docs, wr.Namer()))); err != nil {
+				log.Errorf("failed to create html index: %s", err.Error())
+			}
+		}
 		for docId := range docs {
 			did := docId
 			if err = queue.Wait(func() {
 				log.With("objectID", did).Debugf("write doc")
-				if werr := e.writeDoc(req.Format, wr, docs, queue, did, req.IncludeFiles, req.IsJson); werr != nil {
+				if werr := e.writeDoc(req, wr, docs, queue, did); werr != nil {
 					log.With("objectID", did).Warnf("can't export doc: %v", werr)
 				} else {
 					succeed++
@@ -311,8 +343,20 @@ func (e *export) writeMultiDoc(mw converter.MultiConverter, wr writer, docs map[
 	return
 }
 
-func (e *export) writeDoc(format pb.RpcObjectListExportFormat, wr writer, docInfo map[string]*types.Struct, queue process.Queue, docID string, exportFiles, isJSON bool) (err error) {
-	return e.bs.Do(docID, func(b sb.SmartBlock) error {
+func (e *export) writeDoc(req pb.RpcObjectListExportRequest, wr writer, docInfo map[string]*types.Struct, queue process.Queue, docID string) (err error) {
+	format, exportFiles, isJSON := req.Format, req.IncludeFiles, req.IsJson
+	var dataviews converter.Dataviews
+	switch format {
+	case pb.RpcObjectListExport_HTML, pb.RpcObjectListExport_PDF:
+		if dataviews, err = e.queryDataviews(docID, ""); err != nil {
+			return err
+		}
+	case pb.RpcObjectListExport_CSV:
+		if dataviews, err = e.queryDataviews(docID, req.CsvOptions.GetViewId()); err != nil {
+			return err
+		}
+	}
+	return block.Do(e.picker, docID, func(b sb.SmartBlock) error {
 		if pbtypes.GetBool(b.CombinedDetails(), bundle.RelationKeyIsDeleted.String()) {
 			return nil
 		}
@@ -324,11 +368,21 @@ func (e *export) writeDoc(format pb.RpcObjectListExportFormat, wr writer, docInf
 			conv = pbc.NewConverter(b, isJSON)
 		case pb.RpcObjectListExport_JSON:
 			conv = pbjson.NewConverter(b)
+		case pb.RpcObjectListExport_HTML:
+			conv = html.NewExporter(e.fileService, b.NewState(), wr.Namer(), dataviews)
+		case pb.RpcObjectListExport_PDF:
+			conv = pdf.NewConverter(e.fileService, b.NewState(), convertPdfOptions(req.PdfOptions), dataviews)
+		case pb.RpcObjectListExport_DOCX:
+			conv = docx.NewConverter(e.fileService, b.NewState())
+		case pb.RpcObjectListExport_ODT:
+			conv = odt.NewConverter(e.fileService, b.NewState())
+		case pb.RpcObjectListExport_CSV:
+			conv = csv.NewConverter(b.NewState(), convertCsvOptions(req.CsvOptions), dataviews)
 		}
 		conv.SetKnownDocs(docInfo)
 		result := conv.Convert(b.Type())
 		filename := docID + conv.Ext()
-		if format == pb.RpcObjectListExport_Markdown {
+		if hasNamedFiles(format) {
 			s := b.NewState()
 			name := pbtypes.GetString(s.Details(), bundle.RelationKeyName.String())
 			if name == "" {
@@ -336,7 +390,7 @@ func (e *export) writeDoc(format pb.RpcObjectListExportFormat, wr writer, docInf
 			}
 			filename = wr.Namer().Get("", docID, name, conv.Ext())
 		}
-		if docID == e.a.PredefinedBlocks().Home {
+		if docID == e.a.PredefinedBlocks().Home && format != pb.RpcObjectListExport_HTML {
 			filename = "index" + conv.Ext()
 		}
 		if err = wr.WriteFile(filename, bytes.NewReader(result)); err != nil {
@@ -350,6 +404,103 @@ func (e *export) writeDoc(format pb.RpcObjectListExportFormat, wr writer, docInf
 	})
 }
 
+// queryDataviews queries the records of the dataview blocks of the object before it's locked for the conversion:
+// the records of the collections are queried with the subscriptions which lock the collection objects.
+// The view with viewID is queried, the active view otherwise
+func (e *export) queryDataviews(docID, viewID string) (converter.Dataviews, error) {
+	dvs := make(map[string]*model.BlockContentDataview)
+	err := block.Do(e.picker, docID, func(b sb.SmartBlock) error {
+		return b.NewState().Iterate(func(bl simple.Block) bool {
+			if bl.Model().GetDataview() != nil {
+				dvs[bl.Model().Id] = pbtypes.CopyBlock(bl.Model()).GetDataview()
+			}
+			return true
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	dataviews := make(converter.Dataviews, len(dvs))
+	for blockID, dv := range dvs {
+		view := converter.DataviewView(dv, viewID)
+		if view == nil {
+			continue
+		}
+		relations, records, dependencies, err := e.dataviews.DataviewRecords(docID, dv, view)
+		if err != nil {
+			log.With("objectID", docID, "blockID", blockID).Warnf("can't query dataview records: %v", err)
+			continue
+		}
+		dataviews[converter.DataviewKey{BlockID: blockID, ViewID: view.Id}] = &converter.DataviewRecords{
+			Relations:    relations,
+			Records:      records,
+			Dependencies: dependencies,
+		}
+	}
+	return dataviews, nil
+}
+
+// hasNamedFiles reports whether the exported files are named by the object names instead of the IDs
+func hasNamedFiles(format pb.RpcObjectListExportFormat) bool {
+	switch format {
+	case pb.RpcObjectListExport_Markdown, pb.RpcObjectListExport_HTML, pb.RpcObjectListExport_PDF,
+		pb.RpcObjectListExport_DOCX, pb.RpcObjectListExport_ODT, pb.RpcObjectListExport_CSV:
+		return true
+	}
+	return false
+}
+
+func convertPdfOptions(opts *pb.RpcObjectListExportPdfOptions) pdf.Options {
+	if opts == nil {
+		return pdf.Options{}
+	}
+	pageSize := pdf.PageSizeA4
+	switch opts.PageSize {
+	case pb.RpcObjectListExportPdfOptions_Letter:
+		pageSize = pdf.PageSizeLetter
+	case pb.RpcObjectListExportPdfOptions_Legal:
+		pageSize = pdf.PageSizeLegal
+	case pb.RpcObjectListExportPdfOptions_A3:
+		pageSize = pdf.PageSizeA3
+	case pb.RpcObjectListExportPdfOptions_A5:
+		pageSize = pdf.PageSizeA5
+	}
+	return pdf.Options{
+		PageSize:  pageSize,
+		Landscape: opts.Landscape,
+		Margin:    pdf.Millimeters(float64(opts.Margin)),
+		Report:    opts.SetsAsReport,
+	}
+}
+
+func convertCsvOptions(opts *pb.RpcObjectListExportCsvOptions) csv.Options {
+	if opts == nil {
+		return csv.Options{}
+	}
+	var delimiter rune
+	if opts.Delimiter != "" {
+		delimiter = []rune(opts.Delimiter)[0]
+	}
+	return csv.Options{
+		Delimiter:     delimiter,
+		ViewID:        opts.ViewId,
+		ObjectIDs:     opts.ObjectIds,
+		ListSeparator: opts.ListSeparator,
+	}
+}
+
+// setsAndCollections returns the objects which have the records to export as the table
+func setsAndCollections(docs map[string]*types.Struct) map[string]*types.Struct {
+	res := make(map[string]*types.Struct, len(docs))
+	for id, details := range docs {
+		layout := model.ObjectTypeLayout(pbtypes.GetInt64(details, bundle.RelationKeyLayout.String()))
+		if layout == model.ObjectType_set || layout == model.ObjectType_collection {
+			res[id] = details
+		}
+	}
+	return res
+}
+
 func (e *export) saveFiles(b sb.SmartBlock, queue process.Queue, wr writer, docID string) {
 	fileHashes := b.GetAndUnsetFileKeys()
 	for _, fh := range fileHashes {
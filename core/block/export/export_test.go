@@ -1,11 +1,31 @@
 package export
 
 import (
+	"context"
 	"fmt"
+	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/anyproto/anytype-heart/core/block/collection"
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock/smarttest"
+	"github.com/anyproto/anytype-heart/core/block/editor/template"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/converter/pdf"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/pkg/lib/threads"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+	"github.com/anyproto/anytype-heart/util/testMock"
 )
 
 func TestFileNamer_Get(t *testing.T) {
@@ -30,3 +50,132 @@ func TestFileNamer_Get(t *testing.T) {
 	}
 	assert.Equal(t, len(names), len(nl))
 }
+
+func TestConvertPdfOptions(t *testing.T) {
+	assert.Equal(t, pdf.Options{}, convertPdfOptions(nil))
+	assert.Equal(t, pdf.Options{
+		PageSize:  pdf.PageSizeLetter,
+		Landscape: true,
+		Margin:    pdf.Millimeters(10),
+		Report:    true,
+	}, convertPdfOptions(&pb.RpcObjectListExportPdfOptions{
+		PageSize:     pb.RpcObjectListExportPdfOptions_Letter,
+		Landscape:    true,
+		Margin:       10,
+		SetsAsReport: true,
+	}))
+}
+
+type testPicker map[string]smartblock.SmartBlock
+
+func (p testPicker) PickBlock(_ context.Context, id string) (smartblock.SmartBlock, error) {
+	if sb, ok := p[id]; ok {
+		return sb, nil
+	}
+	return nil, fmt.Errorf("object %s not found", id)
+}
+
+// collectionRecords reads the records of the collection the way the subscriptions do, under the collection lock
+type collectionRecords struct {
+	collections *collection.Service
+	names       map[string]string
+}
+
+func (c *collectionRecords) DataviewRecords(
+	contextID string,
+	dv *model.BlockContentDataview,
+	_ *model.BlockContentDataviewView,
+) ([]*model.Relation, []*types.Struct, []*types.Struct, error) {
+	if !dv.IsCollection {
+		return nil, nil, nil, nil
+	}
+	ids, _, err := c.collections.SubscribeForCollection(contextID, "export")
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	defer c.collections.UnsubscribeFromCollection(contextID, "export")
+	records := make([]*types.Struct, 0, len(ids))
+	for _, id := range ids {
+		records = append(records, &types.Struct{Fields: map[string]*types.Value{
+			bundle.RelationKeyId.String():   pbtypes.String(id),
+			bundle.RelationKeyName.String(): pbtypes.String(c.names[id]),
+		}})
+	}
+	relations := []*model.Relation{{Key: bundle.RelationKeyName.String(), Name: "Name", Format: model.RelationFormat_shorttext}}
+	return relations, records, nil, nil
+}
+
+func TestExport_WriteDoc(t *testing.T) {
+	const collectionID = "collection"
+	newExport := func(t *testing.T) *export {
+		sb := smarttest.New(collectionID)
+		sb.AddBlock(simple.New(&model.Block{Id: collectionID, ChildrenIds: []string{"dataview"}}))
+		sb.AddBlock(simple.New(&model.Block{Id: "dataview", Content: &model.BlockContentOfDataview{Dataview: &model.BlockContentDataview{
+			IsCollection: true,
+			Views:        []*model.BlockContentDataviewView{{Id: "all", Name: "All"}},
+		}}}))
+		st := sb.NewState()
+		st.SetDetail(bundle.RelationKeyName.String(), pbtypes.String("Tasks"))
+		st.UpdateStoreSlice(template.CollectionStoreKey, []string{"task1", "task2"})
+		require.NoError(t, sb.Apply(st))
+
+		picker := testPicker{collectionID: sb}
+		a := testMock.NewMockService(gomock.NewController(t))
+		a.EXPECT().PredefinedBlocks().Return(threads.DerivedSmartblockIds{}).AnyTimes()
+		return &export{
+			picker: picker,
+			dataviews: &collectionRecords{
+				collections: collection.New(picker, nil, nil, nil),
+				names:       map[string]string{"task1": "Write report", "task2": "Send it"},
+			},
+			a: a,
+		}
+	}
+	writeDoc := func(t *testing.T, e *export, req pb.RpcObjectListExportRequest) string {
+		wr, err := newDirWriter(t.TempDir(), false)
+		require.NoError(t, err)
+		done := make(chan error, 1)
+		go func() {
+			done <- e.writeDoc(req, wr, nil, nil, collectionID)
+		}()
+		select {
+		case err = <-done:
+			require.NoError(t, err)
+		case <-time.After(5 * time.Second):
+			t.Fatal("export of the collection is locked")
+		}
+		entries, err := os.ReadDir(wr.Path())
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		data, err := os.ReadFile(filepath.Join(wr.Path(), entries[0].Name()))
+		require.NoError(t, err)
+		return string(data)
+	}
+
+	t.Run("html of collection", func(t *testing.T) {
+		result := writeDoc(t, newExport(t), pb.RpcObjectListExportRequest{Format: pb.RpcObjectListExport_HTML})
+		assert.Contains(t, result, "<td>Write report</td>")
+		assert.Contains(t, result, "<td>Send it</td>")
+	})
+
+	t.Run("pdf report of collection", func(t *testing.T) {
+		result := writeDoc(t, newExport(t), pb.RpcObjectListExportRequest{
+			Format:     pb.RpcObjectListExport_PDF,
+			PdfOptions: &pb.RpcObjectListExportPdfOptions{SetsAsReport: true},
+		})
+		assert.True(t, strings.HasPrefix(result, "%PDF-"))
+	})
+
+	t.Run("csv of collection", func(t *testing.T) {
+		result := writeDoc(t, newExport(t), pb.RpcObjectListExportRequest{Format: pb.RpcObjectListExport_CSV})
+		assert.Equal(t, "Name\nWrite report\nSend it\n", result)
+	})
+
+	t.Run("tsv of collection view", func(t *testing.T) {
+		result := writeDoc(t, newExport(t), pb.RpcObjectListExportRequest{
+			Format:     pb.RpcObjectListExport_CSV,
+			CsvOptions: &pb.RpcObjectListExportCsvOptions{Delimiter: "\t", ViewId: "all", ObjectIds: true},
+		})
+		assert.Equal(t, "ID\tName\ntask1\tWrite report\ntask2\tSend it\n", result)
+	})
+}
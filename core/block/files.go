@@ -92,3 +92,38 @@ func (s *Service) getFileOrLargestImage(ctx context.Context, hash string) (files
 
 	return f, nil
 }
+
+// CleanupOrphanedFiles offloads or deletes files that are not referenced by any object.
+// Files added recently are kept, see files.OrphanGracePeriod
+func (s *Service) CleanupOrphanedFiles(req *pb.RpcFileListCleanupOrphansRequest) (filesCleaned int, bytesCleaned uint64, err error) {
+	orphans, err := s.fileService.ListOrphanedFiles()
+	if err != nil {
+		return 0, 0, fmt.Errorf("list orphaned files: %w", err)
+	}
+	if len(orphans) == 0 {
+		return 0, 0, nil
+	}
+
+	if !req.Delete {
+		bytesOffloaded, filesOffloaded, err := s.fileService.FileListOffload(orphans, req.IncludeNotPinned)
+		return int(filesOffloaded), bytesOffloaded, err
+	}
+
+	deleted := make([]string, 0, len(orphans))
+	for _, fileID := range orphans {
+		size, err := s.fileService.FileDelete(fileID)
+		if err != nil {
+			log.With("fileID", fileID).Errorf("failed to delete orphaned file: %s", err)
+			continue
+		}
+		if err = s.objectStore.DeleteObject(fileID); err != nil {
+			log.With("fileID", fileID).Errorf("failed to delete orphaned file from local store: %s", err)
+		}
+		deleted = append(deleted, fileID)
+		bytesCleaned += size
+	}
+	if len(deleted) > 0 {
+		s.sendOnRemoveEvent(deleted...)
+	}
+	return len(deleted), bytesCleaned, nil
+}
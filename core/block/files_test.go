@@ -0,0 +1,58 @@
+package block
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/util/testMock"
+)
+
+func TestService_CleanupOrphanedFiles(t *testing.T) {
+	newService := func(t *testing.T) (*Service, *testMock.MockFileService, *testMock.MockObjectStore) {
+		ctrl := gomock.NewController(t)
+		fileService := testMock.NewMockFileService(ctrl)
+		store := testMock.NewMockObjectStore(ctrl)
+		return &Service{fileService: fileService, objectStore: store}, fileService, store
+	}
+
+	t.Run("delete", func(t *testing.T) {
+		s, fileService, store := newService(t)
+		// attached and recently added files are not listed as orphans by the file service
+		fileService.EXPECT().ListOrphanedFiles().Return([]string{"file1", "file2", "file3"}, nil)
+		fileService.EXPECT().FileDelete("file1").Return(uint64(10), nil)
+		fileService.EXPECT().FileDelete("file2").Return(uint64(0), errors.New("not found"))
+		fileService.EXPECT().FileDelete("file3").Return(uint64(30), nil)
+		store.EXPECT().DeleteObject("file1").Return(nil)
+		store.EXPECT().DeleteObject("file3").Return(nil)
+
+		filesCleaned, bytesCleaned, err := s.CleanupOrphanedFiles(&pb.RpcFileListCleanupOrphansRequest{Delete: true})
+		require.NoError(t, err)
+		assert.Equal(t, 2, filesCleaned)
+		assert.Equal(t, uint64(40), bytesCleaned)
+	})
+
+	t.Run("offload", func(t *testing.T) {
+		s, fileService, _ := newService(t)
+		fileService.EXPECT().ListOrphanedFiles().Return([]string{"file1"}, nil)
+		fileService.EXPECT().FileListOffload([]string{"file1"}, false).Return(uint64(10), uint64(1), nil)
+
+		filesCleaned, bytesCleaned, err := s.CleanupOrphanedFiles(&pb.RpcFileListCleanupOrphansRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, 1, filesCleaned)
+		assert.Equal(t, uint64(10), bytesCleaned)
+	})
+
+	t.Run("no orphans", func(t *testing.T) {
+		s, fileService, _ := newService(t)
+		fileService.EXPECT().ListOrphanedFiles().Return(nil, nil)
+
+		filesCleaned, _, err := s.CleanupOrphanedFiles(&pb.RpcFileListCleanupOrphansRequest{Delete: true})
+		require.NoError(t, err)
+		assert.Zero(t, filesCleaned)
+	})
+}
@@ -0,0 +1,267 @@
+package block
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/globalsign/mgo/bson"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/block/simple/text"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	coresb "github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+	textutil "github.com/anyproto/anytype-heart/util/text"
+)
+
+// findContextLen is the number of runes shown around the match in the preview
+const findContextLen = 30
+
+// findMatches returns the non-overlapping ranges of the text equal to the find string. The ranges are in UTF-16 code units,
+// like the ranges of the marks
+func findMatches(s, find string, matchCase bool) (ranges []*model.Range) {
+	runes, findRunes := []rune(s), []rune(find)
+	if len(findRunes) == 0 {
+		return nil
+	}
+	// offsets[i] is the UTF-16 position of the i-th rune
+	offsets := make([]int32, len(runes)+1)
+	for i, r := range runes {
+		offsets[i+1] = offsets[i] + int32(len(utf16.Encode([]rune{r})))
+	}
+	for i := 0; i+len(findRunes) <= len(runes); {
+		if runesEqual(runes[i:i+len(findRunes)], findRunes, matchCase) {
+			ranges = append(ranges, &model.Range{From: offsets[i], To: offsets[i+len(findRunes)]})
+			i += len(findRunes)
+			continue
+		}
+		i++
+	}
+	return ranges
+}
+
+func runesEqual(a, b []rune, matchCase bool) bool {
+	for i := range a {
+		if a[i] != b[i] && (matchCase || !strings.EqualFold(string(a[i]), string(b[i]))) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchContext returns the text around the range
+func matchContext(s string, r *model.Range) (before, after string) {
+	u := textutil.StrToUTF16(s)
+	before, after = textutil.UTF16ToStr(u[:r.From]), textutil.UTF16ToStr(u[r.To:])
+	if runes := []rune(before); len(runes) > findContextLen {
+		before = string(runes[len(runes)-findContextLen:])
+	}
+	if runes := []rune(after); len(runes) > findContextLen {
+		after = string(runes[:findContextLen])
+	}
+	return before, after
+}
+
+// replaceText replaces the ranges of the block text. The marks are moved or cut the same way as on the text paste,
+// except the marks covering the whole range: they cover the replacement too
+func replaceText(tb text.Block, ranges []*model.Range, replace string) {
+	replaceLen := int32(textutil.UTF16RuneCountString(replace))
+	for i := len(ranges) - 1; i >= 0; i-- {
+		r := &model.Range{From: ranges[i].From, To: ranges[i].To}
+		var covering []*model.BlockContentTextMark
+		if replaceLen > 0 {
+			for _, m := range tb.Model().GetText().GetMarks().GetMarks() {
+				if m.Range.From <= r.From && m.Range.To >= r.To {
+					// the parts of the mark around the replacement are joined with this one on the marks normalization
+					covering = append(covering, &model.BlockContentTextMark{Range: &model.Range{To: replaceLen}, Type: m.Type, Param: m.Param})
+				}
+			}
+		}
+		marks := tb.SplitMarks(r, covering, replace)
+		u := textutil.StrToUTF16(tb.GetText())
+		tb.SetText(textutil.UTF16ToStr(u[:r.From])+replace+textutil.UTF16ToStr(u[r.To:]), &model.BlockContentTextMarks{Marks: marks})
+	}
+}
+
+func replaceString(s string, ranges []*model.Range, replace string) string {
+	u := textutil.StrToUTF16(s)
+	var b strings.Builder
+	var pos int32
+	for _, r := range ranges {
+		b.WriteString(textutil.UTF16ToStr(u[pos:r.From]))
+		b.WriteString(replace)
+		pos = r.To
+	}
+	b.WriteString(textutil.UTF16ToStr(u[pos:]))
+	return b.String()
+}
+
+// findReplaceState finds the matches in the text blocks and in the detail of the state, they are replaced unless it's the preview.
+// The blocks bound to the details, like the title, are skipped: the detail itself should be requested
+func findReplaceState(st *state.State, req pb.RpcObjectFindReplaceRequest) (matches []*pb.RpcObjectFindReplaceMatch) {
+	var blockIDs []string
+	_ = st.Iterate(func(b simple.Block) bool {
+		if _, ok := b.(text.DetailsBlock); ok {
+			return true
+		}
+		if tb, ok := b.(text.Block); ok && len(findMatches(tb.GetText(), req.Find, req.MatchCase)) > 0 {
+			blockIDs = append(blockIDs, b.Model().Id)
+		}
+		return true
+	})
+	for _, id := range blockIDs {
+		tb := st.Get(id).(text.Block)
+		ranges := findMatches(tb.GetText(), req.Find, req.MatchCase)
+		for _, r := range ranges {
+			before, after := matchContext(tb.GetText(), r)
+			matches = append(matches, &pb.RpcObjectFindReplaceMatch{
+				ObjectId: st.RootId(),
+				BlockId:  id,
+				Range:    r,
+				Before:   before,
+				After:    after,
+			})
+		}
+		if !req.Preview {
+			replaceText(tb, ranges, req.Replace)
+		}
+	}
+
+	if req.DetailKey == "" {
+		return matches
+	}
+	value := pbtypes.GetString(st.Details(), req.DetailKey)
+	ranges := findMatches(value, req.Find, req.MatchCase)
+	for _, r := range ranges {
+		before, after := matchContext(value, r)
+		matches = append(matches, &pb.RpcObjectFindReplaceMatch{
+			ObjectId:  st.RootId(),
+			DetailKey: req.DetailKey,
+			Range:     r,
+			Before:    before,
+			After:     after,
+		})
+	}
+	if !req.Preview && len(ranges) > 0 {
+		st.SetDetail(req.DetailKey, pbtypes.String(replaceString(value, ranges, req.Replace)))
+	}
+	return matches
+}
+
+// FindReplace finds the string in the text blocks and in the requested detail of the objects and replaces it.
+// The changes of every object are applied at once, so they are undone in one step. Nothing is changed in the preview
+func (s *Service) FindReplace(req pb.RpcObjectFindReplaceRequest) (matches []*pb.RpcObjectFindReplaceMatch, err error) {
+	ids, err := s.findReplaceCandidates(req)
+	if err != nil {
+		return nil, err
+	}
+
+	queue := s.process.NewQueue(pb.ModelProcess{
+		Id:   bson.NewObjectId().Hex(),
+		Type: pb.ModelProcess_FindReplace,
+	}, 4)
+	queue.SetMessage("find and replace")
+	if err = queue.Start(); err != nil {
+		return nil, err
+	}
+	defer func() {
+		queue.Stop(err)
+	}()
+
+	// matches are kept in the order of the candidates, every task writes only its own element
+	objectMatches := make([][]*pb.RpcObjectFindReplaceMatch, len(ids))
+	for i, id := range ids {
+		i, id := i, id
+		if err = queue.Add(func() {
+			replaceErr := s.Do(id, func(sb smartblock.SmartBlock) error {
+				st := sb.NewState()
+				found := findReplaceState(st, req)
+				if len(found) == 0 {
+					return nil
+				}
+				if !req.Preview {
+					if err := sb.Apply(st); err != nil {
+						return err
+					}
+				}
+				objectMatches[i] = found
+				return nil
+			})
+			if replaceErr != nil {
+				log.With("objectID", id).Errorf("failed to replace text: %v", replaceErr)
+			}
+		}); err != nil {
+			return nil, err
+		}
+	}
+	if err = queue.Finalize(); err != nil {
+		return flattenMatches(objectMatches), err
+	}
+	return flattenMatches(objectMatches), nil
+}
+
+func flattenMatches(objectMatches [][]*pb.RpcObjectFindReplaceMatch) (matches []*pb.RpcObjectFindReplaceMatch) {
+	for _, found := range objectMatches {
+		matches = append(matches, found...)
+	}
+	return matches
+}
+
+// textObjectTypes are the types of the objects with the text blocks
+var textObjectTypes = []coresb.SmartBlockType{
+	coresb.SmartBlockTypePage,
+	coresb.SmartBlockTypeProfilePage,
+	coresb.SmartBlockTypeTemplate,
+}
+
+// findReplaceCandidates returns the ids of the objects which may contain the string. The full-text index matches the terms
+// and its results are capped, so its hits are only checked first: all the objects with text blocks are scanned after them.
+// The detail is filtered by the substring
+func (s *Service) findReplaceCandidates(req pb.RpcObjectFindReplaceRequest) ([]string, error) {
+	var ids []string
+	seen := make(map[string]struct{})
+	add := func(id string) {
+		if _, ok := seen[id]; !ok {
+			seen[id] = struct{}{}
+			ids = append(ids, id)
+		}
+	}
+
+	records, _, err := s.objectStore.Query(nil, database.Query{FullText: req.Find, Filters: req.Filters})
+	if err != nil {
+		return nil, fmt.Errorf("query objects by full text: %w", err)
+	}
+	for _, rec := range records {
+		add(pbtypes.GetString(rec.Details, bundle.RelationKeyId.String()))
+	}
+
+	textIDs, _, err := s.objectStore.QueryObjectIDs(database.Query{Filters: req.Filters}, textObjectTypes)
+	if err != nil {
+		return nil, fmt.Errorf("query objects with text: %w", err)
+	}
+	for _, id := range textIDs {
+		add(id)
+	}
+
+	if req.DetailKey == "" {
+		return ids, nil
+	}
+	records, _, err = s.objectStore.Query(nil, database.Query{Filters: append([]*model.BlockContentDataviewFilter{{
+		Condition:   model.BlockContentDataviewFilter_Like,
+		RelationKey: req.DetailKey,
+		Value:       pbtypes.String(req.Find),
+	}}, req.Filters...)})
+	if err != nil {
+		return nil, fmt.Errorf("query objects by detail: %w", err)
+	}
+	for _, rec := range records {
+		add(pbtypes.GetString(rec.Details, bundle.RelationKeyId.String()))
+	}
+	return ids, nil
+}
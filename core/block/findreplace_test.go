@@ -0,0 +1,131 @@
+package block
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/block/simple/text"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+	"github.com/anyproto/anytype-heart/util/testMock"
+)
+
+func TestFindMatches(t *testing.T) {
+	assert.Equal(t, []*model.Range{{From: 0, To: 3}, {From: 8, To: 11}}, findMatches("Foo and foo", "foo", false))
+	assert.Equal(t, []*model.Range{{From: 8, To: 11}}, findMatches("Foo and foo", "foo", true))
+	assert.Equal(t, []*model.Range{{From: 0, To: 2}, {From: 2, To: 4}}, findMatches("aaaaa", "aa", true))
+	// the emoji takes two UTF-16 code units
+	assert.Equal(t, []*model.Range{{From: 3, To: 6}}, findMatches("😀 Ünï", "ünï", false))
+	assert.Nil(t, findMatches("text", "", false))
+}
+
+func TestMatchContext(t *testing.T) {
+	before, after := matchContext("one two three", &model.Range{From: 4, To: 7})
+	assert.Equal(t, "one ", before)
+	assert.Equal(t, " three", after)
+
+	long := "0123456789012345678901234567890123456789"
+	before, after = matchContext(long+"x"+long, &model.Range{From: 40, To: 41})
+	assert.Len(t, before, findContextLen)
+	assert.Len(t, after, findContextLen)
+}
+
+func TestFindReplaceState(t *testing.T) {
+	newState := func() *state.State {
+		st := state.NewDoc("root", map[string]simple.Block{
+			"root": simple.New(&model.Block{Id: "root", ChildrenIds: []string{"title", "text"}}),
+			"title": simple.New(&model.Block{
+				Id:      "title",
+				Fields:  &types.Struct{Fields: map[string]*types.Value{text.DetailsKeyFieldName: pbtypes.StringList([]string{"name"})}},
+				Content: &model.BlockContentOfText{Text: &model.BlockContentText{Text: "Acme plan"}},
+			}),
+			"text": simple.New(&model.Block{Id: "text", Content: &model.BlockContentOfText{Text: &model.BlockContentText{
+				Text: "acme is Acme",
+				Marks: &model.BlockContentTextMarks{Marks: []*model.BlockContentTextMark{
+					{Range: &model.Range{From: 8, To: 12}, Type: model.BlockContentTextMark_Bold},
+				}},
+			}}}),
+		}).(*state.State)
+		st.SetDetail(bundle.RelationKeyName.String(), pbtypes.String("Acme plan"))
+		return st.NewState()
+	}
+
+	t.Run("preview", func(t *testing.T) {
+		st := newState()
+		matches := findReplaceState(st, pb.RpcObjectFindReplaceRequest{Find: "acme", Replace: "Globex", Preview: true, DetailKey: "name"})
+		require.Len(t, matches, 3)
+		assert.Equal(t, &pb.RpcObjectFindReplaceMatch{ObjectId: "root", BlockId: "text", Range: &model.Range{From: 0, To: 4}, After: " is Acme"}, matches[0])
+		assert.Equal(t, "name", matches[2].DetailKey)
+		assert.Equal(t, "acme is Acme", st.Get("text").Model().GetText().Text)
+		assert.Equal(t, "Acme plan", pbtypes.GetString(st.Details(), "name"))
+	})
+
+	t.Run("replace", func(t *testing.T) {
+		st := newState()
+		matches := findReplaceState(st, pb.RpcObjectFindReplaceRequest{Find: "Acme", Replace: "Globex", MatchCase: true, DetailKey: "name"})
+		assert.Len(t, matches, 2)
+		tb := st.Get("text").Model().GetText()
+		assert.Equal(t, "acme is Globex", tb.Text)
+		assert.Equal(t, []*model.BlockContentTextMark{
+			{Range: &model.Range{From: 8, To: 14}, Type: model.BlockContentTextMark_Bold},
+		}, tb.Marks.Marks)
+		assert.Equal(t, "Globex plan", pbtypes.GetString(st.Details(), "name"))
+	})
+
+	t.Run("marks after the match are moved", func(t *testing.T) {
+		st := newState()
+		findReplaceState(st, pb.RpcObjectFindReplaceRequest{Find: "acme ", Replace: "", MatchCase: true})
+		tb := st.Get("text").Model().GetText()
+		assert.Equal(t, "is Acme", tb.Text)
+		assert.Equal(t, &model.Range{From: 3, To: 7}, tb.Marks.Marks[0].Range)
+	})
+
+	t.Run("mark covering the match", func(t *testing.T) {
+		st := newState()
+		findReplaceState(st, pb.RpcObjectFindReplaceRequest{Find: "cm", Replace: "CMX", MatchCase: true})
+		tb := st.Get("text").Model().GetText()
+		assert.Equal(t, "aCMXe is ACMXe", tb.Text)
+		assert.Equal(t, []*model.BlockContentTextMark{
+			{Range: &model.Range{From: 9, To: 14}, Type: model.BlockContentTextMark_Bold},
+		}, tb.Marks.Marks)
+	})
+}
+
+func TestService_FindReplaceCandidates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := testMock.NewMockObjectStore(ctrl)
+	s := &Service{objectStore: store}
+
+	// the full-text index returns at most 100 hits, the rest of the objects with text are scanned
+	var ftsHits []database.Record
+	var textIDs []string
+	for i := 0; i < 150; i++ {
+		id := fmt.Sprintf("page%d", i)
+		textIDs = append(textIDs, id)
+		if i >= 50 {
+			ftsHits = append(ftsHits, database.Record{Details: &types.Struct{Fields: map[string]*types.Value{
+				bundle.RelationKeyId.String(): pbtypes.String(id),
+			}}})
+		}
+	}
+	store.EXPECT().Query(gomock.Any(), database.Query{FullText: "foo"}).Return(ftsHits, len(ftsHits), nil)
+	store.EXPECT().QueryObjectIDs(database.Query{}, textObjectTypes).Return(textIDs, 0, nil)
+
+	ids, err := s.findReplaceCandidates(pb.RpcObjectFindReplaceRequest{Find: "foo"})
+	require.NoError(t, err)
+	require.Len(t, ids, 150)
+	// the hits of the full-text index are checked first
+	assert.Equal(t, "page50", ids[0])
+	assert.Equal(t, "page149", ids[99])
+	assert.Equal(t, "page0", ids[100])
+}
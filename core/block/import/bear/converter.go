@@ -0,0 +1,242 @@
+package bear
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/anyproto/anytype-heart/core/block/collection"
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+var log = logging.Logger("bear-import")
+
+const numberOfStages = 2 // 1 cycle to get snapshots and 1 cycle to create objects
+const (
+	Name               = "Bear"
+	rootCollectionName = "Bear Import"
+	textPackExt        = ".textpack"
+)
+
+// archiveExtensions are the zipped exports: zip archive, TextPack which is zipped TextBundle and Bear backup
+var archiveExtensions = []string{".zip", textPackExt, ".bear2bk"}
+
+// Bear imports the notes exported from Bear as TextBundle or Markdown, the tags written in the text become the tags of the objects
+type Bear struct {
+	tempDirProvider core.TempDirProvider
+	service         *collection.Service
+}
+
+func New(tempDirProvider core.TempDirProvider, service *collection.Service) converter.Converter {
+	return &Bear{tempDirProvider: tempDirProvider, service: service}
+}
+
+func (b *Bear) Name() string {
+	return Name
+}
+
+func (b *Bear) GetParams(req *pb.RpcObjectImportRequest) []string {
+	if p := req.GetBearParams(); p != nil {
+		return p.Path
+	}
+
+	return nil
+}
+
+func (b *Bear) GetSnapshots(req *pb.RpcObjectImportRequest, progress process.Progress) (*converter.Response, *converter.ConvertError) {
+	paths := b.GetParams(req)
+	if len(paths) == 0 {
+		return nil, nil
+	}
+	progress.SetProgressMessage("Start creating snapshots from notes")
+	cErr := converter.NewError()
+	snapshots, targetObjects, cancelError := b.getSnapshotsForImport(req, progress, paths, cErr)
+	if !cancelError.IsEmpty() {
+		return nil, cancelError
+	}
+	if (!cErr.IsEmpty() && req.Mode == pb.RpcObjectImportRequest_ALL_OR_NOTHING) || cErr.IsNoObjectToImportError(len(paths)) {
+		return nil, cErr
+	}
+	rootCollection := converter.NewRootCollection(b.service)
+	rootCol, err := rootCollection.MakeRootCollection(rootCollectionName, targetObjects)
+	if err != nil {
+		cErr.Add(err)
+		if req.Mode == pb.RpcObjectImportRequest_ALL_OR_NOTHING {
+			return nil, cErr
+		}
+	}
+	if rootCol != nil {
+		snapshots = append(snapshots, rootCol)
+	}
+	progress.SetTotal(int64(numberOfStages * len(snapshots)))
+	if cErr.IsEmpty() {
+		return &converter.Response{Snapshots: snapshots}, nil
+	}
+	return &converter.Response{
+		Snapshots: snapshots,
+	}, cErr
+}
+
+func (b *Bear) getSnapshotsForImport(req *pb.RpcObjectImportRequest,
+	progress process.Progress,
+	paths []string,
+	cErr *converter.ConvertError) ([]*converter.Snapshot, []string, *converter.ConvertError) {
+	snapshots := make([]*converter.Snapshot, 0)
+	targetObjects := make([]string, 0)
+	// the tags are shared by the notes of all exports
+	tags := converter.NewTags()
+	for _, p := range paths {
+		if err := progress.TryStep(1); err != nil {
+			return nil, nil, converter.NewCancelError(err)
+		}
+		sn, to, err := b.handleImportPath(p, req.GetMode(), tags)
+		if err != nil {
+			cErr.Add(err)
+			if req.Mode == pb.RpcObjectImportRequest_ALL_OR_NOTHING {
+				return nil, nil, nil
+			}
+			continue
+		}
+		snapshots = append(snapshots, sn...)
+		targetObjects = append(targetObjects, to...)
+	}
+	return snapshots, targetObjects, nil
+}
+
+func (b *Bear) handleImportPath(p string, mode pb.RpcObjectImportRequestMode, tags *converter.Tags) ([]*converter.Snapshot, []string, error) {
+	fsys, closer, err := openExport(p)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open %s: %w", p, err)
+	}
+	if closer != nil {
+		defer closeReader(closer)
+	}
+	notes, err := exportNotes(fsys, p)
+	if err != nil {
+		if mode == pb.RpcObjectImportRequest_ALL_OR_NOTHING {
+			return nil, nil, err
+		}
+	}
+	if len(notes) == 0 {
+		return nil, nil, converter.ErrNoObjectsToImport
+	}
+	var (
+		snapshots     []*converter.Snapshot
+		targetObjects []string
+	)
+	for _, f := range notes {
+		n, err := readNote(fsys, f, b.tempDir())
+		if err != nil {
+			if mode == pb.RpcObjectImportRequest_ALL_OR_NOTHING {
+				return nil, nil, fmt.Errorf("%s: %w", f.name, err)
+			}
+			log.Errorf("failed to convert %s: %s", f.name, err)
+			continue
+		}
+		sourcePath := filepath.Join(p, filepath.FromSlash(f.name))
+		if isMarkdown(p) {
+			sourcePath = p
+		}
+		sn, options := b.getSnapshot(n, sourcePath, tags)
+		snapshots = append(snapshots, sn)
+		snapshots = append(snapshots, options...)
+		targetObjects = append(targetObjects, sn.Id)
+	}
+	return snapshots, targetObjects, nil
+}
+
+// openExport opens the export as file system: the archive, the directory with notes or the single markdown file
+func openExport(p string) (fs.FS, io.Closer, error) {
+	if isArchive(p) {
+		r, err := zip.OpenReader(p)
+		if err != nil {
+			return nil, nil, err
+		}
+		return r, r, nil
+	}
+	info, err := os.Stat(p)
+	if err != nil {
+		return nil, nil, err
+	}
+	if info.IsDir() {
+		return os.DirFS(p), nil, nil
+	}
+	if !isMarkdown(p) {
+		return nil, nil, fmt.Errorf("expected markdown file, TextBundle or archive")
+	}
+	// the attachments of the markdown export are placed next to the note
+	return os.DirFS(filepath.Dir(p)), nil, nil
+}
+
+// exportNotes returns the notes of the export, TextPack is the single zipped bundle
+func exportNotes(fsys fs.FS, p string) ([]noteFile, error) {
+	if info, err := os.Stat(p); err == nil && info.Mode().IsRegular() && isMarkdown(p) {
+		return []noteFile{{name: filepath.Base(p), dir: ".", title: titleFromName(p)}}, nil
+	}
+	rootName := filepath.Base(p)
+	if strings.EqualFold(filepath.Ext(p), textPackExt) {
+		rootName = strings.TrimSuffix(rootName, filepath.Ext(rootName)) + bundleExt
+	}
+	return findNotes(fsys, rootName)
+}
+
+func isArchive(p string) bool {
+	ext := strings.ToLower(filepath.Ext(p))
+	for _, e := range archiveExtensions {
+		if ext == e {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *Bear) tempDir() string {
+	if b.tempDirProvider != nil {
+		return b.tempDirProvider.TempDir()
+	}
+	return os.TempDir()
+}
+
+func (b *Bear) getSnapshot(n *note, p string, tags *converter.Tags) (*converter.Snapshot, []*converter.Snapshot) {
+	details := converter.GetCommonDetails(p, n.title, "")
+	if n.created != 0 {
+		details.Fields[bundle.RelationKeyCreatedDate.String()] = pbtypes.Int64(n.created)
+	}
+	if n.modified != 0 {
+		details.Fields[bundle.RelationKeyLastModifiedDate.String()] = pbtypes.Int64(n.modified)
+	}
+	sn := &model.SmartBlockSnapshotBase{
+		Blocks:      n.blocks,
+		Details:     details,
+		ObjectTypes: []string{bundle.TypeKeyPage.URL()},
+	}
+	options := tags.Set(sn, n.tags)
+
+	snapshot := &converter.Snapshot{
+		Id:       uuid.New().String(),
+		FileName: p,
+		Snapshot: &pb.ChangeSnapshot{Data: sn},
+		SbType:   smartblock.SmartBlockTypePage,
+	}
+	return snapshot, options
+}
+
+func closeReader(rc io.Closer) {
+	if err := rc.Close(); err != nil {
+		log.Errorf("failed to close file: %s", err)
+	}
+}
@@ -0,0 +1,114 @@
+package bear
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+type tempDirProvider struct {
+	dir string
+}
+
+func (p tempDirProvider) TempDir() string {
+	return p.dir
+}
+
+// importNotes returns the imported notes by their names and the names of the tags by their ids
+func importNotes(t *testing.T, dir string, paths ...string) (map[string]*model.SmartBlockSnapshotBase, map[string]string) {
+	b := &Bear{tempDirProvider: tempDirProvider{dir: dir}}
+	res, ce := b.GetSnapshots(&pb.RpcObjectImportRequest{
+		Params: &pb.RpcObjectImportRequestParamsOfBearParams{
+			BearParams: &pb.RpcObjectImportRequestBearParams{Path: paths},
+		},
+		Type: pb.RpcObjectImportRequest_Bear,
+		Mode: pb.RpcObjectImportRequest_ALL_OR_NOTHING,
+	}, process.NewProgress(pb.ModelProcess_Import))
+	require.Nil(t, ce)
+	require.NotNil(t, res)
+
+	notes := make(map[string]*model.SmartBlockSnapshotBase)
+	tags := make(map[string]string)
+	for _, sn := range res.Snapshots {
+		data := sn.Snapshot.Data
+		name := pbtypes.GetString(data.Details, bundle.RelationKeyName.String())
+		switch data.ObjectTypes[0] {
+		case bundle.TypeKeyPage.URL():
+			notes[name] = data
+		case bundle.TypeKeyRelationOption.URL():
+			tags[sn.Id] = name
+		}
+	}
+	return notes, tags
+}
+
+func tagNames(note *model.SmartBlockSnapshotBase, tags map[string]string) []string {
+	var res []string
+	for _, id := range pbtypes.GetStringList(note.Details, bundle.RelationKeyTag.String()) {
+		res = append(res, tags[id])
+	}
+	return res
+}
+
+func TestBear_GetSnapshots(t *testing.T) {
+	t.Run("export directory", func(t *testing.T) {
+		dir := t.TempDir()
+		notes, tags := importNotes(t, dir, "testdata/export")
+		require.Len(t, notes, 2)
+		// the tags are shared by the notes
+		assert.Len(t, tags, 3)
+
+		recipe := notes["Pancakes"]
+		require.NotNil(t, recipe)
+		assert.Equal(t, []string{"family recipes", "cooking", "cooking/breakfast"}, tagNames(recipe, tags))
+		assert.Equal(t, int64(1697619600), pbtypes.GetInt64(recipe.Details, bundle.RelationKeyCreatedDate.String()))
+		assert.Equal(t, int64(1697709600), pbtypes.GetInt64(recipe.Details, bundle.RelationKeyLastModifiedDate.String()))
+
+		bl := recipe.Blocks
+		require.Len(t, bl, 5)
+		assert.Equal(t, "Mix the flour with #cooking and #family recipes#.", bl[0].GetText().Text)
+		image := bl[1].GetFile()
+		require.NotNil(t, image)
+		assert.Equal(t, model.BlockContentFile_Image, image.Type)
+		assert.True(t, strings.HasPrefix(image.Name, dir))
+		assert.FileExists(t, image.Name)
+		pdf := bl[2].GetFile()
+		require.NotNil(t, pdf)
+		assert.Equal(t, model.BlockContentFile_PDF, pdf.Type)
+		assert.FileExists(t, pdf.Name)
+		assert.Equal(t, model.BlockContentText_Code, bl[3].GetText().Style)
+
+		plain := notes["Plain note"]
+		require.NotNil(t, plain)
+		assert.Equal(t, []string{"cooking"}, tagNames(plain, tags))
+		assert.NotZero(t, pbtypes.GetInt64(plain.Details, bundle.RelationKeyLastModifiedDate.String()))
+		require.Len(t, plain.Blocks, 2)
+		assert.FileExists(t, plain.Blocks[1].GetFile().GetName())
+	})
+
+	t.Run("single bundle and textpack", func(t *testing.T) {
+		notes, _ := importNotes(t, t.TempDir(), "testdata/export/Recipe.textbundle", "testdata/Recipe.textpack")
+		// the notes have the same title
+		require.Len(t, notes, 1)
+		assert.Len(t, notes["Pancakes"].Blocks, 5)
+	})
+
+	t.Run("single markdown file", func(t *testing.T) {
+		notes, _ := importNotes(t, t.TempDir(), "testdata/export/Plain note.md")
+		require.Len(t, notes, 1)
+		assert.NotNil(t, notes["Plain note"])
+	})
+}
+
+func TestFindTags(t *testing.T) {
+	assert.Equal(t, []string{"multi word", "tag", "nested/tag"}, findTags("#tag and #multi word# with #nested/tag, #1"))
+	assert.Empty(t, findTags("no tags in https://example.com/#anchor or # heading"))
+}
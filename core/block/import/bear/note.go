@@ -0,0 +1,291 @@
+package bear
+
+import (
+	"encoding/json"
+	"io"
+	"io/fs"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/globalsign/mgo/bson"
+
+	"github.com/anyproto/anytype-heart/core/block/import/markdown/anymark"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	textutil "github.com/anyproto/anytype-heart/util/text"
+)
+
+const (
+	bundleExt   = ".textbundle"
+	infoFile    = "info.json"
+	bearInfoKey = "net.shinyfrog.bear"
+)
+
+var (
+	// multiWordTagRegexp matches the tags with spaces, they are closed by # in Bear: #multi word tag#
+	multiWordTagRegexp = regexp.MustCompile(`(?:^|\s)#([^\s#][^#\n]*[^\s#])#`)
+	tagRegexp          = regexp.MustCompile(`(?:^|\s)#([^\s#]+)`)
+	numberRegexp       = regexp.MustCompile(`^\d+$`)
+)
+
+// noteFile is the markdown text of the note, dir is the directory the links to the attachments are relative to
+type noteFile struct {
+	name  string
+	dir   string
+	title string
+}
+
+// note is the note converted to blocks
+type note struct {
+	title    string
+	blocks   []*model.Block
+	tags     []string
+	created  int64
+	modified int64
+}
+
+// isText reports whether the file is the text of TextBundle, it's named text.md, text.markdown etc.
+func isText(name string) bool {
+	base := path.Base(name)
+	return strings.TrimSuffix(base, path.Ext(base)) == "text" && isMarkdown(base)
+}
+
+func isMarkdown(name string) bool {
+	switch strings.ToLower(path.Ext(name)) {
+	case ".md", ".markdown", ".txt":
+		return true
+	}
+	return false
+}
+
+func isBundle(dir string) bool {
+	return strings.EqualFold(path.Ext(dir), bundleExt)
+}
+
+// insideBundle reports whether any of the parent directories is TextBundle, the bundles contain assets besides the text
+func insideBundle(name string) bool {
+	for dir := path.Dir(name); dir != "." && dir != "/"; dir = path.Dir(dir) {
+		if isBundle(dir) {
+			return true
+		}
+	}
+	return false
+}
+
+// findNotes returns the notes of the export: the texts of TextBundles and the markdown files outside the bundles.
+// rootName is the name of the export, it's the title of the note when the export is a single bundle
+func findNotes(fsys fs.FS, rootName string) ([]noteFile, error) {
+	var notes []noteFile
+	err := fs.WalkDir(fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if name == "__MACOSX" {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		dir := path.Dir(name)
+		switch {
+		case isBundle(dir) && isText(name):
+			notes = append(notes, noteFile{name: name, dir: dir, title: titleFromName(dir)})
+		case dir == "." && isBundle(rootName) && isText(name):
+			notes = append(notes, noteFile{name: name, dir: dir, title: titleFromName(rootName)})
+		case !insideBundle(name) && !isBundle(rootName) && isMarkdown(name) && !isText(name):
+			notes = append(notes, noteFile{name: name, dir: dir, title: titleFromName(name)})
+		}
+		return nil
+	})
+	return notes, err
+}
+
+func titleFromName(name string) string {
+	base := path.Base(filepath.ToSlash(name))
+	return strings.TrimSuffix(base, path.Ext(base))
+}
+
+// readNote converts the markdown to blocks, the attachments are copied from the export to the temp dir
+func readNote(fsys fs.FS, f noteFile, tempDir string) (*note, error) {
+	data, err := fs.ReadFile(fsys, f.name)
+	if err != nil {
+		return nil, err
+	}
+	blocks, _, err := anymark.MarkdownToBlocks(data, f.dir, nil)
+	if err != nil {
+		return nil, err
+	}
+	n := &note{title: f.title}
+	n.blocks = removeTitle(blocks, &n.title)
+	for _, b := range n.blocks {
+		if err = convertAttachments(fsys, b, tempDir); err != nil {
+			return nil, err
+		}
+		if t := b.GetText(); t != nil && t.Style != model.BlockContentText_Code {
+			n.tags = append(n.tags, findTags(t.Text)...)
+		}
+	}
+	n.tags = uniqueTags(n.tags)
+	n.created, n.modified = readDates(fsys, f)
+	return n, nil
+}
+
+// removeTitle removes the first heading of the note, Bear writes the title of the note there
+func removeTitle(blocks []*model.Block, title *string) []*model.Block {
+	for i, b := range blocks {
+		t := b.GetText()
+		if t == nil {
+			continue
+		}
+		if t.Style != model.BlockContentText_Header1 || t.Text == "" {
+			return blocks
+		}
+		*title = t.Text
+		return append(blocks[:i], blocks[i+1:]...)
+	}
+	return blocks
+}
+
+func convertAttachments(fsys fs.FS, b *model.Block, tempDir string) error {
+	if f := b.GetFile(); f != nil {
+		name := path.Clean(filepath.ToSlash(f.Name))
+		if !isFile(fsys, name) {
+			return nil
+		}
+		tempPath, err := copyToTempDir(fsys, name, tempDir)
+		if err != nil {
+			return err
+		}
+		f.Name = tempPath
+		return nil
+	}
+	t := b.GetText()
+	if t == nil || t.Marks == nil || len(t.Marks.Marks) != 1 {
+		return nil
+	}
+	mark := t.Marks.Marks[0]
+	if mark.Type != model.BlockContentTextMark_Link || mark.Range.From != 0 || mark.Range.To != int32(textutil.UTF16RuneCountString(t.Text)) {
+		return nil
+	}
+	// the links to the attachments which take the whole line become files
+	name := path.Clean(filepath.ToSlash(mark.Param))
+	if !isFile(fsys, name) {
+		return nil
+	}
+	tempPath, err := copyToTempDir(fsys, name, tempDir)
+	if err != nil {
+		return err
+	}
+	b.Content = &model.BlockContentOfFile{File: &model.BlockContentFile{
+		Name:  tempPath,
+		State: model.BlockContentFile_Empty,
+		Type:  fileType(name),
+	}}
+	return nil
+}
+
+func isFile(fsys fs.FS, name string) bool {
+	if !fs.ValidPath(name) {
+		return false
+	}
+	info, err := fs.Stat(fsys, name)
+	return err == nil && !info.IsDir()
+}
+
+func copyToTempDir(fsys fs.FS, name, tempDir string) (string, error) {
+	rc, err := fsys.Open(name)
+	if err != nil {
+		return "", err
+	}
+	defer closeReader(rc)
+	tempPath := filepath.Join(tempDir, bson.NewObjectId().Hex()+"_"+path.Base(name))
+	f, err := os.Create(tempPath)
+	if err != nil {
+		return "", err
+	}
+	if _, err = io.Copy(f, rc); err != nil {
+		closeReader(f)
+		return "", err
+	}
+	return tempPath, f.Close()
+}
+
+func fileType(name string) model.BlockContentFileType {
+	mimeType := mime.TypeByExtension(strings.ToLower(path.Ext(name)))
+	switch {
+	case strings.HasPrefix(mimeType, "image/"):
+		return model.BlockContentFile_Image
+	case strings.HasPrefix(mimeType, "video/"):
+		return model.BlockContentFile_Video
+	case strings.HasPrefix(mimeType, "audio/"):
+		return model.BlockContentFile_Audio
+	case mimeType == "application/pdf":
+		return model.BlockContentFile_PDF
+	}
+	return model.BlockContentFile_File
+}
+
+// findTags returns the tags written in the text: #tag, #nested/tag and #multi word tag#
+func findTags(text string) []string {
+	var tags []string
+	for _, m := range multiWordTagRegexp.FindAllStringSubmatch(text, -1) {
+		tags = append(tags, m[1])
+	}
+	text = multiWordTagRegexp.ReplaceAllString(text, " ")
+	for _, m := range tagRegexp.FindAllStringSubmatch(text, -1) {
+		tag := strings.TrimRight(m[1], ".,;:!?)")
+		// the numbers like #1 aren't tags
+		if tag != "" && !numberRegexp.MatchString(tag) {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+func uniqueTags(tags []string) []string {
+	seen := make(map[string]struct{}, len(tags))
+	res := tags[:0]
+	for _, t := range tags {
+		if _, ok := seen[t]; ok {
+			continue
+		}
+		seen[t] = struct{}{}
+		res = append(res, t)
+	}
+	return res
+}
+
+// readDates returns the dates of the note from info.json of TextBundle made by Bear,
+// the modification date of the file is used if there is no info
+func readDates(fsys fs.FS, f noteFile) (created, modified int64) {
+	var (
+		info map[string]json.RawMessage
+		bear struct {
+			CreationDate     time.Time `json:"creationDate"`
+			ModificationDate time.Time `json:"modificationDate"`
+		}
+	)
+	if data, err := fs.ReadFile(fsys, path.Join(f.dir, infoFile)); err == nil && json.Unmarshal(data, &info) == nil {
+		if raw, ok := info[bearInfoKey]; ok && json.Unmarshal(raw, &bear) == nil {
+			if !bear.CreationDate.IsZero() {
+				created = bear.CreationDate.Unix()
+			}
+			if !bear.ModificationDate.IsZero() {
+				modified = bear.ModificationDate.Unix()
+			}
+		}
+	}
+	if modified == 0 {
+		if stat, err := fs.Stat(fsys, f.name); err == nil && !stat.ModTime().IsZero() {
+			modified = stat.ModTime().Unix()
+		}
+	}
+	if created == 0 {
+		created = modified
+	}
+	return created, modified
+}
@@ -174,7 +174,8 @@ func handleLinkBlock(oldIDtoNew map[string]string, block simple.Block, st *state
 	if lo.Contains(filesIDs, targetBlockID) {
 		return
 	}
-	newTarget := oldIDtoNew[targetBlockID]
+	targetObjectID, targetBlock := addr.SplitBlockReference(targetBlockID)
+	newTarget := oldIDtoNew[targetObjectID]
 	if newTarget == "" {
 		if widget.IsPredefinedWidgetTargetId(targetBlockID) {
 			return
@@ -182,10 +183,10 @@ func handleLinkBlock(oldIDtoNew map[string]string, block simple.Block, st *state
 		if isBundledObjects(targetBlockID) {
 			return
 		}
-		newTarget = addr.MissingObject
+		newTarget, targetBlock = addr.MissingObject, ""
 	}
 
-	block.Model().GetLink().TargetBlockId = newTarget
+	block.Model().GetLink().TargetBlockId = addr.BlockReference(newTarget, targetBlock)
 	st.Set(simple.New(block.Model()))
 }
 
@@ -217,12 +218,13 @@ func handleMarkdownTest(oldIDtoNew map[string]string, block simple.Block, st *st
 		if isBundledObjects(mark.Param) {
 			return
 		}
-		newTarget := oldIDtoNew[mark.Param]
+		targetObjectID, targetBlock := addr.SplitBlockReference(mark.Param)
+		newTarget := oldIDtoNew[targetObjectID]
 		if newTarget == "" {
-			newTarget = addr.MissingObject
+			newTarget, targetBlock = addr.MissingObject, ""
 		}
 
-		marks[i].Param = newTarget
+		marks[i].Param = addr.BlockReference(newTarget, targetBlock)
 	}
 	st.Set(simple.New(block.Model()))
 }
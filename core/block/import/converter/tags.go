@@ -0,0 +1,76 @@
+package converter
+
+import (
+	"strings"
+	"time"
+
+	"github.com/globalsign/mgo/bson"
+	"github.com/gogo/protobuf/types"
+
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	coresb "github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+// Tags makes the options of the bundled tag relation for the imported objects,
+// the options with the same name are shared by all objects of the import
+type Tags struct {
+	options map[string]string
+}
+
+func NewTags() *Tags {
+	return &Tags{options: make(map[string]string)}
+}
+
+// Set writes the tags to the details of the snapshot and returns the snapshots of the options which weren't made yet
+func (t *Tags) Set(sn *model.SmartBlockSnapshotBase, names []string) []*Snapshot {
+	var (
+		ids       []string
+		snapshots []*Snapshot
+	)
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		id, ok := t.options[name]
+		if !ok {
+			id = bson.NewObjectId().Hex()
+			t.options[name] = id
+			snapshots = append(snapshots, tagOptionSnapshot(id, name))
+		}
+		ids = append(ids, id)
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	if sn.Details == nil {
+		sn.Details = &types.Struct{Fields: map[string]*types.Value{}}
+	}
+	sn.Details.Fields[bundle.RelationKeyTag.String()] = pbtypes.StringList(ids)
+	sn.RelationLinks = append(sn.RelationLinks, &model.RelationLink{
+		Key:    bundle.RelationKeyTag.String(),
+		Format: model.RelationFormat_tag,
+	})
+	return snapshots
+}
+
+func tagOptionSnapshot(id, name string) *Snapshot {
+	details := &types.Struct{Fields: map[string]*types.Value{
+		bundle.RelationKeyName.String():        pbtypes.String(name),
+		bundle.RelationKeyRelationKey.String(): pbtypes.String(bundle.RelationKeyTag.String()),
+		bundle.RelationKeyLayout.String():      pbtypes.Float64(float64(model.ObjectType_relationOption)),
+		bundle.RelationKeyCreatedDate.String(): pbtypes.Int64(time.Now().Unix()),
+		bundle.RelationKeyId.String():          pbtypes.String(id),
+	}}
+	return &Snapshot{
+		Id:     id,
+		SbType: coresb.SmartBlockTypeSubObject,
+		Snapshot: &pb.ChangeSnapshot{Data: &model.SmartBlockSnapshotBase{
+			Details:     details,
+			ObjectTypes: []string{bundle.TypeKeyRelationOption.URL()},
+		}},
+	}
+}
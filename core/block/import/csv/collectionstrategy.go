@@ -77,14 +77,21 @@ func (c *CollectionStrategy) CreateObjects(path string, csvTable [][]string, par
 func updateDetailsForTransposeCollection(details *types.Struct, transpose bool) {
 	if transpose {
 		source := pbtypes.GetString(details, bundle.RelationKeySourceFilePath.String())
-		source = source + string(filepath.Separator) + transposeSource
-		details.Fields[bundle.RelationKeySourceFilePath.String()] = pbtypes.String(source)
+		details.Fields[bundle.RelationKeySourceFilePath.String()] = pbtypes.String(collectionSourcePath(source, transpose))
 		name := pbtypes.GetString(details, bundle.RelationKeyName.String())
 		name = name + " " + transposeName
 		details.Fields[bundle.RelationKeyName.String()] = pbtypes.String(name)
 	}
 }
 
+// collectionSourcePath returns the source path of the collection imported from the file
+func collectionSourcePath(path string, transpose bool) string {
+	if transpose {
+		return path + string(filepath.Separator) + transposeSource
+	}
+	return path
+}
+
 func getDetailsFromCSVTable(csvTable [][]string, useFirstRowForRelations bool) ([]*model.Relation, []*converter.Snapshot, error) {
 	if len(csvTable) == 0 {
 		return nil, nil, nil
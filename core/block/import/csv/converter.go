@@ -146,7 +146,7 @@ func (c *CSV) getSnapshots(mode pb.RpcObjectImportRequestMode,
 			cErr = converter.NewCancelError(err)
 			return nil
 		}
-		csvTable, err := c.getCSVTable(rc, params.GetDelimiter())
+		csvTable, err := getCSVTable(rc, params.GetDelimiter())
 		if err != nil {
 			cErr.Add(err)
 			if mode == pb.RpcObjectImportRequest_ALL_OR_NOTHING {
@@ -173,7 +173,7 @@ func (c *CSV) getSnapshots(mode pb.RpcObjectImportRequestMode,
 	return &Result{objectIDs: allObjectsIDs, snapshots: allSnapshots}
 }
 
-func (c *CSV) getCSVTable(rc io.ReadCloser, delimiter string) ([][]string, error) {
+func getCSVTable(rc io.ReadCloser, delimiter string) ([][]string, error) {
 	defer rc.Close()
 	csvReader := csv.NewReader(rc)
 	csvReader.LazyQuotes = true
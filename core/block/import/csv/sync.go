@@ -0,0 +1,614 @@
+package csv
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/anyproto/anytype-heart/core/block"
+	"github.com/anyproto/anytype-heart/core/block/collection"
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/editor/table"
+	"github.com/anyproto/anytype-heart/core/block/editor/template"
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/import/source"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/session"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	sb "github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+const (
+	// idColumn is the column with the ids of the objects, it's written by the CSV export
+	idColumn = "ID"
+	// listSeparator separates the values of tag, status, object and file relations in the cell
+	listSeparator = ","
+	dateLayout    = "2006-01-02"
+)
+
+// syncColumn is the column of the table and the relation of the objects it's matched with
+type syncColumn struct {
+	index  int
+	name   string
+	key    string // empty until the relation is created
+	format model.RelationFormat
+	isID   bool
+}
+
+// Syncer updates the objects of the collection imported earlier by the rows of the CSV file:
+// the rows are matched with the objects by the key column, the matched objects get the values of the row
+// and the rest of the rows are created as the new objects of the collection
+type Syncer struct {
+	service           *block.Service
+	collectionService *collection.Service
+	objectStore       objectstore.ObjectStore
+}
+
+func NewSyncer(service *block.Service, collectionService *collection.Service, objectStore objectstore.ObjectStore) *Syncer {
+	return &Syncer{service: service, collectionService: collectionService, objectStore: objectStore}
+}
+
+// Sync returns the changes of the objects made by the CSV files, they aren't applied if preview is requested.
+// The changes failed to apply have the error set
+func (s *Syncer) Sync(ctx *session.Context, req *pb.RpcObjectImportRequest) ([]*pb.RpcObjectImportChange, error) {
+	params := req.GetCsvParams()
+	if params.GetKeyColumn() == "" {
+		return nil, fmt.Errorf("key column is not set")
+	}
+	if params.Mode != pb.RpcObjectImportRequestCsvParams_COLLECTION {
+		return nil, fmt.Errorf("sync is supported only in collection mode")
+	}
+	tables := make(map[string][][]string)
+	for _, p := range params.Path {
+		src := source.GetSource(p)
+		if src == nil {
+			return nil, fmt.Errorf("failed to identify source: %s", p)
+		}
+		readers, err := src.GetFileReaders(p, []string{".csv"})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get readers: %w", err)
+		}
+		for filePath, rc := range readers {
+			csvTable, err := getCSVTable(rc, params.Delimiter)
+			if err != nil {
+				return nil, err
+			}
+			if params.TransposeRowsAndColumns && len(csvTable) != 0 {
+				csvTable = transpose(csvTable)
+			}
+			tables[filePath] = csvTable
+		}
+	}
+	if len(tables) == 0 {
+		return nil, converter.ErrNoObjectsToImport
+	}
+	if params.CollectionId != "" && len(tables) > 1 {
+		return nil, fmt.Errorf("collection can be synced with one file only, got %d", len(tables))
+	}
+	changes := make([]*pb.RpcObjectImportChange, 0)
+	for filePath, csvTable := range tables {
+		tableChanges, err := s.syncTable(ctx, filePath, csvTable, params)
+		if err != nil {
+			// the files synced before are already applied
+			return changes, fmt.Errorf("sync %s: %w", filePath, err)
+		}
+		changes = append(changes, tableChanges...)
+	}
+	return changes, nil
+}
+
+func (s *Syncer) syncTable(ctx *session.Context, path string, csvTable [][]string, params *pb.RpcObjectImportRequestCsvParams) ([]*pb.RpcObjectImportChange, error) {
+	if len(csvTable) == 0 {
+		return nil, converter.ErrNoObjectsToImport
+	}
+	rows := csvTable
+	if params.UseFirstRowForRelations {
+		rows = rows[1:]
+	}
+	if len(csvTable[0]) > limitForColumns || len(rows) > limitForRows {
+		return nil, converter.ErrLimitExceeded
+	}
+	collectionID, err := s.findCollection(path, params)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		objectIDs     []string
+		relationLinks []*model.RelationLink
+	)
+	err = block.Do(s.service, collectionID, func(b smartblock.SmartBlock) error {
+		st := b.NewState()
+		objectIDs = st.GetStoreSlice(template.CollectionStoreKey)
+		relationLinks = collectionRelationLinks(st)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("read collection: %w", err)
+	}
+
+	columns, err := s.tableColumns(tableHeader(csvTable, params.UseFirstRowForRelations), relationLinks)
+	if err != nil {
+		return nil, err
+	}
+	keyIdx := -1
+	for i, col := range columns {
+		if col.name == strings.TrimSpace(params.KeyColumn) {
+			keyIdx = i
+		}
+	}
+	if keyIdx == -1 {
+		return nil, fmt.Errorf("key column %q not found", params.KeyColumn)
+	}
+
+	records, err := s.objectStore.QueryByID(objectIDs)
+	if err != nil {
+		return nil, fmt.Errorf("query objects of collection: %w", err)
+	}
+	objects := make([]*types.Struct, 0, len(records))
+	for _, rec := range records {
+		if pbtypes.GetBool(rec.Details, bundle.RelationKeyIsArchived.String()) || pbtypes.GetBool(rec.Details, bundle.RelationKeyIsDeleted.String()) {
+			continue
+		}
+		objects = append(objects, rec.Details)
+	}
+
+	changes := diffTable(rows, columns, keyIdx, objects, s.cellValue, params.ArchiveMissing)
+	if params.Preview {
+		return changes, nil
+	}
+	var lastID string
+	if len(objectIDs) > 0 {
+		lastID = objectIDs[len(objectIDs)-1]
+	}
+	return changes, s.applyChanges(ctx, collectionID, lastID, columns, changes)
+}
+
+// findCollection returns the collection chosen in the params or the collection imported earlier from the file
+func (s *Syncer) findCollection(path string, params *pb.RpcObjectImportRequestCsvParams) (string, error) {
+	if params.CollectionId != "" {
+		return params.CollectionId, nil
+	}
+	ids, _, err := s.objectStore.QueryObjectIDs(database.Query{
+		Filters: []*model.BlockContentDataviewFilter{
+			{
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				RelationKey: bundle.RelationKeySourceFilePath.String(),
+				Value:       pbtypes.String(collectionSourcePath(path, params.TransposeRowsAndColumns)),
+			},
+			{
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				RelationKey: bundle.RelationKeyLayout.String(),
+				Value:       pbtypes.Float64(float64(model.ObjectType_collection)),
+			},
+		},
+	}, []sb.SmartBlockType{sb.SmartBlockTypePage})
+	if err != nil {
+		return "", err
+	}
+	if len(ids) == 0 {
+		return "", fmt.Errorf("collection imported from %s not found", path)
+	}
+	return ids[0], nil
+}
+
+// collectionRelationLinks returns the relations of the collection and its dataview
+func collectionRelationLinks(st *state.State) []*model.RelationLink {
+	links := pbtypes.RelationLinks(st.GetRelationLinks()).Copy()
+	_ = st.Iterate(func(b simple.Block) (isContinue bool) {
+		if dv := b.Model().GetDataview(); dv != nil {
+			links = append(links, dv.RelationLinks...)
+		}
+		return true
+	})
+	return links
+}
+
+// tableHeader returns the names of the columns the same way they are named by the import
+func tableHeader(csvTable [][]string, useFirstRowForRelations bool) []string {
+	header := make([]string, len(csvTable[0]))
+	if useFirstRowForRelations {
+		for i, name := range csvTable[0] {
+			header[i] = strings.TrimSpace(name)
+		}
+		return findUniqueRelationAndAddNumber(header)
+	}
+	for i := 1; i < len(header); i++ {
+		header[i] = getDefaultRelationName(i)
+	}
+	return header
+}
+
+// tableColumns matches the columns with the relations of the collection by name, then with the relations of the library.
+// The column with the name of the name relation holds the names of the objects, the first column is used otherwise.
+// The columns without relations get the key when the relations are created
+func (s *Syncer) tableColumns(header []string, relationLinks []*model.RelationLink) ([]syncColumn, error) {
+	collectionRelations := make(map[string]*model.Relation, len(relationLinks))
+	for _, link := range relationLinks {
+		rel, err := s.objectStore.GetRelationByKey(link.Key)
+		if err != nil {
+			continue
+		}
+		if _, ok := collectionRelations[rel.Name]; !ok {
+			collectionRelations[rel.Name] = rel
+		}
+	}
+	nameRelation := bundle.MustGetRelation(bundle.RelationKeyName)
+	hasNameColumn := false
+	for _, name := range header {
+		if name == nameRelation.Name {
+			hasNameColumn = true
+		}
+	}
+
+	columns := make([]syncColumn, 0, len(header))
+	var hasIDColumn, hasNameKey bool
+	for i, name := range header {
+		col := syncColumn{index: i, name: name, format: model.RelationFormat_longtext}
+		switch {
+		case strings.EqualFold(name, idColumn):
+			if hasIDColumn {
+				return nil, fmt.Errorf("table has more than one %s column", idColumn)
+			}
+			col.isID, hasIDColumn = true, true
+		case !hasNameColumn && !hasNameKey, name == nameRelation.Name:
+			col.key, col.format = nameRelation.Key, nameRelation.Format
+			hasNameKey = true
+		case collectionRelations[name] != nil:
+			col.key, col.format = collectionRelations[name].Key, collectionRelations[name].Format
+		default:
+			rel, err := s.relationByName(name)
+			if err != nil {
+				return nil, err
+			}
+			if rel != nil {
+				col.key, col.format = rel.Key, rel.Format
+			}
+		}
+		columns = append(columns, col)
+	}
+	return columns, nil
+}
+
+func (s *Syncer) relationByName(name string) (*model.Relation, error) {
+	records, _, err := s.objectStore.Query(nil, database.Query{
+		Filters: []*model.BlockContentDataviewFilter{
+			{
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				RelationKey: bundle.RelationKeyLayout.String(),
+				Value:       pbtypes.Float64(float64(model.ObjectType_relation)),
+			},
+			{
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				RelationKey: bundle.RelationKeyName.String(),
+				Value:       pbtypes.String(name),
+			},
+		},
+		Limit: 1,
+	})
+	if err != nil || len(records) == 0 {
+		return nil, err
+	}
+	return &model.Relation{
+		Key:    pbtypes.GetString(records[0].Details, bundle.RelationKeyRelationKey.String()),
+		Name:   name,
+		Format: model.RelationFormat(pbtypes.GetInt64(records[0].Details, bundle.RelationKeyRelationFormat.String())),
+	}, nil
+}
+
+// cellValue converts the text of the cell to the value of the relation, the values of tag, status, object and file
+// relations are the ids or the names of the objects separated by commas
+func (s *Syncer) cellValue(col syncColumn, text string) *types.Value {
+	switch col.format {
+	case model.RelationFormat_tag, model.RelationFormat_status, model.RelationFormat_object, model.RelationFormat_file:
+	default:
+		return table.CellValue(col.format, text)
+	}
+	ids := make([]string, 0)
+	for _, item := range strings.Split(text, listSeparator) {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		if id := s.objectID(col, item); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	return pbtypes.StringList(ids)
+}
+
+// objectID returns the id of the object referred by the id or the name, the options of tag and status
+// relations are looked up among the options of the relation
+func (s *Syncer) objectID(col syncColumn, item string) string {
+	if records, err := s.objectStore.QueryByID([]string{item}); err == nil && len(records) > 0 {
+		return item
+	}
+	filters := []*model.BlockContentDataviewFilter{
+		{
+			Condition:   model.BlockContentDataviewFilter_Equal,
+			RelationKey: bundle.RelationKeyName.String(),
+			Value:       pbtypes.String(item),
+		},
+	}
+	if col.format == model.RelationFormat_tag || col.format == model.RelationFormat_status {
+		filters = append(filters, &model.BlockContentDataviewFilter{
+			Condition:   model.BlockContentDataviewFilter_Equal,
+			RelationKey: bundle.RelationKeyRelationKey.String(),
+			Value:       pbtypes.String(col.key),
+		})
+	}
+	records, _, err := s.objectStore.Query(nil, database.Query{Filters: filters, Limit: 1})
+	if err != nil || len(records) == 0 {
+		return ""
+	}
+	return pbtypes.GetString(records[0].Details, bundle.RelationKeyId.String())
+}
+
+// diffTable matches the rows with the objects by the key column and returns the changes of the relations.
+// Every object is matched with one row at most, the rows without objects are created as new objects
+func diffTable(rows [][]string,
+	columns []syncColumn,
+	keyIdx int,
+	objects []*types.Struct,
+	cellValue func(col syncColumn, text string) *types.Value,
+	archiveMissing bool) []*pb.RpcObjectImportChange {
+	key := columns[keyIdx]
+	matched := make(map[string]bool, len(objects))
+	changes := make([]*pb.RpcObjectImportChange, 0)
+	for _, row := range rows {
+		if isEmptyRow(row) {
+			continue
+		}
+		values := make([]*types.Value, len(columns))
+		for i, col := range columns {
+			if !col.isID && col.index < len(row) {
+				values[i] = cellValue(col, row[col.index])
+			}
+		}
+		var object *types.Struct
+		if key.index < len(row) {
+			object = findObject(objects, matched, key, row[key.index], values[keyIdx])
+		}
+		change := &pb.RpcObjectImportChange{Type: pb.RpcObjectImportChange_Create}
+		if object != nil {
+			change.Type = pb.RpcObjectImportChange_Update
+			change.ObjectId = pbtypes.GetString(object, bundle.RelationKeyId.String())
+			change.Name = pbtypes.GetString(object, bundle.RelationKeyName.String())
+			matched[change.ObjectId] = true
+		}
+		for i, col := range columns {
+			if col.isID || col.index >= len(row) {
+				continue
+			}
+			var oldValue *types.Value
+			if object != nil && col.key != "" {
+				oldValue = pbtypes.Get(object, col.key)
+			}
+			if sameValue(col.format, oldValue, values[i], row[col.index]) {
+				continue
+			}
+			if col.key == bundle.RelationKeyName.String() && object == nil {
+				change.Name = values[i].GetStringValue()
+			}
+			change.Relations = append(change.Relations, &pb.RpcObjectImportChangeRelation{
+				Key:      col.key,
+				Name:     col.name,
+				OldValue: oldValue,
+				NewValue: values[i],
+			})
+		}
+		if object != nil && len(change.Relations) == 0 {
+			continue
+		}
+		changes = append(changes, change)
+	}
+	if !archiveMissing {
+		return changes
+	}
+	for _, object := range objects {
+		id := pbtypes.GetString(object, bundle.RelationKeyId.String())
+		if matched[id] {
+			continue
+		}
+		changes = append(changes, &pb.RpcObjectImportChange{
+			Type:     pb.RpcObjectImportChange_Archive,
+			ObjectId: id,
+			Name:     pbtypes.GetString(object, bundle.RelationKeyName.String()),
+		})
+	}
+	return changes
+}
+
+// findObject returns the first object not matched yet with the same value of the key relation
+func findObject(objects []*types.Struct, matched map[string]bool, key syncColumn, text string, value *types.Value) *types.Struct {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil
+	}
+	for _, object := range objects {
+		id := pbtypes.GetString(object, bundle.RelationKeyId.String())
+		if matched[id] {
+			continue
+		}
+		if key.isID {
+			if id == text {
+				return object
+			}
+			continue
+		}
+		if key.key != "" && sameValue(key.format, pbtypes.Get(object, key.key), value, text) {
+			return object
+		}
+	}
+	return nil
+}
+
+// sameValue reports whether the value of the relation is equal to the value of the cell,
+// the dates are compared with the text of the cell because it may have no time
+func sameValue(format model.RelationFormat, oldValue, newValue *types.Value, text string) bool {
+	oldEmpty, newEmpty := isEmptyValue(format, oldValue), isEmptyValue(format, newValue)
+	if oldEmpty || newEmpty {
+		return oldEmpty && newEmpty
+	}
+	if format == model.RelationFormat_date {
+		t := time.Unix(int64(oldValue.GetNumberValue()), 0)
+		text = strings.TrimSpace(text)
+		if text == t.Format(dateLayout) || text == t.UTC().Format(dateLayout) || text == t.Format(time.RFC3339) {
+			return true
+		}
+	}
+	if _, ok := oldValue.Kind.(*types.Value_StringValue); ok {
+		return strings.TrimSpace(oldValue.GetStringValue()) == strings.TrimSpace(newValue.GetStringValue())
+	}
+	return oldValue.Equal(newValue)
+}
+
+func isEmptyValue(format model.RelationFormat, v *types.Value) bool {
+	if v == nil {
+		return true
+	}
+	switch k := v.Kind.(type) {
+	case *types.Value_NullValue:
+		return true
+	case *types.Value_StringValue:
+		return strings.TrimSpace(k.StringValue) == ""
+	case *types.Value_BoolValue:
+		return !k.BoolValue
+	case *types.Value_ListValue:
+		return len(k.ListValue.GetValues()) == 0
+	case *types.Value_NumberValue:
+		return format == model.RelationFormat_date && k.NumberValue == 0
+	}
+	return false
+}
+
+func isEmptyRow(row []string) bool {
+	for _, cell := range row {
+		if strings.TrimSpace(cell) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// applyChanges creates the relations of the new columns and applies the changes,
+// the created objects are added to the end of the collection.
+// The changes aren't atomic: the failed changes get the error and the rest are applied,
+// the error is returned only if the relations of the new columns can't be created
+func (s *Syncer) applyChanges(ctx *session.Context, collectionID, lastID string, columns []syncColumn, changes []*pb.RpcObjectImportChange) error {
+	newKeys := make(map[string]string)
+	newLinks := make([]*model.RelationLink, 0)
+	for i, col := range columns {
+		if col.isID || col.key != "" {
+			continue
+		}
+		_, details, err := s.service.CreateObject(&pb.RpcObjectCreateRelationRequest{Details: &types.Struct{Fields: map[string]*types.Value{
+			bundle.RelationKeyName.String():           pbtypes.String(col.name),
+			bundle.RelationKeyRelationFormat.String(): pbtypes.Float64(float64(col.format)),
+		}}}, bundle.TypeKeyRelation)
+		if err != nil {
+			return fmt.Errorf("create relation %q: %w", col.name, err)
+		}
+		columns[i].key = pbtypes.GetString(details, bundle.RelationKeyRelationKey.String())
+		newKeys[col.name] = columns[i].key
+		newLinks = append(newLinks, &model.RelationLink{Key: columns[i].key, Format: col.format})
+	}
+	if len(newLinks) > 0 {
+		if err := block.DoState(s.service, collectionID, func(st *state.State, b smartblock.SmartBlock) error {
+			st.AddRelationLinks(newLinks...)
+			for _, link := range newLinks {
+				if err := converter.AddRelationsToDataView(st, link); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return fmt.Errorf("add relations to collection: %w", err)
+		}
+	}
+
+	for _, change := range changes {
+		for _, rel := range change.Relations {
+			if rel.Key == "" {
+				rel.Key = newKeys[rel.Name]
+			}
+		}
+	}
+	created, archived := applyObjectChanges(s.service, changes)
+	if len(created) > 0 {
+		ids := make([]string, 0, len(created))
+		for _, change := range created {
+			ids = append(ids, change.ObjectId)
+		}
+		if err := s.collectionService.Add(ctx, &pb.RpcObjectCollectionAddRequest{
+			ContextId: collectionID,
+			AfterId:   lastID,
+			ObjectIds: ids,
+		}); err != nil {
+			setChangesError(created, fmt.Errorf("add object to collection: %w", err))
+		}
+	}
+	if len(archived) > 0 {
+		ids := make([]string, 0, len(archived))
+		for _, change := range archived {
+			ids = append(ids, change.ObjectId)
+		}
+		if err := s.service.SetPagesIsArchived(pb.RpcObjectListSetIsArchivedRequest{ObjectIds: ids, IsArchived: true}); err != nil {
+			setChangesError(archived, fmt.Errorf("archive object: %w", err))
+		}
+	}
+	return nil
+}
+
+type objectChanger interface {
+	CreateObject(req block.DetailsGetter, forcedType bundle.TypeKey) (id string, details *types.Struct, err error)
+	SetDetails(ctx *session.Context, req pb.RpcObjectSetDetailsRequest) error
+}
+
+// applyObjectChanges updates and creates the objects, returns the created objects and the objects to archive.
+// The objects are changed independently, so the failure of one change is written to its error and doesn't stop the others
+func applyObjectChanges(objects objectChanger, changes []*pb.RpcObjectImportChange) (created, archived []*pb.RpcObjectImportChange) {
+	for _, change := range changes {
+		switch change.Type {
+		case pb.RpcObjectImportChange_Update:
+			details := make([]*pb.RpcObjectSetDetailsDetail, 0, len(change.Relations))
+			for _, rel := range change.Relations {
+				details = append(details, &pb.RpcObjectSetDetailsDetail{Key: rel.Key, Value: rel.NewValue})
+			}
+			if err := objects.SetDetails(nil, pb.RpcObjectSetDetailsRequest{ContextId: change.ObjectId, Details: details}); err != nil {
+				setChangesError([]*pb.RpcObjectImportChange{change}, fmt.Errorf("update object: %w", err))
+			}
+		case pb.RpcObjectImportChange_Create:
+			details := &types.Struct{Fields: make(map[string]*types.Value, len(change.Relations))}
+			for _, rel := range change.Relations {
+				details.Fields[rel.Key] = rel.NewValue
+			}
+			id, _, err := objects.CreateObject(&pb.RpcObjectCreateRequest{Details: details}, bundle.TypeKeyPage)
+			if err != nil {
+				setChangesError([]*pb.RpcObjectImportChange{change}, fmt.Errorf("create object: %w", err))
+				continue
+			}
+			change.ObjectId = id
+			created = append(created, change)
+		case pb.RpcObjectImportChange_Archive:
+			archived = append(archived, change)
+		}
+	}
+	return created, archived
+}
+
+func setChangesError(changes []*pb.RpcObjectImportChange, err error) {
+	for _, change := range changes {
+		change.Error = err.Error()
+	}
+}
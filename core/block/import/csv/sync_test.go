@@ -0,0 +1,181 @@
+package csv
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anyproto/anytype-heart/core/block"
+	"github.com/anyproto/anytype-heart/core/block/editor/table"
+	"github.com/anyproto/anytype-heart/core/session"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func cellValue(col syncColumn, text string) *types.Value {
+	return table.CellValue(col.format, text)
+}
+
+func testObject(id, name, status string, estimate float64) *types.Struct {
+	return &types.Struct{Fields: map[string]*types.Value{
+		bundle.RelationKeyId.String():   pbtypes.String(id),
+		bundle.RelationKeyName.String(): pbtypes.String(name),
+		"status":                        pbtypes.String(status),
+		"estimate":                      pbtypes.Float64(estimate),
+	}}
+}
+
+func TestDiffTable(t *testing.T) {
+	columns := []syncColumn{
+		{index: 0, name: "ID", isID: true},
+		{index: 1, name: "Name", key: bundle.RelationKeyName.String(), format: model.RelationFormat_shorttext},
+		{index: 2, name: "Status", key: "status", format: model.RelationFormat_longtext},
+		{index: 3, name: "Estimate", key: "estimate", format: model.RelationFormat_number},
+		{index: 4, name: "Owner", format: model.RelationFormat_longtext},
+	}
+	objects := []*types.Struct{
+		testObject("task1", "Write report", "open", 2),
+		testObject("task2", "Send it", "open", 1),
+		testObject("task3", "Review", "done", 3),
+	}
+	rows := [][]string{
+		{"task1", "Write report", "open", "2", ""},
+		{"task2", "Send it", "done", "1", "Ann"},
+		{"", "Plan", "open", "", ""},
+		{"", "", "", "", ""},
+	}
+
+	t.Run("match by id", func(t *testing.T) {
+		changes := diffTable(rows, columns, 0, objects, cellValue, false)
+		assert.Equal(t, []*pb.RpcObjectImportChange{
+			{
+				Type:     pb.RpcObjectImportChange_Update,
+				ObjectId: "task2",
+				Name:     "Send it",
+				Relations: []*pb.RpcObjectImportChangeRelation{
+					{Key: "status", Name: "Status", OldValue: pbtypes.String("open"), NewValue: pbtypes.String("done")},
+					{Name: "Owner", NewValue: pbtypes.String("Ann")},
+				},
+			},
+			{
+				Type: pb.RpcObjectImportChange_Create,
+				Name: "Plan",
+				Relations: []*pb.RpcObjectImportChangeRelation{
+					{Key: bundle.RelationKeyName.String(), Name: "Name", NewValue: pbtypes.String("Plan")},
+					{Key: "status", Name: "Status", NewValue: pbtypes.String("open")},
+				},
+			},
+		}, changes)
+	})
+
+	t.Run("match by relation and archive missing", func(t *testing.T) {
+		changes := diffTable([][]string{
+			{"", "Send it", "open", "1.5", ""},
+			{"", "Write report", "open", "2", ""},
+		}, columns, 1, objects, cellValue, true)
+		assert.Len(t, changes, 2)
+		assert.Equal(t, pb.RpcObjectImportChange_Update, changes[0].Type)
+		assert.Equal(t, "task2", changes[0].ObjectId)
+		assert.Equal(t, []*pb.RpcObjectImportChangeRelation{
+			{Key: "estimate", Name: "Estimate", OldValue: pbtypes.Float64(1), NewValue: pbtypes.Float64(1.5)},
+		}, changes[0].Relations)
+		assert.Equal(t, &pb.RpcObjectImportChange{Type: pb.RpcObjectImportChange_Archive, ObjectId: "task3", Name: "Review"}, changes[1])
+	})
+
+	t.Run("duplicated keys", func(t *testing.T) {
+		changes := diffTable([][]string{
+			{"", "Review", "done", "3", ""},
+			{"", "Review", "done", "3", ""},
+		}, columns, 1, objects, cellValue, false)
+		assert.Len(t, changes, 1)
+		assert.Equal(t, pb.RpcObjectImportChange_Create, changes[0].Type)
+	})
+}
+
+func TestSameValue(t *testing.T) {
+	due := time.Date(2023, 10, 20, 15, 30, 0, 0, time.Local)
+	for _, tc := range []struct {
+		name     string
+		format   model.RelationFormat
+		old, new *types.Value
+		text     string
+		same     bool
+	}{
+		{"empty", model.RelationFormat_longtext, nil, nil, "", true},
+		{"empty string", model.RelationFormat_longtext, pbtypes.String(" "), nil, "", true},
+		{"unchecked", model.RelationFormat_checkbox, pbtypes.Bool(false), nil, "", true},
+		{"checked", model.RelationFormat_checkbox, pbtypes.Bool(false), pbtypes.Bool(true), "true", false},
+		{"spaces", model.RelationFormat_longtext, pbtypes.String("text "), pbtypes.String("text"), "text", true},
+		{"number", model.RelationFormat_number, pbtypes.Float64(0), nil, "", false},
+		{"date", model.RelationFormat_date, pbtypes.Int64(due.Unix()), pbtypes.Int64(1), due.Format(dateLayout), true},
+		{"date with time", model.RelationFormat_date, pbtypes.Int64(due.Unix()), pbtypes.Int64(1), due.Format(time.RFC3339), true},
+		{"other date", model.RelationFormat_date, pbtypes.Int64(due.Unix()), pbtypes.Int64(1), "2023-10-21", false},
+		{"list", model.RelationFormat_tag, pbtypes.StringList([]string{"a", "b"}), pbtypes.StringList([]string{"a", "b"}), "", true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.same, sameValue(tc.format, tc.old, tc.new, tc.text))
+		})
+	}
+}
+
+func TestTableHeader(t *testing.T) {
+	csvTable := [][]string{{" Name", "Tag", "Tag", ""}, {"a", "b", "c", "d"}}
+	assert.Equal(t, []string{"Name", "Tag", "Tag 1", "1"}, tableHeader(csvTable, true))
+	assert.Equal(t, []string{"", "Field 1", "Field 2", "Field 3"}, tableHeader(csvTable, false))
+}
+
+// testObjectChanger fails the changes of the objects named "fail"
+type testObjectChanger struct {
+	updated map[string][]*pb.RpcObjectSetDetailsDetail
+	created []*types.Struct
+}
+
+func (c *testObjectChanger) CreateObject(req block.DetailsGetter, _ bundle.TypeKey) (string, *types.Struct, error) {
+	details := req.GetDetails()
+	if pbtypes.GetString(details, bundle.RelationKeyName.String()) == "fail" {
+		return "", nil, errors.New("create failed")
+	}
+	c.created = append(c.created, details)
+	return "new", details, nil
+}
+
+func (c *testObjectChanger) SetDetails(_ *session.Context, req pb.RpcObjectSetDetailsRequest) error {
+	if req.ContextId == "fail" {
+		return errors.New("object is deleted")
+	}
+	c.updated[req.ContextId] = req.Details
+	return nil
+}
+
+func TestApplyObjectChanges(t *testing.T) {
+	relations := []*pb.RpcObjectImportChangeRelation{{Key: "status", NewValue: pbtypes.String("done")}}
+	changes := []*pb.RpcObjectImportChange{
+		{Type: pb.RpcObjectImportChange_Update, ObjectId: "fail", Relations: relations},
+		{Type: pb.RpcObjectImportChange_Update, ObjectId: "task1", Relations: relations},
+		{Type: pb.RpcObjectImportChange_Create, Relations: []*pb.RpcObjectImportChangeRelation{
+			{Key: bundle.RelationKeyName.String(), NewValue: pbtypes.String("fail")},
+		}},
+		{Type: pb.RpcObjectImportChange_Create, Relations: []*pb.RpcObjectImportChangeRelation{
+			{Key: bundle.RelationKeyName.String(), NewValue: pbtypes.String("Plan")},
+		}},
+		{Type: pb.RpcObjectImportChange_Archive, ObjectId: "task2"},
+	}
+	changer := &testObjectChanger{updated: map[string][]*pb.RpcObjectSetDetailsDetail{}}
+
+	// the failed changes don't stop the rest
+	created, archived := applyObjectChanges(changer, changes)
+	assert.Equal(t, "update object: object is deleted", changes[0].Error)
+	assert.Empty(t, changes[1].Error)
+	assert.Equal(t, []*pb.RpcObjectSetDetailsDetail{{Key: "status", Value: pbtypes.String("done")}}, changer.updated["task1"])
+	assert.Equal(t, "create object: create failed", changes[2].Error)
+	assert.Empty(t, changes[2].ObjectId)
+	assert.Equal(t, []*pb.RpcObjectImportChange{changes[3]}, created)
+	assert.Equal(t, "new", changes[3].ObjectId)
+	assert.Len(t, changer.created, 1)
+	assert.Equal(t, []*pb.RpcObjectImportChange{changes[4]}, archived)
+}
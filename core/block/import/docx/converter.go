@@ -0,0 +1,175 @@
+package docx
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/google/uuid"
+
+	"github.com/anyproto/anytype-heart/core/block/collection"
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/import/source"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+var log = logging.Logger("docx-import")
+
+const numberOfStages = 2 // 1 cycle to get snapshots and 1 cycle to create objects
+const (
+	Name               = "Docx"
+	rootCollectionName = "DOCX Import"
+)
+
+type DOCX struct {
+	tempDirProvider core.TempDirProvider
+	service         *collection.Service
+}
+
+func New(tempDirProvider core.TempDirProvider, service *collection.Service) converter.Converter {
+	return &DOCX{tempDirProvider: tempDirProvider, service: service}
+}
+
+func (d *DOCX) Name() string {
+	return Name
+}
+
+func (d *DOCX) GetParams(req *pb.RpcObjectImportRequest) []string {
+	if p := req.GetDocxParams(); p != nil {
+		return p.Path
+	}
+
+	return nil
+}
+
+func (d *DOCX) GetSnapshots(req *pb.RpcObjectImportRequest, progress process.Progress) (*converter.Response, *converter.ConvertError) {
+	paths := d.GetParams(req)
+	if len(paths) == 0 {
+		return nil, nil
+	}
+	progress.SetProgressMessage("Start creating snapshots from files")
+	cErr := converter.NewError()
+	snapshots, targetObjects, cancelError := d.getSnapshotsForImport(req, progress, paths, cErr)
+	if !cancelError.IsEmpty() {
+		return nil, cancelError
+	}
+	if (!cErr.IsEmpty() && req.Mode == pb.RpcObjectImportRequest_ALL_OR_NOTHING) || cErr.IsNoObjectToImportError(len(paths)) {
+		return nil, cErr
+	}
+	rootCollection := converter.NewRootCollection(d.service)
+	rootCol, err := rootCollection.MakeRootCollection(rootCollectionName, targetObjects)
+	if err != nil {
+		cErr.Add(err)
+		if req.Mode == pb.RpcObjectImportRequest_ALL_OR_NOTHING {
+			return nil, cErr
+		}
+	}
+	if rootCol != nil {
+		snapshots = append(snapshots, rootCol)
+	}
+	progress.SetTotal(int64(numberOfStages * len(snapshots)))
+	if cErr.IsEmpty() {
+		return &converter.Response{Snapshots: snapshots}, nil
+	}
+	return &converter.Response{
+		Snapshots: snapshots,
+	}, cErr
+}
+
+func (d *DOCX) getSnapshotsForImport(req *pb.RpcObjectImportRequest,
+	progress process.Progress,
+	paths []string,
+	cErr *converter.ConvertError) ([]*converter.Snapshot, []string, *converter.ConvertError) {
+	snapshots := make([]*converter.Snapshot, 0)
+	targetObjects := make([]string, 0)
+	for _, p := range paths {
+		if err := progress.TryStep(1); err != nil {
+			return nil, nil, converter.NewCancelError(err)
+		}
+		sn, to, err := d.handleImportPath(p, req.GetMode(), cErr)
+		if err != nil {
+			cErr.Add(err)
+			if req.Mode == pb.RpcObjectImportRequest_ALL_OR_NOTHING {
+				return nil, nil, nil
+			}
+			continue
+		}
+		snapshots = append(snapshots, sn...)
+		targetObjects = append(targetObjects, to...)
+	}
+	return snapshots, targetObjects, nil
+}
+
+func (d *DOCX) handleImportPath(
+	p string,
+	mode pb.RpcObjectImportRequestMode,
+	cErr *converter.ConvertError,
+) ([]*converter.Snapshot, []string, error) {
+	s := source.GetSource(p)
+	if s == nil {
+		return nil, nil, fmt.Errorf("failed to identify source: %s", p)
+	}
+
+	readers, err := s.GetFileReaders(p, []string{".docx"})
+	if err != nil {
+		if mode == pb.RpcObjectImportRequest_ALL_OR_NOTHING {
+			return nil, nil, err
+		}
+		// the files read before the error are still imported
+		cErr.Add(err)
+	}
+	if len(readers) == 0 {
+		return nil, nil, converter.ErrNoObjectsToImport
+	}
+	snapshots := make([]*converter.Snapshot, 0, len(readers))
+	targetObjects := make([]string, 0, len(readers))
+	for name, rc := range readers {
+		blocks, err := d.getBlocksForFile(rc)
+		if err != nil {
+			if mode == pb.RpcObjectImportRequest_ALL_OR_NOTHING {
+				return nil, nil, fmt.Errorf("%s: %w", name, err)
+			}
+			log.Errorf("failed to convert %s: %s", name, err)
+			continue
+		}
+		sn, id := d.getSnapshot(blocks, name)
+		snapshots = append(snapshots, sn)
+		targetObjects = append(targetObjects, id)
+	}
+	return snapshots, targetObjects, nil
+}
+
+func (d *DOCX) getBlocksForFile(rc io.ReadCloser) ([]*model.Block, error) {
+	defer rc.Close()
+	b, err := io.ReadAll(rc)
+	if err != nil {
+		return nil, err
+	}
+	tempDir := os.TempDir()
+	if d.tempDirProvider != nil {
+		tempDir = d.tempDirProvider.TempDir()
+	}
+	return documentToBlocks(b, tempDir)
+}
+
+func (d *DOCX) getSnapshot(blocks []*model.Block, p string) (*converter.Snapshot, string) {
+	sn := &model.SmartBlockSnapshotBase{
+		Blocks:      blocks,
+		Details:     converter.GetCommonDetails(p, "", ""),
+		ObjectTypes: []string{bundle.TypeKeyPage.URL()},
+	}
+
+	snapshot := &converter.Snapshot{
+		Id:       uuid.New().String(),
+		FileName: p,
+		Snapshot: &pb.ChangeSnapshot{Data: sn},
+		SbType:   smartblock.SmartBlockTypePage,
+	}
+	return snapshot, snapshot.Id
+}
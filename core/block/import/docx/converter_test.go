@@ -0,0 +1,173 @@
+package docx
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/editor/table"
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	docxexport "github.com/anyproto/anytype-heart/core/converter/docx"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+type tempDirProvider struct {
+	dir string
+}
+
+func (p tempDirProvider) TempDir() string {
+	return p.dir
+}
+
+// blocksState restores the tree of the imported blocks
+func blocksState(blocks []*model.Block) *state.State {
+	m := make(map[string]simple.Block, len(blocks))
+	for _, b := range blocks {
+		m[b.Id] = simple.New(b)
+	}
+	return state.NewDoc(blocks[0].Id, m).(*state.State)
+}
+
+func texts(s *state.State, ids []string) []string {
+	var res []string
+	for _, id := range ids {
+		res = append(res, s.Pick(id).Model().GetText().GetText())
+	}
+	return res
+}
+
+func TestDOCX_GetSnapshots(t *testing.T) {
+	dir := t.TempDir()
+	notDocx := filepath.Join(dir, "not-a-docx.txt")
+	require.NoError(t, os.WriteFile(notDocx, []byte("plain text"), 0600))
+	d := &DOCX{tempDirProvider: tempDirProvider{dir: dir}}
+	p := process.NewProgress(pb.ModelProcess_Import)
+	sn, err := d.GetSnapshots(&pb.RpcObjectImportRequest{
+		Params: &pb.RpcObjectImportRequestParamsOfDocxParams{
+			DocxParams: &pb.RpcObjectImportRequestDocxParams{Path: []string{"testdata/test.docx", notDocx}},
+		},
+		Type: pb.RpcObjectImportRequest_Docx,
+		Mode: pb.RpcObjectImportRequest_IGNORE_ERRORS,
+	}, p)
+
+	assert.NotNil(t, err)
+	assert.True(t, errors.Is(err.GetResultError(pb.RpcObjectImportRequest_Docx), converter.ErrNoObjectsToImport))
+	require.NotNil(t, sn)
+	require.Len(t, sn.Snapshots, 2)
+	assert.Contains(t, sn.Snapshots[0].FileName, "test.docx")
+	assert.Equal(t, pbtypes.String("test"), sn.Snapshots[0].Snapshot.Data.Details.Fields[bundle.RelationKeyName.String()])
+	assert.Contains(t, sn.Snapshots[1].FileName, rootCollectionName)
+	assert.Equal(t, bundle.TypeKeyCollection.URL(), sn.Snapshots[1].Snapshot.Data.ObjectTypes[0])
+
+	s := blocksState(sn.Snapshots[0].Snapshot.Data.Blocks)
+	root := s.Pick(s.RootId()).Model()
+	require.Len(t, root.ChildrenIds, 8)
+
+	t.Run("headings and marks", func(t *testing.T) {
+		heading := s.Pick(root.ChildrenIds[0]).Model().GetText()
+		assert.Equal(t, "Heading", heading.Text)
+		assert.Equal(t, model.BlockContentText_Header1, heading.Style)
+
+		text := s.Pick(root.ChildrenIds[1]).Model().GetText()
+		assert.Equal(t, "Plain bold italic link", text.Text)
+		assert.Equal(t, []*model.BlockContentTextMark{
+			{Range: &model.Range{From: 6, To: 10}, Type: model.BlockContentTextMark_Bold},
+			{Range: &model.Range{From: 10, To: 18}, Type: model.BlockContentTextMark_Italic},
+			{Range: &model.Range{From: 18, To: 22}, Type: model.BlockContentTextMark_Link, Param: "https://anytype.io"},
+		}, text.Marks.Marks)
+	})
+
+	t.Run("lists are nested by levels", func(t *testing.T) {
+		assert.Equal(t, []string{"Bullet", "Second bullet", "Number"}, texts(s, root.ChildrenIds[2:5]))
+		bullet := s.Pick(root.ChildrenIds[2]).Model()
+		assert.Equal(t, model.BlockContentText_Marked, bullet.GetText().Style)
+		assert.Equal(t, []string{"Nested"}, texts(s, bullet.ChildrenIds))
+		assert.Equal(t, model.BlockContentText_Numbered, s.Pick(root.ChildrenIds[4]).Model().GetText().Style)
+	})
+
+	t.Run("quote keeps line breaks", func(t *testing.T) {
+		quote := s.Pick(root.ChildrenIds[5]).Model().GetText()
+		assert.Equal(t, "Quote\nline", quote.Text)
+		assert.Equal(t, model.BlockContentText_Quote, quote.Style)
+	})
+
+	t.Run("table", func(t *testing.T) {
+		tb, err := table.NewTable(s, root.ChildrenIds[6])
+		require.NoError(t, err)
+		require.Len(t, tb.ColumnIDs(), 2)
+		rows := tb.RowIDs()
+		require.Len(t, rows, 2)
+		assert.True(t, s.Pick(rows[0]).Model().GetTableRow().IsHeader)
+		assert.Equal(t, []string{"Key", "Value"}, texts(s, s.Pick(rows[0]).Model().ChildrenIds))
+		assert.Equal(t, []string{"a\nb"}, texts(s, s.Pick(rows[1]).Model().ChildrenIds))
+	})
+
+	t.Run("embedded image is extracted", func(t *testing.T) {
+		file := s.Pick(root.ChildrenIds[7]).Model().GetFile()
+		require.NotNil(t, file)
+		assert.Equal(t, model.BlockContentFile_Image, file.Type)
+		assert.Equal(t, model.BlockContentFile_Empty, file.State)
+		assert.FileExists(t, file.Name)
+		assert.Contains(t, file.Name, dir)
+	})
+}
+
+func TestDOCX_GetSnapshotsUnreadableFile(t *testing.T) {
+	dir := t.TempDir()
+	d := &DOCX{tempDirProvider: tempDirProvider{dir: dir}}
+	_, err := d.GetSnapshots(&pb.RpcObjectImportRequest{
+		Params: &pb.RpcObjectImportRequestParamsOfDocxParams{
+			DocxParams: &pb.RpcObjectImportRequestDocxParams{Path: []string{filepath.Join(dir, "missing.docx")}},
+		},
+		Type: pb.RpcObjectImportRequest_Docx,
+		Mode: pb.RpcObjectImportRequest_IGNORE_ERRORS,
+	}, process.NewProgress(pb.ModelProcess_Import))
+
+	require.NotNil(t, err)
+	resultErr := err.GetResultError(pb.RpcObjectImportRequest_Docx)
+	assert.False(t, errors.Is(resultErr, converter.ErrNoObjectsToImport))
+	assert.Contains(t, resultErr.Error(), "missing.docx")
+}
+
+func TestDocumentToBlocks(t *testing.T) {
+	t.Run("exported document is imported back", func(t *testing.T) {
+		text := func(id string, style model.BlockContentTextStyle, text string) simple.Block {
+			return simple.New(&model.Block{Id: id, Content: &model.BlockContentOfText{Text: &model.BlockContentText{Text: text, Style: style}}})
+		}
+		s := state.NewDoc("root", map[string]simple.Block{
+			"root":   simple.New(&model.Block{Id: "root", ChildrenIds: []string{"h", "item", "code"}}),
+			"h":      text("h", model.BlockContentText_Header2, "Title"),
+			"item":   simple.New(&model.Block{Id: "item", ChildrenIds: []string{"nested"}, Content: &model.BlockContentOfText{Text: &model.BlockContentText{Text: "Item", Style: model.BlockContentText_Numbered}}}),
+			"nested": text("nested", model.BlockContentText_Marked, "Nested"),
+			"code":   text("code", model.BlockContentText_Code, "a  b"),
+		}).(*state.State)
+		data := docxexport.NewConverter(nil, s).Convert(model.SmartBlockType_Page)
+
+		blocks, err := documentToBlocks(data, t.TempDir())
+		require.NoError(t, err)
+		res := blocksState(blocks)
+		root := res.Pick(res.RootId()).Model()
+		require.Equal(t, []string{"Title", "Item", "a  b"}, texts(res, root.ChildrenIds))
+		assert.Equal(t, model.BlockContentText_Header2, res.Pick(root.ChildrenIds[0]).Model().GetText().Style)
+		assert.Equal(t, model.BlockContentText_Code, res.Pick(root.ChildrenIds[2]).Model().GetText().Style)
+		item := res.Pick(root.ChildrenIds[1]).Model()
+		assert.Equal(t, model.BlockContentText_Numbered, item.GetText().Style)
+		require.Len(t, item.ChildrenIds, 1)
+		assert.Equal(t, model.BlockContentText_Marked, res.Pick(item.ChildrenIds[0]).Model().GetText().Style)
+	})
+
+	t.Run("not a zip", func(t *testing.T) {
+		_, err := documentToBlocks([]byte("text"), os.TempDir())
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,635 @@
+package docx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/globalsign/mgo/bson"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/editor/table"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	textutil "github.com/anyproto/anytype-heart/util/text"
+)
+
+const (
+	defaultDocumentPart = "word/document.xml"
+	officeDocumentType  = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument"
+)
+
+// node is the element of the WordprocessingML part. The parts are decoded to the generic tree,
+// because the order of paragraphs, tables and runs matters and most of the elements are skipped anyway
+type node struct {
+	XMLName xml.Name
+	Attrs   []xml.Attr `xml:",any,attr"`
+	Nodes   []node     `xml:",any"`
+	Text    string     `xml:",chardata"`
+}
+
+// attr returns the value of the attribute by the local name, namespaces are ignored
+func (n *node) attr(local string) string {
+	if n == nil {
+		return ""
+	}
+	for _, a := range n.Attrs {
+		if a.Name.Local == local {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+func (n *node) child(local string) *node {
+	if n == nil {
+		return nil
+	}
+	for i := range n.Nodes {
+		if n.Nodes[i].XMLName.Local == local {
+			return &n.Nodes[i]
+		}
+	}
+	return nil
+}
+
+func (n *node) children(local string) []*node {
+	if n == nil {
+		return nil
+	}
+	var res []*node
+	for i := range n.Nodes {
+		if n.Nodes[i].XMLName.Local == local {
+			res = append(res, &n.Nodes[i])
+		}
+	}
+	return res
+}
+
+// val returns the w:val attribute of the child element, e.g. the style of <w:pStyle w:val="Heading1"/>
+func (n *node) val(local string) string {
+	return n.child(local).attr("val")
+}
+
+// flag reports whether the toggle property like <w:b/> is on
+func (n *node) flag(local string) bool {
+	c := n.child(local)
+	if c == nil {
+		return false
+	}
+	switch c.attr("val") {
+	case "0", "false", "off", "none":
+		return false
+	}
+	return true
+}
+
+// find calls f for every descendant of the local name
+func (n *node) find(local string, f func(n *node)) {
+	for i := range n.Nodes {
+		c := &n.Nodes[i]
+		if c.XMLName.Local == local {
+			f(c)
+		}
+		c.find(local, f)
+	}
+}
+
+type relationship struct {
+	target   string
+	external bool
+}
+
+type paragraphStyle struct {
+	name  string
+	numID string
+}
+
+// document converts the body of DOCX document to blocks
+type document struct {
+	files map[string]*zip.File
+	rels  map[string]relationship
+	// styles are the paragraph styles by ID, names are lowercase
+	styles map[string]paragraphStyle
+	// bullets tells whether the list level is bulleted by the numbering ID and the level
+	bullets map[string]map[string]bool
+	tempDir string
+
+	state  *state.State
+	tables table.TableEditor
+	// lists keeps the IDs of the last list items by level, the deeper items become their children
+	lists []string
+}
+
+// documentToBlocks converts DOCX document to blocks, embedded images are extracted to the temp dir
+// and become file blocks, so they are uploaded during the import
+func documentToBlocks(data []byte, tempDir string) ([]*model.Block, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("open docx: %w", err)
+	}
+	d := &document{
+		files:   make(map[string]*zip.File, len(zr.File)),
+		tempDir: tempDir,
+		tables:  table.NewEditor(nil),
+		state: state.NewDoc("root", map[string]simple.Block{
+			"root": simple.New(&model.Block{
+				Content: &model.BlockContentOfSmartblock{
+					Smartblock: &model.BlockContentSmartblock{},
+				},
+			}),
+		}).NewState(),
+	}
+	for _, f := range zr.File {
+		d.files[f.Name] = f
+	}
+
+	mainPart := defaultDocumentPart
+	rootRels, err := d.readRelationships("_rels/.rels", "")
+	if err != nil {
+		return nil, err
+	}
+	for _, rel := range rootRels {
+		if rel.typ == officeDocumentType {
+			mainPart = rel.target
+		}
+	}
+	if d.rels, err = d.readPartRelationships(mainPart); err != nil {
+		return nil, err
+	}
+	if err = d.readStyles(path.Join(path.Dir(mainPart), "styles.xml")); err != nil {
+		return nil, err
+	}
+	if err = d.readNumbering(path.Join(path.Dir(mainPart), "numbering.xml")); err != nil {
+		return nil, err
+	}
+
+	var root node
+	found, err := d.readPart(mainPart, &root)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("document part %s is not found", mainPart)
+	}
+	if err = d.convertBody(root.child("body")); err != nil {
+		return nil, err
+	}
+	return d.state.Blocks(), nil
+}
+
+// readPart decodes the XML part of the package, found is false when the package doesn't have it
+func (d *document) readPart(name string, v interface{}) (found bool, err error) {
+	f, ok := d.files[name]
+	if !ok {
+		return false, nil
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return true, fmt.Errorf("open %s: %w", name, err)
+	}
+	defer rc.Close()
+	if err = xml.NewDecoder(rc).Decode(v); err != nil {
+		return true, fmt.Errorf("decode %s: %w", name, err)
+	}
+	return true, nil
+}
+
+type packageRelationship struct {
+	id, typ, target string
+	external        bool
+}
+
+// readRelationships reads the relationships part, internal targets are resolved relative to the base dir
+func (d *document) readRelationships(name, baseDir string) ([]packageRelationship, error) {
+	var root node
+	if _, err := d.readPart(name, &root); err != nil {
+		return nil, err
+	}
+	var rels []packageRelationship
+	for _, r := range root.children("Relationship") {
+		rel := packageRelationship{id: r.attr("Id"), typ: r.attr("Type"), target: r.attr("Target")}
+		if r.attr("TargetMode") == "External" {
+			rel.external = true
+		} else if strings.HasPrefix(rel.target, "/") {
+			rel.target = strings.TrimPrefix(rel.target, "/")
+		} else {
+			rel.target = path.Join(baseDir, rel.target)
+		}
+		rels = append(rels, rel)
+	}
+	return rels, nil
+}
+
+func (d *document) readPartRelationships(part string) (map[string]relationship, error) {
+	dir, file := path.Split(part)
+	rels, err := d.readRelationships(path.Join(dir, "_rels", file+".rels"), path.Dir(part))
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[string]relationship, len(rels))
+	for _, r := range rels {
+		res[r.id] = relationship{target: r.target, external: r.external}
+	}
+	return res, nil
+}
+
+func (d *document) readStyles(name string) error {
+	var root node
+	if _, err := d.readPart(name, &root); err != nil {
+		return err
+	}
+	d.styles = make(map[string]paragraphStyle)
+	for _, s := range root.children("style") {
+		d.styles[s.attr("styleId")] = paragraphStyle{
+			name:  strings.ToLower(s.val("name")),
+			numID: s.child("pPr").child("numPr").val("numId"),
+		}
+	}
+	return nil
+}
+
+func (d *document) readNumbering(name string) error {
+	var root node
+	if _, err := d.readPart(name, &root); err != nil {
+		return err
+	}
+	abstract := make(map[string]map[string]bool)
+	for _, an := range root.children("abstractNum") {
+		levels := make(map[string]bool)
+		for _, lvl := range an.children("lvl") {
+			levels[lvl.attr("ilvl")] = lvl.val("numFmt") == "bullet"
+		}
+		abstract[an.attr("abstractNumId")] = levels
+	}
+	d.bullets = make(map[string]map[string]bool)
+	for _, num := range root.children("num") {
+		d.bullets[num.attr("numId")] = abstract[num.val("abstractNumId")]
+	}
+	return nil
+}
+
+func (d *document) convertBody(body *node) error {
+	if body == nil {
+		return nil
+	}
+	for i := range body.Nodes {
+		n := &body.Nodes[i]
+		switch n.XMLName.Local {
+		case "p":
+			if err := d.convertParagraph(n); err != nil {
+				return err
+			}
+		case "tbl":
+			d.lists = d.lists[:0]
+			if err := d.convertTable(n); err != nil {
+				return err
+			}
+		case "sdt":
+			if err := d.convertBody(n.child("sdtContent")); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+type runFormat struct {
+	bold, italic, underline, strike bool
+	link                            string
+}
+
+// paragraph collects the text of the runs with marks, the ranges are in UTF-16 like in the text blocks
+type paragraph struct {
+	text   strings.Builder
+	length int
+	marks  []*model.BlockContentTextMark
+	// images are the relationship IDs or URLs of the pictures
+	images []string
+}
+
+func (p *paragraph) add(text string, f runFormat) {
+	if text == "" {
+		return
+	}
+	from := p.length
+	p.text.WriteString(text)
+	p.length += textutil.UTF16RuneCountString(text)
+	if f.bold {
+		p.mark(model.BlockContentTextMark_Bold, "", from)
+	}
+	if f.italic {
+		p.mark(model.BlockContentTextMark_Italic, "", from)
+	}
+	if f.underline {
+		p.mark(model.BlockContentTextMark_Underscored, "", from)
+	}
+	if f.strike {
+		p.mark(model.BlockContentTextMark_Strikethrough, "", from)
+	}
+	if f.link != "" {
+		p.mark(model.BlockContentTextMark_Link, f.link, from)
+	}
+}
+
+// mark adds the mark to the end of the text or extends the same mark of the previous run
+func (p *paragraph) mark(tp model.BlockContentTextMarkType, param string, from int) {
+	for _, m := range p.marks {
+		if m.Type == tp && m.Param == param && int(m.Range.To) == from {
+			m.Range.To = int32(p.length)
+			return
+		}
+	}
+	p.marks = append(p.marks, &model.BlockContentTextMark{
+		Range: &model.Range{From: int32(from), To: int32(p.length)},
+		Type:  tp,
+		Param: param,
+	})
+}
+
+func (p *paragraph) content(style model.BlockContentTextStyle) *model.BlockContentOfText {
+	return &model.BlockContentOfText{
+		Text: &model.BlockContentText{
+			Text:  p.text.String(),
+			Style: style,
+			Marks: &model.BlockContentTextMarks{Marks: p.marks},
+		},
+	}
+}
+
+// convertInline collects the text of the runs, hyperlinks and the elements wrapping them
+func (d *document) convertInline(n *node, f runFormat, p *paragraph) {
+	if n == nil {
+		return
+	}
+	for i := range n.Nodes {
+		c := &n.Nodes[i]
+		switch c.XMLName.Local {
+		case "r":
+			d.convertRun(c, f, p)
+		case "hyperlink":
+			lf := f
+			if rel, ok := d.rels[c.attr("id")]; ok && rel.external {
+				lf.link = rel.target
+			}
+			d.convertInline(c, lf, p)
+		case "ins", "smartTag", "fldSimple", "customXml":
+			d.convertInline(c, f, p)
+		case "sdt":
+			d.convertInline(c.child("sdtContent"), f, p)
+		}
+	}
+}
+
+func (d *document) convertRun(r *node, f runFormat, p *paragraph) {
+	if pr := r.child("rPr"); pr != nil {
+		f.bold = pr.flag("b")
+		f.italic = pr.flag("i")
+		f.underline = pr.flag("u")
+		f.strike = pr.flag("strike") || pr.flag("dstrike")
+	}
+	for i := range r.Nodes {
+		c := &r.Nodes[i]
+		switch c.XMLName.Local {
+		case "t":
+			p.add(c.Text, f)
+		case "tab":
+			p.add("\t", f)
+		case "br", "cr":
+			if c.attr("type") != "page" {
+				p.add("\n", f)
+			}
+		case "noBreakHyphen":
+			p.add("-", f)
+		case "drawing":
+			c.find("blip", func(n *node) {
+				if id := n.attr("embed"); id != "" {
+					p.images = append(p.images, id)
+				} else if id = n.attr("link"); id != "" {
+					p.images = append(p.images, id)
+				}
+			})
+		case "pict", "object":
+			c.find("imagedata", func(n *node) {
+				if id := n.attr("id"); id != "" {
+					p.images = append(p.images, id)
+				}
+			})
+		}
+	}
+}
+
+// textStyle returns the style of the paragraph and the level of the list item, isList is false for the other paragraphs
+func (d *document) textStyle(pr *node) (style model.BlockContentTextStyle, level int, isList bool) {
+	ps := d.styles[pr.val("pStyle")]
+	switch {
+	case ps.name == "title", ps.name == "heading 1":
+		return model.BlockContentText_Header1, 0, false
+	case ps.name == "subtitle", ps.name == "heading 2":
+		return model.BlockContentText_Header2, 0, false
+	case ps.name == "heading 3":
+		return model.BlockContentText_Header3, 0, false
+	case strings.HasPrefix(ps.name, "heading "):
+		return model.BlockContentText_Header4, 0, false
+	case strings.Contains(ps.name, "quote"):
+		return model.BlockContentText_Quote, 0, false
+	case strings.Contains(ps.name, "code"), ps.name == "html preformatted":
+		return model.BlockContentText_Code, 0, false
+	}
+
+	// the numbering is set directly or by the list style
+	numID := ps.numID
+	numPr := pr.child("numPr")
+	if id := numPr.val("numId"); id != "" {
+		numID = id
+	}
+	if numID != "" && numID != "0" {
+		level, _ = strconv.Atoi(numPr.val("ilvl"))
+		if d.bullets[numID][strconv.Itoa(level)] {
+			return model.BlockContentText_Marked, level, true
+		}
+		return model.BlockContentText_Numbered, level, true
+	}
+	switch {
+	case strings.HasPrefix(ps.name, "list bullet"):
+		return model.BlockContentText_Marked, 0, true
+	case strings.HasPrefix(ps.name, "list number"):
+		return model.BlockContentText_Numbered, 0, true
+	}
+	// the direct outline level makes the paragraph a heading too
+	if lvl := pr.val("outlineLvl"); lvl != "" {
+		switch lvl {
+		case "0":
+			return model.BlockContentText_Header1, 0, false
+		case "1":
+			return model.BlockContentText_Header2, 0, false
+		case "2":
+			return model.BlockContentText_Header3, 0, false
+		}
+	}
+	return model.BlockContentText_Paragraph, 0, false
+}
+
+func (d *document) convertParagraph(n *node) error {
+	p := &paragraph{}
+	d.convertInline(n, runFormat{}, p)
+	style, level, isList := d.textStyle(n.child("pPr"))
+
+	var parentID string
+	if isList {
+		if level > len(d.lists) {
+			level = len(d.lists)
+		}
+		d.lists = d.lists[:level]
+		if level > 0 {
+			parentID = d.lists[level-1]
+		}
+	} else {
+		d.lists = d.lists[:0]
+	}
+
+	if p.length > 0 {
+		id, err := d.addBlock(parentID, &model.Block{Content: p.content(style)})
+		if err != nil {
+			return err
+		}
+		if isList {
+			d.lists = append(d.lists, id)
+		}
+	}
+	for _, img := range p.images {
+		name, err := d.extractImage(img)
+		if err != nil {
+			log.Warnf("failed to extract image: %s", err)
+			continue
+		}
+		if _, err = d.addBlock(parentID, &model.Block{
+			Content: &model.BlockContentOfFile{
+				File: &model.BlockContentFile{
+					Name:  name,
+					State: model.BlockContentFile_Empty,
+					Type:  model.BlockContentFile_Image,
+				},
+			},
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *document) addBlock(parentID string, b *model.Block) (string, error) {
+	sb := simple.New(b)
+	if !d.state.Add(sb) {
+		return "", fmt.Errorf("add block %s", sb.Model().Id)
+	}
+	if err := d.state.InsertTo(parentID, model.Block_Inner, sb.Model().Id); err != nil {
+		return "", err
+	}
+	return sb.Model().Id, nil
+}
+
+// extractImage copies the embedded image to the temp dir and returns its path, linked images are returned as URLs
+func (d *document) extractImage(relID string) (string, error) {
+	rel, ok := d.rels[relID]
+	if !ok {
+		return "", fmt.Errorf("relationship %s is not found", relID)
+	}
+	if rel.external {
+		return rel.target, nil
+	}
+	f, ok := d.files[rel.target]
+	if !ok {
+		return "", fmt.Errorf("image %s is not found", rel.target)
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return "", err
+	}
+	defer rc.Close()
+	name := filepath.Join(d.tempDir, bson.NewObjectId().Hex()+"_"+path.Base(rel.target))
+	tmpFile, err := os.Create(name)
+	if err != nil {
+		return "", err
+	}
+	defer tmpFile.Close()
+	if _, err = io.Copy(tmpFile, rc); err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+// convertTable creates the table block, the paragraphs of the cell are joined by line breaks
+func (d *document) convertTable(n *node) error {
+	var (
+		rows       [][]*paragraph
+		headerRows int
+		columns    int
+	)
+	for _, tr := range n.children("tr") {
+		var cells []*paragraph
+		for _, tc := range tr.children("tc") {
+			p := &paragraph{}
+			for _, cp := range tc.children("p") {
+				if p.length > 0 {
+					p.add("\n", runFormat{})
+				}
+				d.convertInline(cp, runFormat{}, p)
+			}
+			cells = append(cells, p)
+			// the merged cells are filled with the empty ones to keep the columns in place
+			span, _ := strconv.Atoi(tc.child("tcPr").val("gridSpan"))
+			for i := 1; i < span; i++ {
+				cells = append(cells, &paragraph{})
+			}
+		}
+		if tr.child("trPr").child("tblHeader") != nil && headerRows == len(rows) {
+			headerRows++
+		}
+		if len(cells) > columns {
+			columns = len(cells)
+		}
+		rows = append(rows, cells)
+	}
+	if len(rows) == 0 || columns == 0 {
+		return nil
+	}
+
+	tableID, err := d.tables.TableCreate(d.state, pb.RpcBlockTableCreateRequest{
+		Position: model.Block_Inner,
+		Rows:     uint32(len(rows)),
+		Columns:  uint32(columns),
+	})
+	if err != nil {
+		return fmt.Errorf("create table: %w", err)
+	}
+	tb, err := table.NewTable(d.state, tableID)
+	if err != nil {
+		return err
+	}
+	rowIDs, colIDs := tb.RowIDs(), tb.ColumnIDs()
+	for i, cells := range rows {
+		if i < headerRows {
+			if err = d.tables.RowSetHeader(d.state, pb.RpcBlockTableRowSetHeaderRequest{TargetId: rowIDs[i], IsHeader: true}); err != nil {
+				return err
+			}
+		}
+		for j, c := range cells {
+			if c.length == 0 {
+				continue
+			}
+			if _, err = d.tables.CellCreate(d.state, rowIDs[i], colIDs[j], &model.Block{Content: c.content(model.BlockContentText_Paragraph)}); err != nil {
+				return fmt.Errorf("create cell: %w", err)
+			}
+		}
+	}
+	return nil
+}
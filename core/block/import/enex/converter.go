@@ -0,0 +1,223 @@
+package enex
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/google/uuid"
+
+	"github.com/anyproto/anytype-heart/core/block/collection"
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/import/source"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+var log = logging.Logger("enex-import")
+
+const numberOfStages = 2 // 1 cycle to get snapshots and 1 cycle to create objects
+const (
+	Name               = "Enex"
+	rootCollectionName = "Evernote Import"
+	untitledNote       = "Untitled"
+)
+
+// ENEX imports the notes exported from Evernote, every .enex file contains one or several notes
+type ENEX struct {
+	tempDirProvider core.TempDirProvider
+	service         *collection.Service
+}
+
+func New(tempDirProvider core.TempDirProvider, service *collection.Service) converter.Converter {
+	return &ENEX{tempDirProvider: tempDirProvider, service: service}
+}
+
+func (e *ENEX) Name() string {
+	return Name
+}
+
+func (e *ENEX) GetParams(req *pb.RpcObjectImportRequest) []string {
+	if p := req.GetEnexParams(); p != nil {
+		return p.Path
+	}
+
+	return nil
+}
+
+func (e *ENEX) GetSnapshots(req *pb.RpcObjectImportRequest, progress process.Progress) (*converter.Response, *converter.ConvertError) {
+	paths := e.GetParams(req)
+	if len(paths) == 0 {
+		return nil, nil
+	}
+	progress.SetProgressMessage("Start creating snapshots from notes")
+	cErr := converter.NewError()
+	snapshots, targetObjects, cancelError := e.getSnapshotsForImport(req, progress, paths, cErr)
+	if !cancelError.IsEmpty() {
+		return nil, cancelError
+	}
+	if (!cErr.IsEmpty() && req.Mode == pb.RpcObjectImportRequest_ALL_OR_NOTHING) || cErr.IsNoObjectToImportError(len(paths)) {
+		return nil, cErr
+	}
+	rootCollection := converter.NewRootCollection(e.service)
+	rootCol, err := rootCollection.MakeRootCollection(rootCollectionName, targetObjects)
+	if err != nil {
+		cErr.Add(err)
+		if req.Mode == pb.RpcObjectImportRequest_ALL_OR_NOTHING {
+			return nil, cErr
+		}
+	}
+	if rootCol != nil {
+		snapshots = append(snapshots, rootCol)
+	}
+	progress.SetTotal(int64(numberOfStages * len(snapshots)))
+	if cErr.IsEmpty() {
+		return &converter.Response{Snapshots: snapshots}, nil
+	}
+	return &converter.Response{
+		Snapshots: snapshots,
+	}, cErr
+}
+
+func (e *ENEX) getSnapshotsForImport(req *pb.RpcObjectImportRequest,
+	progress process.Progress,
+	paths []string,
+	cErr *converter.ConvertError) ([]*converter.Snapshot, []string, *converter.ConvertError) {
+	snapshots := make([]*converter.Snapshot, 0)
+	targetObjects := make([]string, 0)
+	// the tags are shared by the notes of all files
+	tags := converter.NewTags()
+	for _, p := range paths {
+		if err := progress.TryStep(1); err != nil {
+			return nil, nil, converter.NewCancelError(err)
+		}
+		sn, to, err := e.handleImportPath(p, req.GetMode(), tags)
+		if err != nil {
+			cErr.Add(err)
+			if req.Mode == pb.RpcObjectImportRequest_ALL_OR_NOTHING {
+				return nil, nil, nil
+			}
+			continue
+		}
+		snapshots = append(snapshots, sn...)
+		targetObjects = append(targetObjects, to...)
+	}
+	return snapshots, targetObjects, nil
+}
+
+func (e *ENEX) handleImportPath(p string, mode pb.RpcObjectImportRequestMode, tags *converter.Tags) ([]*converter.Snapshot, []string, error) {
+	s := source.GetSource(p)
+	if s == nil {
+		return nil, nil, fmt.Errorf("failed to identify source: %s", p)
+	}
+
+	readers, err := s.GetFileReaders(p, []string{".enex"})
+	if err != nil {
+		if mode == pb.RpcObjectImportRequest_ALL_OR_NOTHING {
+			return nil, nil, err
+		}
+	}
+	if len(readers) == 0 {
+		return nil, nil, converter.ErrNoObjectsToImport
+	}
+	var (
+		snapshots     []*converter.Snapshot
+		targetObjects []string
+	)
+	for name, rc := range readers {
+		notes, err := readNotes(rc)
+		if err != nil {
+			if mode == pb.RpcObjectImportRequest_ALL_OR_NOTHING {
+				return nil, nil, fmt.Errorf("%s: %w", name, err)
+			}
+			log.Errorf("failed to read %s: %s", name, err)
+			continue
+		}
+		titles := make(map[string]int, len(notes))
+		for i := range notes {
+			n := &notes[i]
+			blocks, err := noteToBlocks(n, e.tempDir())
+			if err != nil {
+				if mode == pb.RpcObjectImportRequest_ALL_OR_NOTHING {
+					return nil, nil, fmt.Errorf("%s: %s: %w", name, n.Title, err)
+				}
+				log.Errorf("failed to convert note %s from %s: %s", n.Title, name, err)
+				continue
+			}
+			sn, options := e.getSnapshot(n, blocks, notePath(name, n.Title, titles), tags)
+			snapshots = append(snapshots, sn)
+			snapshots = append(snapshots, options...)
+			targetObjects = append(targetObjects, sn.Id)
+		}
+	}
+	return snapshots, targetObjects, nil
+}
+
+func readNotes(rc io.ReadCloser) ([]note, error) {
+	defer rc.Close()
+	e, err := parse(rc)
+	if err != nil {
+		return nil, err
+	}
+	return e.Notes, nil
+}
+
+// notePath returns the source path of the note, it's unique for the notes with the same title in one file
+func notePath(fileName, title string, titles map[string]int) string {
+	p := filepath.Join(fileName, title)
+	titles[title]++
+	if n := titles[title]; n > 1 {
+		p += " " + strconv.Itoa(n)
+	}
+	return p
+}
+
+func (e *ENEX) tempDir() string {
+	if e.tempDirProvider != nil {
+		return e.tempDirProvider.TempDir()
+	}
+	return os.TempDir()
+}
+
+func (e *ENEX) getSnapshot(n *note, blocks []*model.Block, p string, tags *converter.Tags) (*converter.Snapshot, []*converter.Snapshot) {
+	title := n.Title
+	if title == "" {
+		title = untitledNote
+	}
+	details := converter.GetCommonDetails(p, title, "")
+	if created := parseDate(n.Created); created != 0 {
+		details.Fields[bundle.RelationKeyCreatedDate.String()] = pbtypes.Int64(created)
+	}
+	if updated := parseDate(n.Updated); updated != 0 {
+		details.Fields[bundle.RelationKeyLastModifiedDate.String()] = pbtypes.Int64(updated)
+	}
+	sn := &model.SmartBlockSnapshotBase{
+		Blocks:      blocks,
+		Details:     details,
+		ObjectTypes: []string{bundle.TypeKeyPage.URL()},
+	}
+	if n.SourceURL != "" {
+		details.Fields[bundle.RelationKeySource.String()] = pbtypes.String(n.SourceURL)
+		sn.RelationLinks = append(sn.RelationLinks, &model.RelationLink{
+			Key:    bundle.RelationKeySource.String(),
+			Format: model.RelationFormat_url,
+		})
+	}
+	options := tags.Set(sn, n.Tags)
+
+	snapshot := &converter.Snapshot{
+		Id:       uuid.New().String(),
+		FileName: p,
+		Snapshot: &pb.ChangeSnapshot{Data: sn},
+		SbType:   smartblock.SmartBlockTypePage,
+	}
+	return snapshot, options
+}
@@ -0,0 +1,109 @@
+package enex
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+type tempDirProvider struct {
+	dir string
+}
+
+func (p tempDirProvider) TempDir() string {
+	return p.dir
+}
+
+func TestENEX_GetSnapshots(t *testing.T) {
+	dir := t.TempDir()
+	e := &ENEX{tempDirProvider: tempDirProvider{dir: dir}}
+	res, ce := e.GetSnapshots(&pb.RpcObjectImportRequest{
+		Params: &pb.RpcObjectImportRequestParamsOfEnexParams{
+			EnexParams: &pb.RpcObjectImportRequestEnexParams{Path: []string{"testdata/test.enex"}},
+		},
+		Type: pb.RpcObjectImportRequest_Enex,
+		Mode: pb.RpcObjectImportRequest_ALL_OR_NOTHING,
+	}, process.NewProgress(pb.ModelProcess_Import))
+	require.Nil(t, ce)
+	require.NotNil(t, res)
+
+	var notes, options []*model.SmartBlockSnapshotBase
+	for _, sn := range res.Snapshots {
+		switch sn.Snapshot.Data.ObjectTypes[0] {
+		case bundle.TypeKeyPage.URL():
+			notes = append(notes, sn.Snapshot.Data)
+		case bundle.TypeKeyRelationOption.URL():
+			options = append(options, sn.Snapshot.Data)
+		}
+	}
+	require.Len(t, notes, 2)
+	// the options are shared by the notes
+	require.Len(t, options, 2)
+	optionIDs := make(map[string]string, len(options))
+	for _, o := range options {
+		assert.Equal(t, bundle.RelationKeyTag.String(), pbtypes.GetString(o.Details, bundle.RelationKeyRelationKey.String()))
+		optionIDs[pbtypes.GetString(o.Details, bundle.RelationKeyName.String())] = pbtypes.GetString(o.Details, bundle.RelationKeyId.String())
+	}
+
+	groceries, untitled := notes[0], notes[1]
+	if pbtypes.GetString(groceries.Details, bundle.RelationKeyName.String()) != "Groceries" {
+		groceries, untitled = untitled, groceries
+	}
+
+	t.Run("details", func(t *testing.T) {
+		assert.Equal(t, "Groceries", pbtypes.GetString(groceries.Details, bundle.RelationKeyName.String()))
+		assert.Equal(t, int64(1697619600), pbtypes.GetInt64(groceries.Details, bundle.RelationKeyCreatedDate.String()))
+		assert.Equal(t, int64(1697709600), pbtypes.GetInt64(groceries.Details, bundle.RelationKeyLastModifiedDate.String()))
+		assert.Equal(t, "https://example.com/list", pbtypes.GetString(groceries.Details, bundle.RelationKeySource.String()))
+		assert.Equal(t, []string{optionIDs["home"], optionIDs["todo"]}, pbtypes.GetStringList(groceries.Details, bundle.RelationKeyTag.String()))
+
+		assert.Equal(t, untitledNote, pbtypes.GetString(untitled.Details, bundle.RelationKeyName.String()))
+		assert.Equal(t, []string{optionIDs["home"]}, pbtypes.GetStringList(untitled.Details, bundle.RelationKeyTag.String()))
+		assert.Zero(t, pbtypes.GetInt64(untitled.Details, bundle.RelationKeyLastModifiedDate.String()))
+	})
+
+	t.Run("content", func(t *testing.T) {
+		bl := groceries.Blocks
+		require.Len(t, bl, 5)
+		assert.Equal(t, "Shopping list with bold text", strings.ReplaceAll(bl[0].GetText().Text, " ", " "))
+
+		assert.Equal(t, model.BlockContentText_Checkbox, bl[1].GetText().Style)
+		assert.Equal(t, "Milk", bl[1].GetText().Text)
+		assert.True(t, bl[1].GetText().Checked)
+		assert.Equal(t, "Eggs", bl[2].GetText().Text)
+		assert.False(t, bl[2].GetText().Checked)
+
+		image := bl[3].GetFile()
+		require.NotNil(t, image)
+		assert.Equal(t, model.BlockContentFile_Image, image.Type)
+		assert.True(t, strings.HasPrefix(image.Name, dir))
+		assert.FileExists(t, image.Name)
+
+		pdf := bl[4].GetFile()
+		require.NotNil(t, pdf)
+		assert.Equal(t, model.BlockContentFile_PDF, pdf.Type)
+		assert.True(t, strings.HasSuffix(pdf.Name, "_report.pdf"))
+		assert.FileExists(t, pdf.Name)
+	})
+}
+
+func TestENEX_GetSnapshotsNoFiles(t *testing.T) {
+	e := &ENEX{tempDirProvider: tempDirProvider{dir: t.TempDir()}}
+	res, ce := e.GetSnapshots(&pb.RpcObjectImportRequest{
+		Params: &pb.RpcObjectImportRequestParamsOfEnexParams{
+			EnexParams: &pb.RpcObjectImportRequestEnexParams{Path: []string{t.TempDir()}},
+		},
+		Type: pb.RpcObjectImportRequest_Enex,
+		Mode: pb.RpcObjectImportRequest_IGNORE_ERRORS,
+	}, process.NewProgress(pb.ModelProcess_Import))
+	assert.Nil(t, res)
+	assert.NotNil(t, ce)
+}
@@ -0,0 +1,74 @@
+package enex
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"io"
+	"strings"
+	"time"
+)
+
+// dateLayout is the format of created and updated dates of the notes
+const dateLayout = "20060102T150405Z"
+
+// export is the root element of the file made by Evernote "Export notes", it contains one or several notes
+type export struct {
+	Notes []note `xml:"note"`
+}
+
+type note struct {
+	Title     string     `xml:"title"`
+	Content   string     `xml:"content"`
+	Created   string     `xml:"created"`
+	Updated   string     `xml:"updated"`
+	Tags      []string   `xml:"tag"`
+	SourceURL string     `xml:"note-attributes>source-url"`
+	Resources []resource `xml:"resource"`
+}
+
+// resource is the file attached to the note, it's referenced in the content by the md5 hash of the data
+type resource struct {
+	Data     resourceData `xml:"data"`
+	Mime     string       `xml:"mime"`
+	FileName string       `xml:"resource-attributes>file-name"`
+}
+
+type resourceData struct {
+	Encoding string `xml:"encoding,attr"`
+	Value    string `xml:",chardata"`
+}
+
+func parse(r io.Reader) (*export, error) {
+	var e export
+	d := xml.NewDecoder(r)
+	// ENML uses the entities of XHTML, like &nbsp;
+	d.Strict = false
+	d.Entity = xml.HTMLEntity
+	if err := d.Decode(&e); err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+func (r *resource) decode() ([]byte, error) {
+	if r.Data.Encoding != "" && r.Data.Encoding != "base64" {
+		return []byte(r.Data.Value), nil
+	}
+	// the data is split to lines
+	return base64.StdEncoding.DecodeString(strings.Join(strings.Fields(r.Data.Value), ""))
+}
+
+func hash(data []byte) string {
+	sum := md5.Sum(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func parseDate(v string) int64 {
+	t, err := time.Parse(dateLayout, strings.TrimSpace(v))
+	if err != nil {
+		return 0
+	}
+	return t.Unix()
+}
@@ -0,0 +1,137 @@
+package enex
+
+import (
+	"fmt"
+	"html"
+	"mime"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/globalsign/mgo/bson"
+
+	"github.com/anyproto/anytype-heart/core/block/import/markdown/anymark"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+var (
+	// the elements of ENML aren't closed in the same way, e.g. <en-media .../> and <en-media ...></en-media>
+	mediaRegexp = regexp.MustCompile(`(?s)<en-media\b([^>]*?)/?>(?:\s*</en-media>)?`)
+	todoRegexp  = regexp.MustCompile(`(?s)<en-todo\b([^>]*?)/?>(?:\s*</en-todo>)?`)
+	cryptRegexp = regexp.MustCompile(`(?s)<en-crypt\b.*?</en-crypt>`)
+	attrRegexp  = regexp.MustCompile(`([\w-]+)\s*=\s*"([^"]*)"`)
+)
+
+// attachment is the resource of the note saved to the temp dir, the file syncer uploads it during the import
+type attachment struct {
+	path     string
+	fileType model.BlockContentFileType
+	name     string
+}
+
+// noteToBlocks converts ENML content of the note to blocks. ENML is XHTML with special elements for the attachments,
+// checkboxes and encrypted text, so the special elements are replaced by HTML and the result is converted as HTML import does
+func noteToBlocks(n *note, tempDir string) ([]*model.Block, error) {
+	attachments, err := saveResources(n.Resources, tempDir)
+	if err != nil {
+		return nil, err
+	}
+	content := cryptRegexp.ReplaceAllString(n.Content, "")
+	content = todoRegexp.ReplaceAllStringFunc(content, func(s string) string {
+		if attrs(todoRegexp.FindStringSubmatch(s)[1])["checked"] == "true" {
+			return "[x] "
+		}
+		return "[ ] "
+	})
+	content = mediaRegexp.ReplaceAllStringFunc(content, func(s string) string {
+		a, ok := attachments[attrs(mediaRegexp.FindStringSubmatch(s)[1])["hash"]]
+		if !ok {
+			return ""
+		}
+		src, name := (&url.URL{Path: a.path}).EscapedPath(), html.EscapeString(a.name)
+		if a.fileType == model.BlockContentFile_Image {
+			// the images without alt are converted to the text
+			return fmt.Sprintf(`<img src="%s" alt="%s"/>`, src, name)
+		}
+		return fmt.Sprintf(`<a href="%s">%s</a>`, src, name)
+	})
+
+	blocks, _, err := anymark.HTMLToBlocks([]byte(content))
+	if err != nil {
+		return nil, err
+	}
+	byPath := make(map[string]*attachment, len(attachments))
+	for _, a := range attachments {
+		byPath[a.path] = a
+	}
+	for _, b := range blocks {
+		convertAttachmentLink(b, byPath)
+	}
+	return blocks, nil
+}
+
+// convertAttachmentLink replaces the paragraph which contains only the link to the attachment by the file block
+func convertAttachmentLink(b *model.Block, attachments map[string]*attachment) {
+	t := b.GetText()
+	if t == nil || t.Marks == nil || len(t.Marks.Marks) != 1 {
+		return
+	}
+	mark := t.Marks.Marks[0]
+	a, ok := attachments[mark.Param]
+	if !ok || mark.Type != model.BlockContentTextMark_Link || mark.Range.From != 0 || int(mark.Range.To) != len([]rune(t.Text)) {
+		return
+	}
+	b.Content = &model.BlockContentOfFile{File: &model.BlockContentFile{
+		Name:  a.path,
+		State: model.BlockContentFile_Empty,
+		Type:  a.fileType,
+	}}
+}
+
+// saveResources writes the attachments to the temp dir, the attachments are returned by the hash of the data
+func saveResources(resources []resource, tempDir string) (map[string]*attachment, error) {
+	attachments := make(map[string]*attachment, len(resources))
+	for _, r := range resources {
+		data, err := r.decode()
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode attachment %s: %w", r.FileName, err)
+		}
+		name := filepath.Base(r.FileName)
+		if r.FileName == "" {
+			name = "attachment"
+			if exts, _ := mime.ExtensionsByType(r.Mime); len(exts) > 0 {
+				name += exts[0]
+			}
+		}
+		p := filepath.Join(tempDir, bson.NewObjectId().Hex()+"_"+name)
+		if err = os.WriteFile(p, data, 0600); err != nil {
+			return nil, fmt.Errorf("failed to save attachment %s: %w", name, err)
+		}
+		attachments[hash(data)] = &attachment{path: p, fileType: fileType(r.Mime), name: name}
+	}
+	return attachments, nil
+}
+
+func attrs(s string) map[string]string {
+	res := make(map[string]string)
+	for _, m := range attrRegexp.FindAllStringSubmatch(s, -1) {
+		res[m[1]] = m[2]
+	}
+	return res
+}
+
+func fileType(mimeType string) model.BlockContentFileType {
+	switch {
+	case strings.HasPrefix(mimeType, "image/"):
+		return model.BlockContentFile_Image
+	case strings.HasPrefix(mimeType, "video/"):
+		return model.BlockContentFile_Video
+	case strings.HasPrefix(mimeType, "audio/"):
+		return model.BlockContentFile_Audio
+	case mimeType == "application/pdf":
+		return model.BlockContentFile_PDF
+	}
+	return model.BlockContentFile_File
+}
@@ -14,8 +14,11 @@ import (
 
 	"github.com/anyproto/anytype-heart/core/block"
 	"github.com/anyproto/anytype-heart/core/block/collection"
+	"github.com/anyproto/anytype-heart/core/block/import/bear"
 	"github.com/anyproto/anytype-heart/core/block/import/converter"
 	"github.com/anyproto/anytype-heart/core/block/import/csv"
+	"github.com/anyproto/anytype-heart/core/block/import/docx"
+	"github.com/anyproto/anytype-heart/core/block/import/enex"
 	"github.com/anyproto/anytype-heart/core/block/import/html"
 	"github.com/anyproto/anytype-heart/core/block/import/markdown"
 	"github.com/anyproto/anytype-heart/core/block/import/notion"
@@ -23,9 +26,11 @@ import (
 	"github.com/anyproto/anytype-heart/core/block/import/syncer"
 	"github.com/anyproto/anytype-heart/core/block/import/txt"
 	"github.com/anyproto/anytype-heart/core/block/import/web"
+	"github.com/anyproto/anytype-heart/core/block/import/web/parsers"
 	"github.com/anyproto/anytype-heart/core/block/import/workerpool"
 	"github.com/anyproto/anytype-heart/core/block/object/objectcreator"
 	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/core/relation"
 	"github.com/anyproto/anytype-heart/core/session"
 	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
@@ -51,6 +56,7 @@ type Import struct {
 	objectIDGetter  IDGetter
 	tempDirProvider core.TempDirProvider
 	sbtProvider     typeprovider.SmartBlockTypeProvider
+	csvSyncer       *csv.Syncer
 }
 
 func New(
@@ -70,12 +76,15 @@ func (i *Import) Init(a *app.App) (err error) {
 	col := app.MustComponent[*collection.Service](a)
 	converters := []converter.Converter{
 		markdown.New(i.tempDirProvider, col),
-		notion.New(col),
+		notion.New(i.tempDirProvider, col),
 		pbc.New(col, i.sbtProvider, coreService),
-		web.NewConverter(),
+		web.NewConverter(parsers.NewArticleParser()),
 		html.New(col),
 		txt.New(col),
 		csv.New(col),
+		docx.New(i.tempDirProvider, col),
+		enex.New(i.tempDirProvider, col),
+		bear.New(i.tempDirProvider, col),
 	}
 	for _, c := range converters {
 		i.converters[c.Name()] = c
@@ -87,7 +96,9 @@ func (i *Import) Init(a *app.App) (err error) {
 	i.objectIDGetter = NewObjectIDGetter(store, coreService, i.s)
 	fileStore := app.MustComponent[filestore.FileStore](a)
 	relationSyncer := syncer.NewFileRelationSyncer(i.s, fileStore)
-	i.oc = NewCreator(i.s, objCreator, coreService, factory, store, relationSyncer, fileStore)
+	relationService := app.MustComponent[relation.Service](a)
+	i.oc = NewCreator(i.s, objCreator, coreService, factory, store, relationSyncer, relationService, fileStore)
+	i.csvSyncer = csv.NewSyncer(i.s, col, store)
 	return nil
 }
 
@@ -209,6 +220,15 @@ func (i *Import) ValidateNotionToken(
 	return tv.Validate(ctx, req.GetToken())
 }
 
+// SyncCSV matches the rows of CSV files with the objects of the collection imported earlier and updates them,
+// the changes are only returned if preview is requested
+func (i *Import) SyncCSV(ctx *session.Context, req *pb.RpcObjectImportRequest) ([]*pb.RpcObjectImportChange, error) {
+	if req.Type != pb.RpcObjectImportRequest_Csv {
+		return nil, fmt.Errorf("sync isn't supported for %s import", req.Type)
+	}
+	return i.csvSyncer.Sync(ctx, req)
+}
+
 func (i *Import) ImportWeb(ctx *session.Context, req *pb.RpcObjectImportRequest) (string, *types.Struct, error) {
 	progress := process.NewProgress(pb.ModelProcess_Import)
 	defer progress.Finish(nil)
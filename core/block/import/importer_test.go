@@ -300,7 +300,7 @@ func Test_ImportWebNoParser(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
 	i.converters = make(map[string]cv.Converter, 0)
-	i.converters[web.Name] = web.NewConverter()
+	i.converters[web.Name] = web.NewConverter(nil)
 
 	creator := NewMockCreator(ctrl)
 	i.oc = creator
@@ -321,7 +321,7 @@ func Test_ImportWebFailedToParse(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
 	i.converters = make(map[string]cv.Converter, 0)
-	i.converters[web.Name] = web.NewConverter()
+	i.converters[web.Name] = web.NewConverter(nil)
 	creator := NewMockCreator(ctrl)
 	i.oc = creator
 	idGetter := NewMockIDGetter(ctrl)
@@ -351,7 +351,7 @@ func Test_ImportWebSuccess(t *testing.T) {
 
 	i.converters = make(map[string]cv.Converter, 0)
 
-	i.converters[web.Name] = web.NewConverter()
+	i.converters[web.Name] = web.NewConverter(nil)
 
 	creator := NewMockCreator(ctrl)
 	creator.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, "", nil).Times(1)
@@ -391,7 +391,7 @@ func Test_ImportWebFailedToCreateObject(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
 	i.converters = make(map[string]cv.Converter, 0)
-	i.converters[web.Name] = web.NewConverter()
+	i.converters[web.Name] = web.NewConverter(nil)
 
 	creator := NewMockCreator(ctrl)
 	//nolint:lll
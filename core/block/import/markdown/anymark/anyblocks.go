@@ -5,6 +5,7 @@ import (
 
 	"github.com/gogo/protobuf/types"
 
+	"github.com/anyproto/anytype-heart/core/block/simple/diagram"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 	"github.com/anyproto/anytype-heart/util/pbtypes"
 )
@@ -72,6 +73,17 @@ func combineCodeBlocks(accum []*model.Block) []*model.Block {
 }
 
 func provideCodeBlock(textArr []string, language string, id string) *model.Block {
+	if diagramLanguage, ok := diagram.LanguageFromCode(language); ok {
+		return &model.Block{
+			Id: id,
+			Content: &model.BlockContentOfDiagram{
+				Diagram: &model.BlockContentDiagram{
+					Source:   strings.TrimSuffix(strings.Join(textArr, "\n"), "\n"),
+					Language: diagramLanguage,
+				},
+			},
+		}
+	}
 	var field *types.Struct
 	if language != "" {
 		field = &types.Struct{Fields: map[string]*types.Value{"lang": pbtypes.String(language)}}
@@ -128,3 +128,16 @@ func TestPreprocessBlocksThreeCodeBlock(t *testing.T) {
 	assert.Equal(t, blocks[0].GetText().GetText(), bl.GetText().GetText())
 	assert.Equal(t, blocks[1].GetText().GetText(), bl2.GetText().GetText()+"\n"+bl3.GetText().GetText())
 }
+
+func TestConvertBlocks_Diagram(t *testing.T) {
+	source := []byte("<div class=\"diagram\"><img src=\"data:image/svg+xml;base64,PHN2Zz48L3N2Zz4=\" alt=\"mermaid diagram\"></div>\n\n" +
+		"```mermaid\ngraph TD\n  A --> B\n```\n")
+
+	blocks, _, err := MarkdownToBlocks(source, "", nil)
+	assert.NoError(t, err)
+	assert.Len(t, blocks, 1)
+	assert.Equal(t, &model.BlockContentDiagram{
+		Source:   "graph TD\n  A --> B",
+		Language: model.BlockContentDiagram_Mermaid,
+	}, blocks[0].GetDiagram())
+}
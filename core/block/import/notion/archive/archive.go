@@ -0,0 +1,204 @@
+package archive
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/anyproto/anytype-heart/core/block/collection"
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/core"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+)
+
+var log = logging.Logger("notion-import-archive")
+
+const (
+	rootCollectionName = "Notion Import"
+	// 1 cycle to read files, 1 cycle to make snapshots and 1 cycle to create objects
+	numberOfSteps = 3
+)
+
+// notionIDRegexp matches the page id, Notion adds it to the end of the names of exported files and of the page urls
+var notionIDRegexp = regexp.MustCompile(`([0-9a-f]{32})(?:[^0-9a-f]|$)`)
+
+// Service imports the archives made by Notion "Export → Markdown & CSV",
+// pages become pages, databases become collections with their rows and attachments become files
+type Service struct {
+	tempDirProvider   core.TempDirProvider
+	collectionService *collection.Service
+}
+
+func New(tempDirProvider core.TempDirProvider, c *collection.Service) *Service {
+	return &Service{tempDirProvider: tempDirProvider, collectionService: c}
+}
+
+// entry is the file of the export, fsys is the archive which contains it,
+// the exports of big workspaces are split to several archives packed in one
+type entry struct {
+	fsys fs.FS
+	name string
+}
+
+func (e entry) read() ([]byte, error) {
+	return fs.ReadFile(e.fsys, e.name)
+}
+
+func (s *Service) GetSnapshots(mode pb.RpcObjectImportRequestMode, paths []string, progress process.Progress) (*converter.Response, *converter.ConvertError) {
+	ce := converter.NewError()
+	files := make(map[string]entry)
+	for _, p := range paths {
+		closer, err := readExport(p, files)
+		if closer != nil {
+			// attachments are copied from the archive while the snapshots are made
+			defer closeReader(closer)
+		}
+		if err != nil {
+			ce.Add(fmt.Errorf("failed to read notion export %s: %w", p, err))
+			if mode == pb.RpcObjectImportRequest_ALL_OR_NOTHING {
+				return nil, ce
+			}
+		}
+	}
+
+	w := newWorkspace(s.tempDirProvider, s.collectionService, mode, progress)
+	progress.SetProgressMessage("Start reading notion export")
+	progress.SetTotal(int64(len(files) * numberOfSteps))
+	if cancelErr := w.read(files); cancelErr != nil {
+		return nil, cancelErr
+	}
+	if !w.errors.IsEmpty() && mode == pb.RpcObjectImportRequest_ALL_OR_NOTHING {
+		return nil, w.errors
+	}
+	if len(w.pages) == 0 && len(w.databases) == 0 {
+		ce.Add(converter.ErrNoObjectsToImport)
+		return nil, ce
+	}
+
+	progress.SetProgressMessage("Start creating snapshots from notion export")
+	snapshots, cancelErr := w.makeSnapshots()
+	if cancelErr != nil {
+		return nil, cancelErr
+	}
+	ce.Merge(w.errors)
+	if !ce.IsEmpty() && mode == pb.RpcObjectImportRequest_ALL_OR_NOTHING {
+		return nil, ce
+	}
+
+	rootCollection := converter.NewRootCollection(s.collectionService)
+	rootCol, err := rootCollection.MakeRootCollection(rootCollectionName, w.topLevelObjects())
+	if err != nil {
+		ce.Add(err)
+		if mode == pb.RpcObjectImportRequest_ALL_OR_NOTHING {
+			return nil, ce
+		}
+	}
+	if rootCol != nil {
+		snapshots = append(snapshots, rootCol)
+	}
+	if ce.IsEmpty() {
+		return &converter.Response{Snapshots: snapshots}, nil
+	}
+	return &converter.Response{Snapshots: snapshots}, ce
+}
+
+// readExport adds the files of the export to the files by their paths, the export is either zip archive or unpacked directory.
+// The returned archive is read until the snapshots are made, so the attachments aren't kept in memory
+func readExport(exportPath string, files map[string]entry) (io.Closer, error) {
+	if strings.EqualFold(filepath.Ext(exportPath), ".zip") {
+		r, err := zip.OpenReader(exportPath)
+		if err != nil {
+			return nil, err
+		}
+		return r, collectFiles(r, files)
+	}
+	info, err := os.Stat(exportPath)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("expected zip archive or directory")
+	}
+	return nil, collectFiles(os.DirFS(exportPath), files)
+}
+
+func collectFiles(fsys fs.FS, files map[string]entry) error {
+	return fs.WalkDir(fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if name == "__MACOSX" {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if !strings.EqualFold(path.Ext(name), ".zip") {
+			files[name] = entry{fsys: fsys, name: name}
+			return nil
+		}
+		data, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return err
+		}
+		nested, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+		if err != nil {
+			return fmt.Errorf("failed to open %s: %w", name, err)
+		}
+		return collectFiles(nested, files)
+	})
+}
+
+// notionID returns the id of the page which is the last one in the name of the file or in the url
+func notionID(s string) string {
+	ids := notionIDRegexp.FindAllStringSubmatch(s, -1)
+	if len(ids) == 0 {
+		return ""
+	}
+	return ids[len(ids)-1][1]
+}
+
+// objectKey returns the path of the object without the extension,
+// the children of the object are placed in the directory with this path
+func objectKey(name string) string {
+	ext := path.Ext(name)
+	key := strings.TrimSuffix(name, ext)
+	if strings.EqualFold(ext, csvExt) {
+		key = strings.TrimSuffix(key, allRowsSuffix)
+	}
+	return key
+}
+
+// titleFromName returns the title of the object written in the name of the file
+func titleFromName(name string) string {
+	base := path.Base(objectKey(name))
+	if id := notionID(base); id != "" && strings.HasSuffix(base, id) {
+		base = strings.TrimSuffix(base, id)
+	}
+	return strings.TrimSpace(base)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func closeReader(rc io.Closer) {
+	if err := rc.Close(); err != nil {
+		log.Errorf("failed to close file: %s", err)
+	}
+}
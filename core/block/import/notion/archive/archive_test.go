@@ -0,0 +1,168 @@
+package archive
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/template"
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/addr"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+type tempDirProvider struct {
+	dir string
+}
+
+func (p tempDirProvider) TempDir() string {
+	return p.dir
+}
+
+// snapshotsByName returns the snapshots of the objects by their names, the relations by their names with the prefix
+func snapshotsByName(snapshots []*converter.Snapshot) map[string]*converter.Snapshot {
+	res := make(map[string]*converter.Snapshot, len(snapshots))
+	for _, sn := range snapshots {
+		name := pbtypes.GetString(sn.Snapshot.Data.Details, bundle.RelationKeyName.String())
+		switch {
+		case strings.HasPrefix(sn.Id, addr.RelationKeyToIdPrefix):
+			name = "relation:" + name
+		case sn.Snapshot.Data.ObjectTypes[0] == bundle.TypeKeyRelationOption.URL():
+			name = "option:" + name
+		}
+		res[name] = sn
+	}
+	return res
+}
+
+func TestService_GetSnapshots(t *testing.T) {
+	dir := t.TempDir()
+	s := New(tempDirProvider{dir: dir}, nil)
+	res, ce := s.GetSnapshots(pb.RpcObjectImportRequest_IGNORE_ERRORS, []string{"testdata/Export.zip"}, process.NewProgress(pb.ModelProcess_Import))
+	require.Nil(t, ce)
+	require.NotNil(t, res)
+	objects := snapshotsByName(res.Snapshots)
+
+	home, notes, sub, tasks := objects["Home"], objects["Notes"], objects["Sub page"], objects["Tasks"]
+	require.NotNil(t, home)
+	require.NotNil(t, notes)
+	require.NotNil(t, sub)
+	require.NotNil(t, tasks)
+	blocks := func(sn *converter.Snapshot) []*model.Block {
+		// the last block is the root
+		return sn.Snapshot.Data.Blocks[:len(sn.Snapshot.Data.Blocks)-1]
+	}
+
+	t.Run("root collection contains top level objects", func(t *testing.T) {
+		root := objects[rootCollectionName]
+		require.NotNil(t, root)
+		assert.Equal(t, []string{home.Id, notes.Id}, pbtypes.GetStringList(root.Snapshot.Data.Collections, template.CollectionStoreKey))
+	})
+
+	t.Run("links are converted to objects and files", func(t *testing.T) {
+		bl := blocks(home)
+		require.Len(t, bl, 5)
+		assert.Equal(t, "Intro with Tasks inline and Notes.", bl[0].GetText().Text)
+		assert.Equal(t, []*model.BlockContentTextMark{
+			{Range: &model.Range{From: 11, To: 16}, Type: model.BlockContentTextMark_Mention, Param: tasks.Id},
+			{Range: &model.Range{From: 28, To: 33}, Type: model.BlockContentTextMark_Mention, Param: notes.Id},
+		}, bl[0].GetText().Marks.Marks)
+		assert.Equal(t, sub.Id, bl[1].GetLink().GetTargetBlockId())
+
+		image := bl[2].GetFile()
+		require.NotNil(t, image)
+		assert.Equal(t, model.BlockContentFile_Image, image.Type)
+		assert.FileExists(t, image.Name)
+		assert.True(t, strings.HasPrefix(image.Name, dir))
+		pdf := bl[3].GetFile()
+		require.NotNil(t, pdf)
+		assert.Equal(t, model.BlockContentFile_PDF, pdf.Type)
+		assert.FileExists(t, pdf.Name)
+
+		// the inline database is mentioned only, so it's linked at the end
+		assert.Equal(t, tasks.Id, bl[4].GetLink().GetTargetBlockId())
+
+		assert.Equal(t, home.Id, blocks(sub)[0].GetText().Marks.Marks[0].Param)
+		// the link to Notion page is resolved by its id
+		assert.Equal(t, sub.Id, blocks(notes)[0].GetText().Marks.Marks[0].Param)
+	})
+
+	t.Run("database rows have typed relations", func(t *testing.T) {
+		writeDocs, fixBug, release := objects["Write docs"], objects["Fix bug"], objects["Release"]
+		require.NotNil(t, writeDocs)
+		require.NotNil(t, fixBug)
+		require.NotNil(t, release)
+		assert.Equal(t, []string{writeDocs.Id, fixBug.Id, release.Id}, pbtypes.GetStringList(tasks.Snapshot.Data.Collections, template.CollectionStoreKey))
+
+		formats := map[string]model.RelationFormat{
+			"Status":   model.RelationFormat_status,
+			"Tags":     model.RelationFormat_tag,
+			"Due":      model.RelationFormat_date,
+			"Done":     model.RelationFormat_checkbox,
+			"Estimate": model.RelationFormat_number,
+			"Link":     model.RelationFormat_url,
+			"Related":  model.RelationFormat_object,
+		}
+		keys := make(map[string]string, len(formats))
+		for name, format := range formats {
+			rel := objects["relation:"+name]
+			require.NotNil(t, rel, name)
+			assert.Equal(t, float64(format), pbtypes.GetFloat64(rel.Snapshot.Data.Details, bundle.RelationKeyRelationFormat.String()), name)
+			keys[name] = pbtypes.GetString(rel.Snapshot.Data.Details, bundle.RelationKeyRelationKey.String())
+		}
+
+		details := writeDocs.Snapshot.Data.Details
+		assert.Equal(t, []string{objects["option:Done"].Id}, pbtypes.GetStringList(details, keys["Status"]))
+		assert.Equal(t, []string{objects["option:docs"].Id, objects["option:writing"].Id}, pbtypes.GetStringList(details, keys["Tags"]))
+		assert.Equal(t, int64(1697673600), pbtypes.GetInt64(details, keys["Due"]))
+		assert.True(t, pbtypes.GetBool(details, keys["Done"]))
+		assert.Equal(t, float64(3), pbtypes.GetFloat64(details, keys["Estimate"]))
+		assert.Equal(t, "https://anytype.io", pbtypes.GetString(details, keys["Link"]))
+		assert.Equal(t, []string{notes.Id}, pbtypes.GetStringList(details, keys["Related"]))
+		assert.Len(t, writeDocs.Snapshot.Data.RelationLinks, len(formats))
+
+		// the options are shared by the rows
+		assert.Equal(t, []string{objects["option:docs"].Id}, pbtypes.GetStringList(fixBug.Snapshot.Data.Details, keys["Tags"]))
+		assert.Equal(t, []string{writeDocs.Id}, pbtypes.GetStringList(release.Snapshot.Data.Details, keys["Related"]))
+
+		// the properties written in the page are removed
+		bl := blocks(writeDocs)
+		require.Len(t, bl, 1)
+		assert.Equal(t, "Body of the task", bl[0].GetText().Text)
+		assert.Empty(t, blocks(fixBug))
+	})
+}
+
+func TestService_GetSnapshotsErrors(t *testing.T) {
+	s := New(tempDirProvider{dir: t.TempDir()}, nil)
+
+	t.Run("empty archive", func(t *testing.T) {
+		_, ce := s.GetSnapshots(pb.RpcObjectImportRequest_IGNORE_ERRORS, []string{"testdata/empty.zip"}, process.NewProgress(pb.ModelProcess_Import))
+		require.NotNil(t, ce)
+		assert.True(t, errors.Is(ce.GetResultError(pb.RpcObjectImportRequest_Notion), converter.ErrNoObjectsToImport))
+	})
+
+	t.Run("missing archive", func(t *testing.T) {
+		res, ce := s.GetSnapshots(pb.RpcObjectImportRequest_ALL_OR_NOTHING, []string{"testdata/missing.zip"}, process.NewProgress(pb.ModelProcess_Import))
+		assert.Nil(t, res)
+		assert.NotNil(t, ce)
+	})
+}
+
+func TestNotionID(t *testing.T) {
+	id := strings.Repeat("a1", 16)
+	assert.Equal(t, id, notionID("Page "+id+".md"))
+	assert.Equal(t, id, notionID("https://www.notion.so/Page-"+id+"?pvs=21"))
+	assert.Equal(t, "", notionID("Page.md"))
+	// the hex digits of the escaped space aren't the part of id
+	assert.Equal(t, strings.Repeat("5", 32), notionID("Page%20"+strings.Repeat("5", 32)+".md"))
+	assert.Equal(t, "Page", titleFromName("dir/Page "+id+".md"))
+	assert.Equal(t, "dir/Table "+id, objectKey("dir/Table "+id+"_all.csv"))
+}
@@ -0,0 +1,235 @@
+package archive
+
+import (
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/globalsign/mgo/bson"
+	"github.com/gogo/protobuf/types"
+
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/addr"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+const (
+	// the longer values aren't treated as select options
+	maxOptionLength = 50
+	listSeparator   = ", "
+	checkboxYes     = "Yes"
+	checkboxNo      = "No"
+	// the start and the end of the date range
+	dateRangeSeparator = " → "
+)
+
+// dateLayouts are the formats of the dates in the exported tables, depending on the settings of the property
+var dateLayouts = []string{
+	"January 2, 2006 3:04 PM",
+	"January 2, 2006",
+	"Jan 2, 2006 3:04 PM",
+	"Jan 2, 2006",
+	"2006/01/02 15:04",
+	"2006/01/02",
+	"01/02/2006 3:04 PM",
+	"01/02/2006",
+	"02/01/2006",
+	time.RFC3339,
+	"2006-01-02",
+}
+
+// referenceRegexp matches the reference to the page in the relation value: "Title (Title%20<id>.md)" or "Title (https://www.notion.so/Title-<id>)"
+var referenceRegexp = regexp.MustCompile(`\(([^()\s]*[0-9a-f]{32}[^()\s]*)\)`)
+
+// column is the property of the database, the format is detected by the values of the column
+type column struct {
+	name    string
+	key     string
+	format  model.RelationFormat
+	options map[string]string
+}
+
+func detectFormat(values []string) model.RelationFormat {
+	var filled []string
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			filled = append(filled, v)
+		}
+	}
+	switch {
+	case len(filled) == 0:
+		return model.RelationFormat_longtext
+	case all(filled, func(v string) bool { return v == checkboxYes || v == checkboxNo }):
+		return model.RelationFormat_checkbox
+	case all(filled, func(v string) bool { _, ok := parseNumber(v); return ok }):
+		return model.RelationFormat_number
+	case all(filled, func(v string) bool { _, ok := parseDate(v); return ok }):
+		return model.RelationFormat_date
+	case all(filled, func(v string) bool { return referenceRegexp.MatchString(v) }):
+		return model.RelationFormat_object
+	case all(filled, isURL):
+		return model.RelationFormat_url
+	case all(filled, isEmail):
+		return model.RelationFormat_email
+	}
+	return detectSelectFormat(filled)
+}
+
+// detectSelectFormat returns tag or status format if the short values are repeated in the column
+func detectSelectFormat(values []string) model.RelationFormat {
+	var (
+		total    int
+		multiple bool
+		distinct = make(map[string]struct{})
+	)
+	for _, v := range values {
+		parts := strings.Split(v, listSeparator)
+		if len(parts) > 1 {
+			multiple = true
+		}
+		for _, p := range parts {
+			if len([]rune(p)) > maxOptionLength || strings.Contains(p, "\n") {
+				return model.RelationFormat_longtext
+			}
+			distinct[p] = struct{}{}
+			total++
+		}
+	}
+	switch {
+	case len(distinct) == total:
+		return model.RelationFormat_longtext
+	case multiple:
+		return model.RelationFormat_tag
+	default:
+		return model.RelationFormat_status
+	}
+}
+
+func all(values []string, f func(string) bool) bool {
+	for _, v := range values {
+		if !f(v) {
+			return false
+		}
+	}
+	return true
+}
+
+func parseNumber(v string) (float64, bool) {
+	n, err := strconv.ParseFloat(strings.ReplaceAll(v, ",", ""), 64)
+	return n, err == nil
+}
+
+func parseDate(v string) (time.Time, bool) {
+	// only the start of the range is kept
+	v, _, _ = strings.Cut(v, dateRangeSeparator)
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, v); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func isURL(v string) bool {
+	return (strings.HasPrefix(v, "http://") || strings.HasPrefix(v, "https://")) && !strings.ContainsAny(v, " \n")
+}
+
+func isEmail(v string) bool {
+	addr, err := mail.ParseAddress(v)
+	return err == nil && addr.Address == v
+}
+
+// value converts the exported text to the value of the relation, new select options are returned as snapshots
+func (c *column) value(raw string, w *workspace) (*types.Value, []*converter.Snapshot) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil, nil
+	}
+	switch c.format {
+	case model.RelationFormat_checkbox:
+		return pbtypes.Bool(raw == checkboxYes), nil
+	case model.RelationFormat_number:
+		n, _ := parseNumber(raw)
+		return pbtypes.Float64(n), nil
+	case model.RelationFormat_date:
+		t, _ := parseDate(raw)
+		return pbtypes.Int64(t.Unix()), nil
+	case model.RelationFormat_object:
+		var ids []string
+		for _, m := range referenceRegexp.FindAllStringSubmatch(raw, -1) {
+			ref := m[1]
+			if unescaped, err := url.PathUnescape(ref); err == nil {
+				ref = unescaped
+			}
+			if id := w.targetID(ref); id != "" {
+				ids = append(ids, id)
+			}
+		}
+		return pbtypes.StringList(ids), nil
+	case model.RelationFormat_tag, model.RelationFormat_status:
+		names := []string{raw}
+		if c.format == model.RelationFormat_tag {
+			names = strings.Split(raw, listSeparator)
+		}
+		var (
+			ids       []string
+			snapshots []*converter.Snapshot
+		)
+		for _, name := range names {
+			id, ok := c.options[name]
+			if !ok {
+				id = bson.NewObjectId().Hex()
+				c.options[name] = id
+				snapshots = append(snapshots, c.optionSnapshot(id, name))
+			}
+			ids = append(ids, id)
+		}
+		return pbtypes.StringList(ids), snapshots
+	}
+	return pbtypes.String(raw), nil
+}
+
+func (c *column) relationSnapshot(source string) *converter.Snapshot {
+	details := &types.Struct{Fields: map[string]*types.Value{
+		bundle.RelationKeyRelationFormat.String(): pbtypes.Float64(float64(c.format)),
+		bundle.RelationKeyName.String():           pbtypes.String(c.name),
+		bundle.RelationKeyId.String():             pbtypes.String(addr.RelationKeyToIdPrefix + c.key),
+		bundle.RelationKeyRelationKey.String():    pbtypes.String(c.key),
+		bundle.RelationKeyCreatedDate.String():    pbtypes.Int64(time.Now().Unix()),
+		bundle.RelationKeyLayout.String():         pbtypes.Float64(float64(model.ObjectType_relation)),
+		bundle.RelationKeySourceFilePath.String(): pbtypes.String(source),
+	}}
+	return &converter.Snapshot{
+		Id:     addr.RelationKeyToIdPrefix + c.key,
+		SbType: smartblock.SmartBlockTypeSubObject,
+		Snapshot: &pb.ChangeSnapshot{Data: &model.SmartBlockSnapshotBase{
+			Details:     details,
+			ObjectTypes: []string{bundle.TypeKeyRelation.URL()},
+		}},
+	}
+}
+
+func (c *column) optionSnapshot(id, name string) *converter.Snapshot {
+	details := &types.Struct{Fields: map[string]*types.Value{
+		bundle.RelationKeyName.String():        pbtypes.String(name),
+		bundle.RelationKeyRelationKey.String(): pbtypes.String(c.key),
+		bundle.RelationKeyLayout.String():      pbtypes.Float64(float64(model.ObjectType_relationOption)),
+		bundle.RelationKeyCreatedDate.String(): pbtypes.Int64(time.Now().Unix()),
+		bundle.RelationKeyId.String():          pbtypes.String(id),
+	}}
+	return &converter.Snapshot{
+		Id:     id,
+		SbType: smartblock.SmartBlockTypeSubObject,
+		Snapshot: &pb.ChangeSnapshot{Data: &model.SmartBlockSnapshotBase{
+			Details:     details,
+			ObjectTypes: []string{bundle.TypeKeyRelationOption.URL()},
+		}},
+	}
+}
@@ -0,0 +1,33 @@
+package archive
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+func TestDetectFormat(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		values []string
+		format model.RelationFormat
+	}{
+		{"empty", []string{"", " "}, model.RelationFormat_longtext},
+		{"checkbox", []string{"Yes", "No", ""}, model.RelationFormat_checkbox},
+		{"number", []string{"1", "2.5", "1,000"}, model.RelationFormat_number},
+		{"date", []string{"October 19, 2023", "October 20, 2023 3:04 PM", "October 21, 2023 → October 22, 2023"}, model.RelationFormat_date},
+		{"url", []string{"https://anytype.io", "http://example.com/a?b=c"}, model.RelationFormat_url},
+		{"email", []string{"a@example.com", "b@example.com"}, model.RelationFormat_email},
+		{"object", []string{"Page (Page%200123456789abcdef0123456789abcdef.md)", "A (https://www.notion.so/A-0123456789abcdef0123456789abcdef)"}, model.RelationFormat_object},
+		{"status", []string{"Done", "In progress", "Done"}, model.RelationFormat_status},
+		{"tag", []string{"a, b", "b"}, model.RelationFormat_tag},
+		{"unique values are text", []string{"first", "second"}, model.RelationFormat_longtext},
+		{"long values are text", []string{"Lorem ipsum dolor sit amet consectetur adipiscing elit sed do eiusmod", "Lorem ipsum dolor sit amet consectetur adipiscing elit sed do eiusmod"}, model.RelationFormat_longtext},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.format, detectFormat(tc.values))
+		})
+	}
+}
@@ -0,0 +1,575 @@
+package archive
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/globalsign/mgo/bson"
+	"github.com/gogo/protobuf/types"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/anyproto/anytype-heart/core/block/collection"
+	"github.com/anyproto/anytype-heart/core/block/editor/template"
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/import/markdown/anymark"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+const (
+	// newer exports have the table with the rows of the current view and the table with all rows
+	allRowsSuffix = "_all"
+	mdExt         = ".md"
+	csvExt        = ".csv"
+)
+
+type page struct {
+	name     string
+	id       string
+	title    string
+	blocks   []*model.Block
+	details  *types.Struct
+	relation []*model.RelationLink
+	// linked is the set of objects which have the link block in the page
+	linked map[string]bool
+}
+
+type database struct {
+	name  string
+	id    string
+	title string
+	table [][]string
+	rows  []*page
+}
+
+// workspace is the content of the export, objects are referenced by the paths in the export and by Notion ids
+type workspace struct {
+	tempDirProvider   core.TempDirProvider
+	collectionService *collection.Service
+	mode              pb.RpcObjectImportRequestMode
+	progress          process.Progress
+	errors            *converter.ConvertError
+
+	files     map[string]entry
+	pages     map[string]*page
+	databases map[string]*database
+	// byKey is the ids of the objects by the paths without the extension
+	byKey      map[string]string
+	byNotionID map[string]string
+}
+
+func newWorkspace(tempDirProvider core.TempDirProvider, c *collection.Service, mode pb.RpcObjectImportRequestMode, progress process.Progress) *workspace {
+	return &workspace{
+		tempDirProvider:   tempDirProvider,
+		collectionService: c,
+		mode:              mode,
+		progress:          progress,
+		errors:            converter.NewError(),
+		pages:             make(map[string]*page),
+		databases:         make(map[string]*database),
+		byKey:             make(map[string]string),
+		byNotionID:        make(map[string]string),
+	}
+}
+
+func (w *workspace) read(files map[string]entry) *converter.ConvertError {
+	w.files = files
+	for _, name := range sortedKeys(files) {
+		if err := w.progress.TryStep(1); err != nil {
+			return converter.NewCancelError(err)
+		}
+		var err error
+		switch strings.ToLower(path.Ext(name)) {
+		case mdExt:
+			err = w.readPage(name)
+		case csvExt:
+			err = w.readDatabase(name)
+		default:
+			continue
+		}
+		if err != nil {
+			w.errors.Add(fmt.Errorf("failed to read %s: %w", name, err))
+			if w.mode == pb.RpcObjectImportRequest_ALL_OR_NOTHING {
+				return nil
+			}
+		}
+	}
+	return nil
+}
+
+func (w *workspace) addObject(name string) string {
+	id := uuid.New().String()
+	w.byKey[objectKey(name)] = id
+	if notionID := notionID(path.Base(name)); notionID != "" {
+		w.byNotionID[notionID] = id
+	}
+	return id
+}
+
+func (w *workspace) readPage(name string) error {
+	data, err := w.files[name].read()
+	if err != nil {
+		return err
+	}
+	blocks, _, err := anymark.MarkdownToBlocks(data, path.Dir(name), nil)
+	if err != nil {
+		return err
+	}
+	p := &page{name: name, title: titleFromName(name), blocks: blocks, linked: make(map[string]bool)}
+	p.removeMissingChildren()
+	// the title of the page is exported as the first heading, the name of the file may be shortened
+	if len(blocks) > 0 && blocks[0].GetText().GetStyle() == model.BlockContentText_Header1 {
+		p.title = blocks[0].GetText().Text
+		p.removeBlock(blocks[0].Id)
+	}
+	p.id = w.addObject(name)
+	w.pages[name] = p
+	return nil
+}
+
+func (w *workspace) readDatabase(name string) error {
+	key := objectKey(name)
+	if !strings.HasSuffix(strings.TrimSuffix(name, path.Ext(name)), allRowsSuffix) {
+		// the table with all rows is preferred
+		if _, ok := w.files[key+allRowsSuffix+path.Ext(name)]; ok {
+			return nil
+		}
+	}
+	data, err := w.files[name].read()
+	if err != nil {
+		return err
+	}
+	// the table starts with BOM, so Excel opens it in UTF-8
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+	r := csv.NewReader(bytes.NewReader(data))
+	r.LazyQuotes = true
+	r.FieldsPerRecord = -1
+	table, err := r.ReadAll()
+	if err != nil {
+		return err
+	}
+	w.databases[name] = &database{name: name, id: w.addObject(name), title: titleFromName(name), table: table}
+	return nil
+}
+
+// parent returns the path without the extension of the closest object which contains the object with the name
+func (w *workspace) parent(name string) string {
+	for dir := path.Dir(name); dir != "." && dir != "/"; dir = path.Dir(dir) {
+		if _, ok := w.byKey[dir]; ok {
+			return dir
+		}
+	}
+	return ""
+}
+
+// targetID returns the id of the object referenced by the link: the path in the export or the url of the Notion page
+func (w *workspace) targetID(link string) string {
+	if strings.HasPrefix(link, "http://") || strings.HasPrefix(link, "https://") {
+		if !strings.Contains(link, "notion.so") && !strings.Contains(link, "notion.site") {
+			return ""
+		}
+		return w.byNotionID[notionID(link)]
+	}
+	link = path.Clean(filepath.ToSlash(link))
+	switch strings.ToLower(path.Ext(link)) {
+	case mdExt, csvExt:
+	default:
+		return ""
+	}
+	if id, ok := w.byKey[objectKey(link)]; ok {
+		return id
+	}
+	// the link can be relative to the other directory, so the page is searched by its id
+	return w.byNotionID[notionID(path.Base(link))]
+}
+
+func (w *workspace) makeSnapshots() ([]*converter.Snapshot, *converter.ConvertError) {
+	var snapshots []*converter.Snapshot
+	for _, name := range sortedKeys(w.databases) {
+		snapshots = append(snapshots, w.fillDatabase(w.databases[name])...)
+	}
+	for _, name := range sortedKeys(w.pages) {
+		if err := w.progress.TryStep(1); err != nil {
+			return nil, converter.NewCancelError(err)
+		}
+		p := w.pages[name]
+		w.convertBlocks(p)
+		snapshots = append(snapshots, w.pageSnapshot(p))
+	}
+	for _, name := range sortedKeys(w.databases) {
+		sn, err := w.databaseSnapshot(w.databases[name])
+		if err != nil {
+			w.errors.Add(err)
+			if w.mode == pb.RpcObjectImportRequest_ALL_OR_NOTHING {
+				return nil, nil
+			}
+			continue
+		}
+		snapshots = append(snapshots, sn)
+	}
+	return snapshots, nil
+}
+
+// topLevelObjects returns the objects which aren't contained in the other pages and databases
+func (w *workspace) topLevelObjects() []string {
+	var ids []string
+	for _, name := range sortedKeys(w.databases) {
+		if w.parent(name) == "" {
+			ids = append(ids, w.databases[name].id)
+		}
+	}
+	for _, name := range sortedKeys(w.pages) {
+		if w.parent(name) == "" {
+			ids = append(ids, w.pages[name].id)
+		}
+	}
+	return ids
+}
+
+func (w *workspace) pageSnapshot(p *page) *converter.Snapshot {
+	details := converter.GetCommonDetails(p.name, p.title, "")
+	if p.details != nil {
+		details = pbtypes.StructMerge(details, p.details, false)
+	}
+	var childrenIDs []string
+	for _, b := range p.blocks {
+		if !p.isChild(b.Id) {
+			childrenIDs = append(childrenIDs, b.Id)
+		}
+	}
+	blocks := append(p.blocks, &model.Block{
+		Id:          p.id,
+		ChildrenIds: childrenIDs,
+		Content:     &model.BlockContentOfSmartblock{Smartblock: &model.BlockContentSmartblock{}},
+	})
+	return &converter.Snapshot{
+		Id:       p.id,
+		FileName: p.name,
+		SbType:   smartblock.SmartBlockTypePage,
+		Snapshot: &pb.ChangeSnapshot{Data: &model.SmartBlockSnapshotBase{
+			Blocks:        blocks,
+			Details:       details,
+			RelationLinks: p.relation,
+			ObjectTypes:   []string{bundle.TypeKeyPage.URL()},
+		}},
+	}
+}
+
+func (w *workspace) databaseSnapshot(d *database) (*converter.Snapshot, error) {
+	details := converter.GetCommonDetails(d.name, d.title, "")
+	details.Fields[bundle.RelationKeyLayout.String()] = pbtypes.Float64(float64(model.ObjectType_collection))
+	_, _, st, err := w.collectionService.CreateCollection(details, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, rel := range w.databaseRelations(d) {
+		st.AddRelationLinks(rel)
+		if err = converter.AddRelationsToDataView(st, rel); err != nil {
+			log.Errorf("failed to add relation to notion database, %s", err)
+		}
+	}
+	ids := make([]string, 0, len(d.rows))
+	for _, row := range d.rows {
+		ids = append(ids, row.id)
+	}
+	st.UpdateStoreSlice(template.CollectionStoreKey, ids)
+	return &converter.Snapshot{
+		Id:       d.id,
+		FileName: d.name,
+		SbType:   smartblock.SmartBlockTypePage,
+		Snapshot: &pb.ChangeSnapshot{Data: &model.SmartBlockSnapshotBase{
+			Blocks:        st.Blocks(),
+			Details:       pbtypes.StructMerge(st.CombinedDetails(), details, false),
+			ObjectTypes:   []string{bundle.TypeKeyCollection.URL()},
+			Collections:   st.Store(),
+			RelationLinks: st.GetRelationLinks(),
+		}},
+	}, nil
+}
+
+// databaseRelations returns the relations of the rows, they are shown in the views of the collection
+func (w *workspace) databaseRelations(d *database) []*model.RelationLink {
+	var res []*model.RelationLink
+	seen := make(map[string]bool)
+	for _, row := range d.rows {
+		for _, rel := range row.relation {
+			if !seen[rel.Key] {
+				seen[rel.Key] = true
+				res = append(res, rel)
+			}
+		}
+	}
+	return res
+}
+
+// fillDatabase matches the rows of the table with the pages in the directory of the database,
+// sets the values of the properties to the pages and returns the snapshots of the relations and their options
+func (w *workspace) fillDatabase(d *database) []*converter.Snapshot {
+	key := objectKey(d.name)
+	var pages []*page
+	for _, name := range sortedKeys(w.pages) {
+		if w.parent(name) == key {
+			pages = append(pages, w.pages[name])
+		}
+	}
+	if len(d.table) == 0 {
+		d.rows = pages
+		return nil
+	}
+
+	header := d.table[0]
+	columns := make([]*column, len(header))
+	var snapshots []*converter.Snapshot
+	// the first column is the title of the row
+	for i := 1; i < len(header); i++ {
+		values := make([]string, 0, len(d.table)-1)
+		for _, row := range d.table[1:] {
+			if i < len(row) {
+				values = append(values, row[i])
+			}
+		}
+		columns[i] = &column{
+			name:    strings.TrimSpace(header[i]),
+			key:     bson.NewObjectId().Hex(),
+			format:  detectFormat(values),
+			options: make(map[string]string),
+		}
+		snapshots = append(snapshots, columns[i].relationSnapshot(d.name+"/"+columns[i].name))
+	}
+
+	used := make(map[*page]bool, len(pages))
+	for _, row := range d.table[1:] {
+		if len(row) == 0 {
+			continue
+		}
+		title := strings.TrimSpace(row[0])
+		p := matchRow(pages, used, title)
+		if p == nil {
+			// the row without the page, the object is created from the table only
+			name := key + "/" + title + mdExt
+			p = &page{name: name, id: uuid.New().String(), title: title, linked: make(map[string]bool)}
+			w.pages[name] = p
+		}
+		used[p] = true
+		d.rows = append(d.rows, p)
+		p.details = &types.Struct{Fields: map[string]*types.Value{}}
+		for i := 1; i < len(columns) && i < len(row); i++ {
+			v, options := columns[i].value(row[i], w)
+			snapshots = append(snapshots, options...)
+			if v != nil {
+				p.details.Fields[columns[i].key] = v
+			}
+			p.relation = append(p.relation, &model.RelationLink{Key: columns[i].key, Format: columns[i].format})
+		}
+		p.removeProperties(header)
+	}
+	for _, p := range pages {
+		if !used[p] {
+			d.rows = append(d.rows, p)
+		}
+	}
+	return snapshots
+}
+
+func matchRow(pages []*page, used map[*page]bool, title string) *page {
+	for _, p := range pages {
+		if !used[p] && p.title == title {
+			return p
+		}
+	}
+	return nil
+}
+
+// convertBlocks replaces the links to the exported pages with links to the objects and the links to attachments with files,
+// the children of the page without the link in its content are linked at the end
+func (w *workspace) convertBlocks(p *page) {
+	for _, b := range p.blocks {
+		switch {
+		case b.GetText() != nil:
+			w.convertLinks(p, b)
+		case b.GetFile() != nil:
+			w.convertFile(b.GetFile())
+		}
+	}
+	for _, name := range sortedKeys(w.pages) {
+		w.linkChild(p, name, w.pages[name].id)
+	}
+	for _, name := range sortedKeys(w.databases) {
+		w.linkChild(p, name, w.databases[name].id)
+	}
+}
+
+func (w *workspace) linkChild(p *page, name, id string) {
+	if p.linked[id] || w.parent(name) != objectKey(p.name) {
+		return
+	}
+	p.linked[id] = true
+	p.blocks = append(p.blocks, &model.Block{
+		Id:      bson.NewObjectId().Hex(),
+		Content: &model.BlockContentOfLink{Link: &model.BlockContentLink{TargetBlockId: id, Style: model.BlockContentLink_Page}},
+	})
+}
+
+func (w *workspace) convertLinks(p *page, b *model.Block) {
+	text := b.GetText()
+	marks := text.GetMarks().GetMarks()
+	if len(marks) == 0 {
+		return
+	}
+	filtered := marks[:0]
+	for _, m := range marks {
+		if m.Type != model.BlockContentTextMark_Link {
+			filtered = append(filtered, m)
+			continue
+		}
+		wholeLine := len(marks) == 1 && isWholeLine(text.Text, m.Range) && len(b.ChildrenIds) == 0
+		if id := w.targetID(m.Param); id != "" {
+			if wholeLine {
+				p.linked[id] = true
+				b.Content = &model.BlockContentOfLink{Link: &model.BlockContentLink{TargetBlockId: id, Style: model.BlockContentLink_Page}}
+				return
+			}
+			m.Type, m.Param = model.BlockContentTextMark_Mention, id
+			filtered = append(filtered, m)
+			continue
+		}
+		if _, ok := w.files[path.Clean(filepath.ToSlash(m.Param))]; !ok {
+			filtered = append(filtered, m)
+			continue
+		}
+		if wholeLine {
+			f := &model.BlockContentFile{Name: m.Param, State: model.BlockContentFile_Empty, Type: fileType(m.Param)}
+			b.Content = &model.BlockContentOfFile{File: f}
+			w.convertFile(f)
+			return
+		}
+		// the link to the attachment inside the text is broken after the import, so it's removed
+	}
+	text.Marks.Marks = filtered
+}
+
+func isWholeLine(text string, r *model.Range) bool {
+	runes := []rune(text)
+	from, to := int(r.GetFrom()), int(r.GetTo())
+	if from > len(runes) || to > len(runes) || from > to {
+		return false
+	}
+	return strings.TrimSpace(string(runes[:from])) == "" && strings.TrimSpace(string(runes[to:])) == ""
+}
+
+// convertFile copies the attachment to the temporary directory, so it can be uploaded
+func (w *workspace) convertFile(f *model.BlockContentFile) {
+	name := path.Clean(filepath.ToSlash(f.Name))
+	e, ok := w.files[name]
+	if !ok {
+		return
+	}
+	tempPath, err := w.copyToTempDir(e, name)
+	if err != nil {
+		w.errors.Add(fmt.Errorf("failed to copy attachment %s: %w", name, err))
+		return
+	}
+	f.Name = tempPath
+}
+
+func (w *workspace) copyToTempDir(e entry, name string) (string, error) {
+	tempDir := os.TempDir()
+	if w.tempDirProvider != nil {
+		tempDir = w.tempDirProvider.TempDir()
+	}
+	rc, err := e.fsys.Open(e.name)
+	if err != nil {
+		return "", err
+	}
+	defer closeReader(rc)
+	tempPath := filepath.Join(tempDir, bson.NewObjectId().Hex()+"_"+path.Base(name))
+	f, err := os.Create(tempPath)
+	if err != nil {
+		return "", err
+	}
+	if _, err = io.Copy(f, rc); err != nil {
+		closeReader(f)
+		return "", err
+	}
+	return tempPath, f.Close()
+}
+
+func fileType(name string) model.BlockContentFileType {
+	mimeType := mime.TypeByExtension(strings.ToLower(path.Ext(name)))
+	switch {
+	case strings.HasPrefix(mimeType, "image/"):
+		return model.BlockContentFile_Image
+	case strings.HasPrefix(mimeType, "video/"):
+		return model.BlockContentFile_Video
+	case strings.HasPrefix(mimeType, "audio/"):
+		return model.BlockContentFile_Audio
+	case mimeType == "application/pdf":
+		return model.BlockContentFile_PDF
+	}
+	return model.BlockContentFile_File
+}
+
+func (p *page) isChild(id string) bool {
+	for _, b := range p.blocks {
+		for _, childID := range b.ChildrenIds {
+			if childID == id {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (p *page) removeMissingChildren() {
+	ids := make(map[string]bool, len(p.blocks))
+	for _, b := range p.blocks {
+		ids[b.Id] = true
+	}
+	for _, b := range p.blocks {
+		b.ChildrenIds = lo.Filter(b.ChildrenIds, func(id string, _ int) bool { return ids[id] })
+	}
+}
+
+func (p *page) removeBlock(id string) {
+	for i, b := range p.blocks {
+		if b.Id == id {
+			p.blocks = append(p.blocks[:i], p.blocks[i+1:]...)
+			return
+		}
+	}
+}
+
+// removeProperties removes the properties exported to the beginning of the row page as "Name: value" lines,
+// they are set to the relations from the table
+func (p *page) removeProperties(header []string) {
+	names := make(map[string]bool, len(header))
+	for _, h := range header {
+		names[strings.TrimSpace(h)] = true
+	}
+	for len(p.blocks) > 0 {
+		text := p.blocks[0].GetText()
+		if text == nil || text.Style != model.BlockContentText_Paragraph || len(p.blocks[0].ChildrenIds) > 0 {
+			return
+		}
+		for _, line := range strings.Split(text.Text, "\n") {
+			name, _, ok := strings.Cut(line, ": ")
+			if !ok || !names[name] {
+				return
+			}
+		}
+		p.removeBlock(p.blocks[0].Id)
+	}
+}
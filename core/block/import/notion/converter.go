@@ -11,8 +11,10 @@ import (
 	"github.com/anyproto/anytype-heart/core/block/import/notion/api/database"
 	"github.com/anyproto/anytype-heart/core/block/import/notion/api/page"
 	"github.com/anyproto/anytype-heart/core/block/import/notion/api/search"
+	"github.com/anyproto/anytype-heart/core/block/import/notion/archive"
 	"github.com/anyproto/anytype-heart/core/block/process"
 	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/core"
 )
 
 const (
@@ -27,18 +29,24 @@ type Notion struct {
 	search    *search.Service
 	dbService *database.Service
 	pgService *page.Service
+	archive   *archive.Service
 }
 
-func New(c *collection.Service) converter.Converter {
+func New(tempDirProvider core.TempDirProvider, c *collection.Service) converter.Converter {
 	cl := client.NewClient()
 	return &Notion{
 		search:    search.New(cl),
 		dbService: database.New(c),
 		pgService: page.New(cl),
+		archive:   archive.New(tempDirProvider, c),
 	}
 }
 
 func (n *Notion) GetSnapshots(req *pb.RpcObjectImportRequest, progress process.Progress) (*converter.Response, *converter.ConvertError) {
+	// the exported archives are imported offline, without the api
+	if paths := req.GetNotionParams().GetPath(); len(paths) > 0 {
+		return n.archive.GetSnapshots(req.Mode, paths, progress)
+	}
 	ce := converter.NewError()
 	apiKey := n.getParams(req)
 	if apiKey == "" {
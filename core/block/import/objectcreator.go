@@ -21,6 +21,7 @@ import (
 	"github.com/anyproto/anytype-heart/core/block/import/converter"
 	"github.com/anyproto/anytype-heart/core/block/import/syncer"
 	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/relation"
 	"github.com/anyproto/anytype-heart/core/session"
 	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
@@ -42,14 +43,15 @@ type objectCreator interface {
 }
 
 type ObjectCreator struct {
-	service        *block.Service
-	objCreator     objectCreator
-	core           core.Service
-	objectStore    objectstore.ObjectStore
-	relationSyncer syncer.RelationSyncer
-	syncFactory    *syncer.Factory
-	fileStore      filestore.FileStore
-	mu             sync.Mutex
+	service         *block.Service
+	objCreator      objectCreator
+	core            core.Service
+	objectStore     objectstore.ObjectStore
+	relationSyncer  syncer.RelationSyncer
+	relationService relation.Service
+	syncFactory     *syncer.Factory
+	fileStore       filestore.FileStore
+	mu              sync.Mutex
 }
 
 func NewCreator(service *block.Service,
@@ -58,16 +60,18 @@ func NewCreator(service *block.Service,
 	syncFactory *syncer.Factory,
 	objectStore objectstore.ObjectStore,
 	relationSyncer syncer.RelationSyncer,
+	relationService relation.Service,
 	fileStore filestore.FileStore,
 ) Creator {
 	return &ObjectCreator{
-		service:        service,
-		objCreator:     objCreator,
-		core:           core,
-		syncFactory:    syncFactory,
-		objectStore:    objectStore,
-		relationSyncer: relationSyncer,
-		fileStore:      fileStore,
+		service:         service,
+		objCreator:      objCreator,
+		core:            core,
+		syncFactory:     syncFactory,
+		objectStore:     objectStore,
+		relationSyncer:  relationSyncer,
+		relationService: relationService,
+		fileStore:       fileStore,
 	}
 }
 
@@ -129,6 +133,10 @@ func (oc *ObjectCreator) Create(ctx *session.Context,
 	}
 	oc.updateDetailsKey(st, oldIDtoNew)
 	filesToDelete = append(filesToDelete, oc.handleCoverRelation(st)...)
+	if err = oc.relationService.ValidateConstraints(st.ObjectTypes(), st.CombinedDetails()); err != nil {
+		log.With("objectID", newID).Errorf("failed to validate %s: %s", newID, err.Error())
+		return nil, "", err
+	}
 	var respDetails *types.Struct
 	if payload := createPayloads[newID]; payload.RootRawChange != nil {
 		respDetails, err = oc.createNewObject(ctx, payload, st, newID, oldIDtoNew)
@@ -12,7 +12,7 @@ import (
 
 var log = logging.Logger("import-source")
 
-var extensions = []string{".md", ".csv", ".txt", ".pb", ".json", ".html"}
+var extensions = []string{".md", ".csv", ".txt", ".pb", ".json", ".html", ".docx", ".enex"}
 
 type Source interface {
 	GetFileReaders(importPath string, ext []string) (map[string]io.ReadCloser, error)
@@ -24,6 +24,8 @@ type Importer interface {
 	Import(ctx *session.Context, req *pb.RpcObjectImportRequest) error
 	ListImports(ctx *session.Context, req *pb.RpcObjectImportListRequest) ([]*pb.RpcObjectImportListImportResponse, error)
 	ImportWeb(ctx *session.Context, req *pb.RpcObjectImportRequest) (string, *types.Struct, error)
+	// SyncCSV updates the objects of the collection by the rows of CSV file matched by the key column
+	SyncCSV(ctx *session.Context, req *pb.RpcObjectImportRequest) ([]*pb.RpcObjectImportChange, error)
 	//nolint: lll
 	ValidateNotionToken(ctx context.Context, req *pb.RpcObjectImportNotionValidateTokenRequest) (pb.RpcObjectImportNotionValidateTokenResponseErrorCode, error)
 }
@@ -15,19 +15,24 @@ const Name = "web"
 
 type Converter struct {
 	otc converter.ObjectTreeCreator
+	// fallback is used for urls that aren't matched by any of the site-specific parsers
+	fallback parsers.Parser
 }
 
-func NewConverter() converter.Converter {
-	return &Converter{}
+func NewConverter(fallback parsers.Parser) converter.Converter {
+	return &Converter{fallback: fallback}
 }
 
-func (*Converter) GetParser(url string) parsers.Parser {
+func (c *Converter) GetParser(url string) parsers.Parser {
 	for _, ps := range parsers.Parsers {
 		p := ps()
 		if p.MatchUrl(url) {
 			return p
 		}
 	}
+	if c.fallback != nil && c.fallback.MatchUrl(url) {
+		return c.fallback
+	}
 	return nil
 }
 
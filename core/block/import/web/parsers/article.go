@@ -0,0 +1,133 @@
+package parsers
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/go-shiori/go-readability"
+	"github.com/gogo/protobuf/types"
+	"golang.org/x/net/html/charset"
+
+	"github.com/anyproto/anytype-heart/core/block/import/markdown/anymark"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+const (
+	articleFetchTimeout = time.Minute
+	// read no more than 10 mb of html
+	maxArticleSize = 10 << 20
+	userAgent      = "Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)"
+)
+
+// altReplacer removes characters that break markdown image syntax
+var altReplacer = strings.NewReplacer("[", "", "]", "", "\n", " ")
+
+// ArticleParser extracts the main content of any web page, skipping navigation, ads, comments and so on
+type ArticleParser struct {
+	client *http.Client
+}
+
+func NewArticleParser() Parser {
+	return &ArticleParser{client: &http.Client{Timeout: articleFetchTimeout}}
+}
+
+func (p *ArticleParser) MatchUrl(rawUrl string) bool {
+	u, err := url.Parse(rawUrl)
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+func (p *ArticleParser) ParseUrl(rawUrl string) (*model.SmartBlockSnapshotBase, error) {
+	req, err := http.NewRequest(http.MethodGet, rawUrl, nil)
+	if err != nil {
+		return nil, fmt.Errorf("ArticleParser: create request: %w", err)
+	}
+	req.Header.Set("User-Agent", userAgent)
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("ArticleParser: fetch page: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("ArticleParser: fetch page: unexpected status %s", resp.Status)
+	}
+
+	body, err := charset.NewReader(io.LimitReader(resp.Body, maxArticleSize), resp.Header.Get("Content-Type"))
+	if err != nil {
+		return nil, fmt.Errorf("ArticleParser: decode page: %w", err)
+	}
+	// use the url after redirects to resolve relative links
+	return parseArticle(body, resp.Request.URL, rawUrl)
+}
+
+func parseArticle(r io.Reader, pageUrl *url.URL, sourceUrl string) (*model.SmartBlockSnapshotBase, error) {
+	article, err := readability.FromReader(r, pageUrl)
+	if err != nil {
+		return nil, fmt.Errorf("ArticleParser: extract article: %w", err)
+	}
+
+	content, err := prepareArticleContent(article.Content)
+	if err != nil {
+		return nil, fmt.Errorf("ArticleParser: prepare content: %w", err)
+	}
+	blocks, _, err := anymark.HTMLToBlocks([]byte(content))
+	if err != nil {
+		return nil, fmt.Errorf("ArticleParser: convert to blocks: %w", err)
+	}
+
+	name := strings.TrimSpace(article.Title)
+	if name == "" {
+		name = filepath.Base(pageUrl.Path)
+	}
+	details := &types.Struct{
+		Fields: map[string]*types.Value{
+			bundle.RelationKeyName.String():   pbtypes.String(name),
+			bundle.RelationKeySource.String(): pbtypes.String(sourceUrl),
+			bundle.RelationKeyType.String():   pbtypes.String(bundle.TypeKeyBookmark.URL()),
+		},
+	}
+	if byline := strings.TrimSpace(article.Byline); byline != "" {
+		details.Fields[bundle.RelationKeySourceAuthor.String()] = pbtypes.String(byline)
+	}
+	if excerpt := strings.TrimSpace(article.Excerpt); excerpt != "" {
+		details.Fields[bundle.RelationKeyDescription.String()] = pbtypes.String(excerpt)
+	}
+
+	return &model.SmartBlockSnapshotBase{
+		Blocks:  blocks,
+		Details: details,
+	}, nil
+}
+
+// prepareArticleContent makes images convertible to file blocks. Readability already resolves relative urls,
+// but images without alt text are converted to plain text and inline images can't be downloaded
+func prepareArticleContent(content string) (string, error) {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(content))
+	if err != nil {
+		return "", err
+	}
+	doc.Find("img").Each(func(_ int, img *goquery.Selection) {
+		src, _ := img.Attr("src")
+		if !strings.HasPrefix(src, "http://") && !strings.HasPrefix(src, "https://") {
+			img.Remove()
+			return
+		}
+		alt, _ := img.Attr("alt")
+		alt = strings.TrimSpace(altReplacer.Replace(alt))
+		if alt == "" {
+			alt = "image"
+		}
+		img.SetAttr("alt", alt)
+	})
+	return doc.Find("body").Html()
+}
@@ -0,0 +1,104 @@
+package parsers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func TestArticleParser_ParseUrl(t *testing.T) {
+	fixture, err := os.ReadFile("testdata/article.html")
+	require.NoError(t, err)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write(fixture)
+	}))
+	defer server.Close()
+
+	p := NewArticleParser()
+	pageUrl := server.URL + "/blog/tomatoes"
+	require.True(t, p.MatchUrl(pageUrl))
+
+	snapshot, err := p.ParseUrl(pageUrl)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Growing tomatoes on a balcony", pbtypes.GetString(snapshot.Details, bundle.RelationKeyName.String()))
+	assert.Equal(t, pageUrl, pbtypes.GetString(snapshot.Details, bundle.RelationKeySource.String()))
+	assert.Equal(t, "Jane Gardener", pbtypes.GetString(snapshot.Details, bundle.RelationKeySourceAuthor.String()))
+	assert.Equal(t, "A practical guide to growing tomatoes in containers.",
+		pbtypes.GetString(snapshot.Details, bundle.RelationKeyDescription.String()))
+
+	var (
+		texts  []string
+		styles = map[model.BlockContentTextStyle]int{}
+		images []string
+	)
+	for _, b := range snapshot.Blocks {
+		if text := b.GetText(); text != nil {
+			texts = append(texts, text.Text)
+			styles[text.Style]++
+		}
+		if file := b.GetFile(); file != nil {
+			images = append(images, file.Name)
+		}
+	}
+	content := strings.Join(texts, "\n")
+
+	assert.Contains(t, content, "Choosing the variety")
+	assert.Contains(t, content, "Tiny Tim, a classic dwarf variety")
+	assert.Contains(t, content, "every day at 07:00 and 19:00: water 500 ml")
+	assert.Contains(t, content, "The best fertilizer is the gardener's shadow.")
+	assert.NotContains(t, content, "Popular posts")
+	assert.NotContains(t, content, "premium seeds")
+	assert.NotContains(t, content, "All rights reserved")
+
+	assert.Equal(t, 2, styles[model.BlockContentText_Header2])
+	assert.Equal(t, 3, styles[model.BlockContentText_Marked])
+	assert.Equal(t, 1, styles[model.BlockContentText_Code])
+	assert.Equal(t, 1, styles[model.BlockContentText_Quote])
+	assert.Equal(t, []string{server.URL + "/images/tomatoes.jpg"}, images)
+}
+
+func TestArticleParser_MatchUrl(t *testing.T) {
+	p := NewArticleParser()
+	assert.True(t, p.MatchUrl("https://example.com/post"))
+	assert.False(t, p.MatchUrl("ftp://example.com/post"))
+	assert.False(t, p.MatchUrl("not a url"))
+}
+
+func TestPrepareArticleContent(t *testing.T) {
+	content, err := prepareArticleContent(`<p>text</p><img src="https://example.com/a.png"><img src="data:image/png;base64,AAAA" alt="inline">`)
+	require.NoError(t, err)
+
+	assert.Equal(t, `<p>text</p><img src="https://example.com/a.png" alt="image"/>`, content)
+}
+
+func TestParseArticle(t *testing.T) {
+	f, err := os.Open("testdata/article.html")
+	require.NoError(t, err)
+	defer f.Close()
+
+	pageUrl, err := url.Parse("https://green.example/blog/tomatoes")
+	require.NoError(t, err)
+	snapshot, err := parseArticle(f, pageUrl, pageUrl.String())
+	require.NoError(t, err)
+
+	var images []string
+	for _, b := range snapshot.Blocks {
+		if file := b.GetFile(); file != nil {
+			images = append(images, file.Name)
+			assert.Equal(t, model.BlockContentFile_Image, file.Type)
+		}
+	}
+	assert.Equal(t, []string{"https://green.example/images/tomatoes.jpg"}, images)
+}
@@ -0,0 +1,206 @@
+package block
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/globalsign/mgo/bson"
+	"github.com/gogo/protobuf/types"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/basic"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+	"github.com/anyproto/anytype-heart/util/slice"
+)
+
+var ErrBadDetailsOperation = errors.New("bad details operation")
+
+type detailsModifier interface {
+	basic.DetailsSettable
+	CombinedDetails() *types.Struct
+}
+
+// validateDetailsOperation checks the operation doesn't depend on the object it's applied to
+func validateDetailsOperation(op *pb.RpcObjectListModifyDetailsOperation) error {
+	if op.RelationKey == "" {
+		return fmt.Errorf("%w: relation key is empty", ErrBadDetailsOperation)
+	}
+	switch op.Type {
+	case pb.RpcObjectListModifyDetailsOperation_Set:
+		if op.Value == nil {
+			return fmt.Errorf("%w: value to set %s is empty", ErrBadDetailsOperation, op.RelationKey)
+		}
+		if err := pbtypes.ValidateValue(op.Value); err != nil {
+			return fmt.Errorf("%w: %s", ErrBadDetailsOperation, err)
+		}
+	case pb.RpcObjectListModifyDetailsOperation_AddToList, pb.RpcObjectListModifyDetailsOperation_RemoveFromList:
+		if _, ok := op.Value.GetKind().(*types.Value_StringValue); ok {
+			return nil
+		}
+		if list := op.Value.GetListValue(); list == nil || len(pbtypes.GetStringListValue(op.Value)) != len(list.Values) {
+			return fmt.Errorf("%w: string or list of strings is expected for %s", ErrBadDetailsOperation, op.RelationKey)
+		}
+	case pb.RpcObjectListModifyDetailsOperation_Increment:
+		if _, ok := op.Value.GetKind().(*types.Value_NumberValue); !ok {
+			return fmt.Errorf("%w: number is expected to increment %s", ErrBadDetailsOperation, op.RelationKey)
+		}
+	}
+	return nil
+}
+
+// applyDetailsOperations applies the operations to the copy of the details and returns the changed details, nil value removes the detail
+func applyDetailsOperations(current *types.Struct, ops []*pb.RpcObjectListModifyDetailsOperation) ([]*pb.RpcObjectSetDetailsDetail, error) {
+	details := pbtypes.CopyStruct(current)
+	if details == nil || details.Fields == nil {
+		details = &types.Struct{Fields: map[string]*types.Value{}}
+	}
+	for _, op := range ops {
+		value := details.Fields[op.RelationKey]
+		switch op.Type {
+		case pb.RpcObjectListModifyDetailsOperation_Set:
+			value = pbtypes.CopyVal(op.Value)
+		case pb.RpcObjectListModifyDetailsOperation_Unset:
+			value = nil
+		case pb.RpcObjectListModifyDetailsOperation_AddToList:
+			list := pbtypes.GetStringListValue(value)
+			for _, s := range pbtypes.GetStringListValue(op.Value) {
+				if slice.FindPos(list, s) == -1 {
+					list = append(list, s)
+				}
+			}
+			value = pbtypes.StringList(list)
+		case pb.RpcObjectListModifyDetailsOperation_RemoveFromList:
+			list := slice.Filter(pbtypes.GetStringListValue(value), func(s string) bool {
+				return slice.FindPos(pbtypes.GetStringListValue(op.Value), s) == -1
+			})
+			value = pbtypes.StringList(list)
+			if len(list) == 0 {
+				value = nil
+			}
+		case pb.RpcObjectListModifyDetailsOperation_Increment:
+			if _, isNumber := value.GetKind().(*types.Value_NumberValue); value != nil && !isNumber {
+				if _, isNull := value.GetKind().(*types.Value_NullValue); !isNull {
+					return nil, fmt.Errorf("%s is not a number", op.RelationKey)
+				}
+			}
+			value = pbtypes.Float64(value.GetNumberValue() + op.Value.GetNumberValue())
+		default:
+			return nil, fmt.Errorf("unknown operation %s", op.Type)
+		}
+		if value == nil {
+			delete(details.Fields, op.RelationKey)
+		} else {
+			details.Fields[op.RelationKey] = value
+		}
+	}
+
+	var changed []*pb.RpcObjectSetDetailsDetail
+	for _, op := range ops {
+		key := op.RelationKey
+		if slice.FindPos(detailKeys(changed), key) != -1 {
+			continue
+		}
+		if value := details.Fields[key]; !value.Equal(current.GetFields()[key]) {
+			changed = append(changed, &pb.RpcObjectSetDetailsDetail{Key: key, Value: value})
+		}
+	}
+	return changed, nil
+}
+
+func detailKeys(details []*pb.RpcObjectSetDetailsDetail) []string {
+	keys := make([]string, 0, len(details))
+	for _, d := range details {
+		keys = append(keys, d.Key)
+	}
+	return keys
+}
+
+// ListModifyDetails applies the operations to the details of the objects with the ids or, if there are no ids, matching the filters.
+// The objects are changed independently, so the failure of one object doesn't stop the others
+func (s *Service) ListModifyDetails(req pb.RpcObjectListModifyDetailsRequest) (changedIDs []string, failures []*pb.RpcObjectListModifyDetailsFailure, err error) {
+	for _, op := range req.Operations {
+		if err = validateDetailsOperation(op); err != nil {
+			return nil, nil, err
+		}
+		if _, err = s.relationService.FetchKey(op.RelationKey); err != nil {
+			return nil, nil, fmt.Errorf("%w: get relation %s: %s", ErrBadDetailsOperation, op.RelationKey, err)
+		}
+	}
+	ids := req.ObjectIds
+	if len(ids) == 0 {
+		if ids, err = s.queryObjectIDs(req.Filters); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	queue := s.process.NewQueue(pb.ModelProcess{
+		Id:   bson.NewObjectId().Hex(),
+		Type: pb.ModelProcess_ModifyDetails,
+	}, 4)
+	queue.SetMessage("modify details")
+	if err = queue.Start(); err != nil {
+		return nil, nil, err
+	}
+	defer func() {
+		queue.Stop(err)
+	}()
+
+	var m sync.Mutex
+	for _, id := range ids {
+		id := id
+		if err = queue.Add(func() {
+			var changed bool
+			modifyErr := Do(s, id, func(b detailsModifier) error {
+				details, err := applyDetailsOperations(b.CombinedDetails(), req.Operations)
+				if err != nil {
+					return err
+				}
+				for _, d := range details {
+					if d.Value == nil {
+						continue
+					}
+					if err = s.relationService.ValidateFormat(d.Key, d.Value); err != nil {
+						return err
+					}
+				}
+				if len(details) == 0 {
+					return nil
+				}
+				changed = true
+				return b.SetDetails(nil, details, false)
+			})
+			m.Lock()
+			defer m.Unlock()
+			if modifyErr != nil {
+				failures = append(failures, &pb.RpcObjectListModifyDetailsFailure{ObjectId: id, Description: modifyErr.Error()})
+			} else if changed {
+				changedIDs = append(changedIDs, id)
+			}
+		}); err != nil {
+			return changedIDs, failures, err
+		}
+	}
+	if err = queue.Finalize(); err != nil {
+		return changedIDs, failures, err
+	}
+	return changedIDs, failures, nil
+}
+
+func (s *Service) queryObjectIDs(filters []*model.BlockContentDataviewFilter) ([]string, error) {
+	if len(filters) == 0 {
+		return nil, fmt.Errorf("%w: object ids or filters should be set", ErrBadDetailsOperation)
+	}
+	records, _, err := s.objectStore.Query(nil, database.Query{Filters: filters})
+	if err != nil {
+		return nil, fmt.Errorf("query objects: %w", err)
+	}
+	ids := make([]string, 0, len(records))
+	for _, rec := range records {
+		ids = append(ids, pbtypes.GetString(rec.Details, bundle.RelationKeyId.String()))
+	}
+	return ids, nil
+}
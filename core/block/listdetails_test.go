@@ -0,0 +1,89 @@
+package block
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func TestValidateDetailsOperation(t *testing.T) {
+	for _, tc := range []struct {
+		op *pb.RpcObjectListModifyDetailsOperation
+		ok bool
+	}{
+		{&pb.RpcObjectListModifyDetailsOperation{RelationKey: "status", Value: pbtypes.String("done")}, true},
+		{&pb.RpcObjectListModifyDetailsOperation{RelationKey: "status"}, false},
+		{&pb.RpcObjectListModifyDetailsOperation{Value: pbtypes.String("done")}, false},
+		{&pb.RpcObjectListModifyDetailsOperation{Type: pb.RpcObjectListModifyDetailsOperation_Unset, RelationKey: "status"}, true},
+		{&pb.RpcObjectListModifyDetailsOperation{Type: pb.RpcObjectListModifyDetailsOperation_AddToList, RelationKey: "tag", Value: pbtypes.String("opt1")}, true},
+		{&pb.RpcObjectListModifyDetailsOperation{Type: pb.RpcObjectListModifyDetailsOperation_RemoveFromList, RelationKey: "tag", Value: pbtypes.StringList([]string{"opt1"})}, true},
+		{&pb.RpcObjectListModifyDetailsOperation{Type: pb.RpcObjectListModifyDetailsOperation_AddToList, RelationKey: "tag", Value: pbtypes.Int64(1)}, false},
+		{&pb.RpcObjectListModifyDetailsOperation{Type: pb.RpcObjectListModifyDetailsOperation_Increment, RelationKey: "points", Value: pbtypes.Int64(1)}, true},
+		{&pb.RpcObjectListModifyDetailsOperation{Type: pb.RpcObjectListModifyDetailsOperation_Increment, RelationKey: "points", Value: pbtypes.String("1")}, false},
+	} {
+		err := validateDetailsOperation(tc.op)
+		if tc.ok {
+			assert.NoError(t, err, tc.op.String())
+		} else {
+			assert.ErrorIs(t, err, ErrBadDetailsOperation, tc.op.String())
+		}
+	}
+}
+
+func TestApplyDetailsOperations(t *testing.T) {
+	current := &types.Struct{Fields: map[string]*types.Value{
+		"status": pbtypes.String("new"),
+		"tag":    pbtypes.StringList([]string{"opt1", "opt2"}),
+		"points": pbtypes.Int64(3),
+		"name":   pbtypes.String("Task"),
+	}}
+
+	t.Run("all operations", func(t *testing.T) {
+		details, err := applyDetailsOperations(current, []*pb.RpcObjectListModifyDetailsOperation{
+			{Type: pb.RpcObjectListModifyDetailsOperation_Set, RelationKey: "status", Value: pbtypes.String("done")},
+			{Type: pb.RpcObjectListModifyDetailsOperation_AddToList, RelationKey: "tag", Value: pbtypes.StringList([]string{"opt2", "opt3"})},
+			{Type: pb.RpcObjectListModifyDetailsOperation_RemoveFromList, RelationKey: "tag", Value: pbtypes.String("opt1")},
+			{Type: pb.RpcObjectListModifyDetailsOperation_Increment, RelationKey: "points", Value: pbtypes.Int64(2)},
+			{Type: pb.RpcObjectListModifyDetailsOperation_Increment, RelationKey: "estimate", Value: pbtypes.Int64(5)},
+			{Type: pb.RpcObjectListModifyDetailsOperation_Unset, RelationKey: "name"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []*pb.RpcObjectSetDetailsDetail{
+			{Key: "status", Value: pbtypes.String("done")},
+			{Key: "tag", Value: pbtypes.StringList([]string{"opt2", "opt3"})},
+			{Key: "points", Value: pbtypes.Float64(5)},
+			{Key: "estimate", Value: pbtypes.Float64(5)},
+			{Key: "name"},
+		}, details)
+		assert.Equal(t, "new", pbtypes.GetString(current, "status"))
+	})
+
+	t.Run("unchanged details are skipped", func(t *testing.T) {
+		details, err := applyDetailsOperations(current, []*pb.RpcObjectListModifyDetailsOperation{
+			{Type: pb.RpcObjectListModifyDetailsOperation_AddToList, RelationKey: "tag", Value: pbtypes.String("opt1")},
+			{Type: pb.RpcObjectListModifyDetailsOperation_Unset, RelationKey: "description"},
+		})
+		require.NoError(t, err)
+		assert.Empty(t, details)
+	})
+
+	t.Run("removing the last element unsets the detail", func(t *testing.T) {
+		details, err := applyDetailsOperations(current, []*pb.RpcObjectListModifyDetailsOperation{
+			{Type: pb.RpcObjectListModifyDetailsOperation_RemoveFromList, RelationKey: "tag", Value: pbtypes.StringList([]string{"opt1", "opt2"})},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []*pb.RpcObjectSetDetailsDetail{{Key: "tag"}}, details)
+	})
+
+	t.Run("increment of not a number", func(t *testing.T) {
+		_, err := applyDetailsOperations(current, []*pb.RpcObjectListModifyDetailsOperation{
+			{Type: pb.RpcObjectListModifyDetailsOperation_Increment, RelationKey: "name", Value: pbtypes.Int64(1)},
+		})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,57 @@
+package block
+
+import (
+	"errors"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/session"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+var ErrNotCreator = errors.New("only the creator of the object can change its lock")
+
+// SetObjectLock locks or unlocks the object, the locked object is read-only except for the editable relations.
+// The lock is the part of the object state, so it's synced with the other devices
+func (s *Service) SetObjectLock(ctx *session.Context, objectID string, locked bool, editableRelations []string) error {
+	return DoStateCtx(s, ctx, objectID, func(st *state.State, sb smartblock.SmartBlock) error {
+		if err := s.checkLockCreator(sb); err != nil {
+			return err
+		}
+		if !locked {
+			st.RemoveDetail(bundle.RelationKeyIsLocked.String(), bundle.RelationKeyLockEditableRelations.String())
+			return nil
+		}
+		st.SetDetailAndBundledRelation(bundle.RelationKeyIsLocked, pbtypes.Bool(true))
+		st.SetDetailAndBundledRelation(bundle.RelationKeyLockEditableRelations, pbtypes.StringList(editableRelations))
+		return nil
+	}, smartblock.NoRestrictions)
+}
+
+// SetBlocksLock locks or unlocks the blocks, the locked block can't be edited, removed or moved
+func (s *Service) SetBlocksLock(ctx *session.Context, contextID string, blockIDs []string, locked bool) error {
+	return DoStateCtx(s, ctx, contextID, func(st *state.State, sb smartblock.SmartBlock) error {
+		if err := s.checkLockCreator(sb); err != nil {
+			return err
+		}
+		for _, id := range blockIDs {
+			b := st.Get(id)
+			if b == nil {
+				return smartblock.ErrSimpleBlockNotFound
+			}
+			if err := state.SetBlockLock(b, locked); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, smartblock.NoRestrictions)
+}
+
+func (s *Service) checkLockCreator(sb smartblock.SmartBlock) error {
+	creator := pbtypes.GetString(sb.CombinedDetails(), bundle.RelationKeyCreator.String())
+	if creator != "" && creator != s.anytype.ProfileID() {
+		return ErrNotCreator
+	}
+	return nil
+}
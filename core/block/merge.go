@@ -0,0 +1,427 @@
+package block
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/globalsign/mgo/bson"
+	"github.com/gogo/protobuf/types"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/addr"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+	"github.com/anyproto/anytype-heart/util/slice"
+)
+
+var (
+	ErrIncompatibleMerge = errors.New("objects can't be merged")
+	ErrReadonlyMerge     = errors.New("bundled object can't be merged into another one")
+)
+
+// mergeRewriter replaces the references to the merged objects by the references to the target
+type mergeRewriter struct {
+	// ids maps the ids of the sources to the id of the target
+	ids map[string]string
+	// keys maps the keys of the source relations to the key of the target relation, it's empty unless relations are merged
+	keys map[string]string
+	// format is the format of the target relation
+	format model.RelationFormat
+}
+
+// rewriteState replaces the references in the details and the dataview blocks of the state, it reports whether the state was changed
+func (r *mergeRewriter) rewriteState(st *state.State) bool {
+	changed := r.rewriteRelations(st)
+	for key, v := range st.Details().GetFields() {
+		if key == bundle.RelationKeyId.String() {
+			continue
+		}
+		newValue, ok := r.replaceIDs(v)
+		if key == bundle.RelationKeyFeaturedRelations.String() {
+			var keysChanged bool
+			if newValue, keysChanged = r.replaceKeys(newValue); keysChanged {
+				ok = true
+			}
+		}
+		if ok {
+			st.SetDetail(key, newValue)
+			changed = true
+		}
+	}
+	if objectTypes := st.ObjectTypes(); len(objectTypes) > 0 {
+		if newTypes, ok := r.replaceIDs(pbtypes.StringList(objectTypes)); ok {
+			st.SetObjectTypes(pbtypes.GetStringListValue(newTypes))
+			changed = true
+		}
+	}
+
+	var dataviewIDs []string
+	_ = st.Iterate(func(b simple.Block) bool {
+		if b.Model().GetDataview() != nil {
+			dataviewIDs = append(dataviewIDs, b.Model().Id)
+		}
+		return true
+	})
+	for _, id := range dataviewIDs {
+		if r.rewriteDataview(st.Get(id).Model().GetDataview()) {
+			changed = true
+		}
+	}
+	return changed
+}
+
+// rewriteRelations moves the values of the source relations to the target relation. In case the object has both values,
+// the lists are joined and the value of the target is kept for the other formats
+func (r *mergeRewriter) rewriteRelations(st *state.State) (changed bool) {
+	for _, link := range st.GetRelationLinks() {
+		target, ok := r.keys[link.Key]
+		if !ok {
+			continue
+		}
+		source := pbtypes.Get(st.Details(), link.Key)
+		if targetValue := pbtypes.Get(st.Details(), target); isEmptyMergeValue(targetValue) {
+			if !isEmptyMergeValue(source) {
+				st.SetDetail(target, pbtypes.CopyVal(source))
+			}
+		} else if source.GetListValue() != nil && targetValue.GetListValue() != nil && r.format != model.RelationFormat_status {
+			ids := pbtypes.GetStringListValue(targetValue)
+			for _, id := range pbtypes.GetStringListValue(source) {
+				if slice.FindPos(ids, id) == -1 {
+					ids = append(ids, id)
+				}
+			}
+			st.SetDetail(target, pbtypes.StringList(ids))
+		}
+		featured := pbtypes.GetStringList(st.Details(), bundle.RelationKeyFeaturedRelations.String())
+		st.RemoveRelation(link.Key)
+		// removing of the relation drops it from the featured ones, keep the list to replace the key there
+		st.SetDetail(bundle.RelationKeyFeaturedRelations.String(), pbtypes.StringList(featured))
+		st.AddRelationLinks(&model.RelationLink{Key: target, Format: r.format})
+		changed = true
+	}
+	return changed
+}
+
+func (r *mergeRewriter) rewriteDataview(dv *model.BlockContentDataview) (changed bool) {
+	links := make([]*model.RelationLink, 0, len(dv.RelationLinks))
+	for _, link := range dv.RelationLinks {
+		if target, ok := r.keys[link.Key]; ok {
+			changed = true
+			if pbtypes.RelationLinks(dv.RelationLinks).Has(target) || pbtypes.RelationLinks(links).Has(target) {
+				continue
+			}
+			link = &model.RelationLink{Key: target, Format: r.format}
+		}
+		links = append(links, link)
+	}
+	dv.RelationLinks = links
+
+	for _, view := range dv.Views {
+		for _, f := range view.Filters {
+			if r.replaceKey(&f.RelationKey) {
+				changed = true
+			}
+			if v, ok := r.replaceIDs(f.Value); ok {
+				f.Value = v
+				changed = true
+			}
+		}
+		for _, s := range view.Sorts {
+			if r.replaceKey(&s.RelationKey) {
+				changed = true
+			}
+			for i, v := range s.CustomOrder {
+				if newValue, ok := r.replaceIDs(v); ok {
+					s.CustomOrder[i] = newValue
+					changed = true
+				}
+			}
+		}
+		relations := make([]*model.BlockContentDataviewRelation, 0, len(view.Relations))
+		for _, rel := range view.Relations {
+			if r.replaceKey(&rel.Key) {
+				changed = true
+				var exists bool
+				for _, other := range view.Relations {
+					if other != rel && other.Key == rel.Key {
+						exists = true
+					}
+				}
+				if exists {
+					continue
+				}
+			}
+			relations = append(relations, rel)
+		}
+		view.Relations = relations
+		if r.replaceKey(&view.GroupRelationKey) {
+			changed = true
+		}
+		if r.replaceKey(&view.CoverRelationKey) {
+			changed = true
+		}
+	}
+	return changed
+}
+
+func (r *mergeRewriter) replaceKey(key *string) bool {
+	if target, ok := r.keys[*key]; ok {
+		*key = target
+		return true
+	}
+	return false
+}
+
+// replaceIDs replaces the ids of the sources in the string or list value, the duplicates are removed from the list
+func (r *mergeRewriter) replaceIDs(v *types.Value) (*types.Value, bool) {
+	return replaceStrings(v, r.ids)
+}
+
+func (r *mergeRewriter) replaceKeys(v *types.Value) (*types.Value, bool) {
+	return replaceStrings(v, r.keys)
+}
+
+func replaceStrings(v *types.Value, replacements map[string]string) (*types.Value, bool) {
+	if len(replacements) == 0 || v == nil {
+		return v, false
+	}
+	switch k := v.Kind.(type) {
+	case *types.Value_StringValue:
+		if target, ok := replacements[k.StringValue]; ok {
+			return pbtypes.String(target), true
+		}
+	case *types.Value_ListValue:
+		var changed bool
+		list := pbtypes.GetStringListValue(v)
+		if len(list) != len(k.ListValue.Values) {
+			// not a list of strings
+			return v, false
+		}
+		result := make([]string, 0, len(list))
+		for _, s := range list {
+			if target, ok := replacements[s]; ok {
+				s = target
+				changed = true
+			}
+			if slice.FindPos(result, s) == -1 {
+				result = append(result, s)
+			}
+		}
+		if changed {
+			return pbtypes.StringList(result), true
+		}
+	}
+	return v, false
+}
+
+func isEmptyMergeValue(v *types.Value) bool {
+	if v == nil {
+		return true
+	}
+	switch k := v.Kind.(type) {
+	case *types.Value_NullValue:
+		return true
+	case *types.Value_StringValue:
+		return strings.TrimSpace(k.StringValue) == ""
+	case *types.Value_ListValue:
+		return len(k.ListValue.GetValues()) == 0
+	}
+	return false
+}
+
+// MergeDuplicates merges the relations, the object types or the relation options into the target: the references to the sources
+// are replaced by the target in all objects and the sources are archived unless any object fails to change.
+// Nothing is changed in the dry run, only the report is made
+func (s *Service) MergeDuplicates(req pb.RpcObjectMergeDuplicatesRequest) (report *pb.RpcObjectMergeDuplicatesReport, err error) {
+	if req.TargetId == "" || len(req.SourceIds) == 0 || slice.FindPos(req.SourceIds, req.TargetId) != -1 {
+		return nil, fmt.Errorf("%w: target and sources should be different", ErrIncompatibleMerge)
+	}
+	r, filters, err := s.mergeRewriter(req.TargetId, req.SourceIds)
+	if err != nil {
+		return nil, err
+	}
+	ids, err := s.mergeCandidates(filters, req.SourceIds)
+	if err != nil {
+		return nil, err
+	}
+
+	queue := s.process.NewQueue(pb.ModelProcess{
+		Id:   bson.NewObjectId().Hex(),
+		Type: pb.ModelProcess_MergeDuplicates,
+	}, 4)
+	queue.SetMessage("merge duplicates")
+	if err = queue.Start(); err != nil {
+		return nil, err
+	}
+	defer func() {
+		queue.Stop(err)
+	}()
+
+	report = &pb.RpcObjectMergeDuplicatesReport{}
+	if err = r.rewriteObjects(s, queue, ids, req.DryRun, report); err != nil {
+		return nil, err
+	}
+	if err = queue.Finalize(); err != nil {
+		return nil, err
+	}
+	// the failed objects keep the references to the sources, so they stay unarchived
+	if req.DryRun || len(report.Failures) > 0 {
+		return report, nil
+	}
+	if err = s.SetPagesIsArchived(pb.RpcObjectListSetIsArchivedRequest{ObjectIds: req.SourceIds, IsArchived: true}); err != nil {
+		return report, fmt.Errorf("archive sources: %w", err)
+	}
+	report.ArchivedObjectIds = req.SourceIds
+	return report, nil
+}
+
+// rewriteObjects adds the tasks replacing the references in the objects to the queue, the changed objects
+// and the objects failed to change are added to the report when the queue is finalized
+func (r *mergeRewriter) rewriteObjects(p Picker, queue process.Queue, ids []string, dryRun bool, report *pb.RpcObjectMergeDuplicatesReport) error {
+	var m sync.Mutex
+	for _, id := range ids {
+		id := id
+		if err := queue.Add(func() {
+			err := Do(p, id, func(sb smartblock.SmartBlock) error {
+				st := sb.NewState()
+				if !r.rewriteState(st) {
+					return nil
+				}
+				if !dryRun {
+					if err := sb.Apply(st, smartblock.NoRestrictions); err != nil {
+						return err
+					}
+				}
+				m.Lock()
+				report.ChangedObjectIds = append(report.ChangedObjectIds, id)
+				m.Unlock()
+				return nil
+			})
+			if err != nil {
+				log.With("objectID", id).Errorf("failed to merge duplicates: %v", err)
+				m.Lock()
+				report.Failures = append(report.Failures, &pb.RpcObjectMergeDuplicatesReportFailure{ObjectId: id, Error: err.Error()})
+				m.Unlock()
+			}
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mergeRewriter checks that the objects can be merged and returns the rewriter of the references with the filters
+// to find the objects referring to the sources
+func (s *Service) mergeRewriter(targetID string, sourceIDs []string) (*mergeRewriter, []*model.BlockContentDataviewFilter, error) {
+	target, err := s.objectStore.GetDetails(targetID)
+	if err != nil {
+		return nil, nil, err
+	}
+	layout := model.ObjectTypeLayout(pbtypes.GetInt64(target.GetDetails(), bundle.RelationKeyLayout.String()))
+	r := &mergeRewriter{ids: make(map[string]string, len(sourceIDs))}
+	for _, id := range sourceIDs {
+		source, err := s.objectStore.GetDetails(id)
+		if err != nil {
+			return nil, nil, err
+		}
+		if model.ObjectTypeLayout(pbtypes.GetInt64(source.GetDetails(), bundle.RelationKeyLayout.String())) != layout {
+			return nil, nil, fmt.Errorf("%w: %s has another layout", ErrIncompatibleMerge, id)
+		}
+		if isBundledObject(id, source.GetDetails()) {
+			return nil, nil, fmt.Errorf("%w: %s", ErrReadonlyMerge, id)
+		}
+		r.ids[id] = targetID
+
+		switch layout {
+		case model.ObjectType_relation:
+			targetFormat := pbtypes.GetInt64(target.GetDetails(), bundle.RelationKeyRelationFormat.String())
+			if pbtypes.GetInt64(source.GetDetails(), bundle.RelationKeyRelationFormat.String()) != targetFormat {
+				return nil, nil, fmt.Errorf("%w: relation %s has another format", ErrIncompatibleMerge, id)
+			}
+			if r.keys == nil {
+				r.keys = make(map[string]string, len(sourceIDs))
+			}
+			r.format = model.RelationFormat(targetFormat)
+			targetKey := pbtypes.GetString(target.GetDetails(), bundle.RelationKeyRelationKey.String())
+			r.keys[pbtypes.GetString(source.GetDetails(), bundle.RelationKeyRelationKey.String())] = targetKey
+		case model.ObjectType_relationOption:
+			sourceKey := pbtypes.GetString(source.GetDetails(), bundle.RelationKeyRelationKey.String())
+			if sourceKey != pbtypes.GetString(target.GetDetails(), bundle.RelationKeyRelationKey.String()) {
+				return nil, nil, fmt.Errorf("%w: option %s belongs to another relation", ErrIncompatibleMerge, id)
+			}
+		case model.ObjectType_objectType:
+		default:
+			return nil, nil, fmt.Errorf("%w: only relations, object types and relation options can be merged", ErrIncompatibleMerge)
+		}
+	}
+
+	var filters []*model.BlockContentDataviewFilter
+	in := func(key string) {
+		filters = append(filters, &model.BlockContentDataviewFilter{
+			Condition:   model.BlockContentDataviewFilter_In,
+			RelationKey: key,
+			Value:       pbtypes.StringList(sourceIDs),
+		})
+	}
+	switch layout {
+	case model.ObjectType_relation:
+		for key := range r.keys {
+			filters = append(filters, &model.BlockContentDataviewFilter{
+				Condition:   model.BlockContentDataviewFilter_NotEmpty,
+				RelationKey: key,
+			})
+		}
+		in(bundle.RelationKeyRecommendedRelations.String())
+		in(bundle.RelationKeySetOf.String())
+	case model.ObjectType_objectType:
+		in(bundle.RelationKeyType.String())
+		in(bundle.RelationKeyTargetObjectType.String())
+		in(bundle.RelationKeySetOf.String())
+		in(bundle.RelationKeyRelationFormatObjectTypes.String())
+	case model.ObjectType_relationOption:
+		in(pbtypes.GetString(target.GetDetails(), bundle.RelationKeyRelationKey.String()))
+	}
+	return r, filters, nil
+}
+
+// mergeCandidates returns the ids of the objects matching any of the filters and of the sets and collections,
+// their views may refer to the sources
+func (s *Service) mergeCandidates(filters []*model.BlockContentDataviewFilter, sourceIDs []string) ([]string, error) {
+	filters = append(filters, &model.BlockContentDataviewFilter{
+		Condition:   model.BlockContentDataviewFilter_In,
+		RelationKey: bundle.RelationKeyLayout.String(),
+		Value:       pbtypes.IntList(int(model.ObjectType_set), int(model.ObjectType_collection)),
+	})
+	var ids []string
+	for _, f := range filters {
+		records, _, err := s.objectStore.Query(nil, database.Query{Filters: []*model.BlockContentDataviewFilter{f}})
+		if err != nil {
+			return nil, fmt.Errorf("query objects by %s: %w", f.RelationKey, err)
+		}
+		for _, rec := range records {
+			id := pbtypes.GetString(rec.Details, bundle.RelationKeyId.String())
+			if slice.FindPos(ids, id) == -1 && slice.FindPos(sourceIDs, id) == -1 {
+				ids = append(ids, id)
+			}
+		}
+	}
+	return ids, nil
+}
+
+func isBundledObject(id string, details *types.Struct) bool {
+	for _, prefix := range []string{addr.BundledRelationURLPrefix, addr.BundledObjectTypeURLPrefix} {
+		if strings.HasPrefix(id, prefix) || strings.HasPrefix(pbtypes.GetString(details, bundle.RelationKeySourceObject.String()), prefix) {
+			return true
+		}
+	}
+	return pbtypes.GetInt64(details, bundle.RelationKeyLayout.String()) == int64(model.ObjectType_relation) &&
+		bundle.HasRelation(pbtypes.GetString(details, bundle.RelationKeyRelationKey.String()))
+}
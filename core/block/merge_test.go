@@ -0,0 +1,177 @@
+package block
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/anyproto/any-sync/app"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/app/testapp"
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock/smarttest"
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func TestMergeRewriter(t *testing.T) {
+	newState := func(dv *model.BlockContentDataview) *state.State {
+		blocks := map[string]simple.Block{
+			"root": simple.New(&model.Block{Id: "root"}),
+		}
+		if dv != nil {
+			blocks["root"] = simple.New(&model.Block{Id: "root", ChildrenIds: []string{"dataview"}})
+			blocks["dataview"] = simple.New(&model.Block{Id: "dataview", Content: &model.BlockContentOfDataview{Dataview: dv}})
+		}
+		return state.NewDoc("root", blocks).(*state.State).NewState()
+	}
+
+	t.Run("relation values", func(t *testing.T) {
+		r := &mergeRewriter{
+			ids:    map[string]string{"rel-labels": "rel-tags"},
+			keys:   map[string]string{"labels": "tags"},
+			format: model.RelationFormat_tag,
+		}
+		st := newState(nil)
+		st.AddRelationLinks(
+			&model.RelationLink{Key: "labels", Format: model.RelationFormat_tag},
+			&model.RelationLink{Key: "tags", Format: model.RelationFormat_tag},
+		)
+		st.SetDetail("labels", pbtypes.StringList([]string{"opt1", "opt2"}))
+		st.SetDetail("tags", pbtypes.StringList([]string{"opt2", "opt3"}))
+		st.SetDetail(bundle.RelationKeyFeaturedRelations.String(), pbtypes.StringList([]string{"labels", "tags"}))
+
+		assert.True(t, r.rewriteState(st))
+		assert.Equal(t, []string{"opt2", "opt3", "opt1"}, pbtypes.GetStringList(st.Details(), "tags"))
+		assert.Nil(t, pbtypes.Get(st.Details(), "labels"))
+		assert.False(t, st.HasRelation("labels"))
+		assert.Equal(t, []string{"tags"}, pbtypes.GetStringList(st.Details(), bundle.RelationKeyFeaturedRelations.String()))
+	})
+
+	t.Run("value moved to empty target", func(t *testing.T) {
+		r := &mergeRewriter{
+			keys:   map[string]string{"deadline": "due"},
+			format: model.RelationFormat_date,
+		}
+		st := newState(nil)
+		st.AddRelationLinks(&model.RelationLink{Key: "deadline", Format: model.RelationFormat_date})
+		st.SetDetail("deadline", pbtypes.Int64(100))
+
+		assert.True(t, r.rewriteState(st))
+		assert.Equal(t, int64(100), pbtypes.GetInt64(st.Details(), "due"))
+		assert.True(t, st.HasRelation("due"))
+	})
+
+	t.Run("references", func(t *testing.T) {
+		r := &mergeRewriter{ids: map[string]string{"opt-dup": "opt1", "type-dup": "type1"}}
+		st := newState(nil)
+		st.SetObjectTypes([]string{"type-dup"})
+		st.SetDetail("tags", pbtypes.StringList([]string{"opt1", "opt-dup", "opt2"}))
+		st.SetDetail("status", pbtypes.String("opt-dup"))
+
+		assert.True(t, r.rewriteState(st))
+		assert.Equal(t, []string{"type1"}, st.ObjectTypes())
+		assert.Equal(t, "type1", pbtypes.GetString(st.CombinedDetails(), bundle.RelationKeyType.String()))
+		assert.Equal(t, []string{"opt1", "opt2"}, pbtypes.GetStringList(st.Details(), "tags"))
+		assert.Equal(t, "opt1", pbtypes.GetString(st.Details(), "status"))
+	})
+
+	t.Run("dataview", func(t *testing.T) {
+		r := &mergeRewriter{
+			ids:    map[string]string{"rel-labels": "rel-tags", "opt-dup": "opt1"},
+			keys:   map[string]string{"labels": "tags"},
+			format: model.RelationFormat_tag,
+		}
+		st := newState(&model.BlockContentDataview{
+			RelationLinks: []*model.RelationLink{{Key: "labels"}, {Key: "tags"}, {Key: "name"}},
+			Views: []*model.BlockContentDataviewView{{
+				Id:               "view",
+				Filters:          []*model.BlockContentDataviewFilter{{RelationKey: "labels", Value: pbtypes.StringList([]string{"opt-dup"})}},
+				Sorts:            []*model.BlockContentDataviewSort{{RelationKey: "labels"}},
+				Relations:        []*model.BlockContentDataviewRelation{{Key: "name"}, {Key: "labels"}, {Key: "tags"}},
+				GroupRelationKey: "labels",
+			}},
+		})
+
+		assert.True(t, r.rewriteState(st))
+		dv := st.Get("dataview").Model().GetDataview()
+		assert.Equal(t, []string{"tags", "name"}, pbtypes.GetRelationListKeys(dv.RelationLinks))
+		view := dv.Views[0]
+		assert.Equal(t, "tags", view.Filters[0].RelationKey)
+		assert.Equal(t, pbtypes.StringList([]string{"opt1"}), view.Filters[0].Value)
+		assert.Equal(t, "tags", view.Sorts[0].RelationKey)
+		assert.Len(t, view.Relations, 2)
+		assert.Equal(t, "tags", view.GroupRelationKey)
+		assert.Equal(t, "labels", st.ParentState().Get("dataview").Model().GetDataview().Views[0].GroupRelationKey)
+	})
+
+	t.Run("nothing to change", func(t *testing.T) {
+		r := &mergeRewriter{ids: map[string]string{"opt-dup": "opt1"}}
+		st := newState(nil)
+		st.SetDetail("tags", pbtypes.StringList([]string{"opt2"}))
+		assert.False(t, r.rewriteState(st))
+	})
+}
+
+type testPicker map[string]smartblock.SmartBlock
+
+func (p testPicker) PickBlock(_ context.Context, id string) (smartblock.SmartBlock, error) {
+	if sb, ok := p[id]; ok {
+		return sb, nil
+	}
+	return nil, fmt.Errorf("object %s not found", id)
+}
+
+func newTestQueue(t *testing.T) process.Queue {
+	s := process.New()
+	a := new(app.App)
+	a.Register(&testapp.EventSender{F: func(*pb.Event) {}}).Register(s)
+	require.NoError(t, a.Start(context.Background()))
+	q := s.NewQueue(pb.ModelProcess{}, 2)
+	require.NoError(t, q.Start())
+	return q
+}
+
+func TestMergeRewriter_RewriteObjects(t *testing.T) {
+	r := &mergeRewriter{ids: map[string]string{"type-old": "type-new"}}
+	newPicker := func(t *testing.T) testPicker {
+		page := smarttest.New("page")
+		st := page.NewState()
+		st.SetDetail(bundle.RelationKeyTargetObjectType.String(), pbtypes.String("type-old"))
+		require.NoError(t, page.Apply(st))
+		return testPicker{"page": page}
+	}
+	rewrite := func(t *testing.T, p testPicker, dryRun bool) *pb.RpcObjectMergeDuplicatesReport {
+		q := newTestQueue(t)
+		report := &pb.RpcObjectMergeDuplicatesReport{}
+		require.NoError(t, r.rewriteObjects(p, q, []string{"page", "missing"}, dryRun, report))
+		require.NoError(t, q.Finalize())
+		return report
+	}
+
+	t.Run("failed objects are reported", func(t *testing.T) {
+		p := newPicker(t)
+		report := rewrite(t, p, false)
+		assert.Equal(t, []string{"page"}, report.ChangedObjectIds)
+		require.Len(t, report.Failures, 1)
+		assert.Equal(t, "missing", report.Failures[0].ObjectId)
+		assert.NotEmpty(t, report.Failures[0].Error)
+		assert.Equal(t, "type-new", pbtypes.GetString(p["page"].Details(), bundle.RelationKeyTargetObjectType.String()))
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		p := newPicker(t)
+		report := rewrite(t, p, true)
+		assert.Equal(t, []string{"page"}, report.ChangedObjectIds)
+		require.Len(t, report.Failures, 1)
+		assert.Equal(t, "missing", report.Failures[0].ObjectId)
+		assert.Equal(t, "type-old", pbtypes.GetString(p["page"].Details(), bundle.RelationKeyTargetObjectType.String()))
+	})
+}
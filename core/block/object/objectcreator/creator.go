@@ -16,6 +16,7 @@ import (
 	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
 	"github.com/anyproto/anytype-heart/core/block/editor/state"
 	"github.com/anyproto/anytype-heart/core/block/editor/template"
+	"github.com/anyproto/anytype-heart/core/relation"
 	"github.com/anyproto/anytype-heart/metrics"
 	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
@@ -53,6 +54,7 @@ type Creator struct {
 	objectStore       objectstore.ObjectStore
 	collectionService CollectionService
 	bookmark          bookmark.Service
+	relationService   relation.Service
 	objectFactory     *editor.ObjectFactory
 	app               *app.App
 	sbtProvider       typeprovider.SmartBlockTypeProvider
@@ -79,6 +81,7 @@ func (c *Creator) Init(a *app.App) (err error) {
 	c.objectStore = a.MustComponent(objectstore.CName).(objectstore.ObjectStore)
 	c.bookmark = a.MustComponent(bookmark.CName).(bookmark.Service)
 	c.bookmark = a.MustComponent(bookmark.CName).(bookmark.Service)
+	c.relationService = a.MustComponent(relation.CName).(relation.Service)
 	c.objectFactory = app.MustComponent[*editor.ObjectFactory](a)
 	c.collectionService = app.MustComponent[CollectionService](a)
 	c.anytype = a.MustComponent(core.CName).(core.Service)
@@ -170,6 +173,9 @@ func (c *Creator) CreateSmartBlockFromState(ctx context.Context, sbType coresb.S
 	if sbType == coresb.SmartBlockTypeSubObject {
 		return c.CreateSubObjectInWorkspace(createState.CombinedDetails(), workspaceID)
 	}
+	if err = c.relationService.ValidateConstraints(objectTypes, createState.CombinedDetails()); err != nil {
+		return
+	}
 
 	sb, err := c.blockService.CreateTreeObject(ctx, sbType, func(id string) *smartblock.InitContext {
 		createState.SetRootId(id)
@@ -0,0 +1,336 @@
+package block
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/globalsign/mgo/bson"
+	"github.com/gogo/protobuf/types"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/editor/table"
+	"github.com/anyproto/anytype-heart/core/block/editor/template"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+var (
+	ErrReadonlyRelation      = errors.New("bundled relation can't be changed")
+	ErrUnsupportedConversion = errors.New("conversion is not supported")
+)
+
+var textFormats = []model.RelationFormat{
+	model.RelationFormat_shorttext,
+	model.RelationFormat_longtext,
+	model.RelationFormat_url,
+	model.RelationFormat_email,
+	model.RelationFormat_phone,
+}
+
+func isTextFormat(format model.RelationFormat) bool {
+	for _, f := range textFormats {
+		if f == format {
+			return true
+		}
+	}
+	return false
+}
+
+// canConvertFormat reports whether the values of the relation with the format can be converted to the new format
+func canConvertFormat(from, to model.RelationFormat) bool {
+	if from == to {
+		return true
+	}
+	switch {
+	case isTextFormat(to):
+		return isTextFormat(from) || from == model.RelationFormat_number || from == model.RelationFormat_date ||
+			from == model.RelationFormat_checkbox || from == model.RelationFormat_status ||
+			from == model.RelationFormat_tag || from == model.RelationFormat_object
+	case to == model.RelationFormat_number, to == model.RelationFormat_date, to == model.RelationFormat_checkbox:
+		return isTextFormat(from)
+	case to == model.RelationFormat_status:
+		return isTextFormat(from) || from == model.RelationFormat_tag
+	case to == model.RelationFormat_tag:
+		return isTextFormat(from) || from == model.RelationFormat_status
+	}
+	return false
+}
+
+// formatConverter converts the values of the relation to the new format. The conversion is driven by the kind of the value,
+// so the values already converted are kept as is and the conversion can be repeated
+type formatConverter struct {
+	from, to model.RelationFormat
+	// name returns the name of the option or the object
+	name func(id string) string
+	// option returns the id of the option with the name, the option is created if there is no such one
+	option func(name string) (string, error)
+}
+
+func (c *formatConverter) convert(v *types.Value) (*types.Value, error) {
+	switch k := v.Kind.(type) {
+	case *types.Value_StringValue:
+		return c.convertText(strings.TrimSpace(k.StringValue))
+	case *types.Value_ListValue:
+		return c.convertList(pbtypes.GetStringListValue(v))
+	case *types.Value_NumberValue:
+		switch {
+		case c.to == model.RelationFormat_number || c.to == model.RelationFormat_date:
+			return v, nil
+		case isTextFormat(c.to):
+			return pbtypes.String(table.CellText(c.from, v, c.name)), nil
+		}
+	case *types.Value_BoolValue:
+		switch {
+		case c.to == model.RelationFormat_checkbox:
+			return v, nil
+		case isTextFormat(c.to):
+			return pbtypes.String(strconv.FormatBool(k.BoolValue)), nil
+		}
+	case *types.Value_NullValue, nil:
+		return nil, nil
+	}
+	return nil, fmt.Errorf("%T can't be converted to %s", v.Kind, c.to)
+}
+
+func (c *formatConverter) convertText(text string) (*types.Value, error) {
+	if text == "" {
+		return nil, nil
+	}
+	switch c.to {
+	case model.RelationFormat_number:
+		if n, ok := table.ParseNumber(text); ok {
+			return pbtypes.Float64(n), nil
+		}
+		return nil, fmt.Errorf("%q is not a number", text)
+	case model.RelationFormat_date:
+		if t, ok := table.ParseDate(text); ok {
+			return pbtypes.Int64(t.Unix()), nil
+		}
+		return nil, fmt.Errorf("%q is not a date", text)
+	case model.RelationFormat_checkbox:
+		if checked, ok := table.ParseCheckbox(text); ok {
+			return pbtypes.Bool(checked), nil
+		}
+		return nil, fmt.Errorf("%q is not a checkbox value", text)
+	case model.RelationFormat_status:
+		id, err := c.option(text)
+		if err != nil {
+			return nil, err
+		}
+		return pbtypes.StringList([]string{id}), nil
+	case model.RelationFormat_tag:
+		var ids []string
+		for _, name := range strings.Split(text, ",") {
+			if name = strings.TrimSpace(name); name == "" {
+				continue
+			}
+			id, err := c.option(name)
+			if err != nil {
+				return nil, err
+			}
+			ids = append(ids, id)
+		}
+		return pbtypes.StringList(ids), nil
+	}
+	return pbtypes.String(text), nil
+}
+
+func (c *formatConverter) convertList(ids []string) (*types.Value, error) {
+	switch {
+	case c.to == model.RelationFormat_tag:
+		return pbtypes.StringList(ids), nil
+	case c.to == model.RelationFormat_status:
+		if len(ids) > 1 {
+			return nil, fmt.Errorf("status can't have %d values", len(ids))
+		}
+		return pbtypes.StringList(ids), nil
+	case isTextFormat(c.to):
+		names := make([]string, 0, len(ids))
+		for _, id := range ids {
+			if name := c.name(id); name != "" {
+				names = append(names, name)
+			}
+		}
+		if len(names) == 0 {
+			return nil, nil
+		}
+		return pbtypes.String(strings.Join(names, ", ")), nil
+	}
+	return nil, fmt.Errorf("list can't be converted to %s", c.to)
+}
+
+// ConvertRelationFormat changes the format of the relation and converts its values in all objects.
+// The format is changed first, so the values left after the failed or canceled conversion can be converted by the next call
+func (s *Service) ConvertRelationFormat(key string, format model.RelationFormat) (failures []*pb.RpcRelationConvertFormatFailure, err error) {
+	if bundle.HasRelation(key) {
+		return nil, ErrReadonlyRelation
+	}
+	rel, err := s.relationService.FetchKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("get relation: %w", err)
+	}
+	if !canConvertFormat(rel.Format, format) {
+		return nil, fmt.Errorf("%w: from %s to %s", ErrUnsupportedConversion, rel.Format, format)
+	}
+	ids, err := s.relationFormatObjects(key)
+	if err != nil {
+		return nil, err
+	}
+
+	queue := s.process.NewQueue(pb.ModelProcess{
+		Id:   bson.NewObjectId().Hex(),
+		Type: pb.ModelProcess_RelationFormatConversion,
+	}, 4)
+	queue.SetMessage("convert relation values")
+	if err = queue.Start(); err != nil {
+		return nil, err
+	}
+	defer func() {
+		queue.Stop(err)
+	}()
+
+	err = s.ModifyDetails(rel.Id, func(current *types.Struct) (*types.Struct, error) {
+		details := pbtypes.CopyStruct(current)
+		details.Fields[bundle.RelationKeyRelationFormat.String()] = pbtypes.Int64(int64(format))
+		if format == model.RelationFormat_status {
+			details.Fields[bundle.RelationKeyRelationMaxCount.String()] = pbtypes.Int64(1)
+		} else if rel.Format == model.RelationFormat_status {
+			delete(details.Fields, bundle.RelationKeyRelationMaxCount.String())
+		}
+		return details, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("set relation format: %w", err)
+	}
+
+	conv := &formatConverter{
+		from:   rel.Format,
+		to:     format,
+		name:   s.objectName,
+		option: s.relationOptionGetter(key),
+	}
+	var m sync.Mutex
+	for _, id := range ids {
+		id := id
+		if err = queue.Add(func() {
+			convertErr := DoState(s, id, func(st *state.State, sb smartblock.SmartBlock) error {
+				template.ConvertRelationValue(key, format, conv.convert, func(v *types.Value, err error) {
+					m.Lock()
+					defer m.Unlock()
+					failures = append(failures, &pb.RpcRelationConvertFormatFailure{ObjectId: id, Value: v, Description: err.Error()})
+				})(st)
+				return nil
+			})
+			if convertErr != nil {
+				log.With("objectID", id).Errorf("failed to convert relation %s: %v", key, convertErr)
+			}
+		}); err != nil {
+			return failures, err
+		}
+	}
+	if err = queue.Finalize(); err != nil {
+		return failures, err
+	}
+	return failures, nil
+}
+
+// relationFormatObjects returns the ids of the objects with the relation value and of the sets and collections, they may show the relation
+func (s *Service) relationFormatObjects(key string) ([]string, error) {
+	records, _, err := s.objectStore.Query(nil, database.Query{
+		Filters: []*model.BlockContentDataviewFilter{
+			{
+				Condition:   model.BlockContentDataviewFilter_NotEmpty,
+				RelationKey: key,
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("query objects with relation: %w", err)
+	}
+	dataviews, _, err := s.objectStore.Query(nil, database.Query{
+		Filters: []*model.BlockContentDataviewFilter{
+			{
+				Condition:   model.BlockContentDataviewFilter_In,
+				RelationKey: bundle.RelationKeyLayout.String(),
+				Value:       pbtypes.IntList(int(model.ObjectType_set), int(model.ObjectType_collection)),
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("query sets and collections: %w", err)
+	}
+	ids := make([]string, 0, len(records)+len(dataviews))
+	seen := make(map[string]struct{}, cap(ids))
+	for _, rec := range append(records, dataviews...) {
+		id := pbtypes.GetString(rec.Details, bundle.RelationKeyId.String())
+		if _, ok := seen[id]; !ok {
+			seen[id] = struct{}{}
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+func (s *Service) objectName(id string) string {
+	details, err := s.objectStore.GetDetails(id)
+	if err != nil {
+		return ""
+	}
+	return pbtypes.GetString(details.GetDetails(), bundle.RelationKeyName.String())
+}
+
+// relationOptionGetter returns the function finding the option of the relation by the name, the option is created
+// if there is no such one. The function is safe for the concurrent use
+func (s *Service) relationOptionGetter(key string) func(name string) (string, error) {
+	var (
+		m       sync.Mutex
+		options map[string]string
+	)
+	return func(name string) (string, error) {
+		m.Lock()
+		defer m.Unlock()
+		if options == nil {
+			records, _, err := s.objectStore.Query(nil, database.Query{
+				Filters: []*model.BlockContentDataviewFilter{
+					{
+						Condition:   model.BlockContentDataviewFilter_Equal,
+						RelationKey: bundle.RelationKeyLayout.String(),
+						Value:       pbtypes.Int64(int64(model.ObjectType_relationOption)),
+					},
+					{
+						Condition:   model.BlockContentDataviewFilter_Equal,
+						RelationKey: bundle.RelationKeyRelationKey.String(),
+						Value:       pbtypes.String(key),
+					},
+				},
+			})
+			if err != nil {
+				return "", fmt.Errorf("query options: %w", err)
+			}
+			options = make(map[string]string, len(records))
+			for _, rec := range records {
+				optionName := strings.ToLower(pbtypes.GetString(rec.Details, bundle.RelationKeyName.String()))
+				options[optionName] = pbtypes.GetString(rec.Details, bundle.RelationKeyId.String())
+			}
+		}
+		if id, ok := options[strings.ToLower(name)]; ok {
+			return id, nil
+		}
+		id, _, err := s.objectCreator.CreateObject(&pb.RpcObjectCreateRelationOptionRequest{Details: &types.Struct{Fields: map[string]*types.Value{
+			bundle.RelationKeyName.String():        pbtypes.String(name),
+			bundle.RelationKeyRelationKey.String(): pbtypes.String(key),
+		}}}, bundle.TypeKeyRelationOption)
+		if err != nil {
+			return "", fmt.Errorf("create option %q: %w", name, err)
+		}
+		options[strings.ToLower(name)] = id
+		return id, nil
+	}
+}
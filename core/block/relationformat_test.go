@@ -0,0 +1,131 @@
+package block
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func TestCanConvertFormat(t *testing.T) {
+	for _, tc := range []struct {
+		from, to model.RelationFormat
+		ok       bool
+	}{
+		{model.RelationFormat_shorttext, model.RelationFormat_status, true},
+		{model.RelationFormat_status, model.RelationFormat_tag, true},
+		{model.RelationFormat_tag, model.RelationFormat_longtext, true},
+		{model.RelationFormat_longtext, model.RelationFormat_number, true},
+		{model.RelationFormat_longtext, model.RelationFormat_date, true},
+		{model.RelationFormat_date, model.RelationFormat_longtext, true},
+		{model.RelationFormat_number, model.RelationFormat_number, true},
+		{model.RelationFormat_number, model.RelationFormat_date, false},
+		{model.RelationFormat_tag, model.RelationFormat_number, false},
+		{model.RelationFormat_file, model.RelationFormat_longtext, false},
+		{model.RelationFormat_longtext, model.RelationFormat_object, false},
+	} {
+		assert.Equal(t, tc.ok, canConvertFormat(tc.from, tc.to), "%s to %s", tc.from, tc.to)
+	}
+}
+
+func TestFormatConverter(t *testing.T) {
+	names := map[string]string{"opt1": "High", "opt2": "Low"}
+	newConverter := func(from, to model.RelationFormat) (*formatConverter, map[string]string) {
+		options := map[string]string{"High": "opt1"}
+		return &formatConverter{
+			from: from,
+			to:   to,
+			name: func(id string) string { return names[id] },
+			option: func(name string) (string, error) {
+				if id, ok := options[name]; ok {
+					return id, nil
+				}
+				id := fmt.Sprintf("opt%d", len(options)+1)
+				options[name] = id
+				return id, nil
+			},
+		}, options
+	}
+
+	t.Run("text to status", func(t *testing.T) {
+		c, options := newConverter(model.RelationFormat_shorttext, model.RelationFormat_status)
+		v, err := c.convert(pbtypes.String(" High "))
+		require.NoError(t, err)
+		assert.Equal(t, pbtypes.StringList([]string{"opt1"}), v)
+		v, err = c.convert(pbtypes.String("Urgent"))
+		require.NoError(t, err)
+		assert.Equal(t, pbtypes.StringList([]string{"opt2"}), v)
+		assert.Len(t, options, 2)
+	})
+
+	t.Run("text to tag", func(t *testing.T) {
+		c, _ := newConverter(model.RelationFormat_longtext, model.RelationFormat_tag)
+		v, err := c.convert(pbtypes.String("High, Low,"))
+		require.NoError(t, err)
+		assert.Equal(t, pbtypes.StringList([]string{"opt1", "opt2"}), v)
+	})
+
+	t.Run("status to tag and back", func(t *testing.T) {
+		c, _ := newConverter(model.RelationFormat_status, model.RelationFormat_tag)
+		v, err := c.convert(pbtypes.StringList([]string{"opt1"}))
+		require.NoError(t, err)
+		assert.Equal(t, pbtypes.StringList([]string{"opt1"}), v)
+
+		c, _ = newConverter(model.RelationFormat_tag, model.RelationFormat_status)
+		_, err = c.convert(pbtypes.StringList([]string{"opt1", "opt2"}))
+		assert.Error(t, err)
+	})
+
+	t.Run("tag to text", func(t *testing.T) {
+		c, _ := newConverter(model.RelationFormat_tag, model.RelationFormat_longtext)
+		v, err := c.convert(pbtypes.StringList([]string{"opt1", "opt2", "unknown"}))
+		require.NoError(t, err)
+		assert.Equal(t, pbtypes.String("High, Low"), v)
+	})
+
+	t.Run("text to number and date", func(t *testing.T) {
+		c, _ := newConverter(model.RelationFormat_shorttext, model.RelationFormat_number)
+		v, err := c.convert(pbtypes.String("1 250.5"))
+		require.NoError(t, err)
+		assert.Equal(t, pbtypes.Float64(1250.5), v)
+		_, err = c.convert(pbtypes.String("many"))
+		assert.Error(t, err)
+
+		c, _ = newConverter(model.RelationFormat_shorttext, model.RelationFormat_date)
+		v, err = c.convert(pbtypes.String("2023-10-20"))
+		require.NoError(t, err)
+		assert.Equal(t, pbtypes.Int64(time.Date(2023, 10, 20, 0, 0, 0, 0, time.UTC).Unix()), v)
+		_, err = c.convert(pbtypes.String("tomorrow-ish"))
+		assert.Error(t, err)
+	})
+
+	t.Run("to text", func(t *testing.T) {
+		c, _ := newConverter(model.RelationFormat_date, model.RelationFormat_shorttext)
+		v, err := c.convert(pbtypes.Int64(time.Date(2023, 10, 20, 0, 0, 0, 0, time.UTC).Unix()))
+		require.NoError(t, err)
+		assert.Equal(t, pbtypes.String("2023-10-20"), v)
+
+		c, _ = newConverter(model.RelationFormat_checkbox, model.RelationFormat_shorttext)
+		v, err = c.convert(pbtypes.Bool(true))
+		require.NoError(t, err)
+		assert.Equal(t, pbtypes.String("true"), v)
+	})
+
+	t.Run("empty and converted values", func(t *testing.T) {
+		c, _ := newConverter(model.RelationFormat_shorttext, model.RelationFormat_number)
+		v, err := c.convert(pbtypes.String(" "))
+		require.NoError(t, err)
+		assert.Nil(t, v)
+		v, err = c.convert(pbtypes.Float64(3))
+		require.NoError(t, err)
+		assert.Equal(t, pbtypes.Float64(3), v)
+		_, err = c.convert(&types.Value{Kind: &types.Value_StructValue{StructValue: &types.Struct{}}})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,90 @@
+package restriction
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+	"github.com/anyproto/anytype-heart/util/slice"
+)
+
+var (
+	// objLockRestrictions are added to the restrictions of the object locked by the user
+	objLockRestrictions = ObjectRestrictions{
+		model.Restrictions_Blocks,
+		model.Restrictions_Relations,
+		model.Restrictions_Details,
+		model.Restrictions_Delete,
+		model.Restrictions_LayoutChange,
+		model.Restrictions_TypeChange,
+	}
+
+	// lockRelationKeys are changed by the lock of the object only
+	lockRelationKeys = []string{
+		bundle.RelationKeyIsLocked.String(),
+		bundle.RelationKeyLockEditableRelations.String(),
+	}
+)
+
+// Lock is the lock of the object set by the user, the locked object is read-only except for the editable relations
+type Lock struct {
+	EditableRelations []string
+}
+
+// LockFromDetails returns the lock of the object, it's nil if the object isn't locked
+func LockFromDetails(details *types.Struct) *Lock {
+	if !pbtypes.GetBool(details, bundle.RelationKeyIsLocked.String()) {
+		return nil
+	}
+	return &Lock{EditableRelations: pbtypes.GetStringList(details, bundle.RelationKeyLockEditableRelations.String())}
+}
+
+// CheckDetails checks the details with the keys can be changed. The details of the lock itself can't be changed this way
+// even in the unlocked object
+func (l *Lock) CheckDetails(keys ...string) error {
+	for _, key := range keys {
+		if slice.FindPos(lockRelationKeys, key) != -1 {
+			return fmt.Errorf("%w: %s is changed by the object lock only", ErrRestricted, key)
+		}
+		if l != nil && slice.FindPos(l.EditableRelations, key) == -1 {
+			return fmt.Errorf("%w: object is locked, %s can't be changed", ErrRestricted, key)
+		}
+	}
+	return nil
+}
+
+// GetEditableRelations returns the relations which can be changed in the locked object, nil lock has none
+func (l *Lock) GetEditableRelations() []string {
+	if l == nil {
+		return nil
+	}
+	return l.EditableRelations
+}
+
+func (l *Lock) Equal(l2 *Lock) bool {
+	if l == nil || l2 == nil {
+		return l == l2
+	}
+	return slice.UnsortedEquals(l.EditableRelations, l2.EditableRelations)
+}
+
+func (l *Lock) Copy() *Lock {
+	if l == nil {
+		return nil
+	}
+	return &Lock{EditableRelations: slice.Copy(l.EditableRelations)}
+}
+
+// withLockRestrictions returns the restrictions with the ones of the locked object added
+func withLockRestrictions(r ObjectRestrictions) ObjectRestrictions {
+	res := r.Copy()
+	for _, lr := range objLockRestrictions {
+		if res.Check(lr) == nil {
+			res = append(res, lr)
+		}
+	}
+	return res
+}
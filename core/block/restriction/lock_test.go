@@ -0,0 +1,71 @@
+package restriction
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+	"github.com/anyproto/anytype-heart/util/testMock"
+)
+
+func TestLock(t *testing.T) {
+	t.Run("not locked", func(t *testing.T) {
+		l := LockFromDetails(&types.Struct{Fields: map[string]*types.Value{
+			bundle.RelationKeyLockEditableRelations.String(): pbtypes.StringList([]string{"status"}),
+		}})
+		assert.Nil(t, l)
+		assert.NoError(t, l.CheckDetails("name", "status"))
+		assert.ErrorIs(t, l.CheckDetails(bundle.RelationKeyIsLocked.String()), ErrRestricted)
+	})
+
+	t.Run("locked", func(t *testing.T) {
+		l := LockFromDetails(&types.Struct{Fields: map[string]*types.Value{
+			bundle.RelationKeyIsLocked.String():              pbtypes.Bool(true),
+			bundle.RelationKeyLockEditableRelations.String(): pbtypes.StringList([]string{"status"}),
+		}})
+		assert.Equal(t, []string{"status"}, l.GetEditableRelations())
+		assert.NoError(t, l.CheckDetails("status"))
+		assert.ErrorIs(t, l.CheckDetails("status", "name"), ErrRestricted)
+		assert.ErrorIs(t, l.CheckDetails(bundle.RelationKeyLockEditableRelations.String()), ErrRestricted)
+	})
+}
+
+func TestService_GetRestrictionsLocked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := testMock.NewMockObjectStore(ctrl)
+	store.EXPECT().GetObjectType(gomock.Any()).AnyTimes()
+	rest := New(nil, store)
+
+	r := rest.GetRestrictions(&restrictionHolder{
+		tp:     model.SmartBlockType_Page,
+		layout: model.ObjectType_basic,
+		details: &types.Struct{Fields: map[string]*types.Value{
+			bundle.RelationKeyIsLocked.String(): pbtypes.Bool(true),
+		}},
+	})
+	assert.NotNil(t, r.Lock)
+	assert.ErrorIs(t, r.Object.Check(model.Restrictions_Blocks), ErrRestricted)
+	assert.ErrorIs(t, r.Object.Check(model.Restrictions_Delete), ErrRestricted)
+
+	r = rest.GetRestrictions(&restrictionHolder{
+		tp:     model.SmartBlockType_Page,
+		layout: model.ObjectType_set,
+		details: &types.Struct{Fields: map[string]*types.Value{
+			bundle.RelationKeyIsLocked.String(): pbtypes.Bool(true),
+		}},
+	})
+	assert.Len(t, r.Object, len(withLockRestrictions(collectionRestrictions)))
+	assert.Len(t, collectionRestrictions, 4)
+
+	r = rest.GetRestrictions(&restrictionHolder{
+		tp:     model.SmartBlockType_Page,
+		layout: model.ObjectType_basic,
+	})
+	assert.Nil(t, r.Lock)
+	assert.NoError(t, r.Object.Check(model.Restrictions_Blocks))
+}
@@ -1,6 +1,8 @@
 package restriction
 
 import (
+	"github.com/gogo/protobuf/types"
+
 	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 )
@@ -10,6 +12,7 @@ type RestrictionHolder interface {
 	Type() model.SmartBlockType
 	Layout() (model.ObjectTypeLayout, bool)
 	ObjectType() string
+	Details() *types.Struct
 }
 
 type restrictionHolder struct {
@@ -17,14 +20,16 @@ type restrictionHolder struct {
 	tp         model.SmartBlockType
 	layout     model.ObjectTypeLayout
 	objectType string
+	details    *types.Struct
 }
 
-func newRestrictionHolder(id string, sbType smartblock.SmartBlockType, layout model.ObjectTypeLayout, ot string) RestrictionHolder {
+func newRestrictionHolder(id string, sbType smartblock.SmartBlockType, layout model.ObjectTypeLayout, ot string, details *types.Struct) RestrictionHolder {
 	return &restrictionHolder{
 		id:         id,
 		tp:         sbType.ToProto(),
 		layout:     layout,
 		objectType: ot,
+		details:    details,
 	}
 }
 
@@ -43,3 +48,7 @@ func (rh *restrictionHolder) Layout() (model.ObjectTypeLayout, bool) {
 func (rh *restrictionHolder) ObjectType() string {
 	return rh.objectType
 }
+
+func (rh *restrictionHolder) Details() *types.Struct {
+	return rh.details
+}
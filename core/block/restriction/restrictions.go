@@ -5,6 +5,8 @@ import "github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 type Restrictions struct {
 	Object   ObjectRestrictions
 	Dataview DataviewRestrictions
+	// Lock is set when the object is locked by the user
+	Lock *Lock
 }
 
 func (r Restrictions) Proto() *model.Restrictions {
@@ -21,12 +23,13 @@ func (r Restrictions) Proto() *model.Restrictions {
 }
 
 func (r Restrictions) Equal(r2 Restrictions) bool {
-	return r.Object.Equal(r2.Object) && r.Dataview.Equal(r2.Dataview)
+	return r.Object.Equal(r2.Object) && r.Dataview.Equal(r2.Dataview) && r.Lock.Equal(r2.Lock)
 }
 
 func (r Restrictions) Copy() Restrictions {
 	return Restrictions{
 		Object:   r.Object.Copy(),
 		Dataview: r.Dataview.Copy(),
+		Lock:     r.Lock.Copy(),
 	}
 }
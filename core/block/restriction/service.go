@@ -52,10 +52,15 @@ func (s *service) Name() (name string) {
 }
 
 func (s *service) GetRestrictions(rh RestrictionHolder) (r Restrictions) {
-	return Restrictions{
+	r = Restrictions{
 		Object:   s.getObjectRestrictions(rh),
 		Dataview: s.getDataviewRestrictions(rh),
+		Lock:     LockFromDetails(rh.Details()),
 	}
+	if r.Lock != nil {
+		r.Object = withLockRestrictions(r.Object)
+	}
+	return r
 }
 
 func (s *service) CheckRestrictions(id string, cr ...model.RestrictionsObjectRestriction) error {
@@ -86,7 +91,7 @@ func (s *service) getRestrictionsById(id string) (r Restrictions, err error) {
 		}
 		ot = pbtypes.GetString(d.GetDetails(), bundle.RelationKeyType.String())
 	}
-	obj := newRestrictionHolder(id, sbType, layout, ot)
+	obj := newRestrictionHolder(id, sbType, layout, ot, d.GetDetails())
 	if err != nil {
 		return Restrictions{}, err
 	}
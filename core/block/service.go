@@ -39,6 +39,7 @@ import (
 	"github.com/anyproto/anytype-heart/core/filestorage/filesync"
 	"github.com/anyproto/anytype-heart/core/relation"
 	"github.com/anyproto/anytype-heart/core/session"
+	"github.com/anyproto/anytype-heart/core/subscription"
 	"github.com/anyproto/anytype-heart/core/syncstatus"
 	"github.com/anyproto/anytype-heart/metrics"
 	"github.com/anyproto/anytype-heart/pb"
@@ -105,8 +106,9 @@ func New(
 		layoutConverter: layoutConverter,
 		closing:         make(chan struct{}),
 		openedObjs: &openedObjects{
-			objects: make(map[string]bool),
-			lock:    &sync.Mutex{},
+			objects:       make(map[string]bool),
+			syncedSources: make(map[string][]string),
+			lock:          &sync.Mutex{},
 		},
 	}
 }
@@ -141,6 +143,7 @@ type Service struct {
 	restriction     restriction.Service
 	bookmark        bookmarksvc.Service
 	relationService relation.Service
+	subscription    subscription.Service
 	cache           ocache.OCache
 
 	objectCreator   objectCreator
@@ -166,7 +169,9 @@ type Service struct {
 
 type openedObjects struct {
 	objects map[string]bool
-	lock    *sync.Mutex
+	// syncedSources are the sources of the synced blocks of the opened objects, they are opened with the objects
+	syncedSources map[string][]string
+	lock          *sync.Mutex
 }
 
 func (s *Service) Name() string {
@@ -184,6 +189,7 @@ func (s *Service) Init(a *app.App) (err error) {
 	s.restriction = a.MustComponent(restriction.CName).(restriction.Service)
 	s.bookmark = a.MustComponent("bookmark-importer").(bookmarksvc.Service)
 	s.relationService = a.MustComponent(relation.CName).(relation.Service)
+	s.subscription = app.MustComponent[subscription.Service](a)
 	s.objectCreator = a.MustComponent("objectCreator").(objectCreator)
 	s.clientService = a.MustComponent(space.CName).(space.Service)
 	s.objectFactory = app.MustComponent[*editor.ObjectFactory](a)
@@ -208,6 +214,10 @@ func (s *Service) OpenBlock(
 	ctx *session.Context, id string, includeRelationsAsDependentObjects bool,
 ) (obj *model.ObjectView, err error) {
 	startTime := time.Now()
+	id, focusBlockID, err := s.ResolveBlockReference(id)
+	if err != nil {
+		return nil, err
+	}
 	ob, err := s.getSmartblock(context.WithValue(context.TODO(), metrics.CtxKeyEntrypoint, "object_open"), id)
 	if err != nil {
 		return nil, err
@@ -217,6 +227,13 @@ func (s *Service) OpenBlock(
 	}
 	afterSmartBlockTime := time.Now()
 
+	// synced blocks are resolved after the object is unlocked, because their sources can be in the same object
+	defer func() {
+		if err == nil && obj != nil {
+			s.openSyncedSources(id, s.resolveSyncedBlocks(obj))
+			obj.FocusBlockId = focusBlockID
+		}
+	}()
 	ob.Lock()
 	defer ob.Unlock()
 	ob.SetEventFunc(s.sendEvent)
@@ -274,6 +291,10 @@ func (s *Service) ShowBlock(
 	ctx *session.Context, id string, includeRelationsAsDependentObjects bool,
 ) (obj *model.ObjectView, err error) {
 	cctx := context.WithValue(context.TODO(), metrics.CtxKeyEntrypoint, "object_show")
+	id, focusBlockID, err := s.ResolveBlockReference(id)
+	if err != nil {
+		return nil, err
+	}
 	err2 := s.DoWithContext(cctx, id, func(b smartblock.SmartBlock) error {
 		if includeRelationsAsDependentObjects {
 			b.EnabledRelationAsDependentObjects()
@@ -284,13 +305,22 @@ func (s *Service) ShowBlock(
 	if err2 != nil {
 		return nil, err2
 	}
+	if obj != nil {
+		s.resolveSyncedBlocks(obj)
+		obj.FocusBlockId = focusBlockID
+	}
 	return
 }
 
 func (s *Service) CloseBlock(id string) error {
+	id, _ = addr.SplitBlockReference(id)
 	var isDraft bool
+	// the source of the synced blocks keeps sending events while the objects showing them are opened
+	isSyncedSource := mutex.WithLock(s.openedObjs.lock, func() bool { return s.openedObjs.isSyncedSource(id) })
 	err := s.Do(id, func(b smartblock.SmartBlock) error {
-		b.ObjectClose()
+		if !isSyncedSource {
+			b.ObjectClose()
+		}
 		s := b.NewState()
 		isDraft = internalflag.NewFromState(s).Has(model.InternalFlag_editorDeleteEmpty)
 		// workspaceId = pbtypes.GetString(s.LocalDetails(), bundle.RelationKeyWorkspaceId.String())
@@ -308,11 +338,18 @@ func (s *Service) CloseBlock(id string) error {
 		}
 	}
 	mutex.WithLock(s.openedObjs.lock, func() any { delete(s.openedObjs.objects, id); return nil })
+	s.closeSyncedSources(id)
 	return nil
 }
 
 func (s *Service) GetOpenedObjects() []string {
-	return mutex.WithLock(s.openedObjs.lock, func() []string { return lo.Keys(s.openedObjs.objects) })
+	return mutex.WithLock(s.openedObjs.lock, func() []string {
+		ids := lo.Keys(s.openedObjs.objects)
+		for _, sourceIDs := range s.openedObjs.syncedSources {
+			ids = append(ids, sourceIDs...)
+		}
+		return lo.Uniq(ids)
+	})
 }
 
 func (s *Service) CloseBlocks() {
@@ -220,7 +220,12 @@ func (l *Dataview) HasSmartIds() bool {
 }
 
 func (d *Dataview) AddRelation(relation *model.RelationLink) error {
-	if pbtypes.RelationLinks(d.content.RelationLinks).Has(relation.Key) {
+	if l := pbtypes.RelationLinks(d.content.RelationLinks).Get(relation.Key); l != nil {
+		if l.Format != relation.Format {
+			// the relation format was changed
+			l.Format = relation.Format
+			return nil
+		}
 		return ErrRelationExists
 	}
 	d.content.RelationLinks = append(d.content.RelationLinks, relation)
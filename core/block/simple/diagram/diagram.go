@@ -0,0 +1,126 @@
+package diagram
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/block/simple/base"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func init() {
+	simple.RegisterCreator(NewDiagram)
+}
+
+func NewDiagram(m *model.Block) simple.Block {
+	if diagram := m.GetDiagram(); diagram != nil {
+		return &Diagram{
+			Base:    base.NewBase(m).(*base.Base),
+			content: diagram,
+		}
+	}
+	return nil
+}
+
+// Block keeps the source of the diagram in one of the supported languages, the diagram is rendered on export
+type Block interface {
+	simple.Block
+	SetSource(source string)
+	SetLanguage(language model.BlockContentDiagramLanguage)
+	ApplyEvent(e *pb.EventBlockSetDiagram) error
+}
+
+var _ Block = (*Diagram)(nil)
+
+type Diagram struct {
+	*base.Base
+	content *model.BlockContentDiagram
+}
+
+func (d *Diagram) Copy() simple.Block {
+	return NewDiagram(pbtypes.CopyBlock(d.Model()))
+}
+
+func (d *Diagram) Validate() error {
+	if _, ok := model.BlockContentDiagramLanguage_name[int32(d.content.Language)]; !ok {
+		return fmt.Errorf("unknown diagram language: %d", d.content.Language)
+	}
+	return nil
+}
+
+func (d *Diagram) Diff(b simple.Block) (msgs []simple.EventMessage, err error) {
+	diagram, ok := b.(*Diagram)
+	if !ok {
+		return nil, fmt.Errorf("can't make diff with different block type")
+	}
+	if msgs, err = d.Base.Diff(diagram); err != nil {
+		return
+	}
+	changes := &pb.EventBlockSetDiagram{
+		Id: diagram.Id,
+	}
+	hasChanges := false
+
+	if d.content.Source != diagram.content.Source {
+		hasChanges = true
+		changes.Source = &pb.EventBlockSetDiagramSource{Value: diagram.content.Source}
+	}
+	if d.content.Language != diagram.content.Language {
+		hasChanges = true
+		changes.Language = &pb.EventBlockSetDiagramLanguage{Value: diagram.content.Language}
+	}
+
+	if hasChanges {
+		msgs = append(msgs, simple.EventMessage{Msg: &pb.EventMessage{Value: &pb.EventMessageValueOfBlockSetDiagram{BlockSetDiagram: changes}}})
+	}
+	return
+}
+
+func (d *Diagram) SetSource(source string) {
+	d.content.Source = source
+}
+
+func (d *Diagram) SetLanguage(language model.BlockContentDiagramLanguage) {
+	d.content.Language = language
+}
+
+func (d *Diagram) ApplyEvent(e *pb.EventBlockSetDiagram) error {
+	if e.Source != nil {
+		d.content.Source = e.Source.GetValue()
+	}
+	if e.Language != nil {
+		d.content.Language = e.Language.GetValue()
+	}
+	return nil
+}
+
+// codeLanguages are the names of the diagram languages used for code blocks, e.g. in fenced blocks of markdown
+var codeLanguages = map[model.BlockContentDiagramLanguage][]string{
+	model.BlockContentDiagram_Mermaid:  {"mermaid"},
+	model.BlockContentDiagram_Graphviz: {"dot", "graphviz"},
+	model.BlockContentDiagram_PlantUML: {"plantuml", "puml"},
+}
+
+// CodeLanguage returns the name of the diagram language used for code blocks
+func CodeLanguage(language model.BlockContentDiagramLanguage) string {
+	if names := codeLanguages[language]; len(names) > 0 {
+		return names[0]
+	}
+	return ""
+}
+
+// LanguageFromCode returns the diagram language by the language of the code block
+func LanguageFromCode(name string) (model.BlockContentDiagramLanguage, bool) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for language, names := range codeLanguages {
+		for _, n := range names {
+			if n == name {
+				return language, true
+			}
+		}
+	}
+	return 0, false
+}
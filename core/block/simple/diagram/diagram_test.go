@@ -0,0 +1,72 @@
+package diagram
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/simple/base"
+	"github.com/anyproto/anytype-heart/core/block/simple/test"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+func TestDiagram_Diff(t *testing.T) {
+	testBlock := func() *Diagram {
+		return NewDiagram(&model.Block{
+			Restrictions: &model.BlockRestrictions{},
+			Content:      &model.BlockContentOfDiagram{Diagram: &model.BlockContentDiagram{}},
+		}).(*Diagram)
+	}
+	t.Run("type error", func(t *testing.T) {
+		b1 := testBlock()
+		b2 := base.NewBase(&model.Block{})
+		_, err := b1.Diff(b2)
+		assert.Error(t, err)
+	})
+	t.Run("no diff", func(t *testing.T) {
+		b1 := testBlock()
+		b2 := testBlock()
+		b1.SetSource("graph TD; A-->B")
+		b2.SetSource("graph TD; A-->B")
+		d, err := b1.Diff(b2)
+		require.NoError(t, err)
+		assert.Len(t, d, 0)
+	})
+	t.Run("source and language changed", func(t *testing.T) {
+		b1 := testBlock()
+		b2 := testBlock()
+		b2.SetSource("digraph { a -> b }")
+		b2.SetLanguage(model.BlockContentDiagram_Graphviz)
+		diff, err := b1.Diff(b2)
+		require.NoError(t, err)
+		require.Len(t, diff, 1)
+		assert.Equal(t, test.MakeEvent(&pb.EventMessageValueOfBlockSetDiagram{
+			BlockSetDiagram: &pb.EventBlockSetDiagram{
+				Id:       b1.Id,
+				Source:   &pb.EventBlockSetDiagramSource{Value: "digraph { a -> b }"},
+				Language: &pb.EventBlockSetDiagramLanguage{Value: model.BlockContentDiagram_Graphviz},
+			},
+		}), diff)
+	})
+}
+
+func TestDiagram_ApplyEvent(t *testing.T) {
+	b := NewDiagram(&model.Block{Content: &model.BlockContentOfDiagram{Diagram: &model.BlockContentDiagram{}}}).(*Diagram)
+	require.NoError(t, b.ApplyEvent(&pb.EventBlockSetDiagram{
+		Source:   &pb.EventBlockSetDiagramSource{Value: "@startuml\n@enduml"},
+		Language: &pb.EventBlockSetDiagramLanguage{Value: model.BlockContentDiagram_PlantUML},
+	}))
+	assert.Equal(t, &model.BlockContentDiagram{Source: "@startuml\n@enduml", Language: model.BlockContentDiagram_PlantUML}, b.Model().GetDiagram())
+}
+
+func TestLanguageFromCode(t *testing.T) {
+	language, ok := LanguageFromCode("Graphviz")
+	assert.True(t, ok)
+	assert.Equal(t, model.BlockContentDiagram_Graphviz, language)
+	assert.Equal(t, "dot", CodeLanguage(language))
+
+	_, ok = LanguageFromCode("go")
+	assert.False(t, ok)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/anyproto/anytype-heart/core/block/simple/base"
 	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/addr"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 	"github.com/anyproto/anytype-heart/util/pbtypes"
 	"github.com/anyproto/anytype-heart/util/slice"
@@ -114,7 +115,8 @@ func (l *Link) Diff(b simple.Block) (msgs []simple.EventMessage, err error) {
 
 func (l *Link) FillSmartIds(ids []string) []string {
 	if l.content.TargetBlockId != "" {
-		ids = append(ids, l.content.TargetBlockId)
+		objectID, _ := addr.SplitBlockReference(l.content.TargetBlockId)
+		ids = append(ids, objectID)
 	}
 	return ids
 }
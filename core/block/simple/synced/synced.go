@@ -0,0 +1,112 @@
+package synced
+
+import (
+	"fmt"
+
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/block/simple/base"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func init() {
+	simple.RegisterCreator(NewBlock)
+}
+
+func NewBlock(b *model.Block) simple.Block {
+	if s := b.GetSynced(); s != nil {
+		return &block{
+			Base:    base.NewBase(b).(*base.Base),
+			content: s,
+		}
+	}
+	return nil
+}
+
+// Block is either the source of the synced content, that keeps the content as its children,
+// or the reference that shows the content of the source block from another object
+type Block interface {
+	simple.Block
+	IsSource() bool
+	// Target returns ids of the object and the source block the reference points to
+	Target() (objectID, blockID string)
+	FillSmartIds(ids []string) []string
+	HasSmartIds() bool
+	ApplyEvent(e *pb.EventBlockSetSynced) error
+}
+
+type block struct {
+	*base.Base
+	content *model.BlockContentSynced
+}
+
+func (b *block) Copy() simple.Block {
+	return NewBlock(pbtypes.CopyBlock(b.Model()))
+}
+
+func (b *block) Validate() error {
+	if (b.content.TargetObjectId == "") != (b.content.TargetBlockId == "") {
+		return fmt.Errorf("synced block should have both target object and target block or none of them")
+	}
+	return nil
+}
+
+func (b *block) IsSource() bool {
+	return b.content.TargetObjectId == ""
+}
+
+func (b *block) Target() (objectID, blockID string) {
+	return b.content.TargetObjectId, b.content.TargetBlockId
+}
+
+func (b *block) Diff(ob simple.Block) (msgs []simple.EventMessage, err error) {
+	other, ok := ob.(*block)
+	if !ok {
+		return nil, fmt.Errorf("can't make diff with incompatible block")
+	}
+	if msgs, err = b.Base.Diff(other); err != nil {
+		return
+	}
+
+	var hasChanges bool
+	changes := &pb.EventBlockSetSynced{
+		Id: other.Id,
+	}
+
+	if b.content.TargetObjectId != other.content.TargetObjectId {
+		hasChanges = true
+		changes.TargetObjectId = &pb.EventBlockSetSyncedTargetObjectId{Value: other.content.TargetObjectId}
+	}
+
+	if b.content.TargetBlockId != other.content.TargetBlockId {
+		hasChanges = true
+		changes.TargetBlockId = &pb.EventBlockSetSyncedTargetBlockId{Value: other.content.TargetBlockId}
+	}
+
+	if hasChanges {
+		msgs = append(msgs, simple.EventMessage{Msg: &pb.EventMessage{Value: &pb.EventMessageValueOfBlockSetSynced{BlockSetSynced: changes}}})
+	}
+	return
+}
+
+func (b *block) ApplyEvent(e *pb.EventBlockSetSynced) error {
+	if e.TargetObjectId != nil {
+		b.content.TargetObjectId = e.TargetObjectId.GetValue()
+	}
+	if e.TargetBlockId != nil {
+		b.content.TargetBlockId = e.TargetBlockId.GetValue()
+	}
+	return nil
+}
+
+func (b *block) FillSmartIds(ids []string) []string {
+	if b.content.TargetObjectId != "" {
+		ids = append(ids, b.content.TargetObjectId)
+	}
+	return ids
+}
+
+func (b *block) HasSmartIds() bool {
+	return b.content.TargetObjectId != ""
+}
@@ -0,0 +1,70 @@
+package synced
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/simple/test"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+func testBlock(targetObjectID, targetBlockID string) *block {
+	return NewBlock(&model.Block{
+		Id:           "synced",
+		Restrictions: &model.BlockRestrictions{},
+		Content: &model.BlockContentOfSynced{Synced: &model.BlockContentSynced{
+			TargetObjectId: targetObjectID,
+			TargetBlockId:  targetBlockID,
+		}},
+	}).(*block)
+}
+
+func TestDiff(t *testing.T) {
+	t.Run("no changes", func(t *testing.T) {
+		diff, err := testBlock("object", "block").Diff(testBlock("object", "block"))
+		require.NoError(t, err)
+		assert.Empty(t, diff)
+	})
+
+	t.Run("target changed", func(t *testing.T) {
+		b1 := testBlock("", "")
+		b2 := testBlock("object", "block")
+
+		diff, err := b1.Diff(b2)
+
+		require.NoError(t, err)
+		assert.Equal(t, test.MakeEvent(&pb.EventMessageValueOfBlockSetSynced{
+			BlockSetSynced: &pb.EventBlockSetSynced{
+				Id:             b1.Id,
+				TargetObjectId: &pb.EventBlockSetSyncedTargetObjectId{Value: "object"},
+				TargetBlockId:  &pb.EventBlockSetSyncedTargetBlockId{Value: "block"},
+			},
+		}), diff)
+	})
+}
+
+func TestApplyEvent(t *testing.T) {
+	b := testBlock("", "")
+	require.True(t, b.IsSource())
+
+	err := b.ApplyEvent(&pb.EventBlockSetSynced{
+		TargetObjectId: &pb.EventBlockSetSyncedTargetObjectId{Value: "object"},
+		TargetBlockId:  &pb.EventBlockSetSyncedTargetBlockId{Value: "block"},
+	})
+
+	require.NoError(t, err)
+	assert.False(t, b.IsSource())
+	objectID, blockID := b.Target()
+	assert.Equal(t, "object", objectID)
+	assert.Equal(t, "block", blockID)
+	assert.Equal(t, []string{"object"}, b.FillSmartIds(nil))
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, testBlock("", "").Validate())
+	assert.NoError(t, testBlock("object", "block").Validate())
+	assert.Error(t, testBlock("object", "").Validate())
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/anyproto/anytype-heart/core/block/simple"
 	"github.com/anyproto/anytype-heart/core/block/simple/base"
 	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/addr"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 )
 
@@ -641,7 +642,8 @@ func (t *Text) FillSmartIds(ids []string) []string {
 		for _, m := range t.content.Marks.Marks {
 			if (m.Type == model.BlockContentTextMark_Mention ||
 				m.Type == model.BlockContentTextMark_Object) && m.Param != "" {
-				ids = append(ids, m.Param)
+				objectID, _ := addr.SplitBlockReference(m.Param)
+				ids = append(ids, objectID)
 			}
 		}
 	}
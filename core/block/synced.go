@@ -0,0 +1,91 @@
+package block
+
+import (
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/mutex"
+	"github.com/anyproto/anytype-heart/util/slice"
+)
+
+// resolveSyncedBlocks adds the content of the synced blocks sources to the object view and returns the ids of the sources
+func (s *Service) resolveSyncedBlocks(obj *model.ObjectView) (sourceIDs []string) {
+	if obj == nil {
+		return nil
+	}
+	obj.Blocks = state.ResolveSyncedBlocks(obj.Blocks, func(objectID, blockID string) (blocks []*model.Block, err error) {
+		err = s.Do(objectID, func(b smartblock.SmartBlock) error {
+			blocks, err = b.NewState().SyncedSubtree(blockID)
+			return err
+		})
+		if err == nil && slice.FindPos(sourceIDs, objectID) == -1 {
+			sourceIDs = append(sourceIDs, objectID)
+		}
+		return blocks, err
+	})
+	return sourceIDs
+}
+
+// openSyncedSources makes the sources of the synced blocks of the opened object send events, so clients receive
+// the updates of the synced content the same way they receive the updates of the object. The sources stay opened
+// until the object is closed
+func (s *Service) openSyncedSources(objectID string, sourceIDs []string) {
+	sourceIDs = slice.Remove(sourceIDs, objectID)
+	unused := mutex.WithLock(s.openedObjs.lock, func() []string {
+		prev := s.openedObjs.syncedSources[objectID]
+		if len(sourceIDs) == 0 {
+			delete(s.openedObjs.syncedSources, objectID)
+		} else {
+			s.openedObjs.syncedSources[objectID] = sourceIDs
+		}
+		return s.openedObjs.unused(prev)
+	})
+	for _, id := range sourceIDs {
+		if err := s.Do(id, func(b smartblock.SmartBlock) error {
+			b.SetEventFunc(s.sendEvent)
+			return nil
+		}); err != nil {
+			log.With("objectID", id).Warnf("failed to open synced blocks source: %s", err)
+		}
+	}
+	s.closeObjects(unused)
+}
+
+// closeSyncedSources closes the sources of the synced blocks of the closed object unless they are used by other objects
+func (s *Service) closeSyncedSources(objectID string) {
+	unused := mutex.WithLock(s.openedObjs.lock, func() []string {
+		prev := s.openedObjs.syncedSources[objectID]
+		delete(s.openedObjs.syncedSources, objectID)
+		return s.openedObjs.unused(prev)
+	})
+	s.closeObjects(unused)
+}
+
+func (s *Service) closeObjects(ids []string) {
+	for _, id := range ids {
+		if err := s.Do(id, func(b smartblock.SmartBlock) error {
+			b.ObjectClose()
+			return nil
+		}); err != nil {
+			log.With("objectID", id).Warnf("failed to close synced blocks source: %s", err)
+		}
+	}
+}
+
+// isSyncedSource reports whether the object is the source of the synced blocks of any opened object,
+// the lock should be held
+func (o *openedObjects) isSyncedSource(id string) bool {
+	for _, sourceIDs := range o.syncedSources {
+		if slice.FindPos(sourceIDs, id) != -1 {
+			return true
+		}
+	}
+	return false
+}
+
+// unused returns the objects which are neither opened nor used as the synced blocks sources, the lock should be held
+func (o *openedObjects) unused(ids []string) []string {
+	return slice.Filter(ids, func(id string) bool {
+		return !o.objects[id] && !o.isSyncedSource(id)
+	})
+}
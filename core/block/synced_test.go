@@ -0,0 +1,33 @@
+package block
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOpenedObjects_Unused(t *testing.T) {
+	o := &openedObjects{
+		objects: map[string]bool{"page1": true, "source1": true},
+		syncedSources: map[string][]string{
+			"page1": {"source1", "source2"},
+			"page2": {"source3"},
+		},
+		lock: &sync.Mutex{},
+	}
+
+	assert.True(t, o.isSyncedSource("source2"))
+	assert.False(t, o.isSyncedSource("page1"))
+
+	// page2 is closed
+	sources := o.syncedSources["page2"]
+	delete(o.syncedSources, "page2")
+	assert.Equal(t, []string{"source3"}, o.unused(sources))
+
+	// page1 is closed, but source1 is opened itself
+	sources = o.syncedSources["page1"]
+	delete(o.syncedSources, "page1")
+	delete(o.objects, "page1")
+	assert.Equal(t, []string{"source2"}, o.unused(sources))
+}
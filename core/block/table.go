@@ -0,0 +1,347 @@
+package block
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/editor/table"
+	"github.com/anyproto/anytype-heart/core/block/editor/template"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/block/simple/dataview"
+	"github.com/anyproto/anytype-heart/core/session"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+const defaultTableCollectionName = "Table"
+
+// tableGrid is the text content of the table block, the header row holds the names of the columns
+type tableGrid struct {
+	header []string
+	rows   [][]string
+}
+
+// column returns the texts of the cells of the column i
+func (g tableGrid) column(i int) []string {
+	values := make([]string, 0, len(g.rows))
+	for _, row := range g.rows {
+		values = append(values, row[i])
+	}
+	return values
+}
+
+// TableConvertToCollection creates the collection from the table block: the rows become the objects of the collection
+// and the columns become the relations, the formats of the relations are detected by the texts of the cells.
+// The first column is the name of the objects. The table block is replaced with the link to the collection
+func (s *Service) TableConvertToCollection(ctx *session.Context, req pb.RpcBlockTableConvertToCollectionRequest) (collectionID string, err error) {
+	var grid tableGrid
+	if err = Do(s, req.ContextId, func(sb smartblock.SmartBlock) error {
+		grid, err = readTableGrid(sb.NewState(), req.BlockId)
+		return err
+	}); err != nil {
+		return "", err
+	}
+	if len(grid.header) == 0 {
+		return "", fmt.Errorf("table has no columns")
+	}
+
+	relationLinks := make([]*model.RelationLink, len(grid.header))
+	relationLinks[0] = &model.RelationLink{Key: bundle.RelationKeyName.String(), Format: model.RelationFormat_shorttext}
+	for i := 1; i < len(grid.header); i++ {
+		format := table.DetectFormat(grid.column(i))
+		key, err := s.tableColumnRelation(grid.header[i], format)
+		if err != nil {
+			return "", fmt.Errorf("create relation for column %q: %w", grid.header[i], err)
+		}
+		relationLinks[i] = &model.RelationLink{Key: key, Format: format}
+	}
+
+	// the collection is created first, so the objects created before a failure are cleaned up along with it
+	name := req.Name
+	if name == "" {
+		name = defaultTableCollectionName
+	}
+	collectionID, _, err = s.objectCreator.CreateObject(&pb.RpcObjectCreateRequest{Details: &types.Struct{Fields: map[string]*types.Value{
+		bundle.RelationKeyName.String(): pbtypes.String(name),
+	}}}, bundle.TypeKeyCollection)
+	if err != nil {
+		return "", fmt.Errorf("create collection: %w", err)
+	}
+	created := []string{collectionID}
+	defer func() {
+		if err != nil {
+			s.deleteCreatedObjects(created)
+		}
+	}()
+
+	objectIDs := make([]string, 0, len(grid.rows))
+	for _, row := range grid.rows {
+		details := &types.Struct{Fields: map[string]*types.Value{
+			bundle.RelationKeyName.String(): pbtypes.String(row[0]),
+		}}
+		for i := 1; i < len(row); i++ {
+			if v := table.CellValue(relationLinks[i].Format, row[i]); v != nil {
+				details.Fields[relationLinks[i].Key] = v
+			}
+		}
+		id, _, createErr := s.objectCreator.CreateObject(&pb.RpcObjectCreateRequest{Details: details}, bundle.TypeKeyPage)
+		if createErr != nil {
+			err = fmt.Errorf("create object: %w", createErr)
+			return "", err
+		}
+		objectIDs = append(objectIDs, id)
+		created = append(created, id)
+	}
+
+	if err = DoState(s, collectionID, func(st *state.State, sb smartblock.SmartBlock) error {
+		st.UpdateStoreSlice(template.CollectionStoreKey, objectIDs)
+		st.AddRelationLinks(relationLinks[1:]...)
+		return addDataviewRelations(st, relationLinks[1:])
+	}); err != nil {
+		err = fmt.Errorf("fill collection: %w", err)
+		return "", err
+	}
+
+	err = DoStateCtx(s, ctx, req.ContextId, func(st *state.State, sb smartblock.SmartBlock) error {
+		linkBlock := simple.New(&model.Block{Content: &model.BlockContentOfLink{Link: &model.BlockContentLink{
+			TargetBlockId: collectionID,
+			Style:         model.BlockContentLink_Page,
+		}}})
+		if !st.Add(linkBlock) {
+			return fmt.Errorf("add link block")
+		}
+		if err := st.InsertTo(req.BlockId, model.Block_Bottom, linkBlock.Model().Id); err != nil {
+			return fmt.Errorf("insert link block: %w", err)
+		}
+		st.Unlink(req.BlockId)
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return collectionID, nil
+}
+
+// deleteCreatedObjects removes the objects created by the failed conversion, the relations are kept as they may be reused
+func (s *Service) deleteCreatedObjects(ids []string) {
+	for _, id := range ids {
+		if err := s.DeleteObject(id); err != nil {
+			log.With("objectID", id).Errorf("failed to delete object created by the failed table conversion: %v", err)
+		}
+	}
+}
+
+// readTableGrid returns the texts of the cells of the table, the names of the columns are taken from the header row.
+// The columns without name are named by their number
+func readTableGrid(st *state.State, blockID string) (tableGrid, error) {
+	tb, err := table.NewTable(st, blockID)
+	if err != nil {
+		return tableGrid{}, fmt.Errorf("initialize table state: %w", err)
+	}
+	colIdx := tb.MakeColumnIndex()
+	rowIDs := tb.RowIDs()
+	cells := make(map[string][]string, len(rowIDs))
+	for _, rowID := range rowIDs {
+		cells[rowID] = make([]string, len(colIdx))
+	}
+	if err = tb.Iterate(func(b simple.Block, pos table.CellPosition) bool {
+		if b != nil {
+			cells[pos.RowID][pos.ColNumber] = b.Model().GetText().GetText()
+		}
+		return true
+	}); err != nil {
+		return tableGrid{}, err
+	}
+
+	var grid tableGrid
+	for i, rowID := range rowIDs {
+		row, err := tb.PickRow(rowID)
+		if err != nil {
+			return tableGrid{}, err
+		}
+		if i == 0 && row.Model().GetTableRow().GetIsHeader() {
+			grid.header = cells[rowID]
+			continue
+		}
+		grid.rows = append(grid.rows, cells[rowID])
+	}
+	if grid.header == nil {
+		grid.header = make([]string, len(colIdx))
+	}
+	for i, name := range grid.header {
+		if name == "" {
+			grid.header[i] = fmt.Sprintf("Column %d", i+1)
+		}
+	}
+	return grid, nil
+}
+
+// tableColumnRelation returns the key of the relation with the name and the format, the relation is created if there is no such one.
+// Archived and deleted relations aren't reused
+func (s *Service) tableColumnRelation(name string, format model.RelationFormat) (string, error) {
+	records, _, err := s.objectStore.Query(nil, database.Query{
+		Filters: []*model.BlockContentDataviewFilter{
+			{
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				RelationKey: bundle.RelationKeyIsArchived.String(),
+				Value:       pbtypes.Bool(false),
+			},
+			{
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				RelationKey: bundle.RelationKeyIsDeleted.String(),
+				Value:       pbtypes.Bool(false),
+			},
+			{
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				RelationKey: bundle.RelationKeyLayout.String(),
+				Value:       pbtypes.Float64(float64(model.ObjectType_relation)),
+			},
+			{
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				RelationKey: bundle.RelationKeyName.String(),
+				Value:       pbtypes.String(name),
+			},
+			{
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				RelationKey: bundle.RelationKeyRelationFormat.String(),
+				Value:       pbtypes.Float64(float64(format)),
+			},
+		},
+		Limit: 1,
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(records) > 0 {
+		return pbtypes.GetString(records[0].Details, bundle.RelationKeyRelationKey.String()), nil
+	}
+	_, details, err := s.objectCreator.CreateObject(&pb.RpcObjectCreateRelationRequest{Details: &types.Struct{Fields: map[string]*types.Value{
+		bundle.RelationKeyName.String():           pbtypes.String(name),
+		bundle.RelationKeyRelationFormat.String(): pbtypes.Float64(float64(format)),
+	}}}, bundle.TypeKeyRelation)
+	if err != nil {
+		return "", err
+	}
+	return pbtypes.GetString(details, bundle.RelationKeyRelationKey.String()), nil
+}
+
+// addDataviewRelations adds the relations to the dataview block of the collection, they are shown in all views
+func addDataviewRelations(st *state.State, relationLinks []*model.RelationLink) (err error) {
+	iterErr := st.Iterate(func(b simple.Block) (isContinue bool) {
+		dv, ok := b.(dataview.Block)
+		if !ok {
+			return true
+		}
+		for _, link := range relationLinks {
+			if err = dv.AddRelation(link); err != nil {
+				return false
+			}
+			for _, view := range b.Model().GetDataview().GetViews() {
+				if err = dv.AddViewRelation(view.Id, &model.BlockContentDataviewRelation{
+					Key:       link.Key,
+					IsVisible: true,
+				}); err != nil {
+					return false
+				}
+			}
+		}
+		return true
+	})
+	if iterErr != nil {
+		return iterErr
+	}
+	return err
+}
+
+// DataviewConvertToTable creates the table block below the dataview block with the records shown by the view,
+// the table is the static copy of the records: it isn't updated when the records change
+func (s *Service) DataviewConvertToTable(ctx *session.Context, req pb.RpcBlockDataviewConvertToTableRequest) (tableID string, err error) {
+	var (
+		dv   *model.BlockContentDataview
+		view *model.BlockContentDataviewView
+	)
+	if err = Do(s, req.ContextId, func(sb smartblock.SmartBlock) error {
+		b := sb.NewState().Pick(req.BlockId)
+		if b == nil || b.Model().GetDataview() == nil {
+			return fmt.Errorf("dataview block %s not found", req.BlockId)
+		}
+		dv = pbtypes.CopyBlock(b.Model()).GetDataview()
+		viewID := req.ViewId
+		if viewID == "" {
+			viewID = dv.ActiveView
+		}
+		for _, v := range dv.Views {
+			if v.Id == viewID || (viewID == "" && view == nil) {
+				view = v
+			}
+		}
+		if view == nil {
+			return fmt.Errorf("view %s not found", viewID)
+		}
+		return nil
+	}); err != nil {
+		return "", err
+	}
+
+	relations, records, dependencies, err := s.DataviewRecords(req.ContextId, dv, view)
+	if err != nil {
+		return "", err
+	}
+	names := make(map[string]string, len(dependencies)+len(records))
+	for _, d := range append(dependencies, records...) {
+		names[pbtypes.GetString(d, bundle.RelationKeyId.String())] = pbtypes.GetString(d, bundle.RelationKeyName.String())
+	}
+	name := func(id string) string {
+		return names[id]
+	}
+
+	err = DoStateCtx(s, ctx, req.ContextId, func(st *state.State, e table.TableEditor) error {
+		tableID, err = e.TableCreate(st, pb.RpcBlockTableCreateRequest{
+			TargetId:      req.BlockId,
+			Position:      model.Block_Bottom,
+			Rows:          uint32(len(records) + 1),
+			Columns:       uint32(len(relations)),
+			WithHeaderRow: true,
+		})
+		if err != nil {
+			return err
+		}
+		tb, err := table.NewTable(st, tableID)
+		if err != nil {
+			return err
+		}
+		colIDs, rowIDs := tb.ColumnIDs(), tb.RowIDs()
+		for i, rel := range relations {
+			texts := make([]string, 0, len(records)+1)
+			texts = append(texts, rel.Name)
+			for _, rec := range records {
+				texts = append(texts, table.CellText(rel.Format, pbtypes.Get(rec, rel.Key), name))
+			}
+			for j, text := range texts {
+				if err = setCellText(st, e, rowIDs[j], colIDs[i], text); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	return tableID, err
+}
+
+func setCellText(st *state.State, e table.TableEditor, rowID, colID, text string) error {
+	if cell := st.Get(table.MakeCellID(rowID, colID)); cell != nil {
+		cell.Model().GetText().Text = text
+		return nil
+	}
+	if text == "" {
+		return nil
+	}
+	_, err := e.CellCreate(st, rowID, colID, &model.Block{Content: &model.BlockContentOfText{Text: &model.BlockContentText{Text: text}}})
+	return err
+}
@@ -0,0 +1,78 @@
+package block
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/editor/table"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+	"github.com/anyproto/anytype-heart/util/testMock"
+)
+
+func TestReadTableGrid(t *testing.T) {
+	st := state.NewDoc("root", map[string]simple.Block{
+		"root": simple.New(&model.Block{Id: "root"}),
+	}).NewState()
+	e := table.NewEditor(nil)
+	tableID, err := e.TableCreate(st, pb.RpcBlockTableCreateRequest{
+		TargetId:      "root",
+		Position:      model.Block_Inner,
+		Rows:          3,
+		Columns:       3,
+		WithHeaderRow: true,
+	})
+	require.NoError(t, err)
+
+	tb, err := table.NewTable(st, tableID)
+	require.NoError(t, err)
+	rows, cols := tb.RowIDs(), tb.ColumnIDs()
+	for _, cell := range []struct {
+		row, col int
+		text     string
+	}{
+		{0, 0, "Name"}, {0, 1, "Price"},
+		{1, 0, "Apple"}, {1, 1, "10"}, {1, 2, "red"},
+		{2, 0, "Pear"},
+	} {
+		require.NoError(t, setCellText(st, e, rows[cell.row], cols[cell.col], cell.text))
+	}
+
+	grid, err := readTableGrid(st, tableID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Name", "Price", "Column 3"}, grid.header)
+	assert.Equal(t, [][]string{{"Apple", "10", "red"}, {"Pear", "", ""}}, grid.rows)
+}
+
+func TestService_TableColumnRelation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := testMock.NewMockObjectStore(ctrl)
+	s := &Service{objectStore: store}
+
+	store.EXPECT().Query(gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, q database.Query) ([]database.Record, int, error) {
+		filters := map[string]*types.Value{}
+		for _, f := range q.Filters {
+			filters[f.RelationKey] = f.Value
+		}
+		// archived and deleted relations are skipped
+		assert.Equal(t, pbtypes.Bool(false), filters[bundle.RelationKeyIsArchived.String()])
+		assert.Equal(t, pbtypes.Bool(false), filters[bundle.RelationKeyIsDeleted.String()])
+		assert.Equal(t, pbtypes.String("Price"), filters[bundle.RelationKeyName.String()])
+		return []database.Record{{Details: &types.Struct{Fields: map[string]*types.Value{
+			bundle.RelationKeyRelationKey.String(): pbtypes.String("price"),
+		}}}}, 1, nil
+	})
+
+	key, err := s.tableColumnRelation("Price", model.RelationFormat_number)
+	require.NoError(t, err)
+	assert.Equal(t, "price", key)
+}
@@ -587,3 +587,25 @@ func (mw *Middleware) BlockDataviewViewRelationSort(cctx context.Context, req *p
 
 	return resp(err)
 }
+
+func (mw *Middleware) BlockDataviewConvertToTable(cctx context.Context, req *pb.RpcBlockDataviewConvertToTableRequest) *pb.RpcBlockDataviewConvertToTableResponse {
+	ctx := mw.newContext(cctx)
+	response := func(code pb.RpcBlockDataviewConvertToTableResponseErrorCode, id string, err error) *pb.RpcBlockDataviewConvertToTableResponse {
+		m := &pb.RpcBlockDataviewConvertToTableResponse{Error: &pb.RpcBlockDataviewConvertToTableResponseError{Code: code}, BlockId: id}
+		if err != nil {
+			m.Error.Description = err.Error()
+		} else {
+			m.Event = ctx.GetResponseEvent()
+		}
+		return m
+	}
+	var id string
+	err := mw.doBlockService(func(bs *block.Service) (err error) {
+		id, err = bs.DataviewConvertToTable(ctx, *req)
+		return
+	})
+	if err != nil {
+		return response(pb.RpcBlockDataviewConvertToTableResponseError_UNKNOWN_ERROR, "", err)
+	}
+	return response(pb.RpcBlockDataviewConvertToTableResponseError_NULL, id, nil)
+}
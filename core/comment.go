@@ -0,0 +1,111 @@
+package core
+
+import (
+	"context"
+	"errors"
+
+	"github.com/anyproto/anytype-heart/core/block/comment"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+func (mw *Middleware) CommentCreate(cctx context.Context, req *pb.RpcCommentCreateRequest) *pb.RpcCommentCreateResponse {
+	response := func(c *model.Comment, code pb.RpcCommentCreateResponseErrorCode, err error) *pb.RpcCommentCreateResponse {
+		m := &pb.RpcCommentCreateResponse{Error: &pb.RpcCommentCreateResponseError{Code: code}, Comment: c}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+
+	c, err := getService[comment.Service](mw).CreateComment(req)
+	switch {
+	case err == nil:
+		return response(c, pb.RpcCommentCreateResponseError_NULL, nil)
+	case errors.Is(err, comment.ErrBadInput):
+		return response(nil, pb.RpcCommentCreateResponseError_BAD_INPUT, err)
+	case errors.Is(err, comment.ErrNotFound):
+		return response(nil, pb.RpcCommentCreateResponseError_NOT_FOUND, err)
+	default:
+		return response(nil, pb.RpcCommentCreateResponseError_UNKNOWN_ERROR, err)
+	}
+}
+
+func (mw *Middleware) CommentUpdate(cctx context.Context, req *pb.RpcCommentUpdateRequest) *pb.RpcCommentUpdateResponse {
+	response := func(c *model.Comment, code pb.RpcCommentUpdateResponseErrorCode, err error) *pb.RpcCommentUpdateResponse {
+		m := &pb.RpcCommentUpdateResponse{Error: &pb.RpcCommentUpdateResponseError{Code: code}, Comment: c}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+
+	c, err := getService[comment.Service](mw).UpdateComment(req)
+	switch {
+	case err == nil:
+		return response(c, pb.RpcCommentUpdateResponseError_NULL, nil)
+	case errors.Is(err, comment.ErrNotFound):
+		return response(nil, pb.RpcCommentUpdateResponseError_NOT_FOUND, err)
+	default:
+		return response(nil, pb.RpcCommentUpdateResponseError_UNKNOWN_ERROR, err)
+	}
+}
+
+func (mw *Middleware) CommentSetIsResolved(cctx context.Context, req *pb.RpcCommentSetIsResolvedRequest) *pb.RpcCommentSetIsResolvedResponse {
+	response := func(code pb.RpcCommentSetIsResolvedResponseErrorCode, err error) *pb.RpcCommentSetIsResolvedResponse {
+		m := &pb.RpcCommentSetIsResolvedResponse{Error: &pb.RpcCommentSetIsResolvedResponseError{Code: code}}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+
+	err := getService[comment.Service](mw).SetIsResolved(req.CommentId, req.IsResolved)
+	switch {
+	case err == nil:
+		return response(pb.RpcCommentSetIsResolvedResponseError_NULL, nil)
+	case errors.Is(err, comment.ErrNotFound):
+		return response(pb.RpcCommentSetIsResolvedResponseError_NOT_FOUND, err)
+	default:
+		return response(pb.RpcCommentSetIsResolvedResponseError_UNKNOWN_ERROR, err)
+	}
+}
+
+func (mw *Middleware) CommentDelete(cctx context.Context, req *pb.RpcCommentDeleteRequest) *pb.RpcCommentDeleteResponse {
+	response := func(code pb.RpcCommentDeleteResponseErrorCode, err error) *pb.RpcCommentDeleteResponse {
+		m := &pb.RpcCommentDeleteResponse{Error: &pb.RpcCommentDeleteResponseError{Code: code}}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+
+	err := getService[comment.Service](mw).DeleteComment(req.CommentId)
+	switch {
+	case err == nil:
+		return response(pb.RpcCommentDeleteResponseError_NULL, nil)
+	case errors.Is(err, comment.ErrNotFound):
+		return response(pb.RpcCommentDeleteResponseError_NOT_FOUND, err)
+	default:
+		return response(pb.RpcCommentDeleteResponseError_UNKNOWN_ERROR, err)
+	}
+}
+
+func (mw *Middleware) CommentList(cctx context.Context, req *pb.RpcCommentListRequest) *pb.RpcCommentListResponse {
+	response := func(comments []*model.Comment, code pb.RpcCommentListResponseErrorCode, err error) *pb.RpcCommentListResponse {
+		m := &pb.RpcCommentListResponse{Error: &pb.RpcCommentListResponseError{Code: code}, Comments: comments}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+
+	if req.ObjectId == "" {
+		return response(nil, pb.RpcCommentListResponseError_BAD_INPUT, errors.New("objectId is empty"))
+	}
+	comments, err := getService[comment.Service](mw).ListComments(req.ObjectId, req.IncludeResolved)
+	if err != nil {
+		return response(nil, pb.RpcCommentListResponseError_UNKNOWN_ERROR, err)
+	}
+	return response(comments, pb.RpcCommentListResponseError_NULL, nil)
+}
@@ -0,0 +1,188 @@
+package csv
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/converter"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+var logger = logging.Logger("csv-export")
+
+const (
+	defaultListSeparator = ", "
+	idColumn             = "ID"
+	dateLayout           = "2006-01-02"
+)
+
+type Options struct {
+	// Delimiter separates the columns, comma is used when it's zero
+	Delimiter rune
+	// ViewID is the view to export, the active view is used when the dataview doesn't have it
+	ViewID string
+	// ObjectIDs makes the object, tag, status and file relations written as IDs instead of names,
+	// the IDs of the records are written in the first column then
+	ObjectIDs bool
+	// ListSeparator joins the values of the relations with multiple values
+	ListSeparator string
+}
+
+// NewConverter returns the converter of the set or collection to the table of its records,
+// the columns are the visible relations of the view and the records are filtered and sorted as in the view
+func NewConverter(s *state.State, opts Options, dataview converter.DataviewSource) converter.Converter {
+	if opts.Delimiter == 0 {
+		opts.Delimiter = ','
+	}
+	if opts.ListSeparator == "" {
+		opts.ListSeparator = defaultListSeparator
+	}
+	return &CSV{s: s, opts: opts, dataview: dataview}
+}
+
+type CSV struct {
+	s        *state.State
+	opts     Options
+	dataview converter.DataviewSource
+}
+
+func (c *CSV) Convert(model.SmartBlockType) []byte {
+	result, err := c.convert()
+	if err != nil {
+		logger.Errorf("convert %s to csv: %v", c.s.RootId(), err)
+		return nil
+	}
+	return result
+}
+
+func (c *CSV) convert() ([]byte, error) {
+	var dv *model.Block
+	_ = c.s.Iterate(func(b simple.Block) bool {
+		if b.Model().GetDataview() != nil {
+			dv = b.Model()
+			return false
+		}
+		return true
+	})
+	if dv == nil {
+		return nil, fmt.Errorf("object doesn't have dataview")
+	}
+	view := converter.DataviewView(dv.GetDataview(), c.opts.ViewID)
+	if view == nil {
+		return nil, fmt.Errorf("dataview doesn't have views")
+	}
+	res, err := c.dataview.DataviewRecords(dv.Id, view.Id)
+	if err != nil {
+		return nil, fmt.Errorf("get dataview records: %w", err)
+	}
+	relations, records := res.Relations, res.Records
+	names := make(map[string]string, len(res.Dependencies))
+	for _, d := range res.Dependencies {
+		names[pbtypes.GetString(d, bundle.RelationKeyId.String())] = pbtypes.GetString(d, bundle.RelationKeyName.String())
+	}
+	includeTime := make(map[string]bool, len(view.Relations))
+	for _, rel := range view.Relations {
+		includeTime[rel.Key] = rel.DateIncludeTime
+	}
+
+	buf := &bytes.Buffer{}
+	w := csv.NewWriter(buf)
+	w.Comma = c.opts.Delimiter
+	header := make([]string, 0, len(relations)+1)
+	if c.opts.ObjectIDs {
+		header = append(header, idColumn)
+	}
+	for _, rel := range relations {
+		header = append(header, rel.Name)
+	}
+	if err = w.Write(header); err != nil {
+		return nil, err
+	}
+	for _, rec := range records {
+		row := make([]string, 0, len(header))
+		if c.opts.ObjectIDs {
+			row = append(row, pbtypes.GetString(rec, bundle.RelationKeyId.String()))
+		}
+		for _, rel := range relations {
+			row = append(row, c.value(rec, rel, names, includeTime[rel.Key]))
+		}
+		if err = w.Write(row); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+// value returns the value of the relation as the text: the dates are written in ISO 8601
+// and the multiple values are joined by the list separator
+func (c *CSV) value(rec *types.Struct, rel *model.Relation, names map[string]string, includeTime bool) string {
+	v := pbtypes.Get(rec, rel.Key)
+	if v == nil {
+		return ""
+	}
+	switch rel.Format {
+	case model.RelationFormat_checkbox:
+		return strconv.FormatBool(v.GetBoolValue())
+	case model.RelationFormat_number:
+		if _, ok := v.Kind.(*types.Value_NumberValue); ok {
+			return strconv.FormatFloat(v.GetNumberValue(), 'f', -1, 64)
+		}
+		return ""
+	case model.RelationFormat_date:
+		ts := int64(v.GetNumberValue())
+		if ts == 0 {
+			return ""
+		}
+		if includeTime {
+			return time.Unix(ts, 0).Format(time.RFC3339)
+		}
+		return time.Unix(ts, 0).Format(dateLayout)
+	case model.RelationFormat_status, model.RelationFormat_tag, model.RelationFormat_object, model.RelationFormat_file:
+		ids := pbtypes.GetStringListValue(v)
+		if c.opts.ObjectIDs {
+			return strings.Join(ids, c.opts.ListSeparator)
+		}
+		values := make([]string, 0, len(ids))
+		for _, id := range ids {
+			if name := names[id]; name != "" {
+				values = append(values, name)
+			}
+		}
+		return strings.Join(values, c.opts.ListSeparator)
+	}
+	if list := v.GetListValue(); list != nil {
+		return strings.Join(pbtypes.GetStringListValue(v), c.opts.ListSeparator)
+	}
+	return pbtypes.GetString(rec, rel.Key)
+}
+
+func (c *CSV) SetKnownDocs(map[string]*types.Struct) converter.Converter {
+	return c
+}
+
+func (c *CSV) FileHashes() []string {
+	return nil
+}
+
+func (c *CSV) ImageHashes() []string {
+	return nil
+}
+
+func (c *CSV) Ext() string {
+	if c.opts.Delimiter == '\t' {
+		return ".tsv"
+	}
+	return ".csv"
+}
@@ -0,0 +1,95 @@
+package csv
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/converter"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func newSet() *state.State {
+	return state.NewDoc("root", map[string]simple.Block{
+		"root": simple.New(&model.Block{Id: "root", ChildrenIds: []string{"dataview"}}),
+		"dataview": simple.New(&model.Block{Id: "dataview", Content: &model.BlockContentOfDataview{Dataview: &model.BlockContentDataview{
+			ActiveView: "active",
+			Views: []*model.BlockContentDataviewView{
+				{Id: "all", Name: "All"},
+				{Id: "active", Name: "Active", Relations: []*model.BlockContentDataviewRelation{
+					{Key: "due", IsVisible: true, DateIncludeTime: true},
+				}},
+			},
+		}}}),
+	}).(*state.State)
+}
+
+func TestCSV_Convert(t *testing.T) {
+	created := time.Date(2023, 10, 19, 0, 0, 0, 0, time.Local)
+	due := time.Date(2023, 10, 20, 15, 30, 0, 0, time.Local)
+	records := &converter.DataviewRecords{
+		Relations: []*model.Relation{
+			{Key: bundle.RelationKeyName.String(), Name: "Name", Format: model.RelationFormat_shorttext},
+			{Key: "tag", Name: "Tag", Format: model.RelationFormat_tag},
+			{Key: "done", Name: "Done", Format: model.RelationFormat_checkbox},
+			{Key: "estimate", Name: "Estimate", Format: model.RelationFormat_number},
+			{Key: "created", Name: "Created", Format: model.RelationFormat_date},
+			{Key: "due", Name: "Due", Format: model.RelationFormat_date},
+		},
+		Records: []*types.Struct{
+			{Fields: map[string]*types.Value{
+				bundle.RelationKeyId.String():   pbtypes.String("task1"),
+				bundle.RelationKeyName.String(): pbtypes.String("Write report, draft"),
+				"tag":                           pbtypes.StringList([]string{"tag1", "tag2"}),
+				"done":                          pbtypes.Bool(true),
+				"estimate":                      pbtypes.Float64(2.5),
+				"created":                       pbtypes.Int64(created.Unix()),
+				"due":                           pbtypes.Int64(due.Unix()),
+			}},
+			{Fields: map[string]*types.Value{
+				bundle.RelationKeyId.String():   pbtypes.String("task2"),
+				bundle.RelationKeyName.String(): pbtypes.String("Send it"),
+			}},
+		},
+		Dependencies: []*types.Struct{
+			{Fields: map[string]*types.Value{
+				bundle.RelationKeyId.String():   pbtypes.String("tag1"),
+				bundle.RelationKeyName.String(): pbtypes.String("work"),
+			}},
+			{Fields: map[string]*types.Value{
+				bundle.RelationKeyId.String():   pbtypes.String("tag2"),
+				bundle.RelationKeyName.String(): pbtypes.String("urgent"),
+			}},
+		},
+	}
+
+	t.Run("csv", func(t *testing.T) {
+		source := converter.Dataviews{{BlockID: "dataview", ViewID: "active"}: records}
+		conv := NewConverter(newSet(), Options{}, source)
+		assert.Equal(t, ".csv", conv.Ext())
+		assert.Equal(t, "Name,Tag,Done,Estimate,Created,Due\n"+
+			`"Write report, draft","work, urgent",true,2.5,2023-10-19,`+due.Format(time.RFC3339)+"\n"+
+			"Send it,,,,,\n", string(conv.Convert(model.SmartBlockType_Page)))
+	})
+
+	t.Run("tsv with ids", func(t *testing.T) {
+		source := converter.Dataviews{{BlockID: "dataview", ViewID: "all"}: records}
+		conv := NewConverter(newSet(), Options{Delimiter: '\t', ViewID: "all", ObjectIDs: true, ListSeparator: ";"}, source)
+		assert.Equal(t, ".tsv", conv.Ext())
+		result := string(conv.Convert(model.SmartBlockType_Page))
+		assert.Equal(t, "ID\tName\tTag\tDone\tEstimate\tCreated\tDue\n"+
+			"task1\tWrite report, draft\ttag1;tag2\ttrue\t2.5\t2023-10-19\t2023-10-20\n"+
+			"task2\tSend it\t\t\t\t\t\n", result)
+	})
+
+	t.Run("no dataview", func(t *testing.T) {
+		s := state.NewDoc("root", map[string]simple.Block{"root": simple.New(&model.Block{Id: "root"})}).(*state.State)
+		assert.Nil(t, NewConverter(s, Options{}, converter.Dataviews{}).Convert(model.SmartBlockType_Page))
+	})
+}
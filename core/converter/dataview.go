@@ -0,0 +1,57 @@
+package converter
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+// DataviewRecords are the records shown by the view of the dataview block
+type DataviewRecords struct {
+	// Relations are the relations of the view columns
+	Relations []*model.Relation
+	Records   []*types.Struct
+	// Dependencies are the details of the objects the records refer to
+	Dependencies []*types.Struct
+}
+
+// DataviewSource provides the records shown by the dataview blocks
+type DataviewSource interface {
+	DataviewRecords(blockID, viewID string) (*DataviewRecords, error)
+}
+
+// DataviewKey is the view of the dataview block
+type DataviewKey struct {
+	BlockID, ViewID string
+}
+
+// Dataviews is the DataviewSource with the records queried before the conversion. The records are queried
+// with the subscriptions which lock the collections, so they can't be queried while the object is converted
+type Dataviews map[DataviewKey]*DataviewRecords
+
+func (d Dataviews) DataviewRecords(blockID, viewID string) (*DataviewRecords, error) {
+	records, ok := d[DataviewKey{BlockID: blockID, ViewID: viewID}]
+	if !ok {
+		return nil, fmt.Errorf("records of the view %s of the block %s aren't queried", viewID, blockID)
+	}
+	return records, nil
+}
+
+// DataviewView returns the view with the given id, the active or the first view of the dataview otherwise
+func DataviewView(dv *model.BlockContentDataview, viewID string) *model.BlockContentDataviewView {
+	if len(dv.GetViews()) == 0 {
+		return nil
+	}
+	view := dv.Views[0]
+	for _, v := range dv.Views {
+		if viewID != "" && v.Id == viewID {
+			return v
+		}
+		if v.Id == dv.ActiveView {
+			view = v
+		}
+	}
+	return view
+}
@@ -0,0 +1,63 @@
+// Package diagram renders sources of the diagram blocks to SVG
+package diagram
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+var ErrNotSupported = errors.New("diagram rendering is not supported")
+
+// Render renders the diagram to SVG. Graphviz DOT, Mermaid flowcharts and sequence diagrams and PlantUML sequence diagrams are supported
+func Render(language model.BlockContentDiagramLanguage, source string) ([]byte, error) {
+	switch language {
+	case model.BlockContentDiagram_Graphviz:
+		return renderDot([]byte(source))
+	case model.BlockContentDiagram_Mermaid:
+		return renderMermaid(source)
+	case model.BlockContentDiagram_PlantUML:
+		return renderPlantUML(source)
+	default:
+		return nil, ErrNotSupported
+	}
+}
+
+func renderMermaid(source string) ([]byte, error) {
+	header, body := mermaidHeader(source)
+	switch fields := strings.Fields(header); {
+	case len(fields) == 0:
+		return nil, errors.New("empty mermaid diagram")
+	case fields[0] == "graph" || fields[0] == "flowchart":
+		var direction string
+		if len(fields) > 1 {
+			direction = fields[1]
+		}
+		dot, err := flowchartToDot(direction, body)
+		if err != nil {
+			return nil, err
+		}
+		return renderDot([]byte(dot))
+	case fields[0] == "sequenceDiagram":
+		return renderSequence(body)
+	default:
+		return nil, ErrNotSupported
+	}
+}
+
+// mermaidHeader returns the first meaningful line of the diagram, that declares its type, and the rest of the lines
+func mermaidHeader(source string) (header string, body []string) {
+	lines := strings.Split(source, "\n")
+	for i, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "%%") {
+			continue
+		}
+		// header can be followed by statements on the same line, e.g. "graph TD; A-->B"
+		header, rest, _ := strings.Cut(line, ";")
+		body = append([]string{rest}, lines[i+1:]...)
+		return header, body
+	}
+	return "", nil
+}
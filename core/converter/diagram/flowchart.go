@@ -0,0 +1,234 @@
+package diagram
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	// edge with the text inside, e.g. "-- text -->" or "-. text .->"
+	labeledEdgeRe = regexp.MustCompile(`^\s*(<)?(--|==|-\.)\s*([^-=.>|\s][^|]*?)\s*(-{2,}|={2,}|\.+-)(>|o|x)?`)
+	// edge with the optional text after it, e.g. "-->", "==>", "-.->" or "---|text|"
+	edgeRe   = regexp.MustCompile(`^\s*(<)?(-{2,}|={2,}|-\.+-)(>|o|x)?\s*(?:\|([^|]*)\|)?`)
+	nodeIDRe = regexp.MustCompile(`^\s*([\p{L}\p{N}_]+)`)
+)
+
+// node shapes of the flowchart, longer openings go first
+var flowchartShapes = []struct {
+	open, close string
+	attrs       string
+}{
+	{"((", "))", `shape=circle`},
+	{"([", "])", `shape=box, style=rounded`},
+	{"[[", "]]", `shape=box, peripheries=2`},
+	{"[(", ")]", `shape=cylinder`},
+	{"{{", "}}", `shape=hexagon`},
+	{"[/", "/]", `shape=parallelogram`},
+	{"[\\", "\\]", `shape=parallelogram`},
+	{">", "]", `shape=cds`},
+	{"[", "]", `shape=box`},
+	{"(", ")", `shape=box, style=rounded`},
+	{"{", "}", `shape=diamond`},
+}
+
+// flowchart statements that don't affect the structure of the graph
+var flowchartSkippedKeywords = []string{"classDef", "class", "style", "linkStyle", "click", "subgraph", "end", "direction"}
+
+type flowchartNode struct {
+	id    string
+	label string
+	attrs string
+}
+
+type flowchartEdge struct {
+	from, to string
+	label    string
+	attrs    string
+}
+
+type flowchart struct {
+	nodes []*flowchartNode
+	byID  map[string]*flowchartNode
+	edges []flowchartEdge
+}
+
+// flowchartToDot converts the body of the mermaid flowchart to the graphviz DOT source
+func flowchartToDot(direction string, lines []string) (string, error) {
+	fc := &flowchart{byID: map[string]*flowchartNode{}}
+	for i, line := range lines {
+		for _, stmt := range strings.Split(line, ";") {
+			if err := fc.parseStatement(strings.TrimSpace(stmt)); err != nil {
+				return "", fmt.Errorf("line %d: %w", i+2, err)
+			}
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString("digraph G {\n")
+	fmt.Fprintf(&b, "  rankdir=%s;\n", flowchartRankDir(direction))
+	b.WriteString("  node [fontname=\"Helvetica\", shape=box];\n")
+	b.WriteString("  edge [fontname=\"Helvetica\"];\n")
+	for _, n := range fc.nodes {
+		fmt.Fprintf(&b, "  %s [label=%s", dotString(n.id), dotString(n.label))
+		if n.attrs != "" {
+			fmt.Fprintf(&b, ", %s", n.attrs)
+		}
+		b.WriteString("];\n")
+	}
+	for _, e := range fc.edges {
+		fmt.Fprintf(&b, "  %s -> %s [%s", dotString(e.from), dotString(e.to), e.attrs)
+		if e.label != "" {
+			fmt.Fprintf(&b, ", label=%s", dotString(e.label))
+		}
+		b.WriteString("];\n")
+	}
+	b.WriteString("}\n")
+	return b.String(), nil
+}
+
+func (fc *flowchart) parseStatement(stmt string) error {
+	if stmt == "" || strings.HasPrefix(stmt, "%%") {
+		return nil
+	}
+	keyword, _, _ := strings.Cut(stmt, " ")
+	for _, k := range flowchartSkippedKeywords {
+		if keyword == k {
+			return nil
+		}
+	}
+
+	from, rest, err := fc.parseNodes(stmt)
+	if err != nil {
+		return err
+	}
+	for strings.TrimSpace(rest) != "" {
+		var (
+			label, attrs string
+			ok           bool
+		)
+		label, attrs, rest, ok = parseFlowchartEdge(rest)
+		if !ok {
+			return fmt.Errorf("unexpected %q", strings.TrimSpace(rest))
+		}
+		var to []string
+		if to, rest, err = fc.parseNodes(rest); err != nil {
+			return err
+		}
+		for _, f := range from {
+			for _, t := range to {
+				fc.edges = append(fc.edges, flowchartEdge{from: f, to: t, label: label, attrs: attrs})
+			}
+		}
+		from = to
+	}
+	return nil
+}
+
+// parseNodes parses the list of nodes joined with "&"
+func (fc *flowchart) parseNodes(s string) (ids []string, rest string, err error) {
+	for {
+		var id string
+		if id, s, err = fc.parseNode(s); err != nil {
+			return nil, "", err
+		}
+		ids = append(ids, id)
+		trimmed := strings.TrimSpace(s)
+		if !strings.HasPrefix(trimmed, "&") {
+			return ids, s, nil
+		}
+		s = trimmed[1:]
+	}
+}
+
+func (fc *flowchart) parseNode(s string) (id string, rest string, err error) {
+	m := nodeIDRe.FindStringSubmatch(s)
+	if m == nil {
+		return "", "", fmt.Errorf("node expected at %q", strings.TrimSpace(s))
+	}
+	id, rest = m[1], s[len(m[0]):]
+
+	n := fc.byID[id]
+	if n == nil {
+		n = &flowchartNode{id: id, label: id}
+		fc.byID[id] = n
+		fc.nodes = append(fc.nodes, n)
+	}
+	for _, shape := range flowchartShapes {
+		if !strings.HasPrefix(rest, shape.open) {
+			continue
+		}
+		end := strings.Index(rest[len(shape.open):], shape.close)
+		if end < 0 {
+			return "", "", fmt.Errorf("node %s: %q is not closed", id, shape.open)
+		}
+		n.label = flowchartText(rest[len(shape.open) : len(shape.open)+end])
+		n.attrs = shape.attrs
+		rest = rest[len(shape.open)+end+len(shape.close):]
+		break
+	}
+	return id, rest, nil
+}
+
+func parseFlowchartEdge(s string) (label, attrs, rest string, ok bool) {
+	var line, arrow, back string
+	if m := labeledEdgeRe.FindStringSubmatch(s); m != nil {
+		back, line, label, arrow = m[1], m[2], m[3], m[5]
+		rest = s[len(m[0]):]
+	} else if m = edgeRe.FindStringSubmatch(s); m != nil {
+		back, line, arrow, label = m[1], m[2], m[3], m[4]
+		rest = s[len(m[0]):]
+	} else {
+		return "", "", s, false
+	}
+
+	var a []string
+	switch {
+	case strings.Contains(line, "."):
+		a = append(a, "style=dashed")
+	case strings.HasPrefix(line, "="):
+		a = append(a, "penwidth=2")
+	}
+	switch arrow {
+	case ">":
+		a = append(a, "arrowhead=normal")
+	case "o":
+		a = append(a, "arrowhead=odot")
+	case "x":
+		a = append(a, "arrowhead=tee")
+	default:
+		a = append(a, "arrowhead=none")
+	}
+	if back != "" {
+		a = append(a, "dir=both", "arrowtail=normal")
+	}
+	return flowchartText(label), strings.Join(a, ", "), rest, true
+}
+
+func flowchartRankDir(direction string) string {
+	switch direction {
+	case "LR", "RL", "BT":
+		return direction
+	default:
+		return "TB"
+	}
+}
+
+// flowchartText removes quotes around the text and replaces html line breaks
+func flowchartText(s string) string {
+	s = strings.TrimSpace(s)
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		s = s[1 : len(s)-1]
+	}
+	for _, br := range []string{"<br/>", "<br />", "<br>"} {
+		s = strings.ReplaceAll(s, br, "\n")
+	}
+	return s
+}
+
+func dotString(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	return `"` + s + `"`
+}
@@ -0,0 +1,63 @@
+package diagram
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlowchartToDot(t *testing.T) {
+	t.Run("nodes, shapes and edges", func(t *testing.T) {
+		dot, err := flowchartToDot("LR", []string{
+			"",
+			"  A[Start] --> B{Is it?}",
+			"  B -->|Yes| C((OK))",
+			"  B -- \"No way\" --> D([End])",
+			"  %% comment",
+			"  classDef green fill:#9f6",
+			"  C -.-> D; A ==> D",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, `digraph G {
+  rankdir=LR;
+  node [fontname="Helvetica", shape=box];
+  edge [fontname="Helvetica"];
+  "A" [label="Start", shape=box];
+  "B" [label="Is it?", shape=diamond];
+  "C" [label="OK", shape=circle];
+  "D" [label="End", shape=box, style=rounded];
+  "A" -> "B" [arrowhead=normal];
+  "B" -> "C" [arrowhead=normal, label="Yes"];
+  "B" -> "D" [arrowhead=normal, label="No way"];
+  "C" -> "D" [style=dashed, arrowhead=normal];
+  "A" -> "D" [penwidth=2, arrowhead=normal];
+}
+`, dot)
+	})
+
+	t.Run("chains and node lists", func(t *testing.T) {
+		dot, err := flowchartToDot("", []string{"a & b --- c --> d"})
+		require.NoError(t, err)
+		assert.Contains(t, dot, "rankdir=TB;")
+		assert.Contains(t, dot, `"a" -> "c" [arrowhead=none];`)
+		assert.Contains(t, dot, `"b" -> "c" [arrowhead=none];`)
+		assert.Contains(t, dot, `"c" -> "d" [arrowhead=normal];`)
+	})
+
+	t.Run("quotes and line breaks in labels", func(t *testing.T) {
+		dot, err := flowchartToDot("TD", []string{`A["say<br>hi"]`})
+		require.NoError(t, err)
+		assert.Contains(t, dot, `"A" [label="say\nhi", shape=box];`)
+	})
+
+	t.Run("invalid statement", func(t *testing.T) {
+		_, err := flowchartToDot("TD", []string{"A --> B", "A ~~ B"})
+		assert.EqualError(t, err, `line 3: unexpected "~~ B"`)
+	})
+
+	t.Run("unclosed shape", func(t *testing.T) {
+		_, err := flowchartToDot("TD", []string{"A[Start --> B"})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,39 @@
+//go:build !gomobile && !windows && !nographviz && cgo
+// +build !gomobile,!windows,!nographviz,cgo
+
+package diagram
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+
+	"github.com/goccy/go-graphviz"
+)
+
+// graphviz library keeps the global state, so graphs are rendered one by one
+var graphvizMu sync.Mutex
+
+func renderDot(source []byte) ([]byte, error) {
+	graphvizMu.Lock()
+	defer graphvizMu.Unlock()
+
+	graph, err := graphviz.ParseBytes(source)
+	if err != nil {
+		return nil, fmt.Errorf("parse dot: %w", err)
+	}
+	defer graph.Close()
+
+	g := graphviz.New()
+	defer g.Close()
+	var buf bytes.Buffer
+	if err = g.Render(graph, graphviz.SVG, &buf); err != nil {
+		return nil, fmt.Errorf("render dot: %w", err)
+	}
+	// skip xml declaration and doctype, so the svg can be embedded into html
+	svg := buf.Bytes()
+	if i := bytes.Index(svg, []byte("<svg")); i > 0 {
+		svg = svg[i:]
+	}
+	return svg, nil
+}
@@ -0,0 +1,8 @@
+//go:build gomobile || windows || nographviz || ignore || !cgo
+// +build gomobile windows nographviz ignore !cgo
+
+package diagram
+
+func renderDot([]byte) ([]byte, error) {
+	return nil, ErrNotSupported
+}
@@ -0,0 +1,30 @@
+//go:build !gomobile && !windows && !nographviz && cgo
+// +build !gomobile,!windows,!nographviz,cgo
+
+package diagram
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+func TestRender_Graphviz(t *testing.T) {
+	t.Run("dot", func(t *testing.T) {
+		svg, err := Render(model.BlockContentDiagram_Graphviz, `digraph { a -> b }`)
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(string(svg), "<svg"))
+		assert.Contains(t, string(svg), ">a</text>")
+	})
+
+	t.Run("mermaid flowchart", func(t *testing.T) {
+		svg, err := Render(model.BlockContentDiagram_Mermaid, "graph TD; A[Start] --> B[Stop]")
+		require.NoError(t, err)
+		assert.Contains(t, string(svg), ">Start</text>")
+		assert.Contains(t, string(svg), ">Stop</text>")
+	})
+}
@@ -0,0 +1,107 @@
+package diagram
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+var (
+	plantParticipantRe = regexp.MustCompile(`^(participant|actor|boundary|control|entity|database|collections|queue)\s+("[^"]+"|\S+)(?:\s+as\s+("[^"]+"|\S+))?(?:\s+#\S+)?$`)
+	plantMessageRe     = regexp.MustCompile(`^("[^"]+"|[^\s<>:-]+)\s*(x?<<?--?|--?>>?(?:x\s)?|--?[\\/])\s*("[^"]+"|[^\s:]+)\s*(?::\s*(.*))?$`)
+	plantNoteRe        = regexp.MustCompile(`(?i)^note\s+(left of|right of|over)\s+([^:]+?)\s*:\s*(.*)$`)
+)
+
+// plantuml statements that don't affect the rendering
+var plantSkippedKeywords = []string{
+	"@startuml", "@enduml", "autonumber", "activate", "deactivate", "destroy", "title", "skinparam", "hide", "show",
+	"loop", "alt", "else", "opt", "par", "group", "critical", "break", "end", "...", "|||",
+}
+
+// renderPlantUML renders the PlantUML sequence diagram to SVG, other kinds of PlantUML diagrams aren't supported
+func renderPlantUML(source string) ([]byte, error) {
+	sq, err := parsePlantUMLSequence(strings.Split(source, "\n"))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrNotSupported, err)
+	}
+	return drawSequence(sq), nil
+}
+
+func parsePlantUMLSequence(lines []string) (*sequence, error) {
+	sq := &sequence{labels: map[string]string{}, indexes: map[string]int{}}
+	for i, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "'") {
+			continue
+		}
+		keyword, _, _ := strings.Cut(line, " ")
+		if lo.Contains(plantSkippedKeywords, strings.ToLower(keyword)) || strings.HasPrefix(line, "==") {
+			continue
+		}
+
+		if m := plantParticipantRe.FindStringSubmatch(line); m != nil {
+			// the alias is the unquoted name: `participant "Long name" as L` and `participant Long as L` are both L
+			id, label := m[2], m[3]
+			if label != "" && !strings.HasPrefix(label, `"`) {
+				id, label = label, id
+			}
+			id = strings.Trim(id, `"`)
+			sq.participant(id)
+			if label != "" {
+				sq.labels[id] = plantText(strings.Trim(label, `"`))
+			}
+			continue
+		}
+		if m := plantMessageRe.FindStringSubmatch(line); m != nil {
+			from, to, arrow := strings.Trim(m[1], `"`), strings.Trim(m[3], `"`), strings.TrimSpace(m[2])
+			// the arrow pointing left is drawn from its right side
+			if strings.Contains(arrow, "<") || strings.HasPrefix(arrow, "x") {
+				from, to = to, from
+			}
+			sq.events = append(sq.events, sequenceEvent{
+				kind:   sequenceMessage,
+				from:   sq.participant(from),
+				to:     sq.participant(to),
+				text:   plantText(m[4]),
+				dashed: strings.Contains(arrow, "--"),
+				arrow:  plantArrowHead(arrow),
+			})
+			continue
+		}
+		if m := plantNoteRe.FindStringSubmatch(line); m != nil {
+			from, to, _ := strings.Cut(m[2], ",")
+			ev := sequenceEvent{kind: sequenceNote, position: strings.ToLower(m[1]), text: plantText(m[3])}
+			ev.from = sq.participant(strings.TrimSpace(from))
+			ev.to = ev.from
+			if to != "" {
+				ev.to = sq.participant(strings.TrimSpace(to))
+			}
+			sq.events = append(sq.events, ev)
+			continue
+		}
+		return nil, fmt.Errorf("line %d: unexpected %q", i+1, line)
+	}
+	if len(sq.ids) == 0 {
+		return nil, fmt.Errorf("no participants in the sequence diagram")
+	}
+	return sq, nil
+}
+
+// plantArrowHead converts the head of the PlantUML arrow to the one of the mermaid sequence
+func plantArrowHead(arrow string) string {
+	switch {
+	case strings.Contains(arrow, "x"):
+		return "x"
+	case strings.Contains(arrow, ">>"), strings.Contains(arrow, "<<"),
+		strings.Contains(arrow, `\`), strings.Contains(arrow, "/"):
+		return ")"
+	default:
+		return ">>"
+	}
+}
+
+func plantText(s string) string {
+	return strings.ReplaceAll(strings.TrimSpace(s), `\n`, "\n")
+}
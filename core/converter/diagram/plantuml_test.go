@@ -0,0 +1,52 @@
+package diagram
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+func TestParsePlantUMLSequence(t *testing.T) {
+	sq, err := parsePlantUMLSequence([]string{
+		"@startuml",
+		"' comment",
+		"participant \"Long name\" as L",
+		"actor Bob as B #red",
+		"autonumber",
+		"L -> B : Hello",
+		"alt ok",
+		"B --> L : Hi",
+		"end",
+		"L <- C",
+		"L ->x xavier",
+		"note left of L : Think",
+		"@enduml",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"L", "B", "C", "xavier"}, sq.ids)
+	assert.Equal(t, "Long name", sq.label(0))
+	assert.Equal(t, "Bob", sq.label(1))
+	assert.Equal(t, []sequenceEvent{
+		{kind: sequenceMessage, from: 0, to: 1, text: "Hello", arrow: ">>"},
+		{kind: sequenceMessage, from: 1, to: 0, text: "Hi", dashed: true, arrow: ">>"},
+		{kind: sequenceMessage, from: 2, to: 0, arrow: ">>"},
+		{kind: sequenceMessage, from: 0, to: 3, arrow: "x"},
+		{kind: sequenceNote, from: 0, to: 0, text: "Think", position: "left of"},
+	}, sq.events)
+}
+
+func TestRender_PlantUML(t *testing.T) {
+	svg, err := Render(model.BlockContentDiagram_PlantUML, "@startuml\nAlice -> Bob : Hello <Bob>\n@enduml")
+	require.NoError(t, err)
+	s := string(svg)
+	assert.True(t, strings.HasPrefix(s, "<svg "))
+	assert.Contains(t, s, ">Alice</text>")
+	assert.Contains(t, s, ">Hello &lt;Bob&gt;</text>")
+
+	_, err = Render(model.BlockContentDiagram_PlantUML, "@startuml\nclass A\n@enduml")
+	assert.ErrorIs(t, err, ErrNotSupported)
+}
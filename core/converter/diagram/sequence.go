@@ -0,0 +1,246 @@
+package diagram
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/samber/lo"
+)
+
+var (
+	participantRe = regexp.MustCompile(`^(participant|actor)\s+(\S+)(?:\s+as\s+(.+))?$`)
+	messageRe     = regexp.MustCompile(`^([^\s:>+-]+)\s*(-->>|->>|-->|->|--x|-x|--\)|-\))\s*[+-]?\s*([^\s:]+)\s*:\s*(.*)$`)
+	noteRe        = regexp.MustCompile(`(?i)^note\s+(left of|right of|over)\s+([^:]+?)\s*:\s*(.*)$`)
+)
+
+// sequence statements that don't affect the rendering
+var sequenceSkippedKeywords = []string{
+	"autonumber", "activate", "deactivate", "title",
+	"loop", "alt", "else", "opt", "par", "and", "rect", "critical", "break", "end",
+}
+
+const (
+	seqMargin         = 20
+	seqBoxHeight      = 36
+	seqMinColumnWidth = 150
+	seqRowHeight      = 40
+	seqSelfRowHeight  = 60
+	seqCharWidth      = 7
+	seqNoteWidth      = 120
+)
+
+type sequenceEventKind int
+
+const (
+	sequenceMessage sequenceEventKind = iota
+	sequenceNote
+)
+
+type sequenceEvent struct {
+	kind     sequenceEventKind
+	from, to int
+	text     string
+	dashed   bool
+	arrow    string
+	position string
+}
+
+type sequence struct {
+	ids     []string
+	labels  map[string]string
+	indexes map[string]int
+	events  []sequenceEvent
+}
+
+func (sq *sequence) participant(id string) int {
+	if i, ok := sq.indexes[id]; ok {
+		return i
+	}
+	sq.indexes[id] = len(sq.ids)
+	sq.ids = append(sq.ids, id)
+	return len(sq.ids) - 1
+}
+
+func parseSequence(lines []string) (*sequence, error) {
+	sq := &sequence{labels: map[string]string{}, indexes: map[string]int{}}
+	for i, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "%%") {
+			continue
+		}
+		keyword, _, _ := strings.Cut(line, " ")
+		if lo.Contains(sequenceSkippedKeywords, strings.ToLower(keyword)) {
+			continue
+		}
+
+		if m := participantRe.FindStringSubmatch(line); m != nil {
+			sq.participant(m[2])
+			if m[3] != "" {
+				sq.labels[m[2]] = strings.TrimSpace(m[3])
+			}
+			continue
+		}
+		if m := messageRe.FindStringSubmatch(line); m != nil {
+			arrow := strings.TrimLeft(m[2], "-")
+			sq.events = append(sq.events, sequenceEvent{
+				kind:   sequenceMessage,
+				from:   sq.participant(m[1]),
+				to:     sq.participant(m[3]),
+				text:   flowchartText(m[4]),
+				dashed: strings.HasPrefix(m[2], "--"),
+				arrow:  arrow,
+			})
+			continue
+		}
+		if m := noteRe.FindStringSubmatch(line); m != nil {
+			from, to, _ := strings.Cut(m[2], ",")
+			ev := sequenceEvent{kind: sequenceNote, position: strings.ToLower(m[1]), text: flowchartText(m[3])}
+			ev.from = sq.participant(strings.TrimSpace(from))
+			ev.to = ev.from
+			if to != "" {
+				ev.to = sq.participant(strings.TrimSpace(to))
+			}
+			sq.events = append(sq.events, ev)
+			continue
+		}
+		return nil, fmt.Errorf("line %d: unexpected %q", i+2, line)
+	}
+	if len(sq.ids) == 0 {
+		return nil, fmt.Errorf("no participants in the sequence diagram")
+	}
+	return sq, nil
+}
+
+func (sq *sequence) label(i int) string {
+	if l, ok := sq.labels[sq.ids[i]]; ok {
+		return l
+	}
+	return sq.ids[i]
+}
+
+// renderSequence renders the body of the mermaid sequence diagram to SVG
+func renderSequence(lines []string) ([]byte, error) {
+	sq, err := parseSequence(lines)
+	if err != nil {
+		return nil, err
+	}
+	return drawSequence(sq), nil
+}
+
+func drawSequence(sq *sequence) []byte {
+	columnWidth := seqMinColumnWidth
+	for i := range sq.ids {
+		columnWidth = max(columnWidth, textWidth(sq.label(i))+40)
+	}
+	for _, ev := range sq.events {
+		if ev.kind == sequenceMessage {
+			columnWidth = max(columnWidth, textWidth(ev.text)+40)
+		}
+	}
+	x := func(i int) int {
+		return seqMargin + columnWidth/2 + i*columnWidth
+	}
+
+	var body bytes.Buffer
+	y := seqMargin + seqBoxHeight + seqRowHeight
+	for _, ev := range sq.events {
+		switch {
+		case ev.kind == sequenceNote:
+			left, right := x(min(ev.from, ev.to))-seqNoteWidth/2, x(max(ev.from, ev.to))+seqNoteWidth/2
+			switch ev.position {
+			case "left of":
+				left, right = x(ev.from)-seqNoteWidth-10, x(ev.from)-10
+			case "right of":
+				left, right = x(ev.from)+10, x(ev.from)+seqNoteWidth+10
+			}
+			fmt.Fprintf(&body, `<rect x="%d" y="%d" width="%d" height="%d" fill="#fff5ad" stroke="#aaaa33"/>`+"\n",
+				left, y-seqRowHeight/2-4, right-left, seqRowHeight-8)
+			writeSVGText(&body, (left+right)/2, y+4, ev.text)
+			y += seqRowHeight
+		case ev.from == ev.to:
+			x0 := x(ev.from)
+			fmt.Fprintf(&body, `<path d="M %d %d H %d V %d H %d" fill="none" stroke="#333"%s%s/>`+"\n",
+				x0, y, x0+40, y+20, x0, dashAttr(ev.dashed), markerAttr(ev.arrow))
+			writeSVGText(&body, x0+columnWidth/4+20, y-6, ev.text)
+			y += seqSelfRowHeight
+		default:
+			fmt.Fprintf(&body, `<line x1="%d" y1="%d" x2="%d" y2="%d" stroke="#333"%s%s/>`+"\n",
+				x(ev.from), y, x(ev.to), y, dashAttr(ev.dashed), markerAttr(ev.arrow))
+			writeSVGText(&body, (x(ev.from)+x(ev.to))/2, y-6, ev.text)
+			y += seqRowHeight
+		}
+	}
+	bottom := y - seqRowHeight/2
+
+	var buf bytes.Buffer
+	width := 2*seqMargin + len(sq.ids)*columnWidth
+	height := bottom + seqBoxHeight + seqMargin
+	fmt.Fprintf(&buf, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="Helvetica, Arial, sans-serif" font-size="13">`+"\n",
+		width, height, width, height)
+	buf.WriteString(`<defs>` +
+		`<marker id="arrow" viewBox="0 0 10 10" refX="10" refY="5" markerWidth="8" markerHeight="8" orient="auto"><path d="M 0 0 L 10 5 L 0 10 z" fill="#333"/></marker>` +
+		`<marker id="open" viewBox="0 0 10 10" refX="10" refY="5" markerWidth="8" markerHeight="8" orient="auto"><path d="M 0 0 L 10 5 L 0 10" fill="none" stroke="#333"/></marker>` +
+		`<marker id="cross" viewBox="0 0 10 10" refX="5" refY="5" markerWidth="8" markerHeight="8" orient="auto"><path d="M 0 0 L 10 10 M 0 10 L 10 0" stroke="#333"/></marker>` +
+		"</defs>\n")
+	for i := range sq.ids {
+		fmt.Fprintf(&buf, `<line x1="%d" y1="%d" x2="%d" y2="%d" stroke="#999" stroke-dasharray="3,3"/>`+"\n",
+			x(i), seqMargin+seqBoxHeight, x(i), bottom)
+		for _, top := range []int{seqMargin, bottom} {
+			fmt.Fprintf(&buf, `<rect x="%d" y="%d" width="%d" height="%d" rx="3" fill="#eaeaff" stroke="#9370db"/>`+"\n",
+				x(i)-columnWidth/2+10, top, columnWidth-20, seqBoxHeight)
+			writeSVGText(&buf, x(i), top+seqBoxHeight/2+5, sq.label(i))
+		}
+	}
+	buf.Write(body.Bytes())
+	buf.WriteString("</svg>\n")
+	return buf.Bytes()
+}
+
+func writeSVGText(buf *bytes.Buffer, x, y int, text string) {
+	if text == "" {
+		return
+	}
+	fmt.Fprintf(buf, `<text x="%d" y="%d" text-anchor="middle">%s</text>`+"\n", x, y, html.EscapeString(text))
+}
+
+func dashAttr(dashed bool) string {
+	if dashed {
+		return ` stroke-dasharray="5,3"`
+	}
+	return ""
+}
+
+func markerAttr(arrow string) string {
+	switch arrow {
+	case ">>":
+		return ` marker-end="url(#arrow)"`
+	case ")":
+		return ` marker-end="url(#open)"`
+	case "x":
+		return ` marker-end="url(#cross)"`
+	default:
+		return ""
+	}
+}
+
+func textWidth(s string) int {
+	return utf8.RuneCountInString(s) * seqCharWidth
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,51 @@
+package diagram
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSequence(t *testing.T) {
+	sq, err := parseSequence([]string{
+		"participant A as Alice",
+		"actor B",
+		"autonumber",
+		"A->>+B: Hello <Bob>",
+		"loop Every minute",
+		"B-->>-A: Hi",
+		"end",
+		"A-xA: Think",
+		"Note over A,C: Shared",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"A", "B", "C"}, sq.ids)
+	assert.Equal(t, "Alice", sq.label(0))
+	assert.Equal(t, "B", sq.label(1))
+	assert.Equal(t, []sequenceEvent{
+		{kind: sequenceMessage, from: 0, to: 1, text: "Hello <Bob>", arrow: ">>"},
+		{kind: sequenceMessage, from: 1, to: 0, text: "Hi", dashed: true, arrow: ">>"},
+		{kind: sequenceMessage, from: 0, to: 0, text: "Think", arrow: "x"},
+		{kind: sequenceNote, from: 0, to: 2, text: "Shared", position: "over"},
+	}, sq.events)
+
+	_, err = parseSequence([]string{"A = B"})
+	assert.Error(t, err)
+}
+
+func TestRender_MermaidSequence(t *testing.T) {
+	svg, err := renderMermaid("%% greeting\nsequenceDiagram\n  Alice->>Bob: Hello <Bob>\n  Bob-->>Alice: Hi")
+	require.NoError(t, err)
+	s := string(svg)
+	assert.True(t, strings.HasPrefix(s, "<svg "))
+	assert.Contains(t, s, ">Alice</text>")
+	assert.Contains(t, s, ">Hello &lt;Bob&gt;</text>")
+	assert.Contains(t, s, `stroke-dasharray="5,3" marker-end="url(#arrow)"`)
+}
+
+func TestRender_NotSupported(t *testing.T) {
+	_, err := renderMermaid("pie title Pets\n\"Dogs\" : 386")
+	assert.ErrorIs(t, err, ErrNotSupported)
+}
@@ -0,0 +1,644 @@
+package docx
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	"image/png"
+	"io"
+	"sort"
+	"strconv"
+
+	"github.com/gogo/protobuf/types"
+	_ "golang.org/x/image/webp"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/editor/table"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/converter"
+	"github.com/anyproto/anytype-heart/core/files"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+	utf16 "github.com/anyproto/anytype-heart/util/text"
+)
+
+var logger = logging.Logger("docx-export")
+
+const (
+	// listIndent is the indent of the nesting level in twentieths of a point
+	listIndent = 720
+	// contentWidth is the width of A4 page without margins in twentieths of a point
+	contentWidth = 9638
+	// pixels are converted with 96 dpi
+	twipsPerPixel = 15
+	emuPerPixel   = 9525
+	emuPerTwip    = 635
+	// default width of the table column in Anytype, in pixels
+	defaultColumnWidth = 140
+
+	headerCellFill = "F3F2EC"
+	dividerColor   = "DFDDD0"
+)
+
+func NewConverter(fileService files.Service, s *state.State) converter.Converter {
+	return &DOCX{fileService: fileService, s: s}
+}
+
+type DOCX struct {
+	s           *state.State
+	fileService files.Service
+	knownDocs   map[string]*types.Struct
+
+	buf   *bytes.Buffer
+	rels  []relationship
+	links map[string]string
+	media []media
+	// numberedLists is the number of numbered lists, every list has its own numbering instance
+	numberedLists int
+	// lastIsTable is set when the table is the last written element, Word expects the paragraph after it
+	lastIsTable bool
+}
+
+type relationship struct {
+	id, typ, target string
+	external        bool
+}
+
+type media struct {
+	name string
+	data []byte
+}
+
+func (d *DOCX) Convert(model.SmartBlockType) []byte {
+	result, err := d.convert()
+	if err != nil {
+		logger.Errorf("convert %s to docx: %v", d.s.RootId(), err)
+		return nil
+	}
+	return result
+}
+
+func (d *DOCX) convert() ([]byte, error) {
+	d.buf = bytes.NewBuffer(nil)
+	d.links = make(map[string]string)
+	d.rels = []relationship{
+		{id: "rId1", typ: relTypeStyles, target: "styles.xml"},
+		{id: "rId2", typ: relTypeNumbering, target: "numbering.xml"},
+	}
+	d.buf.WriteString(documentStart)
+	if root := d.s.Pick(d.s.RootId()); root != nil {
+		d.renderChildren(root.Model(), 0)
+	}
+	if d.lastIsTable {
+		d.buf.WriteString("<w:p/>")
+	}
+	d.buf.WriteString(documentEnd)
+
+	title := bytes.NewBuffer(nil)
+	if err := xml.EscapeText(title, []byte(pbtypes.GetString(d.s.Details(), bundle.RelationKeyName.String()))); err != nil {
+		return nil, err
+	}
+	parts := []media{
+		{name: "[Content_Types].xml", data: []byte(contentTypesXML)},
+		{name: "_rels/.rels", data: []byte(rootRelsXML)},
+		{name: "docProps/core.xml", data: []byte(fmt.Sprintf(corePropertiesXML, title.String()))},
+		{name: "word/document.xml", data: d.buf.Bytes()},
+		{name: "word/_rels/document.xml.rels", data: d.relationshipsXML()},
+		{name: "word/styles.xml", data: []byte(stylesXML)},
+		{name: "word/numbering.xml", data: numberingXML(d.numberedLists)},
+	}
+	parts = append(parts, d.media...)
+
+	out := bytes.NewBuffer(nil)
+	zw := zip.NewWriter(out)
+	for _, p := range parts {
+		w, err := zw.Create(p.name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err = w.Write(p.data); err != nil {
+			return nil, err
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+func (d *DOCX) relationshipsXML() []byte {
+	b := bytes.NewBuffer(nil)
+	b.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n")
+	b.WriteString(`<Relationships xmlns="` + nsPackageRels + `">` + "\n")
+	for _, r := range d.rels {
+		fmt.Fprintf(b, `<Relationship Id="%s" Type="%s" Target="`, r.id, r.typ)
+		xml.EscapeText(b, []byte(r.target)) //nolint:errcheck
+		b.WriteString(`"`)
+		if r.external {
+			b.WriteString(` TargetMode="External"`)
+		}
+		b.WriteString("/>\n")
+	}
+	b.WriteString("</Relationships>")
+	return b.Bytes()
+}
+
+func (d *DOCX) addRelationship(typ, target string, external bool) string {
+	id := "rId" + strconv.Itoa(len(d.rels)+1)
+	d.rels = append(d.rels, relationship{id: id, typ: typ, target: target, external: external})
+	return id
+}
+
+func (d *DOCX) SetKnownDocs(docs map[string]*types.Struct) converter.Converter {
+	d.knownDocs = docs
+	return d
+}
+
+func (d *DOCX) FileHashes() []string {
+	return nil
+}
+
+func (d *DOCX) ImageHashes() []string {
+	return nil
+}
+
+func (d *DOCX) Ext() string {
+	return ".docx"
+}
+
+func (d *DOCX) renderChildren(parent *model.Block, depth int) {
+	var numID int
+	for _, id := range parent.ChildrenIds {
+		b := d.s.Pick(id)
+		if b == nil {
+			continue
+		}
+		m := b.Model()
+		if m.GetText().GetStyle() == model.BlockContentText_Numbered {
+			if numID == 0 {
+				d.numberedLists++
+				numID = bulletNumID + d.numberedLists
+			}
+		} else {
+			numID = 0
+		}
+		d.render(m, depth, numID)
+	}
+}
+
+func (d *DOCX) render(b *model.Block, depth int, numID int) {
+	switch content := b.Content.(type) {
+	case *model.BlockContentOfText:
+		d.renderText(b, depth, numID)
+		return
+	case *model.BlockContentOfFile:
+		d.renderFile(b, depth)
+	case *model.BlockContentOfBookmark:
+		d.renderBookmark(content.Bookmark, depth)
+	case *model.BlockContentOfLink:
+		d.renderLink(content.Link, depth)
+	case *model.BlockContentOfDiv:
+		d.renderDiv(content.Div, depth)
+	case *model.BlockContentOfLatex:
+		d.renderCode(content.Latex.Text, depth)
+	case *model.BlockContentOfDiagram:
+		d.renderCode(content.Diagram.Source, depth)
+	case *model.BlockContentOfTable:
+		d.renderTable(b)
+		return
+	case *model.BlockContentOfDataview, *model.BlockContentOfFeaturedRelations:
+		return
+	}
+	d.renderChildren(b, depth)
+}
+
+type paragraphProps struct {
+	style string
+	// numID is set for the list items, the level of the item is the depth
+	numID  int
+	depth  int
+	border bool
+	center bool
+}
+
+func (d *DOCX) startParagraph(p paragraphProps) {
+	d.lastIsTable = false
+	d.buf.WriteString("<w:p><w:pPr>")
+	if p.style != "" {
+		fmt.Fprintf(d.buf, `<w:pStyle w:val="%s"/>`, p.style)
+	}
+	if p.numID > 0 {
+		level := p.depth
+		if level > maxListLevel {
+			level = maxListLevel
+		}
+		fmt.Fprintf(d.buf, `<w:numPr><w:ilvl w:val="%d"/><w:numId w:val="%d"/></w:numPr>`, level, p.numID)
+	}
+	if p.border {
+		fmt.Fprintf(d.buf, `<w:pBdr><w:bottom w:val="single" w:sz="6" w:space="1" w:color="%s"/></w:pBdr>`, dividerColor)
+	}
+	if p.numID == 0 && p.depth > 0 {
+		fmt.Fprintf(d.buf, `<w:ind w:left="%d"/>`, p.depth*listIndent)
+	}
+	if p.center {
+		d.buf.WriteString(`<w:jc w:val="center"/>`)
+	}
+	d.buf.WriteString("</w:pPr>")
+}
+
+func (d *DOCX) endParagraph() {
+	d.buf.WriteString("</w:p>")
+}
+
+func (d *DOCX) renderText(b *model.Block, depth int, numID int) {
+	text := b.GetText()
+	p := paragraphProps{depth: depth}
+	var prefix string
+	switch text.Style {
+	case model.BlockContentText_Title:
+		p.style = "Title"
+	case model.BlockContentText_Header1:
+		p.style = "Heading1"
+	case model.BlockContentText_Header2:
+		p.style = "Heading2"
+	case model.BlockContentText_Header3:
+		p.style = "Heading3"
+	case model.BlockContentText_Header4:
+		p.style = "Heading4"
+	case model.BlockContentText_Description:
+		p.style = "Subtitle"
+	case model.BlockContentText_Quote:
+		p.style = "Quote"
+	case model.BlockContentText_Code:
+		p.style = "Code"
+	case model.BlockContentText_Callout:
+		p.style = "Callout"
+		if text.IconEmoji != "" {
+			prefix = text.IconEmoji + " "
+		}
+	case model.BlockContentText_Marked:
+		p.style, p.numID = "ListParagraph", bulletNumID
+	case model.BlockContentText_Numbered:
+		p.style, p.numID = "ListParagraph", numID
+	case model.BlockContentText_Checkbox:
+		prefix = "☐ "
+		if text.Checked {
+			prefix = "☒ "
+		}
+	}
+
+	d.startParagraph(p)
+	if prefix != "" {
+		d.writeRun(prefix, runFormat{})
+	}
+	d.writeRuns(text, runFormat{})
+	d.endParagraph()
+	d.renderChildren(b, depth+1)
+}
+
+type runFormat struct {
+	bold, italic, underline, strike, code bool
+	link                                  string
+}
+
+// writeRuns splits the text into runs by marks
+func (d *DOCX) writeRuns(text *model.BlockContentText, base runFormat) {
+	u := utf16.StrToUTF16(text.Text)
+	var marks []*model.BlockContentTextMark
+	bounds := []int{0, len(u)}
+	for _, m := range text.GetMarks().GetMarks() {
+		if m.Range == nil {
+			continue
+		}
+		from, to := clamp(int(m.Range.From), 0, len(u)), clamp(int(m.Range.To), 0, len(u))
+		if from >= to {
+			continue
+		}
+		marks = append(marks, m)
+		bounds = append(bounds, from, to)
+	}
+	sort.Ints(bounds)
+
+	for i := 0; i+1 < len(bounds); i++ {
+		from, to := bounds[i], bounds[i+1]
+		if from == to {
+			continue
+		}
+		f := base
+		for _, m := range marks {
+			if int(m.Range.From) <= from && int(m.Range.To) >= to {
+				applyMark(&f, m)
+			}
+		}
+		d.writeRun(utf16.UTF16ToStr(u[from:to]), f)
+	}
+}
+
+func applyMark(f *runFormat, m *model.BlockContentTextMark) {
+	switch m.Type {
+	case model.BlockContentTextMark_Bold:
+		f.bold = true
+	case model.BlockContentTextMark_Italic:
+		f.italic = true
+	case model.BlockContentTextMark_Strikethrough:
+		f.strike = true
+	case model.BlockContentTextMark_Underscored:
+		f.underline = true
+	case model.BlockContentTextMark_Keyboard:
+		f.code = true
+	case model.BlockContentTextMark_Link:
+		f.link = m.Param
+	}
+}
+
+func clamp(v, min, max int) int {
+	if v < min {
+		return min
+	}
+	if v > max {
+		return max
+	}
+	return v
+}
+
+func (d *DOCX) writeRun(text string, f runFormat) {
+	if f.link != "" {
+		id, ok := d.links[f.link]
+		if !ok {
+			id = d.addRelationship(relTypeHyperlink, f.link, true)
+			d.links[f.link] = id
+		}
+		fmt.Fprintf(d.buf, `<w:hyperlink r:id="%s" w:history="1">`, id)
+	}
+	d.buf.WriteString("<w:r><w:rPr>")
+	switch {
+	case f.link != "":
+		d.buf.WriteString(`<w:rStyle w:val="Hyperlink"/>`)
+	case f.code:
+		d.buf.WriteString(`<w:rStyle w:val="InlineCode"/>`)
+	}
+	if f.bold {
+		d.buf.WriteString("<w:b/>")
+	}
+	if f.italic {
+		d.buf.WriteString("<w:i/>")
+	}
+	if f.strike {
+		d.buf.WriteString("<w:strike/>")
+	}
+	if f.underline {
+		d.buf.WriteString(`<w:u w:val="single"/>`)
+	}
+	d.buf.WriteString("</w:rPr>")
+	d.writeText(text)
+	d.buf.WriteString("</w:r>")
+	if f.link != "" {
+		d.buf.WriteString("</w:hyperlink>")
+	}
+}
+
+// writeText writes the text of the run, line breaks and tabs are the separate elements
+func (d *DOCX) writeText(text string) {
+	start := 0
+	flush := func(end int) {
+		if end > start {
+			d.buf.WriteString(`<w:t xml:space="preserve">`)
+			xml.EscapeText(d.buf, []byte(text[start:end])) //nolint:errcheck
+			d.buf.WriteString("</w:t>")
+		}
+	}
+	for i, r := range text {
+		switch r {
+		case '\n':
+			flush(i)
+			d.buf.WriteString("<w:br/>")
+			start = i + 1
+		case '\t':
+			flush(i)
+			d.buf.WriteString("<w:tab/>")
+			start = i + 1
+		}
+	}
+	flush(len(text))
+}
+
+// renderCode writes the text with the monospace font, it's used for LaTeX and diagram sources
+func (d *DOCX) renderCode(text string, depth int) {
+	d.startParagraph(paragraphProps{style: "Code", depth: depth})
+	d.writeRun(text, runFormat{})
+	d.endParagraph()
+}
+
+func (d *DOCX) renderFile(b *model.Block, depth int) {
+	file := b.GetFile()
+	if file.State != model.BlockContentFile_Done {
+		return
+	}
+	if file.Type == model.BlockContentFile_Image {
+		err := d.renderImage(file.Hash, pbtypes.GetFloat64(b.Fields, "width"), depth)
+		if err == nil {
+			return
+		}
+		logger.With("hash", file.Hash).Warnf("can't embed image: %v", err)
+	}
+	d.startParagraph(paragraphProps{depth: depth})
+	d.writeRun(file.Name, runFormat{})
+	d.endParagraph()
+}
+
+// renderImage embeds the image scaled to the width fraction of the content or to its size,
+// the formats other than PNG, JPEG and GIF are converted to PNG
+func (d *DOCX) renderImage(hash string, widthFraction float64, depth int) error {
+	if d.fileService == nil {
+		return fmt.Errorf("file service is not available")
+	}
+	img, err := d.fileService.ImageByHash(context.TODO(), hash)
+	if err != nil {
+		return err
+	}
+	f, err := img.GetFileForWidth(context.TODO(), 1024)
+	if err != nil {
+		return err
+	}
+	rd, err := f.Reader(context.TODO())
+	if err != nil {
+		return err
+	}
+	data, err := io.ReadAll(rd)
+	if err != nil {
+		return err
+	}
+	return d.embedImage(data, widthFraction, depth)
+}
+
+func (d *DOCX) embedImage(data []byte, widthFraction float64, depth int) error {
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("decode image config: %w", err)
+	}
+	if cfg.Width == 0 || cfg.Height == 0 {
+		return fmt.Errorf("image is empty")
+	}
+	switch format {
+	case "png", "jpeg", "gif":
+	default:
+		decoded, _, err := image.Decode(bytes.NewReader(data))
+		if err != nil {
+			return fmt.Errorf("decode image: %w", err)
+		}
+		buf := bytes.NewBuffer(nil)
+		if err = png.Encode(buf, decoded); err != nil {
+			return fmt.Errorf("encode png: %w", err)
+		}
+		data, format = buf.Bytes(), "png"
+	}
+
+	name := fmt.Sprintf("image%d.%s", len(d.media)+1, format)
+	d.media = append(d.media, media{name: "word/media/" + name, data: data})
+	relID := d.addRelationship(relTypeImage, "media/"+name, false)
+
+	maxWidth := int64(contentWidth-depth*listIndent) * emuPerTwip
+	width := int64(cfg.Width) * emuPerPixel
+	if widthFraction > 0 {
+		width = int64(float64(maxWidth) * widthFraction)
+	}
+	if width > maxWidth {
+		width = maxWidth
+	}
+	height := width * int64(cfg.Height) / int64(cfg.Width)
+
+	d.startParagraph(paragraphProps{depth: depth})
+	fmt.Fprintf(d.buf, imageXML, width, height, len(d.media), name, relID)
+	d.endParagraph()
+	return nil
+}
+
+func (d *DOCX) renderBookmark(bm *model.BlockContentBookmark, depth int) {
+	if bm.Url == "" {
+		return
+	}
+	title := bm.Title
+	if title == "" {
+		title = bm.Url
+	}
+	d.startParagraph(paragraphProps{depth: depth})
+	d.writeRun(title, runFormat{bold: true, link: bm.Url})
+	if bm.Description != "" {
+		d.writeRun("\n"+bm.Description, runFormat{})
+	}
+	d.endParagraph()
+}
+
+func (d *DOCX) renderLink(l *model.BlockContentLink, depth int) {
+	title, ok := d.docTitle(l.TargetBlockId)
+	if !ok {
+		return
+	}
+	d.startParagraph(paragraphProps{depth: depth})
+	d.writeRun(title, runFormat{bold: true, underline: true})
+	d.endParagraph()
+}
+
+func (d *DOCX) docTitle(id string) (title string, ok bool) {
+	info, ok := d.knownDocs[id]
+	if !ok {
+		return "", false
+	}
+	title = pbtypes.GetString(info, bundle.RelationKeyName.String())
+	if title == "" {
+		title = pbtypes.GetString(info, bundle.RelationKeySnippet.String())
+	}
+	if title == "" {
+		title = id
+	}
+	return title, true
+}
+
+func (d *DOCX) renderDiv(div *model.BlockContentDiv, depth int) {
+	switch div.Style {
+	case model.BlockContentDiv_Line:
+		d.startParagraph(paragraphProps{depth: depth, border: true})
+	case model.BlockContentDiv_Dots:
+		d.startParagraph(paragraphProps{depth: depth, center: true})
+		d.writeRun("•   •   •", runFormat{})
+	default:
+		return
+	}
+	d.endParagraph()
+}
+
+func (d *DOCX) renderTable(b *model.Block) {
+	tb, err := table.NewTable(d.s, b.Id)
+	if err != nil {
+		return
+	}
+	colIDs := tb.ColumnIDs()
+	if len(colIDs) == 0 {
+		return
+	}
+	widths := make([]int, len(colIDs))
+	var total int
+	for i, colID := range colIDs {
+		w := float64(defaultColumnWidth)
+		if col := d.s.Pick(colID); col != nil {
+			if cw := pbtypes.GetFloat64(col.Model().GetFields(), "width"); cw > 0 {
+				w = cw
+			}
+		}
+		widths[i] = int(w * twipsPerPixel)
+		total += widths[i]
+	}
+	// wide tables are shrunk to the content
+	if total > contentWidth {
+		for i := range widths {
+			widths[i] = widths[i] * contentWidth / total
+		}
+		total = contentWidth
+	}
+
+	d.lastIsTable = true
+	fmt.Fprintf(d.buf, `<w:tbl><w:tblPr><w:tblStyle w:val="TableGrid"/><w:tblW w:w="%d" w:type="dxa"/><w:tblLook w:val="04A0"/></w:tblPr><w:tblGrid>`, total)
+	for _, w := range widths {
+		fmt.Fprintf(d.buf, `<w:gridCol w:w="%d"/>`, w)
+	}
+	d.buf.WriteString("</w:tblGrid>")
+	for _, rowID := range tb.RowIDs() {
+		row := d.s.Pick(rowID)
+		if row == nil {
+			continue
+		}
+		isHeader := row.Model().GetTableRow().GetIsHeader()
+		cells := make(map[string]simple.Block, len(row.Model().ChildrenIds))
+		for _, cellID := range row.Model().ChildrenIds {
+			if _, colID, err := table.ParseCellID(cellID); err == nil {
+				cells[colID] = d.s.Pick(cellID)
+			}
+		}
+		d.buf.WriteString("<w:tr>")
+		if isHeader {
+			d.buf.WriteString("<w:trPr><w:tblHeader/></w:trPr>")
+		}
+		for i, colID := range colIDs {
+			fmt.Fprintf(d.buf, `<w:tc><w:tcPr><w:tcW w:w="%d" w:type="dxa"/>`, widths[i])
+			if isHeader {
+				fmt.Fprintf(d.buf, `<w:shd w:val="clear" w:color="auto" w:fill="%s"/>`, headerCellFill)
+			}
+			d.buf.WriteString("</w:tcPr><w:p>")
+			if c := cells[colID]; c != nil {
+				if text := c.Model().GetText(); text != nil {
+					d.writeRuns(text, runFormat{bold: isHeader})
+				}
+			}
+			d.buf.WriteString("</w:p></w:tc>")
+		}
+		d.buf.WriteString("</w:tr>")
+	}
+	d.buf.WriteString("</w:tbl>")
+}
@@ -0,0 +1,155 @@
+package docx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"image"
+	"image/png"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/editor/table"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func newDoc(blocks ...*model.Block) *state.State {
+	root := &model.Block{Id: "root"}
+	m := map[string]simple.Block{}
+	for _, b := range blocks {
+		if !strings.HasPrefix(b.Id, "/") {
+			root.ChildrenIds = append(root.ChildrenIds, b.Id)
+		}
+		b.Id = strings.TrimPrefix(b.Id, "/")
+		m[b.Id] = simple.New(b)
+	}
+	m["root"] = simple.New(root)
+	s := state.NewDoc("root", m).(*state.State)
+	s.SetDetails(&types.Struct{Fields: map[string]*types.Value{bundle.RelationKeyName.String(): pbtypes.String("A & B")}})
+	return s
+}
+
+func textBlock(id string, style model.BlockContentTextStyle, text string) *model.Block {
+	return &model.Block{Id: id, Content: &model.BlockContentOfText{Text: &model.BlockContentText{Text: text, Style: style}}}
+}
+
+// unzip checks that all the parts are well-formed and returns them by names
+func unzip(t *testing.T, data []byte) map[string]string {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	parts := make(map[string]string, len(zr.File))
+	for _, f := range zr.File {
+		r, err := f.Open()
+		require.NoError(t, err)
+		content, err := io.ReadAll(r)
+		require.NoError(t, err)
+		parts[f.Name] = string(content)
+		if strings.HasSuffix(f.Name, ".xml") || strings.HasSuffix(f.Name, ".rels") {
+			dec := xml.NewDecoder(bytes.NewReader(content))
+			for {
+				_, err = dec.Token()
+				if err == io.EOF {
+					break
+				}
+				require.NoError(t, err, f.Name)
+			}
+		}
+	}
+	return parts
+}
+
+func TestDOCX_Convert(t *testing.T) {
+	t.Run("package parts", func(t *testing.T) {
+		parts := unzip(t, NewConverter(nil, newDoc()).Convert(model.SmartBlockType_Page))
+		for _, name := range []string{"[Content_Types].xml", "_rels/.rels", "docProps/core.xml", "word/document.xml",
+			"word/_rels/document.xml.rels", "word/styles.xml", "word/numbering.xml"} {
+			assert.Contains(t, parts, name)
+		}
+		assert.Contains(t, parts["docProps/core.xml"], "<dc:title>A &amp; B</dc:title>")
+	})
+
+	t.Run("text styles and marks", func(t *testing.T) {
+		text := textBlock("text", model.BlockContentText_Paragraph, "bold link <tag>")
+		text.GetText().Marks = &model.BlockContentTextMarks{Marks: []*model.BlockContentTextMark{
+			{Range: &model.Range{From: 0, To: 4}, Type: model.BlockContentTextMark_Bold},
+			{Range: &model.Range{From: 5, To: 9}, Type: model.BlockContentTextMark_Link, Param: "https://anytype.io?a=1&b=2"},
+		}}
+		parts := unzip(t, NewConverter(nil, newDoc(
+			textBlock("h1", model.BlockContentText_Header1, "Heading"),
+			textBlock("quote", model.BlockContentText_Quote, "line\nbreak"),
+			text,
+		)).Convert(model.SmartBlockType_Page))
+
+		doc := parts["word/document.xml"]
+		assert.Contains(t, doc, `<w:pStyle w:val="Heading1"/>`)
+		assert.Contains(t, doc, `<w:pStyle w:val="Quote"/>`)
+		assert.Contains(t, doc, `<w:t xml:space="preserve">line</w:t><w:br/><w:t xml:space="preserve">break</w:t>`)
+		assert.Contains(t, doc, `<w:b/>`)
+		assert.Contains(t, doc, "&lt;tag&gt;")
+		assert.Contains(t, doc, `<w:hyperlink r:id="rId3" w:history="1">`)
+		assert.Contains(t, parts["word/_rels/document.xml.rels"], `Target="https://anytype.io?a=1&amp;b=2" TargetMode="External"`)
+	})
+
+	t.Run("numbered lists are numbered separately", func(t *testing.T) {
+		item := textBlock("n1", model.BlockContentText_Numbered, "one")
+		item.ChildrenIds = []string{"nested"}
+		parts := unzip(t, NewConverter(nil, newDoc(
+			item,
+			textBlock("/nested", model.BlockContentText_Marked, "nested"),
+			textBlock("n2", model.BlockContentText_Numbered, "two"),
+			textBlock("p", model.BlockContentText_Paragraph, "break"),
+			textBlock("n3", model.BlockContentText_Numbered, "one again"),
+		)).Convert(model.SmartBlockType_Page))
+
+		doc := parts["word/document.xml"]
+		assert.Equal(t, 2, strings.Count(doc, `<w:numId w:val="2"/>`))
+		assert.Equal(t, 1, strings.Count(doc, `<w:numId w:val="3"/>`))
+		assert.Contains(t, doc, `<w:ilvl w:val="1"/><w:numId w:val="1"/>`)
+		assert.Contains(t, parts["word/numbering.xml"], `<w:num w:numId="3">`)
+	})
+
+	t.Run("table", func(t *testing.T) {
+		s := newDoc()
+		tableID, err := table.NewEditor(nil).TableCreate(s, pb.RpcBlockTableCreateRequest{
+			TargetId: "root", Position: model.Block_Inner, Rows: 2, Columns: 2, WithHeaderRow: true,
+		})
+		require.NoError(t, err)
+		tb, err := table.NewTable(s, tableID)
+		require.NoError(t, err)
+		_, err = table.NewEditor(nil).CellCreate(s, tb.RowIDs()[1], tb.ColumnIDs()[1], textBlock("", model.BlockContentText_Paragraph, "Value"))
+		require.NoError(t, err)
+
+		parts := unzip(t, NewConverter(nil, s).Convert(model.SmartBlockType_Page))
+		doc := parts["word/document.xml"]
+		assert.Contains(t, doc, `<w:tblStyle w:val="TableGrid"/>`)
+		assert.Equal(t, 1, strings.Count(doc, "<w:tblHeader/>"))
+		assert.Equal(t, 4, strings.Count(doc, "<w:tc>"))
+		assert.Contains(t, doc, "Value")
+		// the document can't end with the table
+		assert.Contains(t, doc, "</w:tbl><w:p/>")
+	})
+
+	t.Run("image", func(t *testing.T) {
+		img := bytes.NewBuffer(nil)
+		require.NoError(t, png.Encode(img, image.NewRGBA(image.Rect(0, 0, 20, 10))))
+		d := NewConverter(nil, newDoc()).(*DOCX)
+		d.buf = bytes.NewBuffer(nil)
+		d.rels = nil
+		require.NoError(t, d.embedImage(img.Bytes(), 0, 0))
+		require.Len(t, d.media, 1)
+		assert.Equal(t, "word/media/image1.png", d.media[0].name)
+		assert.Contains(t, d.buf.String(), `<wp:extent cx="190500" cy="95250"/>`)
+
+		assert.Error(t, d.embedImage([]byte("not an image"), 0, 0))
+	})
+}
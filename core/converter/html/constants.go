@@ -32,11 +32,9 @@ const (
 		<html>
 			<head>
 				<meta http-equiv="content-type" content="text/html; charset=utf-8" />
-				<title></title>
-				<style type="text/css"></style>
-				<link rel="stylesheet" href="https://cdnjs.cloudflare.com/ajax/libs/highlight.js/9.15.6/styles/github.min.css">
-				<script src="https://code.jquery.com/jquery-3.4.1.min.js"></script>
-				<script src="https://cdnjs.cloudflare.com/ajax/libs/highlight.js/9.15.6/highlight.min.js"></script>
+				<title>`
+	wrapExportHead = `</title>
+				<style type="text/css">` + exportCSS + `</style>
 			</head>
 			<body>
 				<div class="anytype-container">`
@@ -44,18 +42,58 @@ const (
 			</body>
 		</html>`
 
-	styleParagraph = "font-size: 15px; line-height: 24px; letter-spacing: -0.08px; font-weight: 400; word-wrap: break-word;"
-	styleHeader1   = "padding: 23px 0px 1px 0px; font-size: 28px; line-height: 32px; letter-spacing: -0.36px; font-weight: 600;"
-	styleHeader2   = "padding: 15px 0px 1px 0px; font-size: 22px; line-height: 28px; letter-spacing: -0.16px; font-weight: 600;"
-	styleHeader3   = "padding: 15px 0px 1px 0px; font-size: 17px; line-height: 24px; font-weight: 600;"
-	styleHeader4   = ""
-	styleQuote     = "padding: 7px 0px 7px 0px; font-size: 18px; line-height: 26px; font-style: italic;"
-	styleCode      = "font-size:15px; font-family: monospace;"
-	styleTitle     = ""
-	styleCheckbox  = "font-size:15px;"
-	styleToggle    = "font-size:15px;"
-	styleKbd       = "display: inline; font-family: 'Mono'; line-height: 1.71; background: rgba(247,245,240,0.5); padding: 0px 4px; border-radius: 2px;"
-	styleCallout   = "background: #f3f2ec; border-radius: 6px; padding: 16px; margin: 6px 0px;"
+	exportCSS = `
+				body { margin: 0; color: #2c2b27; font-family: -apple-system, BlinkMacSystemFont, "Segoe UI", Helvetica, Arial, sans-serif; }
+				.anytype-container { max-width: 704px; margin: 0px auto; padding: 40px 20px; }
+				.row { display: flex; }
+				.row > .column { flex: 1 1 0; padding: 0px 8px; }
+				a { color: inherit; text-decoration: underline; }
+				img { max-width: 100%; }
+				hr.line { border: 0; border-top: 1px solid #dfddd0; }
+				hr.dots { border: 0; text-align: center; }
+				hr.dots:after { content: "..."; letter-spacing: 8px; color: #aca996; }
+				quote { display: block; border-left: 3px solid #2c2b27; padding-left: 12px; }
+				kbd { font-family: monospace; }
+				code pre { background: #f7f5f0; border-radius: 6px; padding: 16px; overflow-x: auto; white-space: pre-wrap; }
+				.hl-keyword { color: #ab50cc; }
+				.hl-string { color: #0fc8ba; }
+				.hl-comment { color: #aca996; font-style: italic; }
+				.hl-number { color: #ff6600; }
+				.callout { display: flex; align-items: flex-start; }
+				.callout-image { flex-shrink: 0; width: 20px; height: 20px; font-size: 16px; line-height: 20px; margin-right: 6px; }
+				.callout-image img { width: 100%; object-fit: cover; }
+				details.toggle > summary { cursor: pointer; }
+				details.toggle > :not(summary) { margin-left: 24px; }
+				.check input { margin-right: 6px; }
+				.link a { display: block; padding: 4px 0px; font-weight: 500; }
+				.message { color: #aca996; }
+				.dataview { margin: 12px 0px; overflow-x: auto; }
+				.dataview-name { font-weight: 600; padding: 6px 0px; }
+				.dataview-table { border-collapse: collapse; width: 100%; font-size: 14px; }
+				.dataview-table th, .dataview-table td { border: 1px solid #dfddd0; padding: 6px 9px; text-align: left; vertical-align: top; }
+				.dataview-table th { color: #aca996; font-weight: 400; }
+				.diagram svg { max-width: 100%; height: auto; }
+				ul.site-index { list-style: none; padding: 0px; }
+				ul.site-index li { padding: 4px 0px; }
+			`
+
+	exportExt        = ".html"
+	exportIndexTitle = "Index"
+
+	styleParagraph     = "font-size: 15px; line-height: 24px; letter-spacing: -0.08px; font-weight: 400; word-wrap: break-word;"
+	styleHeader1       = "padding: 23px 0px 1px 0px; font-size: 28px; line-height: 32px; letter-spacing: -0.36px; font-weight: 600;"
+	styleHeader2       = "padding: 15px 0px 1px 0px; font-size: 22px; line-height: 28px; letter-spacing: -0.16px; font-weight: 600;"
+	styleHeader3       = "padding: 15px 0px 1px 0px; font-size: 17px; line-height: 24px; font-weight: 600;"
+	styleHeader4       = ""
+	styleQuote         = "padding: 7px 0px 7px 0px; font-size: 18px; line-height: 26px; font-style: italic;"
+	styleCode          = "font-size:15px; font-family: monospace;"
+	styleTitle         = ""
+	styleCheckbox      = "font-size:15px;"
+	styleToggle        = "font-size:15px;"
+	styleKbd           = "display: inline; font-family: 'Mono'; line-height: 1.71; background: rgba(247,245,240,0.5); padding: 0px 4px; border-radius: 2px;"
+	styleCallout       = "background: #f3f2ec; border-radius: 6px; padding: 16px; margin: 6px 0px;"
+	styleExportCallout = "border-radius: 6px; padding: 16px; margin: 6px 0px;"
+	calloutBackground  = "#f3f2ec"
 
 	defaultStyle = -1
 )
@@ -0,0 +1,191 @@
+package html
+
+import (
+	"html"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// syntax describes the lexical rules of the language needed for the highlighting
+type syntax struct {
+	lineComments []string
+	blockComment [2]string
+	quotes       string
+	keywords     map[string]struct{}
+}
+
+func newSyntax(lineComments []string, blockComment [2]string, quotes string, keywords string) *syntax {
+	s := &syntax{
+		lineComments: lineComments,
+		blockComment: blockComment,
+		quotes:       quotes,
+		keywords:     map[string]struct{}{},
+	}
+	for _, k := range strings.Fields(keywords) {
+		s.keywords[k] = struct{}{}
+	}
+	return s
+}
+
+var (
+	cBlockComment = [2]string{"/*", "*/"}
+
+	syntaxGo = newSyntax([]string{"//"}, cBlockComment, "\"'`",
+		"break case chan const continue default defer else fallthrough for func go goto if import interface map package range return select struct switch type var nil true false iota")
+	syntaxJS = newSyntax([]string{"//"}, cBlockComment, "\"'`",
+		"break case catch class const continue debugger default delete do else export extends finally for function if import in instanceof let new of return super switch this throw try typeof var void while with yield async await static null undefined true false interface type enum implements private public protected readonly")
+	syntaxPython = newSyntax([]string{"#"}, [2]string{}, "\"'",
+		"and as assert async await break class continue def del elif else except finally for from global if import in is lambda nonlocal not or pass raise return try while with yield None True False self")
+	syntaxJava = newSyntax([]string{"//"}, cBlockComment, "\"'",
+		"abstract boolean break byte case catch char class const continue default do double else enum extends final finally float for if implements import instanceof int interface long native new package private protected public return short static super switch synchronized this throw throws try void volatile while null true false var val fun object when is in override data sealed")
+	syntaxC = newSyntax([]string{"//"}, cBlockComment, "\"'",
+		"auto bool break case char class const continue default delete do double else enum explicit extern false float for friend goto if inline int long namespace new nullptr operator private protected public register return short signed sizeof static struct switch template this throw true try typedef typename union unsigned using virtual void volatile while string var readonly override base foreach in out ref")
+	syntaxRust = newSyntax([]string{"//"}, cBlockComment, "\"",
+		"as async await break const continue crate dyn else enum extern false fn for if impl in let loop match mod move mut pub ref return self Self static struct super trait true type unsafe use where while")
+	syntaxSwift = newSyntax([]string{"//"}, cBlockComment, "\"",
+		"associatedtype break case catch class continue default defer do else enum extension fallthrough false fileprivate for func guard if import in init inout internal let nil private protocol public repeat return self static struct subscript super switch throw throws true try var where while")
+	syntaxPHP = newSyntax([]string{"//", "#"}, cBlockComment, "\"'",
+		"abstract and array as break case catch class clone const continue declare default do echo else elseif empty extends final finally fn for foreach function global if implements include instanceof interface isset list namespace new null or print private protected public require return static switch throw trait try use var while true false")
+	syntaxRuby = newSyntax([]string{"#"}, [2]string{}, "\"'",
+		"alias and begin break case class def defined? do else elsif end ensure false for if in module next nil not or redo rescue retry return self super then true undef unless until when while yield require")
+	syntaxShell = newSyntax([]string{"#"}, [2]string{}, "\"'",
+		"if then else elif fi case esac for while until do done in function return local export readonly echo exit")
+	syntaxSQL = newSyntax([]string{"--"}, cBlockComment, "'\"",
+		"select from where and or not insert into values update set delete create table drop alter index primary key foreign references join left right inner outer on group by order having limit offset as distinct union all null is in like between case when then else end asc desc")
+	syntaxJSON = newSyntax(nil, [2]string{}, "\"", "true false null")
+)
+
+// languages by the code language names used in the code block fields
+var syntaxByLanguage = map[string]*syntax{
+	"go":         syntaxGo,
+	"golang":     syntaxGo,
+	"javascript": syntaxJS,
+	"js":         syntaxJS,
+	"typescript": syntaxJS,
+	"ts":         syntaxJS,
+	"python":     syntaxPython,
+	"py":         syntaxPython,
+	"java":       syntaxJava,
+	"kotlin":     syntaxJava,
+	"scala":      syntaxJava,
+	"c":          syntaxC,
+	"cpp":        syntaxC,
+	"c++":        syntaxC,
+	"csharp":     syntaxC,
+	"cs":         syntaxC,
+	"objectivec": syntaxC,
+	"rust":       syntaxRust,
+	"swift":      syntaxSwift,
+	"php":        syntaxPHP,
+	"ruby":       syntaxRuby,
+	"shell":      syntaxShell,
+	"bash":       syntaxShell,
+	"sh":         syntaxShell,
+	"sql":        syntaxSQL,
+	"json":       syntaxJSON,
+}
+
+// highlightCode returns the escaped code where comments, strings, numbers and keywords are wrapped into spans with hl-* classes
+func highlightCode(language, code string) string {
+	syn, ok := syntaxByLanguage[strings.ToLower(language)]
+	if !ok {
+		return html.EscapeString(code)
+	}
+	caseInsensitive := syn == syntaxSQL
+
+	var b strings.Builder
+	span := func(class, text string) {
+		b.WriteString(`<span class="hl-` + class + `">`)
+		b.WriteString(html.EscapeString(text))
+		b.WriteString(`</span>`)
+	}
+	for i := 0; i < len(code); {
+		rest := code[i:]
+		if n := syn.commentLen(rest); n > 0 {
+			span("comment", rest[:n])
+			i += n
+			continue
+		}
+		if strings.IndexByte(syn.quotes, rest[0]) >= 0 {
+			n := stringLen(rest)
+			span("string", rest[:n])
+			i += n
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(rest)
+		switch {
+		case unicode.IsDigit(r):
+			n := strings.IndexFunc(rest, func(r rune) bool {
+				return !unicode.IsDigit(r) && !unicode.IsLetter(r) && r != '.' && r != '_'
+			})
+			if n < 0 {
+				n = len(rest)
+			}
+			span("number", rest[:n])
+			i += n
+		case isWordRune(r):
+			n := strings.IndexFunc(rest, func(r rune) bool {
+				return !isWordRune(r) && !unicode.IsDigit(r) && r != '?'
+			})
+			if n < 0 {
+				n = len(rest)
+			}
+			word := rest[:n]
+			if caseInsensitive {
+				word = strings.ToLower(word)
+			}
+			if _, ok := syn.keywords[word]; ok {
+				span("keyword", rest[:n])
+			} else {
+				b.WriteString(html.EscapeString(rest[:n]))
+			}
+			i += n
+		default:
+			b.WriteString(html.EscapeString(rest[:size]))
+			i += size
+		}
+	}
+	return b.String()
+}
+
+// commentLen returns the length of the comment at the beginning of s or 0
+func (syn *syntax) commentLen(s string) int {
+	for _, c := range syn.lineComments {
+		if strings.HasPrefix(s, c) {
+			if n := strings.IndexByte(s, '\n'); n >= 0 {
+				return n
+			}
+			return len(s)
+		}
+	}
+	if open, end := syn.blockComment[0], syn.blockComment[1]; open != "" && strings.HasPrefix(s, open) {
+		if n := strings.Index(s[len(open):], end); n >= 0 {
+			return len(open) + n + len(end)
+		}
+		return len(s)
+	}
+	return 0
+}
+
+// stringLen returns the length of the string literal at the beginning of s including quotes
+func stringLen(s string) int {
+	quote := s[0]
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case quote:
+			return i + 1
+		case '\n':
+			if quote != '`' {
+				return i
+			}
+		}
+	}
+	return len(s)
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || r == '_' || r == '$'
+}
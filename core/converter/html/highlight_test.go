@@ -0,0 +1,28 @@
+package html
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHighlightCode(t *testing.T) {
+	t.Run("unknown language is only escaped", func(t *testing.T) {
+		assert.Equal(t, "if a &lt; 1", highlightCode("brainfuck", "if a < 1"))
+	})
+
+	t.Run("python", func(t *testing.T) {
+		assert.Equal(t,
+			`<span class="hl-keyword">def</span> f(): <span class="hl-comment"># it&#39;s 2</span>`+"\n"+
+				`    <span class="hl-keyword">return</span> <span class="hl-string">&#39;x\&#39;y&#39;</span> * <span class="hl-number">2</span>`,
+			highlightCode("Python", "def f(): # it's 2\n    return 'x\\'y' * 2"),
+		)
+	})
+
+	t.Run("sql keywords are case insensitive", func(t *testing.T) {
+		assert.Equal(t,
+			`<span class="hl-keyword">SELECT</span> name <span class="hl-keyword">from</span> t <span class="hl-comment">/* all */</span>`,
+			highlightCode("sql", "SELECT name from t /* all */"),
+		)
+	})
+}
@@ -7,25 +7,53 @@ import (
 	"fmt"
 	"html"
 	"io/ioutil"
+	"sort"
 	"strconv"
+	"time"
+
+	"github.com/gogo/protobuf/types"
 
 	"github.com/anyproto/anytype-heart/core/block/editor/state"
 	"github.com/anyproto/anytype-heart/core/block/editor/table"
 	"github.com/anyproto/anytype-heart/core/block/simple"
+	diagramblock "github.com/anyproto/anytype-heart/core/block/simple/diagram"
+	"github.com/anyproto/anytype-heart/core/converter"
+	"github.com/anyproto/anytype-heart/core/converter/diagram"
 	"github.com/anyproto/anytype-heart/core/files"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/addr"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 	"github.com/anyproto/anytype-heart/util/pbtypes"
 	utf16 "github.com/anyproto/anytype-heart/util/text"
 )
 
+var logger = logging.Logger("html-export")
+
+type FileNamer interface {
+	Get(path, hash, title, ext string) (name string)
+}
+
 func NewHTMLConverter(fileService files.Service, s *state.State) *HTML {
 	return &HTML{fileService: fileService, s: s}
 }
 
+// NewExporter returns the converter of the object to the standalone html page.
+// Links to the known docs point to their pages named by fn, dataview blocks are rendered as tables when the source is set
+func NewExporter(fileService files.Service, s *state.State, fn FileNamer, dataview converter.DataviewSource) converter.Converter {
+	return &exporter{HTML: &HTML{fileService: fileService, s: s, fn: fn, dataview: dataview, export: true}}
+}
+
 type HTML struct {
 	s           *state.State
 	buf         *bytes.Buffer
 	fileService files.Service
+
+	// export enables the markup of the standalone page, the clipboard output stays plain
+	export    bool
+	knownDocs map[string]*types.Struct
+	fn        FileNamer
+	dataview  converter.DataviewSource
 }
 
 func (h *HTML) Convert() (result string) {
@@ -44,6 +72,8 @@ func (h *HTML) Convert() (result string) {
 func (h *HTML) Export() (result string) {
 	h.buf = bytes.NewBuffer(nil)
 	h.buf.WriteString(wrapExportStart)
+	h.buf.WriteString(html.EscapeString(pbtypes.GetString(h.s.Details(), bundle.RelationKeyName.String())))
+	h.buf.WriteString(wrapExportHead)
 	h.renderChildren(h.s.Pick(h.s.RootId()).Model())
 	h.buf.WriteString(wrapExportEnd)
 	return h.buf.String()
@@ -73,6 +103,12 @@ func (h *HTML) render(rs *renderState, b *model.Block) {
 	case *model.BlockContentOfTable:
 		rs.Close()
 		h.renderTable(b)
+	case *model.BlockContentOfDiagram:
+		rs.Close()
+		h.renderDiagram(b)
+	case *model.BlockContentOfDataview:
+		rs.Close()
+		h.renderDataview(b)
 	default:
 		rs.Close()
 		h.renderLayout(b)
@@ -97,6 +133,9 @@ func (h *HTML) renderChildren(parent *model.Block) {
 }
 
 func (h *HTML) renderText(rs *renderState, b *model.Block) {
+	if h.export && h.renderExportText(rs, b) {
+		return
+	}
 	text := b.GetText()
 	switch text.Style {
 	case model.BlockContentText_Marked:
@@ -146,6 +185,65 @@ func (h *HTML) renderText(rs *renderState, b *model.Block) {
 	}
 }
 
+// renderExportText renders the text styles that differ on the standalone page,
+// the clipboard keeps the plain markup. Returns false when the style is rendered as usual
+func (h *HTML) renderExportText(rs *renderState, b *model.Block) bool {
+	text := b.GetText()
+	switch text.Style {
+	case model.BlockContentText_Callout:
+		rs.Close()
+
+		img := ""
+		if text.IconImage != "" {
+			if src := h.getImageBase64(text.IconImage); src != "" {
+				img = fmt.Sprintf(`<span class="callout-image"><img src="%s" /></span>`, src)
+			}
+		}
+		if img == "" && text.IconEmoji != "" {
+			img = fmt.Sprintf(`<span class="callout-image">%s</span>`, text.IconEmoji)
+		}
+		background := calloutBackground
+		if b.BackgroundColor != "" {
+			background = backgroundColor(b.BackgroundColor)
+		}
+
+		fmt.Fprintf(h.buf, `<div class="callout" style="%s background: %s;">%s`, styleExportCallout, background, img)
+		h.writeTextToBuf(text)
+		h.renderChildren(b)
+		h.buf.WriteString(`</div>`)
+	case model.BlockContentText_Toggle:
+		rs.Close()
+		fmt.Fprintf(h.buf, `<details style="%s" class="toggle"><summary>`, styleToggle)
+		h.writeTextToBuf(text)
+		h.buf.WriteString(`</summary>`)
+		h.renderChildren(b)
+		h.buf.WriteString(`</details>`)
+	case model.BlockContentText_Checkbox:
+		rs.Close()
+		checked := ""
+		if text.Checked {
+			checked = ` checked`
+		}
+		fmt.Fprintf(h.buf, `<div style="%s" class="check"><input type="checkbox" disabled%s/>`, styleCheckbox, checked)
+		h.writeTextToBuf(text)
+		h.renderChildren(b)
+		h.buf.WriteString(`</div>`)
+	case model.BlockContentText_Code:
+		language := pbtypes.GetString(b.Fields, "lang")
+		if language == "" {
+			return false
+		}
+		rs.Close()
+		fmt.Fprintf(h.buf, `<code style="%s"><pre class="code" data-language="%s">`, styleCode, html.EscapeString(language))
+		h.buf.WriteString(highlightCode(language, text.Text))
+		h.renderChildren(b)
+		h.buf.WriteString(styleTags[model.BlockContentText_Code].CloseTag)
+	default:
+		return false
+	}
+	return true
+}
+
 func (h *HTML) renderFile(b *model.Block) {
 	file := b.GetFile()
 	if file.State != model.BlockContentFile_Done {
@@ -251,16 +349,34 @@ func (h *HTML) renderLink(b *model.Block) {
 	if len(b.ChildrenIds) > 0 {
 		h.buf.WriteString("<div>")
 	}
-	h.buf.WriteString(`<div class="message">
+	if title, filename, ok := h.getLinkInfo(b.GetLink().GetTargetBlockId()); ok {
+		fmt.Fprintf(h.buf, `<div class="link"><a href="%s">%s</a></div>`, html.EscapeString(filename), html.EscapeString(title))
+	} else {
+		h.buf.WriteString(`<div class="message">
 		<div class="header">This content is available in Anytype.</div>
 		Follow <a href="https://anytype.io">link</a> to ask a permission to get the content
 	</div>`)
+	}
 	if len(b.ChildrenIds) > 0 {
 		h.renderChildren(b)
 		h.buf.WriteString("</div>")
 	}
 }
 
+// renderDiagram embeds the rendered diagram and keeps its source, diagrams that can't be rendered are exported as code
+func (h *HTML) renderDiagram(b *model.Block) {
+	d := b.GetDiagram()
+	language := diagramblock.CodeLanguage(d.Language)
+	source := html.EscapeString(d.Source)
+	svg, err := diagram.Render(d.Language, d.Source)
+	if err != nil {
+		fmt.Fprintf(h.buf, `<code style="%s"><pre class="diagram" data-language="%s">%s</pre></code>`, styleCode, language, source)
+	} else {
+		fmt.Fprintf(h.buf, `<div class="diagram" data-language="%s">%s<pre class="diagram-source" hidden>%s</pre></div>`, language, svg, source)
+	}
+	h.renderChildren(b)
+}
+
 func (h *HTML) renderTable(b *model.Block) {
 	tb, err := table.NewTable(h.s, b.Id)
 	if err != nil {
@@ -366,6 +482,16 @@ func (h *HTML) writeTag(m *model.BlockContentTextMark, start bool) {
 		} else {
 			h.buf.WriteString("</a>")
 		}
+	case model.BlockContentTextMark_Mention, model.BlockContentTextMark_Object:
+		_, filename, ok := h.getLinkInfo(m.Param)
+		if !ok {
+			return
+		}
+		if start {
+			fmt.Fprintf(h.buf, `<a href="%s">`, html.EscapeString(filename))
+		} else {
+			h.buf.WriteString("</a>")
+		}
 	case model.BlockContentTextMark_TextColor:
 		if start {
 			fmt.Fprintf(h.buf, `<span style="color:%s">`, textColor(m.Param))
@@ -459,6 +585,9 @@ func (h *HTML) writeTextToBuf(text *model.BlockContentText) {
 }
 
 func (h *HTML) getImageBase64(hash string) (res string) {
+	if h.fileService == nil {
+		return
+	}
 	im, err := h.fileService.ImageByHash(context.TODO(), hash)
 	if err != nil {
 		return
@@ -475,3 +604,177 @@ func (h *HTML) getImageBase64(hash string) (res string) {
 	dataBase64 := base64.StdEncoding.EncodeToString(data)
 	return fmt.Sprintf("data:%s;base64, %s", f.Meta().Media, dataBase64)
 }
+
+// renderDataview renders the records of the active view as a static table
+func (h *HTML) renderDataview(b *model.Block) {
+	dv := b.GetDataview()
+	if h.dataview == nil || len(dv.Views) == 0 {
+		h.renderLayout(b)
+		return
+	}
+	view := converter.DataviewView(dv, "")
+	res, err := h.dataview.DataviewRecords(b.Id, view.Id)
+	if err != nil {
+		logger.With("blockID", b.Id).Warnf("can't get dataview records: %v", err)
+		h.renderLayout(b)
+		return
+	}
+	deps := make(map[string]*types.Struct, len(res.Dependencies))
+	for _, d := range res.Dependencies {
+		deps[pbtypes.GetString(d, bundle.RelationKeyId.String())] = d
+	}
+
+	h.buf.WriteString(`<div class="dataview">`)
+	if view.Name != "" {
+		fmt.Fprintf(h.buf, `<div class="dataview-name">%s</div>`, html.EscapeString(view.Name))
+	}
+	h.buf.WriteString(`<table class="dataview-table"><thead><tr>`)
+	for _, rel := range res.Relations {
+		fmt.Fprintf(h.buf, `<th>%s</th>`, html.EscapeString(rel.Name))
+	}
+	h.buf.WriteString(`</tr></thead><tbody>`)
+	for _, rec := range res.Records {
+		h.buf.WriteString(`<tr>`)
+		for _, rel := range res.Relations {
+			h.buf.WriteString(`<td>`)
+			h.renderRelationValue(rec, rel, deps)
+			h.buf.WriteString(`</td>`)
+		}
+		h.buf.WriteString(`</tr>`)
+	}
+	h.buf.WriteString(`</tbody></table></div>`)
+	h.renderChildren(b)
+}
+
+func (h *HTML) renderRelationValue(rec *types.Struct, rel *model.Relation, deps map[string]*types.Struct) {
+	v := pbtypes.Get(rec, rel.Key)
+	if v == nil {
+		return
+	}
+	if rel.Key == bundle.RelationKeyName.String() {
+		h.writeObjectLink(pbtypes.GetString(rec, bundle.RelationKeyId.String()), v.GetStringValue())
+		return
+	}
+	switch rel.Format {
+	case model.RelationFormat_checkbox:
+		if v.GetBoolValue() {
+			h.buf.WriteString("&#10003;")
+		}
+	case model.RelationFormat_number:
+		if _, ok := v.Kind.(*types.Value_NumberValue); ok {
+			h.buf.WriteString(strconv.FormatFloat(v.GetNumberValue(), 'f', -1, 64))
+		}
+	case model.RelationFormat_date:
+		if ts := int64(v.GetNumberValue()); ts != 0 {
+			h.buf.WriteString(time.Unix(ts, 0).Format("2006-01-02"))
+		}
+	case model.RelationFormat_url:
+		fmt.Fprintf(h.buf, `<a href="%s">%s</a>`, html.EscapeString(v.GetStringValue()), html.EscapeString(v.GetStringValue()))
+	case model.RelationFormat_email:
+		fmt.Fprintf(h.buf, `<a href="mailto:%s">%s</a>`, html.EscapeString(v.GetStringValue()), html.EscapeString(v.GetStringValue()))
+	case model.RelationFormat_status, model.RelationFormat_tag, model.RelationFormat_object, model.RelationFormat_file:
+		for i, id := range pbtypes.GetStringListValue(v) {
+			if i > 0 {
+				h.buf.WriteString(", ")
+			}
+			name := pbtypes.GetString(deps[id], bundle.RelationKeyName.String())
+			if rel.Format == model.RelationFormat_object {
+				h.writeObjectLink(id, name)
+			} else {
+				h.buf.WriteString(html.EscapeString(name))
+			}
+		}
+	default:
+		h.buf.WriteString(html.EscapeString(pbtypes.GetString(rec, rel.Key)))
+	}
+}
+
+// writeObjectLink writes the name of the object as a link when the object is exported
+func (h *HTML) writeObjectLink(id, name string) {
+	title, filename, ok := h.getLinkInfo(id)
+	if !ok {
+		h.buf.WriteString(html.EscapeString(name))
+		return
+	}
+	if name == "" {
+		name = title
+	}
+	fmt.Fprintf(h.buf, `<a href="%s">%s</a>`, html.EscapeString(filename), html.EscapeString(name))
+}
+
+// getLinkInfo returns the title and the page of the exported object, block references point to the object page
+func (h *HTML) getLinkInfo(ref string) (title, filename string, ok bool) {
+	if h.fn == nil {
+		return
+	}
+	docID, _ := addr.SplitBlockReference(ref)
+	info, ok := h.knownDocs[docID]
+	if !ok {
+		return
+	}
+	title = pbtypes.GetString(info, bundle.RelationKeyName.String())
+	if title == "" {
+		title = pbtypes.GetString(info, bundle.RelationKeySnippet.String())
+	}
+	if title == "" {
+		title = docID
+	}
+	filename = h.fn.Get("", docID, title, exportExt)
+	return
+}
+
+type exporter struct {
+	*HTML
+}
+
+func (e *exporter) Convert(model.SmartBlockType) []byte {
+	return []byte(e.Export())
+}
+
+func (e *exporter) SetKnownDocs(docs map[string]*types.Struct) converter.Converter {
+	e.knownDocs = docs
+	return e
+}
+
+func (e *exporter) FileHashes() []string {
+	return nil
+}
+
+func (e *exporter) ImageHashes() []string {
+	return nil
+}
+
+func (e *exporter) Ext() string {
+	return exportExt
+}
+
+// ExportIndex returns the page with links to the pages of all exported docs, sorted by title
+func ExportIndex(docs map[string]*types.Struct, fn FileNamer) []byte {
+	h := &HTML{knownDocs: docs, fn: fn, buf: bytes.NewBuffer(nil)}
+	type page struct {
+		title, filename string
+	}
+	pages := make([]page, 0, len(docs))
+	for id := range docs {
+		if title, filename, ok := h.getLinkInfo(id); ok {
+			pages = append(pages, page{title: title, filename: filename})
+		}
+	}
+	sort.Slice(pages, func(i, j int) bool {
+		if pages[i].title != pages[j].title {
+			return pages[i].title < pages[j].title
+		}
+		return pages[i].filename < pages[j].filename
+	})
+
+	h.buf.WriteString(wrapExportStart)
+	h.buf.WriteString(exportIndexTitle)
+	h.buf.WriteString(wrapExportHead)
+	fmt.Fprintf(h.buf, `<h1>%s</h1><ul class="site-index">`, exportIndexTitle)
+	for _, p := range pages {
+		fmt.Fprintf(h.buf, `<li><a href="%s">%s</a></li>`, html.EscapeString(p.filename), html.EscapeString(p.title))
+	}
+	h.buf.WriteString(`</ul>`)
+	h.buf.WriteString(wrapExportEnd)
+	return h.buf.Bytes()
+}
@@ -5,11 +5,15 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/anyproto/anytype-heart/core/block/editor/state"
 	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/converter"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
 )
 
 func TestHTML_Convert(t *testing.T) {
@@ -441,3 +445,178 @@ const columnsExpectation = `
 	</div>
 </div>
 `
+
+func TestHTML_Diagram(t *testing.T) {
+	diagramDoc := func(language model.BlockContentDiagramLanguage, source string) *state.State {
+		return state.NewDoc("root", map[string]simple.Block{
+			"root": simple.New(&model.Block{Id: "root", ChildrenIds: []string{"diagram"}}),
+			"diagram": simple.New(&model.Block{Id: "diagram", Content: &model.BlockContentOfDiagram{
+				Diagram: &model.BlockContentDiagram{Source: source, Language: language},
+			}}),
+		}).(*state.State)
+	}
+
+	t.Run("rendered diagram keeps the source", func(t *testing.T) {
+		html := convertHtml(diagramDoc(model.BlockContentDiagram_Mermaid, "sequenceDiagram\nA->>B: a < b"))
+		assert.Contains(t, html, `<div class="diagram" data-language="mermaid"><svg `)
+		assert.Contains(t, html, `<pre class="diagram-source" hidden>sequenceDiagram
+A-&gt;&gt;B: a &lt; b</pre></div>`)
+	})
+
+	t.Run("not supported diagram is exported as code", func(t *testing.T) {
+		html := convertHtml(diagramDoc(model.BlockContentDiagram_PlantUML, "@startuml\nclass A\n@enduml"))
+		assert.Contains(t, html, `<pre class="diagram" data-language="plantuml">@startuml
+class A
+@enduml</pre>`)
+		assert.NotContains(t, html, "<svg")
+	})
+}
+
+type testNamer struct{}
+
+func (testNamer) Get(path, hash, title, ext string) string {
+	return hash + ext
+}
+
+func TestHTML_Export(t *testing.T) {
+	newDoc := func(blocks ...*model.Block) *state.State {
+		root := &model.Block{Id: "root"}
+		m := map[string]simple.Block{}
+		for _, b := range blocks {
+			root.ChildrenIds = append(root.ChildrenIds, b.Id)
+			m[b.Id] = simple.New(b)
+		}
+		m["root"] = simple.New(root)
+		return state.NewDoc("root", m).(*state.State)
+	}
+	export := func(s *state.State, dataview converter.DataviewSource) string {
+		conv := NewExporter(nil, s, testNamer{}, dataview)
+		conv.SetKnownDocs(map[string]*types.Struct{
+			"page1": {Fields: map[string]*types.Value{bundle.RelationKeyName.String(): pbtypes.String("Page <1>")}},
+		})
+		return string(conv.Convert(model.SmartBlockType_Page))
+	}
+
+	t.Run("toggle is collapsible", func(t *testing.T) {
+		s := newDoc(&model.Block{Id: "toggle", Content: &model.BlockContentOfText{
+			Text: &model.BlockContentText{Text: "header", Style: model.BlockContentText_Toggle},
+		}})
+		html := export(s, nil)
+		assert.Contains(t, html, `class="toggle"><summary>header</summary></details>`)
+		assert.Contains(t, html, "<style")
+		assert.NotContains(t, html, "<script")
+	})
+
+	t.Run("callout keeps the icon and background", func(t *testing.T) {
+		s := newDoc(&model.Block{Id: "callout", BackgroundColor: "red", Content: &model.BlockContentOfText{
+			Text: &model.BlockContentText{Text: "note", Style: model.BlockContentText_Callout, IconEmoji: "💡"},
+		}})
+		html := export(s, nil)
+		assert.Contains(t, html, `background: #ffebe5;"><span class="callout-image">💡</span>note</div>`)
+	})
+
+	t.Run("code is highlighted by language", func(t *testing.T) {
+		s := newDoc(&model.Block{
+			Id:     "code",
+			Fields: &types.Struct{Fields: map[string]*types.Value{"lang": pbtypes.String("go")}},
+			Content: &model.BlockContentOfText{
+				Text: &model.BlockContentText{Text: `return "a<b" // done`, Style: model.BlockContentText_Code},
+			},
+		})
+		html := export(s, nil)
+		assert.Contains(t, html, `<pre class="code" data-language="go"><span class="hl-keyword">return</span> `+
+			`<span class="hl-string">&#34;a&lt;b&#34;</span> <span class="hl-comment">// done</span></pre>`)
+	})
+
+	t.Run("clipboard keeps the plain markup", func(t *testing.T) {
+		s := newDoc(
+			&model.Block{Id: "toggle", Content: &model.BlockContentOfText{
+				Text: &model.BlockContentText{Text: "header", Style: model.BlockContentText_Toggle},
+			}},
+			&model.Block{Id: "check", Content: &model.BlockContentOfText{
+				Text: &model.BlockContentText{Text: "done", Style: model.BlockContentText_Checkbox, Checked: true},
+			}},
+			&model.Block{Id: "callout", BackgroundColor: "red", Content: &model.BlockContentOfText{
+				Text: &model.BlockContentText{Text: "note", Style: model.BlockContentText_Callout, IconEmoji: "💡"},
+			}},
+			&model.Block{
+				Id:     "code",
+				Fields: &types.Struct{Fields: map[string]*types.Value{"lang": pbtypes.String("go")}},
+				Content: &model.BlockContentOfText{
+					Text: &model.BlockContentText{Text: "return", Style: model.BlockContentText_Code},
+				},
+			},
+		)
+		html := convertHtml(s)
+		assert.Contains(t, html, `<div style="font-size:15px;" class="toggle">header</div>`)
+		assert.Contains(t, html, `<div style="font-size:15px;" class="check"><input type="checkbox"/>done</div>`)
+		assert.Contains(t, html, `<div style="background: #f3f2ec; border-radius: 6px; padding: 16px; margin: 6px 0px;"><span class="callout-image">💡</span>note</div>`)
+		assert.Contains(t, html, `<code style="font-size:15px; font-family: monospace;"><pre>return</pre></code>`)
+		assert.NotContains(t, html, "<details")
+		assert.NotContains(t, html, "hl-keyword")
+	})
+
+	t.Run("links point to the exported pages", func(t *testing.T) {
+		s := newDoc(
+			&model.Block{Id: "link", Content: &model.BlockContentOfLink{Link: &model.BlockContentLink{TargetBlockId: "page1#block"}}},
+			&model.Block{Id: "missing", Content: &model.BlockContentOfLink{Link: &model.BlockContentLink{TargetBlockId: "page2"}}},
+			&model.Block{Id: "mention", Content: &model.BlockContentOfText{Text: &model.BlockContentText{
+				Text: "see it",
+				Marks: &model.BlockContentTextMarks{Marks: []*model.BlockContentTextMark{
+					{Range: &model.Range{From: 4, To: 6}, Type: model.BlockContentTextMark_Mention, Param: "page1"},
+				}},
+			}}},
+		)
+		html := export(s, nil)
+		assert.Contains(t, html, `<div class="link"><a href="page1.html">Page &lt;1&gt;</a></div>`)
+		assert.Contains(t, html, `This content is available in Anytype.`)
+		assert.Contains(t, html, `see <a href="page1.html">it</a>`)
+	})
+
+	t.Run("dataview is rendered as table", func(t *testing.T) {
+		s := newDoc(&model.Block{Id: "dataview", Content: &model.BlockContentOfDataview{Dataview: &model.BlockContentDataview{
+			Views:      []*model.BlockContentDataviewView{{Id: "v1", Name: "All"}, {Id: "v2", Name: "Tasks"}},
+			ActiveView: "v2",
+		}}})
+		source := converter.Dataviews{{BlockID: "dataview", ViewID: "v2"}: {
+			Relations: []*model.Relation{
+				{Key: bundle.RelationKeyName.String(), Name: "Name", Format: model.RelationFormat_shorttext},
+				{Key: "done", Name: "Done", Format: model.RelationFormat_checkbox},
+				{Key: "tag", Name: "Tag", Format: model.RelationFormat_tag},
+				{Key: "score", Name: "Score", Format: model.RelationFormat_number},
+			},
+			Records: []*types.Struct{
+				{Fields: map[string]*types.Value{
+					bundle.RelationKeyId.String():   pbtypes.String("page1"),
+					bundle.RelationKeyName.String(): pbtypes.String("First"),
+					"done":                          pbtypes.Bool(true),
+					"tag":                           pbtypes.StringList([]string{"tag1"}),
+					"score":                         pbtypes.Float64(1.5),
+				}},
+				{Fields: map[string]*types.Value{
+					bundle.RelationKeyId.String():   pbtypes.String("other"),
+					bundle.RelationKeyName.String(): pbtypes.String("Second"),
+				}},
+			},
+			Dependencies: []*types.Struct{
+				{Fields: map[string]*types.Value{
+					bundle.RelationKeyId.String():   pbtypes.String("tag1"),
+					bundle.RelationKeyName.String(): pbtypes.String("urgent"),
+				}},
+			},
+		}}
+		html := export(s, source)
+		assert.Contains(t, html, `<div class="dataview-name">Tasks</div>`)
+		assert.Contains(t, html, `<thead><tr><th>Name</th><th>Done</th><th>Tag</th><th>Score</th></tr></thead>`)
+		assert.Contains(t, html, `<tr><td><a href="page1.html">First</a></td><td>&#10003;</td><td>urgent</td><td>1.5</td></tr>`)
+		assert.Contains(t, html, `<tr><td>Second</td><td></td><td></td><td></td></tr>`)
+	})
+}
+
+func TestExportIndex(t *testing.T) {
+	index := string(ExportIndex(map[string]*types.Struct{
+		"b": {Fields: map[string]*types.Value{bundle.RelationKeyName.String(): pbtypes.String("Beta")}},
+		"a": {Fields: map[string]*types.Value{bundle.RelationKeyName.String(): pbtypes.String("Alpha")}},
+	}, testNamer{}))
+	assert.Contains(t, index, `<li><a href="a.html">Alpha</a></li><li><a href="b.html">Beta</a></li>`)
+}
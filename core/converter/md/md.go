@@ -2,6 +2,7 @@ package md
 
 import (
 	"bytes"
+	"encoding/base64"
 	"fmt"
 	"html"
 	"io"
@@ -15,7 +16,9 @@ import (
 	"github.com/anyproto/anytype-heart/core/block/editor/state"
 	"github.com/anyproto/anytype-heart/core/block/editor/table"
 	"github.com/anyproto/anytype-heart/core/block/simple"
+	diagramblock "github.com/anyproto/anytype-heart/core/block/simple/diagram"
 	"github.com/anyproto/anytype-heart/core/converter"
+	"github.com/anyproto/anytype-heart/core/converter/diagram"
 	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
 	"github.com/anyproto/anytype-heart/pkg/lib/core"
 	"github.com/anyproto/anytype-heart/pkg/lib/logging"
@@ -112,6 +115,8 @@ func (h *MD) render(buf writer, in *renderState, b *model.Block) {
 		h.renderLatex(buf, in, b)
 	case *model.BlockContentOfTable:
 		h.renderTable(buf, in, b)
+	case *model.BlockContentOfDiagram:
+		h.renderDiagram(buf, in, b)
 	default:
 		h.renderLayout(buf, in, b)
 	}
@@ -282,6 +287,28 @@ func (h *MD) renderLatex(buf writer, in *renderState, b *model.Block) {
 	}
 }
 
+// renderDiagram writes the rendered diagram as html, that is skipped on import, and its source as fenced code block
+func (h *MD) renderDiagram(buf writer, in *renderState, b *model.Block) {
+	d := b.GetDiagram()
+	if d == nil {
+		return
+	}
+	language := diagramblock.CodeLanguage(d.Language)
+	if svg, err := diagram.Render(d.Language, d.Source); err == nil {
+		buf.WriteString(in.indent)
+		fmt.Fprintf(buf, "\n<div class=\"diagram\"><img src=\"data:image/svg+xml;base64,%s\" alt=\"%s diagram\"></div>\n\n",
+			base64.StdEncoding.EncodeToString(svg), language)
+	}
+	// the fence is longer than any backtick sequence of the source, so the source is kept as is
+	fence := "```"
+	for strings.Contains(d.Source, fence) {
+		fence += "`"
+	}
+	buf.WriteString(in.indent)
+	fmt.Fprintf(buf, "%s%s\n%s\n%s\n", fence, language, d.Source, fence)
+	h.renderChildren(buf, in, b)
+}
+
 func (h *MD) renderTable(buf writer, in *renderState, b *model.Block) {
 	if t := b.GetTable(); t == nil {
 		return
@@ -1,6 +1,7 @@
 package md
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/anyproto/anytype-heart/core/block/editor/state"
@@ -131,3 +132,27 @@ func TestMD_Convert(t *testing.T) {
 		assert.Equal(t, exp, string(res))
 	})
 }
+
+func TestMD_Diagram(t *testing.T) {
+	s := state.NewDoc("root", map[string]simple.Block{
+		"root": simple.New(&model.Block{Id: "root", ChildrenIds: []string{"mermaid", "plantuml", "backticks"}}),
+		"mermaid": simple.New(&model.Block{Id: "mermaid", Content: &model.BlockContentOfDiagram{Diagram: &model.BlockContentDiagram{
+			Source:   "sequenceDiagram\nA->>B: Hi",
+			Language: model.BlockContentDiagram_Mermaid,
+		}}}),
+		"plantuml": simple.New(&model.Block{Id: "plantuml", Content: &model.BlockContentOfDiagram{Diagram: &model.BlockContentDiagram{
+			Source:   "@startuml\nclass A\n@enduml",
+			Language: model.BlockContentDiagram_PlantUML,
+		}}}),
+		"backticks": simple.New(&model.Block{Id: "backticks", Content: &model.BlockContentOfDiagram{Diagram: &model.BlockContentDiagram{
+			Source:   "graph TD\nA[```] --> B[````]",
+			Language: model.BlockContentDiagram_Mermaid,
+		}}}),
+	}).(*state.State)
+
+	res := string(NewMDConverter(nil, s, nil).Convert(0))
+	assert.Contains(t, res, "\n<div class=\"diagram\"><img src=\"data:image/svg+xml;base64,")
+	assert.Contains(t, res, "\" alt=\"mermaid diagram\"></div>\n\n```mermaid\nsequenceDiagram\nA->>B: Hi\n```\n")
+	assert.Contains(t, res, "```mermaid\nsequenceDiagram\nA->>B: Hi\n```\n```plantuml\n@startuml\nclass A\n@enduml\n```\n")
+	assert.True(t, strings.HasSuffix(res, "`````mermaid\ngraph TD\nA[```] --> B[````]\n`````\n"))
+}
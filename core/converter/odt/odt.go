@@ -0,0 +1,708 @@
+package odt
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	"image/png"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/gogo/protobuf/types"
+	_ "golang.org/x/image/webp"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/editor/table"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/converter"
+	"github.com/anyproto/anytype-heart/core/files"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+	utf16 "github.com/anyproto/anytype-heart/util/text"
+)
+
+var logger = logging.Logger("odt-export")
+
+const (
+	// indentStep is the indent of the nesting level in inches
+	indentStep = 0.5
+	// contentWidth is the width of A4 page without margins in inches
+	contentWidth  = 6.6933
+	pixelsPerInch = 96
+	// default width of the table column in Anytype, in pixels
+	defaultColumnWidth = 140
+
+	styleBullets   = "Bullets"
+	styleNumbering = "Numbering_20_123"
+)
+
+func NewConverter(fileService files.Service, s *state.State) converter.Converter {
+	return &ODT{fileService: fileService, s: s}
+}
+
+type ODT struct {
+	s           *state.State
+	fileService files.Service
+	knownDocs   map[string]*types.Struct
+
+	body *bytes.Buffer
+	// autoStyles are the automatic styles of the content: text marks, indents and table sizes
+	autoStyles      *bytes.Buffer
+	spanStyles      map[runFormat]string
+	paragraphStyles map[paragraphStyleKey]string
+	tables          int
+	pictures        []picture
+	// afterSpace is set when the next space is collapsed by the editors, so it's written as the element
+	afterSpace bool
+}
+
+type picture struct {
+	name, mediaType string
+	data            []byte
+}
+
+type paragraphStyleKey struct {
+	parent string
+	depth  int
+}
+
+func (o *ODT) Convert(model.SmartBlockType) []byte {
+	result, err := o.convert()
+	if err != nil {
+		logger.Errorf("convert %s to odt: %v", o.s.RootId(), err)
+		return nil
+	}
+	return result
+}
+
+func (o *ODT) convert() ([]byte, error) {
+	o.body = bytes.NewBuffer(nil)
+	o.autoStyles = bytes.NewBuffer(nil)
+	o.spanStyles = make(map[runFormat]string)
+	o.paragraphStyles = make(map[paragraphStyleKey]string)
+	if root := o.s.Pick(o.s.RootId()); root != nil {
+		o.renderChildren(root.Model(), 0)
+	}
+
+	content := bytes.NewBuffer(nil)
+	content.WriteString(contentStart)
+	content.Write(o.autoStyles.Bytes())
+	content.WriteString(contentBodyStart)
+	content.Write(o.body.Bytes())
+	content.WriteString(contentEnd)
+
+	title := bytes.NewBuffer(nil)
+	if err := xml.EscapeText(title, []byte(pbtypes.GetString(o.s.Details(), bundle.RelationKeyName.String()))); err != nil {
+		return nil, err
+	}
+	manifest := bytes.NewBuffer(nil)
+	manifest.WriteString(manifestStart)
+	for _, p := range o.pictures {
+		fmt.Fprintf(manifest, `<manifest:file-entry manifest:full-path="%s" manifest:media-type="%s"/>`+"\n", p.name, p.mediaType)
+	}
+	manifest.WriteString(manifestEnd)
+
+	out := bytes.NewBuffer(nil)
+	zw := zip.NewWriter(out)
+	// the mimetype goes first and isn't compressed, so the format can be detected by the magic number
+	w, err := zw.CreateHeader(&zip.FileHeader{Name: "mimetype", Method: zip.Store})
+	if err != nil {
+		return nil, err
+	}
+	if _, err = w.Write([]byte(mimeType)); err != nil {
+		return nil, err
+	}
+	parts := []picture{
+		{name: "META-INF/manifest.xml", data: manifest.Bytes()},
+		{name: "meta.xml", data: []byte(fmt.Sprintf(metaXML, title.String()))},
+		{name: "styles.xml", data: stylesPart()},
+		{name: "content.xml", data: content.Bytes()},
+	}
+	parts = append(parts, o.pictures...)
+	for _, p := range parts {
+		if w, err = zw.Create(p.name); err != nil {
+			return nil, err
+		}
+		if _, err = w.Write(p.data); err != nil {
+			return nil, err
+		}
+	}
+	if err = zw.Close(); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+func (o *ODT) SetKnownDocs(docs map[string]*types.Struct) converter.Converter {
+	o.knownDocs = docs
+	return o
+}
+
+func (o *ODT) FileHashes() []string {
+	return nil
+}
+
+func (o *ODT) ImageHashes() []string {
+	return nil
+}
+
+func (o *ODT) Ext() string {
+	return ".odt"
+}
+
+func listStyle(b simple.Block) string {
+	switch b.Model().GetText().GetStyle() {
+	case model.BlockContentText_Marked:
+		return styleBullets
+	case model.BlockContentText_Numbered:
+		return styleNumbering
+	}
+	return ""
+}
+
+// renderChildren renders the blocks, the neighbour list items of the same style are wrapped into the list
+func (o *ODT) renderChildren(parent *model.Block, depth int) {
+	ids := parent.ChildrenIds
+	for i := 0; i < len(ids); i++ {
+		b := o.s.Pick(ids[i])
+		if b == nil {
+			continue
+		}
+		style := listStyle(b)
+		if style == "" {
+			o.render(b.Model(), depth)
+			continue
+		}
+		fmt.Fprintf(o.body, `<text:list text:style-name="%s">`, style)
+		for ; i < len(ids); i++ {
+			item := o.s.Pick(ids[i])
+			if item == nil {
+				continue
+			}
+			if listStyle(item) != style {
+				i--
+				break
+			}
+			o.renderListItem(item.Model())
+		}
+		o.body.WriteString("</text:list>")
+	}
+}
+
+// renderListItem renders the item with its children, the nested items are indented by the list
+func (o *ODT) renderListItem(b *model.Block) {
+	o.body.WriteString("<text:list-item>")
+	o.startParagraph("List", 0)
+	o.writeRuns(b.GetText(), runFormat{})
+	o.endParagraph()
+	o.renderChildren(b, 0)
+	o.body.WriteString("</text:list-item>")
+}
+
+func (o *ODT) render(b *model.Block, depth int) {
+	switch content := b.Content.(type) {
+	case *model.BlockContentOfText:
+		o.renderText(b, depth)
+		return
+	case *model.BlockContentOfFile:
+		o.renderFile(b, depth)
+	case *model.BlockContentOfBookmark:
+		o.renderBookmark(content.Bookmark, depth)
+	case *model.BlockContentOfLink:
+		o.renderLink(content.Link, depth)
+	case *model.BlockContentOfDiv:
+		o.renderDiv(content.Div, depth)
+	case *model.BlockContentOfLatex:
+		o.renderCode(content.Latex.Text, depth)
+	case *model.BlockContentOfDiagram:
+		o.renderCode(content.Diagram.Source, depth)
+	case *model.BlockContentOfTable:
+		o.renderTable(b)
+		return
+	case *model.BlockContentOfDataview, *model.BlockContentOfFeaturedRelations:
+		return
+	}
+	o.renderChildren(b, depth)
+}
+
+// paragraphStyle returns the style of the paragraph, the nested paragraphs get the automatic style with the indent
+func (o *ODT) paragraphStyle(parent string, depth int) string {
+	if depth == 0 {
+		return parent
+	}
+	key := paragraphStyleKey{parent: parent, depth: depth}
+	if name, ok := o.paragraphStyles[key]; ok {
+		return name
+	}
+	name := fmt.Sprintf("P%d", len(o.paragraphStyles)+1)
+	o.paragraphStyles[key] = name
+	fmt.Fprintf(o.autoStyles, `<style:style style:name="%s" style:family="paragraph" style:parent-style-name="%s">`+
+		`<style:paragraph-properties fo:margin-left="%.2fin"/></style:style>`+"\n", name, parent, float64(depth)*indentStep)
+	return name
+}
+
+func (o *ODT) startParagraph(style string, depth int) {
+	fmt.Fprintf(o.body, `<text:p text:style-name="%s">`, o.paragraphStyle(style, depth))
+	o.afterSpace = true
+}
+
+func (o *ODT) endParagraph() {
+	o.body.WriteString("</text:p>")
+}
+
+func (o *ODT) renderText(b *model.Block, depth int) {
+	text := b.GetText()
+	var (
+		style, prefix string
+		level         int
+	)
+	switch text.Style {
+	case model.BlockContentText_Title:
+		style = "Title"
+	case model.BlockContentText_Header1:
+		style, level = "Heading_20_1", 1
+	case model.BlockContentText_Header2:
+		style, level = "Heading_20_2", 2
+	case model.BlockContentText_Header3:
+		style, level = "Heading_20_3", 3
+	case model.BlockContentText_Header4:
+		style, level = "Heading_20_4", 4
+	case model.BlockContentText_Description:
+		style = "Subtitle"
+	case model.BlockContentText_Quote:
+		style = "Quotations"
+	case model.BlockContentText_Code:
+		style = "Preformatted_20_Text"
+	case model.BlockContentText_Callout:
+		style = "Callout"
+		if text.IconEmoji != "" {
+			prefix = text.IconEmoji + " "
+		}
+	case model.BlockContentText_Checkbox:
+		style, prefix = "Standard", "☐ "
+		if text.Checked {
+			prefix = "☒ "
+		}
+	default:
+		style = "Standard"
+	}
+
+	if level > 0 {
+		fmt.Fprintf(o.body, `<text:h text:style-name="%s" text:outline-level="%d">`, o.paragraphStyle(style, depth), level)
+		o.afterSpace = true
+	} else {
+		o.startParagraph(style, depth)
+	}
+	if prefix != "" {
+		o.writeRun(prefix, runFormat{})
+	}
+	o.writeRuns(text, runFormat{})
+	if level > 0 {
+		o.body.WriteString("</text:h>")
+	} else {
+		o.endParagraph()
+	}
+	o.renderChildren(b, depth+1)
+}
+
+type runFormat struct {
+	bold, italic, underline, strike, code bool
+	link                                  string
+}
+
+// writeRuns splits the text into spans by marks
+func (o *ODT) writeRuns(text *model.BlockContentText, base runFormat) {
+	u := utf16.StrToUTF16(text.Text)
+	var marks []*model.BlockContentTextMark
+	bounds := []int{0, len(u)}
+	for _, m := range text.GetMarks().GetMarks() {
+		if m.Range == nil {
+			continue
+		}
+		from, to := clamp(int(m.Range.From), 0, len(u)), clamp(int(m.Range.To), 0, len(u))
+		if from >= to {
+			continue
+		}
+		marks = append(marks, m)
+		bounds = append(bounds, from, to)
+	}
+	sort.Ints(bounds)
+
+	for i := 0; i+1 < len(bounds); i++ {
+		from, to := bounds[i], bounds[i+1]
+		if from == to {
+			continue
+		}
+		f := base
+		for _, m := range marks {
+			if int(m.Range.From) <= from && int(m.Range.To) >= to {
+				applyMark(&f, m)
+			}
+		}
+		o.writeRun(utf16.UTF16ToStr(u[from:to]), f)
+	}
+}
+
+func applyMark(f *runFormat, m *model.BlockContentTextMark) {
+	switch m.Type {
+	case model.BlockContentTextMark_Bold:
+		f.bold = true
+	case model.BlockContentTextMark_Italic:
+		f.italic = true
+	case model.BlockContentTextMark_Strikethrough:
+		f.strike = true
+	case model.BlockContentTextMark_Underscored:
+		f.underline = true
+	case model.BlockContentTextMark_Keyboard:
+		f.code = true
+	case model.BlockContentTextMark_Link:
+		f.link = m.Param
+	}
+}
+
+func clamp(v, min, max int) int {
+	if v < min {
+		return min
+	}
+	if v > max {
+		return max
+	}
+	return v
+}
+
+// spanStyle returns the automatic text style for the marks, it's empty for the plain text
+func (o *ODT) spanStyle(f runFormat) string {
+	f.link = ""
+	if f == (runFormat{}) {
+		return ""
+	}
+	if name, ok := o.spanStyles[f]; ok {
+		return name
+	}
+	name := fmt.Sprintf("T%d", len(o.spanStyles)+1)
+	o.spanStyles[f] = name
+	fmt.Fprintf(o.autoStyles, `<style:style style:name="%s" style:family="text"><style:text-properties`, name)
+	if f.bold {
+		o.autoStyles.WriteString(` fo:font-weight="bold"`)
+	}
+	if f.italic {
+		o.autoStyles.WriteString(` fo:font-style="italic"`)
+	}
+	if f.underline {
+		o.autoStyles.WriteString(` style:text-underline-style="solid" style:text-underline-width="auto" style:text-underline-color="font-color"`)
+	}
+	if f.strike {
+		o.autoStyles.WriteString(` style:text-line-through-style="solid"`)
+	}
+	if f.code {
+		o.autoStyles.WriteString(` fo:font-family="'Liberation Mono'" fo:background-color="#f3f2ec"`)
+	}
+	o.autoStyles.WriteString("/></style:style>\n")
+	return name
+}
+
+func (o *ODT) writeRun(text string, f runFormat) {
+	if f.link != "" {
+		o.body.WriteString(`<text:a xlink:type="simple" xlink:href="`)
+		xml.EscapeText(o.body, []byte(f.link)) //nolint:errcheck
+		o.body.WriteString(`" text:style-name="Internet_20_link">`)
+	}
+	style := o.spanStyle(f)
+	if style != "" {
+		fmt.Fprintf(o.body, `<text:span text:style-name="%s">`, style)
+	}
+	o.writeText(text)
+	if style != "" {
+		o.body.WriteString("</text:span>")
+	}
+	if f.link != "" {
+		o.body.WriteString("</text:a>")
+	}
+}
+
+// writeText writes the text, line breaks, tabs and the spaces collapsed by the editors are written as elements
+func (o *ODT) writeText(text string) {
+	var (
+		plain  strings.Builder
+		spaces int
+	)
+	flush := func() {
+		if plain.Len() > 0 {
+			xml.EscapeText(o.body, []byte(plain.String())) //nolint:errcheck
+			plain.Reset()
+		}
+		switch {
+		case spaces == 1:
+			o.body.WriteString("<text:s/>")
+		case spaces > 1:
+			fmt.Fprintf(o.body, `<text:s text:c="%d"/>`, spaces)
+		}
+		spaces = 0
+	}
+	for _, r := range text {
+		switch r {
+		case '\n':
+			flush()
+			o.body.WriteString("<text:line-break/>")
+			o.afterSpace = true
+		case '\t':
+			flush()
+			o.body.WriteString("<text:tab/>")
+			o.afterSpace = false
+		case ' ':
+			if o.afterSpace {
+				spaces++
+			} else {
+				plain.WriteRune(r)
+				o.afterSpace = true
+			}
+		default:
+			if spaces > 0 {
+				flush()
+			}
+			plain.WriteRune(r)
+			o.afterSpace = false
+		}
+	}
+	flush()
+}
+
+// renderCode writes the text with the monospace font, it's used for LaTeX and diagram sources
+func (o *ODT) renderCode(text string, depth int) {
+	o.startParagraph("Preformatted_20_Text", depth)
+	o.writeRun(text, runFormat{})
+	o.endParagraph()
+}
+
+func (o *ODT) renderFile(b *model.Block, depth int) {
+	file := b.GetFile()
+	if file.State != model.BlockContentFile_Done {
+		return
+	}
+	if file.Type == model.BlockContentFile_Image {
+		err := o.renderImage(file.Hash, pbtypes.GetFloat64(b.Fields, "width"), depth)
+		if err == nil {
+			return
+		}
+		logger.With("hash", file.Hash).Warnf("can't embed image: %v", err)
+	}
+	o.startParagraph("Standard", depth)
+	o.writeRun(file.Name, runFormat{})
+	o.endParagraph()
+}
+
+// renderImage embeds the image scaled to the width fraction of the content or to its size,
+// the formats other than PNG, JPEG and GIF are converted to PNG
+func (o *ODT) renderImage(hash string, widthFraction float64, depth int) error {
+	if o.fileService == nil {
+		return fmt.Errorf("file service is not available")
+	}
+	img, err := o.fileService.ImageByHash(context.TODO(), hash)
+	if err != nil {
+		return err
+	}
+	f, err := img.GetFileForWidth(context.TODO(), 1024)
+	if err != nil {
+		return err
+	}
+	rd, err := f.Reader(context.TODO())
+	if err != nil {
+		return err
+	}
+	data, err := io.ReadAll(rd)
+	if err != nil {
+		return err
+	}
+	return o.embedImage(data, widthFraction, depth)
+}
+
+func (o *ODT) embedImage(data []byte, widthFraction float64, depth int) error {
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("decode image config: %w", err)
+	}
+	if cfg.Width == 0 || cfg.Height == 0 {
+		return fmt.Errorf("image is empty")
+	}
+	switch format {
+	case "png", "jpeg", "gif":
+	default:
+		decoded, _, err := image.Decode(bytes.NewReader(data))
+		if err != nil {
+			return fmt.Errorf("decode image: %w", err)
+		}
+		buf := bytes.NewBuffer(nil)
+		if err = png.Encode(buf, decoded); err != nil {
+			return fmt.Errorf("encode png: %w", err)
+		}
+		data, format = buf.Bytes(), "png"
+	}
+	name := fmt.Sprintf("Pictures/image%d.%s", len(o.pictures)+1, format)
+	o.pictures = append(o.pictures, picture{name: name, mediaType: "image/" + format, data: data})
+
+	maxWidth := contentWidth - float64(depth)*indentStep
+	width := float64(cfg.Width) / pixelsPerInch
+	if widthFraction > 0 {
+		width = maxWidth * widthFraction
+	}
+	if width > maxWidth {
+		width = maxWidth
+	}
+	height := width * float64(cfg.Height) / float64(cfg.Width)
+
+	o.startParagraph("Standard", depth)
+	fmt.Fprintf(o.body, `<draw:frame draw:name="Image%d" text:anchor-type="as-char" svg:width="%.4fin" svg:height="%.4fin">`+
+		`<draw:image xlink:href="%s" xlink:type="simple" xlink:show="embed" xlink:actuate="onLoad"/></draw:frame>`,
+		len(o.pictures), width, height, name)
+	o.endParagraph()
+	return nil
+}
+
+func (o *ODT) renderBookmark(bm *model.BlockContentBookmark, depth int) {
+	if bm.Url == "" {
+		return
+	}
+	title := bm.Title
+	if title == "" {
+		title = bm.Url
+	}
+	o.startParagraph("Standard", depth)
+	o.writeRun(title, runFormat{bold: true, link: bm.Url})
+	if bm.Description != "" {
+		o.writeRun("\n"+bm.Description, runFormat{})
+	}
+	o.endParagraph()
+}
+
+func (o *ODT) renderLink(l *model.BlockContentLink, depth int) {
+	title, ok := o.docTitle(l.TargetBlockId)
+	if !ok {
+		return
+	}
+	o.startParagraph("Standard", depth)
+	o.writeRun(title, runFormat{bold: true, underline: true})
+	o.endParagraph()
+}
+
+func (o *ODT) docTitle(id string) (title string, ok bool) {
+	info, ok := o.knownDocs[id]
+	if !ok {
+		return "", false
+	}
+	title = pbtypes.GetString(info, bundle.RelationKeyName.String())
+	if title == "" {
+		title = pbtypes.GetString(info, bundle.RelationKeySnippet.String())
+	}
+	if title == "" {
+		title = id
+	}
+	return title, true
+}
+
+func (o *ODT) renderDiv(div *model.BlockContentDiv, depth int) {
+	switch div.Style {
+	case model.BlockContentDiv_Line:
+		o.startParagraph("Horizontal_20_Line", depth)
+	case model.BlockContentDiv_Dots:
+		o.startParagraph("Separator", depth)
+		o.writeRun("•   •   •", runFormat{})
+	default:
+		return
+	}
+	o.endParagraph()
+}
+
+func (o *ODT) renderTable(b *model.Block) {
+	tb, err := table.NewTable(o.s, b.Id)
+	if err != nil {
+		return
+	}
+	colIDs := tb.ColumnIDs()
+	if len(colIDs) == 0 {
+		return
+	}
+	widths := make([]float64, len(colIDs))
+	var total float64
+	for i, colID := range colIDs {
+		widths[i] = defaultColumnWidth
+		if col := o.s.Pick(colID); col != nil {
+			if w := pbtypes.GetFloat64(col.Model().GetFields(), "width"); w > 0 {
+				widths[i] = w
+			}
+		}
+		widths[i] /= pixelsPerInch
+		total += widths[i]
+	}
+	// wide tables are shrunk to the content
+	if total > contentWidth {
+		for i := range widths {
+			widths[i] = widths[i] * contentWidth / total
+		}
+		total = contentWidth
+	}
+
+	o.tables++
+	name := fmt.Sprintf("Table%d", o.tables)
+	fmt.Fprintf(o.autoStyles, `<style:style style:name="%s" style:family="table"><style:table-properties style:width="%.4fin" table:align="left"/></style:style>`+"\n", name, total)
+	fmt.Fprintf(o.body, `<table:table table:name="%s" table:style-name="%s">`, name, name)
+	for i, w := range widths {
+		fmt.Fprintf(o.autoStyles, `<style:style style:name="%s.C%d" style:family="table-column"><style:table-column-properties style:column-width="%.4fin"/></style:style>`+"\n", name, i+1, w)
+		fmt.Fprintf(o.body, `<table:table-column table:style-name="%s.C%d"/>`, name, i+1)
+	}
+
+	// only the header rows at the top are repeated, the others are just highlighted
+	var rows, headerRows int
+	for _, rowID := range tb.RowIDs() {
+		row := o.s.Pick(rowID)
+		if row == nil {
+			continue
+		}
+		isHeader := row.Model().GetTableRow().GetIsHeader()
+		repeated := isHeader && headerRows == rows
+		if repeated {
+			if headerRows == 0 {
+				o.body.WriteString("<table:table-header-rows>")
+			}
+			headerRows++
+		} else if headerRows > 0 && headerRows == rows {
+			o.body.WriteString("</table:table-header-rows>")
+		}
+		rows++
+
+		cells := make(map[string]simple.Block, len(row.Model().ChildrenIds))
+		for _, cellID := range row.Model().ChildrenIds {
+			if _, colID, err := table.ParseCellID(cellID); err == nil {
+				cells[colID] = o.s.Pick(cellID)
+			}
+		}
+		o.body.WriteString("<table:table-row>")
+		cellStyle := "TableCell"
+		if isHeader {
+			cellStyle = "TableHeaderCell"
+		}
+		for _, colID := range colIDs {
+			fmt.Fprintf(o.body, `<table:table-cell table:style-name="%s" office:value-type="string">`, cellStyle)
+			o.startParagraph("Table_20_Contents", 0)
+			if c := cells[colID]; c != nil {
+				if text := c.Model().GetText(); text != nil {
+					o.writeRuns(text, runFormat{bold: isHeader})
+				}
+			}
+			o.endParagraph()
+			o.body.WriteString("</table:table-cell>")
+		}
+		o.body.WriteString("</table:table-row>")
+	}
+	if headerRows > 0 && headerRows == rows {
+		o.body.WriteString("</table:table-header-rows>")
+	}
+	o.body.WriteString("</table:table>")
+}
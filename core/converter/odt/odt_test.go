@@ -0,0 +1,120 @@
+package odt
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/editor/table"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+func newDoc(blocks ...*model.Block) *state.State {
+	root := &model.Block{Id: "root"}
+	m := map[string]simple.Block{}
+	for _, b := range blocks {
+		if !strings.HasPrefix(b.Id, "/") {
+			root.ChildrenIds = append(root.ChildrenIds, b.Id)
+		}
+		b.Id = strings.TrimPrefix(b.Id, "/")
+		m[b.Id] = simple.New(b)
+	}
+	m["root"] = simple.New(root)
+	return state.NewDoc("root", m).(*state.State)
+}
+
+func textBlock(id string, style model.BlockContentTextStyle, text string) *model.Block {
+	return &model.Block{Id: id, Content: &model.BlockContentOfText{Text: &model.BlockContentText{Text: text, Style: style}}}
+}
+
+// content checks the package and returns its content.xml
+func content(t *testing.T, data []byte) string {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	require.NotEmpty(t, zr.File)
+	// the mimetype goes first without compression, so the type can be detected by the magic bytes
+	assert.Equal(t, "mimetype", zr.File[0].Name)
+	assert.Equal(t, zip.Store, zr.File[0].Method)
+
+	var res string
+	for _, f := range zr.File {
+		r, err := f.Open()
+		require.NoError(t, err)
+		data, err := io.ReadAll(r)
+		require.NoError(t, err)
+		if !strings.HasSuffix(f.Name, ".xml") {
+			continue
+		}
+		dec := xml.NewDecoder(bytes.NewReader(data))
+		for {
+			_, err = dec.Token()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err, f.Name)
+		}
+		if f.Name == "content.xml" {
+			res = string(data)
+		}
+	}
+	return res
+}
+
+func TestODT_Convert(t *testing.T) {
+	t.Run("headings and spaces", func(t *testing.T) {
+		c := content(t, NewConverter(nil, newDoc(
+			textBlock("h2", model.BlockContentText_Header2, "Heading"),
+			textBlock("code", model.BlockContentText_Code, "  a   b\tc\nd"),
+		)).Convert(model.SmartBlockType_Page))
+
+		assert.Contains(t, c, `<text:h text:style-name="Heading_20_2" text:outline-level="2">Heading</text:h>`)
+		assert.Contains(t, c, `<text:s text:c="2"/>a <text:s text:c="2"/>b<text:tab/>c<text:line-break/>d`)
+	})
+
+	t.Run("lists", func(t *testing.T) {
+		item := textBlock("b1", model.BlockContentText_Marked, "first")
+		item.ChildrenIds = []string{"nested"}
+		c := content(t, NewConverter(nil, newDoc(
+			item,
+			textBlock("/nested", model.BlockContentText_Numbered, "nested"),
+			textBlock("b2", model.BlockContentText_Marked, "second"),
+			textBlock("n1", model.BlockContentText_Numbered, "number"),
+		)).Convert(model.SmartBlockType_Page))
+
+		assert.Contains(t, c, `<text:list text:style-name="Bullets"><text:list-item><text:p text:style-name="List">first</text:p>`+
+			`<text:list text:style-name="Numbering_20_123"><text:list-item><text:p text:style-name="List">nested</text:p></text:list-item></text:list>`+
+			`</text:list-item><text:list-item><text:p text:style-name="List">second</text:p></text:list-item></text:list>`)
+		assert.Contains(t, c, `<text:list text:style-name="Numbering_20_123"><text:list-item><text:p text:style-name="List">number</text:p>`)
+	})
+
+	t.Run("nested paragraphs are indented", func(t *testing.T) {
+		p := textBlock("p", model.BlockContentText_Paragraph, "parent")
+		p.ChildrenIds = []string{"child"}
+		c := content(t, NewConverter(nil, newDoc(p, textBlock("/child", model.BlockContentText_Paragraph, "child"))).Convert(model.SmartBlockType_Page))
+
+		assert.Contains(t, c, `<style:style style:name="P1" style:family="paragraph" style:parent-style-name="Standard">`)
+		assert.Contains(t, c, `<text:p text:style-name="P1">child</text:p>`)
+	})
+
+	t.Run("table", func(t *testing.T) {
+		s := newDoc()
+		_, err := table.NewEditor(nil).TableCreate(s, pb.RpcBlockTableCreateRequest{
+			TargetId: "root", Position: model.Block_Inner, Rows: 3, Columns: 2, WithHeaderRow: true,
+		})
+		require.NoError(t, err)
+		c := content(t, NewConverter(nil, s).Convert(model.SmartBlockType_Page))
+
+		assert.Equal(t, 1, strings.Count(c, "<table:table-header-rows>"))
+		assert.Equal(t, 2, strings.Count(c, "<table:table-column "))
+		assert.Equal(t, 3, strings.Count(c, "<table:table-row>"))
+	})
+}
@@ -0,0 +1,118 @@
+package odt
+
+import (
+	"fmt"
+	"strings"
+)
+
+const mimeType = "application/vnd.oasis.opendocument.text"
+
+const namespaces = `xmlns:office="urn:oasis:names:tc:opendocument:xmlns:office:1.0" ` +
+	`xmlns:style="urn:oasis:names:tc:opendocument:xmlns:style:1.0" ` +
+	`xmlns:text="urn:oasis:names:tc:opendocument:xmlns:text:1.0" ` +
+	`xmlns:table="urn:oasis:names:tc:opendocument:xmlns:table:1.0" ` +
+	`xmlns:draw="urn:oasis:names:tc:opendocument:xmlns:drawing:1.0" ` +
+	`xmlns:fo="urn:oasis:names:tc:opendocument:xmlns:xsl-fo-compatible:1.0" ` +
+	`xmlns:xlink="http://www.w3.org/1999/xlink" ` +
+	`xmlns:dc="http://purl.org/dc/elements/1.1/" ` +
+	`xmlns:meta="urn:oasis:names:tc:opendocument:xmlns:meta:1.0" ` +
+	`xmlns:svg="urn:oasis:names:tc:opendocument:xmlns:svg-compatible:1.0"`
+
+const manifestStart = `<?xml version="1.0" encoding="UTF-8"?>
+<manifest:manifest xmlns:manifest="urn:oasis:names:tc:opendocument:xmlns:manifest:1.0" manifest:version="1.2">
+<manifest:file-entry manifest:full-path="/" manifest:version="1.2" manifest:media-type="` + mimeType + `"/>
+<manifest:file-entry manifest:full-path="content.xml" manifest:media-type="text/xml"/>
+<manifest:file-entry manifest:full-path="styles.xml" manifest:media-type="text/xml"/>
+<manifest:file-entry manifest:full-path="meta.xml" manifest:media-type="text/xml"/>
+`
+
+const manifestEnd = `</manifest:manifest>`
+
+const metaXML = `<?xml version="1.0" encoding="UTF-8"?>
+<office:document-meta ` + namespaces + ` office:version="1.2">
+<office:meta><dc:title>%s</dc:title></office:meta>
+</office:document-meta>`
+
+const contentStart = `<?xml version="1.0" encoding="UTF-8"?>
+<office:document-content ` + namespaces + ` office:version="1.2">
+<office:automatic-styles>
+<style:style style:name="TableCell" style:family="table-cell"><style:table-cell-properties fo:padding="0.05in" fo:border="0.5pt solid #dfddd0"/></style:style>
+<style:style style:name="TableHeaderCell" style:family="table-cell"><style:table-cell-properties fo:padding="0.05in" fo:border="0.5pt solid #dfddd0" fo:background-color="#f3f2ec"/></style:style>
+`
+
+const contentBodyStart = `</office:automatic-styles>
+<office:body>
+<office:text>
+`
+
+const contentEnd = `</office:text>
+</office:body>
+</office:document-content>`
+
+// the styles have the names of LibreOffice built-in styles, so the editors recognize the headings, quotes and lists
+const stylesStart = `<?xml version="1.0" encoding="UTF-8"?>
+<office:document-styles ` + namespaces + ` office:version="1.2">
+<office:styles>
+<style:default-style style:family="paragraph"><style:paragraph-properties fo:margin-top="0in" fo:margin-bottom="0.08in" fo:line-height="115%"/><style:text-properties fo:font-family="'Liberation Sans'" fo:font-size="11pt"/></style:default-style>
+<style:style style:name="Standard" style:family="paragraph" style:class="text"/>
+<style:style style:name="Heading" style:family="paragraph" style:parent-style-name="Standard" style:class="text"><style:paragraph-properties fo:margin-top="0.17in" fo:margin-bottom="0.08in" fo:keep-with-next="always"/><style:text-properties fo:font-weight="bold"/></style:style>
+<style:style style:name="Title" style:family="paragraph" style:parent-style-name="Heading" style:class="chapter"><style:paragraph-properties fo:margin-top="0in" fo:margin-bottom="0.17in"/><style:text-properties fo:font-size="26pt"/></style:style>
+<style:style style:name="Subtitle" style:family="paragraph" style:parent-style-name="Standard" style:class="chapter"><style:paragraph-properties fo:margin-bottom="0.17in"/><style:text-properties fo:font-size="13pt" fo:color="#666666"/></style:style>
+<style:style style:name="Heading_20_1" style:display-name="Heading 1" style:family="paragraph" style:parent-style-name="Heading" style:default-outline-level="1" style:class="text"><style:text-properties fo:font-size="20pt"/></style:style>
+<style:style style:name="Heading_20_2" style:display-name="Heading 2" style:family="paragraph" style:parent-style-name="Heading" style:default-outline-level="2" style:class="text"><style:text-properties fo:font-size="16pt"/></style:style>
+<style:style style:name="Heading_20_3" style:display-name="Heading 3" style:family="paragraph" style:parent-style-name="Heading" style:default-outline-level="3" style:class="text"><style:text-properties fo:font-size="13pt"/></style:style>
+<style:style style:name="Heading_20_4" style:display-name="Heading 4" style:family="paragraph" style:parent-style-name="Heading" style:default-outline-level="4" style:class="text"><style:text-properties fo:font-size="12pt"/></style:style>
+<style:style style:name="Quotations" style:family="paragraph" style:parent-style-name="Standard" style:class="html"><style:paragraph-properties fo:margin-left="0.17in" fo:padding-left="0.11in" fo:border-left="1.5pt solid #2c2b27"/><style:text-properties fo:font-style="italic" fo:font-size="12pt"/></style:style>
+<style:style style:name="Preformatted_20_Text" style:display-name="Preformatted Text" style:family="paragraph" style:parent-style-name="Standard" style:class="html"><style:paragraph-properties fo:background-color="#f3f2ec" fo:padding="0.08in" fo:line-height="100%"/><style:text-properties fo:font-family="'Liberation Mono'" fo:font-size="9.5pt"/></style:style>
+<style:style style:name="Callout" style:family="paragraph" style:parent-style-name="Standard" style:class="text"><style:paragraph-properties fo:background-color="#f3f2ec" fo:padding="0.08in"/></style:style>
+<style:style style:name="List" style:family="paragraph" style:parent-style-name="Standard" style:class="list"><style:paragraph-properties fo:margin-bottom="0.04in"/></style:style>
+<style:style style:name="Table_20_Contents" style:display-name="Table Contents" style:family="paragraph" style:parent-style-name="Standard" style:class="extra"><style:paragraph-properties fo:margin-bottom="0in"/></style:style>
+<style:style style:name="Horizontal_20_Line" style:display-name="Horizontal Line" style:family="paragraph" style:parent-style-name="Standard" style:class="html"><style:paragraph-properties fo:padding="0in" fo:border-bottom="0.5pt solid #dfddd0"/><style:text-properties fo:font-size="6pt"/></style:style>
+<style:style style:name="Separator" style:family="paragraph" style:parent-style-name="Standard" style:class="text"><style:paragraph-properties fo:text-align="center"/></style:style>
+<style:style style:name="Internet_20_link" style:display-name="Internet link" style:family="text"><style:text-properties fo:color="#0563c1" style:text-underline-style="solid" style:text-underline-width="auto" style:text-underline-color="font-color"/></style:style>
+`
+
+const stylesEnd = `</office:styles>
+<office:automatic-styles>
+<style:page-layout style:name="pm1"><style:page-layout-properties fo:page-width="8.2681in" fo:page-height="11.6929in" fo:margin-top="0.7874in" fo:margin-bottom="0.7874in" fo:margin-left="0.7874in" fo:margin-right="0.7874in" style:print-orientation="portrait"/></style:page-layout>
+</office:automatic-styles>
+<office:master-styles><style:master-page style:name="Standard" style:page-layout-name="pm1"/></office:master-styles>
+</office:document-styles>`
+
+var (
+	bulletSymbols = []string{"•", "◦", "▪"}
+	numberFormats = []string{"1", "a", "i"}
+)
+
+const listLevels = 6
+
+// stylesPart returns the styles with the bullet and the number list styles
+func stylesPart() []byte {
+	var b strings.Builder
+	b.WriteString(stylesStart)
+	for _, bullet := range []bool{true, false} {
+		if bullet {
+			b.WriteString(`<text:list-style style:name="Bullets">`)
+		} else {
+			b.WriteString(`<text:list-style style:name="Numbering_20_123" style:display-name="Numbering 123">`)
+		}
+		for lvl := 1; lvl <= listLevels; lvl++ {
+			if bullet {
+				fmt.Fprintf(&b, `<text:list-level-style-bullet text:level="%d" text:bullet-char="%s">`, lvl, bulletSymbols[(lvl-1)%len(bulletSymbols)])
+			} else {
+				fmt.Fprintf(&b, `<text:list-level-style-number text:level="%d" style:num-suffix="." style:num-format="%s">`, lvl, numberFormats[(lvl-1)%len(numberFormats)])
+			}
+			fmt.Fprintf(&b, `<style:list-level-properties text:list-level-position-and-space-mode="label-alignment">`+
+				`<style:list-level-label-alignment text:label-followed-by="listtab" fo:text-indent="-0.25in" fo:margin-left="%.2fin"/>`+
+				`</style:list-level-properties>`, float64(lvl)*indentStep)
+			if bullet {
+				b.WriteString(`</text:list-level-style-bullet>`)
+			} else {
+				b.WriteString(`</text:list-level-style-number>`)
+			}
+		}
+		b.WriteString("</text:list-style>\n")
+	}
+	b.WriteString(stylesEnd)
+	return []byte(b.String())
+}
@@ -0,0 +1,174 @@
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+// document is the minimal PDF writer: the renderer draws on the pages and the document serializes them
+// with the fonts and images into PDF objects
+type document struct {
+	width, height float64
+	title         string
+
+	pages  []*page
+	fonts  []*font
+	images []*pdfImage
+
+	// serialized objects, the object number is the index + 1
+	objects [][]byte
+}
+
+type page struct {
+	content bytes.Buffer
+	links   []link
+}
+
+// link is the clickable area of the page with the URI, coordinates are in the PDF space
+type link struct {
+	x, y, w, h float64
+	uri        string
+}
+
+func newDocument(width, height float64, fonts []*font) *document {
+	for i, f := range fonts {
+		f.resource = "F" + strconv.Itoa(i+1)
+	}
+	return &document{width: width, height: height, fonts: fonts}
+}
+
+func (d *document) newPage() *page {
+	p := &page{}
+	d.pages = append(d.pages, p)
+	return p
+}
+
+func (d *document) addImage(img *pdfImage) *pdfImage {
+	img.resource = "Im" + strconv.Itoa(len(d.images)+1)
+	d.images = append(d.images, img)
+	return img
+}
+
+func (d *document) addObject(data string) int {
+	d.objects = append(d.objects, []byte(data))
+	return len(d.objects)
+}
+
+func (d *document) reserveObject() int {
+	d.objects = append(d.objects, nil)
+	return len(d.objects)
+}
+
+// addStream adds the stream object, dict contains the additional entries of the stream dictionary
+func (d *document) addStream(dict string, data []byte, compress bool) int {
+	if compress {
+		var buf bytes.Buffer
+		zw := zlib.NewWriter(&buf)
+		// writing to the buffer can't fail
+		_, _ = zw.Write(data)
+		_ = zw.Close()
+		data = buf.Bytes()
+		dict = strings.TrimSpace(dict + " /Filter /FlateDecode")
+	}
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "<< /Length %d %s >>\nstream\n", len(data), dict)
+	b.Write(data)
+	b.WriteString("\nendstream")
+	d.objects = append(d.objects, b.Bytes())
+	return len(d.objects)
+}
+
+func (d *document) bytes() ([]byte, error) {
+	d.objects = nil
+	catalogObj := d.reserveObject()
+	pagesObj := d.reserveObject()
+
+	var resources strings.Builder
+	resources.WriteString("<< /ProcSet [/PDF /Text /ImageB /ImageC] /Font <<")
+	for _, f := range d.fonts {
+		if len(f.used) == 0 {
+			continue
+		}
+		fontObj, err := f.write(d)
+		if err != nil {
+			return nil, fmt.Errorf("write font %s: %w", f.baseFont, err)
+		}
+		fmt.Fprintf(&resources, " /%s %d 0 R", f.resource, fontObj)
+	}
+	resources.WriteString(" >> /XObject <<")
+	for _, img := range d.images {
+		fmt.Fprintf(&resources, " /%s %d 0 R", img.resource, img.write(d))
+	}
+	resources.WriteString(" >> >>")
+	resourcesObj := d.addObject(resources.String())
+
+	kids := make([]string, 0, len(d.pages))
+	for _, p := range d.pages {
+		contentObj := d.addStream("", p.content.Bytes(), true)
+		var annots strings.Builder
+		for _, l := range p.links {
+			annotObj := d.addObject(fmt.Sprintf(
+				"<< /Type /Annot /Subtype /Link /Rect [%s %s %s %s] /Border [0 0 0] /A << /S /URI /URI %s >> >>",
+				num(l.x), num(l.y), num(l.x+l.w), num(l.y+l.h), literalString(l.uri),
+			))
+			fmt.Fprintf(&annots, "%d 0 R ", annotObj)
+		}
+		pageObj := d.addObject(fmt.Sprintf(
+			"<< /Type /Page /Parent %d 0 R /MediaBox [0 0 %s %s] /Resources %d 0 R /Contents %d 0 R /Annots [%s] >>",
+			pagesObj, num(d.width), num(d.height), resourcesObj, contentObj, strings.TrimSpace(annots.String()),
+		))
+		kids = append(kids, fmt.Sprintf("%d 0 R", pageObj))
+	}
+	d.objects[pagesObj-1] = []byte(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(kids)))
+	d.objects[catalogObj-1] = []byte(fmt.Sprintf("<< /Type /Catalog /Pages %d 0 R >>", pagesObj))
+	infoObj := d.addObject(fmt.Sprintf("<< /Title %s /Producer (Anytype) >>", textString(d.title)))
+
+	var out bytes.Buffer
+	out.WriteString("%PDF-1.7\n%\xe2\xe3\xcf\xd3\n")
+	offsets := make([]int, len(d.objects))
+	for i, obj := range d.objects {
+		offsets[i] = out.Len()
+		fmt.Fprintf(&out, "%d 0 obj\n", i+1)
+		out.Write(obj)
+		out.WriteString("\nendobj\n")
+	}
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(d.objects)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root %d 0 R /Info %d 0 R >>\nstartxref\n%d\n%%%%EOF\n",
+		len(d.objects)+1, catalogObj, infoObj, xref)
+	return out.Bytes(), nil
+}
+
+// num formats the number for the PDF content with up to two decimal places
+func num(f float64) string {
+	s := strconv.FormatFloat(f, 'f', 2, 64)
+	s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	if s == "-0" || s == "" {
+		return "0"
+	}
+	return s
+}
+
+// literalString returns the ASCII string as the PDF literal string
+func literalString(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `(`, `\(`, `)`, `\)`, "\r", `\r`, "\n", `\n`)
+	return "(" + r.Replace(s) + ")"
+}
+
+// textString returns the text as the UTF-16 hex string, that is used for the text outside of the page content
+func textString(s string) string {
+	var b strings.Builder
+	b.WriteString("<FEFF")
+	for _, u := range utf16.Encode([]rune(s)) {
+		fmt.Fprintf(&b, "%04X", u)
+	}
+	b.WriteString(">")
+	return b.String()
+}
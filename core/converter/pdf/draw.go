@@ -0,0 +1,109 @@
+package pdf
+
+import (
+	"fmt"
+	"strconv"
+)
+
+type color struct {
+	r, g, b float64
+}
+
+var (
+	colorText       = color{0.17, 0.17, 0.15}
+	colorGrey       = color{0.67, 0.66, 0.59}
+	colorLink       = color{0.16, 0.44, 0.78}
+	colorBorder     = color{0.87, 0.87, 0.82}
+	colorCodeBg     = color{0.97, 0.96, 0.94}
+	colorCalloutBg  = color{0.95, 0.95, 0.93}
+	colorHeaderCell = color{0.97, 0.96, 0.94}
+)
+
+// parseColor returns the color of the anytype palette or the color in #rrggbb notation
+func parseColor(name string, background bool) (color, bool) {
+	palette := textColors
+	if background {
+		palette = backgroundColors
+	}
+	if hex, ok := palette[name]; ok {
+		name = hex
+	}
+	if len(name) != 7 || name[0] != '#' {
+		return color{}, false
+	}
+	v, err := strconv.ParseUint(name[1:], 16, 32)
+	if err != nil {
+		return color{}, false
+	}
+	return color{float64(v>>16&0xff) / 255, float64(v>>8&0xff) / 255, float64(v&0xff) / 255}, true
+}
+
+var textColors = map[string]string{
+	"grey":   "#aca996",
+	"yellow": "#ecd91b",
+	"orange": "#ffb522",
+	"red":    "#f55522",
+	"pink":   "#e51ca0",
+	"purple": "#ab50cc",
+	"blue":   "#3e58eb",
+	"ice":    "#2aa7ee",
+	"teal":   "#0fc8ba",
+	"lime":   "#5dd400",
+	"black":  "#2c2b27",
+}
+
+var backgroundColors = map[string]string{
+	"grey":   "#f3f2ec",
+	"yellow": "#fef9cc",
+	"orange": "#fef3c5",
+	"red":    "#ffebe5",
+	"pink":   "#fee3f5",
+	"purple": "#f4e3fa",
+	"blue":   "#e4e7fc",
+	"ice":    "#d6effd",
+	"teal":   "#d6f5f3",
+	"lime":   "#e3f7d0",
+}
+
+// drawing operations use coordinates from the top left corner of the page, the page converts them to the PDF space
+
+func (d *document) text(p *page, x, y float64, f *font, size float64, c color, text string) {
+	fmt.Fprintf(&p.content, "%s %s %s rg BT /%s %s Tf 1 0 0 1 %s %s Tm %s Tj ET\n",
+		num(c.r), num(c.g), num(c.b), f.resource, num(size), num(x), num(d.height-y), f.encode(text))
+}
+
+func (d *document) fillRect(p *page, x, y, w, h float64, c color) {
+	fmt.Fprintf(&p.content, "%s %s %s rg %s %s %s %s re f\n",
+		num(c.r), num(c.g), num(c.b), num(x), num(d.height-y-h), num(w), num(h))
+}
+
+func (d *document) strokeRect(p *page, x, y, w, h, width float64, c color) {
+	fmt.Fprintf(&p.content, "%s %s %s RG %s w %s %s %s %s re S\n",
+		num(c.r), num(c.g), num(c.b), num(width), num(x), num(d.height-y-h), num(w), num(h))
+}
+
+func (d *document) line(p *page, x1, y1, x2, y2, width float64, c color) {
+	fmt.Fprintf(&p.content, "%s %s %s RG %s w %s %s m %s %s l S\n",
+		num(c.r), num(c.g), num(c.b), num(width), num(x1), num(d.height-y1), num(x2), num(d.height-y2))
+}
+
+// polygon fills the closed path through the points given as x, y pairs
+func (d *document) polygon(p *page, c color, points ...float64) {
+	fmt.Fprintf(&p.content, "%s %s %s rg", num(c.r), num(c.g), num(c.b))
+	for i := 0; i+1 < len(points); i += 2 {
+		op := "l"
+		if i == 0 {
+			op = "m"
+		}
+		fmt.Fprintf(&p.content, " %s %s %s", num(points[i]), num(d.height-points[i+1]), op)
+	}
+	p.content.WriteString(" h f\n")
+}
+
+func (d *document) drawImage(p *page, img *pdfImage, x, y, w, h float64) {
+	fmt.Fprintf(&p.content, "q %s 0 0 %s %s %s cm /%s Do Q\n", num(w), num(h), num(x), num(d.height-y-h), img.resource)
+}
+
+func (d *document) addLink(p *page, x, y, w, h float64, uri string) {
+	p.links = append(p.links, link{x: x, y: d.height - y - h, w: w, h: h, uri: uri})
+}
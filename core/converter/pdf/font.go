@@ -0,0 +1,234 @@
+package pdf
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf16"
+
+	xfont "golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/gobolditalic"
+	"golang.org/x/image/font/gofont/goitalic"
+	"golang.org/x/image/font/gofont/gomono"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+)
+
+// font metrics are measured in the thousandths of em, like the widths in PDF font dictionaries
+var fontPPEM = fixed.I(1000)
+
+// font is the TrueType font embedded into the document as the composite font with Identity-H encoding,
+// so the text is written as glyph indexes and any glyph of the font can be used
+type font struct {
+	resource string
+	baseFont string
+	data     []byte
+	flags    int
+
+	sf     *sfnt.Font
+	buf    sfnt.Buffer
+	glyphs map[rune]glyph
+	// used glyphs with the runes they were used for, needed for the widths and the ToUnicode map
+	used map[sfnt.GlyphIndex]rune
+}
+
+type glyph struct {
+	index sfnt.GlyphIndex
+	width float64
+}
+
+type fontSet struct {
+	regular, bold, italic, boldItalic, mono *font
+}
+
+const (
+	fontFlagFixedPitch  = 1 << 0
+	fontFlagNonSymbolic = 1 << 5
+	fontFlagItalic      = 1 << 6
+)
+
+func newFontSet() (*fontSet, error) {
+	var (
+		fs  fontSet
+		err error
+	)
+	for _, f := range []struct {
+		dst      **font
+		baseFont string
+		data     []byte
+		flags    int
+	}{
+		{&fs.regular, "GoRegular", goregular.TTF, fontFlagNonSymbolic},
+		{&fs.bold, "GoBold", gobold.TTF, fontFlagNonSymbolic},
+		{&fs.italic, "GoItalic", goitalic.TTF, fontFlagNonSymbolic | fontFlagItalic},
+		{&fs.boldItalic, "GoBoldItalic", gobolditalic.TTF, fontFlagNonSymbolic | fontFlagItalic},
+		{&fs.mono, "GoMono", gomono.TTF, fontFlagNonSymbolic | fontFlagFixedPitch},
+	} {
+		if *f.dst, err = newFont(f.baseFont, f.data, f.flags); err != nil {
+			return nil, fmt.Errorf("font %s: %w", f.baseFont, err)
+		}
+	}
+	return &fs, nil
+}
+
+func (fs *fontSet) all() []*font {
+	return []*font{fs.regular, fs.bold, fs.italic, fs.boldItalic, fs.mono}
+}
+
+// get returns the font for the style, the monospace font has no bold and italic faces
+func (fs *fontSet) get(bold, italic, mono bool) *font {
+	switch {
+	case mono:
+		return fs.mono
+	case bold && italic:
+		return fs.boldItalic
+	case bold:
+		return fs.bold
+	case italic:
+		return fs.italic
+	default:
+		return fs.regular
+	}
+}
+
+func newFont(baseFont string, data []byte, flags int) (*font, error) {
+	sf, err := sfnt.Parse(data)
+	if err != nil {
+		return nil, err
+	}
+	return &font{
+		baseFont: baseFont,
+		data:     data,
+		flags:    flags,
+		sf:       sf,
+		glyphs:   map[rune]glyph{},
+		used:     map[sfnt.GlyphIndex]rune{},
+	}, nil
+}
+
+// glyph returns the glyph of the rune, runes missing in the font are replaced with the question mark
+func (f *font) glyph(r rune) glyph {
+	if g, ok := f.glyphs[r]; ok {
+		return g
+	}
+	var g glyph
+	idx, err := f.sf.GlyphIndex(&f.buf, r)
+	if err != nil || idx == 0 {
+		if r != '?' {
+			g = f.glyph('?')
+		}
+	} else if adv, err := f.sf.GlyphAdvance(&f.buf, idx, fontPPEM, xfont.HintingNone); err == nil {
+		g = glyph{index: idx, width: float64(adv) / 64}
+	}
+	f.glyphs[r] = g
+	return g
+}
+
+// covers reports whether all runes of the text have glyphs in the font
+func (f *font) covers(text string) bool {
+	for _, r := range text {
+		if idx, err := f.sf.GlyphIndex(&f.buf, r); err != nil || idx == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// width returns the width of the text in points for the font size
+func (f *font) width(text string, size float64) float64 {
+	var w float64
+	for _, r := range text {
+		w += f.glyph(r).width
+	}
+	return w * size / 1000
+}
+
+// encode returns the text as the hex string of glyph indexes and marks the glyphs as used
+func (f *font) encode(text string) string {
+	var b strings.Builder
+	b.WriteByte('<')
+	for _, r := range text {
+		g := f.glyph(r)
+		if _, ok := f.used[g.index]; !ok {
+			f.used[g.index] = r
+		}
+		fmt.Fprintf(&b, "%04X", uint16(g.index))
+	}
+	b.WriteByte('>')
+	return b.String()
+}
+
+// write adds the font objects to the document and returns the number of the font dictionary
+func (f *font) write(d *document) (int, error) {
+	metrics, err := f.sf.Metrics(&f.buf, fontPPEM, xfont.HintingNone)
+	if err != nil {
+		return 0, err
+	}
+	bounds, err := f.sf.Bounds(&f.buf, fontPPEM, xfont.HintingNone)
+	if err != nil {
+		return 0, err
+	}
+	var italicAngle float64
+	if post := f.sf.PostTable(); post != nil {
+		italicAngle = post.ItalicAngle
+	}
+
+	fileObj := d.addStream(fmt.Sprintf("/Length1 %d", len(f.data)), f.data, true)
+	descriptorObj := d.addObject(fmt.Sprintf(
+		"<< /Type /FontDescriptor /FontName /%s /Flags %d /FontBBox [%d %d %d %d] /ItalicAngle %s "+
+			"/Ascent %d /Descent %d /CapHeight %d /StemV 80 /FontFile2 %d 0 R >>",
+		f.baseFont, f.flags,
+		bounds.Min.X.Round(), -bounds.Max.Y.Round(), bounds.Max.X.Round(), -bounds.Min.Y.Round(),
+		num(italicAngle), metrics.Ascent.Round(), -metrics.Descent.Round(), metrics.CapHeight.Round(), fileObj,
+	))
+
+	indexes := make([]sfnt.GlyphIndex, 0, len(f.used))
+	for idx := range f.used {
+		indexes = append(indexes, idx)
+	}
+	sort.Slice(indexes, func(i, j int) bool { return indexes[i] < indexes[j] })
+
+	var widths strings.Builder
+	for _, idx := range indexes {
+		fmt.Fprintf(&widths, "%d [%s] ", idx, num(f.glyph(f.used[idx]).width))
+	}
+	cidObj := d.addObject(fmt.Sprintf(
+		"<< /Type /Font /Subtype /CIDFontType2 /BaseFont /%s /CIDSystemInfo << /Registry (Adobe) /Ordering (Identity) /Supplement 0 >> "+
+			"/FontDescriptor %d 0 R /DW 1000 /W [%s] /CIDToGIDMap /Identity >>",
+		f.baseFont, descriptorObj, widths.String(),
+	))
+	toUnicodeObj := d.addStream("", f.toUnicode(indexes), true)
+	return d.addObject(fmt.Sprintf(
+		"<< /Type /Font /Subtype /Type0 /BaseFont /%s /Encoding /Identity-H /DescendantFonts [%d 0 R] /ToUnicode %d 0 R >>",
+		f.baseFont, cidObj, toUnicodeObj,
+	)), nil
+}
+
+// toUnicode returns the CMap that maps the used glyphs back to the text, so it can be copied and searched
+func (f *font) toUnicode(indexes []sfnt.GlyphIndex) []byte {
+	var b strings.Builder
+	b.WriteString("/CIDInit /ProcSet findresource begin\n12 dict begin\nbegincmap\n" +
+		"/CIDSystemInfo << /Registry (Adobe) /Ordering (UCS) /Supplement 0 >> def\n" +
+		"/CMapName /Adobe-Identity-UCS def\n/CMapType 2 def\n" +
+		"1 begincodespacerange\n<0000> <FFFF>\nendcodespacerange\n")
+	// the number of entries in one bfchar section is limited to 100
+	for start := 0; start < len(indexes); start += 100 {
+		end := start + 100
+		if end > len(indexes) {
+			end = len(indexes)
+		}
+		fmt.Fprintf(&b, "%d beginbfchar\n", end-start)
+		for _, idx := range indexes[start:end] {
+			fmt.Fprintf(&b, "<%04X> <", uint16(idx))
+			for _, u := range utf16.Encode([]rune{f.used[idx]}) {
+				fmt.Fprintf(&b, "%04X", u)
+			}
+			b.WriteString(">\n")
+		}
+		b.WriteString("endbfchar\n")
+	}
+	b.WriteString("endcmap\nCMapName currentdict /CMap defineresource pop\nend\nend\n")
+	return []byte(b.String())
+}
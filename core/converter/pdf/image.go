@@ -0,0 +1,63 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	imagecolor "image/color"
+	"image/draw"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+
+	_ "golang.org/x/image/webp"
+)
+
+// pdfImage is the image XObject, JPEG images are embedded as is and the other images are decoded to RGB
+type pdfImage struct {
+	resource      string
+	width, height int
+	colorSpace    string
+	filter        string
+	data          []byte
+}
+
+func newImage(data []byte) (*pdfImage, error) {
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("decode image config: %w", err)
+	}
+	if format == "jpeg" {
+		switch cfg.ColorModel {
+		case imagecolor.YCbCrModel, imagecolor.RGBAModel:
+			return &pdfImage{width: cfg.Width, height: cfg.Height, colorSpace: "/DeviceRGB", filter: "/DCTDecode", data: data}, nil
+		case imagecolor.GrayModel:
+			return &pdfImage{width: cfg.Width, height: cfg.Height, colorSpace: "/DeviceGray", filter: "/DCTDecode", data: data}, nil
+		}
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("decode image: %w", err)
+	}
+	// the transparency is dropped, so transparent pixels are drawn over the white background
+	bounds := img.Bounds()
+	rgba := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(rgba, rgba.Bounds(), image.White, image.Point{}, draw.Src)
+	draw.Draw(rgba, rgba.Bounds(), img, bounds.Min, draw.Over)
+
+	rgb := make([]byte, 0, bounds.Dx()*bounds.Dy()*3)
+	for i := 0; i < len(rgba.Pix); i += 4 {
+		rgb = append(rgb, rgba.Pix[i], rgba.Pix[i+1], rgba.Pix[i+2])
+	}
+	return &pdfImage{width: bounds.Dx(), height: bounds.Dy(), colorSpace: "/DeviceRGB", data: rgb}, nil
+}
+
+func (img *pdfImage) write(d *document) int {
+	dict := fmt.Sprintf("/Type /XObject /Subtype /Image /Width %d /Height %d /ColorSpace %s /BitsPerComponent 8",
+		img.width, img.height, img.colorSpace)
+	if img.filter != "" {
+		return d.addStream(dict+" /Filter "+img.filter, img.data, false)
+	}
+	return d.addStream(dict, img.data, true)
+}
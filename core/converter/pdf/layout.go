@@ -0,0 +1,155 @@
+package pdf
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+type style struct {
+	font      *font
+	size      float64
+	color     color
+	underline bool
+	strike    bool
+	link      string
+	// background is used when highlighted is set
+	background  color
+	highlighted bool
+}
+
+type span struct {
+	text  string
+	style style
+}
+
+type line struct {
+	spans []span
+	width float64
+}
+
+// atom is the piece of the text that isn't split on wrapping: the part of the word in one style, a space or a line break
+type atom struct {
+	span
+	width float64
+	space bool
+	br    bool
+}
+
+// wrap splits the spans into lines not wider than maxWidth, words longer than the line are split by runes.
+// Spaces at the beginning of the lines are kept only when keepSpaces is set, e.g. for the code
+func wrap(spans []span, maxWidth float64, keepSpaces bool) []line {
+	var (
+		lines []line
+		cur   []atom
+		width float64
+		word  []atom
+		wordW float64
+	)
+	pushLine := func() {
+		for len(cur) > 0 && cur[len(cur)-1].space {
+			width -= cur[len(cur)-1].width
+			cur = cur[:len(cur)-1]
+		}
+		lines = append(lines, newLine(cur, width))
+		cur, width = nil, 0
+	}
+	flushWord := func() {
+		if len(word) == 0 {
+			return
+		}
+		if width+wordW > maxWidth && len(cur) > 0 {
+			pushLine()
+		}
+		if wordW <= maxWidth {
+			cur = append(cur, word...)
+			width += wordW
+		} else {
+			for _, a := range word {
+				for _, r := range a.text {
+					ra := atom{span: span{text: string(r), style: a.style}}
+					ra.width = a.style.font.width(ra.text, a.style.size)
+					if width+ra.width > maxWidth && len(cur) > 0 {
+						pushLine()
+					}
+					cur = append(cur, ra)
+					width += ra.width
+				}
+			}
+		}
+		word, wordW = nil, 0
+	}
+
+	for _, a := range atomize(spans) {
+		switch {
+		case a.br:
+			flushWord()
+			pushLine()
+		case a.space:
+			flushWord()
+			if len(cur) > 0 || keepSpaces {
+				cur = append(cur, a)
+				width += a.width
+			}
+		default:
+			word = append(word, a)
+			wordW += a.width
+		}
+	}
+	flushWord()
+	if len(cur) > 0 || len(lines) == 0 {
+		pushLine()
+	}
+	return lines
+}
+
+func atomize(spans []span) []atom {
+	var atoms []atom
+	for _, s := range spans {
+		text := s.text
+		for text != "" {
+			r, size := utf8.DecodeRuneInString(text)
+			var a atom
+			switch {
+			case r == '\n':
+				a = atom{span: span{style: s.style}, br: true}
+			case r == ' ' || r == '\t':
+				a = atom{span: span{text: " ", style: s.style}, space: true}
+			default:
+				end := strings.IndexAny(text, " \t\n")
+				if end < 0 {
+					end = len(text)
+				}
+				size = end
+				a = atom{span: span{text: text[:end], style: s.style}}
+			}
+			a.width = s.style.font.width(a.text, s.style.size)
+			atoms = append(atoms, a)
+			text = text[size:]
+		}
+	}
+	return atoms
+}
+
+// newLine merges the neighbour atoms of the same style
+func newLine(atoms []atom, width float64) line {
+	l := line{width: width}
+	for _, a := range atoms {
+		if n := len(l.spans); n > 0 && l.spans[n-1].style == a.style {
+			l.spans[n-1].text += a.text
+			continue
+		}
+		l.spans = append(l.spans, a.span)
+	}
+	return l
+}
+
+// height returns the height of the line for the line spacing factor
+func (l line) height(defaultSize, spacing float64) float64 {
+	size := defaultSize
+	for _, s := range l.spans {
+		if s.style.size > size {
+			size = s.style.size
+		}
+	}
+	return size * spacing
+}
@@ -0,0 +1,57 @@
+package pdf
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWrap(t *testing.T) {
+	fs, err := newFontSet()
+	require.NoError(t, err)
+	st := style{font: fs.mono, size: 10}
+	// the width of the monospace rune
+	charWidth := fs.mono.width("a", 10)
+
+	texts := func(lines []line) []string {
+		var res []string
+		for _, l := range lines {
+			var text string
+			for _, s := range l.spans {
+				text += s.text
+			}
+			res = append(res, text)
+		}
+		return res
+	}
+
+	t.Run("words are wrapped", func(t *testing.T) {
+		lines := wrap([]span{{text: "aaa bbb ccc", style: st}}, charWidth*7.5, false)
+		assert.Equal(t, []string{"aaa bbb", "ccc"}, texts(lines))
+	})
+
+	t.Run("long words are split", func(t *testing.T) {
+		lines := wrap([]span{{text: "abcdefgh", style: st}}, charWidth*3.5, false)
+		assert.Equal(t, []string{"abc", "def", "gh"}, texts(lines))
+	})
+
+	t.Run("line breaks and styles", func(t *testing.T) {
+		boldSt := style{font: fs.bold, size: 10}
+		lines := wrap([]span{{text: "a\n\nb", style: st}, {text: "c", style: boldSt}}, 100, false)
+		require.Equal(t, []string{"a", "", "bc"}, texts(lines))
+		assert.Len(t, lines[2].spans, 2)
+	})
+
+	t.Run("leading spaces are kept for code", func(t *testing.T) {
+		lines := wrap([]span{{text: "if\n  a", style: st}}, 100, true)
+		assert.Equal(t, []string{"if", "  a"}, texts(lines))
+
+		lines = wrap([]span{{text: "if\n  a", style: st}}, 100, false)
+		assert.Equal(t, []string{"if", "a"}, texts(lines))
+	})
+
+	t.Run("empty text has one line", func(t *testing.T) {
+		assert.Len(t, wrap(nil, 100, false), 1)
+	})
+}
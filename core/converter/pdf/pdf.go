@@ -0,0 +1,887 @@
+package pdf
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/editor/table"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/converter"
+	"github.com/anyproto/anytype-heart/core/files"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+	utf16 "github.com/anyproto/anytype-heart/util/text"
+)
+
+var logger = logging.Logger("pdf-export")
+
+// PageSize is the size of the page in points
+type PageSize struct {
+	Width, Height float64
+}
+
+var (
+	PageSizeA4     = PageSize{Width: 595.28, Height: 841.89}
+	PageSizeLetter = PageSize{Width: 612, Height: 792}
+	PageSizeLegal  = PageSize{Width: 612, Height: 1008}
+	PageSizeA3     = PageSize{Width: 841.89, Height: 1190.55}
+	PageSizeA5     = PageSize{Width: 419.53, Height: 595.28}
+)
+
+type Options struct {
+	// PageSize is A4 when it's not set
+	PageSize  PageSize
+	Landscape bool
+	// Margin is the page margin in points, DefaultMargin is used when it's not set
+	Margin float64
+	// Report renders sets and collections as the table of their records instead of the blocks
+	Report bool
+}
+
+// DefaultMargin is 20 mm
+var DefaultMargin = Millimeters(20)
+
+// Millimeters converts millimeters to points
+func Millimeters(mm float64) float64 {
+	return mm * 72 / 25.4
+}
+
+const (
+	fontSizeText   = 11
+	fontSizeCode   = 9.5
+	fontSizeTable  = 10
+	fontSizeFooter = 8
+
+	lineSpacing      = 1.4
+	tableLineSpacing = 1.3
+	indentStep       = 18
+	boxPadding       = 8
+	cellPadding      = 4
+	blockSpacing     = 4
+	// default width of the table column in Anytype, in pixels
+	defaultColumnWidth = 140
+	maxColumnWidth     = 220
+	minColumnWidth     = 40
+)
+
+func NewConverter(fileService files.Service, s *state.State, opts Options, dataview converter.DataviewSource) converter.Converter {
+	if opts.PageSize.Width <= 0 || opts.PageSize.Height <= 0 {
+		opts.PageSize = PageSizeA4
+	}
+	if opts.Landscape {
+		opts.PageSize.Width, opts.PageSize.Height = opts.PageSize.Height, opts.PageSize.Width
+	}
+	if opts.Margin <= 0 {
+		opts.Margin = DefaultMargin
+	}
+	// margins can't take the whole page
+	if maxMargin := opts.PageSize.Width / 4; opts.Margin > maxMargin {
+		opts.Margin = maxMargin
+	}
+	return &PDF{fileService: fileService, s: s, opts: opts, dataview: dataview}
+}
+
+type PDF struct {
+	s           *state.State
+	fileService files.Service
+	dataview    converter.DataviewSource
+	opts        Options
+	knownDocs   map[string]*types.Struct
+
+	doc   *document
+	fonts *fontSet
+	page  *page
+	// y is the distance from the top of the page to the current position
+	y float64
+}
+
+func (p *PDF) Convert(model.SmartBlockType) []byte {
+	result, err := p.convert()
+	if err != nil {
+		logger.Errorf("convert %s to pdf: %v", p.s.RootId(), err)
+		return nil
+	}
+	return result
+}
+
+func (p *PDF) convert() ([]byte, error) {
+	fonts, err := newFontSet()
+	if err != nil {
+		return nil, err
+	}
+	p.fonts = fonts
+	p.doc = newDocument(p.opts.PageSize.Width, p.opts.PageSize.Height, fonts.all())
+	p.doc.title = pbtypes.GetString(p.s.Details(), bundle.RelationKeyName.String())
+	p.newPage()
+
+	if p.opts.Report && isSetOrCollection(p.s.Details()) {
+		p.renderReport()
+	} else if root := p.s.Pick(p.s.RootId()); root != nil {
+		p.renderChildren(root.Model(), 0)
+	}
+	p.drawPageNumbers()
+	return p.doc.bytes()
+}
+
+func (p *PDF) SetKnownDocs(docs map[string]*types.Struct) converter.Converter {
+	p.knownDocs = docs
+	return p
+}
+
+func (p *PDF) FileHashes() []string {
+	return nil
+}
+
+func (p *PDF) ImageHashes() []string {
+	return nil
+}
+
+func (p *PDF) Ext() string {
+	return ".pdf"
+}
+
+func isSetOrCollection(details *types.Struct) bool {
+	layout := model.ObjectTypeLayout(pbtypes.GetInt64(details, bundle.RelationKeyLayout.String()))
+	return layout == model.ObjectType_set || layout == model.ObjectType_collection
+}
+
+func (p *PDF) left() float64 {
+	return p.opts.Margin
+}
+
+func (p *PDF) contentWidth() float64 {
+	return p.opts.PageSize.Width - 2*p.opts.Margin
+}
+
+func (p *PDF) bottom() float64 {
+	return p.opts.PageSize.Height - p.opts.Margin
+}
+
+func (p *PDF) newPage() {
+	p.page = p.doc.newPage()
+	p.y = p.opts.Margin
+}
+
+// ensureSpace moves to the next page when the content of the height doesn't fit the rest of the page
+func (p *PDF) ensureSpace(height float64) {
+	if p.y+height > p.bottom() && p.y > p.opts.Margin {
+		p.newPage()
+	}
+}
+
+func (p *PDF) drawPageNumbers() {
+	for i, pg := range p.doc.pages {
+		text := fmt.Sprintf("%d / %d", i+1, len(p.doc.pages))
+		w := p.fonts.regular.width(text, fontSizeFooter)
+		p.doc.text(pg, (p.opts.PageSize.Width-w)/2, p.opts.PageSize.Height-p.opts.Margin/2, p.fonts.regular, fontSizeFooter, colorGrey, text)
+	}
+}
+
+func (p *PDF) renderChildren(parent *model.Block, indent float64) {
+	var number int
+	for _, id := range parent.ChildrenIds {
+		b := p.s.Pick(id)
+		if b == nil {
+			continue
+		}
+		m := b.Model()
+		if m.GetText().GetStyle() == model.BlockContentText_Numbered {
+			number++
+		} else {
+			number = 0
+		}
+		p.render(m, indent, number)
+	}
+}
+
+func (p *PDF) render(b *model.Block, indent float64, number int) {
+	switch content := b.Content.(type) {
+	case *model.BlockContentOfText:
+		p.renderText(b, indent, number)
+		return
+	case *model.BlockContentOfFile:
+		p.renderFile(b, indent)
+	case *model.BlockContentOfBookmark:
+		p.renderBookmark(content.Bookmark, indent)
+	case *model.BlockContentOfLink:
+		p.renderLink(content.Link, indent)
+	case *model.BlockContentOfDiv:
+		p.renderDiv(content.Div, indent)
+	case *model.BlockContentOfLatex:
+		p.renderCode(content.Latex.Text, indent)
+	case *model.BlockContentOfDiagram:
+		p.renderCode(content.Diagram.Source, indent)
+	case *model.BlockContentOfTable:
+		p.renderTable(b, indent)
+		return
+	case *model.BlockContentOfDataview:
+		p.renderDataview(b, indent, false)
+	case *model.BlockContentOfFeaturedRelations:
+		return
+	}
+	p.renderChildren(b, indent)
+}
+
+// format describes the text style before the font is chosen
+type format struct {
+	bold, italic, mono bool
+	underline, strike  bool
+	size               float64
+	color              color
+	background         color
+	highlighted        bool
+	link               string
+}
+
+func (p *PDF) style(f format) style {
+	return style{
+		font:        p.fonts.get(f.bold, f.italic, f.mono),
+		size:        f.size,
+		color:       f.color,
+		underline:   f.underline,
+		strike:      f.strike,
+		link:        f.link,
+		background:  f.background,
+		highlighted: f.highlighted,
+	}
+}
+
+func (p *PDF) renderText(b *model.Block, indent float64, number int) {
+	text := b.GetText()
+	if text.Style == model.BlockContentText_Code {
+		p.renderCode(text.Text, indent)
+		p.renderChildren(b, indent+indentStep)
+		return
+	}
+
+	f := format{size: fontSizeText, color: colorText}
+	if c, ok := parseColor(text.Color, false); ok {
+		f.color = c
+	}
+	var (
+		before, after float64 = 0, blockSpacing
+		x                     = p.left() + indent
+		marker        func(top, height float64)
+		background    *color
+	)
+	if c, ok := parseColor(b.BackgroundColor, true); ok {
+		background = &c
+	}
+	switch text.Style {
+	case model.BlockContentText_Title:
+		f.bold, f.size, after = true, 24, 12
+	case model.BlockContentText_Header1:
+		f.bold, f.size, before, after = true, 20, 12, 6
+	case model.BlockContentText_Header2:
+		f.bold, f.size, before, after = true, 16, 10, 4
+	case model.BlockContentText_Header3:
+		f.bold, f.size, before = true, 13, 8
+	case model.BlockContentText_Header4:
+		f.bold, f.size, before = true, 12, 6
+	case model.BlockContentText_Description:
+		f.size, f.color, after = 12, colorGrey, 10
+	case model.BlockContentText_Quote:
+		f.italic, f.size = true, 12
+		x += 12
+		marker = func(top, height float64) {
+			p.doc.fillRect(p.page, x-12, top, 2, height, colorText)
+		}
+	case model.BlockContentText_Marked:
+		x += indentStep
+		marker = func(top, height float64) {
+			p.doc.text(p.page, x-indentStep+4, baseline(top, height, f.size), p.fonts.regular, f.size, f.color, "•")
+		}
+	case model.BlockContentText_Numbered:
+		x += indentStep
+		marker = func(top, height float64) {
+			label := strconv.Itoa(number) + "."
+			w := p.fonts.regular.width(label, f.size)
+			p.doc.text(p.page, x-4-w, baseline(top, height, f.size), p.fonts.regular, f.size, f.color, label)
+		}
+	case model.BlockContentText_Checkbox:
+		x += indentStep
+		marker = func(top, height float64) {
+			const size = 9
+			bx, by := x-indentStep+2, top+(height-size)/2
+			p.doc.strokeRect(p.page, bx, by, size, size, 0.8, f.color)
+			if text.Checked {
+				p.doc.line(p.page, bx+2, by+4.5, bx+4, by+7, 1.2, f.color)
+				p.doc.line(p.page, bx+4, by+7, bx+7.5, by+2, 1.2, f.color)
+			}
+		}
+	case model.BlockContentText_Toggle:
+		x += indentStep
+		marker = func(top, height float64) {
+			cx, cy := x-indentStep+6, top+height/2
+			p.doc.polygon(p.page, f.color, cx-4, cy-2.5, cx+4, cy-2.5, cx, cy+3)
+		}
+	case model.BlockContentText_Callout:
+		if background == nil {
+			background = &colorCalloutBg
+		}
+		before, after = 2, 6
+	}
+
+	spans := p.textSpans(text, f)
+	// emoji are drawn only when the font has them
+	if icon := text.IconEmoji; text.Style == model.BlockContentText_Callout && icon != "" && p.fonts.regular.covers(icon) {
+		spans = append([]span{{text: icon + "  ", style: p.style(f)}}, spans...)
+	}
+	p.y += before
+	width := p.opts.PageSize.Width - p.opts.Margin - x
+	p.drawParagraph(spans, x, width, f.size, false, background, marker)
+	p.y += after
+	p.renderChildren(b, indent+indentStep)
+}
+
+// textSpans splits the text into spans by marks
+func (p *PDF) textSpans(text *model.BlockContentText, base format) []span {
+	u := utf16.StrToUTF16(text.Text)
+	var marks []*model.BlockContentTextMark
+	bounds := []int{0, len(u)}
+	for _, m := range text.GetMarks().GetMarks() {
+		if m.Range == nil {
+			continue
+		}
+		from, to := clamp(int(m.Range.From), 0, len(u)), clamp(int(m.Range.To), 0, len(u))
+		if from >= to {
+			continue
+		}
+		marks = append(marks, m)
+		bounds = append(bounds, from, to)
+	}
+	sort.Ints(bounds)
+
+	var spans []span
+	for i := 0; i+1 < len(bounds); i++ {
+		from, to := bounds[i], bounds[i+1]
+		if from == to {
+			continue
+		}
+		f := base
+		for _, m := range marks {
+			if int(m.Range.From) <= from && int(m.Range.To) >= to {
+				applyMark(&f, m)
+			}
+		}
+		spans = append(spans, span{text: utf16.UTF16ToStr(u[from:to]), style: p.style(f)})
+	}
+	return spans
+}
+
+func applyMark(f *format, m *model.BlockContentTextMark) {
+	switch m.Type {
+	case model.BlockContentTextMark_Bold:
+		f.bold = true
+	case model.BlockContentTextMark_Italic:
+		f.italic = true
+	case model.BlockContentTextMark_Strikethrough:
+		f.strike = true
+	case model.BlockContentTextMark_Underscored:
+		f.underline = true
+	case model.BlockContentTextMark_Keyboard:
+		f.mono = true
+	case model.BlockContentTextMark_Link:
+		f.link, f.color, f.underline = m.Param, colorLink, true
+	case model.BlockContentTextMark_Mention, model.BlockContentTextMark_Object:
+		f.underline = true
+	case model.BlockContentTextMark_TextColor:
+		if c, ok := parseColor(m.Param, false); ok {
+			f.color = c
+		}
+	case model.BlockContentTextMark_BackgroundColor:
+		if c, ok := parseColor(m.Param, true); ok {
+			f.background, f.highlighted = c, true
+		}
+	}
+}
+
+func clamp(v, min, max int) int {
+	if v < min {
+		return min
+	}
+	if v > max {
+		return max
+	}
+	return v
+}
+
+// baseline returns the baseline of the text of the size in the line
+func baseline(top, height, size float64) float64 {
+	return top + height/2 + size*0.3
+}
+
+// drawParagraph wraps the spans into the width and draws the lines from the current position,
+// the background box is drawn with the padding around the text, the marker is drawn near the first line
+func (p *PDF) drawParagraph(spans []span, x, width, size float64, keepSpaces bool, background *color, marker func(top, height float64)) {
+	if background != nil {
+		x += boxPadding
+		width -= 2 * boxPadding
+		p.ensureSpace(boxPadding + size*lineSpacing)
+		p.doc.fillRect(p.page, x-boxPadding, p.y, width+2*boxPadding, boxPadding, *background)
+		p.y += boxPadding
+	}
+	for i, l := range wrap(spans, width, keepSpaces) {
+		h := l.height(size, lineSpacing)
+		if p.y+h > p.bottom() && p.y > p.opts.Margin {
+			p.newPage()
+		}
+		if background != nil {
+			p.doc.fillRect(p.page, x-boxPadding, p.y, width+2*boxPadding, h, *background)
+		}
+		if i == 0 && marker != nil {
+			marker(p.y, h)
+		}
+		p.drawLine(l, x, p.y, h)
+		p.y += h
+	}
+	if background != nil {
+		p.doc.fillRect(p.page, x-boxPadding, p.y, width+2*boxPadding, boxPadding, *background)
+		p.y += boxPadding
+	}
+}
+
+func (p *PDF) drawLine(l line, x, top, height float64) {
+	var size float64
+	for _, s := range l.spans {
+		if s.style.size > size {
+			size = s.style.size
+		}
+	}
+	y := baseline(top, height, size)
+	for _, s := range l.spans {
+		st := s.style
+		w := st.font.width(s.text, st.size)
+		if st.highlighted {
+			p.doc.fillRect(p.page, x, y-st.size*0.9, w, st.size*1.2, st.background)
+		}
+		if strings.TrimSpace(s.text) != "" {
+			p.doc.text(p.page, x, y, st.font, st.size, st.color, s.text)
+		}
+		if st.underline {
+			p.doc.line(p.page, x, y+st.size*0.12, x+w, y+st.size*0.12, st.size*0.06, st.color)
+		}
+		if st.strike {
+			p.doc.line(p.page, x, y-st.size*0.3, x+w, y-st.size*0.3, st.size*0.06, st.color)
+		}
+		if st.link != "" {
+			p.doc.addLink(p.page, x, y-st.size*0.9, w, st.size*1.2, st.link)
+		}
+		x += w
+	}
+}
+
+// renderCode draws the text with the monospace font in the box, it's used for code, LaTeX and diagram sources
+func (p *PDF) renderCode(text string, indent float64) {
+	text = strings.ReplaceAll(text, "\t", "    ")
+	st := p.style(format{mono: true, size: fontSizeCode, color: colorText})
+	p.y += 2
+	p.drawParagraph([]span{{text: text, style: st}}, p.left()+indent, p.contentWidth()-indent, fontSizeCode, true, &colorCodeBg, nil)
+	p.y += blockSpacing + 2
+}
+
+func (p *PDF) renderFile(b *model.Block, indent float64) {
+	file := b.GetFile()
+	if file.State != model.BlockContentFile_Done {
+		return
+	}
+	if file.Type == model.BlockContentFile_Image {
+		err := p.renderImage(file.Hash, pbtypes.GetFloat64(b.Fields, "width"), indent)
+		if err == nil {
+			return
+		}
+		logger.With("hash", file.Hash).Warnf("can't render image: %v", err)
+	}
+	st := p.style(format{size: fontSizeText, color: colorGrey})
+	p.drawParagraph([]span{{text: file.Name, style: st}}, p.left()+indent, p.contentWidth()-indent, fontSizeText, false, nil, nil)
+	p.y += blockSpacing
+}
+
+// renderImage draws the image scaled to the width fraction of the content or to its size,
+// images are never wider than the content and higher than the page
+func (p *PDF) renderImage(hash string, widthFraction float64, indent float64) error {
+	if p.fileService == nil {
+		return fmt.Errorf("file service is not available")
+	}
+	img, err := p.fileService.ImageByHash(context.TODO(), hash)
+	if err != nil {
+		return err
+	}
+	f, err := img.GetFileForWidth(context.TODO(), 1024)
+	if err != nil {
+		return err
+	}
+	rd, err := f.Reader(context.TODO())
+	if err != nil {
+		return err
+	}
+	data, err := io.ReadAll(rd)
+	if err != nil {
+		return err
+	}
+	pi, err := newImage(data)
+	if err != nil {
+		return err
+	}
+	p.drawImage(p.doc.addImage(pi), widthFraction, indent)
+	return nil
+}
+
+func (p *PDF) drawImage(img *pdfImage, widthFraction float64, indent float64) {
+	maxWidth := p.contentWidth() - indent
+	// pixels are converted to points with 96 dpi
+	w := float64(img.width) * 0.75
+	if widthFraction > 0 {
+		w = maxWidth * widthFraction
+	}
+	if w > maxWidth {
+		w = maxWidth
+	}
+	h := w * float64(img.height) / float64(img.width)
+	if maxHeight := p.bottom() - p.opts.Margin; h > maxHeight {
+		h = maxHeight
+		w = h * float64(img.width) / float64(img.height)
+	}
+	p.ensureSpace(h)
+	p.doc.drawImage(p.page, img, p.left()+indent, p.y, w, h)
+	p.y += h + blockSpacing
+}
+
+func (p *PDF) renderBookmark(bm *model.BlockContentBookmark, indent float64) {
+	if bm.Url == "" {
+		return
+	}
+	title := bm.Title
+	if title == "" {
+		title = bm.Url
+	}
+	spans := []span{{text: title, style: p.style(format{bold: true, size: fontSizeText, color: colorText, link: bm.Url})}}
+	if bm.Description != "" {
+		spans = append(spans, span{text: "\n" + bm.Description, style: p.style(format{size: fontSizeText - 1, color: colorGrey})})
+	}
+	spans = append(spans, span{text: "\n" + bm.Url, style: p.style(format{size: fontSizeText - 1, color: colorLink, link: bm.Url})})
+	p.drawParagraph(spans, p.left()+indent, p.contentWidth()-indent, fontSizeText, false, &colorCalloutBg, nil)
+	p.y += blockSpacing
+}
+
+func (p *PDF) renderLink(l *model.BlockContentLink, indent float64) {
+	title, ok := p.docTitle(l.TargetBlockId)
+	if !ok {
+		return
+	}
+	st := p.style(format{bold: true, underline: true, size: fontSizeText, color: colorText})
+	p.drawParagraph([]span{{text: title, style: st}}, p.left()+indent, p.contentWidth()-indent, fontSizeText, false, nil, nil)
+	p.y += blockSpacing
+}
+
+func (p *PDF) docTitle(id string) (title string, ok bool) {
+	info, ok := p.knownDocs[id]
+	if !ok {
+		return "", false
+	}
+	title = pbtypes.GetString(info, bundle.RelationKeyName.String())
+	if title == "" {
+		title = pbtypes.GetString(info, bundle.RelationKeySnippet.String())
+	}
+	if title == "" {
+		title = id
+	}
+	return title, true
+}
+
+func (p *PDF) renderDiv(div *model.BlockContentDiv, indent float64) {
+	p.ensureSpace(16)
+	x, w := p.left()+indent, p.contentWidth()-indent
+	switch div.Style {
+	case model.BlockContentDiv_Line:
+		p.doc.line(p.page, x, p.y+8, x+w, p.y+8, 0.8, colorBorder)
+	case model.BlockContentDiv_Dots:
+		dots := "•   •   •"
+		dw := p.fonts.regular.width(dots, fontSizeText)
+		p.doc.text(p.page, x+(w-dw)/2, p.y+12, p.fonts.regular, fontSizeText, colorGrey, dots)
+	}
+	p.y += 16
+}
+
+type cell struct {
+	spans       []span
+	background  color
+	highlighted bool
+}
+
+// drawTable draws the rows of cells, rows aren't split between pages and the header rows are repeated on every page
+func (p *PDF) drawTable(x float64, widths []float64, rows [][]cell, headerRows int) {
+	layout := func(row []cell) (lines [][]line, height float64) {
+		lines = make([][]line, len(widths))
+		for i := range widths {
+			var spans []span
+			if i < len(row) {
+				spans = row[i].spans
+			}
+			lines[i] = wrap(spans, widths[i]-2*cellPadding, false)
+			var h float64
+			for _, l := range lines[i] {
+				h += l.height(fontSizeTable, tableLineSpacing)
+			}
+			if h > height {
+				height = h
+			}
+		}
+		return lines, height + 2*cellPadding
+	}
+	drawRow := func(row []cell) {
+		lines, height := layout(row)
+		cx := x
+		for i, w := range widths {
+			if i < len(row) && row[i].highlighted {
+				p.doc.fillRect(p.page, cx, p.y, w, height, row[i].background)
+			}
+			top := p.y + cellPadding
+			for _, l := range lines[i] {
+				h := l.height(fontSizeTable, tableLineSpacing)
+				p.drawLine(l, cx+cellPadding, top, h)
+				top += h
+			}
+			p.doc.strokeRect(p.page, cx, p.y, w, height, 0.5, colorBorder)
+			cx += w
+		}
+		p.y += height
+	}
+
+	for i, row := range rows {
+		if _, height := layout(row); p.y+height > p.bottom() && p.y > p.opts.Margin {
+			p.newPage()
+			if i >= headerRows {
+				for _, header := range rows[:headerRows] {
+					drawRow(header)
+				}
+			}
+		}
+		drawRow(row)
+	}
+	p.y += blockSpacing * 2
+}
+
+func (p *PDF) renderTable(b *model.Block, indent float64) {
+	tb, err := table.NewTable(p.s, b.Id)
+	if err != nil {
+		return
+	}
+	colIDs := tb.ColumnIDs()
+	widths := make([]float64, len(colIDs))
+	var total float64
+	for i, colID := range colIDs {
+		widths[i] = defaultColumnWidth
+		if col := p.s.Pick(colID); col != nil {
+			if w := pbtypes.GetFloat64(col.Model().GetFields(), "width"); w > 0 {
+				widths[i] = w
+			}
+		}
+		total += widths[i]
+	}
+	// pixels are converted to points with 96 dpi, wide tables are shrunk to the content
+	scale := 0.75
+	if available := p.contentWidth() - indent; total*scale > available {
+		scale = available / total
+	}
+	for i := range widths {
+		widths[i] *= scale
+	}
+
+	var (
+		rows       [][]cell
+		headerRows int
+	)
+	for _, rowID := range tb.RowIDs() {
+		row := p.s.Pick(rowID)
+		if row == nil {
+			continue
+		}
+		isHeader := row.Model().GetTableRow().GetIsHeader()
+		if isHeader && headerRows == len(rows) {
+			headerRows++
+		}
+		cells := make(map[string]simple.Block, len(row.Model().ChildrenIds))
+		for _, cellID := range row.Model().ChildrenIds {
+			if _, colID, err := table.ParseCellID(cellID); err == nil {
+				cells[colID] = p.s.Pick(cellID)
+			}
+		}
+		rowCells := make([]cell, len(colIDs))
+		for i, colID := range colIDs {
+			c := &rowCells[i]
+			if isHeader {
+				c.background, c.highlighted = colorHeaderCell, true
+			}
+			cb := cells[colID]
+			if cb == nil {
+				continue
+			}
+			if bg, ok := parseColor(cb.Model().BackgroundColor, true); ok {
+				c.background, c.highlighted = bg, true
+			}
+			if text := cb.Model().GetText(); text != nil {
+				c.spans = p.textSpans(text, format{bold: isHeader, size: fontSizeTable, color: colorText})
+			}
+		}
+		rows = append(rows, rowCells)
+	}
+	p.y += 2
+	p.drawTable(p.left()+indent, widths, rows, headerRows)
+}
+
+// renderDataview draws the records of the active view as the table, the report also has the number of records
+func (p *PDF) renderDataview(b *model.Block, indent float64, report bool) {
+	dv := b.GetDataview()
+	if p.dataview == nil || len(dv.Views) == 0 {
+		return
+	}
+	view := converter.DataviewView(dv, "")
+	res, err := p.dataview.DataviewRecords(b.Id, view.Id)
+	if err != nil {
+		logger.With("blockID", b.Id).Warnf("can't get dataview records: %v", err)
+		return
+	}
+	relations, records := res.Relations, res.Records
+	if len(relations) == 0 {
+		return
+	}
+	names := make(map[string]string, len(res.Dependencies))
+	for _, d := range res.Dependencies {
+		names[pbtypes.GetString(d, bundle.RelationKeyId.String())] = pbtypes.GetString(d, bundle.RelationKeyName.String())
+	}
+
+	x, available := p.left()+indent, p.contentWidth()-indent
+	var title []span
+	if view.Name != "" {
+		title = append(title, span{text: view.Name, style: p.style(format{bold: true, size: 12, color: colorText})})
+	}
+	if report {
+		title = append(title, span{text: fmt.Sprintf("   %d records", len(records)), style: p.style(format{size: 10, color: colorGrey})})
+	}
+	if len(title) > 0 {
+		p.y += 4
+		p.drawParagraph(title, x, available, 12, false, nil, nil)
+		p.y += 2
+	}
+
+	header := make([]cell, len(relations))
+	for i, rel := range relations {
+		header[i] = cell{
+			spans:       []span{{text: rel.Name, style: p.style(format{bold: true, size: fontSizeTable, color: colorText})}},
+			background:  colorHeaderCell,
+			highlighted: true,
+		}
+	}
+	rows := [][]cell{header}
+	valueStyle := p.style(format{size: fontSizeTable, color: colorText})
+	for _, rec := range records {
+		row := make([]cell, len(relations))
+		for i, rel := range relations {
+			if v := relationValue(rec, rel, names); v != "" {
+				row[i].spans = []span{{text: v, style: valueStyle}}
+			}
+		}
+		rows = append(rows, row)
+	}
+	p.drawTable(x, p.columnWidths(rows, available), rows, 1)
+}
+
+// columnWidths returns widths of the columns proportional to the widths of their content that fill the available width
+func (p *PDF) columnWidths(rows [][]cell, available float64) []float64 {
+	widths := make([]float64, len(rows[0]))
+	for _, row := range rows {
+		for i, c := range row {
+			var w float64
+			for _, s := range c.spans {
+				w += s.style.font.width(s.text, s.style.size)
+			}
+			w += 2 * cellPadding
+			if w > maxColumnWidth {
+				w = maxColumnWidth
+			}
+			if w < minColumnWidth {
+				w = minColumnWidth
+			}
+			if w > widths[i] {
+				widths[i] = w
+			}
+		}
+	}
+	var total float64
+	for _, w := range widths {
+		total += w
+	}
+	for i := range widths {
+		widths[i] *= available / total
+	}
+	return widths
+}
+
+// relationValue returns the value of the relation as the text
+func relationValue(rec *types.Struct, rel *model.Relation, names map[string]string) string {
+	v := pbtypes.Get(rec, rel.Key)
+	if v == nil {
+		return ""
+	}
+	switch rel.Format {
+	case model.RelationFormat_checkbox:
+		if v.GetBoolValue() {
+			return "Yes"
+		}
+		return ""
+	case model.RelationFormat_number:
+		if _, ok := v.Kind.(*types.Value_NumberValue); ok {
+			return strconv.FormatFloat(v.GetNumberValue(), 'f', -1, 64)
+		}
+		return ""
+	case model.RelationFormat_date:
+		if ts := int64(v.GetNumberValue()); ts != 0 {
+			return time.Unix(ts, 0).Format("2006-01-02")
+		}
+		return ""
+	case model.RelationFormat_status, model.RelationFormat_tag, model.RelationFormat_object, model.RelationFormat_file:
+		ids := pbtypes.GetStringListValue(v)
+		values := make([]string, 0, len(ids))
+		for _, id := range ids {
+			if name := names[id]; name != "" {
+				values = append(values, name)
+			}
+		}
+		return strings.Join(values, ", ")
+	default:
+		if list := v.GetListValue(); list != nil {
+			return strings.Join(pbtypes.GetStringListValue(v), ", ")
+		}
+		return pbtypes.GetString(rec, rel.Key)
+	}
+}
+
+// renderReport draws the name and the description of the set or collection and the tables of its dataview blocks
+func (p *PDF) renderReport() {
+	details := p.s.Details()
+	name := pbtypes.GetString(details, bundle.RelationKeyName.String())
+	p.drawParagraph([]span{{text: name, style: p.style(format{bold: true, size: 22, color: colorText})}},
+		p.left(), p.contentWidth(), 22, false, nil, nil)
+	if description := pbtypes.GetString(details, bundle.RelationKeyDescription.String()); description != "" {
+		p.drawParagraph([]span{{text: description, style: p.style(format{size: 12, color: colorGrey})}},
+			p.left(), p.contentWidth(), 12, false, nil, nil)
+	}
+	p.y += 8
+	_ = p.s.Iterate(func(b simple.Block) bool {
+		if b.Model().GetDataview() != nil {
+			p.renderDataview(b.Model(), 0, true)
+		}
+		return true
+	})
+}
@@ -0,0 +1,286 @@
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/converter"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+// newDoc returns the state with the blocks as children of the root, blocks with ids starting with "/" are nested ones
+func newDoc(details *types.Struct, blocks ...*model.Block) *state.State {
+	root := &model.Block{Id: "root"}
+	m := map[string]simple.Block{}
+	for _, b := range blocks {
+		if !strings.HasPrefix(b.Id, "/") {
+			root.ChildrenIds = append(root.ChildrenIds, b.Id)
+		}
+		b.Id = strings.TrimPrefix(b.Id, "/")
+		m[b.Id] = simple.New(b)
+	}
+	m["root"] = simple.New(root)
+	s := state.NewDoc("root", m).(*state.State)
+	if details != nil {
+		s.SetDetails(details)
+	}
+	return s
+}
+
+func textBlock(id string, style model.BlockContentTextStyle, text string) *model.Block {
+	return &model.Block{Id: id, Content: &model.BlockContentOfText{Text: &model.BlockContentText{Text: text, Style: style}}}
+}
+
+// encoded returns the text as it's written to the page content with the font
+func encoded(t *testing.T, font func(fs *fontSet) *font, text string) string {
+	fs, err := newFontSet()
+	require.NoError(t, err)
+	return font(fs).encode(text) + " Tj"
+}
+
+func regular(fs *fontSet) *font { return fs.regular }
+func bold(fs *fontSet) *font    { return fs.bold }
+func mono(fs *fontSet) *font    { return fs.mono }
+
+// checkStructure checks that the cross-reference table points to the objects and returns the inflated streams
+func checkStructure(t *testing.T, data []byte) string {
+	require.True(t, bytes.HasPrefix(data, []byte("%PDF-1.7\n")))
+	require.True(t, bytes.HasSuffix(data, []byte("%%EOF\n")))
+
+	m := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(data)
+	require.NotNil(t, m)
+	xref, err := strconv.Atoi(string(m[1]))
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(data[xref:], []byte("xref\n")))
+
+	lines := strings.Split(string(data[xref:]), "\n")
+	var count int
+	_, err = fmt.Sscanf(lines[1], "0 %d", &count)
+	require.NoError(t, err)
+	for i := 1; i < count; i++ {
+		offset, err := strconv.Atoi(lines[2+i][:10])
+		require.NoError(t, err)
+		assert.True(t, bytes.HasPrefix(data[offset:], []byte(fmt.Sprintf("%d 0 obj\n", i))), "object %d", i)
+	}
+
+	var streams strings.Builder
+	for _, m := range regexp.MustCompile(`(?s)/FlateDecode >>\nstream\n(.*?)\nendstream`).FindAllSubmatch(data, -1) {
+		zr, err := zlib.NewReader(bytes.NewReader(m[1]))
+		require.NoError(t, err)
+		inflated, err := io.ReadAll(zr)
+		require.NoError(t, err)
+		streams.Write(inflated)
+	}
+	return streams.String()
+}
+
+func TestPDF_Convert(t *testing.T) {
+	t.Run("text blocks", func(t *testing.T) {
+		s := newDoc(
+			&types.Struct{Fields: map[string]*types.Value{bundle.RelationKeyName.String(): pbtypes.String("Report")}},
+			textBlock("title", model.BlockContentText_Title, "Report"),
+			textBlock("h1", model.BlockContentText_Header1, "Тема"),
+			textBlock("li1", model.BlockContentText_Marked, "first"),
+			textBlock("n1", model.BlockContentText_Numbered, "one"),
+			textBlock("n2", model.BlockContentText_Numbered, "two"),
+			&model.Block{Id: "check", Content: &model.BlockContentOfText{Text: &model.BlockContentText{
+				Text: "done", Style: model.BlockContentText_Checkbox, Checked: true,
+			}}},
+			textBlock("code", model.BlockContentText_Code, "if a {\n\treturn\n}"),
+			&model.Block{Id: "latex", Content: &model.BlockContentOfLatex{Latex: &model.BlockContentLatex{Text: `\frac{a}{b}`}}},
+		)
+
+		data := NewConverter(nil, s, Options{}, nil).Convert(model.SmartBlockType_Page)
+		content := checkStructure(t, data)
+
+		assert.Contains(t, string(data), "/MediaBox [0 0 595.28 841.89]")
+		assert.Contains(t, string(data), "/Title <FEFF005200650070006F00720074>")
+		assert.Contains(t, string(data), "/Count 1 >>")
+		assert.Contains(t, content, encoded(t, bold, "Report"))
+		assert.Contains(t, content, encoded(t, bold, "Тема"))
+		assert.Contains(t, content, encoded(t, regular, "•"))
+		assert.Contains(t, content, encoded(t, regular, "2."))
+		assert.Contains(t, content, encoded(t, mono, "    return"))
+		assert.Contains(t, content, encoded(t, mono, `\frac{a}{b}`))
+		// the text can be extracted with the ToUnicode map
+		assert.Contains(t, content, "> <0422>\n")
+	})
+
+	t.Run("page size and margins", func(t *testing.T) {
+		s := newDoc(nil, textBlock("p", model.BlockContentText_Paragraph, "text"))
+
+		data := NewConverter(nil, s, Options{PageSize: PageSizeLetter, Landscape: true, Margin: Millimeters(10)}, nil).
+			Convert(model.SmartBlockType_Page)
+		content := checkStructure(t, data)
+
+		assert.Contains(t, string(data), "/MediaBox [0 0 792 612]")
+		assert.Contains(t, content, "1 0 0 1 28.35 ")
+	})
+
+	t.Run("long documents are split into pages", func(t *testing.T) {
+		var blocks []*model.Block
+		for i := 0; i < 100; i++ {
+			blocks = append(blocks, textBlock(strconv.Itoa(i), model.BlockContentText_Paragraph, strings.Repeat("word ", 40)))
+		}
+		data := NewConverter(nil, newDoc(nil, blocks...), Options{}, nil).Convert(model.SmartBlockType_Page)
+		content := checkStructure(t, data)
+
+		count, err := strconv.Atoi(regexp.MustCompile(`/Count (\d+) >>`).FindStringSubmatch(string(data))[1])
+		require.NoError(t, err)
+		assert.Greater(t, count, 1)
+		assert.Contains(t, content, encoded(t, regular, fmt.Sprintf("%d / %d", count, count)))
+	})
+
+	t.Run("links", func(t *testing.T) {
+		s := newDoc(nil,
+			&model.Block{Id: "p", Content: &model.BlockContentOfText{Text: &model.BlockContentText{
+				Text: "see site",
+				Marks: &model.BlockContentTextMarks{Marks: []*model.BlockContentTextMark{
+					{Range: &model.Range{From: 4, To: 8}, Type: model.BlockContentTextMark_Link, Param: "https://anytype.io"},
+				}},
+			}}},
+			&model.Block{Id: "link", Content: &model.BlockContentOfLink{Link: &model.BlockContentLink{TargetBlockId: "page1"}}},
+		)
+		conv := NewConverter(nil, s, Options{}, nil)
+		conv.SetKnownDocs(map[string]*types.Struct{
+			"page1": {Fields: map[string]*types.Value{bundle.RelationKeyName.String(): pbtypes.String("Other page")}},
+		})
+		data := conv.Convert(model.SmartBlockType_Page)
+		content := checkStructure(t, data)
+
+		assert.Contains(t, string(data), "/S /URI /URI (https://anytype.io)")
+		assert.Contains(t, content, encoded(t, regular, "site"))
+		assert.Contains(t, content, encoded(t, bold, "Other page"))
+	})
+
+	t.Run("table", func(t *testing.T) {
+		cellText := func(id, text string) *model.Block {
+			return textBlock("/"+id, model.BlockContentText_Paragraph, text)
+		}
+		s := newDoc(nil,
+			&model.Block{Id: "table", ChildrenIds: []string{"columns", "rows"}, Content: &model.BlockContentOfTable{Table: &model.BlockContentTable{}}},
+			&model.Block{Id: "/columns", ChildrenIds: []string{"col1", "col2"}, Content: &model.BlockContentOfLayout{
+				Layout: &model.BlockContentLayout{Style: model.BlockContentLayout_TableColumns},
+			}},
+			&model.Block{Id: "/col1", Content: &model.BlockContentOfTableColumn{TableColumn: &model.BlockContentTableColumn{}}},
+			&model.Block{Id: "/col2", Content: &model.BlockContentOfTableColumn{TableColumn: &model.BlockContentTableColumn{}}},
+			&model.Block{Id: "/rows", ChildrenIds: []string{"row1", "row2"}, Content: &model.BlockContentOfLayout{
+				Layout: &model.BlockContentLayout{Style: model.BlockContentLayout_TableRows},
+			}},
+			&model.Block{Id: "/row1", ChildrenIds: []string{"row1-col1", "row1-col2"}, Content: &model.BlockContentOfTableRow{
+				TableRow: &model.BlockContentTableRow{IsHeader: true},
+			}},
+			&model.Block{Id: "/row2", ChildrenIds: []string{"row2-col1"}, Content: &model.BlockContentOfTableRow{
+				TableRow: &model.BlockContentTableRow{},
+			}},
+			cellText("row1-col1", "Key"),
+			cellText("row1-col2", "Value"),
+			cellText("row2-col1", "a"),
+		)
+		data := NewConverter(nil, s, Options{}, nil).Convert(model.SmartBlockType_Page)
+		content := checkStructure(t, data)
+
+		assert.Contains(t, content, encoded(t, bold, "Key"))
+		assert.Contains(t, content, encoded(t, bold, "Value"))
+		assert.Contains(t, content, encoded(t, regular, "a"))
+		assert.Equal(t, 4, strings.Count(content, " re S\n"))
+	})
+}
+
+func TestPDF_Report(t *testing.T) {
+	s := newDoc(
+		&types.Struct{Fields: map[string]*types.Value{
+			bundle.RelationKeyName.String():   pbtypes.String("Tasks"),
+			bundle.RelationKeyLayout.String(): pbtypes.Int64(int64(model.ObjectType_set)),
+		}},
+		textBlock("note", model.BlockContentText_Paragraph, "hidden in report"),
+		&model.Block{Id: "dataview", Content: &model.BlockContentOfDataview{Dataview: &model.BlockContentDataview{
+			Views: []*model.BlockContentDataviewView{{Id: "all", Name: "All"}},
+		}}},
+	)
+	source := converter.Dataviews{{BlockID: "dataview", ViewID: "all"}: {
+		Relations: []*model.Relation{
+			{Key: bundle.RelationKeyName.String(), Name: "Name", Format: model.RelationFormat_shorttext},
+			{Key: "tag", Name: "Tag", Format: model.RelationFormat_tag},
+			{Key: "done", Name: "Done", Format: model.RelationFormat_checkbox},
+		},
+		Records: []*types.Struct{
+			{Fields: map[string]*types.Value{
+				bundle.RelationKeyName.String(): pbtypes.String("Write report"),
+				"tag":                           pbtypes.StringList([]string{"tag1"}),
+				"done":                          pbtypes.Bool(true),
+			}},
+			{Fields: map[string]*types.Value{bundle.RelationKeyName.String(): pbtypes.String("Send it")}},
+		},
+		Dependencies: []*types.Struct{{Fields: map[string]*types.Value{
+			bundle.RelationKeyId.String():   pbtypes.String("tag1"),
+			bundle.RelationKeyName.String(): pbtypes.String("work"),
+		}}},
+	}}
+
+	t.Run("report", func(t *testing.T) {
+		data := NewConverter(nil, s, Options{Report: true}, source).Convert(model.SmartBlockType_Page)
+		content := checkStructure(t, data)
+
+		assert.Contains(t, content, encoded(t, bold, "Tasks"))
+		assert.Contains(t, content, encoded(t, regular, "   2 records"))
+		assert.Contains(t, content, encoded(t, bold, "Name"))
+		assert.Contains(t, content, encoded(t, regular, "Write report"))
+		assert.Contains(t, content, encoded(t, regular, "work"))
+		assert.Contains(t, content, encoded(t, regular, "Yes"))
+		assert.NotContains(t, content, encoded(t, regular, "hidden in report"))
+	})
+
+	t.Run("blocks", func(t *testing.T) {
+		data := NewConverter(nil, s, Options{}, source).Convert(model.SmartBlockType_Page)
+		content := checkStructure(t, data)
+
+		assert.Contains(t, content, encoded(t, regular, "hidden in report"))
+		assert.Contains(t, content, encoded(t, regular, "Send it"))
+		assert.NotContains(t, content, encoded(t, regular, "   2 records"))
+	})
+}
+
+func TestNewImage(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 3, 2))
+
+	t.Run("png is converted to rgb", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, png.Encode(&buf, img))
+
+		pi, err := newImage(buf.Bytes())
+		require.NoError(t, err)
+		assert.Equal(t, 3, pi.width)
+		assert.Equal(t, 2, pi.height)
+		assert.Empty(t, pi.filter)
+		// transparent pixels are white
+		assert.Equal(t, bytes.Repeat([]byte{0xff}, 3*2*3), pi.data)
+	})
+
+	t.Run("jpeg is embedded as is", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, jpeg.Encode(&buf, img, nil))
+
+		pi, err := newImage(buf.Bytes())
+		require.NoError(t, err)
+		assert.Equal(t, "/DCTDecode", pi.filter)
+		assert.Equal(t, buf.Bytes(), pi.data)
+	})
+}
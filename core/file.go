@@ -141,3 +141,45 @@ func (mw *Middleware) FileSpaceUsage(cctx context.Context, req *pb.RpcFileSpaceU
 	}
 	return response(pb.RpcFileSpaceUsageResponseError_NULL, nil, usage)
 }
+
+func (mw *Middleware) FileReport(cctx context.Context, req *pb.RpcFileReportRequest) *pb.RpcFileReportResponse {
+	response := func(code pb.RpcFileReportResponseErrorCode, err error, report *pb.RpcFileReportResponseReport) *pb.RpcFileReportResponse {
+		m := &pb.RpcFileReportResponse{
+			Error:  &pb.RpcFileReportResponseError{Code: code},
+			Report: report,
+		}
+
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+
+	report, err := getService[files.Service](mw).GetFilesReport(cctx)
+	if err != nil {
+		return response(pb.RpcFileReportResponseError_UNKNOWN_ERROR, err, nil)
+	}
+	return response(pb.RpcFileReportResponseError_NULL, nil, report)
+}
+
+func (mw *Middleware) FileListCleanupOrphans(cctx context.Context, req *pb.RpcFileListCleanupOrphansRequest) *pb.RpcFileListCleanupOrphansResponse {
+	response := func(filesCleaned int, bytesCleaned uint64, code pb.RpcFileListCleanupOrphansResponseErrorCode, err error) *pb.RpcFileListCleanupOrphansResponse {
+		m := &pb.RpcFileListCleanupOrphansResponse{Error: &pb.RpcFileListCleanupOrphansResponseError{Code: code}, FilesCleaned: int32(filesCleaned), BytesCleaned: bytesCleaned}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+	var (
+		filesCleaned int
+		bytesCleaned uint64
+	)
+	err := mw.doBlockService(func(bs *block.Service) (err error) {
+		filesCleaned, bytesCleaned, err = bs.CleanupOrphanedFiles(req)
+		return
+	})
+	if err != nil {
+		return response(0, 0, pb.RpcFileListCleanupOrphansResponseError_UNKNOWN_ERROR, err)
+	}
+	return response(filesCleaned, bytesCleaned, pb.RpcFileListCleanupOrphansResponseError_NULL, nil)
+}
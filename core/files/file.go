@@ -1,8 +1,11 @@
 package files
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"io"
+	"math"
 	"path/filepath"
 	"strings"
 	"time"
@@ -11,11 +14,19 @@ import (
 	"github.com/gogo/protobuf/types"
 
 	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/mediainfo"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/storage"
 	"github.com/anyproto/anytype-heart/util/pbtypes"
 )
 
+const (
+	// coverImageMetaKey is the key of the file meta with the hash of the cover art extracted from the audio file on upload
+	coverImageMetaKey = "coverImage"
+	// mediaMetaKey is the key of the file meta with the technical details of the audio or video file extracted on upload
+	mediaMetaKey = "media"
+)
+
 type File interface {
 	Meta() *FileMeta
 	Hash() string
@@ -71,10 +82,85 @@ func (f *file) audioDetails(ctx context.Context) (*types.Struct, error) {
 	if t.Year() != 0 {
 		d.Fields[bundle.RelationKeyReleasedYear.String()] = pbtypes.Int64(int64(t.Year()))
 	}
+	if cover := pbtypes.GetString(f.info.GetMeta(), coverImageMetaKey); cover != "" {
+		d.Fields[bundle.RelationKeyThumbnailImage.String()] = pbtypes.String(cover)
+	}
 
 	return d, nil
 }
 
+// addAudioCover adds the cover art of the audio file as the image and returns its hash, the hash is empty if the file has no cover.
+// The reader is rewound to the start
+func (s *service) addAudioCover(ctx context.Context, r io.ReadSeeker) string {
+	if r == nil {
+		return ""
+	}
+	defer func() {
+		if _, err := r.Seek(0, io.SeekStart); err != nil {
+			log.Errorf("failed to seek audio reader: %s", err)
+		}
+	}()
+	t, err := tag.ReadFrom(r)
+	if err != nil {
+		// the file without tags is still added
+		return ""
+	}
+	pic := t.Picture()
+	if pic == nil || len(pic.Data) == 0 {
+		return ""
+	}
+	// the same cover is deduplicated by its checksum, so the image is added only once
+	cover, err := s.ImageAdd(ctx, WithReader(bytes.NewReader(pic.Data)), WithName("cover."+pic.Ext))
+	if err != nil {
+		log.Errorf("failed to add cover art: %s", err)
+		return ""
+	}
+	return cover.Hash()
+}
+
+// mediaDetails returns technical details of audio and video files, like duration and codecs, the details are empty
+// if the format isn't supported. The reader is rewound to the start
+func mediaDetails(r io.ReadSeeker) *types.Struct {
+	if r == nil {
+		return nil
+	}
+	defer func() {
+		if _, err := r.Seek(0, io.SeekStart); err != nil {
+			log.Errorf("failed to seek media reader: %s", err)
+		}
+	}()
+	info, err := mediainfo.Parse(r)
+	if err != nil {
+		if !errors.Is(err, mediainfo.ErrUnsupportedFormat) {
+			log.Warnf("failed to parse media metadata: %s", err)
+		}
+		return nil
+	}
+
+	d := &types.Struct{
+		Fields: map[string]*types.Value{},
+	}
+
+	if info.Duration > 0 {
+		d.Fields[bundle.RelationKeyDuration.String()] = pbtypes.Int64(int64(math.Round(info.Duration.Seconds())))
+	}
+	if info.Width > 0 && info.Height > 0 {
+		d.Fields[bundle.RelationKeyWidthInPixels.String()] = pbtypes.Int64(int64(info.Width))
+		d.Fields[bundle.RelationKeyHeightInPixels.String()] = pbtypes.Int64(int64(info.Height))
+	}
+	if info.VideoCodec != "" {
+		d.Fields[bundle.RelationKeyVideoCodec.String()] = pbtypes.String(info.VideoCodec)
+	}
+	if info.AudioCodec != "" {
+		d.Fields[bundle.RelationKeyAudioCodec.String()] = pbtypes.String(info.AudioCodec)
+	}
+	if info.Bitrate > 0 {
+		d.Fields[bundle.RelationKeyBitrate.String()] = pbtypes.Int64(info.Bitrate / 1000)
+	}
+
+	return d
+}
+
 func (f *file) Details(ctx context.Context) (*types.Struct, error) {
 	meta := f.Meta()
 
@@ -89,6 +175,10 @@ func (f *file) Details(ctx context.Context) (*types.Struct, error) {
 		Fields: commonDetails,
 	}
 
+	if media := pbtypes.GetStruct(f.info.GetMeta(), mediaMetaKey); media != nil {
+		t = pbtypes.StructMerge(t, media, false)
+	}
+
 	if strings.HasPrefix(meta.Media, "video") {
 		t.Fields[bundle.RelationKeyType.String()] = pbtypes.String(bundle.TypeKeyVideo.URL())
 	}
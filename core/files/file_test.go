@@ -0,0 +1,51 @@
+package files
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/storage"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func TestFile_Details(t *testing.T) {
+	t.Run("media details are read from meta", func(t *testing.T) {
+		// the file has no node, so its content can't be read
+		f := &file{hash: "video", info: &storage.FileInfo{
+			Media: "video/mp4",
+			Name:  "clip.mp4",
+			Meta: &types.Struct{Fields: map[string]*types.Value{
+				mediaMetaKey: pbtypes.Struct(&types.Struct{Fields: map[string]*types.Value{
+					bundle.RelationKeyDuration.String():   pbtypes.Int64(42),
+					bundle.RelationKeyVideoCodec.String(): pbtypes.String("H.264"),
+				}}),
+			}},
+		}}
+		details, err := f.Details(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, int64(42), pbtypes.GetInt64(details, bundle.RelationKeyDuration.String()))
+		assert.Equal(t, "H.264", pbtypes.GetString(details, bundle.RelationKeyVideoCodec.String()))
+		assert.Equal(t, bundle.TypeKeyVideo.URL(), pbtypes.GetString(details, bundle.RelationKeyType.String()))
+	})
+}
+
+func TestMediaDetails(t *testing.T) {
+	t.Run("unsupported format", func(t *testing.T) {
+		r := bytes.NewReader([]byte("not a media file"))
+		assert.Nil(t, mediaDetails(r))
+		pos, err := r.Seek(0, io.SeekCurrent)
+		require.NoError(t, err)
+		assert.Zero(t, pos)
+	})
+
+	t.Run("no reader", func(t *testing.T) {
+		assert.Nil(t, mediaDetails(nil))
+	})
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/anyproto/any-sync/commonfile/fileservice"
 	"github.com/anyproto/any-sync/commonspace/syncstatus"
 	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
 	uio "github.com/ipfs/boxo/ipld/unixfs/io"
 	"github.com/ipfs/go-cid"
 	ipld "github.com/ipfs/go-ipld-format"
@@ -58,7 +59,10 @@ type Service interface {
 	FileGetKeys(hash string) (*FileKeys, error)
 	FileListOffload(fileIDs []string, includeNotPinned bool) (totalBytesOffloaded uint64, totalFilesOffloaded uint64, err error)
 	FileOffload(fileID string, includeNotPinned bool) (totalSize uint64, err error)
+	FileDelete(fileID string) (totalSize uint64, err error)
 	GetSpaceUsage(ctx context.Context) (*pb.RpcFileSpaceUsageResponseUsage, error)
+	GetFilesReport(ctx context.Context) (*pb.RpcFileReportResponseReport, error)
+	ListOrphanedFiles() ([]string, error)
 	ImageAdd(ctx context.Context, options ...AddOption) (Image, error)
 	ImageByHash(ctx context.Context, hash string) (Image, error)
 	StoreFileKeys(fileKeys ...FileKeys) error
@@ -553,6 +557,17 @@ func (s *service) fileAddWithConfig(ctx context.Context, mill m.Mill, conf AddOp
 		Meta:             pbtypes.ToStruct(res.Meta),
 		Size_:            int64(readerWithCounter.Count()),
 	}
+	if conf.coverImage != "" || conf.mediaDetails != nil {
+		if fileInfo.Meta == nil || fileInfo.Meta.Fields == nil {
+			fileInfo.Meta = &types.Struct{Fields: map[string]*types.Value{}}
+		}
+		if conf.coverImage != "" {
+			fileInfo.Meta.Fields[coverImageMetaKey] = pbtypes.String(conf.coverImage)
+		}
+		if conf.mediaDetails != nil {
+			fileInfo.Meta.Fields[mediaMetaKey] = pbtypes.Struct(conf.mediaDetails)
+		}
+	}
 
 	var (
 		contentReader io.Reader
@@ -923,6 +938,12 @@ func (s *service) FileAdd(ctx context.Context, options ...AddOption) (File, erro
 	if err != nil {
 		return nil, err
 	}
+	if strings.HasPrefix(opts.Media, "audio") {
+		opts.coverImage = s.addAudioCover(ctx, opts.Reader)
+	}
+	if strings.HasPrefix(opts.Media, "audio") || strings.HasPrefix(opts.Media, "video") {
+		opts.mediaDetails = mediaDetails(opts.Reader)
+	}
 
 	hash, info, err := s.fileAdd(ctx, opts)
 	if err != nil {
@@ -20,6 +20,17 @@ func (s *service) FileOffload(fileID string, includeNotPinned bool) (totalSize u
 	return s.fileOffload(fileID)
 }
 
+// FileDelete removes the file from the file store and from the sync, then offloads its local blocks
+func (s *service) FileDelete(fileID string) (totalSize uint64, err error) {
+	if err = s.fileStore.DeleteFile(fileID); err != nil {
+		return 0, fmt.Errorf("delete file from store: %w", err)
+	}
+	if err = s.fileSync.RemoveFile(s.spaceService.AccountId(), fileID); err != nil {
+		return 0, fmt.Errorf("failed to remove file from sync: %w", err)
+	}
+	return s.fileOffload(fileID)
+}
+
 func (s *service) checkIfPinned(fileID string, includeNotPinned bool) error {
 	if includeNotPinned {
 		return nil
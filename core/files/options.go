@@ -3,14 +3,19 @@ package files
 import (
 	"context"
 	"fmt"
+	stdimage "image"
 	"io"
 	"io/ioutil"
 	"net/http"
+	"path/filepath"
 	"strings"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/h2non/filetype"
 	ipfspath "github.com/ipfs/boxo/path"
 
+	m "github.com/anyproto/anytype-heart/pkg/lib/mill"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/storage"
 )
 
@@ -22,6 +27,12 @@ type AddOptions struct {
 	Name             string
 	LastModifiedDate int64
 	Plaintext        bool
+	ImageEdit        m.ImageEditOpts
+
+	// coverImage is the hash of the image extracted from the audio file
+	coverImage string
+	// mediaDetails are the technical details extracted from the audio or video file
+	mediaDetails *types.Struct
 }
 
 func WithReader(r io.ReadSeeker) AddOption {
@@ -42,6 +53,29 @@ func WithLastModifiedDate(timestamp int64) AddOption {
 	}
 }
 
+// WithImageEditOptions sets transformations applied to the file before adding in case it is an image
+func WithImageEditOptions(opts *model.ImageEditOptions) AddOption {
+	return func(args *AddOptions) {
+		if opts == nil {
+			return
+		}
+		args.ImageEdit = m.ImageEditOpts{
+			StripExif:     opts.StripExif,
+			StripLocation: opts.StripLocation,
+			Rotate:        int(opts.Rotate),
+		}
+		if crop := opts.Crop; crop != nil {
+			args.ImageEdit.Crop = stdimage.Rect(int(crop.X), int(crop.Y), int(crop.X+crop.Width), int(crop.Y+crop.Height))
+		}
+		switch opts.Format {
+		case model.ImageEditOptions_JPEG:
+			args.ImageEdit.Format = m.JPEG
+		case model.ImageEditOptions_PNG:
+			args.ImageEdit.Format = m.PNG
+		}
+	}
+}
+
 func (s *service) normalizeOptions(ctx context.Context, opts *AddOptions) error {
 	if opts.Use != "" {
 		ref, err := ipfspath.ParsePath(opts.Use)
@@ -89,5 +123,29 @@ func (s *service) normalizeOptions(ctx context.Context, opts *AddOptions) error
 		}
 	}
 
+	if strings.HasPrefix(opts.Media, "image/") && !opts.ImageEdit.IsEmpty() {
+		if err := applyImageEdit(opts); err != nil {
+			return fmt.Errorf("edit image: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func applyImageEdit(opts *AddOptions) error {
+	r, format, err := m.ImageEdit(opts.Reader, opts.ImageEdit)
+	if err != nil {
+		return err
+	}
+	opts.Reader = r
+	media := "image/" + string(format)
+	if media != opts.Media {
+		opts.Media = media
+		ext := "." + string(format)
+		if format == m.JPEG {
+			ext = ".jpg"
+		}
+		opts.Name = strings.TrimSuffix(opts.Name, filepath.Ext(opts.Name)) + ext
+	}
 	return nil
 }
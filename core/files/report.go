@@ -0,0 +1,147 @@
+package files
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/storage"
+)
+
+// OrphanGracePeriod is the time since the file is added when it's not considered orphaned yet,
+// so the file uploaded but not attached to an object yet isn't cleaned up
+const OrphanGracePeriod = 24 * time.Hour
+
+// GetFilesReport returns the space used by every file, files that are not referenced by any object
+// and groups of files with the same content
+func (s *service) GetFilesReport(ctx context.Context) (*pb.RpcFileReportResponseReport, error) {
+	fileIDs, err := s.fileStore.ListTargets()
+	if err != nil {
+		return nil, fmt.Errorf("list all files: %w", err)
+	}
+
+	report := &pb.RpcFileReportResponseReport{}
+	byChecksum := map[string][]*pb.RpcFileReportResponseFile{}
+	for _, fileID := range fileIDs {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		file, err := s.fileReport(fileID)
+		if err != nil {
+			log.With("fileID", fileID).Errorf("failed to get file report: %s", err)
+			continue
+		}
+		report.Files = append(report.Files, file)
+		if file.IsOrphaned {
+			report.OrphanedBytesUsage += file.BytesUsage
+		}
+		if file.Checksum != "" {
+			byChecksum[file.Checksum] = append(byChecksum[file.Checksum], file)
+		}
+	}
+
+	for checksum, dups := range byChecksum {
+		if len(dups) < 2 {
+			continue
+		}
+		duplicates := &pb.RpcFileReportResponseDuplicates{Checksum: checksum}
+		for i, f := range dups {
+			duplicates.Hashes = append(duplicates.Hashes, f.Hash)
+			if i > 0 {
+				duplicates.BytesUsage += f.BytesUsage
+			}
+		}
+		report.Duplicates = append(report.Duplicates, duplicates)
+	}
+	sort.Slice(report.Duplicates, func(i, j int) bool {
+		return report.Duplicates[i].BytesUsage > report.Duplicates[j].BytesUsage
+	})
+	sort.Slice(report.Files, func(i, j int) bool {
+		return report.Files[i].BytesUsage > report.Files[j].BytesUsage
+	})
+	return report, nil
+}
+
+// ListOrphanedFiles returns files that are not referenced by any object and are added earlier than OrphanGracePeriod ago.
+// Objects reference files via file blocks and file relations, both are stored in the file references index
+func (s *service) ListOrphanedFiles() ([]string, error) {
+	fileIDs, err := s.fileStore.ListTargets()
+	if err != nil {
+		return nil, fmt.Errorf("list all files: %w", err)
+	}
+	var orphans []string
+	for _, fileID := range fileIDs {
+		referencedBy, err := s.objectStore.GetFileReferences(fileID)
+		if err != nil {
+			return nil, fmt.Errorf("get file references: %w", err)
+		}
+		if len(referencedBy) > 0 {
+			continue
+		}
+		infos, err := s.fileStore.ListByTarget(fileID)
+		if err != nil {
+			return nil, fmt.Errorf("list file variants: %w", err)
+		}
+		if isOrphaned(infos, referencedBy) {
+			orphans = append(orphans, fileID)
+		}
+	}
+	return orphans, nil
+}
+
+// isOrphaned checks the file isn't referenced and every variant of it is added before the grace period
+func isOrphaned(infos []*storage.FileInfo, referencedBy []string) bool {
+	if len(referencedBy) > 0 {
+		return false
+	}
+	addedBefore := time.Now().Add(-OrphanGracePeriod).Unix()
+	for _, info := range infos {
+		if info.Added > addedBefore {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *service) fileReport(fileID string) (*pb.RpcFileReportResponseFile, error) {
+	infos, err := s.fileStore.ListByTarget(fileID)
+	if err != nil {
+		return nil, fmt.Errorf("list file variants: %w", err)
+	}
+	referencedBy, err := s.objectStore.GetFileReferences(fileID)
+	if err != nil {
+		return nil, fmt.Errorf("get file references: %w", err)
+	}
+	localBytesUsage, _, err := s.getAllExistingFileBlocksCids(fileID)
+	if err != nil {
+		return nil, fmt.Errorf("get local blocks: %w", err)
+	}
+
+	file := &pb.RpcFileReportResponseFile{
+		Hash:            fileID,
+		LocalBytesUsage: localBytesUsage,
+		ReferencedBy:    referencedBy,
+		IsOrphaned:      isOrphaned(infos, referencedBy),
+	}
+	if original := originalVariant(infos); original != nil {
+		file.Name = original.Name
+		file.Checksum = original.Checksum
+	}
+	for _, info := range infos {
+		file.BytesUsage += uint64(info.Size_)
+	}
+	return file, nil
+}
+
+// originalVariant returns the biggest variant of the file, that is the original file for images
+func originalVariant(infos []*storage.FileInfo) *storage.FileInfo {
+	var original *storage.FileInfo
+	for _, info := range infos {
+		if original == nil || info.Size_ > original.Size_ {
+			original = info
+		}
+	}
+	return original
+}
@@ -0,0 +1,112 @@
+package files
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/anyproto/any-sync/commonfile/fileservice"
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/anyproto/anytype-heart/core/filestorage/filesync"
+	"github.com/anyproto/anytype-heart/core/filestorage/filesync/mock_filesync"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/storage"
+	"github.com/anyproto/anytype-heart/space/mock_space"
+)
+
+type testObjectStore struct {
+	objectstore.ObjectStore
+	fileRefs map[string][]string
+}
+
+func (s *testObjectStore) GetFileReferences(fileHash string) ([]string, error) {
+	return s.fileRefs[fileHash], nil
+}
+
+// testCommonFile has no local blocks
+type testCommonFile struct {
+	fileservice.FileService
+}
+
+func (f *testCommonFile) HasCid(ctx context.Context, c cid.Cid) (bool, error) {
+	return false, nil
+}
+
+type reportFixture struct {
+	*service
+	fileStore *filesync.MockFileStore
+	fileSync  *mock_filesync.MockFileSync
+
+	attached, unattached, recent string
+}
+
+func testFileHash(t *testing.T, content string) string {
+	c, err := cidBuilder.Sum([]byte(content))
+	require.NoError(t, err)
+	return c.String()
+}
+
+// newReportFixture has the attached file, the old unattached file and the unattached file added recently
+func newReportFixture(t *testing.T) *reportFixture {
+	ctrl := gomock.NewController(t)
+	fx := &reportFixture{
+		fileStore:  filesync.NewMockFileStore(ctrl),
+		fileSync:   mock_filesync.NewMockFileSync(ctrl),
+		attached:   testFileHash(t, "attached"),
+		unattached: testFileHash(t, "unattached"),
+		recent:     testFileHash(t, "recent"),
+	}
+	spaceService := mock_space.NewMockService(ctrl)
+	spaceService.EXPECT().AccountId().Return("space1").AnyTimes()
+	fx.service = &service{
+		fileStore:    fx.fileStore,
+		fileSync:     fx.fileSync,
+		commonFile:   &testCommonFile{},
+		spaceService: spaceService,
+		objectStore:  &testObjectStore{fileRefs: map[string][]string{fx.attached: {"page1"}}},
+	}
+
+	old := time.Now().Add(-2 * OrphanGracePeriod).Unix()
+	fx.fileStore.EXPECT().ListTargets().Return([]string{fx.attached, fx.unattached, fx.recent}, nil).AnyTimes()
+	fx.fileStore.EXPECT().ListByTarget(fx.attached).Return([]*storage.FileInfo{{Hash: fx.attached, Name: "a.png", Size_: 10, Added: old}}, nil).AnyTimes()
+	fx.fileStore.EXPECT().ListByTarget(fx.unattached).Return([]*storage.FileInfo{{Hash: fx.unattached, Name: "b.png", Size_: 20, Added: old}}, nil).AnyTimes()
+	fx.fileStore.EXPECT().ListByTarget(fx.recent).Return([]*storage.FileInfo{{Hash: fx.recent, Name: "c.png", Size_: 30, Added: time.Now().Unix()}}, nil).AnyTimes()
+	return fx
+}
+
+func TestService_GetFilesReport(t *testing.T) {
+	fx := newReportFixture(t)
+
+	report, err := fx.GetFilesReport(context.Background())
+	require.NoError(t, err)
+
+	require.Len(t, report.Files, 3)
+	byHash := map[string]bool{}
+	for _, f := range report.Files {
+		byHash[f.Hash] = f.IsOrphaned
+	}
+	assert.Equal(t, map[string]bool{fx.attached: false, fx.unattached: true, fx.recent: false}, byHash)
+	assert.Equal(t, []string{"page1"}, report.Files[2].ReferencedBy)
+	assert.Equal(t, uint64(20), report.OrphanedBytesUsage)
+}
+
+func TestService_ListOrphanedFiles(t *testing.T) {
+	fx := newReportFixture(t)
+
+	orphans, err := fx.ListOrphanedFiles()
+	require.NoError(t, err)
+	assert.Equal(t, []string{fx.unattached}, orphans)
+}
+
+func TestService_FileDelete(t *testing.T) {
+	fx := newReportFixture(t)
+	fx.fileStore.EXPECT().DeleteFile(fx.unattached).Return(nil)
+	fx.fileSync.EXPECT().RemoveFile("space1", fx.unattached).Return(nil)
+
+	_, err := fx.FileDelete(fx.unattached)
+	require.NoError(t, err)
+}
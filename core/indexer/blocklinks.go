@@ -0,0 +1,44 @@
+package indexer
+
+import (
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/addr"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+// extractBlockLinks collects links to other objects and their blocks from mentions, object marks and link blocks
+func extractBlockLinks(objectID string, st *state.State) []*model.BlockLink {
+	var links []*model.BlockLink
+	add := func(blockID, ref string) {
+		targetObjectID, targetBlockID := addr.SplitBlockReference(ref)
+		if targetObjectID == "" || targetObjectID == objectID && targetBlockID == "" {
+			return
+		}
+		for _, l := range links {
+			if l.SourceBlockId == blockID && l.TargetObjectId == targetObjectID && l.TargetBlockId == targetBlockID {
+				return
+			}
+		}
+		links = append(links, &model.BlockLink{
+			SourceObjectId: objectID,
+			SourceBlockId:  blockID,
+			TargetObjectId: targetObjectID,
+			TargetBlockId:  targetBlockID,
+		})
+	}
+	st.Iterate(func(b simple.Block) (isContinue bool) {
+		switch content := b.Model().Content.(type) {
+		case *model.BlockContentOfLink:
+			add(b.Model().Id, content.Link.TargetBlockId)
+		case *model.BlockContentOfText:
+			for _, mark := range content.Text.GetMarks().GetMarks() {
+				if mark.Type == model.BlockContentTextMark_Mention || mark.Type == model.BlockContentTextMark_Object {
+					add(b.Model().Id, mark.Param)
+				}
+			}
+		}
+		return true
+	})
+	return links
+}
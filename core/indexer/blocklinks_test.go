@@ -0,0 +1,45 @@
+package indexer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+func TestExtractBlockLinks(t *testing.T) {
+	mark := func(markType model.BlockContentTextMarkType, param string) *model.BlockContentTextMark {
+		return &model.BlockContentTextMark{Type: markType, Param: param}
+	}
+
+	st := state.NewDoc("root", map[string]simple.Block{
+		"root": simple.New(&model.Block{Id: "root", ChildrenIds: []string{"text", "link", "self"}}),
+		"text": simple.New(&model.Block{Id: "text", Content: &model.BlockContentOfText{Text: &model.BlockContentText{
+			Marks: &model.BlockContentTextMarks{Marks: []*model.BlockContentTextMark{
+				mark(model.BlockContentTextMark_Mention, "page1#block1"),
+				mark(model.BlockContentTextMark_Object, "page2"),
+				mark(model.BlockContentTextMark_Mention, "page1#block1"),
+				mark(model.BlockContentTextMark_Link, "https://anytype.io"),
+			}},
+		}}}),
+		"link": simple.New(&model.Block{Id: "link", Content: &model.BlockContentOfLink{Link: &model.BlockContentLink{
+			TargetBlockId: "page2#block2",
+		}}}),
+		"self": simple.New(&model.Block{Id: "self", Content: &model.BlockContentOfText{Text: &model.BlockContentText{
+			Marks: &model.BlockContentTextMarks{Marks: []*model.BlockContentTextMark{
+				mark(model.BlockContentTextMark_Object, "obj"),
+				mark(model.BlockContentTextMark_Object, "obj#text"),
+			}},
+		}}}),
+	}).NewState()
+
+	assert.Equal(t, []*model.BlockLink{
+		{SourceObjectId: "obj", SourceBlockId: "text", TargetObjectId: "page1", TargetBlockId: "block1"},
+		{SourceObjectId: "obj", SourceBlockId: "text", TargetObjectId: "page2"},
+		{SourceObjectId: "obj", SourceBlockId: "link", TargetObjectId: "page2", TargetBlockId: "block2"},
+		{SourceObjectId: "obj", SourceBlockId: "self", TargetObjectId: "obj", TargetBlockId: "text"},
+	}, extractBlockLinks("obj", st))
+}
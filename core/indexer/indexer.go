@@ -52,7 +52,7 @@ const (
 	ForceBundledObjectsReindexCounter int32 = 5 // reindex objects like anytypeProfile
 	// ForceIdxRebuildCounter erases localstore indexes and reindex all type of objects
 	// (no need to increase ForceThreadsObjectsReindexCounter & ForceFilesReindexCounter)
-	ForceIdxRebuildCounter int32 = 47
+	ForceIdxRebuildCounter int32 = 50
 	// ForceFulltextIndexCounter  performs fulltext indexing for all type of objects (useful when we change fulltext config)
 	ForceFulltextIndexCounter int32 = 5
 	// ForceFilestoreKeysReindexCounter reindex filestore keys in all objects
@@ -237,6 +237,10 @@ func (i *indexer) Index(ctx context.Context, info smartblock2.DocInfo, options .
 			hasError = true
 			log.With("objectID", info.Id).Errorf("failed to save object links: %v", err)
 		}
+		if err = i.store.UpdateObjectFileReferences(info.Id, info.FileHashes); err != nil {
+			hasError = true
+			log.With("objectID", info.Id).Errorf("failed to save object file references: %v", err)
+		}
 	}
 
 	indexLinksTime := time.Now()
@@ -268,6 +272,15 @@ func (i *indexer) Index(ctx context.Context, info smartblock2.DocInfo, options .
 			}
 		}
 
+		if err := i.store.UpdateObjectTasks(info.Id, extractTasks(info.Id, info.State)); err != nil {
+			hasError = true
+			log.With("objectID", info.Id).Errorf("failed to save object tasks: %v", err)
+		}
+		if err := i.store.UpdateObjectBlockLinks(info.Id, extractBlockLinks(info.Id, info.State)); err != nil {
+			hasError = true
+			log.With("objectID", info.Id).Errorf("failed to save object block links: %v", err)
+		}
+
 		// todo: the optimization temporarily disabled to see the metrics
 		if true || !(opts.SkipFullTextIfHeadsNotChanged && lastIndexedHash == headHashToIndex) {
 			if err := i.store.AddToIndexQueue(info.Id); err != nil {
@@ -0,0 +1,52 @@
+package indexer
+
+import (
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/addr"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+// extractTasks collects checkbox blocks of the object with people, objects and dates mentioned in them
+func extractTasks(objectID string, st *state.State) []*model.Task {
+	var tasks []*model.Task
+	st.Iterate(func(b simple.Block) (isContinue bool) {
+		text := b.Model().GetText()
+		if text == nil || text.Style != model.BlockContentText_Checkbox {
+			return true
+		}
+		task := &model.Task{
+			ObjectId: objectID,
+			BlockId:  b.Model().Id,
+			Text:     text.Text,
+			Checked:  text.Checked,
+		}
+		for _, mark := range text.GetMarks().GetMarks() {
+			if mark.Type != model.BlockContentTextMark_Mention || mark.Param == "" {
+				continue
+			}
+			if strings.HasPrefix(mark.Param, addr.DatePrefix) {
+				date, err := time.Parse("2006-01-02", strings.TrimPrefix(mark.Param, addr.DatePrefix))
+				if err != nil {
+					log.With("objectID", objectID).Warnf("invalid date mention %s: %s", mark.Param, err)
+					continue
+				}
+				if !lo.Contains(task.Dates, date.Unix()) {
+					task.Dates = append(task.Dates, date.Unix())
+				}
+				continue
+			}
+			if !lo.Contains(task.Mentions, mark.Param) {
+				task.Mentions = append(task.Mentions, mark.Param)
+			}
+		}
+		tasks = append(tasks, task)
+		return true
+	})
+	return tasks
+}
@@ -0,0 +1,44 @@
+package indexer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+func TestExtractTasks(t *testing.T) {
+	textBlock := func(id string, style model.BlockContentTextStyle, checked bool, marks ...*model.BlockContentTextMark) simple.Block {
+		return simple.New(&model.Block{Id: id, Content: &model.BlockContentOfText{Text: &model.BlockContentText{
+			Text:    "text " + id,
+			Style:   style,
+			Checked: checked,
+			Marks:   &model.BlockContentTextMarks{Marks: marks},
+		}}})
+	}
+	mention := func(param string) *model.BlockContentTextMark {
+		return &model.BlockContentTextMark{Type: model.BlockContentTextMark_Mention, Param: param}
+	}
+
+	st := state.NewDoc("root", map[string]simple.Block{
+		"root": simple.New(&model.Block{Id: "root", ChildrenIds: []string{"task1", "paragraph", "task2"}}),
+		"task1": textBlock("task1", model.BlockContentText_Checkbox, false,
+			mention("profile"),
+			mention("_date_2023-09-01"),
+			mention("profile"),
+			&model.BlockContentTextMark{Type: model.BlockContentTextMark_Bold},
+		),
+		"paragraph": textBlock("paragraph", model.BlockContentText_Paragraph, false, mention("profile")),
+		"task2":     textBlock("task2", model.BlockContentText_Checkbox, true, mention("_date_invalid")),
+	}).NewState()
+
+	date := time.Date(2023, 9, 1, 0, 0, 0, 0, time.UTC).Unix()
+	assert.Equal(t, []*model.Task{
+		{ObjectId: "obj", BlockId: "task1", Text: "text task1", Mentions: []string{"profile"}, Dates: []int64{date}},
+		{ObjectId: "obj", BlockId: "task2", Text: "text task2", Checked: true},
+	}, extractTasks("obj", st))
+}
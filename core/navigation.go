@@ -2,12 +2,14 @@ package core
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/anyproto/any-sync/app"
 	"github.com/gogo/protobuf/types"
 
 	"github.com/anyproto/anytype-heart/core/block"
+	"github.com/anyproto/anytype-heart/core/relation"
 	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
@@ -88,6 +90,12 @@ func (mw *Middleware) ObjectCreate(cctx context.Context, req *pb.RpcObjectCreate
 		id, newDetails, err = bs.CreateObject(req, "")
 		return err
 	})
+	var constraintErr *relation.ConstraintError
+	if errors.As(err, &constraintErr) {
+		resp := response(pb.RpcObjectCreateResponseError_CONSTRAINT_VIOLATION, "", nil, err)
+		resp.Violations = constraintErr.Violations
+		return resp
+	}
 	if err != nil {
 		return response(pb.RpcObjectCreateResponseError_UNKNOWN_ERROR, "", nil, err)
 	}
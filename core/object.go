@@ -17,7 +17,9 @@ import (
 	importer "github.com/anyproto/anytype-heart/core/block/import"
 	"github.com/anyproto/anytype-heart/core/block/import/converter"
 	"github.com/anyproto/anytype-heart/core/block/object/objectgraph"
+	"github.com/anyproto/anytype-heart/core/block/process"
 	"github.com/anyproto/anytype-heart/core/indexer"
+	"github.com/anyproto/anytype-heart/core/relation"
 	"github.com/anyproto/anytype-heart/core/subscription"
 	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
@@ -45,6 +47,12 @@ func (mw *Middleware) ObjectSetDetails(cctx context.Context, req *pb.RpcObjectSe
 	err := mw.doBlockService(func(bs *block.Service) (err error) {
 		return bs.SetDetails(ctx, *req)
 	})
+	var constraintErr *relation.ConstraintError
+	if errors.As(err, &constraintErr) {
+		resp := response(pb.RpcObjectSetDetailsResponseError_CONSTRAINT_VIOLATION, err)
+		resp.Violations = constraintErr.Violations
+		return resp
+	}
 	if err != nil {
 		return response(pb.RpcObjectSetDetailsResponseError_UNKNOWN_ERROR, err)
 	}
@@ -503,15 +511,57 @@ func (mw *Middleware) ObjectSetObjectType(cctx context.Context, req *pb.RpcObjec
 		return m
 	}
 
-	if err := mw.doBlockService(func(bs *block.Service) (err error) {
+	err := mw.doBlockService(func(bs *block.Service) (err error) {
 		return bs.SetObjectTypes(ctx, req.ContextId, []string{req.ObjectTypeUrl})
-	}); err != nil {
+	})
+	var constraintErr *relation.ConstraintError
+	if errors.As(err, &constraintErr) {
+		resp := response(pb.RpcObjectSetObjectTypeResponseError_CONSTRAINT_VIOLATION, err)
+		resp.Violations = constraintErr.Violations
+		return resp
+	}
+	if err != nil {
 		return response(pb.RpcObjectSetObjectTypeResponseError_UNKNOWN_ERROR, err)
 	}
 
 	return response(pb.RpcObjectSetObjectTypeResponseError_NULL, nil)
 }
 
+func (mw *Middleware) ObjectListModifyDetails(cctx context.Context, req *pb.RpcObjectListModifyDetailsRequest) *pb.RpcObjectListModifyDetailsResponse {
+	response := func(code pb.RpcObjectListModifyDetailsResponseErrorCode, changedIDs []string, failures []*pb.RpcObjectListModifyDetailsFailure, err error) *pb.RpcObjectListModifyDetailsResponse {
+		m := &pb.RpcObjectListModifyDetailsResponse{
+			Error:            &pb.RpcObjectListModifyDetailsResponseError{Code: code},
+			ChangedObjectIds: changedIDs,
+			Failures:         failures,
+		}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+	if len(req.Operations) == 0 {
+		return response(pb.RpcObjectListModifyDetailsResponseError_BAD_INPUT, nil, nil, errors.New("operations are empty"))
+	}
+
+	var (
+		changedIDs []string
+		failures   []*pb.RpcObjectListModifyDetailsFailure
+	)
+	err := mw.doBlockService(func(bs *block.Service) (err error) {
+		changedIDs, failures, err = bs.ListModifyDetails(*req)
+		return err
+	})
+	switch {
+	case err == nil:
+		return response(pb.RpcObjectListModifyDetailsResponseError_NULL, changedIDs, failures, nil)
+	case errors.Is(err, block.ErrBadDetailsOperation):
+		return response(pb.RpcObjectListModifyDetailsResponseError_BAD_INPUT, nil, nil, err)
+	case errors.Is(err, process.ErrQueueCanceled):
+		return response(pb.RpcObjectListModifyDetailsResponseError_CANCELED, changedIDs, failures, err)
+	}
+	return response(pb.RpcObjectListModifyDetailsResponseError_UNKNOWN_ERROR, changedIDs, failures, err)
+}
+
 func (mw *Middleware) ObjectListSetObjectType(cctx context.Context, req *pb.RpcObjectListSetObjectTypeRequest) *pb.RpcObjectListSetObjectTypeResponse {
 	ctx := mw.newContext(cctx)
 	response := func(code pb.RpcObjectListSetObjectTypeResponseErrorCode, err error) *pb.RpcObjectListSetObjectTypeResponse {
@@ -657,6 +707,29 @@ func (mw *Middleware) ObjectSetIsFavorite(cctx context.Context, req *pb.RpcObjec
 	return response(pb.RpcObjectSetIsFavoriteResponseError_NULL, nil)
 }
 
+func (mw *Middleware) ObjectSetLock(cctx context.Context, req *pb.RpcObjectSetLockRequest) *pb.RpcObjectSetLockResponse {
+	ctx := mw.newContext(cctx)
+	response := func(code pb.RpcObjectSetLockResponseErrorCode, err error) *pb.RpcObjectSetLockResponse {
+		m := &pb.RpcObjectSetLockResponse{Error: &pb.RpcObjectSetLockResponseError{Code: code}}
+		if err != nil {
+			m.Error.Description = err.Error()
+		} else {
+			m.Event = ctx.GetResponseEvent()
+		}
+		return m
+	}
+	err := mw.doBlockService(func(bs *block.Service) (err error) {
+		return bs.SetObjectLock(ctx, req.ContextId, req.IsLocked, req.EditableRelationKeys)
+	})
+	switch {
+	case err == nil:
+		return response(pb.RpcObjectSetLockResponseError_NULL, nil)
+	case errors.Is(err, block.ErrNotCreator):
+		return response(pb.RpcObjectSetLockResponseError_NOT_CREATOR, err)
+	}
+	return response(pb.RpcObjectSetLockResponseError_UNKNOWN_ERROR, err)
+}
+
 func (mw *Middleware) ObjectRelationAddFeatured(cctx context.Context, req *pb.RpcObjectRelationAddFeaturedRequest) *pb.RpcObjectRelationAddFeaturedResponse {
 	ctx := mw.newContext(cctx)
 	response := func(code pb.RpcObjectRelationAddFeaturedResponseErrorCode, err error) *pb.RpcObjectRelationAddFeaturedResponse {
@@ -813,8 +886,9 @@ func (mw *Middleware) ObjectSetInternalFlags(cctx context.Context, req *pb.RpcOb
 func (mw *Middleware) ObjectImport(cctx context.Context, req *pb.RpcObjectImportRequest) *pb.RpcObjectImportResponse {
 	ctx := mw.newContext(cctx)
 
+	var changes []*pb.RpcObjectImportChange
 	response := func(code pb.RpcObjectImportResponseErrorCode, err error) *pb.RpcObjectImportResponse {
-		m := &pb.RpcObjectImportResponse{Error: &pb.RpcObjectImportResponseError{Code: code}}
+		m := &pb.RpcObjectImportResponse{Error: &pb.RpcObjectImportResponseError{Code: code}, Changes: changes}
 		if err != nil {
 			m.Error.Description = err.Error()
 		}
@@ -829,7 +903,12 @@ func (mw *Middleware) ObjectImport(cctx context.Context, req *pb.RpcObjectImport
 	}
 
 	importer := mw.app.MustComponent(importer.CName).(importer.Importer)
-	err := importer.Import(ctx, req)
+	var err error
+	if req.GetCsvParams().GetKeyColumn() != "" {
+		changes, err = importer.SyncCSV(ctx, req)
+	} else {
+		err = importer.Import(ctx, req)
+	}
 
 	if err == nil {
 		return response(pb.RpcObjectImportResponseError_NULL, nil)
@@ -0,0 +1,241 @@
+package relation
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/addr"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+	"github.com/anyproto/anytype-heart/util/slice"
+)
+
+// constraintJSON is the constraint stored in the relationConstraints detail of the object type,
+// the detail is the JSON encoded list of the constraints
+type constraintJSON struct {
+	RelationKey string   `json:"relationKey"`
+	Required    bool     `json:"required,omitempty"`
+	Min         *float64 `json:"min,omitempty"`
+	Max         *float64 `json:"max,omitempty"`
+	Pattern     string   `json:"pattern,omitempty"`
+	ObjectTypes []string `json:"objectTypes,omitempty"`
+}
+
+// ConstraintError is returned when the details of the object violate the constraints of its type
+type ConstraintError struct {
+	Violations []*model.RelationConstraintViolation
+}
+
+func (e *ConstraintError) Error() string {
+	descriptions := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		descriptions = append(descriptions, v.Description)
+	}
+	return "constraint violation: " + strings.Join(descriptions, "; ")
+}
+
+// ConstraintsToValue converts the constraints to the value of the relationConstraints detail.
+// The range of the constraints should be validated by ValidateConstraintDefinition
+func ConstraintsToValue(constraints []*model.RelationConstraint) *types.Value {
+	list := make([]constraintJSON, 0, len(constraints))
+	for _, c := range constraints {
+		cj := constraintJSON{
+			RelationKey: c.RelationKey,
+			Required:    c.Required,
+			Pattern:     c.Pattern,
+			ObjectTypes: c.ObjectTypes,
+		}
+		if !isNull(c.Min) {
+			min := c.Min.GetNumberValue()
+			cj.Min = &min
+		}
+		if !isNull(c.Max) {
+			max := c.Max.GetNumberValue()
+			cj.Max = &max
+		}
+		list = append(list, cj)
+	}
+	data, err := json.Marshal(list)
+	if err != nil {
+		// the list of plain structs can't fail to marshal
+		log.Errorf("failed to marshal constraints: %v", err)
+		return pbtypes.String("")
+	}
+	return pbtypes.String(string(data))
+}
+
+// ConstraintsFromDetails returns the constraints stored in the details of the object type
+func ConstraintsFromDetails(details *types.Struct) []*model.RelationConstraint {
+	data := pbtypes.GetString(details, bundle.RelationKeyRelationConstraints.String())
+	if data == "" {
+		return nil
+	}
+	var list []constraintJSON
+	if err := json.Unmarshal([]byte(data), &list); err != nil {
+		log.With("objectType", pbtypes.GetString(details, bundle.RelationKeyId.String())).Errorf("failed to unmarshal constraints: %v", err)
+		return nil
+	}
+	constraints := make([]*model.RelationConstraint, 0, len(list))
+	for _, cj := range list {
+		if cj.RelationKey == "" {
+			continue
+		}
+		c := &model.RelationConstraint{
+			RelationKey: cj.RelationKey,
+			Required:    cj.Required,
+			Pattern:     cj.Pattern,
+			ObjectTypes: cj.ObjectTypes,
+		}
+		if cj.Min != nil {
+			c.Min = pbtypes.Float64(*cj.Min)
+		}
+		if cj.Max != nil {
+			c.Max = pbtypes.Float64(*cj.Max)
+		}
+		constraints = append(constraints, c)
+	}
+	return constraints
+}
+
+// ValidateConstraintDefinition checks that the constraint can be applied
+func ValidateConstraintDefinition(c *model.RelationConstraint) error {
+	if c.RelationKey == "" {
+		return fmt.Errorf("relation key is empty")
+	}
+	for _, v := range []*types.Value{c.Min, c.Max} {
+		if isNull(v) {
+			continue
+		}
+		if _, ok := v.Kind.(*types.Value_NumberValue); !ok {
+			return fmt.Errorf("range of %s should be numbers", c.RelationKey)
+		}
+	}
+	if !isNull(c.Min) && !isNull(c.Max) && c.Min.GetNumberValue() > c.Max.GetNumberValue() {
+		return fmt.Errorf("min of %s is greater than max", c.RelationKey)
+	}
+	if c.Pattern != "" {
+		if _, err := regexp.Compile(c.Pattern); err != nil {
+			return fmt.Errorf("invalid pattern of %s: %w", c.RelationKey, err)
+		}
+	}
+	return nil
+}
+
+// typeConstraints are the constraints of the object type with the compiled patterns
+type typeConstraints struct {
+	constraints []*model.RelationConstraint
+	patterns    map[string]*regexp.Regexp
+}
+
+func newTypeConstraints(constraints []*model.RelationConstraint) *typeConstraints {
+	tc := &typeConstraints{constraints: constraints, patterns: make(map[string]*regexp.Regexp)}
+	for _, c := range constraints {
+		if c.Pattern == "" {
+			continue
+		}
+		// the patterns are validated when set, the invalid ones are skipped
+		if re, err := regexp.Compile(c.Pattern); err == nil {
+			tc.patterns[c.Pattern] = re
+		}
+	}
+	return tc
+}
+
+// check returns the violations of the constraints by the details, only the constraints of the keys are checked
+// if keys are passed. objectType returns the type of the object referred by the object relation or empty string if it's unknown
+func (tc *typeConstraints) check(details *types.Struct, keys []string, objectType func(id string) string) []*model.RelationConstraintViolation {
+	var violations []*model.RelationConstraintViolation
+	objectID := pbtypes.GetString(details, bundle.RelationKeyId.String())
+	for _, c := range tc.constraints {
+		if len(keys) > 0 && slice.FindPos(keys, c.RelationKey) == -1 {
+			continue
+		}
+		violation := func(tp model.RelationConstraintViolationType, format string, args ...interface{}) {
+			violations = append(violations, &model.RelationConstraintViolation{
+				ObjectId:    objectID,
+				RelationKey: c.RelationKey,
+				Type:        tp,
+				Description: fmt.Sprintf("%s: ", c.RelationKey) + fmt.Sprintf(format, args...),
+			})
+		}
+
+		v := pbtypes.Get(details, c.RelationKey)
+		if isEmpty(v) {
+			if c.Required {
+				violation(model.RelationConstraintViolation_required, "value is required")
+			}
+			continue
+		}
+		if n, ok := v.Kind.(*types.Value_NumberValue); ok {
+			if !isNull(c.Min) && n.NumberValue < c.Min.GetNumberValue() {
+				violation(model.RelationConstraintViolation_range, "%v is less than %v", n.NumberValue, c.Min.GetNumberValue())
+			}
+			if !isNull(c.Max) && n.NumberValue > c.Max.GetNumberValue() {
+				violation(model.RelationConstraintViolation_range, "%v is greater than %v", n.NumberValue, c.Max.GetNumberValue())
+			}
+		}
+		if re := tc.patterns[c.Pattern]; re != nil {
+			for _, text := range stringValues(v) {
+				if !re.MatchString(text) {
+					violation(model.RelationConstraintViolation_pattern, "%q doesn't match %s", text, c.Pattern)
+				}
+			}
+		}
+		if len(c.ObjectTypes) > 0 && objectType != nil {
+			for _, id := range pbtypes.GetStringListValue(v) {
+				if tp := objectType(id); tp != "" && slice.FindPos(c.ObjectTypes, tp) == -1 {
+					violation(model.RelationConstraintViolation_objectType, "object %s has type %s which isn't allowed", id, tp)
+				}
+			}
+		}
+	}
+	return violations
+}
+
+// ViolationsRelationIDs returns the ids of the relations with the violations, they are stored in the constraintViolations detail
+func ViolationsRelationIDs(violations []*model.RelationConstraintViolation) []string {
+	ids := make([]string, 0, len(violations))
+	for _, v := range violations {
+		id := addr.RelationKeyToIdPrefix + v.RelationKey
+		if slice.FindPos(ids, id) == -1 {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+func stringValues(v *types.Value) []string {
+	switch k := v.Kind.(type) {
+	case *types.Value_StringValue:
+		return []string{k.StringValue}
+	case *types.Value_ListValue:
+		return pbtypes.GetStringListValue(v)
+	}
+	return nil
+}
+
+func isNull(v *types.Value) bool {
+	if v == nil {
+		return true
+	}
+	_, ok := v.Kind.(*types.Value_NullValue)
+	return ok || v.Kind == nil
+}
+
+func isEmpty(v *types.Value) bool {
+	if isNull(v) {
+		return true
+	}
+	switch k := v.Kind.(type) {
+	case *types.Value_StringValue:
+		return strings.TrimSpace(k.StringValue) == ""
+	case *types.Value_ListValue:
+		return len(k.ListValue.GetValues()) == 0
+	}
+	return false
+}
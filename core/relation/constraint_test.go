@@ -0,0 +1,151 @@
+package relation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+	"github.com/anyproto/anytype-heart/util/testMock"
+)
+
+func TestConstraintsValue(t *testing.T) {
+	constraints := []*model.RelationConstraint{
+		{RelationKey: "estimate", Min: pbtypes.Float64(0), Max: pbtypes.Float64(10)},
+		{RelationKey: "assignee", Required: true, ObjectTypes: []string{bundle.TypeKeyProfile.URL()}},
+		{RelationKey: "code", Pattern: `^[A-Z]+-\d+$`},
+	}
+	details := &types.Struct{Fields: map[string]*types.Value{
+		bundle.RelationKeyRelationConstraints.String(): ConstraintsToValue(constraints),
+	}}
+	assert.Equal(t, []*model.RelationConstraint{
+		{RelationKey: "estimate", Min: pbtypes.Float64(0), Max: pbtypes.Float64(10)},
+		{RelationKey: "assignee", Required: true, ObjectTypes: []string{bundle.TypeKeyProfile.URL()}},
+		{RelationKey: "code", Pattern: `^[A-Z]+-\d+$`},
+	}, ConstraintsFromDetails(details))
+	assert.Empty(t, ConstraintsFromDetails(&types.Struct{}))
+	// the constraints are stored as JSON string, so the longtext relation holds a valid value
+	assert.JSONEq(t, `[{"relationKey":"estimate","min":0,"max":10},{"relationKey":"assignee","required":true,"objectTypes":["`+
+		bundle.TypeKeyProfile.URL()+`"]},{"relationKey":"code","pattern":"^[A-Z]+-\\d+$"}]`,
+		pbtypes.GetString(details, bundle.RelationKeyRelationConstraints.String()))
+}
+
+func TestValidateConstraintDefinition(t *testing.T) {
+	assert.NoError(t, ValidateConstraintDefinition(&model.RelationConstraint{RelationKey: "estimate", Min: pbtypes.Float64(1), Max: pbtypes.Float64(1)}))
+	assert.Error(t, ValidateConstraintDefinition(&model.RelationConstraint{}))
+	assert.Error(t, ValidateConstraintDefinition(&model.RelationConstraint{RelationKey: "estimate", Min: pbtypes.Float64(2), Max: pbtypes.Float64(1)}))
+	assert.Error(t, ValidateConstraintDefinition(&model.RelationConstraint{RelationKey: "estimate", Min: pbtypes.String("1")}))
+	assert.Error(t, ValidateConstraintDefinition(&model.RelationConstraint{RelationKey: "code", Pattern: "[a-"}))
+}
+
+func TestCheckConstraints(t *testing.T) {
+	constraints := []*model.RelationConstraint{
+		{RelationKey: "estimate", Min: pbtypes.Float64(0), Max: pbtypes.Float64(10)},
+		{RelationKey: "assignee", Required: true, ObjectTypes: []string{bundle.TypeKeyProfile.URL()}},
+		{RelationKey: "code", Pattern: `^[A-Z]+-\d+$`},
+	}
+	objectTypes := map[string]string{
+		"ann":  bundle.TypeKeyProfile.URL(),
+		"page": bundle.TypeKeyPage.URL(),
+	}
+	objectType := func(id string) string {
+		return objectTypes[id]
+	}
+	violationTypes := func(violations []*model.RelationConstraintViolation) []model.RelationConstraintViolationType {
+		var result []model.RelationConstraintViolationType
+		for _, v := range violations {
+			result = append(result, v.Type)
+		}
+		return result
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		details := &types.Struct{Fields: map[string]*types.Value{
+			"estimate": pbtypes.Float64(10),
+			"assignee": pbtypes.StringList([]string{"ann", "unknown"}),
+			"code":     pbtypes.String("TASK-1"),
+		}}
+		assert.Empty(t, newTypeConstraints(constraints).check(details, nil, objectType))
+	})
+
+	t.Run("violated", func(t *testing.T) {
+		details := &types.Struct{Fields: map[string]*types.Value{
+			bundle.RelationKeyId.String(): pbtypes.String("task"),
+			"estimate":                    pbtypes.Float64(-1),
+			"assignee":                    pbtypes.StringList([]string{"page"}),
+			"code":                        pbtypes.String("task 1"),
+		}}
+		violations := newTypeConstraints(constraints).check(details, nil, objectType)
+		assert.Equal(t, []model.RelationConstraintViolationType{
+			model.RelationConstraintViolation_range,
+			model.RelationConstraintViolation_objectType,
+			model.RelationConstraintViolation_pattern,
+		}, violationTypes(violations))
+		assert.Equal(t, "task", violations[0].ObjectId)
+		assert.Equal(t, "estimate", violations[0].RelationKey)
+	})
+
+	t.Run("required", func(t *testing.T) {
+		for _, v := range []*types.Value{nil, pbtypes.Null(), pbtypes.String(" "), pbtypes.StringList(nil)} {
+			details := &types.Struct{Fields: map[string]*types.Value{"assignee": v}}
+			assert.Equal(t, []model.RelationConstraintViolationType{model.RelationConstraintViolation_required},
+				violationTypes(newTypeConstraints(constraints).check(details, nil, objectType)))
+		}
+	})
+
+	t.Run("only keys", func(t *testing.T) {
+		details := &types.Struct{Fields: map[string]*types.Value{"estimate": pbtypes.Float64(11)}}
+		assert.Empty(t, newTypeConstraints(constraints).check(details, []string{"code"}, objectType))
+		assert.Len(t, newTypeConstraints(constraints).check(details, []string{"estimate"}, objectType), 1)
+	})
+}
+
+func TestViolationsRelationIDs(t *testing.T) {
+	assert.Equal(t, []string{"rel-estimate", "rel-code"}, ViolationsRelationIDs([]*model.RelationConstraintViolation{
+		{RelationKey: "estimate"}, {RelationKey: "code"}, {RelationKey: "estimate"},
+	}))
+}
+
+func TestConstraintsCache(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := testMock.NewMockObjectStore(ctrl)
+	typeDetails := func(constraints ...*model.RelationConstraint) *types.Struct {
+		return &types.Struct{Fields: map[string]*types.Value{
+			database.RecordIDField:                         pbtypes.String("type1"),
+			bundle.RelationKeyRelationConstraints.String(): ConstraintsToValue(constraints),
+		}}
+	}
+	var sub database.Subscription
+	// the type is read once and then refreshed by the subscription
+	store.EXPECT().QueryByIDAndSubscribeForChanges([]string{"type1"}, gomock.Any()).DoAndReturn(
+		func(ids []string, s database.Subscription) ([]database.Record, func(), error) {
+			sub = s
+			s.Subscribe(ids)
+			return []database.Record{{Details: typeDetails(&model.RelationConstraint{RelationKey: "code", Pattern: `^\d+$`})}}, s.Close, nil
+		})
+
+	c := newConstraintsCache(store)
+	go c.run()
+	defer c.close()
+
+	tc, err := c.get("type1")
+	require.NoError(t, err)
+	require.Len(t, tc.constraints, 1)
+	assert.NotNil(t, tc.patterns[`^\d+$`])
+	cached, err := c.get("type1")
+	require.NoError(t, err)
+	assert.Same(t, tc, cached)
+
+	sub.Publish("type1", typeDetails(&model.RelationConstraint{RelationKey: "estimate", Required: true}))
+	assert.Eventually(t, func() bool {
+		tc, err := c.get("type1")
+		return err == nil && len(tc.constraints) == 1 && tc.constraints[0].RelationKey == "estimate"
+	}, time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,86 @@
+package relation
+
+import (
+	"sync"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+// constraintsCache keeps the constraints of the object types, so they aren't read and compiled on every apply.
+// The cache is subscribed for the changes of the cached types and refreshes them on change
+type constraintsCache struct {
+	objectStore objectstore.ObjectStore
+
+	mu       sync.Mutex
+	types    map[string]*typeConstraints
+	records  chan *types.Struct
+	sub      database.Subscription
+	closeSub func()
+}
+
+func newConstraintsCache(objectStore objectstore.ObjectStore) *constraintsCache {
+	records := make(chan *types.Struct, 10)
+	return &constraintsCache{
+		objectStore: objectStore,
+		types:       make(map[string]*typeConstraints),
+		records:     records,
+		sub:         database.NewSubscription(nil, records),
+	}
+}
+
+// run refreshes the cached types on change until the cache is closed
+func (c *constraintsCache) run() {
+	for rec := range c.records {
+		id := pbtypes.GetString(rec, database.RecordIDField)
+		c.mu.Lock()
+		c.types[id] = newTypeConstraints(ConstraintsFromDetails(rec))
+		c.mu.Unlock()
+	}
+}
+
+func (c *constraintsCache) get(typeURL string) (*typeConstraints, error) {
+	c.mu.Lock()
+	tc, ok := c.types[typeURL]
+	c.mu.Unlock()
+	if ok {
+		return tc, nil
+	}
+
+	records, closeSub, err := c.objectStore.QueryByIDAndSubscribeForChanges([]string{typeURL}, c.sub)
+	if err != nil {
+		return nil, err
+	}
+	var details *types.Struct
+	if len(records) > 0 {
+		details = records[0].Details
+	}
+	tc = newTypeConstraints(ConstraintsFromDetails(details))
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closeSub == nil {
+		c.closeSub = closeSub
+	}
+	// the type may be already refreshed by the subscription
+	if cached, ok := c.types[typeURL]; ok {
+		return cached, nil
+	}
+	c.types[typeURL] = tc
+	return tc, nil
+}
+
+func (c *constraintsCache) close() {
+	c.mu.Lock()
+	closeSub := c.closeSub
+	c.mu.Unlock()
+	if closeSub != nil {
+		// also closes the subscription
+		closeSub()
+		return
+	}
+	c.sub.Close()
+}
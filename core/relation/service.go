@@ -1,6 +1,7 @@
 package relation
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -40,6 +41,12 @@ type Service interface {
 
 	FetchLinks(links pbtypes.RelationLinks) (relations relationutils.Relations, err error)
 	ValidateFormat(key string, v *types.Value) error
+
+	// ConstraintViolations returns the violations of the constraints of the object types by the details,
+	// only the constraints of the keys are checked if keys are passed
+	ConstraintViolations(objectTypes []string, details *types.Struct, keys ...string) []*model.RelationConstraintViolation
+	// ValidateConstraints returns *ConstraintError if the details violate the constraints of the object types
+	ValidateConstraints(objectTypes []string, details *types.Struct, keys ...string) error
 	app.Component
 }
 type subObjectCreator interface {
@@ -50,12 +57,24 @@ type subObjectCreator interface {
 type service struct {
 	objectStore     objectstore.ObjectStore
 	relationCreator subObjectCreator
+	constraints     *constraintsCache
 }
 
 func (s *service) Init(a *app.App) (err error) {
 	s.objectStore = a.MustComponent(objectstore.CName).(objectstore.ObjectStore)
 	s.relationCreator = a.MustComponent("objectCreator").(subObjectCreator)
+	s.constraints = newConstraintsCache(s.objectStore)
+
+	return
+}
+
+func (s *service) Run(context.Context) (err error) {
+	go s.constraints.run()
+	return
+}
 
+func (s *service) Close(context.Context) (err error) {
+	s.constraints.close()
 	return
 }
 
@@ -310,6 +329,35 @@ func (s *service) ValidateFormat(key string, v *types.Value) error {
 	}
 }
 
+func (s *service) ConstraintViolations(objectTypes []string, details *types.Struct, keys ...string) []*model.RelationConstraintViolation {
+	var violations []*model.RelationConstraintViolation
+	for _, typeURL := range objectTypes {
+		tc, err := s.constraints.get(typeURL)
+		if err != nil {
+			log.Errorf("failed to get constraints of type %s: %v", typeURL, err)
+			continue
+		}
+		violations = append(violations, tc.check(details, keys, s.objectType)...)
+	}
+	return violations
+}
+
+func (s *service) ValidateConstraints(objectTypes []string, details *types.Struct, keys ...string) error {
+	if violations := s.ConstraintViolations(objectTypes, details, keys...); len(violations) > 0 {
+		return &ConstraintError{Violations: violations}
+	}
+	return nil
+}
+
+// objectType returns the type of the object or empty string if the object is unknown
+func (s *service) objectType(id string) string {
+	details, err := s.objectStore.GetDetails(id)
+	if err != nil {
+		return ""
+	}
+	return pbtypes.GetString(details.GetDetails(), bundle.RelationKeyType.String())
+}
+
 func (s *service) validateOptions(rel *relationutils.Relation, v []string) error {
 	// TODO:
 	return nil
@@ -10,6 +10,8 @@ import (
 	"github.com/gogo/protobuf/types"
 
 	"github.com/anyproto/anytype-heart/core/block"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/core/relation"
 	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
 	"github.com/anyproto/anytype-heart/pkg/lib/localstore/addr"
@@ -135,6 +137,47 @@ func (mw *Middleware) ObjectTypeRelationRemove(cctx context.Context, req *pb.Rpc
 	return response(pb.RpcObjectTypeRelationRemoveResponseError_NULL, nil)
 }
 
+func (mw *Middleware) ObjectTypeConstraintsSet(cctx context.Context, req *pb.RpcObjectTypeConstraintsSetRequest) *pb.RpcObjectTypeConstraintsSetResponse {
+	response := func(code pb.RpcObjectTypeConstraintsSetResponseErrorCode, err error) *pb.RpcObjectTypeConstraintsSetResponse {
+		m := &pb.RpcObjectTypeConstraintsSetResponse{Error: &pb.RpcObjectTypeConstraintsSetResponseError{Code: code}}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+
+	at := mw.GetAnytype()
+	if at == nil {
+		return response(pb.RpcObjectTypeConstraintsSetResponseError_BAD_INPUT, fmt.Errorf("account must be started"))
+	}
+
+	if strings.HasPrefix(req.ObjectTypeUrl, bundle.TypePrefix) {
+		return response(pb.RpcObjectTypeConstraintsSetResponseError_READONLY_OBJECT_TYPE, fmt.Errorf("can't modify bundled object type"))
+	}
+	for _, c := range req.Constraints {
+		if err := relation.ValidateConstraintDefinition(c); err != nil {
+			return response(pb.RpcObjectTypeConstraintsSetResponseError_BAD_INPUT, err)
+		}
+	}
+
+	store := app.MustComponent[objectstore.ObjectStore](mw.app)
+	if _, err := store.GetObjectType(req.ObjectTypeUrl); err != nil {
+		if err == block.ErrUnknownObjectType {
+			return response(pb.RpcObjectTypeConstraintsSetResponseError_UNKNOWN_OBJECT_TYPE_URL, err)
+		}
+		return response(pb.RpcObjectTypeConstraintsSetResponseError_UNKNOWN_ERROR, err)
+	}
+
+	err := mw.doBlockService(func(bs *block.Service) (err error) {
+		return bs.SetObjectTypeConstraints(req.ObjectTypeUrl, req.Constraints)
+	})
+	if err != nil {
+		return response(pb.RpcObjectTypeConstraintsSetResponseError_UNKNOWN_ERROR, err)
+	}
+
+	return response(pb.RpcObjectTypeConstraintsSetResponseError_NULL, nil)
+}
+
 func (mw *Middleware) ObjectCreateObjectType(cctx context.Context, req *pb.RpcObjectCreateObjectTypeRequest) *pb.RpcObjectCreateObjectTypeResponse {
 	response := func(code pb.RpcObjectCreateObjectTypeResponseErrorCode, id string, details *types.Struct, err error) *pb.RpcObjectCreateObjectTypeResponse {
 		m := &pb.RpcObjectCreateObjectTypeResponse{ObjectId: id, Details: details, Error: &pb.RpcObjectCreateObjectTypeResponseError{Code: code}}
@@ -299,6 +342,38 @@ func (mw *Middleware) RelationListRemoveOption(cctx context.Context, request *pb
 	return response(pb.RpcRelationListRemoveOptionResponseError_NULL, nil)
 }
 
+func (mw *Middleware) RelationConvertFormat(cctx context.Context, req *pb.RpcRelationConvertFormatRequest) *pb.RpcRelationConvertFormatResponse {
+	response := func(code pb.RpcRelationConvertFormatResponseErrorCode, failures []*pb.RpcRelationConvertFormatFailure, err error) *pb.RpcRelationConvertFormatResponse {
+		m := &pb.RpcRelationConvertFormatResponse{Error: &pb.RpcRelationConvertFormatResponseError{Code: code}, Failures: failures}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+	if req.RelationKey == "" {
+		return response(pb.RpcRelationConvertFormatResponseError_BAD_INPUT, nil, fmt.Errorf("relation key is empty"))
+	}
+
+	var failures []*pb.RpcRelationConvertFormatFailure
+	err := mw.doBlockService(func(bs *block.Service) (err error) {
+		failures, err = bs.ConvertRelationFormat(req.RelationKey, req.Format)
+		return err
+	})
+	switch {
+	case err == nil:
+		return response(pb.RpcRelationConvertFormatResponseError_NULL, failures, nil)
+	case errors.Is(err, block.ErrReadonlyRelation):
+		return response(pb.RpcRelationConvertFormatResponseError_READONLY_RELATION, nil, err)
+	case errors.Is(err, block.ErrUnsupportedConversion):
+		return response(pb.RpcRelationConvertFormatResponseError_UNSUPPORTED_CONVERSION, nil, err)
+	case errors.Is(err, relation.ErrNotFound):
+		return response(pb.RpcRelationConvertFormatResponseError_BAD_INPUT, nil, err)
+	case errors.Is(err, process.ErrQueueCanceled):
+		return response(pb.RpcRelationConvertFormatResponseError_CANCELED, failures, err)
+	}
+	return response(pb.RpcRelationConvertFormatResponseError_UNKNOWN_ERROR, failures, err)
+}
+
 func (mw *Middleware) RelationOptions(cctx context.Context, request *pb.RpcRelationOptionsRequest) *pb.RpcRelationOptionsResponse {
 	// TODO implement me
 	panic("implement me")
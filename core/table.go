@@ -314,3 +314,25 @@ func (mw *Middleware) BlockTableRowSetHeader(cctx context.Context, req *pb.RpcBl
 	}
 	return response(pb.RpcBlockTableRowSetHeaderResponseError_NULL, id, nil)
 }
+
+func (mw *Middleware) BlockTableConvertToCollection(cctx context.Context, req *pb.RpcBlockTableConvertToCollectionRequest) *pb.RpcBlockTableConvertToCollectionResponse {
+	ctx := mw.newContext(cctx)
+	response := func(code pb.RpcBlockTableConvertToCollectionResponseErrorCode, id string, err error) *pb.RpcBlockTableConvertToCollectionResponse {
+		m := &pb.RpcBlockTableConvertToCollectionResponse{Error: &pb.RpcBlockTableConvertToCollectionResponseError{Code: code}, CollectionId: id}
+		if err != nil {
+			m.Error.Description = err.Error()
+		} else {
+			m.Event = ctx.GetResponseEvent()
+		}
+		return m
+	}
+	var id string
+	err := mw.doBlockService(func(bs *block.Service) (err error) {
+		id, err = bs.TableConvertToCollection(ctx, *req)
+		return
+	})
+	if err != nil {
+		return response(pb.RpcBlockTableConvertToCollectionResponseError_UNKNOWN_ERROR, "", err)
+	}
+	return response(pb.RpcBlockTableConvertToCollectionResponseError_NULL, id, nil)
+}
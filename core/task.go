@@ -0,0 +1,48 @@
+package core
+
+import (
+	"context"
+
+	"github.com/anyproto/anytype-heart/core/task"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+func (mw *Middleware) TaskList(cctx context.Context, req *pb.RpcTaskListRequest) *pb.RpcTaskListResponse {
+	response := func(tasks []*model.Task, code pb.RpcTaskListResponseErrorCode, err error) *pb.RpcTaskListResponse {
+		m := &pb.RpcTaskListResponse{Error: &pb.RpcTaskListResponseError{Code: code}, Tasks: tasks}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+
+	tasks, err := getService[task.Service](mw).List(req.Filter, int(req.Limit))
+	if err != nil {
+		return response(nil, pb.RpcTaskListResponseError_UNKNOWN_ERROR, err)
+	}
+	return response(tasks, pb.RpcTaskListResponseError_NULL, nil)
+}
+
+func (mw *Middleware) TaskSubscribe(cctx context.Context, req *pb.RpcTaskSubscribeRequest) *pb.RpcTaskSubscribeResponse {
+	response := func(subID string, tasks []*model.Task, code pb.RpcTaskSubscribeResponseErrorCode, err error) *pb.RpcTaskSubscribeResponse {
+		m := &pb.RpcTaskSubscribeResponse{Error: &pb.RpcTaskSubscribeResponseError{Code: code}, SubId: subID, Tasks: tasks}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+
+	subID, tasks, err := getService[task.Service](mw).Subscribe(req.SubId, req.Filter)
+	if err != nil {
+		return response("", nil, pb.RpcTaskSubscribeResponseError_UNKNOWN_ERROR, err)
+	}
+	return response(subID, tasks, pb.RpcTaskSubscribeResponseError_NULL, nil)
+}
+
+func (mw *Middleware) TaskUnsubscribe(cctx context.Context, req *pb.RpcTaskUnsubscribeRequest) *pb.RpcTaskUnsubscribeResponse {
+	getService[task.Service](mw).Unsubscribe(req.SubIds...)
+	return &pb.RpcTaskUnsubscribeResponse{
+		Error: &pb.RpcTaskUnsubscribeResponseError{Code: pb.RpcTaskUnsubscribeResponseError_NULL},
+	}
+}
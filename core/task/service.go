@@ -0,0 +1,246 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/anyproto/any-sync/app"
+	"github.com/globalsign/mgo/bson"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"github.com/samber/lo"
+	"golang.org/x/exp/slices"
+
+	"github.com/anyproto/anytype-heart/core/event"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+const CName = "task"
+
+var log = logging.Logger("anytype-mw-task")
+
+// Service queries tasks, i.e. checkbox blocks of all objects, extracted by the indexer.
+// Tasks of archived and deleted objects are skipped
+type Service interface {
+	List(filter *pb.RpcTaskFilter, limit int) ([]*model.Task, error)
+	// Subscribe returns tasks matching the filter and sends Task.Subscription events on their changes
+	Subscribe(subID string, filter *pb.RpcTaskFilter) (string, []*model.Task, error)
+	Unsubscribe(subIDs ...string)
+
+	app.ComponentRunnable
+}
+
+type subscription struct {
+	filter *pb.RpcTaskFilter
+	// tasks sent to the client, by object id and block id
+	tasks map[string]map[string]*model.Task
+}
+
+type service struct {
+	store     objectstore.ObjectStore
+	sendEvent func(e *pb.Event)
+
+	m             sync.Mutex
+	subscriptions map[string]*subscription
+}
+
+func New() Service {
+	return &service{}
+}
+
+func (s *service) Init(a *app.App) (err error) {
+	s.store = a.MustComponent(objectstore.CName).(objectstore.ObjectStore)
+	s.sendEvent = a.MustComponent(event.CName).(event.Sender).Send
+	s.subscriptions = map[string]*subscription{}
+	return nil
+}
+
+func (s *service) Name() (name string) {
+	return CName
+}
+
+func (s *service) Run(context.Context) (err error) {
+	s.store.SubscribeForTasks(s.onTasksChange)
+	return nil
+}
+
+func (s *service) Close(context.Context) (err error) {
+	return nil
+}
+
+func (s *service) List(filter *pb.RpcTaskFilter, limit int) ([]*model.Task, error) {
+	tasks, err := s.query(filter)
+	if err != nil {
+		return nil, err
+	}
+	if limit > 0 && len(tasks) > limit {
+		tasks = tasks[:limit]
+	}
+	return tasks, nil
+}
+
+func (s *service) Subscribe(subID string, filter *pb.RpcTaskFilter) (string, []*model.Task, error) {
+	if subID == "" {
+		subID = bson.NewObjectId().Hex()
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	tasks, err := s.query(filter)
+	if err != nil {
+		return "", nil, err
+	}
+	sub := &subscription{filter: filter, tasks: map[string]map[string]*model.Task{}}
+	for _, t := range tasks {
+		if sub.tasks[t.ObjectId] == nil {
+			sub.tasks[t.ObjectId] = map[string]*model.Task{}
+		}
+		sub.tasks[t.ObjectId][t.BlockId] = t
+	}
+	s.subscriptions[subID] = sub
+	return subID, tasks, nil
+}
+
+func (s *service) Unsubscribe(subIDs ...string) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	for _, id := range subIDs {
+		delete(s.subscriptions, id)
+	}
+}
+
+func (s *service) query(filter *pb.RpcTaskFilter) ([]*model.Task, error) {
+	tasks, err := s.store.ListTasks()
+	if err != nil {
+		return nil, fmt.Errorf("list tasks: %w", err)
+	}
+	tasks = lo.Filter(tasks, func(t *model.Task, _ int) bool {
+		return matchTask(filter, t)
+	})
+	if len(tasks) == 0 {
+		return tasks, nil
+	}
+
+	records, err := s.store.QueryByID(lo.Uniq(lo.Map(tasks, func(t *model.Task, _ int) string {
+		return t.ObjectId
+	})))
+	if err != nil {
+		return nil, fmt.Errorf("query objects: %w", err)
+	}
+	active := map[string]bool{}
+	for _, rec := range records {
+		active[pbtypes.GetString(rec.Details, bundle.RelationKeyId.String())] = isActive(rec.Details)
+	}
+	return lo.Filter(tasks, func(t *model.Task, _ int) bool {
+		return active[t.ObjectId]
+	}), nil
+}
+
+func (s *service) onTasksChange(objectID string, tasks []*model.Task) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if len(s.subscriptions) == 0 {
+		return
+	}
+
+	details, err := s.store.GetDetails(objectID)
+	if err != nil {
+		log.With("objectID", objectID).Errorf("failed to get details: %s", err)
+		return
+	}
+	if !isActive(details.GetDetails()) {
+		tasks = nil
+	}
+
+	var msgs []*pb.EventMessage
+	for subID, sub := range s.subscriptions {
+		matched := lo.Filter(tasks, func(t *model.Task, _ int) bool {
+			return matchTask(sub.filter, t)
+		})
+		msgs = append(msgs, sub.update(subID, objectID, matched)...)
+	}
+	if len(msgs) > 0 {
+		s.sendEvent(&pb.Event{Messages: msgs})
+	}
+}
+
+// update replaces tasks of the object and returns events for the changed tasks
+func (sub *subscription) update(subID string, objectID string, tasks []*model.Task) (msgs []*pb.EventMessage) {
+	prev := sub.tasks[objectID]
+	next := make(map[string]*model.Task, len(tasks))
+	for _, t := range tasks {
+		next[t.BlockId] = t
+		if old, ok := prev[t.BlockId]; ok && proto.Equal(old, t) {
+			continue
+		}
+		msgs = append(msgs, &pb.EventMessage{
+			Value: &pb.EventMessageValueOfTaskSubscriptionSet{
+				TaskSubscriptionSet: &pb.EventTaskSubscriptionSet{SubId: subID, Task: t},
+			},
+		})
+	}
+
+	removed := lo.Filter(lo.Keys(prev), func(blockID string, _ int) bool {
+		_, ok := next[blockID]
+		return !ok
+	})
+	sort.Strings(removed)
+	for _, blockID := range removed {
+		msgs = append(msgs, &pb.EventMessage{
+			Value: &pb.EventMessageValueOfTaskSubscriptionRemove{
+				TaskSubscriptionRemove: &pb.EventTaskSubscriptionRemove{SubId: subID, ObjectId: objectID, BlockId: blockID},
+			},
+		})
+	}
+
+	if len(next) == 0 {
+		delete(sub.tasks, objectID)
+	} else {
+		sub.tasks[objectID] = next
+	}
+	return msgs
+}
+
+func matchTask(filter *pb.RpcTaskFilter, t *model.Task) bool {
+	if filter == nil {
+		return true
+	}
+	switch filter.Checked {
+	case pb.RpcTaskFilter_Checked:
+		if !t.Checked {
+			return false
+		}
+	case pb.RpcTaskFilter_Unchecked:
+		if t.Checked {
+			return false
+		}
+	}
+	if len(filter.ObjectIds) > 0 && !slices.Contains(filter.ObjectIds, t.ObjectId) {
+		return false
+	}
+	if len(filter.Mentions) > 0 && !lo.Some(t.Mentions, filter.Mentions) {
+		return false
+	}
+	if filter.DateFrom != 0 || filter.DateTo != 0 {
+		inRange := lo.ContainsBy(t.Dates, func(date int64) bool {
+			return (filter.DateFrom == 0 || date >= filter.DateFrom) && (filter.DateTo == 0 || date <= filter.DateTo)
+		})
+		if !inRange {
+			return false
+		}
+	}
+	return true
+}
+
+func isActive(details *types.Struct) bool {
+	return len(details.GetFields()) > 0 &&
+		!pbtypes.GetBool(details, bundle.RelationKeyIsArchived.String()) &&
+		!pbtypes.GetBool(details, bundle.RelationKeyIsDeleted.String())
+}
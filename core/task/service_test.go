@@ -0,0 +1,156 @@
+package task
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+	"github.com/anyproto/anytype-heart/util/testMock"
+)
+
+type fixture struct {
+	*service
+	store  *testMock.MockObjectStore
+	events []*pb.Event
+}
+
+func newFixture(t *testing.T) *fixture {
+	ctrl := gomock.NewController(t)
+	fx := &fixture{store: testMock.NewMockObjectStore(ctrl)}
+	fx.service = &service{
+		store:         fx.store,
+		subscriptions: map[string]*subscription{},
+		sendEvent: func(e *pb.Event) {
+			fx.events = append(fx.events, e)
+		},
+	}
+	return fx
+}
+
+func objectDetails(id string, archived bool) *types.Struct {
+	return &types.Struct{Fields: map[string]*types.Value{
+		bundle.RelationKeyId.String():         pbtypes.String(id),
+		bundle.RelationKeyIsArchived.String(): pbtypes.Bool(archived),
+	}}
+}
+
+func TestMatchTask(t *testing.T) {
+	task := &model.Task{
+		ObjectId: "page",
+		Checked:  false,
+		Mentions: []string{"alice", "bob"},
+		Dates:    []int64{1000, 5000},
+	}
+
+	for _, tc := range []struct {
+		name   string
+		filter *pb.RpcTaskFilter
+		match  bool
+	}{
+		{"no filter", nil, true},
+		{"unchecked", &pb.RpcTaskFilter{Checked: pb.RpcTaskFilter_Unchecked}, true},
+		{"checked", &pb.RpcTaskFilter{Checked: pb.RpcTaskFilter_Checked}, false},
+		{"mentions one of", &pb.RpcTaskFilter{Mentions: []string{"carol", "bob"}}, true},
+		{"doesn't mention", &pb.RpcTaskFilter{Mentions: []string{"carol"}}, false},
+		{"other object", &pb.RpcTaskFilter{ObjectIds: []string{"other"}}, false},
+		{"date before", &pb.RpcTaskFilter{DateTo: 2000}, true},
+		{"date after", &pb.RpcTaskFilter{DateFrom: 6000}, false},
+		{"date in range", &pb.RpcTaskFilter{DateFrom: 2000, DateTo: 5000}, true},
+		{"date between mentioned dates", &pb.RpcTaskFilter{DateFrom: 2000, DateTo: 3000}, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.match, matchTask(tc.filter, task))
+		})
+	}
+
+	t.Run("date filter skips tasks without dates", func(t *testing.T) {
+		assert.False(t, matchTask(&pb.RpcTaskFilter{DateTo: 2000}, &model.Task{}))
+	})
+}
+
+func TestService_List(t *testing.T) {
+	fx := newFixture(t)
+	fx.store.EXPECT().ListTasks().Return([]*model.Task{
+		{ObjectId: "page1", BlockId: "a"},
+		{ObjectId: "page1", BlockId: "b", Checked: true},
+		{ObjectId: "archived", BlockId: "c"},
+		{ObjectId: "page2", BlockId: "d"},
+	}, nil)
+	fx.store.EXPECT().QueryByID(gomock.InAnyOrder([]string{"page1", "archived", "page2"})).Return([]database.Record{
+		{Details: objectDetails("page1", false)},
+		{Details: objectDetails("archived", true)},
+		{Details: objectDetails("page2", false)},
+	}, nil)
+
+	tasks, err := fx.List(&pb.RpcTaskFilter{Checked: pb.RpcTaskFilter_Unchecked}, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []*model.Task{
+		{ObjectId: "page1", BlockId: "a"},
+		{ObjectId: "page2", BlockId: "d"},
+	}, tasks)
+}
+
+func TestService_Subscribe(t *testing.T) {
+	fx := newFixture(t)
+	fx.store.EXPECT().ListTasks().Return([]*model.Task{
+		{ObjectId: "page", BlockId: "a", Text: "first"},
+		{ObjectId: "page", BlockId: "b", Text: "second"},
+	}, nil)
+	fx.store.EXPECT().QueryByID([]string{"page"}).Return([]database.Record{{Details: objectDetails("page", false)}}, nil)
+	fx.store.EXPECT().GetDetails("page").Return(&model.ObjectDetails{Details: objectDetails("page", false)}, nil).Times(2)
+	fx.store.EXPECT().GetDetails("archived").Return(&model.ObjectDetails{Details: objectDetails("archived", true)}, nil)
+
+	subID, tasks, err := fx.Subscribe("sub", &pb.RpcTaskFilter{Checked: pb.RpcTaskFilter_Unchecked})
+	require.NoError(t, err)
+	assert.Equal(t, "sub", subID)
+	assert.Len(t, tasks, 2)
+
+	t.Run("task is checked and another one is changed", func(t *testing.T) {
+		fx.events = nil
+		fx.onTasksChange("page", []*model.Task{
+			{ObjectId: "page", BlockId: "a", Text: "first", Checked: true},
+			{ObjectId: "page", BlockId: "b", Text: "second changed"},
+		})
+
+		require.Len(t, fx.events, 1)
+		assert.Equal(t, []*pb.EventMessage{
+			{Value: &pb.EventMessageValueOfTaskSubscriptionSet{TaskSubscriptionSet: &pb.EventTaskSubscriptionSet{
+				SubId: "sub",
+				Task:  &model.Task{ObjectId: "page", BlockId: "b", Text: "second changed"},
+			}}},
+			{Value: &pb.EventMessageValueOfTaskSubscriptionRemove{TaskSubscriptionRemove: &pb.EventTaskSubscriptionRemove{
+				SubId: "sub", ObjectId: "page", BlockId: "a",
+			}}},
+		}, fx.events[0].Messages)
+	})
+
+	t.Run("nothing changed", func(t *testing.T) {
+		fx.events = nil
+		fx.onTasksChange("page", []*model.Task{
+			{ObjectId: "page", BlockId: "a", Text: "first", Checked: true},
+			{ObjectId: "page", BlockId: "b", Text: "second changed"},
+		})
+		assert.Empty(t, fx.events)
+	})
+
+	t.Run("tasks of archived objects are skipped", func(t *testing.T) {
+		fx.events = nil
+		fx.onTasksChange("archived", []*model.Task{{ObjectId: "archived", BlockId: "c"}})
+		assert.Empty(t, fx.events)
+	})
+
+	t.Run("no events after unsubscribe", func(t *testing.T) {
+		fx.events = nil
+		fx.Unsubscribe("sub")
+		fx.onTasksChange("page", nil)
+		assert.Empty(t, fx.events)
+	})
+}
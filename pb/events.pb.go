@@ -98,12 +98,16 @@ func (EventStatusThreadSyncStatus) EnumDescriptor() ([]byte, []int) {
 type ModelProcessType int32
 
 const (
-	ModelProcess_DropFiles      ModelProcessType = 0
-	ModelProcess_Import         ModelProcessType = 1
-	ModelProcess_Export         ModelProcessType = 2
-	ModelProcess_SaveFile       ModelProcessType = 3
-	ModelProcess_RecoverAccount ModelProcessType = 4
-	ModelProcess_Migration      ModelProcessType = 5
+	ModelProcess_DropFiles                ModelProcessType = 0
+	ModelProcess_Import                   ModelProcessType = 1
+	ModelProcess_Export                   ModelProcessType = 2
+	ModelProcess_SaveFile                 ModelProcessType = 3
+	ModelProcess_RecoverAccount           ModelProcessType = 4
+	ModelProcess_Migration                ModelProcessType = 5
+	ModelProcess_RelationFormatConversion ModelProcessType = 6
+	ModelProcess_MergeDuplicates          ModelProcessType = 7
+	ModelProcess_FindReplace              ModelProcessType = 8
+	ModelProcess_ModifyDetails            ModelProcessType = 9
 )
 
 var ModelProcessType_name = map[int32]string{
@@ -113,15 +117,23 @@ var ModelProcessType_name = map[int32]string{
 	3: "SaveFile",
 	4: "RecoverAccount",
 	5: "Migration",
+	6: "RelationFormatConversion",
+	7: "MergeDuplicates",
+	8: "FindReplace",
+	9: "ModifyDetails",
 }
 
 var ModelProcessType_value = map[string]int32{
-	"DropFiles":      0,
-	"Import":         1,
-	"Export":         2,
-	"SaveFile":       3,
-	"RecoverAccount": 4,
-	"Migration":      5,
+	"DropFiles":                0,
+	"Import":                   1,
+	"Export":                   2,
+	"SaveFile":                 3,
+	"RecoverAccount":           4,
+	"Migration":                5,
+	"RelationFormatConversion": 6,
+	"MergeDuplicates":          7,
+	"FindReplace":              8,
+	"ModifyDetails":            9,
 }
 
 func (x ModelProcessType) String() string {
@@ -272,6 +284,8 @@ type EventMessage struct {
 	//	*EventMessageValueOfBlockSetVerticalAlign
 	//	*EventMessageValueOfBlockSetTableRow
 	//	*EventMessageValueOfBlockSetWidget
+	//	*EventMessageValueOfBlockSetSynced
+	//	*EventMessageValueOfBlockSetDiagram
 	//	*EventMessageValueOfBlockDataviewViewSet
 	//	*EventMessageValueOfBlockDataviewViewDelete
 	//	*EventMessageValueOfBlockDataviewViewOrder
@@ -297,6 +311,10 @@ type EventMessage struct {
 	//	*EventMessageValueOfFileLimitReached
 	//	*EventMessageValueOfFileSpaceUsage
 	//	*EventMessageValueOfFileLocalUsage
+	//	*EventMessageValueOfCommentSet
+	//	*EventMessageValueOfCommentRemove
+	//	*EventMessageValueOfTaskSubscriptionSet
+	//	*EventMessageValueOfTaskSubscriptionRemove
 	Value IsEventMessageValue `protobuf_oneof:"value"`
 }
 
@@ -444,6 +462,12 @@ type EventMessageValueOfBlockSetTableRow struct {
 type EventMessageValueOfBlockSetWidget struct {
 	BlockSetWidget *EventBlockSetWidget `protobuf:"bytes,40,opt,name=blockSetWidget,proto3,oneof" json:"blockSetWidget,omitempty"`
 }
+type EventMessageValueOfBlockSetSynced struct {
+	BlockSetSynced *EventBlockSetSynced `protobuf:"bytes,41,opt,name=blockSetSynced,proto3,oneof" json:"blockSetSynced,omitempty"`
+}
+type EventMessageValueOfBlockSetDiagram struct {
+	BlockSetDiagram *EventBlockSetDiagram `protobuf:"bytes,42,opt,name=blockSetDiagram,proto3,oneof" json:"blockSetDiagram,omitempty"`
+}
 type EventMessageValueOfBlockDataviewViewSet struct {
 	BlockDataviewViewSet *EventBlockDataviewViewSet `protobuf:"bytes,19,opt,name=blockDataviewViewSet,proto3,oneof" json:"blockDataviewViewSet,omitempty"`
 }
@@ -519,6 +543,18 @@ type EventMessageValueOfFileSpaceUsage struct {
 type EventMessageValueOfFileLocalUsage struct {
 	FileLocalUsage *EventFileLocalUsage `protobuf:"bytes,113,opt,name=fileLocalUsage,proto3,oneof" json:"fileLocalUsage,omitempty"`
 }
+type EventMessageValueOfCommentSet struct {
+	CommentSet *EventCommentSet `protobuf:"bytes,114,opt,name=commentSet,proto3,oneof" json:"commentSet,omitempty"`
+}
+type EventMessageValueOfCommentRemove struct {
+	CommentRemove *EventCommentRemove `protobuf:"bytes,115,opt,name=commentRemove,proto3,oneof" json:"commentRemove,omitempty"`
+}
+type EventMessageValueOfTaskSubscriptionSet struct {
+	TaskSubscriptionSet *EventTaskSubscriptionSet `protobuf:"bytes,116,opt,name=taskSubscriptionSet,proto3,oneof" json:"taskSubscriptionSet,omitempty"`
+}
+type EventMessageValueOfTaskSubscriptionRemove struct {
+	TaskSubscriptionRemove *EventTaskSubscriptionRemove `protobuf:"bytes,117,opt,name=taskSubscriptionRemove,proto3,oneof" json:"taskSubscriptionRemove,omitempty"`
+}
 
 func (*EventMessageValueOfAccountShow) IsEventMessageValue()                    {}
 func (*EventMessageValueOfAccountDetails) IsEventMessageValue()                 {}
@@ -555,6 +591,8 @@ func (*EventMessageValueOfBlockSetLatex) IsEventMessageValue()
 func (*EventMessageValueOfBlockSetVerticalAlign) IsEventMessageValue()          {}
 func (*EventMessageValueOfBlockSetTableRow) IsEventMessageValue()               {}
 func (*EventMessageValueOfBlockSetWidget) IsEventMessageValue()                 {}
+func (*EventMessageValueOfBlockSetSynced) IsEventMessageValue()                 {}
+func (*EventMessageValueOfBlockSetDiagram) IsEventMessageValue()                {}
 func (*EventMessageValueOfBlockDataviewViewSet) IsEventMessageValue()           {}
 func (*EventMessageValueOfBlockDataviewViewDelete) IsEventMessageValue()        {}
 func (*EventMessageValueOfBlockDataviewViewOrder) IsEventMessageValue()         {}
@@ -580,6 +618,10 @@ func (*EventMessageValueOfThreadStatus) IsEventMessageValue()
 func (*EventMessageValueOfFileLimitReached) IsEventMessageValue()               {}
 func (*EventMessageValueOfFileSpaceUsage) IsEventMessageValue()                 {}
 func (*EventMessageValueOfFileLocalUsage) IsEventMessageValue()                 {}
+func (*EventMessageValueOfCommentSet) IsEventMessageValue()                     {}
+func (*EventMessageValueOfCommentRemove) IsEventMessageValue()                  {}
+func (*EventMessageValueOfTaskSubscriptionSet) IsEventMessageValue()            {}
+func (*EventMessageValueOfTaskSubscriptionRemove) IsEventMessageValue()         {}
 
 func (m *EventMessage) GetValue() IsEventMessageValue {
 	if m != nil {
@@ -833,6 +875,20 @@ func (m *EventMessage) GetBlockSetWidget() *EventBlockSetWidget {
 	return nil
 }
 
+func (m *EventMessage) GetBlockSetSynced() *EventBlockSetSynced {
+	if x, ok := m.GetValue().(*EventMessageValueOfBlockSetSynced); ok {
+		return x.BlockSetSynced
+	}
+	return nil
+}
+
+func (m *EventMessage) GetBlockSetDiagram() *EventBlockSetDiagram {
+	if x, ok := m.GetValue().(*EventMessageValueOfBlockSetDiagram); ok {
+		return x.BlockSetDiagram
+	}
+	return nil
+}
+
 func (m *EventMessage) GetBlockDataviewViewSet() *EventBlockDataviewViewSet {
 	if x, ok := m.GetValue().(*EventMessageValueOfBlockDataviewViewSet); ok {
 		return x.BlockDataviewViewSet
@@ -1008,6 +1064,34 @@ func (m *EventMessage) GetFileLocalUsage() *EventFileLocalUsage {
 	return nil
 }
 
+func (m *EventMessage) GetCommentSet() *EventCommentSet {
+	if x, ok := m.GetValue().(*EventMessageValueOfCommentSet); ok {
+		return x.CommentSet
+	}
+	return nil
+}
+
+func (m *EventMessage) GetCommentRemove() *EventCommentRemove {
+	if x, ok := m.GetValue().(*EventMessageValueOfCommentRemove); ok {
+		return x.CommentRemove
+	}
+	return nil
+}
+
+func (m *EventMessage) GetTaskSubscriptionSet() *EventTaskSubscriptionSet {
+	if x, ok := m.GetValue().(*EventMessageValueOfTaskSubscriptionSet); ok {
+		return x.TaskSubscriptionSet
+	}
+	return nil
+}
+
+func (m *EventMessage) GetTaskSubscriptionRemove() *EventTaskSubscriptionRemove {
+	if x, ok := m.GetValue().(*EventMessageValueOfTaskSubscriptionRemove); ok {
+		return x.TaskSubscriptionRemove
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*EventMessage) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1046,6 +1130,8 @@ func (*EventMessage) XXX_OneofWrappers() []interface{} {
 		(*EventMessageValueOfBlockSetVerticalAlign)(nil),
 		(*EventMessageValueOfBlockSetTableRow)(nil),
 		(*EventMessageValueOfBlockSetWidget)(nil),
+		(*EventMessageValueOfBlockSetSynced)(nil),
+		(*EventMessageValueOfBlockSetDiagram)(nil),
 		(*EventMessageValueOfBlockDataviewViewSet)(nil),
 		(*EventMessageValueOfBlockDataviewViewDelete)(nil),
 		(*EventMessageValueOfBlockDataviewViewOrder)(nil),
@@ -1071,6 +1157,10 @@ func (*EventMessage) XXX_OneofWrappers() []interface{} {
 		(*EventMessageValueOfFileLimitReached)(nil),
 		(*EventMessageValueOfFileSpaceUsage)(nil),
 		(*EventMessageValueOfFileLocalUsage)(nil),
+		(*EventMessageValueOfCommentSet)(nil),
+		(*EventMessageValueOfCommentRemove)(nil),
+		(*EventMessageValueOfTaskSubscriptionSet)(nil),
+		(*EventMessageValueOfTaskSubscriptionRemove)(nil),
 	}
 }
 
@@ -5173,6 +5263,302 @@ func (m *EventBlockSetWidgetViewId) GetValue() string {
 	return ""
 }
 
+type EventBlockSetSynced struct {
+	Id             string                             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TargetObjectId *EventBlockSetSyncedTargetObjectId `protobuf:"bytes,2,opt,name=targetObjectId,proto3" json:"targetObjectId,omitempty"`
+	TargetBlockId  *EventBlockSetSyncedTargetBlockId  `protobuf:"bytes,3,opt,name=targetBlockId,proto3" json:"targetBlockId,omitempty"`
+}
+
+func (m *EventBlockSetSynced) Reset()         { *m = EventBlockSetSynced{} }
+func (m *EventBlockSetSynced) String() string { return proto.CompactTextString(m) }
+func (*EventBlockSetSynced) ProtoMessage()    {}
+func (*EventBlockSetSynced) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 4, 15}
+}
+func (m *EventBlockSetSynced) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBlockSetSynced) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBlockSetSynced.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBlockSetSynced) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBlockSetSynced.Merge(m, src)
+}
+func (m *EventBlockSetSynced) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBlockSetSynced) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBlockSetSynced.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBlockSetSynced proto.InternalMessageInfo
+
+func (m *EventBlockSetSynced) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *EventBlockSetSynced) GetTargetObjectId() *EventBlockSetSyncedTargetObjectId {
+	if m != nil {
+		return m.TargetObjectId
+	}
+	return nil
+}
+
+func (m *EventBlockSetSynced) GetTargetBlockId() *EventBlockSetSyncedTargetBlockId {
+	if m != nil {
+		return m.TargetBlockId
+	}
+	return nil
+}
+
+type EventBlockSetSyncedTargetObjectId struct {
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *EventBlockSetSyncedTargetObjectId) Reset()         { *m = EventBlockSetSyncedTargetObjectId{} }
+func (m *EventBlockSetSyncedTargetObjectId) String() string { return proto.CompactTextString(m) }
+func (*EventBlockSetSyncedTargetObjectId) ProtoMessage()    {}
+func (*EventBlockSetSyncedTargetObjectId) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 4, 15, 0}
+}
+func (m *EventBlockSetSyncedTargetObjectId) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBlockSetSyncedTargetObjectId) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBlockSetSyncedTargetObjectId.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBlockSetSyncedTargetObjectId) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBlockSetSyncedTargetObjectId.Merge(m, src)
+}
+func (m *EventBlockSetSyncedTargetObjectId) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBlockSetSyncedTargetObjectId) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBlockSetSyncedTargetObjectId.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBlockSetSyncedTargetObjectId proto.InternalMessageInfo
+
+func (m *EventBlockSetSyncedTargetObjectId) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type EventBlockSetSyncedTargetBlockId struct {
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *EventBlockSetSyncedTargetBlockId) Reset()         { *m = EventBlockSetSyncedTargetBlockId{} }
+func (m *EventBlockSetSyncedTargetBlockId) String() string { return proto.CompactTextString(m) }
+func (*EventBlockSetSyncedTargetBlockId) ProtoMessage()    {}
+func (*EventBlockSetSyncedTargetBlockId) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 4, 15, 1}
+}
+func (m *EventBlockSetSyncedTargetBlockId) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBlockSetSyncedTargetBlockId) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBlockSetSyncedTargetBlockId.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBlockSetSyncedTargetBlockId) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBlockSetSyncedTargetBlockId.Merge(m, src)
+}
+func (m *EventBlockSetSyncedTargetBlockId) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBlockSetSyncedTargetBlockId) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBlockSetSyncedTargetBlockId.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBlockSetSyncedTargetBlockId proto.InternalMessageInfo
+
+func (m *EventBlockSetSyncedTargetBlockId) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type EventBlockSetDiagram struct {
+	Id       string                        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Source   *EventBlockSetDiagramSource   `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Language *EventBlockSetDiagramLanguage `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
+}
+
+func (m *EventBlockSetDiagram) Reset()         { *m = EventBlockSetDiagram{} }
+func (m *EventBlockSetDiagram) String() string { return proto.CompactTextString(m) }
+func (*EventBlockSetDiagram) ProtoMessage()    {}
+func (*EventBlockSetDiagram) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 4, 16}
+}
+func (m *EventBlockSetDiagram) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBlockSetDiagram) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBlockSetDiagram.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBlockSetDiagram) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBlockSetDiagram.Merge(m, src)
+}
+func (m *EventBlockSetDiagram) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBlockSetDiagram) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBlockSetDiagram.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBlockSetDiagram proto.InternalMessageInfo
+
+func (m *EventBlockSetDiagram) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *EventBlockSetDiagram) GetSource() *EventBlockSetDiagramSource {
+	if m != nil {
+		return m.Source
+	}
+	return nil
+}
+
+func (m *EventBlockSetDiagram) GetLanguage() *EventBlockSetDiagramLanguage {
+	if m != nil {
+		return m.Language
+	}
+	return nil
+}
+
+type EventBlockSetDiagramSource struct {
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *EventBlockSetDiagramSource) Reset()         { *m = EventBlockSetDiagramSource{} }
+func (m *EventBlockSetDiagramSource) String() string { return proto.CompactTextString(m) }
+func (*EventBlockSetDiagramSource) ProtoMessage()    {}
+func (*EventBlockSetDiagramSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 4, 16, 0}
+}
+func (m *EventBlockSetDiagramSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBlockSetDiagramSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBlockSetDiagramSource.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBlockSetDiagramSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBlockSetDiagramSource.Merge(m, src)
+}
+func (m *EventBlockSetDiagramSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBlockSetDiagramSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBlockSetDiagramSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBlockSetDiagramSource proto.InternalMessageInfo
+
+func (m *EventBlockSetDiagramSource) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type EventBlockSetDiagramLanguage struct {
+	Value model.BlockContentDiagramLanguage `protobuf:"varint,1,opt,name=value,proto3,enum=anytype.model.BlockContentDiagramLanguage" json:"value,omitempty"`
+}
+
+func (m *EventBlockSetDiagramLanguage) Reset()         { *m = EventBlockSetDiagramLanguage{} }
+func (m *EventBlockSetDiagramLanguage) String() string { return proto.CompactTextString(m) }
+func (*EventBlockSetDiagramLanguage) ProtoMessage()    {}
+func (*EventBlockSetDiagramLanguage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 4, 16, 1}
+}
+func (m *EventBlockSetDiagramLanguage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBlockSetDiagramLanguage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBlockSetDiagramLanguage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBlockSetDiagramLanguage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBlockSetDiagramLanguage.Merge(m, src)
+}
+func (m *EventBlockSetDiagramLanguage) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBlockSetDiagramLanguage) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBlockSetDiagramLanguage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBlockSetDiagramLanguage proto.InternalMessageInfo
+
+func (m *EventBlockSetDiagramLanguage) GetValue() model.BlockContentDiagramLanguage {
+	if m != nil {
+		return m.Value
+	}
+	return model.BlockContentDiagram_Mermaid
+}
+
 type EventBlockFill struct {
 }
 
@@ -10078,6 +10464,325 @@ func (m *EventFileLocalUsage) GetLocalBytesUsage() uint64 {
 	return 0
 }
 
+// Comment events are sent with the id of the commented object as a contextId
+type EventComment struct {
+}
+
+func (m *EventComment) Reset()         { *m = EventComment{} }
+func (m *EventComment) String() string { return proto.CompactTextString(m) }
+func (*EventComment) ProtoMessage()    {}
+func (*EventComment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{0, 9}
+}
+func (m *EventComment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventComment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventComment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventComment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventComment.Merge(m, src)
+}
+func (m *EventComment) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventComment) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventComment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventComment proto.InternalMessageInfo
+
+type EventCommentSet struct {
+	Comment *model.Comment `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+}
+
+func (m *EventCommentSet) Reset()         { *m = EventCommentSet{} }
+func (m *EventCommentSet) String() string { return proto.CompactTextString(m) }
+func (*EventCommentSet) ProtoMessage()    {}
+func (*EventCommentSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{0, 9, 0}
+}
+func (m *EventCommentSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCommentSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCommentSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCommentSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCommentSet.Merge(m, src)
+}
+func (m *EventCommentSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCommentSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCommentSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCommentSet proto.InternalMessageInfo
+
+func (m *EventCommentSet) GetComment() *model.Comment {
+	if m != nil {
+		return m.Comment
+	}
+	return nil
+}
+
+type EventCommentRemove struct {
+	Id             string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TargetObjectId string `protobuf:"bytes,2,opt,name=targetObjectId,proto3" json:"targetObjectId,omitempty"`
+}
+
+func (m *EventCommentRemove) Reset()         { *m = EventCommentRemove{} }
+func (m *EventCommentRemove) String() string { return proto.CompactTextString(m) }
+func (*EventCommentRemove) ProtoMessage()    {}
+func (*EventCommentRemove) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{0, 9, 1}
+}
+func (m *EventCommentRemove) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCommentRemove) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCommentRemove.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCommentRemove) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCommentRemove.Merge(m, src)
+}
+func (m *EventCommentRemove) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCommentRemove) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCommentRemove.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCommentRemove proto.InternalMessageInfo
+
+func (m *EventCommentRemove) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *EventCommentRemove) GetTargetObjectId() string {
+	if m != nil {
+		return m.TargetObjectId
+	}
+	return ""
+}
+
+type EventTask struct {
+}
+
+func (m *EventTask) Reset()         { *m = EventTask{} }
+func (m *EventTask) String() string { return proto.CompactTextString(m) }
+func (*EventTask) ProtoMessage()    {}
+func (*EventTask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{0, 10}
+}
+func (m *EventTask) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTask.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTask.Merge(m, src)
+}
+func (m *EventTask) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTask.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTask proto.InternalMessageInfo
+
+type EventTaskSubscription struct {
+}
+
+func (m *EventTaskSubscription) Reset()         { *m = EventTaskSubscription{} }
+func (m *EventTaskSubscription) String() string { return proto.CompactTextString(m) }
+func (*EventTaskSubscription) ProtoMessage()    {}
+func (*EventTaskSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{0, 10, 0}
+}
+func (m *EventTaskSubscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTaskSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTaskSubscription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventTaskSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTaskSubscription.Merge(m, src)
+}
+func (m *EventTaskSubscription) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTaskSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTaskSubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTaskSubscription proto.InternalMessageInfo
+
+// Task was added to the subscription or changed
+type EventTaskSubscriptionSet struct {
+	SubId string      `protobuf:"bytes,1,opt,name=subId,proto3" json:"subId,omitempty"`
+	Task  *model.Task `protobuf:"bytes,2,opt,name=task,proto3" json:"task,omitempty"`
+}
+
+func (m *EventTaskSubscriptionSet) Reset()         { *m = EventTaskSubscriptionSet{} }
+func (m *EventTaskSubscriptionSet) String() string { return proto.CompactTextString(m) }
+func (*EventTaskSubscriptionSet) ProtoMessage()    {}
+func (*EventTaskSubscriptionSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{0, 10, 0, 0}
+}
+func (m *EventTaskSubscriptionSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTaskSubscriptionSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTaskSubscriptionSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventTaskSubscriptionSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTaskSubscriptionSet.Merge(m, src)
+}
+func (m *EventTaskSubscriptionSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTaskSubscriptionSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTaskSubscriptionSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTaskSubscriptionSet proto.InternalMessageInfo
+
+func (m *EventTaskSubscriptionSet) GetSubId() string {
+	if m != nil {
+		return m.SubId
+	}
+	return ""
+}
+
+func (m *EventTaskSubscriptionSet) GetTask() *model.Task {
+	if m != nil {
+		return m.Task
+	}
+	return nil
+}
+
+// Task was removed from the object or doesn't match the subscription filter anymore
+type EventTaskSubscriptionRemove struct {
+	SubId    string `protobuf:"bytes,1,opt,name=subId,proto3" json:"subId,omitempty"`
+	ObjectId string `protobuf:"bytes,2,opt,name=objectId,proto3" json:"objectId,omitempty"`
+	BlockId  string `protobuf:"bytes,3,opt,name=blockId,proto3" json:"blockId,omitempty"`
+}
+
+func (m *EventTaskSubscriptionRemove) Reset()         { *m = EventTaskSubscriptionRemove{} }
+func (m *EventTaskSubscriptionRemove) String() string { return proto.CompactTextString(m) }
+func (*EventTaskSubscriptionRemove) ProtoMessage()    {}
+func (*EventTaskSubscriptionRemove) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{0, 10, 0, 1}
+}
+func (m *EventTaskSubscriptionRemove) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTaskSubscriptionRemove) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTaskSubscriptionRemove.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventTaskSubscriptionRemove) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTaskSubscriptionRemove.Merge(m, src)
+}
+func (m *EventTaskSubscriptionRemove) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTaskSubscriptionRemove) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTaskSubscriptionRemove.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTaskSubscriptionRemove proto.InternalMessageInfo
+
+func (m *EventTaskSubscriptionRemove) GetSubId() string {
+	if m != nil {
+		return m.SubId
+	}
+	return ""
+}
+
+func (m *EventTaskSubscriptionRemove) GetObjectId() string {
+	if m != nil {
+		return m.ObjectId
+	}
+	return ""
+}
+
+func (m *EventTaskSubscriptionRemove) GetBlockId() string {
+	if m != nil {
+		return m.BlockId
+	}
+	return ""
+}
+
 type ResponseEvent struct {
 	Messages  []*EventMessage `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
 	ContextId string          `protobuf:"bytes,2,opt,name=contextId,proto3" json:"contextId,omitempty"`
@@ -10391,6 +11096,12 @@ func init() {
 	proto.RegisterType((*EventBlockSetWidgetLayout)(nil), "anytype.Event.Block.Set.Widget.Layout")
 	proto.RegisterType((*EventBlockSetWidgetLimit)(nil), "anytype.Event.Block.Set.Widget.Limit")
 	proto.RegisterType((*EventBlockSetWidgetViewId)(nil), "anytype.Event.Block.Set.Widget.ViewId")
+	proto.RegisterType((*EventBlockSetSynced)(nil), "anytype.Event.Block.Set.Synced")
+	proto.RegisterType((*EventBlockSetSyncedTargetObjectId)(nil), "anytype.Event.Block.Set.Synced.TargetObjectId")
+	proto.RegisterType((*EventBlockSetSyncedTargetBlockId)(nil), "anytype.Event.Block.Set.Synced.TargetBlockId")
+	proto.RegisterType((*EventBlockSetDiagram)(nil), "anytype.Event.Block.Set.Diagram")
+	proto.RegisterType((*EventBlockSetDiagramSource)(nil), "anytype.Event.Block.Set.Diagram.Source")
+	proto.RegisterType((*EventBlockSetDiagramLanguage)(nil), "anytype.Event.Block.Set.Diagram.Language")
 	proto.RegisterType((*EventBlockFill)(nil), "anytype.Event.Block.Fill")
 	proto.RegisterType((*EventBlockFillDetails)(nil), "anytype.Event.Block.Fill.Details")
 	proto.RegisterType((*EventBlockFillDatabaseRecords)(nil), "anytype.Event.Block.Fill.DatabaseRecords")
@@ -10481,6 +11192,13 @@ func init() {
 	proto.RegisterType((*EventFileLimitReached)(nil), "anytype.Event.File.LimitReached")
 	proto.RegisterType((*EventFileSpaceUsage)(nil), "anytype.Event.File.SpaceUsage")
 	proto.RegisterType((*EventFileLocalUsage)(nil), "anytype.Event.File.LocalUsage")
+	proto.RegisterType((*EventComment)(nil), "anytype.Event.Comment")
+	proto.RegisterType((*EventCommentSet)(nil), "anytype.Event.Comment.Set")
+	proto.RegisterType((*EventCommentRemove)(nil), "anytype.Event.Comment.Remove")
+	proto.RegisterType((*EventTask)(nil), "anytype.Event.Task")
+	proto.RegisterType((*EventTaskSubscription)(nil), "anytype.Event.Task.Subscription")
+	proto.RegisterType((*EventTaskSubscriptionSet)(nil), "anytype.Event.Task.Subscription.Set")
+	proto.RegisterType((*EventTaskSubscriptionRemove)(nil), "anytype.Event.Task.Subscription.Remove")
 	proto.RegisterType((*ResponseEvent)(nil), "anytype.ResponseEvent")
 	proto.RegisterType((*Model)(nil), "anytype.Model")
 	proto.RegisterType((*ModelProcess)(nil), "anytype.Model.Process")
@@ -10490,326 +11208,350 @@ func init() {
 func init() { proto.RegisterFile("pb/protos/events.proto", fileDescriptor_a966342d378ae5f5) }
 
 var fileDescriptor_a966342d378ae5f5 = []byte{
-	// 5101 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x4b, 0x8c, 0x1c, 0xc7,
-	0x79, 0xde, 0x99, 0xe9, 0x79, 0xfd, 0x4b, 0x2e, 0x87, 0x25, 0x8a, 0x6a, 0xb5, 0x56, 0x2b, 0x6a,
-	0xf9, 0x94, 0x44, 0x0d, 0x25, 0xbe, 0x4d, 0xf1, 0xb5, 0x2f, 0x6a, 0x87, 0x8f, 0xe5, 0xa6, 0x96,
-	0xa4, 0x65, 0xd9, 0x30, 0xd4, 0x3b, 0x5d, 0x3b, 0xdb, 0xde, 0xd9, 0xee, 0x71, 0x77, 0xef, 0x92,
-	0x6b, 0xe7, 0x85, 0x24, 0xc7, 0x04, 0x48, 0x72, 0x70, 0x72, 0x0d, 0xe0, 0xdc, 0x02, 0xc3, 0x40,
-	0x2e, 0x3e, 0x05, 0x0e, 0x82, 0x00, 0x79, 0x5c, 0x94, 0x5b, 0x72, 0xb2, 0x21, 0x5d, 0x72, 0x31,
-	0x90, 0x5c, 0x7c, 0x0e, 0xea, 0xd1, 0xdd, 0x55, 0xfd, 0x98, 0xee, 0xb1, 0x64, 0x38, 0x41, 0x74,
-	0xda, 0xa9, 0xaa, 0xff, 0xfb, 0xfe, 0x7a, 0xfc, 0x55, 0x7f, 0xd5, 0xdf, 0x55, 0x0b, 0xc7, 0x47,
-	0x9b, 0x17, 0x46, 0x9e, 0x1b, 0xb8, 0xfe, 0x05, 0xb2, 0x4f, 0x9c, 0xc0, 0xef, 0xb2, 0x14, 0x6a,
-	0x9a, 0xce, 0x41, 0x70, 0x30, 0x22, 0xc6, 0xa9, 0xd1, 0xce, 0xe0, 0xc2, 0xd0, 0xde, 0xbc, 0x30,
-	0xda, 0xbc, 0xb0, 0xeb, 0x5a, 0x64, 0x18, 0x8a, 0xb3, 0x84, 0x10, 0x37, 0x66, 0x07, 0xae, 0x3b,
-	0x18, 0x12, 0x5e, 0xb6, 0xb9, 0xb7, 0x75, 0xc1, 0x0f, 0xbc, 0xbd, 0x7e, 0xc0, 0x4b, 0xe7, 0xff,
-	0xe3, 0x87, 0x15, 0xa8, 0xaf, 0x50, 0x7a, 0x74, 0x11, 0x5a, 0xbb, 0xc4, 0xf7, 0xcd, 0x01, 0xf1,
-	0xf5, 0xca, 0x89, 0xda, 0xb9, 0xe9, 0x8b, 0xc7, 0xbb, 0x42, 0x55, 0x97, 0x49, 0x74, 0x1f, 0xf1,
-	0x62, 0x1c, 0xc9, 0xa1, 0x59, 0x68, 0xf7, 0x5d, 0x27, 0x20, 0x2f, 0x82, 0x9e, 0xa5, 0x57, 0x4f,
-	0x54, 0xce, 0xb5, 0x71, 0x9c, 0x81, 0x2e, 0x43, 0xdb, 0x76, 0xec, 0xc0, 0x36, 0x03, 0xd7, 0xd3,
-	0x6b, 0x27, 0x2a, 0x0a, 0x25, 0xab, 0x64, 0x77, 0xa1, 0xdf, 0x77, 0xf7, 0x9c, 0x00, 0xc7, 0x82,
-	0x48, 0x87, 0x66, 0xe0, 0x99, 0x7d, 0xd2, 0xb3, 0x74, 0x8d, 0x31, 0x86, 0x49, 0xe3, 0xcf, 0xcf,
-	0x41, 0x53, 0xd4, 0x01, 0xdd, 0x81, 0x69, 0x93, 0x63, 0x37, 0xb6, 0xdd, 0xe7, 0x7a, 0x85, 0xb1,
-	0xbf, 0x96, 0xa8, 0xb0, 0x60, 0xef, 0x52, 0x91, 0xd5, 0x29, 0x2c, 0x23, 0x50, 0x0f, 0x66, 0x44,
-	0x72, 0x99, 0x04, 0xa6, 0x3d, 0xf4, 0xf5, 0x7f, 0xe6, 0x24, 0x73, 0x39, 0x24, 0x42, 0x6c, 0x75,
-	0x0a, 0x27, 0x80, 0xe8, 0x1b, 0xf0, 0x92, 0xc8, 0x59, 0x72, 0x9d, 0x2d, 0x7b, 0xf0, 0x74, 0x64,
-	0x99, 0x01, 0xd1, 0xff, 0x85, 0xf3, 0x9d, 0xca, 0xe1, 0xe3, 0xb2, 0x5d, 0x2e, 0xbc, 0x3a, 0x85,
-	0xb3, 0x38, 0xd0, 0x3d, 0x38, 0x2c, 0xb2, 0x05, 0xe9, 0xbf, 0x72, 0xd2, 0xd7, 0x73, 0x48, 0x23,
-	0x36, 0x15, 0x86, 0x1e, 0x43, 0xc7, 0xdd, 0xfc, 0x0e, 0xe9, 0x87, 0x75, 0xde, 0x20, 0x81, 0xde,
-	0x61, 0x4c, 0x6f, 0x26, 0x98, 0x1e, 0x33, 0xb1, 0xb0, 0xb5, 0xdd, 0x0d, 0x12, 0xac, 0x4e, 0xe1,
-	0x14, 0x18, 0x3d, 0x05, 0xa4, 0xe4, 0x2d, 0xec, 0x12, 0xc7, 0xd2, 0x2f, 0x32, 0xca, 0x93, 0xe3,
-	0x29, 0x99, 0xe8, 0xea, 0x14, 0xce, 0x20, 0x48, 0xd1, 0x3e, 0x75, 0x7c, 0x12, 0xe8, 0x97, 0xca,
-	0xd0, 0x32, 0xd1, 0x14, 0x2d, 0xcb, 0x45, 0xdf, 0x84, 0x63, 0x3c, 0x17, 0x93, 0xa1, 0x19, 0xd8,
-	0xae, 0x23, 0xea, 0x7b, 0x99, 0x11, 0x9f, 0xce, 0x26, 0x8e, 0x64, 0xa3, 0x1a, 0x67, 0x92, 0xa0,
-	0x6f, 0xc3, 0xcb, 0x89, 0x7c, 0x4c, 0x76, 0xdd, 0x7d, 0xa2, 0x5f, 0x61, 0xec, 0x67, 0x8a, 0xd8,
-	0xb9, 0xf4, 0xea, 0x14, 0xce, 0xa6, 0x41, 0x8b, 0x70, 0x28, 0x2c, 0x60, 0xb4, 0x57, 0x19, 0xed,
-	0x6c, 0x1e, 0xad, 0x20, 0x53, 0x30, 0x72, 0x1d, 0xfd, 0xc0, 0xb3, 0xfb, 0x8c, 0x9f, 0x1a, 0xc1,
-	0xb5, 0xf1, 0x75, 0x8c, 0x85, 0x85, 0x25, 0x64, 0xd3, 0x20, 0x0c, 0x47, 0xfc, 0xbd, 0x4d, 0xbf,
-	0xef, 0xd9, 0x23, 0x9a, 0xb7, 0x60, 0x59, 0xfa, 0xcd, 0x71, 0xcc, 0x1b, 0x92, 0x70, 0x77, 0xc1,
-	0xa2, 0x9d, 0x9b, 0x24, 0x40, 0xdf, 0x04, 0x24, 0x67, 0x89, 0xd6, 0xdf, 0x62, 0xb4, 0x6f, 0x95,
-	0xa0, 0x8d, 0xba, 0x22, 0x83, 0x06, 0x99, 0x70, 0x4c, 0xce, 0x5d, 0x77, 0x7d, 0x9b, 0xfe, 0xd5,
-	0x6f, 0x33, 0xfa, 0x77, 0x4a, 0xd0, 0x87, 0x10, 0x6a, 0x17, 0x59, 0x54, 0x49, 0x15, 0x4b, 0x74,
-	0x3a, 0x12, 0xcf, 0xd7, 0xef, 0x94, 0x56, 0x11, 0x42, 0x92, 0x2a, 0xc2, 0xfc, 0x64, 0x17, 0x7d,
-	0xe8, 0xb9, 0x7b, 0x23, 0x5f, 0xbf, 0x5b, 0xba, 0x8b, 0x38, 0x20, 0xd9, 0x45, 0x3c, 0x17, 0x5d,
-	0x85, 0xd6, 0xe6, 0xd0, 0xed, 0xef, 0xd0, 0xc1, 0xac, 0x32, 0x4a, 0x3d, 0x41, 0xb9, 0x48, 0x8b,
-	0xc5, 0xf0, 0x45, 0xb2, 0x74, 0x69, 0x66, 0xbf, 0x97, 0xc9, 0x90, 0x04, 0x44, 0x2c, 0xfc, 0xaf,
-	0x65, 0x42, 0xb9, 0x08, 0x5d, 0x9a, 0x25, 0x04, 0x5a, 0x86, 0xe9, 0x2d, 0x7b, 0x48, 0xfc, 0xa7,
-	0xa3, 0xa1, 0x6b, 0x72, 0x2f, 0x30, 0x7d, 0xf1, 0x44, 0x26, 0xc1, 0xbd, 0x58, 0x8e, 0xb2, 0x48,
-	0x30, 0x74, 0x1b, 0xda, 0xbb, 0xa6, 0xb7, 0xe3, 0xf7, 0x9c, 0x2d, 0x57, 0xaf, 0x67, 0x2e, 0xed,
-	0x9c, 0xe3, 0x51, 0x28, 0xb5, 0x3a, 0x85, 0x63, 0x08, 0x75, 0x10, 0xac, 0x52, 0x1b, 0x24, 0xb8,
-	0x67, 0x93, 0xa1, 0xe5, 0xeb, 0x0d, 0x46, 0xf2, 0x46, 0x26, 0xc9, 0x06, 0x09, 0xba, 0x5c, 0x8c,
-	0x3a, 0x08, 0x15, 0x88, 0x3e, 0x82, 0x97, 0xc2, 0x9c, 0xa5, 0x6d, 0x7b, 0x68, 0x79, 0xc4, 0xe9,
-	0x59, 0xbe, 0xde, 0xcc, 0xf4, 0x0f, 0x31, 0x9f, 0x24, 0x4b, 0xfd, 0x43, 0x06, 0x05, 0x5d, 0xd8,
-	0xc2, 0x6c, 0x79, 0x4a, 0xea, 0xad, 0xcc, 0x85, 0x2d, 0xa6, 0x96, 0x85, 0xa9, 0x75, 0x65, 0x91,
-	0x20, 0x0b, 0x5e, 0x09, 0xf3, 0x17, 0xcd, 0xfe, 0xce, 0xc0, 0x73, 0xf7, 0x1c, 0x6b, 0xc9, 0x1d,
-	0xba, 0x9e, 0xde, 0x66, 0xfc, 0xe7, 0x72, 0xf9, 0x13, 0xf2, 0xab, 0x53, 0x38, 0x8f, 0x0a, 0x2d,
-	0xc1, 0xa1, 0xb0, 0xe8, 0x09, 0x79, 0x11, 0xe8, 0x90, 0xe9, 0xe0, 0x62, 0x6a, 0x2a, 0x44, 0xd7,
-	0x37, 0x19, 0x24, 0x93, 0x50, 0x93, 0xd0, 0xa7, 0x0b, 0x48, 0xa8, 0x90, 0x4c, 0x42, 0xd3, 0x32,
-	0xc9, 0x43, 0xdb, 0xd9, 0xd1, 0x0f, 0x17, 0x90, 0x50, 0x21, 0x99, 0x84, 0xa6, 0xa9, 0xa7, 0x8d,
-	0x5a, 0xea, 0xba, 0x3b, 0xd4, 0x9e, 0xf4, 0x99, 0x4c, 0x4f, 0x2b, 0xf5, 0x96, 0x10, 0xa4, 0x9e,
-	0x36, 0x09, 0xa6, 0x5b, 0x80, 0x30, 0x6f, 0x61, 0x68, 0x0f, 0x1c, 0xfd, 0xc8, 0x18, 0x5b, 0xa6,
-	0x6c, 0x4c, 0x8a, 0x6e, 0x01, 0x14, 0x18, 0xba, 0x2b, 0xa6, 0xe5, 0x06, 0x09, 0x96, 0xed, 0x7d,
-	0xfd, 0x68, 0xa6, 0x17, 0x89, 0x59, 0x96, 0xed, 0xfd, 0x68, 0x5e, 0x72, 0x88, 0xdc, 0xb4, 0xd0,
-	0x47, 0xe9, 0x2f, 0x17, 0x34, 0x2d, 0x14, 0x94, 0x9b, 0x16, 0xe6, 0xc9, 0x4d, 0x7b, 0x68, 0x06,
-	0xe4, 0x85, 0xfe, 0x6a, 0x41, 0xd3, 0x98, 0x94, 0xdc, 0x34, 0x96, 0x41, 0xbd, 0x5b, 0x98, 0xf1,
-	0x8c, 0x78, 0x81, 0xdd, 0x37, 0x87, 0xbc, 0xab, 0x4e, 0x65, 0xfa, 0xa0, 0x98, 0x4f, 0x91, 0xa6,
-	0xde, 0x2d, 0x93, 0x46, 0x6e, 0xf8, 0x13, 0x73, 0x73, 0x48, 0xb0, 0xfb, 0x5c, 0x3f, 0x5d, 0xd0,
-	0xf0, 0x50, 0x50, 0x6e, 0x78, 0x98, 0x27, 0xaf, 0x2d, 0x5f, 0xb7, 0xad, 0x01, 0x09, 0xf4, 0x73,
-	0x05, 0x6b, 0x0b, 0x17, 0x93, 0xd7, 0x16, 0x9e, 0x13, 0xad, 0x00, 0xcb, 0x66, 0x60, 0xee, 0xdb,
-	0xe4, 0xf9, 0x33, 0x9b, 0x3c, 0xa7, 0x8e, 0xfd, 0xa5, 0x31, 0x2b, 0x40, 0x28, 0xdb, 0x15, 0xc2,
-	0xd1, 0x0a, 0x90, 0x20, 0x89, 0x56, 0x00, 0x39, 0x5f, 0x2c, 0xeb, 0xc7, 0xc6, 0xac, 0x00, 0x0a,
-	0x7f, 0xb4, 0xc6, 0xe7, 0x51, 0x21, 0x13, 0x8e, 0xa7, 0x8a, 0x1e, 0x7b, 0x16, 0xf1, 0xf4, 0xd7,
-	0x99, 0x92, 0xb3, 0xc5, 0x4a, 0x98, 0xf8, 0xea, 0x14, 0xce, 0x21, 0x4a, 0xa9, 0xd8, 0x70, 0xf7,
-	0xbc, 0x3e, 0xa1, 0xfd, 0x74, 0xb2, 0x8c, 0x8a, 0x48, 0x3c, 0xa5, 0x22, 0x2a, 0x41, 0xfb, 0xf0,
-	0x7a, 0x54, 0x42, 0x15, 0x33, 0x2f, 0xca, 0xb4, 0x8b, 0xad, 0xfb, 0x19, 0xa6, 0xa9, 0x3b, 0x5e,
-	0x53, 0x12, 0xb5, 0x3a, 0x85, 0xc7, 0xd3, 0xa2, 0x03, 0x98, 0x53, 0x04, 0xb8, 0x9f, 0x97, 0x15,
-	0x9f, 0x65, 0x8a, 0x2f, 0x8c, 0x57, 0x9c, 0x82, 0xad, 0x4e, 0xe1, 0x02, 0x62, 0x34, 0x82, 0xd7,
-	0x94, 0xce, 0x08, 0x27, 0xb6, 0x30, 0x91, 0xdf, 0x66, 0x7a, 0xcf, 0x8f, 0xd7, 0xab, 0x62, 0x56,
-	0xa7, 0xf0, 0x38, 0x4a, 0x34, 0x00, 0x3d, 0xb3, 0x98, 0x8e, 0xe4, 0xf7, 0x33, 0xb7, 0x3d, 0x39,
-	0xea, 0xf8, 0x58, 0xe6, 0x92, 0x65, 0x5a, 0xbe, 0xe8, 0xce, 0xdf, 0x29, 0x6b, 0xf9, 0x51, 0x3f,
-	0xe6, 0x51, 0x29, 0x63, 0x47, 0x8b, 0x9e, 0x98, 0xde, 0x80, 0x04, 0xbc, 0xa3, 0x7b, 0x16, 0x6d,
-	0xd4, 0xef, 0x96, 0x19, 0xbb, 0x14, 0x4c, 0x19, 0xbb, 0x4c, 0x62, 0xe4, 0xc3, 0xac, 0x22, 0xd1,
-	0xf3, 0x97, 0xdc, 0xe1, 0x90, 0xf4, 0xc3, 0xde, 0xfc, 0x3d, 0xa6, 0xf8, 0xdd, 0xf1, 0x8a, 0x13,
-	0xa0, 0xd5, 0x29, 0x3c, 0x96, 0x34, 0xd5, 0xde, 0xc7, 0x43, 0x2b, 0x61, 0x33, 0x7a, 0x29, 0x5b,
-	0x4d, 0xc2, 0x52, 0xed, 0x4d, 0x49, 0xa4, 0x6c, 0x55, 0x92, 0xa0, 0xcd, 0x7d, 0xa5, 0x8c, 0xad,
-	0xaa, 0x98, 0x94, 0xad, 0xaa, 0xc5, 0xd4, 0xbb, 0xed, 0xf9, 0xc4, 0x63, 0x1c, 0xf7, 0x5d, 0xdb,
-	0xd1, 0xdf, 0xc8, 0xf4, 0x6e, 0x4f, 0x7d, 0xe2, 0x09, 0x45, 0x54, 0x8a, 0x7a, 0x37, 0x05, 0xa6,
-	0xf0, 0x3c, 0x24, 0x5b, 0x81, 0x7e, 0xa2, 0x88, 0x87, 0x4a, 0x29, 0x3c, 0x34, 0x83, 0x7a, 0x8a,
-	0x28, 0x63, 0x83, 0xd0, 0x51, 0xc1, 0xa6, 0x33, 0x20, 0xfa, 0x9b, 0x99, 0x9e, 0x42, 0xa2, 0x93,
-	0x84, 0xa9, 0xa7, 0xc8, 0x22, 0xa1, 0x07, 0xf7, 0x28, 0x9f, 0xee, 0xc8, 0x38, 0xf5, 0x7c, 0xe6,
-	0xc1, 0x5d, 0xa2, 0x8e, 0x44, 0xe9, 0x19, 0x24, 0x4d, 0x80, 0xde, 0x02, 0x6d, 0x64, 0x3b, 0x03,
-	0xdd, 0x62, 0x44, 0x2f, 0x25, 0x88, 0xd6, 0x6d, 0x67, 0xb0, 0x3a, 0x85, 0x99, 0x08, 0xba, 0x09,
-	0x30, 0xf2, 0xdc, 0x3e, 0xf1, 0xfd, 0x35, 0xf2, 0x5c, 0x27, 0x0c, 0x60, 0x24, 0x01, 0x5c, 0xa0,
-	0xbb, 0x46, 0xa8, 0x5f, 0x96, 0xe4, 0xd1, 0x0a, 0x1c, 0x16, 0x29, 0x31, 0xcb, 0xb7, 0x32, 0x37,
-	0x7f, 0x21, 0x41, 0x1c, 0x67, 0x51, 0x50, 0xf4, 0xec, 0x23, 0x32, 0x96, 0x5d, 0x87, 0xe8, 0x83,
-	0xcc, 0xb3, 0x4f, 0x48, 0x42, 0x45, 0xe8, 0x1e, 0x4b, 0x42, 0xd0, 0xc3, 0x7e, 0xb0, 0xed, 0x11,
-	0xd3, 0xda, 0x08, 0xcc, 0x60, 0xcf, 0xd7, 0x9d, 0xcc, 0x6d, 0x1a, 0x2f, 0xec, 0x3e, 0x61, 0x92,
-	0x74, 0x0b, 0x2a, 0x63, 0xd0, 0x1a, 0x74, 0xe8, 0x41, 0xe8, 0xa1, 0xbd, 0x6b, 0x07, 0x98, 0x98,
-	0xfd, 0x6d, 0x62, 0xe9, 0x6e, 0xe6, 0x21, 0x8a, 0x6e, 0x7b, 0xbb, 0xb2, 0x1c, 0xdd, 0xad, 0x24,
-	0xb1, 0x68, 0x15, 0x66, 0x68, 0xde, 0xc6, 0xc8, 0xec, 0x93, 0xa7, 0xbe, 0x39, 0x20, 0xfa, 0x28,
-	0xd3, 0x02, 0x19, 0x5b, 0x2c, 0x45, 0x37, 0x2b, 0x2a, 0x2e, 0x64, 0x7a, 0xe8, 0xf6, 0xcd, 0x21,
-	0x67, 0xfa, 0x6e, 0x3e, 0x53, 0x2c, 0x15, 0x32, 0xc5, 0x39, 0x8b, 0x4d, 0xa8, 0xef, 0x9b, 0xc3,
-	0x3d, 0x62, 0xfc, 0xb8, 0x06, 0x4d, 0x11, 0xfd, 0x32, 0xd6, 0x40, 0x63, 0xb1, 0xbd, 0x63, 0x50,
-	0xb7, 0x1d, 0x8b, 0xbc, 0x60, 0x61, 0xc1, 0x3a, 0xe6, 0x09, 0xf4, 0x1e, 0x34, 0x45, 0x50, 0x4c,
-	0x1c, 0x67, 0xf3, 0x82, 0x91, 0xa1, 0x98, 0xf1, 0x31, 0x34, 0xc3, 0x18, 0xdf, 0x2c, 0xb4, 0x47,
-	0x9e, 0x4b, 0x2b, 0xd1, 0xb3, 0x18, 0x6d, 0x1b, 0xc7, 0x19, 0xe8, 0x7d, 0x68, 0x5a, 0x22, 0x8a,
-	0xc8, 0xa9, 0x5f, 0xe9, 0xf2, 0xb0, 0x6b, 0x37, 0x0c, 0xbb, 0x76, 0x37, 0x58, 0xd8, 0x15, 0x87,
-	0x72, 0xc6, 0xef, 0x57, 0xa0, 0xc1, 0x43, 0x7d, 0xc6, 0x3e, 0x34, 0x84, 0xf9, 0x5c, 0x81, 0x46,
-	0x9f, 0xe5, 0xe9, 0xc9, 0x30, 0x9f, 0x52, 0x43, 0x11, 0x3b, 0xc4, 0x42, 0x98, 0xc2, 0x7c, 0x6e,
-	0x2e, 0xd5, 0xb1, 0x30, 0x6e, 0x1f, 0x58, 0x08, 0xff, 0xc6, 0xf4, 0xfe, 0x57, 0x0b, 0x1a, 0xdc,
-	0x15, 0x19, 0xbf, 0xac, 0x46, 0x5d, 0x6c, 0xfc, 0x43, 0x05, 0xea, 0x3c, 0xa2, 0x36, 0x03, 0x55,
-	0x3b, 0xec, 0xe5, 0xaa, 0x6d, 0xa1, 0x7b, 0x72, 0xf7, 0xd6, 0x32, 0xd6, 0xe9, 0xac, 0x08, 0x63,
-	0xf7, 0x01, 0x39, 0x78, 0x46, 0x4d, 0x24, 0xea, 0x73, 0x74, 0x1c, 0x1a, 0xfe, 0xde, 0x26, 0x3d,
-	0x7a, 0xd7, 0x4e, 0xd4, 0xce, 0xb5, 0xb1, 0x48, 0x19, 0xf7, 0xa1, 0x15, 0x0a, 0xa3, 0x0e, 0xd4,
-	0x76, 0xc8, 0x81, 0x50, 0x4e, 0x7f, 0xa2, 0xf3, 0xc2, 0xd4, 0x22, 0xab, 0x49, 0x0e, 0x2d, 0xd7,
-	0x22, 0xec, 0xf1, 0x13, 0xa8, 0xd1, 0xc5, 0x3f, 0xd9, 0x84, 0xc9, 0x2d, 0x24, 0xb7, 0xb6, 0x4b,
-	0x50, 0xe7, 0x51, 0xcd, 0xa4, 0x0e, 0x04, 0xda, 0x0e, 0x39, 0xe0, 0x7d, 0xd4, 0xc6, 0xec, 0x77,
-	0x2e, 0xc9, 0x4f, 0x6b, 0x70, 0x48, 0x0e, 0x05, 0x19, 0x2b, 0x50, 0x5b, 0xb0, 0xd2, 0x5d, 0xaf,
-	0x43, 0xd3, 0xdc, 0x0a, 0x88, 0x17, 0xc5, 0xf7, 0xc3, 0x24, 0x9d, 0x64, 0x8c, 0x8b, 0x05, 0x78,
-	0xda, 0x98, 0x27, 0x8c, 0x2e, 0x34, 0x44, 0x84, 0x2d, 0xc9, 0x14, 0xc9, 0x57, 0x65, 0xf9, 0xfb,
-	0xd0, 0x8a, 0x02, 0x66, 0x5f, 0x54, 0xb7, 0x07, 0xad, 0x28, 0x32, 0x76, 0x0c, 0xea, 0x81, 0x1b,
-	0x98, 0x43, 0x46, 0x57, 0xc3, 0x3c, 0x41, 0x67, 0xb1, 0x43, 0x5e, 0x04, 0x4b, 0xd1, 0x22, 0x50,
-	0xc3, 0x71, 0x06, 0x9f, 0xe3, 0x64, 0x9f, 0x97, 0xd6, 0x78, 0x69, 0x94, 0x11, 0xeb, 0xd4, 0x64,
-	0x9d, 0x07, 0xd0, 0x10, 0xe1, 0xb2, 0xa8, 0xbc, 0x22, 0x95, 0xa3, 0x05, 0xa8, 0x0f, 0x68, 0xb9,
-	0x18, 0xf5, 0x77, 0x12, 0x33, 0x84, 0x7b, 0xc1, 0x25, 0xd7, 0x09, 0xa8, 0x19, 0xab, 0xa7, 0x00,
-	0xcc, 0x91, 0x74, 0x08, 0x3d, 0x1e, 0xfb, 0xa4, 0x75, 0x6a, 0x61, 0x91, 0x32, 0xfe, 0xba, 0x02,
-	0xed, 0x28, 0x56, 0x6c, 0x7c, 0x9c, 0x37, 0x79, 0x16, 0xe0, 0xb0, 0x27, 0xa4, 0x1e, 0xda, 0xce,
-	0x4e, 0x38, 0x85, 0x5e, 0x4b, 0xd4, 0x04, 0x4b, 0x32, 0x58, 0x45, 0x18, 0x37, 0x73, 0x07, 0x75,
-	0x1e, 0x0e, 0x85, 0xa2, 0x0f, 0x62, 0xd3, 0x53, 0xf2, 0x0c, 0x23, 0x42, 0x77, 0xa0, 0x66, 0x5b,
-	0xfc, 0xeb, 0x52, 0x1b, 0xd3, 0x9f, 0xc6, 0x16, 0x1c, 0x92, 0x43, 0x4e, 0xc6, 0xb3, 0xec, 0xd9,
-	0x73, 0x87, 0xaa, 0x91, 0xc2, 0x5b, 0xd5, 0x84, 0x5f, 0x0d, 0x9b, 0x10, 0x8b, 0x60, 0x05, 0x60,
-	0x7c, 0xfa, 0x09, 0xd4, 0x59, 0x5f, 0x1b, 0x97, 0xb8, 0x9d, 0x9f, 0x87, 0x06, 0xdb, 0xbb, 0x85,
-	0xdf, 0xba, 0x8e, 0x65, 0x0d, 0x0c, 0x16, 0x32, 0xc6, 0x12, 0x4c, 0x4b, 0x91, 0x46, 0x6a, 0x98,
-	0xac, 0x20, 0x1a, 0xec, 0x30, 0x89, 0x0c, 0x68, 0x51, 0x97, 0xb0, 0x6e, 0x06, 0xdb, 0xa2, 0x2f,
-	0xa2, 0xb4, 0x71, 0x0a, 0x1a, 0x62, 0x2f, 0x6a, 0x88, 0xc8, 0x6a, 0x2f, 0xea, 0x8c, 0x28, 0x6d,
-	0x7c, 0x0b, 0xda, 0x51, 0x40, 0x12, 0x3d, 0x86, 0x43, 0x22, 0x20, 0xc9, 0xf7, 0x53, 0x54, 0x78,
-	0xa6, 0xc0, 0x88, 0xe8, 0xe6, 0x89, 0xc5, 0x34, 0xbb, 0x4f, 0x0e, 0x46, 0x04, 0x2b, 0x04, 0xc6,
-	0x2f, 0x4e, 0xb3, 0x0e, 0x36, 0x46, 0xd0, 0x8a, 0xa2, 0x30, 0xc9, 0xce, 0xbe, 0xc6, 0x57, 0xc0,
-	0x6a, 0x61, 0x08, 0x91, 0xe3, 0xe9, 0x3a, 0xcb, 0x16, 0x4a, 0xe3, 0x35, 0xa8, 0x3d, 0x20, 0x07,
-	0x74, 0x22, 0xf0, 0xf5, 0x52, 0x4c, 0x04, 0xbe, 0x2e, 0xf6, 0xa0, 0x21, 0xa2, 0xa1, 0x49, 0x7d,
-	0x17, 0xa0, 0xb1, 0xc5, 0x03, 0xac, 0x05, 0x2b, 0xa3, 0x10, 0x33, 0xee, 0xc0, 0xb4, 0x1c, 0x03,
-	0x4d, 0xf2, 0x9d, 0x80, 0xe9, 0xbe, 0x14, 0x65, 0xe5, 0xc3, 0x20, 0x67, 0x19, 0x44, 0xb5, 0xba,
-	0x14, 0xc3, 0x4a, 0xa6, 0xb9, 0xbd, 0x99, 0xd9, 0xed, 0x63, 0x8c, 0xee, 0x01, 0x1c, 0x49, 0x06,
-	0x3b, 0x93, 0x9a, 0xce, 0xc1, 0x91, 0xcd, 0x44, 0x68, 0x95, 0x2f, 0x75, 0xc9, 0x6c, 0xa3, 0x07,
-	0x75, 0x1e, 0x8c, 0x4a, 0x52, 0xbc, 0x07, 0x75, 0x93, 0x05, 0xbb, 0x28, 0x70, 0x46, 0xda, 0xf2,
-	0xca, 0xb5, 0x64, 0x50, 0xcc, 0x05, 0x0d, 0x1b, 0x0e, 0xab, 0xf1, 0xad, 0x24, 0xe5, 0x2a, 0x1c,
-	0xde, 0x57, 0xe2, 0x68, 0x9c, 0x7a, 0x3e, 0x93, 0x5a, 0xa1, 0xc2, 0x2a, 0xd0, 0xf8, 0x83, 0x06,
-	0x68, 0x2c, 0x40, 0x9b, 0x54, 0x71, 0x15, 0xb4, 0x80, 0xbc, 0x08, 0x77, 0x62, 0xf3, 0x63, 0xa3,
-	0xbd, 0xfc, 0x94, 0xc0, 0xe4, 0xd1, 0xd7, 0xa0, 0xee, 0x07, 0x07, 0xc3, 0xf0, 0xb3, 0xc2, 0xc9,
-	0xf1, 0xc0, 0x0d, 0x2a, 0x8a, 0x39, 0x82, 0x42, 0xd9, 0x5c, 0x10, 0x1f, 0x14, 0x0a, 0xa0, 0x6c,
-	0x12, 0x62, 0x8e, 0x40, 0x77, 0xa0, 0xd9, 0xdf, 0x26, 0xfd, 0x1d, 0x62, 0x89, 0x2f, 0x09, 0xa7,
-	0xc7, 0x83, 0x97, 0xb8, 0x30, 0x0e, 0x51, 0x54, 0x77, 0x9f, 0x8d, 0x6e, 0xa3, 0x8c, 0x6e, 0x36,
-	0xe2, 0x98, 0x23, 0xd0, 0x0a, 0xb4, 0xed, 0xbe, 0xeb, 0xac, 0xec, 0xba, 0xdf, 0xb1, 0xc5, 0x27,
-	0x83, 0xb3, 0xe3, 0xe1, 0xbd, 0x50, 0x1c, 0xc7, 0xc8, 0x90, 0xa6, 0xb7, 0x4b, 0x77, 0xdd, 0xad,
-	0xb2, 0x34, 0x4c, 0x1c, 0xc7, 0x48, 0x63, 0x56, 0x8c, 0x67, 0xf6, 0x24, 0xbf, 0x07, 0x75, 0xd6,
-	0xe5, 0xe8, 0x96, 0x5c, 0x3c, 0x23, 0x69, 0xca, 0x5d, 0xb1, 0xc4, 0x50, 0x45, 0x3c, 0xac, 0xff,
-	0x55, 0x9e, 0xe9, 0x32, 0x3c, 0x62, 0xdc, 0x38, 0xcf, 0x1b, 0xd0, 0x14, 0x43, 0xa1, 0x56, 0xb8,
-	0x15, 0x0a, 0xbc, 0x0e, 0x75, 0x3e, 0x31, 0xb3, 0xdb, 0xf3, 0x26, 0xb4, 0xa3, 0xce, 0x1c, 0x2f,
-	0xc2, 0x7a, 0x27, 0x47, 0xc4, 0x81, 0x3a, 0x8f, 0x53, 0xa7, 0x57, 0x5a, 0x79, 0x12, 0x9c, 0x1c,
-	0x1f, 0xf6, 0x96, 0x66, 0x41, 0xc1, 0x28, 0xfc, 0xa0, 0x02, 0xb5, 0x65, 0x7b, 0x3f, 0xa5, 0xee,
-	0x7a, 0x38, 0x77, 0x8a, 0x26, 0xdd, 0xb2, 0xbd, 0xaf, 0x4c, 0x1d, 0x63, 0x25, 0x1c, 0xd7, 0x9b,
-	0xea, 0xb8, 0x9e, 0x19, 0xbf, 0x9d, 0x89, 0x69, 0x78, 0xc5, 0xfe, 0xac, 0x01, 0x1a, 0xfb, 0xd2,
-	0x92, 0xb5, 0x1a, 0x1c, 0x8c, 0x8a, 0x2b, 0xc6, 0xce, 0x85, 0xcc, 0xad, 0x31, 0x79, 0xbe, 0x1a,
-	0x98, 0x41, 0xf1, 0x6a, 0xc0, 0x8f, 0xa6, 0x54, 0x14, 0x73, 0x04, 0x55, 0xb9, 0x6b, 0xef, 0x12,
-	0xb1, 0x18, 0x14, 0xa8, 0x7c, 0x64, 0xef, 0x12, 0xcc, 0xe4, 0x29, 0x6e, 0xdb, 0xf4, 0xb7, 0xc5,
-	0x3a, 0x50, 0x80, 0x5b, 0x35, 0xfd, 0x6d, 0xcc, 0xe4, 0x29, 0xce, 0x31, 0x77, 0x89, 0x58, 0x00,
-	0x0a, 0x70, 0x6b, 0x26, 0xd5, 0x47, 0xe5, 0x29, 0xce, 0xb7, 0xbf, 0x47, 0xc4, 0xcc, 0x2f, 0xc0,
-	0x6d, 0xd8, 0xdf, 0x23, 0x98, 0xc9, 0xc7, 0x0b, 0x65, 0xab, 0x5c, 0xd7, 0x48, 0xa3, 0x3d, 0x0b,
-	0x1a, 0xad, 0x40, 0x8e, 0x75, 0xbd, 0x0e, 0xf5, 0xaf, 0xdb, 0x56, 0xb0, 0xad, 0x16, 0xd7, 0x95,
-	0x25, 0x80, 0x76, 0xf0, 0x44, 0x4b, 0x80, 0x3c, 0x3e, 0x9c, 0x67, 0x19, 0x34, 0x3a, 0xd0, 0x93,
-	0x59, 0x5c, 0x6c, 0x1f, 0x5f, 0x68, 0x41, 0x92, 0xbb, 0x84, 0xf3, 0xcc, 0x82, 0x46, 0xc7, 0x32,
-	0xa7, 0x4b, 0x66, 0x41, 0xa3, 0x16, 0x92, 0x5f, 0x4a, 0xc7, 0x45, 0x2d, 0xad, 0x85, 0xa5, 0x7f,
-	0xd7, 0x04, 0x8d, 0x7d, 0x38, 0x4c, 0xce, 0x89, 0xdf, 0x82, 0xc3, 0x01, 0x8b, 0xda, 0x2e, 0x8a,
-	0xad, 0x66, 0x35, 0xf3, 0xde, 0x80, 0xfa, 0x39, 0x52, 0x84, 0x82, 0x05, 0x04, 0xab, 0x0c, 0xe5,
-	0x9d, 0x27, 0xa3, 0x52, 0x9c, 0xe7, 0xcd, 0x68, 0x93, 0xa6, 0x15, 0x7c, 0xb5, 0x66, 0x58, 0xbe,
-	0xd5, 0x0b, 0x77, 0x6c, 0x68, 0x11, 0x5a, 0xd4, 0x85, 0xd0, 0x6e, 0x10, 0x13, 0xe7, 0xcc, 0x78,
-	0x7c, 0x4f, 0x48, 0xe3, 0x08, 0x47, 0x1d, 0x58, 0xdf, 0xf4, 0x2c, 0x56, 0x2b, 0x31, 0x8b, 0xce,
-	0x8e, 0x27, 0x59, 0x0a, 0xc5, 0x71, 0x8c, 0x44, 0x0f, 0x60, 0xda, 0x22, 0xd1, 0xb1, 0x57, 0x4c,
-	0xab, 0xb7, 0xc6, 0x13, 0x2d, 0xc7, 0x00, 0x2c, 0xa3, 0x69, 0x9d, 0xc2, 0xa3, 0x8e, 0x5f, 0xe8,
-	0x54, 0x19, 0x55, 0x7c, 0xb9, 0x27, 0x46, 0x1a, 0xa7, 0xe1, 0xb0, 0x32, 0x6e, 0x5f, 0xaa, 0x77,
-	0x95, 0xc7, 0x92, 0xf3, 0x5c, 0x8b, 0xb6, 0xe2, 0xef, 0xaa, 0xee, 0x35, 0x77, 0xe7, 0x2d, 0x80,
-	0x0f, 0xa1, 0x15, 0x0e, 0x0c, 0xba, 0xab, 0xd6, 0xe1, 0xed, 0xe2, 0x3a, 0x44, 0x63, 0x2a, 0xd8,
-	0xd6, 0xa0, 0x1d, 0x8d, 0x10, 0x3d, 0x27, 0xcb, 0x74, 0xef, 0x14, 0xd3, 0xc5, 0xa3, 0x2b, 0xf8,
-	0x30, 0x4c, 0x4b, 0x03, 0x85, 0x96, 0x54, 0xc6, 0x77, 0x8b, 0x19, 0xe5, 0x61, 0x8e, 0xbd, 0x7b,
-	0x34, 0x62, 0xf2, 0xa8, 0xd4, 0xe2, 0x51, 0xf9, 0x71, 0x13, 0x5a, 0xd1, 0xc7, 0xfa, 0x8c, 0xb3,
-	0xd4, 0x9e, 0x37, 0x2c, 0x3c, 0x4b, 0x85, 0xf8, 0xee, 0x53, 0x6f, 0x88, 0x29, 0x82, 0x0e, 0x71,
-	0x60, 0x07, 0xd1, 0x54, 0x3d, 0x5b, 0x0c, 0x7d, 0x42, 0xc5, 0x31, 0x47, 0xa1, 0xc7, 0xaa, 0x95,
-	0x6b, 0x63, 0x3e, 0xe6, 0x28, 0x24, 0xb9, 0x96, 0xde, 0x83, 0xb6, 0x4d, 0xb7, 0x38, 0xab, 0xb1,
-	0xef, 0x7b, 0xa7, 0x98, 0xae, 0x17, 0x42, 0x70, 0x8c, 0xa6, 0x75, 0xdb, 0x32, 0xf7, 0xe9, 0xbc,
-	0x66, 0x64, 0x8d, 0xb2, 0x75, 0xbb, 0x17, 0x83, 0xb0, 0xcc, 0x80, 0x6e, 0x88, 0xdd, 0x43, 0xb3,
-	0x60, 0x65, 0x89, 0xbb, 0x2a, 0xde, 0x41, 0x7c, 0x04, 0x33, 0x81, 0xf2, 0x6d, 0x4c, 0x4c, 0xe3,
-	0xf7, 0x4a, 0xb0, 0x28, 0x38, 0x9c, 0xe0, 0xa1, 0x23, 0xc8, 0xf7, 0x26, 0xed, 0xb2, 0x23, 0x28,
-	0xef, 0x4f, 0xe8, 0x61, 0xfa, 0xa9, 0x37, 0xcc, 0xf7, 0xc1, 0x6c, 0xb8, 0x73, 0x8a, 0x4f, 0xaa,
-	0x33, 0x21, 0x7f, 0xe3, 0x1a, 0x8d, 0x49, 0x2e, 0x8f, 0xd4, 0xe9, 0x39, 0x42, 0xb7, 0x84, 0xa3,
-	0xbe, 0xa2, 0xce, 0xb7, 0x37, 0x12, 0xf3, 0x8d, 0xce, 0xb0, 0x75, 0x8f, 0xf0, 0xef, 0x95, 0x92,
-	0x87, 0x3e, 0x03, 0x33, 0x6a, 0x47, 0xe6, 0xa8, 0xb9, 0x1f, 0xee, 0x2b, 0x26, 0x5a, 0x29, 0x92,
-	0x7d, 0xcb, 0xb9, 0xfe, 0xa8, 0x02, 0xad, 0xe8, 0x2e, 0x46, 0x3a, 0xd8, 0xdc, 0xb2, 0xfd, 0x55,
-	0x62, 0x5a, 0xc4, 0x13, 0xf3, 0xf6, 0xed, 0xc2, 0x4b, 0x1e, 0xdd, 0x9e, 0x40, 0xe0, 0x08, 0x6b,
-	0x9c, 0x80, 0x56, 0x98, 0x9b, 0x73, 0xf8, 0xf8, 0x79, 0x15, 0x1a, 0xe2, 0x16, 0x47, 0xb2, 0x12,
-	0xb7, 0xa1, 0x31, 0x34, 0x0f, 0xdc, 0xbd, 0xf0, 0x6c, 0x70, 0xa6, 0xe0, 0x62, 0x48, 0xf7, 0x21,
-	0x93, 0xc6, 0x02, 0x85, 0x3e, 0x80, 0xfa, 0xd0, 0xde, 0xb5, 0x03, 0xb1, 0x7c, 0x9c, 0x2e, 0x84,
-	0xb3, 0xef, 0x3d, 0x1c, 0x43, 0x95, 0xb3, 0x8f, 0xb7, 0xe1, 0xd5, 0xbb, 0x42, 0xe5, 0xcf, 0x98,
-	0x34, 0x16, 0x28, 0xe3, 0x3e, 0x34, 0x78, 0x75, 0x26, 0x73, 0x12, 0x6a, 0x4b, 0x62, 0x4b, 0x67,
-	0x75, 0xcb, 0xd9, 0x6d, 0xce, 0x41, 0x83, 0x2b, 0xcf, 0xb1, 0x9a, 0x9f, 0xbd, 0xca, 0x4e, 0x1c,
-	0x43, 0xe3, 0x61, 0xfc, 0x29, 0xe7, 0x8b, 0x87, 0xe6, 0x8d, 0x27, 0x70, 0x64, 0xd9, 0x0c, 0xcc,
-	0x4d, 0xd3, 0x27, 0x98, 0xf4, 0x5d, 0xcf, 0xca, 0x64, 0xf5, 0x78, 0x91, 0x08, 0xb8, 0xe6, 0xb3,
-	0x0a, 0xb9, 0xaf, 0x42, 0x64, 0xff, 0x7b, 0x42, 0x64, 0x7f, 0xab, 0xe5, 0xc4, 0xad, 0xca, 0x1c,
-	0xd9, 0xa9, 0xc1, 0xa5, 0x02, 0x57, 0x37, 0xd4, 0xbd, 0xf7, 0xa9, 0x02, 0xa4, 0xb2, 0xf9, 0xbe,
-	0xa1, 0x46, 0xae, 0x8a, 0xb0, 0x4a, 0xe8, 0xea, 0x6e, 0x32, 0x74, 0x75, 0xa6, 0x00, 0x9d, 0x8a,
-	0x5d, 0xdd, 0x50, 0x63, 0x57, 0x45, 0xda, 0xe5, 0xe0, 0xd5, 0xff, 0xb3, 0x70, 0xd1, 0x5f, 0xe4,
-	0x04, 0x5e, 0xbe, 0xa6, 0x06, 0x5e, 0xc6, 0x58, 0xcd, 0xaf, 0x2b, 0xf2, 0xf2, 0x97, 0x79, 0x91,
-	0x97, 0x6b, 0x4a, 0xe4, 0x65, 0x4c, 0xcd, 0x92, 0xa1, 0x97, 0x1b, 0x6a, 0xe8, 0xe5, 0x54, 0x01,
-	0x52, 0x89, 0xbd, 0x5c, 0x53, 0x62, 0x2f, 0x45, 0x4a, 0xa5, 0xe0, 0xcb, 0x35, 0x25, 0xf8, 0x52,
-	0x04, 0x94, 0xa2, 0x2f, 0xd7, 0x94, 0xe8, 0x4b, 0x11, 0x50, 0x0a, 0xbf, 0x5c, 0x53, 0xc2, 0x2f,
-	0x45, 0x40, 0x29, 0xfe, 0x72, 0x43, 0x8d, 0xbf, 0x14, 0xf7, 0xcf, 0x57, 0x01, 0x98, 0xdf, 0x4c,
-	0x00, 0xe6, 0x4f, 0x6a, 0x39, 0x01, 0x18, 0x9c, 0x1d, 0x80, 0x39, 0x9f, 0x3f, 0x92, 0xc5, 0x11,
-	0x98, 0xf2, 0x5e, 0x20, 0x1d, 0x82, 0xb9, 0x95, 0x08, 0xc1, 0x9c, 0x2e, 0x00, 0xab, 0x31, 0x98,
-	0xff, 0x33, 0x41, 0x86, 0xbf, 0x69, 0x8c, 0x39, 0x4f, 0x5f, 0x97, 0xcf, 0xd3, 0x63, 0x3c, 0x59,
-	0xfa, 0x40, 0x7d, 0x5b, 0x3d, 0x50, 0x9f, 0x2b, 0x81, 0x55, 0x4e, 0xd4, 0xeb, 0x59, 0x27, 0xea,
-	0x6e, 0x09, 0x96, 0xdc, 0x23, 0xf5, 0xfd, 0xf4, 0x91, 0xfa, 0x7c, 0x09, 0xbe, 0xcc, 0x33, 0xf5,
-	0x7a, 0xd6, 0x99, 0xba, 0x4c, 0xed, 0x72, 0x0f, 0xd5, 0x1f, 0x28, 0x87, 0xea, 0xb3, 0x65, 0xba,
-	0x2b, 0x76, 0x0e, 0xdf, 0xc8, 0x39, 0x55, 0xbf, 0x5f, 0x86, 0x66, 0xec, 0xb1, 0xfa, 0xab, 0x73,
-	0x71, 0x42, 0xcd, 0x2f, 0xe7, 0xa0, 0x15, 0xde, 0x1b, 0x31, 0xbe, 0x0b, 0xcd, 0xf0, 0xea, 0x7e,
-	0x72, 0xe6, 0x1c, 0x8f, 0x0e, 0x75, 0x7c, 0xf7, 0x2c, 0x52, 0xe8, 0x36, 0x68, 0xf4, 0x97, 0x98,
-	0x16, 0x6f, 0x97, 0xbb, 0x9f, 0x42, 0x95, 0x60, 0x86, 0x33, 0xfe, 0xfe, 0x18, 0x80, 0x74, 0xa3,
-	0xb9, 0xac, 0xda, 0x0f, 0xe9, 0x62, 0x36, 0x0c, 0x88, 0xc7, 0xee, 0x25, 0x15, 0xde, 0xf8, 0x8d,
-	0x35, 0x50, 0x6b, 0x09, 0x88, 0x87, 0x05, 0x1c, 0x3d, 0x82, 0x56, 0x18, 0x48, 0xd5, 0x35, 0x46,
-	0xf5, 0x7e, 0x69, 0xaa, 0x30, 0xb4, 0x87, 0x23, 0x0a, 0xb4, 0x00, 0x9a, 0xef, 0x7a, 0x81, 0x5e,
-	0x67, 0x54, 0xef, 0x96, 0xa6, 0xda, 0x70, 0xbd, 0x00, 0x33, 0x28, 0x6f, 0x9a, 0xf4, 0x60, 0x6c,
-	0x92, 0xa6, 0x29, 0x2b, 0xf6, 0x4f, 0x6b, 0xd1, 0x1a, 0xba, 0x24, 0x66, 0x23, 0xb7, 0xa1, 0x0b,
-	0xe5, 0x47, 0x49, 0x9e, 0x95, 0x48, 0x6c, 0x82, 0xf8, 0x48, 0xf0, 0xfd, 0xcd, 0xdb, 0xd0, 0xe9,
-	0xbb, 0xfb, 0xc4, 0xc3, 0xf1, 0x8d, 0x1d, 0x71, 0xa9, 0x2a, 0x95, 0x8f, 0x0c, 0x68, 0x6d, 0xdb,
-	0x16, 0xe9, 0xf5, 0xc5, 0xfa, 0xd7, 0xc2, 0x51, 0x1a, 0x3d, 0x80, 0x16, 0x8b, 0xb1, 0x87, 0x11,
-	0xfe, 0xc9, 0x2a, 0xc9, 0x43, 0xfd, 0x21, 0x01, 0x55, 0xc4, 0x94, 0xdf, 0xb3, 0x03, 0xd6, 0x87,
-	0x2d, 0x1c, 0xa5, 0x69, 0x85, 0xd9, 0xb5, 0x28, 0xb9, 0xc2, 0x4d, 0x5e, 0xe1, 0x64, 0x3e, 0xba,
-	0x0c, 0x2f, 0xb3, 0xbc, 0xc4, 0x11, 0x93, 0x87, 0xea, 0x5b, 0x38, 0xbb, 0x90, 0x5d, 0x03, 0x33,
-	0x07, 0xfc, 0x0a, 0x2c, 0x0b, 0xde, 0xd5, 0x71, 0x9c, 0x81, 0xce, 0xc3, 0x51, 0x8b, 0x6c, 0x99,
-	0x7b, 0xc3, 0xe0, 0x09, 0xd9, 0x1d, 0x0d, 0xcd, 0x80, 0xf4, 0x2c, 0xf6, 0x66, 0xad, 0x8d, 0xd3,
-	0x05, 0xc6, 0xcf, 0x34, 0x3a, 0x84, 0xcc, 0x50, 0x3f, 0x84, 0x9a, 0x69, 0x59, 0xc2, 0x09, 0x5e,
-	0x9a, 0xd0, 0xdc, 0xc5, 0x23, 0x4b, 0xca, 0x80, 0xd6, 0xa3, 0xfb, 0x60, 0xdc, 0x0d, 0x5e, 0x9d,
-	0x94, 0x2b, 0x7a, 0x18, 0x2b, 0x78, 0x28, 0xe3, 0x1e, 0xbf, 0xf5, 0x5c, 0xfb, 0xd5, 0x18, 0xa3,
-	0xeb, 0xd0, 0x82, 0x07, 0xdd, 0x07, 0x8d, 0xd5, 0x90, 0xbb, 0xc9, 0xcb, 0x93, 0xf2, 0x3d, 0xe2,
-	0xf5, 0x63, 0x1c, 0x46, 0x9f, 0xdf, 0xd8, 0x92, 0x6e, 0x03, 0x56, 0xd4, 0xdb, 0x80, 0x8b, 0x50,
-	0xb7, 0x03, 0xb2, 0x9b, 0xbe, 0x1c, 0x3a, 0xd6, 0xf0, 0xc4, 0x3a, 0xc2, 0xa1, 0x63, 0x2f, 0xa9,
-	0x7d, 0x1c, 0xdd, 0x93, 0x4d, 0xae, 0x6e, 0x77, 0x41, 0xa3, 0xf0, 0xd4, 0xce, 0xb0, 0x8c, 0x62,
-	0x86, 0x34, 0x2e, 0x82, 0x46, 0x1b, 0x3b, 0xa6, 0x75, 0xa2, 0x3e, 0xd5, 0xa8, 0x3e, 0x8b, 0xd3,
-	0xd0, 0x76, 0x47, 0xc4, 0x63, 0x66, 0x6e, 0xfc, 0x42, 0x93, 0xae, 0x72, 0xf5, 0x64, 0x1b, 0xbb,
-	0x32, 0xf1, 0x3a, 0x28, 0x5b, 0x19, 0x4e, 0x58, 0xd9, 0xf5, 0xc9, 0xd9, 0x52, 0x76, 0x86, 0x13,
-	0x76, 0xf6, 0x2b, 0x70, 0xa6, 0x2c, 0xed, 0xa1, 0x62, 0x69, 0x57, 0x27, 0x67, 0x54, 0x6c, 0x8d,
-	0x14, 0xd9, 0xda, 0xb2, 0x6a, 0x6b, 0xdd, 0x72, 0x43, 0x1e, 0x39, 0x9a, 0x12, 0xd6, 0xf6, 0xad,
-	0x5c, 0x6b, 0x5b, 0x54, 0xac, 0x6d, 0x52, 0xd5, 0x5f, 0x92, 0xbd, 0xfd, 0x9b, 0x06, 0x1a, 0x75,
-	0x76, 0x68, 0x45, 0xb6, 0xb5, 0xf7, 0x27, 0x72, 0x94, 0xb2, 0x9d, 0xad, 0x25, 0xec, 0xec, 0xf2,
-	0x64, 0x4c, 0x29, 0x1b, 0x5b, 0x4b, 0xd8, 0xd8, 0x84, 0x7c, 0x29, 0xfb, 0x5a, 0x55, 0xec, 0xeb,
-	0xe2, 0x64, 0x6c, 0x8a, 0x6d, 0x99, 0x45, 0xb6, 0x75, 0x57, 0xb5, 0xad, 0x92, 0x7b, 0x31, 0xb6,
-	0xf3, 0x28, 0x61, 0x57, 0x1f, 0xe5, 0xda, 0xd5, 0x6d, 0xc5, 0xae, 0x26, 0x51, 0xfb, 0x25, 0xd9,
-	0xd4, 0x65, 0xbe, 0x85, 0x14, 0xb7, 0x63, 0x4b, 0x6e, 0x21, 0x8d, 0x2b, 0xd0, 0x8e, 0x1f, 0x78,
-	0x66, 0xdc, 0x1d, 0xe7, 0x62, 0xa1, 0xd6, 0x30, 0x69, 0x5c, 0x82, 0x76, 0xfc, 0x68, 0x33, 0x43,
-	0x97, 0xcf, 0x0a, 0x05, 0x4a, 0xa4, 0x8c, 0x15, 0x38, 0x9a, 0x7e, 0x52, 0x96, 0x11, 0x55, 0x97,
-	0x2e, 0x3e, 0x8b, 0xda, 0xca, 0x59, 0xc6, 0x73, 0x98, 0x49, 0x3c, 0x12, 0x9b, 0x98, 0x03, 0x5d,
-	0x92, 0x36, 0xbc, 0x35, 0x71, 0xa2, 0xce, 0xbe, 0xca, 0x1d, 0x6f, 0x6b, 0x8d, 0x65, 0x98, 0x29,
-	0xa8, 0x7c, 0x99, 0x9b, 0xdc, 0x9f, 0xc0, 0xf4, 0xb8, 0xba, 0x7f, 0x09, 0x37, 0xcd, 0x03, 0xe8,
-	0xa4, 0x1e, 0xb8, 0x26, 0xd5, 0xac, 0x03, 0x0c, 0x22, 0x19, 0x61, 0xb4, 0xef, 0x4d, 0x70, 0xaf,
-	0x9e, 0xe1, 0xb0, 0xc4, 0x61, 0xfc, 0xb0, 0x02, 0x47, 0xd3, 0xaf, 0x5b, 0xcb, 0x1e, 0x65, 0x74,
-	0x68, 0x32, 0xae, 0xe8, 0x39, 0x42, 0x98, 0x44, 0x8f, 0xe0, 0x90, 0x3f, 0xb4, 0xfb, 0x64, 0x69,
-	0xdb, 0x74, 0x06, 0xc4, 0x17, 0xe7, 0x93, 0x82, 0x17, 0xaa, 0x1b, 0x31, 0x02, 0x2b, 0x70, 0xe3,
-	0x39, 0x4c, 0x4b, 0x85, 0xe8, 0x26, 0x54, 0xdd, 0x91, 0x38, 0x11, 0x9c, 0x2f, 0xc1, 0xf9, 0x38,
-	0x9c, 0x6f, 0xb8, 0xea, 0x8e, 0xd2, 0x53, 0x52, 0x9e, 0xbe, 0x35, 0x65, 0xfa, 0x1a, 0x0f, 0xe0,
-	0x68, 0xfa, 0x01, 0x69, 0xb2, 0x7b, 0xce, 0xa4, 0xce, 0xfc, 0xbc, 0x9b, 0x92, 0x07, 0xf8, 0x6b,
-	0x70, 0x24, 0xf9, 0x2c, 0x34, 0xe3, 0xa9, 0x48, 0xfc, 0xe2, 0x26, 0x0c, 0xbe, 0xcf, 0xff, 0x71,
-	0x05, 0x66, 0xd4, 0x86, 0xa0, 0xe3, 0x80, 0xd4, 0x9c, 0x35, 0xd7, 0x21, 0x9d, 0x29, 0xf4, 0x32,
-	0x1c, 0x55, 0xf3, 0x17, 0x2c, 0xab, 0x53, 0x49, 0x8b, 0xd3, 0x65, 0xab, 0x53, 0x45, 0x3a, 0x1c,
-	0x4b, 0xf4, 0x10, 0x5b, 0x44, 0x3b, 0x35, 0xf4, 0x2a, 0xbc, 0x9c, 0x2c, 0x19, 0x0d, 0xcd, 0x3e,
-	0xe9, 0x68, 0xc6, 0x7f, 0x57, 0x41, 0x7b, 0xea, 0x13, 0xcf, 0xf8, 0xcf, 0x6a, 0xf8, 0xb6, 0xe0,
-	0x3a, 0x68, 0xec, 0xc5, 0xa6, 0xf4, 0xd2, 0xac, 0x92, 0x78, 0x69, 0xa6, 0xfc, 0xbb, 0xa6, 0xf8,
-	0xa5, 0xd9, 0x75, 0xd0, 0xd8, 0x1b, 0xcd, 0xc9, 0x91, 0x7f, 0x58, 0x81, 0x76, 0xfc, 0x5e, 0x72,
-	0x62, 0xbc, 0xfc, 0x96, 0xa1, 0xaa, 0xbe, 0x65, 0x78, 0x1b, 0xea, 0x1e, 0x7b, 0x75, 0xc0, 0x57,
-	0x99, 0xe4, 0x0b, 0x09, 0xa6, 0x10, 0x73, 0x11, 0x83, 0xc0, 0xb4, 0xfc, 0x1a, 0x74, 0xf2, 0x6a,
-	0x9c, 0x12, 0xff, 0x0a, 0xa2, 0x67, 0xf9, 0x0b, 0x9e, 0x67, 0x1e, 0x08, 0xc3, 0x54, 0x33, 0x8d,
-	0x59, 0xd0, 0xd6, 0x6d, 0x67, 0x90, 0xfd, 0xc0, 0xcf, 0xf8, 0x49, 0x05, 0x9a, 0xe2, 0x6d, 0xa5,
-	0x71, 0x0d, 0x6a, 0x6b, 0xe4, 0x39, 0xad, 0x88, 0x78, 0x5d, 0x99, 0xaa, 0xc8, 0x23, 0xd6, 0x0a,
-	0x21, 0x8f, 0x43, 0x31, 0xe3, 0x46, 0xe4, 0x26, 0x27, 0xc7, 0x5e, 0x07, 0x8d, 0x3d, 0xe2, 0x9c,
-	0x1c, 0xf9, 0x57, 0x2d, 0x68, 0xf0, 0x57, 0x72, 0xc6, 0x0f, 0x5a, 0xd0, 0xe0, 0x0f, 0x3b, 0xd1,
-	0x6d, 0x68, 0xfa, 0x7b, 0xbb, 0xbb, 0xa6, 0x77, 0xa0, 0x67, 0xff, 0x2f, 0x31, 0xe5, 0x1d, 0x68,
-	0x77, 0x83, 0xcb, 0xe2, 0x10, 0x84, 0xae, 0x80, 0xd6, 0x37, 0xb7, 0x48, 0xea, 0xe3, 0x6c, 0x16,
-	0x78, 0xc9, 0xdc, 0x22, 0x98, 0x89, 0xa3, 0xbb, 0xd0, 0x12, 0xc3, 0xe2, 0x8b, 0xe8, 0xcc, 0x78,
-	0xbd, 0xe1, 0x60, 0x46, 0x28, 0xe3, 0x3e, 0x34, 0x45, 0x65, 0xd0, 0x9d, 0xe8, 0x8d, 0x60, 0x32,
-	0x8e, 0x9c, 0xd9, 0x84, 0x03, 0xa7, 0x9f, 0x78, 0x2d, 0xf8, 0x8f, 0x55, 0xd0, 0x68, 0xe5, 0xbe,
-	0x30, 0x13, 0x9a, 0x03, 0x18, 0x9a, 0x7e, 0xb0, 0xbe, 0x37, 0x1c, 0x12, 0x4b, 0x3c, 0xff, 0x92,
-	0x72, 0xd0, 0x39, 0x38, 0xc2, 0x53, 0xfe, 0xf6, 0xc6, 0x5e, 0xbf, 0x4f, 0x88, 0x25, 0x5e, 0x5c,
-	0x25, 0xb3, 0xd1, 0x02, 0xd4, 0xd9, 0xbf, 0x1a, 0x12, 0xbb, 0xc2, 0x77, 0x0a, 0x7b, 0xb6, 0xbb,
-	0x6e, 0x3b, 0xa2, 0x36, 0x1c, 0x69, 0xb8, 0xd0, 0x8e, 0xf2, 0xe8, 0x24, 0x1c, 0xd9, 0x8e, 0x63,
-	0x3b, 0x03, 0x61, 0xd1, 0x61, 0x92, 0x3a, 0x1d, 0xfa, 0x53, 0xd4, 0xb7, 0x8e, 0x45, 0x8a, 0xe6,
-	0x6f, 0x99, 0xf6, 0x50, 0x54, 0xb1, 0x8e, 0x45, 0x8a, 0x32, 0xf1, 0x8d, 0x2b, 0xbf, 0xbc, 0x51,
-	0xc3, 0x61, 0xd2, 0xf8, 0xac, 0x12, 0x3d, 0x94, 0xcd, 0x7a, 0x39, 0x98, 0x8a, 0x0c, 0xcd, 0xca,
-	0xe1, 0x69, 0xee, 0x10, 0xa4, 0x80, 0xf3, 0x71, 0x68, 0xb8, 0xce, 0xd0, 0x76, 0x88, 0x88, 0x04,
-	0x89, 0x54, 0xa2, 0x8f, 0xeb, 0xa9, 0x3e, 0x16, 0xe5, 0x2b, 0x96, 0x4d, 0xab, 0xd8, 0x88, 0xcb,
-	0x79, 0x0e, 0xba, 0x05, 0x4d, 0x8b, 0xec, 0xdb, 0x7d, 0xe2, 0xeb, 0x4d, 0x66, 0x7a, 0x27, 0xc7,
-	0xf6, 0xed, 0x32, 0x93, 0xc5, 0x21, 0xc6, 0x08, 0xa0, 0xc1, 0xb3, 0xa2, 0x26, 0x55, 0xa4, 0x26,
-	0xc5, 0x95, 0xae, 0x8e, 0xa9, 0x74, 0xad, 0xa0, 0xd2, 0x5a, 0xb2, 0xd2, 0xf3, 0x16, 0x40, 0x6c,
-	0x6e, 0x68, 0x1a, 0x9a, 0x4f, 0x9d, 0x1d, 0xc7, 0x7d, 0xee, 0x74, 0xa6, 0x68, 0xe2, 0xf1, 0xd6,
-	0x16, 0xd5, 0xd2, 0xa9, 0xd0, 0x04, 0x95, 0xb3, 0x9d, 0x41, 0xa7, 0x8a, 0x00, 0x1a, 0x34, 0x41,
-	0xac, 0x4e, 0x8d, 0xfe, 0xbe, 0xc7, 0xc6, 0xaf, 0xa3, 0xa1, 0x57, 0xe0, 0xa5, 0x9e, 0xd3, 0x77,
-	0x77, 0x47, 0x66, 0x60, 0x6f, 0x0e, 0xc9, 0x33, 0xe2, 0xf9, 0xb6, 0xeb, 0x74, 0xea, 0xc6, 0x8f,
-	0x2a, 0xfc, 0x1b, 0xae, 0x71, 0x17, 0x0e, 0x29, 0xef, 0xb3, 0x75, 0x68, 0xfa, 0x23, 0xfe, 0x1f,
-	0x13, 0xc5, 0xbe, 0x5b, 0x24, 0x99, 0x95, 0xf0, 0x27, 0xcb, 0x62, 0xcb, 0xc2, 0x53, 0xc6, 0x79,
-	0x00, 0xe9, 0x55, 0xf6, 0x1c, 0xc0, 0xe6, 0x41, 0x40, 0x7c, 0xfe, 0x22, 0x9b, 0x52, 0x68, 0x58,
-	0xca, 0x31, 0xae, 0x02, 0xc4, 0x2f, 0xaf, 0xd9, 0x2c, 0xa1, 0xa9, 0xc5, 0x24, 0x24, 0x99, 0x3d,
-	0xff, 0x7d, 0x38, 0x8c, 0x89, 0x3f, 0x72, 0x1d, 0x9f, 0xfc, 0xba, 0xfe, 0xc5, 0x64, 0xee, 0x3f,
-	0x8b, 0x9c, 0xff, 0x49, 0x0d, 0xea, 0x6c, 0xb1, 0x35, 0x7e, 0x54, 0x8b, 0xdc, 0x42, 0xc6, 0xc5,
-	0x9a, 0xf8, 0xf3, 0xf7, 0x8c, 0xb4, 0x53, 0x55, 0x96, 0x69, 0x39, 0x86, 0x7a, 0x51, 0xfe, 0xec,
-	0x3d, 0x23, 0x3d, 0xcc, 0x57, 0x11, 0xca, 0xe7, 0xee, 0x0f, 0xa0, 0x35, 0xf2, 0xdc, 0x81, 0x47,
-	0xfd, 0x81, 0x96, 0xf8, 0x3f, 0x3f, 0x2a, 0x6c, 0x5d, 0x88, 0xe1, 0x08, 0x60, 0xac, 0x41, 0x2b,
-	0xcc, 0xcd, 0x79, 0xd4, 0x8a, 0x40, 0xb3, 0x5c, 0x61, 0xd3, 0x35, 0xcc, 0x7e, 0xd3, 0x7e, 0x11,
-	0x3d, 0x18, 0xee, 0xe5, 0x44, 0x72, 0xfe, 0xdb, 0xe2, 0xb3, 0xc4, 0x61, 0x68, 0x2f, 0x7b, 0xee,
-	0x88, 0x3d, 0x6b, 0xec, 0x4c, 0x51, 0x0b, 0xec, 0xed, 0x8e, 0x5c, 0x2f, 0xe8, 0x54, 0xe8, 0xef,
-	0x95, 0x17, 0xec, 0x77, 0x15, 0x1d, 0x82, 0xd6, 0x86, 0xb9, 0x4f, 0xa8, 0x58, 0xa7, 0x86, 0x10,
-	0x3d, 0x46, 0xb0, 0x50, 0xac, 0x58, 0x49, 0x3a, 0x1a, 0x25, 0x7a, 0x64, 0x0f, 0xf8, 0xee, 0xa8,
-	0x53, 0x9f, 0x5f, 0x08, 0x3f, 0x3f, 0xb7, 0x40, 0x13, 0xbb, 0xb1, 0x69, 0x68, 0xe2, 0x3d, 0xb6,
-	0x9c, 0x75, 0x2a, 0x34, 0x9b, 0xfa, 0x48, 0x4e, 0xbd, 0x64, 0x3a, 0x7d, 0x32, 0x64, 0x53, 0xa0,
-	0x0d, 0xf5, 0x15, 0xcf, 0x73, 0xbd, 0x8e, 0xb6, 0x38, 0xfb, 0x4f, 0x9f, 0xcd, 0x55, 0x3e, 0xfd,
-	0x6c, 0xae, 0xf2, 0xf3, 0xcf, 0xe6, 0x2a, 0x7f, 0xfa, 0xf9, 0xdc, 0xd4, 0xa7, 0x9f, 0xcf, 0x4d,
-	0xfd, 0xfb, 0xe7, 0x73, 0x53, 0x1f, 0x57, 0x47, 0x9b, 0x9b, 0x0d, 0xf6, 0xdd, 0xf0, 0xd2, 0xff,
-	0x04, 0x00, 0x00, 0xff, 0xff, 0x56, 0x47, 0xb4, 0x1d, 0x20, 0x55, 0x00, 0x00,
+	// 5477 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x4d, 0x8c, 0x1c, 0xc7,
+	0x75, 0xde, 0x99, 0xe9, 0xf9, 0x7b, 0x4b, 0x2e, 0x87, 0x45, 0x8a, 0x6a, 0xb7, 0x56, 0x2b, 0x8a,
+	0xff, 0x22, 0xa9, 0xa1, 0xc4, 0x7f, 0xd3, 0x14, 0xc5, 0xfd, 0xa3, 0x77, 0xc8, 0x25, 0xb9, 0xa9,
+	0xe5, 0xd2, 0xb2, 0x6c, 0x04, 0xee, 0x9d, 0xae, 0x9d, 0x6d, 0xef, 0x4c, 0xf7, 0xb8, 0xbb, 0x67,
+	0xc9, 0xb5, 0xf3, 0x87, 0xc4, 0xc8, 0x29, 0x01, 0x9c, 0x20, 0x70, 0x92, 0x43, 0x0e, 0x01, 0x92,
+	0x4b, 0x10, 0x24, 0x06, 0x8c, 0x00, 0x39, 0x05, 0x0e, 0x82, 0x00, 0xf9, 0xb9, 0x38, 0xb7, 0xdc,
+	0x6c, 0x48, 0x97, 0x5c, 0x0c, 0x24, 0x17, 0x9f, 0x72, 0x08, 0xea, 0xa7, 0xbb, 0xab, 0xfa, 0x67,
+	0x7a, 0xc6, 0x92, 0xe1, 0x04, 0xd1, 0x69, 0xb7, 0xaa, 0xde, 0xfb, 0xde, 0xeb, 0xaa, 0x57, 0xf5,
+	0x5e, 0xbd, 0xaa, 0x1a, 0x38, 0x31, 0xdc, 0xbe, 0x32, 0xf4, 0xdc, 0xc0, 0xf5, 0xaf, 0x90, 0x7d,
+	0xe2, 0x04, 0x7e, 0x9b, 0x95, 0x50, 0xdd, 0x74, 0x0e, 0x82, 0x83, 0x21, 0x31, 0xce, 0x0c, 0xf7,
+	0x7a, 0x57, 0xfa, 0xf6, 0xf6, 0x95, 0xe1, 0xf6, 0x95, 0x81, 0x6b, 0x91, 0x7e, 0x48, 0xce, 0x0a,
+	0x82, 0xdc, 0x98, 0xef, 0xb9, 0x6e, 0xaf, 0x4f, 0x78, 0xdb, 0xf6, 0x68, 0xe7, 0x8a, 0x1f, 0x78,
+	0xa3, 0x6e, 0xc0, 0x5b, 0x4f, 0xfd, 0xc9, 0x5f, 0x97, 0xa0, 0xba, 0x4a, 0xe1, 0xd1, 0x55, 0x68,
+	0x0c, 0x88, 0xef, 0x9b, 0x3d, 0xe2, 0xeb, 0xa5, 0x93, 0x95, 0x0b, 0xb3, 0x57, 0x4f, 0xb4, 0x85,
+	0xa8, 0x36, 0xa3, 0x68, 0x3f, 0xe6, 0xcd, 0x38, 0xa2, 0x43, 0xf3, 0xd0, 0xec, 0xba, 0x4e, 0x40,
+	0x5e, 0x06, 0x1d, 0x4b, 0x2f, 0x9f, 0x2c, 0x5d, 0x68, 0xe2, 0xb8, 0x02, 0x5d, 0x87, 0xa6, 0xed,
+	0xd8, 0x81, 0x6d, 0x06, 0xae, 0xa7, 0x57, 0x4e, 0x96, 0x14, 0x48, 0xa6, 0x64, 0x7b, 0xb1, 0xdb,
+	0x75, 0x47, 0x4e, 0x80, 0x63, 0x42, 0xa4, 0x43, 0x3d, 0xf0, 0xcc, 0x2e, 0xe9, 0x58, 0xba, 0xc6,
+	0x10, 0xc3, 0xa2, 0xf1, 0x93, 0x4b, 0x50, 0x17, 0x3a, 0xa0, 0xf7, 0x61, 0xd6, 0xe4, 0xbc, 0x9b,
+	0xbb, 0xee, 0x0b, 0xbd, 0xc4, 0xd0, 0x5f, 0x4b, 0x28, 0x2c, 0xd0, 0xdb, 0x94, 0x64, 0x6d, 0x06,
+	0xcb, 0x1c, 0xa8, 0x03, 0x73, 0xa2, 0xb8, 0x42, 0x02, 0xd3, 0xee, 0xfb, 0xfa, 0x3f, 0x73, 0x90,
+	0x85, 0x1c, 0x10, 0x41, 0xb6, 0x36, 0x83, 0x13, 0x8c, 0xe8, 0xcb, 0x70, 0x4c, 0xd4, 0x2c, 0xbb,
+	0xce, 0x8e, 0xdd, 0xdb, 0x1a, 0x5a, 0x66, 0x40, 0xf4, 0x7f, 0xe1, 0x78, 0x67, 0x72, 0xf0, 0x38,
+	0x6d, 0x9b, 0x13, 0xaf, 0xcd, 0xe0, 0x2c, 0x0c, 0xf4, 0x00, 0x0e, 0x8b, 0x6a, 0x01, 0xfa, 0xaf,
+	0x1c, 0xf4, 0xf5, 0x1c, 0xd0, 0x08, 0x4d, 0x65, 0x43, 0x4f, 0xa1, 0xe5, 0x6e, 0x7f, 0x9d, 0x74,
+	0x43, 0x9d, 0x37, 0x49, 0xa0, 0xb7, 0x18, 0xd2, 0x9b, 0x09, 0xa4, 0xa7, 0x8c, 0x2c, 0xfc, 0xda,
+	0xf6, 0x26, 0x09, 0xd6, 0x66, 0x70, 0x8a, 0x19, 0x6d, 0x01, 0x52, 0xea, 0x16, 0x07, 0xc4, 0xb1,
+	0xf4, 0xab, 0x0c, 0xf2, 0xf4, 0x78, 0x48, 0x46, 0xba, 0x36, 0x83, 0x33, 0x00, 0x52, 0xb0, 0x5b,
+	0x8e, 0x4f, 0x02, 0xfd, 0xda, 0x24, 0xb0, 0x8c, 0x34, 0x05, 0xcb, 0x6a, 0xd1, 0x57, 0xe0, 0x38,
+	0xaf, 0xc5, 0xa4, 0x6f, 0x06, 0xb6, 0xeb, 0x08, 0x7d, 0xaf, 0x33, 0xe0, 0xb3, 0xd9, 0xc0, 0x11,
+	0x6d, 0xa4, 0x71, 0x26, 0x08, 0xfa, 0x65, 0x78, 0x25, 0x51, 0x8f, 0xc9, 0xc0, 0xdd, 0x27, 0xfa,
+	0x0d, 0x86, 0x7e, 0xae, 0x08, 0x9d, 0x53, 0xaf, 0xcd, 0xe0, 0x6c, 0x18, 0xb4, 0x04, 0x87, 0xc2,
+	0x06, 0x06, 0x7b, 0x93, 0xc1, 0xce, 0xe7, 0xc1, 0x0a, 0x30, 0x85, 0x47, 0xd6, 0xd1, 0x0f, 0x3c,
+	0xbb, 0xcb, 0xf0, 0xa9, 0x11, 0xdc, 0x1a, 0xaf, 0x63, 0x4c, 0x2c, 0x2c, 0x21, 0x1b, 0x06, 0x61,
+	0x38, 0xe2, 0x8f, 0xb6, 0xfd, 0xae, 0x67, 0x0f, 0x69, 0xdd, 0xa2, 0x65, 0xe9, 0x77, 0xc7, 0x21,
+	0x6f, 0x4a, 0xc4, 0xed, 0x45, 0x8b, 0x76, 0x6e, 0x12, 0x00, 0x7d, 0x05, 0x90, 0x5c, 0x25, 0xbe,
+	0xfe, 0x3d, 0x06, 0xfb, 0xd6, 0x04, 0xb0, 0x51, 0x57, 0x64, 0xc0, 0x20, 0x13, 0x8e, 0xcb, 0xb5,
+	0x1b, 0xae, 0x6f, 0xd3, 0xbf, 0xfa, 0x3d, 0x06, 0x7f, 0x69, 0x02, 0xf8, 0x90, 0x85, 0xda, 0x45,
+	0x16, 0x54, 0x52, 0xc4, 0x32, 0x9d, 0x8e, 0xc4, 0xf3, 0xf5, 0xf7, 0x27, 0x16, 0x11, 0xb2, 0x24,
+	0x45, 0x84, 0xf5, 0xc9, 0x2e, 0xfa, 0xa2, 0xe7, 0x8e, 0x86, 0xbe, 0x7e, 0x7f, 0xe2, 0x2e, 0xe2,
+	0x0c, 0xc9, 0x2e, 0xe2, 0xb5, 0xe8, 0x26, 0x34, 0xb6, 0xfb, 0x6e, 0x77, 0x8f, 0x0e, 0x66, 0x99,
+	0x41, 0xea, 0x09, 0xc8, 0x25, 0xda, 0x2c, 0x86, 0x2f, 0xa2, 0xa5, 0x4b, 0x33, 0xfb, 0x7f, 0x85,
+	0xf4, 0x49, 0x40, 0xc4, 0xc2, 0xff, 0x5a, 0x26, 0x2b, 0x27, 0xa1, 0x4b, 0xb3, 0xc4, 0x81, 0x56,
+	0x60, 0x76, 0xc7, 0xee, 0x13, 0x7f, 0x6b, 0xd8, 0x77, 0x4d, 0xee, 0x05, 0x66, 0xaf, 0x9e, 0xcc,
+	0x04, 0x78, 0x10, 0xd3, 0x51, 0x14, 0x89, 0x0d, 0xdd, 0x83, 0xe6, 0xc0, 0xf4, 0xf6, 0xfc, 0x8e,
+	0xb3, 0xe3, 0xea, 0xd5, 0xcc, 0xa5, 0x9d, 0x63, 0x3c, 0x0e, 0xa9, 0xd6, 0x66, 0x70, 0xcc, 0x42,
+	0x1d, 0x04, 0x53, 0x6a, 0x93, 0x04, 0x0f, 0x6c, 0xd2, 0xb7, 0x7c, 0xbd, 0xc6, 0x40, 0xde, 0xc8,
+	0x04, 0xd9, 0x24, 0x41, 0x9b, 0x93, 0x51, 0x07, 0xa1, 0x32, 0xa2, 0x0f, 0xe0, 0x58, 0x58, 0xb3,
+	0xbc, 0x6b, 0xf7, 0x2d, 0x8f, 0x38, 0x1d, 0xcb, 0xd7, 0xeb, 0x99, 0xfe, 0x21, 0xc6, 0x93, 0x68,
+	0xa9, 0x7f, 0xc8, 0x80, 0xa0, 0x0b, 0x5b, 0x58, 0x2d, 0x4f, 0x49, 0xbd, 0x91, 0xb9, 0xb0, 0xc5,
+	0xd0, 0x32, 0x31, 0xb5, 0xae, 0x2c, 0x10, 0x64, 0xc1, 0xab, 0x61, 0xfd, 0x92, 0xd9, 0xdd, 0xeb,
+	0x79, 0xee, 0xc8, 0xb1, 0x96, 0xdd, 0xbe, 0xeb, 0xe9, 0x4d, 0x86, 0x7f, 0x21, 0x17, 0x3f, 0x41,
+	0xbf, 0x36, 0x83, 0xf3, 0xa0, 0xd0, 0x32, 0x1c, 0x0a, 0x9b, 0x9e, 0x91, 0x97, 0x81, 0x0e, 0x99,
+	0x0e, 0x2e, 0x86, 0xa6, 0x44, 0x74, 0x7d, 0x93, 0x99, 0x64, 0x10, 0x6a, 0x12, 0xfa, 0x6c, 0x01,
+	0x08, 0x25, 0x92, 0x41, 0x68, 0x59, 0x06, 0x59, 0xb7, 0x9d, 0x3d, 0xfd, 0x70, 0x01, 0x08, 0x25,
+	0x92, 0x41, 0x68, 0x99, 0x7a, 0xda, 0xe8, 0x4b, 0x5d, 0x77, 0x8f, 0xda, 0x93, 0x3e, 0x97, 0xe9,
+	0x69, 0xa5, 0xde, 0x12, 0x84, 0xd4, 0xd3, 0x26, 0x99, 0x69, 0x08, 0x10, 0xd6, 0x2d, 0xf6, 0xed,
+	0x9e, 0xa3, 0x1f, 0x19, 0x63, 0xcb, 0x14, 0x8d, 0x51, 0xd1, 0x10, 0x40, 0x61, 0x43, 0xf7, 0xc5,
+	0xb4, 0xdc, 0x24, 0xc1, 0x8a, 0xbd, 0xaf, 0x1f, 0xcd, 0xf4, 0x22, 0x31, 0xca, 0x8a, 0xbd, 0x1f,
+	0xcd, 0x4b, 0xce, 0x22, 0x7f, 0x5a, 0xe8, 0xa3, 0xf4, 0x57, 0x0a, 0x3e, 0x2d, 0x24, 0x94, 0x3f,
+	0x2d, 0xac, 0x93, 0x3f, 0x6d, 0xdd, 0x0c, 0xc8, 0x4b, 0xfd, 0x73, 0x05, 0x9f, 0xc6, 0xa8, 0xe4,
+	0x4f, 0x63, 0x15, 0xd4, 0xbb, 0x85, 0x15, 0xcf, 0x89, 0x17, 0xd8, 0x5d, 0xb3, 0xcf, 0xbb, 0xea,
+	0x4c, 0xa6, 0x0f, 0x8a, 0xf1, 0x14, 0x6a, 0xea, 0xdd, 0x32, 0x61, 0xe4, 0x0f, 0x7f, 0x66, 0x6e,
+	0xf7, 0x09, 0x76, 0x5f, 0xe8, 0x67, 0x0b, 0x3e, 0x3c, 0x24, 0x94, 0x3f, 0x3c, 0xac, 0x93, 0xd7,
+	0x96, 0x2f, 0xd9, 0x56, 0x8f, 0x04, 0xfa, 0x85, 0x82, 0xb5, 0x85, 0x93, 0xc9, 0x6b, 0x0b, 0xaf,
+	0x91, 0xa1, 0x36, 0x0f, 0x9c, 0x2e, 0xb1, 0xf4, 0xb7, 0x0a, 0xa0, 0x38, 0x99, 0x0c, 0xc5, 0x6b,
+	0xd0, 0x3a, 0x1c, 0x89, 0x87, 0xdb, 0xec, 0x79, 0xe6, 0x40, 0xbf, 0x38, 0x66, 0xed, 0xe5, 0x56,
+	0xc2, 0xe8, 0xa8, 0xfb, 0x4e, 0xb0, 0x46, 0x4b, 0xd3, 0x8a, 0x19, 0x98, 0xfb, 0x36, 0x79, 0xf1,
+	0xdc, 0x26, 0x2f, 0x68, 0xc4, 0x71, 0x6c, 0xcc, 0xd2, 0x14, 0xd2, 0xb6, 0x05, 0x71, 0xb4, 0x34,
+	0x25, 0x40, 0xa2, 0xa5, 0x49, 0xae, 0x17, 0xfe, 0xe6, 0xf8, 0x98, 0xa5, 0x49, 0xc1, 0x8f, 0x9c,
+	0x4f, 0x1e, 0x14, 0x32, 0xe1, 0x44, 0xaa, 0xe9, 0xa9, 0x67, 0x11, 0x4f, 0x7f, 0x9d, 0x09, 0x39,
+	0x5f, 0x2c, 0x84, 0x91, 0xaf, 0xcd, 0xe0, 0x1c, 0xa0, 0x94, 0x88, 0x4d, 0x77, 0xe4, 0x75, 0x09,
+	0xed, 0xa7, 0xd3, 0x93, 0x88, 0x88, 0xc8, 0x53, 0x22, 0xa2, 0x16, 0xb4, 0x0f, 0xaf, 0x47, 0x2d,
+	0x54, 0x30, 0x73, 0xef, 0x4c, 0xba, 0xd8, 0x53, 0x9c, 0x63, 0x92, 0xda, 0xe3, 0x25, 0x25, 0xb9,
+	0xd6, 0x66, 0xf0, 0x78, 0x58, 0x74, 0x00, 0x0b, 0x0a, 0x01, 0x0f, 0x40, 0x64, 0xc1, 0xe7, 0x99,
+	0xe0, 0x2b, 0xe3, 0x05, 0xa7, 0xd8, 0xd6, 0x66, 0x70, 0x01, 0x30, 0x1a, 0xc2, 0x6b, 0x4a, 0x67,
+	0x84, 0x2b, 0x8e, 0x30, 0x91, 0x5f, 0x61, 0x72, 0x2f, 0x8f, 0x97, 0xab, 0xf2, 0xac, 0xcd, 0xe0,
+	0x71, 0x90, 0xa8, 0x07, 0x7a, 0x66, 0x33, 0x1d, 0xc9, 0x6f, 0x65, 0xc6, 0x63, 0x39, 0xe2, 0xf8,
+	0x58, 0xe6, 0x82, 0x65, 0x5a, 0xbe, 0xe8, 0xce, 0x5f, 0x9d, 0xd4, 0xf2, 0xa3, 0x7e, 0xcc, 0x83,
+	0x52, 0xc6, 0x8e, 0x36, 0x3d, 0x33, 0xbd, 0x1e, 0x09, 0x78, 0x47, 0x77, 0x2c, 0xfa, 0x51, 0xbf,
+	0x36, 0xc9, 0xd8, 0xa5, 0xd8, 0x94, 0xb1, 0xcb, 0x04, 0x46, 0x3e, 0xcc, 0x2b, 0x14, 0x1d, 0x7f,
+	0xd9, 0xed, 0xf7, 0x49, 0x37, 0xec, 0xcd, 0x5f, 0x67, 0x82, 0xdf, 0x1e, 0x2f, 0x38, 0xc1, 0xb4,
+	0x36, 0x83, 0xc7, 0x82, 0xa6, 0xbe, 0xf7, 0x69, 0xdf, 0x4a, 0xd8, 0x8c, 0x3e, 0x91, 0xad, 0x26,
+	0xd9, 0x52, 0xdf, 0x9b, 0xa2, 0x48, 0xd9, 0xaa, 0x44, 0x41, 0x3f, 0xf7, 0xd5, 0x49, 0x6c, 0x55,
+	0xe5, 0x49, 0xd9, 0xaa, 0xda, 0x4c, 0xdd, 0xee, 0xc8, 0x27, 0x1e, 0xc3, 0x78, 0xe8, 0xda, 0x8e,
+	0xfe, 0x46, 0xa6, 0xdb, 0xdd, 0xf2, 0x89, 0x27, 0x04, 0x51, 0x2a, 0xea, 0x76, 0x15, 0x36, 0x05,
+	0x67, 0x9d, 0xec, 0x04, 0xfa, 0xc9, 0x22, 0x1c, 0x4a, 0xa5, 0xe0, 0xd0, 0x0a, 0xea, 0x29, 0xa2,
+	0x8a, 0x4d, 0x42, 0x47, 0x05, 0x9b, 0x4e, 0x8f, 0xe8, 0x6f, 0x66, 0x7a, 0x0a, 0x09, 0x4e, 0x22,
+	0xa6, 0x9e, 0x22, 0x0b, 0x04, 0x6d, 0x01, 0x8a, 0xea, 0x69, 0xa8, 0xc8, 0xa1, 0x4f, 0x65, 0x66,
+	0x14, 0x24, 0xe8, 0x88, 0x94, 0x6e, 0x8e, 0xd2, 0x00, 0xe8, 0x2d, 0xd0, 0x86, 0xb6, 0xd3, 0xd3,
+	0x2d, 0x06, 0x74, 0x2c, 0x01, 0xb4, 0x61, 0x3b, 0xbd, 0xb5, 0x19, 0xcc, 0x48, 0xd0, 0x5d, 0x80,
+	0xa1, 0xe7, 0x76, 0x89, 0xef, 0x3f, 0x21, 0x2f, 0x74, 0xc2, 0x18, 0x8c, 0x24, 0x03, 0x27, 0x68,
+	0x3f, 0x21, 0x34, 0x60, 0x90, 0xe8, 0xd1, 0x2a, 0x1c, 0x16, 0x25, 0x31, 0xcb, 0x77, 0x32, 0xa3,
+	0xd2, 0x10, 0x20, 0x4e, 0x00, 0x29, 0x5c, 0x74, 0x53, 0x26, 0x2a, 0x56, 0x5c, 0x87, 0xe8, 0xbd,
+	0xcc, 0x4d, 0x59, 0x08, 0x42, 0x49, 0x68, 0xf0, 0x27, 0x71, 0xa0, 0x25, 0x38, 0x14, 0xec, 0x7a,
+	0xc4, 0xb4, 0x36, 0x03, 0x33, 0x18, 0xf9, 0xba, 0x93, 0x19, 0x3f, 0xf2, 0xc6, 0xf6, 0x33, 0x46,
+	0x49, 0x63, 0x63, 0x99, 0x07, 0x3d, 0x81, 0x16, 0xdd, 0xa1, 0xad, 0xdb, 0x03, 0x3b, 0xc0, 0xc4,
+	0xec, 0xee, 0x12, 0x4b, 0x77, 0x33, 0x23, 0x0c, 0x1a, 0x8f, 0xb7, 0x65, 0x3a, 0x1a, 0x46, 0x25,
+	0x79, 0xd1, 0x1a, 0xcc, 0xd1, 0xba, 0xcd, 0xa1, 0xd9, 0x25, 0x5b, 0xbe, 0xd9, 0x23, 0xfa, 0x30,
+	0xd3, 0x02, 0x19, 0x5a, 0x4c, 0x45, 0x43, 0x1f, 0x95, 0x2f, 0x44, 0x5a, 0x77, 0xbb, 0x66, 0x9f,
+	0x23, 0x7d, 0x23, 0x1f, 0x29, 0xa6, 0x0a, 0x91, 0xe2, 0x1a, 0x3a, 0xda, 0x5d, 0x77, 0x30, 0x20,
+	0x4e, 0x40, 0x67, 0xaf, 0x97, 0x39, 0xda, 0xcb, 0x9c, 0x40, 0xa4, 0x54, 0x24, 0x7a, 0x3a, 0xda,
+	0xa2, 0x24, 0xd2, 0x1d, 0x7e, 0xe6, 0x68, 0x87, 0x00, 0x51, 0x8a, 0x43, 0xe5, 0xa2, 0x1b, 0xce,
+	0xc0, 0xf4, 0xf7, 0xe4, 0xbd, 0x3e, 0xd5, 0x26, 0xc8, 0xdc, 0x70, 0x3e, 0x33, 0xfd, 0x3d, 0x35,
+	0x2d, 0xc0, 0xf5, 0xca, 0x82, 0xa0, 0xf1, 0x4a, 0xb2, 0x5a, 0x68, 0x3a, 0xca, 0x8c, 0x57, 0xd2,
+	0xe0, 0x91, 0xce, 0x39, 0x40, 0x4b, 0x75, 0xa8, 0xee, 0x9b, 0xfd, 0x11, 0x31, 0xbe, 0x57, 0x81,
+	0xba, 0x48, 0x6c, 0x1a, 0x4f, 0x40, 0x63, 0x69, 0xdb, 0xe3, 0x50, 0xb5, 0x1d, 0x8b, 0xbc, 0x64,
+	0x19, 0xdf, 0x2a, 0xe6, 0x05, 0xf4, 0x0e, 0xd4, 0x45, 0xbe, 0x53, 0x64, 0x2a, 0xf2, 0xf2, 0xcc,
+	0x21, 0x99, 0xf1, 0x21, 0xd4, 0xc3, 0xf4, 0xed, 0x3c, 0x34, 0x87, 0x9e, 0x4b, 0x87, 0xb1, 0x63,
+	0x31, 0xd8, 0x26, 0x8e, 0x2b, 0xd0, 0xbb, 0x50, 0xb7, 0x44, 0x82, 0x98, 0x43, 0xbf, 0xda, 0xe6,
+	0x19, 0xf5, 0x76, 0x98, 0x51, 0x6f, 0x6f, 0xb2, 0x8c, 0x3a, 0x0e, 0xe9, 0x8c, 0xdf, 0x28, 0x41,
+	0x8d, 0x67, 0x71, 0x8d, 0x7d, 0xa8, 0x89, 0x09, 0x78, 0x03, 0x6a, 0x5d, 0x56, 0xa7, 0x27, 0x33,
+	0xb8, 0x8a, 0x86, 0x22, 0x2d, 0x8c, 0x05, 0x31, 0x65, 0xf3, 0xf9, 0x84, 0x2b, 0x8f, 0x65, 0xe3,
+	0x33, 0x0c, 0x0b, 0xe2, 0x5f, 0x98, 0xdc, 0xff, 0x6c, 0x40, 0x8d, 0x3b, 0x73, 0xe3, 0xa7, 0xe5,
+	0xa8, 0x8b, 0x8d, 0x7f, 0x28, 0x41, 0x95, 0x27, 0x4b, 0xe7, 0xa0, 0x6c, 0x87, 0xbd, 0x5c, 0xb6,
+	0x2d, 0xf4, 0x40, 0xee, 0xde, 0x4a, 0x86, 0xa7, 0xcb, 0x4a, 0x1e, 0xb7, 0x1f, 0x91, 0x83, 0xe7,
+	0xd4, 0x44, 0xa2, 0x3e, 0x47, 0x27, 0xa0, 0xe6, 0x8f, 0xb6, 0x3b, 0x96, 0xaf, 0x57, 0x4e, 0x56,
+	0x2e, 0x34, 0xb1, 0x28, 0x19, 0x0f, 0xa1, 0x11, 0x12, 0xa3, 0x16, 0x54, 0xf6, 0xc8, 0x81, 0x10,
+	0x4e, 0xff, 0x45, 0x97, 0x85, 0xa9, 0x45, 0x56, 0x93, 0x1c, 0x5a, 0x2e, 0x45, 0xd8, 0xe3, 0xd7,
+	0xa0, 0x42, 0xa7, 0x40, 0xf2, 0x13, 0xa6, 0xb7, 0x90, 0x5c, 0x6d, 0x97, 0xa1, 0xca, 0x13, 0xd6,
+	0x49, 0x19, 0x08, 0xb4, 0x3d, 0x72, 0xc0, 0xfb, 0xa8, 0x89, 0xd9, 0xff, 0xb9, 0x20, 0x3f, 0xa8,
+	0xc0, 0x21, 0x79, 0x5a, 0x19, 0xab, 0x50, 0x59, 0xb4, 0xd2, 0x5d, 0xaf, 0x43, 0xdd, 0xdc, 0x09,
+	0x88, 0x17, 0x1d, 0xdd, 0x84, 0x45, 0x3a, 0xc9, 0x18, 0x16, 0xcb, 0xdd, 0x35, 0x31, 0x2f, 0x18,
+	0x6d, 0xa8, 0x89, 0xe5, 0x25, 0x89, 0x14, 0xd1, 0x97, 0x65, 0xfa, 0x87, 0xd0, 0x88, 0x72, 0xa1,
+	0x9f, 0x54, 0xb6, 0x07, 0x8d, 0x28, 0xe9, 0x79, 0x1c, 0xaa, 0x81, 0x1b, 0x98, 0x7d, 0x06, 0x57,
+	0xc1, 0xbc, 0x40, 0x67, 0xb1, 0x43, 0x5e, 0x06, 0xcb, 0xd1, 0x22, 0x50, 0xc1, 0x71, 0x05, 0x9f,
+	0xe3, 0x64, 0x9f, 0xb7, 0x56, 0x78, 0x6b, 0x54, 0x11, 0xcb, 0xd4, 0x64, 0x99, 0x07, 0x50, 0x13,
+	0x99, 0xd0, 0xa8, 0xbd, 0x24, 0xb5, 0xa3, 0x45, 0xa8, 0xf6, 0x68, 0xbb, 0x18, 0xf5, 0x4b, 0x89,
+	0x19, 0xc2, 0xe3, 0x88, 0x65, 0xd7, 0x09, 0xa8, 0x19, 0xab, 0xfb, 0x28, 0xcc, 0x39, 0xe9, 0x10,
+	0x7a, 0x7c, 0xf5, 0xa4, 0x3a, 0x35, 0xb0, 0x28, 0x19, 0x7f, 0x5e, 0x82, 0x66, 0x74, 0x0c, 0x60,
+	0x7c, 0x98, 0x37, 0x79, 0x16, 0xe1, 0xb0, 0x27, 0xa8, 0xd6, 0x6d, 0x67, 0x2f, 0x9c, 0x42, 0xaf,
+	0x25, 0x34, 0xc1, 0x12, 0x0d, 0x56, 0x39, 0x8c, 0xbb, 0xb9, 0x83, 0x7a, 0x0a, 0x0e, 0x85, 0xa4,
+	0x8f, 0x62, 0xd3, 0x53, 0xea, 0x0c, 0x23, 0xe2, 0x6e, 0x41, 0xc5, 0xb6, 0xf8, 0xc1, 0x61, 0x13,
+	0xd3, 0x7f, 0x8d, 0x1d, 0x38, 0x24, 0x67, 0x13, 0x8d, 0xe7, 0xd9, 0xb3, 0xe7, 0x7d, 0x2a, 0x46,
+	0xca, 0x5c, 0x96, 0x13, 0x91, 0x49, 0xf8, 0x09, 0x31, 0x09, 0x56, 0x18, 0x8c, 0xff, 0xb6, 0xa0,
+	0xca, 0xfa, 0xda, 0xb8, 0xc6, 0xed, 0xfc, 0x32, 0xd4, 0x58, 0xf4, 0x1b, 0x1e, 0x63, 0x1e, 0xcf,
+	0x1a, 0x18, 0x2c, 0x68, 0x8c, 0x65, 0x98, 0x95, 0x92, 0xc8, 0xd4, 0x30, 0x59, 0x43, 0x34, 0xd8,
+	0x61, 0x11, 0x19, 0xd0, 0xa0, 0x2e, 0x61, 0xc3, 0x0c, 0x76, 0x45, 0x5f, 0x44, 0x65, 0xe3, 0x0c,
+	0xd4, 0x44, 0x34, 0x6f, 0x88, 0xa4, 0x79, 0x27, 0xea, 0x8c, 0xa8, 0x6c, 0x7c, 0x15, 0x9a, 0x51,
+	0xae, 0x19, 0x3d, 0x85, 0x43, 0x22, 0xd7, 0xcc, 0x23, 0x52, 0x4a, 0x3c, 0x57, 0x60, 0x44, 0x34,
+	0xfc, 0x64, 0xe9, 0xea, 0xf6, 0xb3, 0x83, 0x21, 0xc1, 0x0a, 0x80, 0xf1, 0xc7, 0x17, 0x59, 0x07,
+	0x1b, 0x43, 0x68, 0x44, 0x09, 0xb6, 0x64, 0x67, 0xdf, 0xe2, 0x2b, 0x60, 0xb9, 0x30, 0x3b, 0xcc,
+	0xf9, 0xe9, 0x3a, 0xcb, 0x16, 0x4a, 0xe3, 0x35, 0xa8, 0x3c, 0x22, 0x07, 0x74, 0x22, 0xf0, 0xf5,
+	0x52, 0x4c, 0x04, 0xbe, 0x2e, 0x76, 0xa0, 0x26, 0x12, 0xdd, 0x49, 0x79, 0x57, 0xa0, 0xb6, 0xc3,
+	0x73, 0xe7, 0x05, 0x2b, 0xa3, 0x20, 0x33, 0xde, 0x87, 0x59, 0x39, 0xbd, 0x9d, 0xc4, 0x3b, 0x09,
+	0xb3, 0x5d, 0x29, 0x81, 0xce, 0x87, 0x41, 0xae, 0x32, 0x88, 0x6a, 0x75, 0x29, 0x84, 0xd5, 0x4c,
+	0x73, 0x7b, 0x33, 0xb3, 0xdb, 0xc7, 0x18, 0xdd, 0x23, 0x38, 0x92, 0xcc, 0x63, 0x27, 0x25, 0x5d,
+	0x80, 0x23, 0xdb, 0x89, 0xac, 0x39, 0x5f, 0xea, 0x92, 0xd5, 0x46, 0x07, 0xaa, 0x3c, 0xcf, 0x98,
+	0x84, 0x78, 0x07, 0xaa, 0x26, 0xcb, 0x63, 0x52, 0xc6, 0x39, 0x29, 0x8c, 0x94, 0xb5, 0x64, 0xac,
+	0x98, 0x13, 0x1a, 0x36, 0x1c, 0x56, 0x53, 0x97, 0x49, 0xc8, 0x35, 0x38, 0xbc, 0xaf, 0xa4, 0x48,
+	0x39, 0xf4, 0xa9, 0x4c, 0x68, 0x05, 0x0a, 0xab, 0x8c, 0xc6, 0x6f, 0xd6, 0x40, 0x63, 0xb9, 0xf7,
+	0xa4, 0x88, 0x9b, 0xa0, 0x05, 0xe4, 0x65, 0x18, 0x89, 0x9d, 0x1a, 0x9b, 0xc8, 0xe7, 0xfb, 0x2c,
+	0x46, 0x8f, 0x3e, 0x0f, 0x55, 0x3f, 0x38, 0xe8, 0x87, 0x27, 0x46, 0xa7, 0xc7, 0x33, 0x6e, 0x52,
+	0x52, 0xcc, 0x39, 0x28, 0x2b, 0x9b, 0x0b, 0xe2, 0xac, 0xa8, 0x80, 0x95, 0x4d, 0x42, 0xcc, 0x39,
+	0xd0, 0xfb, 0x50, 0xef, 0xee, 0x92, 0xee, 0x1e, 0xb1, 0xc4, 0x21, 0xd1, 0xd9, 0xf1, 0xcc, 0xcb,
+	0x9c, 0x18, 0x87, 0x5c, 0x54, 0x76, 0x97, 0x8d, 0x6e, 0x6d, 0x12, 0xd9, 0x6c, 0xc4, 0x31, 0xe7,
+	0x40, 0xab, 0xd0, 0xb4, 0xbb, 0xae, 0xb3, 0x3a, 0x70, 0xbf, 0x6e, 0x8b, 0xd3, 0xa0, 0xf3, 0xe3,
+	0xd9, 0x3b, 0x21, 0x39, 0x8e, 0x39, 0x43, 0x98, 0xce, 0x80, 0xee, 0x5b, 0x1a, 0x93, 0xc2, 0x30,
+	0x72, 0x1c, 0x73, 0x1a, 0xf3, 0x62, 0x3c, 0xb3, 0x27, 0xf9, 0x03, 0xa8, 0xb2, 0x2e, 0x47, 0xef,
+	0xc9, 0xcd, 0x73, 0x92, 0xa4, 0xdc, 0x15, 0x4b, 0x0c, 0x55, 0x84, 0xc3, 0xfa, 0x5f, 0xc5, 0x99,
+	0x9d, 0x04, 0x47, 0x8c, 0x1b, 0xc7, 0x79, 0x03, 0xea, 0x62, 0x28, 0x54, 0x85, 0x1b, 0x21, 0xc1,
+	0xeb, 0x50, 0xe5, 0x13, 0x33, 0xfb, 0x7b, 0xde, 0x84, 0x66, 0xd4, 0x99, 0xe3, 0x49, 0x58, 0xef,
+	0xe4, 0x90, 0x38, 0x50, 0xe5, 0x47, 0x10, 0xe9, 0x95, 0x56, 0x9e, 0x04, 0xa7, 0xc7, 0x9f, 0x68,
+	0x48, 0xb3, 0xa0, 0x60, 0x14, 0xbe, 0x5b, 0x82, 0xca, 0x8a, 0xbd, 0x9f, 0x12, 0x77, 0x3b, 0x9c,
+	0x3b, 0x45, 0x93, 0x6e, 0xc5, 0xde, 0x57, 0xa6, 0x8e, 0xb1, 0x1a, 0x8e, 0xeb, 0x5d, 0x75, 0x5c,
+	0xcf, 0x8d, 0x0f, 0x67, 0x62, 0x18, 0xae, 0xd8, 0xef, 0xd5, 0x40, 0x63, 0x87, 0x68, 0x59, 0xab,
+	0xc1, 0xc1, 0xb0, 0x58, 0x31, 0xb6, 0xb3, 0x66, 0x6e, 0x8d, 0xd1, 0xf3, 0xd5, 0xc0, 0x0c, 0x8a,
+	0x57, 0x03, 0xbe, 0xb9, 0xa7, 0xa4, 0x98, 0x73, 0x50, 0x91, 0x03, 0x7b, 0x40, 0xc4, 0x62, 0x50,
+	0x20, 0xf2, 0xb1, 0x3d, 0x20, 0x98, 0xd1, 0x53, 0xbe, 0x5d, 0xd3, 0xdf, 0x15, 0xeb, 0x40, 0x01,
+	0xdf, 0x9a, 0xe9, 0xef, 0x62, 0x46, 0x4f, 0xf9, 0x1c, 0x73, 0x40, 0xc4, 0x02, 0x50, 0xc0, 0xf7,
+	0xc4, 0xa4, 0xf2, 0x28, 0x3d, 0xe5, 0xf3, 0xed, 0x6f, 0x12, 0x31, 0xf3, 0x0b, 0xf8, 0x36, 0xed,
+	0x6f, 0x12, 0xcc, 0xe8, 0xe3, 0x85, 0xb2, 0x31, 0x59, 0xd7, 0x48, 0xa3, 0x3d, 0x0f, 0x1a, 0x55,
+	0x20, 0xc7, 0xba, 0x5e, 0x87, 0xea, 0x97, 0x6c, 0x2b, 0xd8, 0x55, 0x9b, 0xab, 0xca, 0x12, 0x40,
+	0x3b, 0x78, 0xaa, 0x25, 0x40, 0x1e, 0x1f, 0x8e, 0xb3, 0x02, 0x1a, 0x1d, 0xe8, 0xe9, 0x2c, 0x2e,
+	0xb6, 0x8f, 0x4f, 0xb4, 0x20, 0xc9, 0x5d, 0xc2, 0x71, 0xe6, 0x41, 0xa3, 0x63, 0x99, 0xd3, 0x25,
+	0xf3, 0xa0, 0x51, 0x0b, 0xc9, 0x6f, 0xa5, 0xe3, 0xa2, 0xb6, 0x56, 0xc2, 0xd6, 0xbf, 0xab, 0x83,
+	0xc6, 0xce, 0x84, 0x93, 0x73, 0xe2, 0x97, 0xe0, 0x70, 0xc0, 0xf2, 0xde, 0x4b, 0x22, 0xd4, 0x2c,
+	0x67, 0x5e, 0x09, 0x51, 0x4f, 0x9a, 0x45, 0x32, 0x5d, 0xb0, 0x60, 0x15, 0x61, 0x72, 0xe7, 0xc9,
+	0xa0, 0x14, 0xe7, 0x79, 0x37, 0x0a, 0xd2, 0xb4, 0x82, 0x0b, 0x09, 0x8c, 0x97, 0x87, 0x7a, 0x61,
+	0xc4, 0x86, 0x96, 0xa0, 0x41, 0x5d, 0x08, 0xed, 0x06, 0x31, 0x71, 0xce, 0x8d, 0xe7, 0xef, 0x08,
+	0x6a, 0x1c, 0xf1, 0x51, 0x07, 0xd6, 0x35, 0x3d, 0x8b, 0x69, 0x25, 0x66, 0xd1, 0xf9, 0xf1, 0x20,
+	0xcb, 0x21, 0x39, 0x8e, 0x39, 0xd1, 0x23, 0x98, 0xb5, 0x48, 0xb4, 0xed, 0x15, 0xd3, 0xea, 0xad,
+	0xf1, 0x40, 0x2b, 0x31, 0x03, 0x96, 0xb9, 0xa9, 0x4e, 0xe1, 0x56, 0xc7, 0x2f, 0x74, 0xaa, 0x0c,
+	0x2a, 0xbe, 0xb7, 0x15, 0x73, 0x1a, 0x67, 0xe1, 0xb0, 0x32, 0x6e, 0x9f, 0xaa, 0x77, 0x95, 0xc7,
+	0x92, 0xe3, 0xdc, 0x8a, 0x42, 0xf1, 0xb7, 0x55, 0xf7, 0x9a, 0x1b, 0x79, 0x0b, 0xc6, 0x75, 0x68,
+	0x84, 0x03, 0x83, 0xee, 0xab, 0x3a, 0x5c, 0x2c, 0xd6, 0x21, 0x1a, 0x53, 0x81, 0xf6, 0x04, 0x9a,
+	0xd1, 0x08, 0xd1, 0x7d, 0xb2, 0x0c, 0x77, 0xa9, 0x18, 0x2e, 0x1e, 0x5d, 0x81, 0x87, 0x61, 0x56,
+	0x1a, 0x28, 0xb4, 0xac, 0x22, 0xbe, 0x5d, 0x8c, 0x28, 0x0f, 0x73, 0xec, 0xdd, 0xa3, 0x11, 0x93,
+	0x47, 0xa5, 0x12, 0x8f, 0xca, 0xf7, 0xea, 0xd0, 0x88, 0xee, 0x61, 0x64, 0xec, 0xa5, 0x46, 0x5e,
+	0xbf, 0x70, 0x2f, 0x15, 0xf2, 0xb7, 0xb7, 0xbc, 0x3e, 0xa6, 0x1c, 0x74, 0x88, 0x03, 0x3b, 0x88,
+	0xa6, 0xea, 0xf9, 0x62, 0xd6, 0x67, 0x94, 0x1c, 0x73, 0x2e, 0xf4, 0x54, 0xb5, 0x72, 0x6d, 0xcc,
+	0x71, 0x98, 0x02, 0x92, 0x6b, 0xe9, 0x1d, 0x68, 0xda, 0x34, 0xc4, 0x59, 0x8b, 0x7d, 0xdf, 0xa5,
+	0x62, 0xb8, 0x4e, 0xc8, 0x82, 0x63, 0x6e, 0xaa, 0xdb, 0x8e, 0xb9, 0x4f, 0xe7, 0x35, 0x03, 0xab,
+	0x4d, 0xaa, 0xdb, 0x83, 0x98, 0x09, 0xcb, 0x08, 0xe8, 0x8e, 0x88, 0x1e, 0xea, 0x05, 0x2b, 0x4b,
+	0xdc, 0x55, 0x71, 0x04, 0xf1, 0x01, 0xcc, 0x05, 0xca, 0xe9, 0xa2, 0x98, 0xc6, 0xef, 0x4c, 0x80,
+	0xa2, 0xf0, 0xe1, 0x04, 0x0e, 0x1d, 0x41, 0x1e, 0x9b, 0x34, 0x27, 0x1d, 0x41, 0x39, 0x3e, 0xa1,
+	0x9b, 0xe9, 0x2d, 0xaf, 0x9f, 0xef, 0x83, 0xd9, 0x70, 0xe7, 0x34, 0x9f, 0x56, 0x67, 0x42, 0x7e,
+	0xe0, 0x1a, 0x8d, 0x49, 0x2e, 0x8e, 0xd4, 0xe9, 0x39, 0x44, 0xef, 0x09, 0x47, 0x7d, 0x43, 0x9d,
+	0x6f, 0x6f, 0x24, 0xe6, 0x1b, 0x9d, 0x61, 0x1b, 0x1e, 0xe1, 0x27, 0xbe, 0x92, 0x87, 0x3e, 0x07,
+	0x73, 0x6a, 0x47, 0xe6, 0x88, 0x79, 0x18, 0xc6, 0x15, 0x53, 0xad, 0x14, 0xc9, 0xbe, 0xe5, 0x58,
+	0xdf, 0x2e, 0x41, 0x23, 0xba, 0x66, 0x93, 0x4e, 0x36, 0x37, 0x6c, 0x7f, 0x8d, 0x98, 0x16, 0xf1,
+	0xc4, 0xbc, 0xbd, 0x58, 0x78, 0x7f, 0xa7, 0xdd, 0x11, 0x1c, 0x38, 0xe2, 0x35, 0x4e, 0x42, 0x23,
+	0xac, 0xcd, 0xd9, 0x7c, 0xfc, 0xb8, 0x0c, 0x35, 0x71, 0x41, 0x27, 0xa9, 0xc4, 0x3d, 0xa8, 0xf5,
+	0xcd, 0x03, 0x77, 0x14, 0xee, 0x0d, 0xce, 0x15, 0xdc, 0xf9, 0x69, 0xaf, 0x33, 0x6a, 0x2c, 0xb8,
+	0xd0, 0x17, 0xa0, 0xda, 0xb7, 0x07, 0x76, 0x20, 0x96, 0x8f, 0xb3, 0x85, 0xec, 0xec, 0xc4, 0x8c,
+	0xf3, 0x50, 0xe1, 0xec, 0xf8, 0x3b, 0xbc, 0x55, 0x59, 0x28, 0xfc, 0x39, 0xa3, 0xc6, 0x82, 0xcb,
+	0x78, 0x08, 0x35, 0xae, 0xce, 0x74, 0x4e, 0x42, 0xfd, 0x92, 0xd8, 0xd2, 0x99, 0x6e, 0x39, 0xd1,
+	0xe6, 0x02, 0xd4, 0xb8, 0xf0, 0x1c, 0xab, 0xf9, 0x83, 0x32, 0xd4, 0xc4, 0xc5, 0xa5, 0x64, 0x17,
+	0x3f, 0x4f, 0xcd, 0xfc, 0xf2, 0x98, 0x2b, 0x2e, 0xf1, 0x9d, 0xa8, 0xa2, 0x79, 0xbf, 0x99, 0x8c,
+	0xdb, 0x2a, 0x05, 0x0b, 0x9c, 0x02, 0x9b, 0x1d, 0xb9, 0x4d, 0x3c, 0x4b, 0x26, 0x8c, 0x24, 0x7e,
+	0xbf, 0x0c, 0xf5, 0xf0, 0x0a, 0x56, 0x3a, 0xd7, 0x5a, 0xf3, 0xd9, 0xb5, 0x20, 0xd1, 0x1f, 0xe7,
+	0x8b, 0xee, 0x75, 0x89, 0xfb, 0x45, 0x58, 0xb0, 0xa1, 0x55, 0x68, 0xf4, 0x4d, 0xa7, 0x37, 0x32,
+	0x7b, 0xa1, 0xf7, 0x7a, 0xab, 0x10, 0x62, 0x5d, 0x30, 0xe0, 0x88, 0x95, 0x0e, 0x2d, 0x07, 0xce,
+	0xf9, 0x86, 0xa7, 0xd0, 0x08, 0xb9, 0xa6, 0xf3, 0xf5, 0x29, 0x99, 0x02, 0xf0, 0x47, 0x9f, 0x63,
+	0xbb, 0xd3, 0xbe, 0xb1, 0x1e, 0x1f, 0xfb, 0x7d, 0xf2, 0x63, 0x1c, 0xe3, 0x19, 0x1c, 0x59, 0x31,
+	0x03, 0x73, 0xdb, 0xf4, 0x09, 0x26, 0x5d, 0xd7, 0xb3, 0x32, 0x51, 0x3d, 0xde, 0x24, 0x92, 0xf3,
+	0xf9, 0xa8, 0x82, 0xee, 0xb3, 0x74, 0xea, 0xff, 0x9e, 0x74, 0xea, 0xf7, 0xb5, 0x9c, 0x1c, 0xe7,
+	0x24, 0xe9, 0x1d, 0x6a, 0x70, 0xa9, 0x24, 0xe7, 0x1d, 0x75, 0x9f, 0x76, 0xa6, 0x80, 0x53, 0xd9,
+	0xa8, 0xdd, 0x51, 0xb3, 0x9c, 0x45, 0xbc, 0x4a, 0x9a, 0xf3, 0x7e, 0x32, 0xcd, 0x79, 0xae, 0x80,
+	0x3b, 0x95, 0xe7, 0xbc, 0xa3, 0xe6, 0x39, 0x8b, 0xa4, 0xcb, 0x89, 0xce, 0xff, 0x67, 0xa9, 0xc5,
+	0x3f, 0xcc, 0x49, 0xd2, 0x7d, 0x5e, 0x4d, 0xd2, 0x8d, 0xb1, 0x9a, 0x9f, 0x57, 0x96, 0xee, 0x8f,
+	0xf2, 0xb2, 0x74, 0xb7, 0x94, 0x2c, 0xdd, 0x18, 0xcd, 0x92, 0x69, 0xba, 0x3b, 0x6a, 0x9a, 0xee,
+	0x4c, 0x01, 0xa7, 0x92, 0xa7, 0xbb, 0xa5, 0xe4, 0xe9, 0x8a, 0x84, 0x4a, 0x89, 0xba, 0x5b, 0x4a,
+	0xa2, 0xae, 0x88, 0x51, 0xca, 0xd4, 0xdd, 0x52, 0x32, 0x75, 0x45, 0x8c, 0x52, 0xaa, 0xee, 0x96,
+	0x92, 0xaa, 0x2b, 0x62, 0x94, 0x72, 0x75, 0x77, 0xd4, 0x5c, 0x5d, 0x71, 0xff, 0x7c, 0x96, 0xac,
+	0xfb, 0xc5, 0x24, 0xeb, 0x7e, 0xb7, 0x92, 0x93, 0xac, 0xc3, 0xd9, 0xc9, 0xba, 0xcb, 0xf9, 0x23,
+	0x59, 0x9c, 0xad, 0x9b, 0xdc, 0x0b, 0xa4, 0xd3, 0x75, 0xef, 0x25, 0xd2, 0x75, 0x67, 0x0b, 0x98,
+	0xd5, 0x7c, 0xdd, 0xff, 0x99, 0x84, 0xd4, 0x5f, 0xd6, 0xc6, 0xe4, 0x5e, 0x6e, 0xcb, 0xb9, 0x97,
+	0x31, 0x9e, 0x2c, 0x9d, 0x7c, 0xb9, 0xa7, 0x26, 0x5f, 0x2e, 0x4c, 0xc0, 0xab, 0x64, 0x5f, 0x36,
+	0xb2, 0xb2, 0x2f, 0xed, 0x09, 0x50, 0x72, 0xd3, 0x2f, 0x0f, 0xd3, 0xe9, 0x97, 0xcb, 0x13, 0xe0,
+	0x65, 0xe6, 0x5f, 0x36, 0xb2, 0xf2, 0x2f, 0x93, 0x68, 0x97, 0x9b, 0x80, 0xf9, 0x82, 0x92, 0x80,
+	0x39, 0x3f, 0x49, 0x77, 0xc5, 0xce, 0xe1, 0xcb, 0x39, 0x19, 0x98, 0x77, 0x27, 0x81, 0x19, 0xbb,
+	0x15, 0xfb, 0x2c, 0x87, 0x92, 0x10, 0xf3, 0xd3, 0x05, 0x68, 0x84, 0x77, 0x8c, 0x8c, 0x6f, 0x40,
+	0x3d, 0x7c, 0x28, 0x93, 0x9c, 0x39, 0x27, 0xa2, 0x04, 0x00, 0x8f, 0x9e, 0x45, 0x09, 0xdd, 0x03,
+	0x8d, 0xfe, 0x27, 0xa6, 0xc5, 0xc5, 0xc9, 0xee, 0x32, 0x51, 0x21, 0x98, 0xf1, 0x19, 0x7f, 0x7f,
+	0x1c, 0x40, 0x7a, 0x3f, 0x30, 0xa9, 0xd8, 0x2f, 0xd2, 0xc5, 0xac, 0x1f, 0x10, 0x8f, 0xdd, 0x61,
+	0x2b, 0xbc, 0x5f, 0x1f, 0x4b, 0xa0, 0xd6, 0x12, 0x10, 0x0f, 0x0b, 0x76, 0xf4, 0x18, 0x1a, 0x61,
+	0xd2, 0x5d, 0xd7, 0x18, 0xd4, 0xbb, 0x13, 0x43, 0x85, 0x69, 0x60, 0x1c, 0x41, 0xa0, 0x45, 0xd0,
+	0x7c, 0xd7, 0x0b, 0xf4, 0x2a, 0x83, 0x7a, 0x7b, 0x62, 0xa8, 0x4d, 0xd7, 0x0b, 0x30, 0x63, 0xe5,
+	0x9f, 0x26, 0xbd, 0x1b, 0x9d, 0xe6, 0xd3, 0x94, 0x15, 0xfb, 0x07, 0x95, 0x68, 0x0d, 0x5d, 0x16,
+	0xb3, 0x91, 0xdb, 0xd0, 0x95, 0xc9, 0x47, 0x49, 0x9e, 0x95, 0x48, 0x04, 0x41, 0x7c, 0x24, 0x78,
+	0x7c, 0x73, 0x11, 0x5a, 0x5d, 0x77, 0x9f, 0x78, 0x38, 0xbe, 0xdd, 0x25, 0x2e, 0xe0, 0xa5, 0xea,
+	0x91, 0x01, 0x8d, 0x5d, 0xdb, 0x22, 0x9d, 0xae, 0x58, 0xff, 0x1a, 0x38, 0x2a, 0xa3, 0x47, 0xd0,
+	0x60, 0xe7, 0x31, 0xe1, 0x69, 0xd0, 0x74, 0x4a, 0xf2, 0x63, 0xa1, 0x10, 0x80, 0x0a, 0x62, 0xc2,
+	0x1f, 0xd8, 0x01, 0xeb, 0xc3, 0x06, 0x8e, 0xca, 0x54, 0x61, 0x76, 0x85, 0x4e, 0x56, 0xb8, 0xce,
+	0x15, 0x4e, 0xd6, 0xa3, 0xeb, 0xf0, 0x0a, 0xab, 0x4b, 0x6c, 0x31, 0xf9, 0xb1, 0x4e, 0x03, 0x67,
+	0x37, 0xb2, 0x2b, 0x83, 0x66, 0x8f, 0x5f, 0x38, 0x67, 0x89, 0xde, 0x2a, 0x8e, 0x2b, 0xd0, 0x65,
+	0x38, 0x6a, 0x91, 0x1d, 0x73, 0xd4, 0x0f, 0x9e, 0x91, 0xc1, 0xb0, 0x6f, 0x06, 0xa4, 0x63, 0xb1,
+	0xa7, 0xab, 0x4d, 0x9c, 0x6e, 0x30, 0x7e, 0xa4, 0xd1, 0x21, 0x64, 0x86, 0xfa, 0x45, 0xa8, 0x98,
+	0x96, 0x25, 0x9c, 0xe0, 0xb5, 0x29, 0xcd, 0x5d, 0xbc, 0xb5, 0xa6, 0x08, 0x68, 0x23, 0xba, 0x3b,
+	0xc8, 0xdd, 0xe0, 0xcd, 0x69, 0xb1, 0xa2, 0x8b, 0xd8, 0x02, 0x87, 0x22, 0x8e, 0xf8, 0x1b, 0x83,
+	0xca, 0xcf, 0x86, 0x18, 0x3d, 0x3e, 0x10, 0x38, 0xe8, 0x21, 0x68, 0x4c, 0x43, 0xee, 0x26, 0xaf,
+	0x4f, 0x8b, 0xf7, 0x98, 0xeb, 0xc7, 0x30, 0x8c, 0x2e, 0xbf, 0xdd, 0x27, 0xdd, 0x1c, 0x2d, 0xa9,
+	0x37, 0x47, 0x97, 0xa0, 0x6a, 0x07, 0x64, 0x90, 0xbe, 0x48, 0x3c, 0xd6, 0xf0, 0xc4, 0x3a, 0xc2,
+	0x59, 0xc7, 0x5e, 0x68, 0xfc, 0x30, 0xba, 0x53, 0x9d, 0x5c, 0xdd, 0xee, 0x83, 0x46, 0xd9, 0x53,
+	0x91, 0xe1, 0x24, 0x82, 0x19, 0xa7, 0x71, 0x15, 0x34, 0xfa, 0xb1, 0x63, 0xbe, 0x4e, 0xe8, 0x53,
+	0x8e, 0xf4, 0x59, 0x9a, 0x85, 0xa6, 0x3b, 0x24, 0x1e, 0x33, 0x73, 0xe3, 0x27, 0x9a, 0x74, 0xed,
+	0xaf, 0x23, 0xdb, 0xd8, 0x8d, 0xa9, 0xd7, 0x41, 0xd9, 0xca, 0x70, 0xc2, 0xca, 0x6e, 0x4f, 0x8f,
+	0x96, 0xb2, 0x33, 0x9c, 0xb0, 0xb3, 0x9f, 0x01, 0x33, 0x65, 0x69, 0xeb, 0x8a, 0xa5, 0xdd, 0x9c,
+	0x1e, 0x51, 0xb1, 0x35, 0x52, 0x64, 0x6b, 0x2b, 0xaa, 0xad, 0xb5, 0x27, 0x1b, 0xf2, 0xc8, 0xd1,
+	0x4c, 0x60, 0x6d, 0x5f, 0xcd, 0xb5, 0xb6, 0x25, 0xc5, 0xda, 0xa6, 0x15, 0xfd, 0x29, 0xd9, 0xdb,
+	0xbf, 0x69, 0xa0, 0x51, 0x67, 0x87, 0x56, 0x65, 0x5b, 0x7b, 0x77, 0x2a, 0x47, 0x29, 0xdb, 0xd9,
+	0x93, 0x84, 0x9d, 0x5d, 0x9f, 0x0e, 0x29, 0x65, 0x63, 0x4f, 0x12, 0x36, 0x36, 0x25, 0x5e, 0xca,
+	0xbe, 0xd6, 0x14, 0xfb, 0xba, 0x3a, 0x1d, 0x9a, 0x62, 0x5b, 0x66, 0x91, 0x6d, 0xdd, 0x57, 0x6d,
+	0x6b, 0xc2, 0x58, 0x8c, 0x45, 0x1e, 0x13, 0xd8, 0xd5, 0x07, 0xb9, 0x76, 0x75, 0x4f, 0xb1, 0xab,
+	0x69, 0xc4, 0x7e, 0x4a, 0x36, 0x75, 0x9d, 0x87, 0x90, 0xe2, 0x26, 0xf5, 0x84, 0x21, 0xa4, 0x71,
+	0x03, 0x9a, 0xf1, 0x73, 0xea, 0x8c, 0x77, 0x06, 0x9c, 0x2c, 0x94, 0x1a, 0x16, 0x8d, 0x6b, 0xd0,
+	0x8c, 0x9f, 0x48, 0x67, 0xc8, 0x8a, 0x0e, 0x4a, 0xf8, 0xd3, 0x0a, 0x56, 0x32, 0x56, 0xe1, 0x68,
+	0xfa, 0x01, 0x67, 0x46, 0x56, 0x5d, 0xba, 0x24, 0x2f, 0xb4, 0x95, 0xab, 0x8c, 0x17, 0x30, 0x97,
+	0x78, 0x92, 0x39, 0x35, 0x06, 0xba, 0x26, 0x05, 0xbc, 0x15, 0xb1, 0xa3, 0xce, 0xbe, 0xf6, 0x1f,
+	0x87, 0xb5, 0xc6, 0x0a, 0xcc, 0x15, 0x28, 0x3f, 0xc9, 0xad, 0xff, 0xaf, 0xc1, 0xec, 0x38, 0xdd,
+	0x3f, 0x85, 0x57, 0x09, 0x01, 0xb4, 0x52, 0xcf, 0xc9, 0x93, 0x62, 0x36, 0x00, 0x7a, 0x11, 0x8d,
+	0x30, 0xda, 0x77, 0xa6, 0x78, 0x83, 0xc1, 0xf8, 0xb0, 0x84, 0x61, 0xfc, 0x59, 0x09, 0x8e, 0xa6,
+	0xdf, 0x92, 0x4f, 0xba, 0x95, 0xd1, 0xa1, 0xce, 0xb0, 0xa2, 0xa7, 0x2b, 0x61, 0x11, 0x3d, 0x86,
+	0x43, 0x7e, 0xdf, 0xee, 0x92, 0xe5, 0x5d, 0xd3, 0xe9, 0x11, 0x5f, 0xec, 0x4f, 0x0a, 0xde, 0x83,
+	0x6f, 0xc6, 0x1c, 0x58, 0x61, 0x37, 0x5e, 0xc0, 0xac, 0xd4, 0x88, 0xee, 0x42, 0xd9, 0x1d, 0x8a,
+	0x1d, 0xc1, 0xe5, 0x09, 0x30, 0x9f, 0x86, 0xf3, 0x0d, 0x97, 0xdd, 0x61, 0x7a, 0x4a, 0xca, 0xd3,
+	0xb7, 0xa2, 0x4c, 0x5f, 0xe3, 0x11, 0x1c, 0x4d, 0x3f, 0xd7, 0x4e, 0x76, 0xcf, 0xb9, 0xcc, 0xb3,
+	0xd7, 0x66, 0x6a, 0x03, 0x7f, 0x0b, 0x8e, 0x24, 0x1f, 0x61, 0x67, 0x3c, 0x2b, 0x8a, 0x5f, 0x67,
+	0x85, 0xc9, 0xf7, 0x53, 0xbf, 0x53, 0x82, 0x39, 0xf5, 0x43, 0xd0, 0x09, 0x40, 0x6a, 0xcd, 0x13,
+	0xd7, 0x21, 0xad, 0x19, 0xf4, 0x0a, 0x1c, 0x55, 0xeb, 0x17, 0x2d, 0xab, 0x55, 0x4a, 0x93, 0xd3,
+	0x65, 0xab, 0x55, 0x46, 0x3a, 0x1c, 0x4f, 0xf4, 0x10, 0x5b, 0x44, 0x5b, 0x15, 0xf4, 0x39, 0x78,
+	0x25, 0xd9, 0x32, 0xec, 0x9b, 0x5d, 0xd2, 0xd2, 0x8c, 0xff, 0x2a, 0x83, 0xb6, 0xe5, 0x13, 0xcf,
+	0xf8, 0x8f, 0x72, 0xf8, 0x0e, 0xe5, 0x36, 0x68, 0xec, 0x7d, 0xb4, 0xf4, 0x2a, 0xb1, 0x94, 0x78,
+	0x95, 0xa8, 0xfc, 0x6a, 0x5b, 0xfc, 0x2a, 0xf1, 0x36, 0x68, 0xec, 0x45, 0xf4, 0xf4, 0x9c, 0xbf,
+	0x55, 0x82, 0x66, 0xfc, 0x3a, 0x79, 0x6a, 0x7e, 0xf9, 0xdd, 0x4b, 0x59, 0x7d, 0xf7, 0x72, 0x11,
+	0xaa, 0x1e, 0x7b, 0xa1, 0xc2, 0x57, 0x99, 0xe4, 0x6b, 0x1a, 0x26, 0x10, 0x73, 0x12, 0x83, 0xc0,
+	0xac, 0xfc, 0xf6, 0x7a, 0x7a, 0x35, 0xce, 0x88, 0x5f, 0x84, 0xe9, 0x58, 0xfe, 0xa2, 0xe7, 0x99,
+	0x07, 0xc2, 0x30, 0xd5, 0x4a, 0x63, 0x1e, 0xb4, 0x0d, 0xdb, 0xe9, 0x65, 0x3f, 0x06, 0x35, 0xfe,
+	0xb6, 0x04, 0x75, 0xf1, 0x92, 0xd9, 0xb8, 0x05, 0x95, 0x27, 0xe4, 0x05, 0x55, 0x44, 0xbc, 0x65,
+	0x4e, 0x29, 0xf2, 0x98, 0x7d, 0x85, 0xa0, 0xc7, 0x21, 0x99, 0x71, 0x27, 0x72, 0x93, 0xd3, 0xf3,
+	0xde, 0x06, 0x8d, 0x3d, 0x99, 0x9e, 0x9e, 0xf3, 0x4f, 0x1b, 0x50, 0xe3, 0x2f, 0x2a, 0x8d, 0xef,
+	0x36, 0xa0, 0xc6, 0x9f, 0x51, 0xa3, 0x7b, 0x50, 0xf7, 0x47, 0x83, 0x81, 0xe9, 0x1d, 0xe8, 0xd9,
+	0x3f, 0x29, 0xa8, 0xbc, 0xba, 0x6e, 0x6f, 0x72, 0x5a, 0x1c, 0x32, 0xa1, 0x1b, 0xa0, 0x75, 0xcd,
+	0x1d, 0x92, 0x3a, 0x9c, 0xcd, 0x62, 0x5e, 0x36, 0x77, 0x08, 0x66, 0xe4, 0xe8, 0x3e, 0x34, 0xc4,
+	0xb0, 0xf8, 0x22, 0x3b, 0x33, 0x5e, 0x6e, 0x38, 0x98, 0x11, 0x97, 0xf1, 0x10, 0xea, 0x42, 0x19,
+	0x76, 0xf5, 0x80, 0xbf, 0x27, 0x4d, 0xe6, 0x91, 0x33, 0x3f, 0xe1, 0xc0, 0xe9, 0x26, 0x5e, 0x96,
+	0xfe, 0x63, 0x19, 0x34, 0xaa, 0xdc, 0x27, 0x46, 0x42, 0x0b, 0x00, 0x7d, 0xd3, 0x0f, 0x36, 0x46,
+	0xfd, 0x3e, 0xb1, 0xc4, 0x53, 0x41, 0xa9, 0x06, 0x5d, 0x80, 0x23, 0xbc, 0xe4, 0xef, 0x6e, 0x8e,
+	0xba, 0x5d, 0x42, 0x2c, 0xf1, 0x3a, 0x2f, 0x59, 0x8d, 0x16, 0xa1, 0xca, 0x7e, 0x71, 0x4c, 0x44,
+	0x85, 0x97, 0x0a, 0x7b, 0xb6, 0xbd, 0x61, 0x3b, 0x42, 0x1b, 0xce, 0x69, 0xb8, 0xd0, 0x8c, 0xea,
+	0xe8, 0x24, 0x1c, 0xda, 0x8e, 0x63, 0x3b, 0x3d, 0x61, 0xd1, 0x61, 0x91, 0x3a, 0x1d, 0xfa, 0xaf,
+	0xd0, 0xb7, 0x8a, 0x45, 0x89, 0xd6, 0xef, 0x98, 0x76, 0x5f, 0xa8, 0x58, 0xc5, 0xa2, 0x44, 0x91,
+	0x78, 0xe0, 0xca, 0x2f, 0xfa, 0x54, 0x70, 0x58, 0x34, 0x3e, 0x2a, 0x45, 0x8f, 0xaa, 0xb3, 0x5e,
+	0x99, 0xa6, 0x32, 0x43, 0xf3, 0x72, 0x7a, 0x9a, 0x3b, 0x04, 0x29, 0xe1, 0x7c, 0x02, 0x6a, 0xae,
+	0xd3, 0xb7, 0x1d, 0x22, 0x32, 0x41, 0xa2, 0x94, 0xe8, 0xe3, 0x6a, 0xaa, 0x8f, 0x45, 0xfb, 0xaa,
+	0x65, 0x53, 0x15, 0x6b, 0x71, 0x3b, 0xaf, 0x41, 0xef, 0x41, 0xdd, 0x22, 0xfb, 0x76, 0x97, 0xf8,
+	0x7a, 0x9d, 0x99, 0xde, 0xe9, 0xb1, 0x7d, 0xbb, 0xc2, 0x68, 0x71, 0xc8, 0x63, 0x04, 0x50, 0xe3,
+	0x55, 0xd1, 0x27, 0x95, 0xa4, 0x4f, 0x8a, 0x95, 0x2e, 0x8f, 0x51, 0xba, 0x52, 0xa0, 0xb4, 0x96,
+	0x54, 0xfa, 0x94, 0x05, 0x10, 0x9b, 0x1b, 0x9a, 0x85, 0xfa, 0x96, 0xb3, 0xe7, 0xb8, 0x2f, 0x9c,
+	0xd6, 0x0c, 0x2d, 0x3c, 0xdd, 0xd9, 0xa1, 0x52, 0x5a, 0x25, 0x5a, 0xa0, 0x74, 0xb6, 0xd3, 0x6b,
+	0x95, 0x11, 0x84, 0xb7, 0x98, 0x5a, 0x15, 0xfa, 0xff, 0x03, 0x36, 0x7e, 0x2d, 0x0d, 0xbd, 0x0a,
+	0xc7, 0x3a, 0x4e, 0xd7, 0x1d, 0x0c, 0xcd, 0xc0, 0xde, 0xee, 0x93, 0xe7, 0xc4, 0xf3, 0x6d, 0xd7,
+	0x69, 0x55, 0x8d, 0xbf, 0x2a, 0xf1, 0x33, 0x5c, 0xe3, 0x3e, 0x1c, 0x52, 0x7e, 0x0d, 0x41, 0x87,
+	0xba, 0x3f, 0xe4, 0x3f, 0x9c, 0x2a, 0xe2, 0x6e, 0x51, 0x64, 0x56, 0xc2, 0x9f, 0xb7, 0x8b, 0x90,
+	0x85, 0x97, 0x8c, 0xcb, 0x00, 0xd2, 0x6f, 0x20, 0x2c, 0x00, 0x6c, 0x1f, 0x04, 0xc4, 0xe7, 0xbf,
+	0x7f, 0x40, 0x21, 0x34, 0x2c, 0xd5, 0x18, 0x37, 0x01, 0xa4, 0xdf, 0x39, 0xa0, 0xb3, 0x84, 0x96,
+	0x96, 0x92, 0x2c, 0xc9, 0x6a, 0xe3, 0xdb, 0x25, 0xa8, 0x8b, 0x1f, 0x2c, 0xa0, 0xeb, 0x31, 0xf5,
+	0xf4, 0xef, 0x40, 0x5d, 0xfc, 0x60, 0x41, 0x6a, 0x65, 0xe4, 0x5e, 0x45, 0xd0, 0xe3, 0x90, 0xcc,
+	0xb8, 0x9f, 0xfb, 0x4e, 0x75, 0xd2, 0x80, 0xe3, 0xfb, 0x25, 0xd0, 0x9e, 0x99, 0xfe, 0x9e, 0xf1,
+	0x17, 0xa5, 0xc4, 0xfb, 0xe8, 0x15, 0xae, 0x54, 0xf6, 0x2b, 0xdf, 0xf3, 0xa0, 0x05, 0xa6, 0xbf,
+	0x27, 0x16, 0xcf, 0x63, 0x09, 0x3d, 0x29, 0x20, 0x66, 0x04, 0xc6, 0xb3, 0x48, 0xc3, 0x6c, 0x20,
+	0x03, 0x1a, 0xae, 0xaa, 0x61, 0x54, 0x96, 0xbd, 0x6f, 0x45, 0xf1, 0xbe, 0xa7, 0xbe, 0x05, 0x87,
+	0x31, 0xf1, 0x87, 0xae, 0xe3, 0x93, 0x9f, 0xd7, 0xcf, 0xf4, 0xe6, 0xfe, 0xe0, 0xee, 0xa9, 0xef,
+	0x68, 0x50, 0x65, 0x9e, 0xca, 0xf8, 0x6d, 0x2d, 0xf2, 0xa9, 0x19, 0xb7, 0x92, 0xe2, 0xbb, 0x03,
+	0x73, 0x52, 0x98, 0xaf, 0xf8, 0x38, 0x39, 0x01, 0x7d, 0x55, 0xbe, 0x33, 0x30, 0x27, 0xfd, 0x86,
+	0x88, 0xca, 0xa1, 0xdc, 0x15, 0xf8, 0x02, 0x34, 0x86, 0x9e, 0xdb, 0xf3, 0xa8, 0x33, 0xd5, 0x12,
+	0x3f, 0x70, 0xa6, 0xb2, 0x6d, 0x08, 0x32, 0x1c, 0x31, 0x18, 0x4f, 0xa0, 0x11, 0xd6, 0xe6, 0xbc,
+	0x1e, 0x47, 0xa0, 0x59, 0xae, 0x58, 0x10, 0x2a, 0x98, 0xfd, 0x4f, 0xfb, 0x45, 0xf4, 0x60, 0x38,
+	0x28, 0xa2, 0x78, 0xea, 0x6f, 0x4a, 0xe2, 0x50, 0xe7, 0x30, 0x34, 0x57, 0x3c, 0x77, 0xc8, 0x1e,
+	0x10, 0xb7, 0x66, 0xe8, 0xfc, 0xed, 0x0c, 0x86, 0xae, 0x17, 0xb4, 0x4a, 0xf4, 0xff, 0xd5, 0x97,
+	0xec, 0xff, 0x32, 0x3a, 0x04, 0x8d, 0x4d, 0x73, 0x9f, 0x50, 0xb2, 0x56, 0x05, 0x21, 0xba, 0x09,
+	0x63, 0x89, 0x6c, 0xb1, 0x0e, 0xb7, 0x34, 0x0a, 0xf4, 0xd8, 0xee, 0xf1, 0xd8, 0xb2, 0x55, 0x45,
+	0xf3, 0xa0, 0x87, 0xdb, 0xa3, 0x07, 0xae, 0x37, 0x30, 0x83, 0x65, 0xd7, 0xd9, 0x17, 0x2b, 0x40,
+	0x0d, 0x1d, 0x83, 0x23, 0x8f, 0x89, 0xd7, 0x23, 0x2b, 0xa3, 0x61, 0xdf, 0xee, 0x9a, 0x01, 0xf1,
+	0x5b, 0x75, 0x74, 0x04, 0x66, 0x1f, 0xd8, 0x8e, 0x15, 0x06, 0xa6, 0x0d, 0x74, 0x14, 0x0e, 0x3f,
+	0x76, 0x2d, 0x7b, 0xe7, 0x40, 0x5c, 0x78, 0x6b, 0x35, 0x4f, 0x2d, 0x86, 0x77, 0x02, 0x1a, 0xa0,
+	0x89, 0x10, 0x79, 0x16, 0xea, 0x78, 0xc4, 0x7c, 0x4c, 0xab, 0x44, 0xab, 0x69, 0xe0, 0xc2, 0x35,
+	0x5e, 0x36, 0x9d, 0x2e, 0xe9, 0xb3, 0x75, 0xa9, 0x09, 0xd5, 0x55, 0xcf, 0x73, 0xbd, 0x96, 0xb6,
+	0x34, 0xff, 0x4f, 0x1f, 0x2d, 0x94, 0x7e, 0xf8, 0xd1, 0x42, 0xe9, 0xc7, 0x1f, 0x2d, 0x94, 0xbe,
+	0xf3, 0xf1, 0xc2, 0xcc, 0x0f, 0x3f, 0x5e, 0x98, 0xf9, 0xf7, 0x8f, 0x17, 0x66, 0x3e, 0x2c, 0x0f,
+	0xb7, 0xb7, 0x6b, 0xec, 0x30, 0xf7, 0xda, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x81, 0xcc, 0xec,
+	0x45, 0xbc, 0x5a, 0x00, 0x00,
 }
 
 func (m *Event) Marshal() (dAtA []byte, err error) {
@@ -11638,6 +12380,52 @@ func (m *EventMessageValueOfBlockSetWidget) MarshalToSizedBuffer(dAtA []byte) (i
 	}
 	return len(dAtA) - i, nil
 }
+func (m *EventMessageValueOfBlockSetSynced) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessageValueOfBlockSetSynced) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.BlockSetSynced != nil {
+		{
+			size, err := m.BlockSetSynced.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xca
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventMessageValueOfBlockSetDiagram) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessageValueOfBlockSetDiagram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.BlockSetDiagram != nil {
+		{
+			size, err := m.BlockSetDiagram.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd2
+	}
+	return len(dAtA) - i, nil
+}
 func (m *EventMessageValueOfObjectDetailsAmend) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
@@ -12075,6 +12863,98 @@ func (m *EventMessageValueOfFileLocalUsage) MarshalToSizedBuffer(dAtA []byte) (i
 	}
 	return len(dAtA) - i, nil
 }
+func (m *EventMessageValueOfCommentSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessageValueOfCommentSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CommentSet != nil {
+		{
+			size, err := m.CommentSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0x92
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventMessageValueOfCommentRemove) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessageValueOfCommentRemove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CommentRemove != nil {
+		{
+			size, err := m.CommentRemove.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0x9a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventMessageValueOfTaskSubscriptionSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessageValueOfTaskSubscriptionSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.TaskSubscriptionSet != nil {
+		{
+			size, err := m.TaskSubscriptionSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0xa2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventMessageValueOfTaskSubscriptionRemove) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessageValueOfTaskSubscriptionRemove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.TaskSubscriptionRemove != nil {
+		{
+			size, err := m.TaskSubscriptionRemove.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0xaa
+	}
+	return len(dAtA) - i, nil
+}
 func (m *EventMessageValueOfBlockDataviewRelationSet) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
@@ -13321,20 +14201,20 @@ func (m *EventBlockMarksInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if len(m.MarksInRange) > 0 {
-		dAtA73 := make([]byte, len(m.MarksInRange)*10)
-		var j72 int
+		dAtA79 := make([]byte, len(m.MarksInRange)*10)
+		var j78 int
 		for _, num := range m.MarksInRange {
 			for num >= 1<<7 {
-				dAtA73[j72] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA79[j78] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j72++
+				j78++
 			}
-			dAtA73[j72] = uint8(num)
-			j72++
+			dAtA79[j78] = uint8(num)
+			j78++
 		}
-		i -= j72
-		copy(dAtA[i:], dAtA73[:j72])
-		i = encodeVarintEvents(dAtA, i, uint64(j72))
+		i -= j78
+		copy(dAtA[i:], dAtA79[:j78])
+		i = encodeVarintEvents(dAtA, i, uint64(j78))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -15394,6 +16274,232 @@ func (m *EventBlockSetWidgetViewId) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *EventBlockSetSynced) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBlockSetSynced) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBlockSetSynced) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TargetBlockId != nil {
+		{
+			size, err := m.TargetBlockId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TargetObjectId != nil {
+		{
+			size, err := m.TargetObjectId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventBlockSetSyncedTargetObjectId) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBlockSetSyncedTargetObjectId) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBlockSetSyncedTargetObjectId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventBlockSetSyncedTargetBlockId) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBlockSetSyncedTargetBlockId) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBlockSetSyncedTargetBlockId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventBlockSetDiagram) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBlockSetDiagram) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBlockSetDiagram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Language != nil {
+		{
+			size, err := m.Language.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Source != nil {
+		{
+			size, err := m.Source.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventBlockSetDiagramSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBlockSetDiagramSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBlockSetDiagramSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventBlockSetDiagramLanguage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBlockSetDiagramLanguage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBlockSetDiagramLanguage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventBlockFill) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -19246,6 +20352,233 @@ func (m *EventFileLocalUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventComment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventComment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventComment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCommentSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCommentSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCommentSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Comment != nil {
+		{
+			size, err := m.Comment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCommentRemove) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCommentRemove) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCommentRemove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TargetObjectId) > 0 {
+		i -= len(m.TargetObjectId)
+		copy(dAtA[i:], m.TargetObjectId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.TargetObjectId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTaskSubscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTaskSubscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTaskSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTaskSubscriptionSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTaskSubscriptionSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTaskSubscriptionSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Task != nil {
+		{
+			size, err := m.Task.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SubId) > 0 {
+		i -= len(m.SubId)
+		copy(dAtA[i:], m.SubId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SubId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTaskSubscriptionRemove) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTaskSubscriptionRemove) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTaskSubscriptionRemove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlockId) > 0 {
+		i -= len(m.BlockId)
+		copy(dAtA[i:], m.BlockId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BlockId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ObjectId) > 0 {
+		i -= len(m.ObjectId)
+		copy(dAtA[i:], m.ObjectId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SubId) > 0 {
+		i -= len(m.SubId)
+		copy(dAtA[i:], m.SubId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SubId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ResponseEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -19858,6 +21191,30 @@ func (m *EventMessageValueOfBlockSetWidget) Size() (n int) {
 	}
 	return n
 }
+func (m *EventMessageValueOfBlockSetSynced) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockSetSynced != nil {
+		l = m.BlockSetSynced.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventMessageValueOfBlockSetDiagram) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockSetDiagram != nil {
+		l = m.BlockSetDiagram.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	return n
+}
 func (m *EventMessageValueOfObjectDetailsAmend) Size() (n int) {
 	if m == nil {
 		return 0
@@ -20086,6 +21443,54 @@ func (m *EventMessageValueOfFileLocalUsage) Size() (n int) {
 	}
 	return n
 }
+func (m *EventMessageValueOfCommentSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CommentSet != nil {
+		l = m.CommentSet.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventMessageValueOfCommentRemove) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CommentRemove != nil {
+		l = m.CommentRemove.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventMessageValueOfTaskSubscriptionSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TaskSubscriptionSet != nil {
+		l = m.TaskSubscriptionSet.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventMessageValueOfTaskSubscriptionRemove) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TaskSubscriptionRemove != nil {
+		l = m.TaskSubscriptionRemove.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	return n
+}
 func (m *EventMessageValueOfBlockDataviewRelationSet) Size() (n int) {
 	if m == nil {
 		return 0
@@ -21485,6 +22890,99 @@ func (m *EventBlockSetWidgetViewId) Size() (n int) {
 	return n
 }
 
+func (m *EventBlockSetSynced) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.TargetObjectId != nil {
+		l = m.TargetObjectId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.TargetBlockId != nil {
+		l = m.TargetBlockId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventBlockSetSyncedTargetObjectId) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventBlockSetSyncedTargetBlockId) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventBlockSetDiagram) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Source != nil {
+		l = m.Source.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Language != nil {
+		l = m.Language.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventBlockSetDiagramSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventBlockSetDiagramLanguage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovEvents(uint64(m.Value))
+	}
+	return n
+}
+
 func (m *EventBlockFill) Size() (n int) {
 	if m == nil {
 		return 0
@@ -23146,6 +24644,101 @@ func (m *EventFileLocalUsage) Size() (n int) {
 	return n
 }
 
+func (m *EventComment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *EventCommentSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Comment != nil {
+		l = m.Comment.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventCommentRemove) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.TargetObjectId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *EventTaskSubscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *EventTaskSubscriptionSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SubId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Task != nil {
+		l = m.Task.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventTaskSubscriptionRemove) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SubId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ObjectId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BlockId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func (m *ResponseEvent) Size() (n int) {
 	if m == nil {
 		return 0
@@ -24594,6 +26187,76 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			}
 			m.Value = &EventMessageValueOfBlockSetWidget{v}
 			iNdEx = postIndex
+		case 41:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockSetSynced", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EventBlockSetSynced{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &EventMessageValueOfBlockSetSynced{v}
+			iNdEx = postIndex
+		case 42:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockSetDiagram", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EventBlockSetDiagram{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &EventMessageValueOfBlockSetDiagram{v}
+			iNdEx = postIndex
 		case 50:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ObjectDetailsAmend", wireType)
@@ -25259,9 +26922,9 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			}
 			m.Value = &EventMessageValueOfFileLocalUsage{v}
 			iNdEx = postIndex
-		case 123:
+		case 114:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockDataviewRelationSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommentSet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25288,15 +26951,15 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &EventBlockDataviewRelationSet{}
+			v := &EventCommentSet{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Value = &EventMessageValueOfBlockDataviewRelationSet{v}
+			m.Value = &EventMessageValueOfCommentSet{v}
 			iNdEx = postIndex
-		case 124:
+		case 115:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockDataviewRelationDelete", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommentRemove", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25323,15 +26986,15 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &EventBlockDataviewRelationDelete{}
+			v := &EventCommentRemove{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Value = &EventMessageValueOfBlockDataviewRelationDelete{v}
+			m.Value = &EventMessageValueOfCommentRemove{v}
 			iNdEx = postIndex
-		case 125:
+		case 116:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockDataviewViewUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskSubscriptionSet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25358,15 +27021,155 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &EventBlockDataviewViewUpdate{}
+			v := &EventTaskSubscriptionSet{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Value = &EventMessageValueOfBlockDataviewViewUpdate{v}
+			m.Value = &EventMessageValueOfTaskSubscriptionSet{v}
 			iNdEx = postIndex
-		case 126:
+		case 117:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockDataviewTargetObjectIdSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskSubscriptionRemove", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EventTaskSubscriptionRemove{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &EventMessageValueOfTaskSubscriptionRemove{v}
+			iNdEx = postIndex
+		case 123:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockDataviewRelationSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EventBlockDataviewRelationSet{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &EventMessageValueOfBlockDataviewRelationSet{v}
+			iNdEx = postIndex
+		case 124:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockDataviewRelationDelete", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EventBlockDataviewRelationDelete{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &EventMessageValueOfBlockDataviewRelationDelete{v}
+			iNdEx = postIndex
+		case 125:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockDataviewViewUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EventBlockDataviewViewUpdate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &EventMessageValueOfBlockDataviewViewUpdate{v}
+			iNdEx = postIndex
+		case 126:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockDataviewTargetObjectIdSet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34074,7 +35877,7 @@ func (m *EventBlockSetWidgetViewId) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBlockFill) Unmarshal(dAtA []byte) error {
+func (m *EventBlockSetSynced) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34097,12 +35900,116 @@ func (m *EventBlockFill) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Fill: wiretype end group for non-group")
+			return fmt.Errorf("proto: Synced: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Fill: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Synced: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetObjectId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TargetObjectId == nil {
+				m.TargetObjectId = &EventBlockSetSyncedTargetObjectId{}
+			}
+			if err := m.TargetObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TargetBlockId == nil {
+				m.TargetBlockId = &EventBlockSetSyncedTargetBlockId{}
+			}
+			if err := m.TargetBlockId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -34124,7 +36031,7 @@ func (m *EventBlockFill) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBlockFillDetails) Unmarshal(dAtA []byte) error {
+func (m *EventBlockSetSyncedTargetObjectId) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34147,15 +36054,15 @@ func (m *EventBlockFillDetails) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Details: wiretype end group for non-group")
+			return fmt.Errorf("proto: TargetObjectId: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Details: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TargetObjectId: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34183,13 +36090,63 @@ func (m *EventBlockFillDetails) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBlockSetSyncedTargetBlockId) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TargetBlockId: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TargetBlockId: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -34199,27 +36156,23 @@ func (m *EventBlockFillDetails) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Details == nil {
-				m.Details = &types.Struct{}
-			}
-			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -34242,7 +36195,7 @@ func (m *EventBlockFillDetails) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBlockFillDatabaseRecords) Unmarshal(dAtA []byte) error {
+func (m *EventBlockSetDiagram) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34265,10 +36218,10 @@ func (m *EventBlockFillDatabaseRecords) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DatabaseRecords: wiretype end group for non-group")
+			return fmt.Errorf("proto: Diagram: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DatabaseRecords: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Diagram: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -34305,7 +36258,7 @@ func (m *EventBlockFillDatabaseRecords) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34332,8 +36285,46 @@ func (m *EventBlockFillDatabaseRecords) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Records = append(m.Records, &types.Struct{})
-			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Source == nil {
+				m.Source = &EventBlockSetDiagramSource{}
+			}
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Language", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Language == nil {
+				m.Language = &EventBlockSetDiagramLanguage{}
+			}
+			if err := m.Language.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -34358,7 +36349,7 @@ func (m *EventBlockFillDatabaseRecords) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBlockFillFields) Unmarshal(dAtA []byte) error {
+func (m *EventBlockSetDiagramSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34381,10 +36372,211 @@ func (m *EventBlockFillFields) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Fields: wiretype end group for non-group")
+			return fmt.Errorf("proto: Source: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Fields: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Source: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBlockSetDiagramLanguage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Language: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Language: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= model.BlockContentDiagramLanguage(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBlockFill) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Fill: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Fill: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBlockFillDetails) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Details: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Details: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -34421,7 +36613,7 @@ func (m *EventBlockFillFields) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34448,10 +36640,10 @@ func (m *EventBlockFillFields) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Fields == nil {
-				m.Fields = &types.Struct{}
+			if m.Details == nil {
+				m.Details = &types.Struct{}
 			}
-			if err := m.Fields.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -34476,7 +36668,7 @@ func (m *EventBlockFillFields) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBlockFillChildrenIds) Unmarshal(dAtA []byte) error {
+func (m *EventBlockFillDatabaseRecords) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34499,10 +36691,10 @@ func (m *EventBlockFillChildrenIds) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChildrenIds: wiretype end group for non-group")
+			return fmt.Errorf("proto: DatabaseRecords: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChildrenIds: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DatabaseRecords: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -34539,9 +36731,9 @@ func (m *EventBlockFillChildrenIds) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChildrenIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -34551,23 +36743,25 @@ func (m *EventBlockFillChildrenIds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChildrenIds = append(m.ChildrenIds, string(dAtA[iNdEx:postIndex]))
+			m.Records = append(m.Records, &types.Struct{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -34590,7 +36784,7 @@ func (m *EventBlockFillChildrenIds) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBlockFillRestrictions) Unmarshal(dAtA []byte) error {
+func (m *EventBlockFillFields) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34613,10 +36807,10 @@ func (m *EventBlockFillRestrictions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Restrictions: wiretype end group for non-group")
+			return fmt.Errorf("proto: Fields: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Restrictions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Fields: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -34653,7 +36847,7 @@ func (m *EventBlockFillRestrictions) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restrictions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34680,10 +36874,10 @@ func (m *EventBlockFillRestrictions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Restrictions == nil {
-				m.Restrictions = &model.BlockRestrictions{}
+			if m.Fields == nil {
+				m.Fields = &types.Struct{}
 			}
-			if err := m.Restrictions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Fields.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -34708,7 +36902,7 @@ func (m *EventBlockFillRestrictions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBlockFillBackgroundColor) Unmarshal(dAtA []byte) error {
+func (m *EventBlockFillChildrenIds) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34731,10 +36925,10 @@ func (m *EventBlockFillBackgroundColor) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BackgroundColor: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChildrenIds: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BackgroundColor: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChildrenIds: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -34771,7 +36965,7 @@ func (m *EventBlockFillBackgroundColor) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BackgroundColor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildrenIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34799,7 +36993,7 @@ func (m *EventBlockFillBackgroundColor) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BackgroundColor = string(dAtA[iNdEx:postIndex])
+			m.ChildrenIds = append(m.ChildrenIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -34822,7 +37016,7 @@ func (m *EventBlockFillBackgroundColor) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBlockFillAlign) Unmarshal(dAtA []byte) error {
+func (m *EventBlockFillRestrictions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34845,10 +37039,242 @@ func (m *EventBlockFillAlign) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Align: wiretype end group for non-group")
+			return fmt.Errorf("proto: Restrictions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Align: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Restrictions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restrictions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Restrictions == nil {
+				m.Restrictions = &model.BlockRestrictions{}
+			}
+			if err := m.Restrictions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBlockFillBackgroundColor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BackgroundColor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BackgroundColor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackgroundColor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BackgroundColor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBlockFillAlign) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Align: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Align: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -44244,6 +46670,620 @@ func (m *EventFileLocalUsage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventComment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Comment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Comment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCommentSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Set: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Set: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Comment == nil {
+				m.Comment = &model.Comment{}
+			}
+			if err := m.Comment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCommentRemove) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Remove: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Remove: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetObjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTask) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Task: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Task: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTaskSubscription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Subscription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Subscription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTaskSubscriptionSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Set: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Set: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Task == nil {
+				m.Task = &model.Task{}
+			}
+			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTaskSubscriptionRemove) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Remove: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Remove: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ResponseEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4,7 +4,7 @@ source: pkg/lib/bundle/internalTypes.json
 */
 package bundle
 
-const InternalTypesChecksum = "d80636e9d0d0b6e96ecd0bd7e1dd8279298778ed077fe748c4c129de6a9128f1"
+const InternalTypesChecksum = "f9da4527ad79cc683f7b3ce17a4897c85d92085dbb83cb1b8b2f8e8b6d774c32"
 
 // InternalTypes contains the list of types that are not possible to create directly via ObjectCreate
 // to create as a general object because they have specific logic
@@ -20,4 +20,5 @@ var InternalTypes = []TypeKey{
 	TypeKeyRelationOption,
 	TypeKeyDate,
 	TypeKeyTemplate,
+	TypeKeyComment,
 }
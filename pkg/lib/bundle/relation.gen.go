@@ -9,7 +9,7 @@ import (
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 )
 
-const RelationChecksum = "165040560b4a08b762713b5f5f4bdecb02432de64306c5b475421ffff0efa0c6"
+const RelationChecksum = "6f296157eff2d82ca964290abcf9126cc6c437e4c30695fcfa6072df9f3e129f"
 
 type RelationKey string
 
@@ -165,6 +165,22 @@ const (
 	RelationKeyLastChangeId              RelationKey = "lastChangeId"
 	RelationKeyStarred                   RelationKey = "starred"
 	RelationKeyDefaultTemplateId         RelationKey = "defaultTemplateId"
+	RelationKeyDuration                  RelationKey = "duration"
+	RelationKeyVideoCodec                RelationKey = "videoCodec"
+	RelationKeyAudioCodec                RelationKey = "audioCodec"
+	RelationKeyBitrate                   RelationKey = "bitrate"
+	RelationKeySourceAuthor              RelationKey = "sourceAuthor"
+	RelationKeyCommentTarget             RelationKey = "commentTarget"
+	RelationKeyCommentBlockId            RelationKey = "commentBlockId"
+	RelationKeyCommentRangeFrom          RelationKey = "commentRangeFrom"
+	RelationKeyCommentRangeTo            RelationKey = "commentRangeTo"
+	RelationKeyCommentThread             RelationKey = "commentThread"
+	RelationKeyIsResolved                RelationKey = "isResolved"
+	RelationKeyMentions                  RelationKey = "mentions"
+	RelationKeyRelationConstraints       RelationKey = "relationConstraints"
+	RelationKeyConstraintViolations      RelationKey = "constraintViolations"
+	RelationKeyIsLocked                  RelationKey = "isLocked"
+	RelationKeyLockEditableRelations     RelationKey = "lockEditableRelations"
 )
 
 var (
@@ -299,6 +315,19 @@ var (
 			ReadOnlyRelation: true,
 			Scope:            model.Relation_type,
 		},
+		RelationKeyAudioCodec: {
+
+			DataSource:       model.Relation_details,
+			Description:      "Codec of the audio stream",
+			Format:           model.RelationFormat_shorttext,
+			Id:               "_braudioCodec",
+			Key:              "audioCodec",
+			MaxCount:         1,
+			Name:             "Audio codec",
+			ReadOnly:         true,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
 		RelationKeyAudioGenre: {
 
 			DataSource:       model.Relation_details,
@@ -338,6 +367,19 @@ var (
 			ReadOnlyRelation: true,
 			Scope:            model.Relation_type,
 		},
+		RelationKeyBitrate: {
+
+			DataSource:       model.Relation_details,
+			Description:      "Average bitrate of the audio or video in kbit/s",
+			Format:           model.RelationFormat_number,
+			Id:               "_brbitrate",
+			Key:              "bitrate",
+			MaxCount:         1,
+			Name:             "Bitrate",
+			ReadOnly:         true,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
 		RelationKeyBudget: {
 
 			DataSource:       model.Relation_details,
@@ -426,6 +468,77 @@ var (
 			ReadOnlyRelation: true,
 			Scope:            model.Relation_type,
 		},
+		RelationKeyCommentBlockId: {
+
+			DataSource:       model.Relation_details,
+			Description:      "Id of the block the comment is attached to",
+			Format:           model.RelationFormat_shorttext,
+			Hidden:           true,
+			Id:               "_brcommentBlockId",
+			Key:              "commentBlockId",
+			MaxCount:         1,
+			Name:             "Comment block",
+			ReadOnly:         true,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
+		RelationKeyCommentRangeFrom: {
+
+			DataSource:       model.Relation_details,
+			Description:      "Start of the commented text range in the block",
+			Format:           model.RelationFormat_number,
+			Hidden:           true,
+			Id:               "_brcommentRangeFrom",
+			Key:              "commentRangeFrom",
+			MaxCount:         1,
+			Name:             "Comment range start",
+			ReadOnly:         true,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
+		RelationKeyCommentRangeTo: {
+
+			DataSource:       model.Relation_details,
+			Description:      "End of the commented text range in the block",
+			Format:           model.RelationFormat_number,
+			Hidden:           true,
+			Id:               "_brcommentRangeTo",
+			Key:              "commentRangeTo",
+			MaxCount:         1,
+			Name:             "Comment range end",
+			ReadOnly:         true,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
+		RelationKeyCommentTarget: {
+
+			DataSource:       model.Relation_details,
+			Description:      "Object the comment is attached to",
+			Format:           model.RelationFormat_object,
+			Hidden:           true,
+			Id:               "_brcommentTarget",
+			Key:              "commentTarget",
+			MaxCount:         1,
+			Name:             "Comment target",
+			ReadOnly:         true,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
+		RelationKeyCommentThread: {
+
+			DataSource:       model.Relation_details,
+			Description:      "First comment of the discussion thread. Empty for the first comment itself",
+			Format:           model.RelationFormat_object,
+			Hidden:           true,
+			Id:               "_brcommentThread",
+			Key:              "commentThread",
+			MaxCount:         1,
+			Name:             "Comment thread",
+			ObjectTypes:      []string{TypePrefix + "comment"},
+			ReadOnly:         true,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
 		RelationKeyCompany: {
 
 			DataSource:       model.Relation_details,
@@ -452,6 +565,19 @@ var (
 			ReadOnlyRelation: true,
 			Scope:            model.Relation_type,
 		},
+		RelationKeyConstraintViolations: {
+
+			DataSource:       model.Relation_local,
+			Description:      "Relations which values violate the constraints of the object type",
+			Format:           model.RelationFormat_object,
+			Id:               "_brconstraintViolations",
+			Key:              "constraintViolations",
+			Name:             "Validation errors",
+			ObjectTypes:      []string{TypePrefix + "relation"},
+			ReadOnly:         true,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
 		RelationKeyCoverId: {
 
 			DataSource:       model.Relation_details,
@@ -641,6 +767,19 @@ var (
 			ReadOnlyRelation: true,
 			Scope:            model.Relation_type,
 		},
+		RelationKeyDuration: {
+
+			DataSource:       model.Relation_details,
+			Description:      "Duration of the audio or video in seconds",
+			Format:           model.RelationFormat_number,
+			Id:               "_brduration",
+			Key:              "duration",
+			MaxCount:         1,
+			Name:             "Duration",
+			ReadOnly:         true,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
 		RelationKeyEmail: {
 
 			DataSource:       model.Relation_details,
@@ -1016,6 +1155,20 @@ var (
 			ReadOnlyRelation: true,
 			Scope:            model.Relation_type,
 		},
+		RelationKeyIsLocked: {
+
+			DataSource:       model.Relation_details,
+			Description:      "Object is protected from edits, only its creator can unlock it",
+			Format:           model.RelationFormat_checkbox,
+			Hidden:           true,
+			Id:               "_brisLocked",
+			Key:              "isLocked",
+			MaxCount:         1,
+			Name:             "Locked",
+			ReadOnly:         true,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
 		RelationKeyIsReadonly: {
 
 			DataSource:       model.Relation_derived,
@@ -1030,6 +1183,20 @@ var (
 			ReadOnlyRelation: true,
 			Scope:            model.Relation_type,
 		},
+		RelationKeyIsResolved: {
+
+			DataSource:       model.Relation_details,
+			Description:      "Discussion thread is resolved",
+			Format:           model.RelationFormat_checkbox,
+			Hidden:           true,
+			Id:               "_brisResolved",
+			Key:              "isResolved",
+			MaxCount:         1,
+			Name:             "Resolved",
+			ReadOnly:         false,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
 		RelationKeyJob: {
 
 			DataSource:       model.Relation_details,
@@ -1163,6 +1330,20 @@ var (
 			ReadOnlyRelation: true,
 			Scope:            model.Relation_type,
 		},
+		RelationKeyLockEditableRelations: {
+
+			DataSource:       model.Relation_details,
+			Description:      "Relations which can be edited in the locked object",
+			Format:           model.RelationFormat_object,
+			Hidden:           true,
+			Id:               "_brlockEditableRelations",
+			Key:              "lockEditableRelations",
+			Name:             "Editable relations of locked object",
+			ObjectTypes:      []string{TypePrefix + "relation"},
+			ReadOnly:         true,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
 		RelationKeyLogic: {
 
 			DataSource:       model.Relation_details,
@@ -1230,6 +1411,18 @@ var (
 			ReadOnlyRelation: true,
 			Scope:            model.Relation_type,
 		},
+		RelationKeyMentions: {
+
+			DataSource:       model.Relation_details,
+			Description:      "Objects mentioned in the text",
+			Format:           model.RelationFormat_object,
+			Id:               "_brmentions",
+			Key:              "mentions",
+			Name:             "Mentions",
+			ReadOnly:         true,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
 		RelationKeyMood: {
 
 			DataSource:       model.Relation_details,
@@ -1456,6 +1649,19 @@ var (
 			ReadOnlyRelation: true,
 			Scope:            model.Relation_type,
 		},
+		RelationKeyRelationConstraints: {
+
+			DataSource:       model.Relation_details,
+			Description:      "Constraints of the relations values in the objects of the type, JSON encoded list of the constraints",
+			Format:           model.RelationFormat_longtext,
+			Hidden:           true,
+			Id:               "_brrelationConstraints",
+			Key:              "relationConstraints",
+			Name:             "Relation constraints",
+			ReadOnly:         true,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
 		RelationKeyRelationDefaultValue: {
 
 			DataSource:       model.Relation_details,
@@ -1722,6 +1928,19 @@ var (
 			ReadOnlyRelation: true,
 			Scope:            model.Relation_type,
 		},
+		RelationKeySourceAuthor: {
+
+			DataSource:       model.Relation_details,
+			Description:      "Author of the imported web page",
+			Format:           model.RelationFormat_shorttext,
+			Id:               "_brsourceAuthor",
+			Key:              "sourceAuthor",
+			MaxCount:         1,
+			Name:             "Source author",
+			ReadOnly:         false,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
 		RelationKeySourceFilePath: {
 
 			DataSource:       model.Relation_details,
@@ -2013,6 +2232,19 @@ var (
 			ReadOnlyRelation: true,
 			Scope:            model.Relation_type,
 		},
+		RelationKeyVideoCodec: {
+
+			DataSource:       model.Relation_details,
+			Description:      "Codec of the video stream",
+			Format:           model.RelationFormat_shorttext,
+			Id:               "_brvideoCodec",
+			Key:              "videoCodec",
+			MaxCount:         1,
+			Name:             "Video codec",
+			ReadOnly:         true,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
 		RelationKeyWidthInPixels: {
 
 			DataSource:       model.Relation_details,
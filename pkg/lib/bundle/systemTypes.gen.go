@@ -4,7 +4,7 @@ source: pkg/lib/bundle/systemTypes.json
 */
 package bundle
 
-const SystemTypesChecksum = "1db8347b19281b4f7831f6d7ffe89dab23f37b09ad143208b7e0e34eb4402ac1"
+const SystemTypesChecksum = "03d2856b7d25e52c9fe8bbd6d1bbeb64e5bde7c56141e7311f64ad971906c558"
 
 // SystemTypes contains types that have some special biz logic depends on them in some objects
 // they shouldn't be removed or edited in any way
@@ -9,7 +9,7 @@ import (
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 )
 
-const TypeChecksum = "95f4f96dbb1537fa332ee00bb4d46061ccd3e3f2e1c1b50693e4f539e2a7406b"
+const TypeChecksum = "a2fe3efe29ef7a171ec7c11fe369b190ed4e2ba9a7dcfddacbec360542824e3e"
 
 type TypeKey string
 
@@ -59,6 +59,7 @@ const (
 	TypeKeyDocument       TypeKey = "document"
 	TypeKeyFile           TypeKey = "file"
 	TypeKeyProject        TypeKey = "project"
+	TypeKeyComment        TypeKey = "comment"
 )
 
 var (
@@ -70,7 +71,7 @@ var (
 			Layout:        model.ObjectType_file,
 			Name:          "Audio",
 			Readonly:      true,
-			RelationLinks: []*model.RelationLink{MustGetRelationLink(RelationKeyArtist), MustGetRelationLink(RelationKeyAudioAlbum), MustGetRelationLink(RelationKeyAudioAlbumTrackNumber), MustGetRelationLink(RelationKeyAudioGenre), MustGetRelationLink(RelationKeyReleasedYear), MustGetRelationLink(RelationKeyThumbnailImage), MustGetRelationLink(RelationKeyComposer), MustGetRelationLink(RelationKeySizeInBytes), MustGetRelationLink(RelationKeyFileMimeType), MustGetRelationLink(RelationKeyAddedDate), MustGetRelationLink(RelationKeyFileExt), MustGetRelationLink(RelationKeyAudioArtist), MustGetRelationLink(RelationKeyAudioLyrics)},
+			RelationLinks: []*model.RelationLink{MustGetRelationLink(RelationKeyArtist), MustGetRelationLink(RelationKeyAudioAlbum), MustGetRelationLink(RelationKeyAudioAlbumTrackNumber), MustGetRelationLink(RelationKeyAudioGenre), MustGetRelationLink(RelationKeyReleasedYear), MustGetRelationLink(RelationKeyThumbnailImage), MustGetRelationLink(RelationKeyComposer), MustGetRelationLink(RelationKeySizeInBytes), MustGetRelationLink(RelationKeyFileMimeType), MustGetRelationLink(RelationKeyAddedDate), MustGetRelationLink(RelationKeyFileExt), MustGetRelationLink(RelationKeyAudioArtist), MustGetRelationLink(RelationKeyAudioLyrics), MustGetRelationLink(RelationKeyDuration), MustGetRelationLink(RelationKeyAudioCodec), MustGetRelationLink(RelationKeyBitrate)},
 			Types:         []model.SmartBlockType{model.SmartBlockType_File},
 			Url:           TypePrefix + "audio",
 		},
@@ -92,7 +93,7 @@ var (
 			Layout:        model.ObjectType_bookmark,
 			Name:          "Bookmark",
 			Readonly:      true,
-			RelationLinks: []*model.RelationLink{MustGetRelationLink(RelationKeyTag), MustGetRelationLink(RelationKeySource), MustGetRelationLink(RelationKeyPicture)},
+			RelationLinks: []*model.RelationLink{MustGetRelationLink(RelationKeyTag), MustGetRelationLink(RelationKeySource), MustGetRelationLink(RelationKeyPicture), MustGetRelationLink(RelationKeySourceAuthor)},
 			Types:         []model.SmartBlockType{model.SmartBlockType_Page},
 			Url:           TypePrefix + "bookmark",
 		},
@@ -129,6 +130,18 @@ var (
 			Types:         []model.SmartBlockType{model.SmartBlockType_Page},
 			Url:           TypePrefix + "collection",
 		},
+		TypeKeyComment: {
+
+			Description:   "Remark attached to a block of another object. Comments form discussion threads that can be resolved",
+			Hidden:        true,
+			IconEmoji:     "💬",
+			Layout:        model.ObjectType_note,
+			Name:          "Comment",
+			Readonly:      true,
+			RelationLinks: []*model.RelationLink{MustGetRelationLink(RelationKeyCommentTarget), MustGetRelationLink(RelationKeyCommentThread), MustGetRelationLink(RelationKeyIsResolved), MustGetRelationLink(RelationKeyMentions)},
+			Types:         []model.SmartBlockType{model.SmartBlockType_Page},
+			Url:           TypePrefix + "comment",
+		},
 		TypeKeyContact: {
 
 			Description:   "Information to make action of communicating or meeting with Human or Company",
@@ -402,7 +415,7 @@ var (
 			Layout:        model.ObjectType_file,
 			Name:          "Video",
 			Readonly:      true,
-			RelationLinks: []*model.RelationLink{MustGetRelationLink(RelationKeySizeInBytes), MustGetRelationLink(RelationKeyFileMimeType), MustGetRelationLink(RelationKeyCamera), MustGetRelationLink(RelationKeyThumbnailImage), MustGetRelationLink(RelationKeyHeightInPixels), MustGetRelationLink(RelationKeyWidthInPixels), MustGetRelationLink(RelationKeyCameraIso), MustGetRelationLink(RelationKeyAperture), MustGetRelationLink(RelationKeyExposure), MustGetRelationLink(RelationKeyAddedDate), MustGetRelationLink(RelationKeyFileExt)},
+			RelationLinks: []*model.RelationLink{MustGetRelationLink(RelationKeySizeInBytes), MustGetRelationLink(RelationKeyFileMimeType), MustGetRelationLink(RelationKeyCamera), MustGetRelationLink(RelationKeyThumbnailImage), MustGetRelationLink(RelationKeyHeightInPixels), MustGetRelationLink(RelationKeyWidthInPixels), MustGetRelationLink(RelationKeyCameraIso), MustGetRelationLink(RelationKeyAperture), MustGetRelationLink(RelationKeyExposure), MustGetRelationLink(RelationKeyAddedDate), MustGetRelationLink(RelationKeyFileExt), MustGetRelationLink(RelationKeyDuration), MustGetRelationLink(RelationKeyVideoCodec), MustGetRelationLink(RelationKeyAudioCodec), MustGetRelationLink(RelationKeyBitrate)},
 			Types:         []model.SmartBlockType{model.SmartBlockType_File},
 			Url:           TypePrefix + "video",
 		},
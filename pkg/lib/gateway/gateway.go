@@ -5,9 +5,11 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"mime"
 	"net"
 	"net/http"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"sync"
@@ -222,8 +224,9 @@ func (g *gateway) fileHandler(w http.ResponseWriter, r *http.Request) {
 
 	ctx, cancel := context.WithTimeout(r.Context(), getFileTimeout)
 	defer cancel()
-	file, reader, err := g.getFile(ctx, r)
+	file, err := g.fileService.FileByHash(ctx, fileHashFromPath(r.URL.Path))
 	if err != nil {
+		err = fmt.Errorf("get file by hash: %w", err)
 		log.With("path", r.URL.Path).Errorf("error getting file: %s", err)
 		if errors.Is(err, domain.ErrFileNotFound) {
 			http.NotFound(w, r)
@@ -232,27 +235,14 @@ func (g *gateway) fileHandler(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, err.Error(), 500)
 		return
 	}
-	meta := file.Meta()
-	w.Header().Set("Content-Type", meta.Media)
-	w.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=\"%s\"", meta.Name))
 
-	// todo: inside textile it still requires the file to be fully downloaded and decrypted(consuming 2xSize in ram) to provide the ReadSeeker interface
-	// 	need to find a way to use ReadSeeker all the way from downloading files from IPFS to writing the decrypted chunk to the HTTP
-	http.ServeContent(w, r, meta.Name, meta.Added, reader)
+	serveFile(w, r, file)
 }
 
-func (g *gateway) getFile(ctx context.Context, r *http.Request) (files.File, io.ReadSeeker, error) {
-	fileHashAndPath := strings.TrimPrefix(r.URL.Path, "/file/")
+func fileHashFromPath(path string) string {
+	fileHashAndPath := strings.TrimPrefix(path, "/file/")
 	parts := strings.Split(fileHashAndPath, "/")
-	fileHash := parts[0]
-
-	file, err := g.fileService.FileByHash(ctx, fileHash)
-	if err != nil {
-		return nil, nil, fmt.Errorf("get file by hash: %s", err)
-	}
-
-	reader, err := file.Reader(ctx)
-	return file, reader, err
+	return parts[0]
 }
 
 // imageHandler gets image meta from the DB, gets the corresponding data from the IPFS and decrypts it
@@ -269,7 +259,7 @@ func (g *gateway) imageHandler(w http.ResponseWriter, r *http.Request) {
 	ctx, cancel := context.WithTimeout(r.Context(), getFileTimeout)
 	defer cancel()
 
-	file, reader, err := g.getImage(ctx, r)
+	file, err := g.getImage(ctx, r)
 	if err != nil {
 		log.With("path", r.URL.Path).Errorf("error getting image: %s", err)
 		if errors.Is(err, domain.ErrFileNotFound) {
@@ -280,42 +270,101 @@ func (g *gateway) imageHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	meta := file.Meta()
-	w.Header().Set("Content-Type", meta.Media)
-	w.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=\"%s\"", meta.Name))
-
-	// todo: inside textile it still requires the file to be fully downloaded and decrypted(consuming 2xSize in ram) to provide the ReadSeeker interface
-	// 	need to find a way to use ReadSeeker all the way from downloading files from IPFS to writing the decrypted chunk to the HTTP
-	http.ServeContent(w, r, meta.Name, meta.Added, reader)
+	serveFile(w, r, file)
 }
 
-func (g *gateway) getImage(ctx context.Context, r *http.Request) (files.File, io.ReadSeeker, error) {
+func (g *gateway) getImage(ctx context.Context, r *http.Request) (files.File, error) {
 	urlParts := strings.Split(r.URL.Path, "/")
 	imageHash := urlParts[2]
 	query := r.URL.Query()
 
 	image, err := g.fileService.ImageByHash(ctx, imageHash)
 	if err != nil {
-		return nil, nil, fmt.Errorf("get image by hash: %w", err)
+		return nil, fmt.Errorf("get image by hash: %w", err)
 	}
 	var file files.File
 	wantWidthStr := query.Get("width")
 	if wantWidthStr == "" {
 		file, err = image.GetOriginalFile(ctx)
 		if err != nil {
-			return nil, nil, fmt.Errorf("get image file: %w", err)
+			return nil, fmt.Errorf("get image file: %w", err)
 		}
 	} else {
 		wantWidth, err := strconv.Atoi(wantWidthStr)
 		if err != nil {
-			return nil, nil, fmt.Errorf("parse width: %w", err)
+			return nil, fmt.Errorf("parse width: %w", err)
 		}
 		file, err = image.GetFileForWidth(ctx, wantWidth)
 		if err != nil {
-			return nil, nil, fmt.Errorf("get image file: %w", err)
+			return nil, fmt.Errorf("get image file: %w", err)
 		}
 	}
+	return file, nil
+}
+
+// serveFile writes the file content with support of Range and If-None-Match requests.
+// Files are content-addressed, so the hash is enough to answer the conditional request
+func serveFile(w http.ResponseWriter, r *http.Request, file files.File) {
+	if etagMatches(r, file.Hash()) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	// the reader fetches blocks lazily, so it must live as long as the request does rather than be limited by getFileTimeout
+	reader, err := file.Reader(r.Context())
+	if err != nil {
+		log.With("path", r.URL.Path).Errorf("error getting file reader: %s", err)
+		http.Error(w, err.Error(), 500)
+		return
+	}
+	if closer, ok := reader.(io.Closer); ok {
+		defer closer.Close()
+	}
+
+	// todo: files encrypted with GCM are still fully downloaded and decrypted(consuming 2xSize in ram) by DecryptReader
+	// 	to provide the ReadSeeker interface, so the Range request of such file loads the whole file
+	meta := file.Meta()
+	if contentType := contentTypeForFile(meta); contentType != "" {
+		w.Header().Set("Content-Type", contentType)
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=\"%s\"", meta.Name))
+	w.Header().Set("ETag", etag(file.Hash()))
+	// content is addressed by hash, so it never changes
+	w.Header().Set("Cache-Control", "private, max-age=31536000, immutable")
 
-	reader, err := file.Reader(ctx)
-	return file, reader, err
+	http.ServeContent(w, r, meta.Name, meta.Added, reader)
+}
+
+// contentTypeForFile returns the media type stored in the file meta,
+// falling back to the type derived from the file extension. Empty result lets http.ServeContent sniff the content
+func contentTypeForFile(meta *files.FileMeta) string {
+	if meta.Media != "" && meta.Media != "application/octet-stream" {
+		return meta.Media
+	}
+	if byExt := mime.TypeByExtension(filepath.Ext(meta.Name)); byExt != "" {
+		return byExt
+	}
+	return meta.Media
+}
+
+func etag(hash string) string {
+	return `"` + hash + `"`
+}
+
+func etagMatches(r *http.Request, hash string) bool {
+	if hash == "" {
+		return false
+	}
+	ifNoneMatch := r.Header.Get("If-None-Match")
+	if ifNoneMatch == "" {
+		return false
+	}
+	for _, tag := range strings.Split(ifNoneMatch, ",") {
+		tag = strings.TrimSpace(tag)
+		tag = strings.TrimPrefix(tag, "W/")
+		if tag == "*" || tag == etag(hash) {
+			return true
+		}
+	}
+	return false
 }
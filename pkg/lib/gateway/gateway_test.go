@@ -0,0 +1,156 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/files"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/storage"
+)
+
+type testFile struct {
+	hash    string
+	meta    *files.FileMeta
+	content []byte
+}
+
+func (f *testFile) Meta() *files.FileMeta {
+	return f.meta
+}
+
+func (f *testFile) Hash() string {
+	return f.hash
+}
+
+func (f *testFile) Reader(ctx context.Context) (io.ReadSeeker, error) {
+	return bytes.NewReader(f.content), nil
+}
+
+func (f *testFile) Details(ctx context.Context) (*types.Struct, error) {
+	return nil, nil
+}
+
+func (f *testFile) Info() *storage.FileInfo {
+	return nil
+}
+
+func newTestFile() *testFile {
+	return &testFile{
+		hash:    "bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi",
+		content: []byte("0123456789abcdefghijklmnopqrstuvwxyz"),
+		meta: &files.FileMeta{
+			Media: "video/mp4",
+			Name:  "video.mp4",
+			Added: time.Unix(1690000000, 0),
+		},
+	}
+}
+
+func TestServeFile(t *testing.T) {
+	t.Run("full content", func(t *testing.T) {
+		f := newTestFile()
+		r := httptest.NewRequest(http.MethodGet, "/file/"+f.hash, nil)
+		w := httptest.NewRecorder()
+
+		serveFile(w, r, f)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, f.content, w.Body.Bytes())
+		assert.Equal(t, "video/mp4", w.Header().Get("Content-Type"))
+		assert.Equal(t, `"`+f.hash+`"`, w.Header().Get("ETag"))
+		assert.Equal(t, "bytes", w.Header().Get("Accept-Ranges"))
+	})
+
+	t.Run("range", func(t *testing.T) {
+		f := newTestFile()
+		r := httptest.NewRequest(http.MethodGet, "/file/"+f.hash, nil)
+		r.Header.Set("Range", "bytes=10-15")
+		w := httptest.NewRecorder()
+
+		serveFile(w, r, f)
+
+		assert.Equal(t, http.StatusPartialContent, w.Code)
+		assert.Equal(t, "abcdef", w.Body.String())
+		assert.Equal(t, "bytes 10-15/36", w.Header().Get("Content-Range"))
+	})
+
+	t.Run("not modified", func(t *testing.T) {
+		f := newTestFile()
+		r := httptest.NewRequest(http.MethodGet, "/file/"+f.hash, nil)
+		r.Header.Set("If-None-Match", `W/"other", "`+f.hash+`"`)
+		w := httptest.NewRecorder()
+
+		serveFile(w, r, f)
+
+		assert.Equal(t, http.StatusNotModified, w.Code)
+		assert.Empty(t, w.Body.Bytes())
+	})
+
+	t.Run("content type from extension", func(t *testing.T) {
+		f := newTestFile()
+		f.meta.Media = ""
+		f.meta.Name = "picture.png"
+		r := httptest.NewRequest(http.MethodGet, "/file/"+f.hash, nil)
+		w := httptest.NewRecorder()
+
+		serveFile(w, r, f)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "image/png", w.Header().Get("Content-Type"))
+	})
+}
+
+type testFileService struct {
+	files.Service
+	files map[string]files.File
+}
+
+func (s *testFileService) FileByHash(ctx context.Context, hash string) (files.File, error) {
+	if f, ok := s.files[hash]; ok {
+		return f, nil
+	}
+	return nil, domain.ErrFileNotFound
+}
+
+func TestGateway_FileHandler(t *testing.T) {
+	f := newTestFile()
+	g := &gateway{
+		fileService: &testFileService{files: map[string]files.File{f.hash: f}},
+		limitCh:     make(chan struct{}, 1),
+	}
+
+	t.Run("not modified", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/file/"+f.hash, nil)
+		r.Header.Set("If-None-Match", `"`+f.hash+`"`)
+		w := httptest.NewRecorder()
+
+		g.fileHandler(w, r)
+
+		assert.Equal(t, http.StatusNotModified, w.Code)
+	})
+
+	t.Run("not modified for missing file", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/file/missing", nil)
+		r.Header.Set("If-None-Match", `"missing"`)
+		w := httptest.NewRecorder()
+
+		g.fileHandler(w, r)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestFileHashFromPath(t *testing.T) {
+	assert.Equal(t, "hash", fileHashFromPath("/file/hash"))
+	assert.Equal(t, "hash", fileHashFromPath("/file/hash/name.mp4"))
+}
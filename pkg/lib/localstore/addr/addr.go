@@ -24,6 +24,8 @@ const (
 	DatePrefix                  = "_date_"
 
 	MissingObject = "_missing_object"
+
+	BlockReferenceSeparator = "#"
 )
 
 func ExtractVirtualSourceType(id string) (model.SmartBlockType, error) {
@@ -58,3 +60,18 @@ func ConvertBundledObjectIdToInstalledId(bundledId string) (string, error) {
 func TimeToID(t time.Time) string {
 	return DatePrefix + t.Format("2006-01-02")
 }
+
+// BlockReference returns the reference to the block of the object, i.e. <objectId>#<blockId>
+func BlockReference(objectID, blockID string) string {
+	if blockID == "" {
+		return objectID
+	}
+	return objectID + BlockReferenceSeparator + blockID
+}
+
+// SplitBlockReference splits the block reference into object id and block id.
+// Block id is empty when the reference points to the whole object
+func SplitBlockReference(ref string) (objectID, blockID string) {
+	objectID, blockID, _ = strings.Cut(ref, BlockReferenceSeparator)
+	return objectID, blockID
+}
@@ -0,0 +1,140 @@
+package objectstore
+
+import (
+	"fmt"
+
+	"github.com/dgraph-io/badger/v3"
+	ds "github.com/ipfs/go-datastore"
+	"github.com/samber/lo"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+var (
+	// /pages/blockout/<source id>: all block links of the source object
+	pagesOutboundBlockLinksBase = ds.NewKey("/" + pagesPrefix + "/blockout")
+	// /pages/blockin/<target id>/<source id>: block links of the source object pointing to the target object
+	pagesInboundBlockLinksBase = ds.NewKey("/" + pagesPrefix + "/blockin")
+)
+
+type BlockLinkStore interface {
+	// UpdateObjectBlockLinks replaces indexed block links of the object, empty links remove the object from the index
+	UpdateObjectBlockLinks(id string, links []*model.BlockLink) error
+	// GetInboundBlockLinks returns links from blocks of other objects to the object or its blocks
+	GetInboundBlockLinks(id string) ([]*model.BlockLink, error)
+	// GetOutboundBlockLinks returns links from blocks of the object
+	GetOutboundBlockLinks(id string) ([]*model.BlockLink, error)
+}
+
+func (s *dsObjectStore) UpdateObjectBlockLinks(id string, links []*model.BlockLink) error {
+	return retryOnConflict(func() error {
+		txn := s.db.NewTransaction(true)
+		defer txn.Discard()
+
+		prev, err := findOutboundBlockLinks(txn, id)
+		if err != nil {
+			return fmt.Errorf("find outbound block links: %w", err)
+		}
+		for _, l := range prev {
+			err = txn.Delete(pagesInboundBlockLinksBase.ChildString(l.TargetObjectId).ChildString(id).Bytes())
+			if err != nil && !isNotFound(err) {
+				return fmt.Errorf("delete inbound block links: %w", err)
+			}
+		}
+
+		outKey := pagesOutboundBlockLinksBase.ChildString(id).Bytes()
+		if len(links) == 0 {
+			err = txn.Delete(outKey)
+			if err != nil && !isNotFound(err) {
+				return fmt.Errorf("delete outbound block links: %w", err)
+			}
+			return txn.Commit()
+		}
+		if err = setValueTxn(txn, outKey, &model.BlockLinks{Links: links}); err != nil {
+			return fmt.Errorf("set outbound block links: %w", err)
+		}
+		for targetID, targetLinks := range lo.GroupBy(links, func(l *model.BlockLink) string {
+			return l.TargetObjectId
+		}) {
+			key := pagesInboundBlockLinksBase.ChildString(targetID).ChildString(id).Bytes()
+			if err = setValueTxn(txn, key, &model.BlockLinks{Links: targetLinks}); err != nil {
+				return fmt.Errorf("set inbound block links: %w", err)
+			}
+		}
+		return txn.Commit()
+	})
+}
+
+func (s *dsObjectStore) GetInboundBlockLinks(id string) (links []*model.BlockLink, err error) {
+	err = s.db.View(func(txn *badger.Txn) error {
+		links, err = findInboundBlockLinks(txn, id)
+		return err
+	})
+	return
+}
+
+func (s *dsObjectStore) GetOutboundBlockLinks(id string) (links []*model.BlockLink, err error) {
+	err = s.db.View(func(txn *badger.Txn) error {
+		links, err = findOutboundBlockLinks(txn, id)
+		return err
+	})
+	return
+}
+
+func findOutboundBlockLinks(txn *badger.Txn, id string) ([]*model.BlockLink, error) {
+	links, err := getValueTxn(txn, pagesOutboundBlockLinksBase.ChildString(id).Bytes(), unmarshalBlockLinks)
+	if isNotFound(err) {
+		return nil, nil
+	}
+	return links, err
+}
+
+func findInboundBlockLinks(txn *badger.Txn, id string) ([]*model.BlockLink, error) {
+	var links []*model.BlockLink
+	opts := badger.DefaultIteratorOptions
+	opts.Prefix = []byte(pagesInboundBlockLinksBase.ChildString(id).String() + "/")
+	iter := txn.NewIterator(opts)
+	defer iter.Close()
+
+	for iter.Rewind(); iter.Valid(); iter.Next() {
+		err := iter.Item().Value(func(val []byte) error {
+			sourceLinks, err := unmarshalBlockLinks(val)
+			if err != nil {
+				return err
+			}
+			links = append(links, sourceLinks...)
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unmarshal block links %s: %w", iter.Item().Key(), err)
+		}
+	}
+	return links, nil
+}
+
+func unmarshalBlockLinks(raw []byte) ([]*model.BlockLink, error) {
+	v := &model.BlockLinks{}
+	if err := v.Unmarshal(raw); err != nil {
+		return nil, err
+	}
+	return v.Links, nil
+}
+
+func (s *dsObjectStore) eraseBlockLinks() (removed int, err error) {
+	err = retryOnConflict(func() error {
+		txn := s.db.NewTransaction(true)
+		defer txn.Discard()
+		var removedOut, removedIn int
+		txn, removedOut, err = s.removeByPrefixInTx(txn, pagesOutboundBlockLinksBase.String()+"/")
+		if err != nil {
+			return fmt.Errorf("remove outbound block links: %w", err)
+		}
+		txn, removedIn, err = s.removeByPrefixInTx(txn, pagesInboundBlockLinksBase.String()+"/")
+		if err != nil {
+			return fmt.Errorf("remove inbound block links: %w", err)
+		}
+		removed = removedOut + removedIn
+		return txn.Commit()
+	})
+	return
+}
@@ -0,0 +1,61 @@
+package objectstore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+func TestDsObjectStore_BlockLinks(t *testing.T) {
+	link := func(source, sourceBlock, target, targetBlock string) *model.BlockLink {
+		return &model.BlockLink{SourceObjectId: source, SourceBlockId: sourceBlock, TargetObjectId: target, TargetBlockId: targetBlock}
+	}
+
+	t.Run("update and get", func(t *testing.T) {
+		s := newStoreFixture(t)
+
+		require.NoError(t, s.UpdateObjectBlockLinks("id1", []*model.BlockLink{
+			link("id1", "a", "id3", "x"),
+			link("id1", "b", "id3", ""),
+			link("id1", "b", "id4", "y"),
+		}))
+		require.NoError(t, s.UpdateObjectBlockLinks("id2", []*model.BlockLink{link("id2", "c", "id3", "x")}))
+
+		inbound, err := s.GetInboundBlockLinks("id3")
+		require.NoError(t, err)
+		assert.Equal(t, []*model.BlockLink{
+			link("id1", "a", "id3", "x"),
+			link("id1", "b", "id3", ""),
+			link("id2", "c", "id3", "x"),
+		}, inbound)
+
+		outbound, err := s.GetOutboundBlockLinks("id1")
+		require.NoError(t, err)
+		assert.Len(t, outbound, 3)
+	})
+
+	t.Run("links are replaced", func(t *testing.T) {
+		s := newStoreFixture(t)
+
+		require.NoError(t, s.UpdateObjectBlockLinks("id1", []*model.BlockLink{link("id1", "a", "id2", "x")}))
+		require.NoError(t, s.UpdateObjectBlockLinks("id1", []*model.BlockLink{link("id1", "a", "id3", "y")}))
+
+		inbound, err := s.GetInboundBlockLinks("id2")
+		require.NoError(t, err)
+		assert.Empty(t, inbound)
+		inbound, err = s.GetInboundBlockLinks("id3")
+		require.NoError(t, err)
+		assert.Equal(t, []*model.BlockLink{link("id1", "a", "id3", "y")}, inbound)
+
+		require.NoError(t, s.UpdateObjectBlockLinks("id1", nil))
+		inbound, err = s.GetInboundBlockLinks("id3")
+		require.NoError(t, err)
+		assert.Empty(t, inbound)
+		outbound, err := s.GetOutboundBlockLinks("id1")
+		require.NoError(t, err)
+		assert.Empty(t, outbound)
+	})
+}
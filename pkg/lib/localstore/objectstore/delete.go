@@ -43,6 +43,15 @@ func (s *dsObjectStore) DeleteObject(id string) error {
 	if err != nil && !errors.Is(err, ErrDetailsNotChanged) {
 		return fmt.Errorf("failed to overwrite details and relations: %w", err)
 	}
+	if err = s.UpdateObjectTasks(id, nil); err != nil {
+		return fmt.Errorf("delete object tasks: %w", err)
+	}
+	if err = s.UpdateObjectBlockLinks(id, nil); err != nil {
+		return fmt.Errorf("delete block links: %w", err)
+	}
+	if err = s.UpdateObjectFileReferences(id, nil); err != nil {
+		return fmt.Errorf("delete file references: %w", err)
+	}
 
 	return retryOnConflict(func() error {
 		txn := s.db.NewTransaction(true)
@@ -0,0 +1,85 @@
+package objectstore
+
+import (
+	"fmt"
+
+	"github.com/dgraph-io/badger/v3"
+	ds "github.com/ipfs/go-datastore"
+
+	"github.com/anyproto/anytype-heart/util/slice"
+)
+
+var (
+	// /pages/fileout/<object id>/<file hash>: files referenced by the object in blocks or relations
+	pagesOutboundFileRefsBase = ds.NewKey("/" + pagesPrefix + "/fileout")
+	// /pages/filein/<file hash>/<object id>: objects referencing the file
+	pagesInboundFileRefsBase = ds.NewKey("/" + pagesPrefix + "/filein")
+)
+
+// FileReferenceStore indexes the files referenced by objects. It's kept apart from the object links,
+// so the files don't appear in the links graph
+type FileReferenceStore interface {
+	// UpdateObjectFileReferences replaces indexed file references of the object, empty hashes remove the object from the index
+	UpdateObjectFileReferences(id string, fileHashes []string) error
+	// GetFileReferences returns ids of the objects referencing the file
+	GetFileReferences(fileHash string) ([]string, error)
+}
+
+func (s *dsObjectStore) UpdateObjectFileReferences(id string, fileHashes []string) error {
+	return s.updateTxn(func(txn *badger.Txn) error {
+		prev, err := listIDsByPrefix(txn, []byte(pagesOutboundFileRefsBase.ChildString(id).String()+"/"))
+		if err != nil {
+			return fmt.Errorf("find file references: %w", err)
+		}
+		removed, added := slice.DifferenceRemovedAdded(prev, fileHashes)
+		for _, hash := range added {
+			for _, k := range fileRefKeys(id, hash) {
+				if err = txn.Set(k.Bytes(), nil); err != nil {
+					return fmt.Errorf("set file reference %s: %w", k, err)
+				}
+			}
+		}
+		for _, hash := range removed {
+			for _, k := range fileRefKeys(id, hash) {
+				if err = txn.Delete(k.Bytes()); err != nil {
+					return fmt.Errorf("delete file reference %s: %w", k, err)
+				}
+			}
+		}
+		return nil
+	})
+}
+
+func (s *dsObjectStore) GetFileReferences(fileHash string) (ids []string, err error) {
+	err = s.db.View(func(txn *badger.Txn) error {
+		ids, err = listIDsByPrefix(txn, []byte(pagesInboundFileRefsBase.ChildString(fileHash).String()+"/"))
+		return err
+	})
+	return
+}
+
+func fileRefKeys(id, fileHash string) []ds.Key {
+	return []ds.Key{
+		pagesOutboundFileRefsBase.ChildString(id).ChildString(fileHash),
+		pagesInboundFileRefsBase.ChildString(fileHash).ChildString(id),
+	}
+}
+
+func (s *dsObjectStore) eraseFileReferences() (removed int, err error) {
+	err = retryOnConflict(func() error {
+		txn := s.db.NewTransaction(true)
+		defer txn.Discard()
+		var removedOut, removedIn int
+		txn, removedOut, err = s.removeByPrefixInTx(txn, pagesOutboundFileRefsBase.String()+"/")
+		if err != nil {
+			return fmt.Errorf("remove outbound file references: %w", err)
+		}
+		txn, removedIn, err = s.removeByPrefixInTx(txn, pagesInboundFileRefsBase.String()+"/")
+		if err != nil {
+			return fmt.Errorf("remove inbound file references: %w", err)
+		}
+		removed = removedOut + removedIn
+		return txn.Commit()
+	})
+	return
+}
@@ -0,0 +1,50 @@
+package objectstore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDsObjectStore_FileReferences(t *testing.T) {
+	t.Run("update and get", func(t *testing.T) {
+		s := newStoreFixture(t)
+
+		require.NoError(t, s.UpdateObjectFileReferences("id1", []string{"file1", "file2"}))
+		require.NoError(t, s.UpdateObjectFileReferences("id2", []string{"file1"}))
+
+		refs, err := s.GetFileReferences("file1")
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"id1", "id2"}, refs)
+		refs, err = s.GetFileReferences("file2")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"id1"}, refs)
+	})
+
+	t.Run("references are replaced", func(t *testing.T) {
+		s := newStoreFixture(t)
+
+		require.NoError(t, s.UpdateObjectFileReferences("id1", []string{"file1"}))
+		require.NoError(t, s.UpdateObjectFileReferences("id1", []string{"file2"}))
+
+		refs, err := s.GetFileReferences("file1")
+		require.NoError(t, err)
+		assert.Empty(t, refs)
+
+		require.NoError(t, s.DeleteObject("id1"))
+		refs, err = s.GetFileReferences("file2")
+		require.NoError(t, err)
+		assert.Empty(t, refs)
+	})
+
+	t.Run("files are not links", func(t *testing.T) {
+		s := newStoreFixture(t)
+
+		require.NoError(t, s.UpdateObjectFileReferences("id1", []string{"file1"}))
+
+		links, err := s.GetOutboundLinksByID("id1")
+		require.NoError(t, err)
+		assert.Empty(t, links)
+	})
+}
@@ -108,6 +108,9 @@ type ObjectStore interface {
 	app.ComponentRunnable
 	IndexerStore
 	AccountStore
+	TaskStore
+	BlockLinkStore
+	FileReferenceStore
 
 	SubscribeForAll(callback func(rec database.Record))
 
@@ -182,8 +185,9 @@ type dsObjectStore struct {
 	sbtProvider typeprovider.SmartBlockTypeProvider
 
 	sync.RWMutex
-	onChangeCallback func(record database.Record)
-	subscriptions    []database.Subscription
+	onChangeCallback      func(record database.Record)
+	onTasksChangeCallback func(objectID string, tasks []*model.Task)
+	subscriptions         []database.Subscription
 }
 
 func (s *dsObjectStore) EraseIndexes() error {
@@ -193,6 +197,21 @@ func (s *dsObjectStore) EraseIndexes() error {
 	}
 	log.Infof("eraseLinks: removed %d outbound links", outboundRemoved)
 	log.Infof("eraseLinks: removed %d inbound links", inboundRemoved)
+	tasksRemoved, err := s.eraseTasks()
+	if err != nil {
+		log.Errorf("eraseTasks failed: %s", err)
+	}
+	log.Infof("eraseTasks: removed tasks of %d objects", tasksRemoved)
+	blockLinksRemoved, err := s.eraseBlockLinks()
+	if err != nil {
+		log.Errorf("eraseBlockLinks failed: %s", err)
+	}
+	log.Infof("eraseBlockLinks: removed %d block links", blockLinksRemoved)
+	fileRefsRemoved, err := s.eraseFileReferences()
+	if err != nil {
+		log.Errorf("eraseFileReferences failed: %s", err)
+	}
+	log.Infof("eraseFileReferences: removed %d file references", fileRefsRemoved)
 	return nil
 }
 
@@ -354,12 +373,23 @@ func (s *dsObjectStore) GetWithLinksInfoByID(id string) (*model.ObjectInfoWithLi
 			return err
 		}
 
+		inboundBlockLinks, err := findInboundBlockLinks(txn, id)
+		if err != nil {
+			return fmt.Errorf("find inbound block links: %w", err)
+		}
+		outboundBlockLinks, err := findOutboundBlockLinks(txn, id)
+		if err != nil {
+			return fmt.Errorf("find outbound block links: %w", err)
+		}
+
 		res = &model.ObjectInfoWithLinks{
 			Id:   id,
 			Info: page,
 			Links: &model.ObjectLinksInfo{
-				Inbound:  inbound,
-				Outbound: outbound,
+				Inbound:            inbound,
+				Outbound:           outbound,
+				InboundBlockLinks:  inboundBlockLinks,
+				OutboundBlockLinks: outboundBlockLinks,
 			},
 		}
 		return nil
@@ -0,0 +1,95 @@
+package objectstore
+
+import (
+	"fmt"
+
+	"github.com/dgraph-io/badger/v3"
+	ds "github.com/ipfs/go-datastore"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+// tasks of the object are stored in a single value in order of the blocks
+var pagesTasksBase = ds.NewKey("/" + pagesPrefix + "/tasks")
+
+type TaskStore interface {
+	// UpdateObjectTasks replaces indexed tasks of the object, empty tasks remove the object from the index
+	UpdateObjectTasks(id string, tasks []*model.Task) error
+	// ListTasks returns tasks of all objects, tasks of the same object go in order of the blocks
+	ListTasks() ([]*model.Task, error)
+	// SubscribeForTasks sets the callback that is called each time tasks of the object are indexed
+	SubscribeForTasks(callback func(objectID string, tasks []*model.Task))
+}
+
+func (s *dsObjectStore) UpdateObjectTasks(id string, tasks []*model.Task) error {
+	key := pagesTasksBase.ChildString(id).Bytes()
+	err := s.updateTxn(func(txn *badger.Txn) error {
+		if len(tasks) == 0 {
+			err := txn.Delete(key)
+			if err != nil && !isNotFound(err) {
+				return err
+			}
+			return nil
+		}
+		return setValueTxn(txn, key, &model.Tasks{Tasks: tasks})
+	})
+	if err != nil {
+		return fmt.Errorf("update tasks: %w", err)
+	}
+	s.notifyTasksChanged(id, tasks)
+	return nil
+}
+
+func (s *dsObjectStore) ListTasks() ([]*model.Task, error) {
+	var tasks []*model.Task
+	err := s.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = []byte(pagesTasksBase.String() + "/")
+		iter := txn.NewIterator(opts)
+		defer iter.Close()
+
+		for iter.Rewind(); iter.Valid(); iter.Next() {
+			err := iter.Item().Value(func(val []byte) error {
+				objectTasks := &model.Tasks{}
+				if err := objectTasks.Unmarshal(val); err != nil {
+					return err
+				}
+				tasks = append(tasks, objectTasks.Tasks...)
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("unmarshal tasks %s: %w", iter.Item().Key(), err)
+			}
+		}
+		return nil
+	})
+	return tasks, err
+}
+
+func (s *dsObjectStore) SubscribeForTasks(callback func(objectID string, tasks []*model.Task)) {
+	s.Lock()
+	s.onTasksChangeCallback = callback
+	s.Unlock()
+}
+
+func (s *dsObjectStore) notifyTasksChanged(id string, tasks []*model.Task) {
+	s.RLock()
+	callback := s.onTasksChangeCallback
+	s.RUnlock()
+	if callback != nil {
+		callback(id, tasks)
+	}
+}
+
+func (s *dsObjectStore) eraseTasks() (removed int, err error) {
+	err = retryOnConflict(func() error {
+		txn := s.db.NewTransaction(true)
+		defer txn.Discard()
+		txn, removed, err = s.removeByPrefixInTx(txn, pagesTasksBase.String()+"/")
+		if err != nil {
+			return fmt.Errorf("remove all tasks: %w", err)
+		}
+		return txn.Commit()
+	})
+	return
+}
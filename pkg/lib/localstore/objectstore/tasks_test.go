@@ -0,0 +1,70 @@
+package objectstore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+func TestDsObjectStore_Tasks(t *testing.T) {
+	task := func(objectID, blockID string) *model.Task {
+		return &model.Task{ObjectId: objectID, BlockId: blockID, Text: "task " + blockID}
+	}
+
+	t.Run("update and list", func(t *testing.T) {
+		s := newStoreFixture(t)
+
+		require.NoError(t, s.UpdateObjectTasks("id2", []*model.Task{task("id2", "b"), task("id2", "a")}))
+		require.NoError(t, s.UpdateObjectTasks("id1", []*model.Task{task("id1", "c")}))
+
+		tasks, err := s.ListTasks()
+		require.NoError(t, err)
+		assert.Equal(t, []*model.Task{task("id1", "c"), task("id2", "b"), task("id2", "a")}, tasks)
+	})
+
+	t.Run("tasks are replaced", func(t *testing.T) {
+		s := newStoreFixture(t)
+
+		require.NoError(t, s.UpdateObjectTasks("id1", []*model.Task{task("id1", "a"), task("id1", "b")}))
+		require.NoError(t, s.UpdateObjectTasks("id1", []*model.Task{task("id1", "c")}))
+
+		tasks, err := s.ListTasks()
+		require.NoError(t, err)
+		assert.Equal(t, []*model.Task{task("id1", "c")}, tasks)
+
+		require.NoError(t, s.UpdateObjectTasks("id1", nil))
+		tasks, err = s.ListTasks()
+		require.NoError(t, err)
+		assert.Empty(t, tasks)
+	})
+
+	t.Run("subscribe for changes", func(t *testing.T) {
+		s := newStoreFixture(t)
+		var changed []string
+		s.SubscribeForTasks(func(objectID string, tasks []*model.Task) {
+			changed = append(changed, objectID)
+		})
+
+		require.NoError(t, s.UpdateObjectTasks("id1", []*model.Task{task("id1", "a")}))
+		require.NoError(t, s.DeleteObject("id1"))
+
+		assert.Equal(t, []string{"id1", "id1"}, changed)
+		tasks, err := s.ListTasks()
+		require.NoError(t, err)
+		assert.Empty(t, tasks)
+	})
+
+	t.Run("erase indexes", func(t *testing.T) {
+		s := newStoreFixture(t)
+
+		require.NoError(t, s.UpdateObjectTasks("id1", []*model.Task{task("id1", "a")}))
+		require.NoError(t, s.EraseIndexes())
+
+		tasks, err := s.ListTasks()
+		require.NoError(t, err)
+		assert.Empty(t, tasks)
+	})
+}
@@ -0,0 +1,224 @@
+package mediainfo
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"time"
+)
+
+var ebmlMagic = []byte{0x1A, 0x45, 0xDF, 0xA3}
+
+// Matroska element IDs, see https://www.matroska.org/technical/elements.html
+const (
+	mkvSegment       = 0x18538067
+	mkvInfo          = 0x1549A966
+	mkvTimecodeScale = 0x2AD7B1
+	mkvDuration      = 0x4489
+	mkvTracks        = 0x1654AE6B
+	mkvTrackEntry    = 0xAE
+	mkvTrackType     = 0x83
+	mkvCodecID       = 0x86
+	mkvVideo         = 0xE0
+	mkvPixelWidth    = 0xB0
+	mkvPixelHeight   = 0xBA
+	mkvCluster       = 0x1F43B675
+
+	mkvTrackTypeVideo = 1
+	mkvTrackTypeAudio = 2
+
+	// maxMkvValue limits the size of the values we read, they are small in practice
+	maxMkvValue = 4096
+)
+
+var errMkvDone = errors.New("matroska metadata is read")
+
+type mkvTrack struct {
+	trackType uint64
+	codecID   string
+	width     int
+	height    int
+}
+
+type mkvParser struct {
+	r             io.ReadSeeker
+	info          *Info
+	timecodeScale uint64
+	duration      float64
+	track         *mkvTrack
+	hasInfo       bool
+	hasTracks     bool
+}
+
+func parseMatroska(r io.ReadSeeker, size int64, info *Info) error {
+	p := &mkvParser{r: r, info: info, timecodeScale: 1000000}
+	if err := p.parseElements(0, size); err != nil && !errors.Is(err, errMkvDone) {
+		return err
+	}
+	info.Duration = time.Duration(p.duration * float64(p.timecodeScale))
+	return nil
+}
+
+func (p *mkvParser) parseElements(start, end int64) error {
+	for offset := start; offset < end; {
+		if _, err := p.r.Seek(offset, io.SeekStart); err != nil {
+			return err
+		}
+		id, idLen, err := readVint(p.r, true)
+		if err != nil {
+			return fmt.Errorf("read element id: %w", err)
+		}
+		size, sizeLen, err := readVint(p.r, false)
+		if err != nil {
+			return fmt.Errorf("read element size: %w", err)
+		}
+		dataStart := offset + int64(idLen+sizeLen)
+		dataEnd := end
+		if size >= 0 && dataStart+size < end {
+			dataEnd = dataStart + size
+		}
+
+		if err = p.parseElement(uint64(id), dataStart, dataEnd); err != nil {
+			return err
+		}
+		if p.hasInfo && p.hasTracks {
+			return errMkvDone
+		}
+		offset = dataEnd
+	}
+	return nil
+}
+
+func (p *mkvParser) parseElement(id uint64, start, end int64) error {
+	switch id {
+	case mkvSegment:
+		return p.parseElements(start, end)
+	case mkvInfo:
+		err := p.parseElements(start, end)
+		p.hasInfo = true
+		return err
+	case mkvTracks:
+		err := p.parseElements(start, end)
+		p.hasTracks = true
+		return err
+	case mkvTrackEntry:
+		p.track = &mkvTrack{}
+		if err := p.parseElements(start, end); err != nil {
+			return err
+		}
+		p.applyTrack()
+		p.track = nil
+		return nil
+	case mkvVideo:
+		return p.parseElements(start, end)
+	case mkvCluster:
+		// media data goes after the metadata we need
+		return errMkvDone
+	case mkvTimecodeScale, mkvDuration, mkvTrackType, mkvCodecID, mkvPixelWidth, mkvPixelHeight:
+	default:
+		return nil
+	}
+
+	if end-start > maxMkvValue {
+		return fmt.Errorf("element %x is too big", id)
+	}
+	data := make([]byte, end-start)
+	if _, err := io.ReadFull(p.r, data); err != nil {
+		return fmt.Errorf("read element %x: %w", id, err)
+	}
+
+	switch id {
+	case mkvTimecodeScale:
+		if scale := readUint(data); scale > 0 {
+			p.timecodeScale = scale
+		}
+	case mkvDuration:
+		switch len(data) {
+		case 4:
+			p.duration = float64(math.Float32frombits(binary.BigEndian.Uint32(data)))
+		case 8:
+			p.duration = math.Float64frombits(binary.BigEndian.Uint64(data))
+		}
+	}
+	if p.track == nil {
+		return nil
+	}
+	switch id {
+	case mkvTrackType:
+		p.track.trackType = readUint(data)
+	case mkvCodecID:
+		p.track.codecID = strings.TrimRight(string(data), "\x00")
+	case mkvPixelWidth:
+		p.track.width = int(readUint(data))
+	case mkvPixelHeight:
+		p.track.height = int(readUint(data))
+	}
+	return nil
+}
+
+// applyTrack fills the info with the first video and the first audio track found
+func (p *mkvParser) applyTrack() {
+	t := p.track
+	switch t.trackType {
+	case mkvTrackTypeVideo:
+		if p.info.VideoCodec == "" {
+			p.info.VideoCodec = codecName(t.codecID)
+			p.info.Width = t.width
+			p.info.Height = t.height
+		}
+	case mkvTrackTypeAudio:
+		if p.info.AudioCodec == "" {
+			codecID := t.codecID
+			// AAC codec ID may contain a profile, e.g. A_AAC/MPEG4/LC
+			if strings.HasPrefix(codecID, "A_AAC") {
+				codecID = "A_AAC"
+			}
+			p.info.AudioCodec = codecName(codecID)
+		}
+	}
+}
+
+// readVint reads EBML variable-length integer. IDs keep their length marker bits, sizes don't.
+// Size with all value bits set means unknown size and is returned as -1
+func readVint(r io.Reader, keepMarker bool) (value int64, length int, err error) {
+	var b [8]byte
+	if _, err = io.ReadFull(r, b[:1]); err != nil {
+		return 0, 0, err
+	}
+	length = 1
+	for mask := byte(0x80); length <= 8 && b[0]&mask == 0; mask >>= 1 {
+		length++
+	}
+	if length > 8 {
+		return 0, 0, errors.New("invalid vint")
+	}
+	if _, err = io.ReadFull(r, b[1:length]); err != nil {
+		return 0, 0, err
+	}
+
+	first := b[0]
+	if !keepMarker {
+		first &= 0xFF >> length
+	}
+	unknown := first == 0xFF>>length
+	value = int64(first)
+	for i := 1; i < length; i++ {
+		value = value<<8 | int64(b[i])
+		unknown = unknown && b[i] == 0xFF
+	}
+	if !keepMarker && unknown {
+		return -1, length, nil
+	}
+	return value, length, nil
+}
+
+func readUint(b []byte) uint64 {
+	var v uint64
+	for _, c := range b {
+		v = v<<8 | uint64(c)
+	}
+	return v
+}
@@ -0,0 +1,104 @@
+// Package mediainfo extracts technical metadata (duration, codecs, resolution, bitrate)
+// from MP4, Matroska/WebM and MP3 files without decoding them
+package mediainfo
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"time"
+)
+
+var ErrUnsupportedFormat = errors.New("unsupported media format")
+
+type Info struct {
+	Duration   time.Duration
+	Width      int
+	Height     int
+	VideoCodec string
+	AudioCodec string
+	// Bitrate is an average bitrate of the whole file in bits per second
+	Bitrate int64
+}
+
+// Parse detects the container format by its signature and reads the metadata from it
+func Parse(r io.ReadSeeker) (*Info, error) {
+	size, err := r.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = r.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	header := make([]byte, 12)
+	n, err := io.ReadFull(r, header)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, err
+	}
+	header = header[:n]
+	if _, err = r.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	info := &Info{}
+	switch {
+	case len(header) >= 8 && bytes.Equal(header[4:8], []byte("ftyp")):
+		err = parseMP4(r, size, info)
+	case bytes.HasPrefix(header, ebmlMagic):
+		err = parseMatroska(r, size, info)
+	case bytes.HasPrefix(header, []byte("ID3")) || isMP3FrameHeader(header):
+		err = parseMP3(r, size, info)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if info.Bitrate == 0 && info.Duration > 0 {
+		info.Bitrate = int64(float64(size*8) / info.Duration.Seconds())
+	}
+	return info, nil
+}
+
+var codecNames = map[string]string{
+	// MP4 sample entry types
+	"avc1": "H.264",
+	"avc3": "H.264",
+	"hvc1": "HEVC",
+	"hev1": "HEVC",
+	"av01": "AV1",
+	"vp08": "VP8",
+	"vp09": "VP9",
+	"mp4v": "MPEG-4",
+	"mp4a": "AAC",
+	"Opus": "Opus",
+	"fLaC": "FLAC",
+	"ac-3": "AC-3",
+	"ec-3": "E-AC-3",
+	".mp3": "MP3",
+	"alac": "ALAC",
+	// Matroska codec IDs
+	"V_MPEG4/ISO/AVC":  "H.264",
+	"V_MPEGH/ISO/HEVC": "HEVC",
+	"V_AV1":            "AV1",
+	"V_VP8":            "VP8",
+	"V_VP9":            "VP9",
+	"V_THEORA":         "Theora",
+	"A_AAC":            "AAC",
+	"A_OPUS":           "Opus",
+	"A_VORBIS":         "Vorbis",
+	"A_FLAC":           "FLAC",
+	"A_MPEG/L3":        "MP3",
+	"A_AC3":            "AC-3",
+	"A_EAC3":           "E-AC-3",
+}
+
+// codecName returns a human-readable name of the codec, or the raw identifier if it is unknown
+func codecName(id string) string {
+	if name, ok := codecNames[id]; ok {
+		return name
+	}
+	return id
+}
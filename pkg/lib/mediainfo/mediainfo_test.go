@@ -0,0 +1,178 @@
+package mediainfo
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mp4Box(boxType string, payload ...[]byte) []byte {
+	data := bytes.Join(payload, nil)
+	b := make([]byte, 8, 8+len(data))
+	binary.BigEndian.PutUint32(b, uint32(8+len(data)))
+	copy(b[4:], boxType)
+	return append(b, data...)
+}
+
+func mp4TrackBox(handler, codec string, width, height uint32) []byte {
+	tkhd := make([]byte, 84)
+	binary.BigEndian.PutUint32(tkhd[76:], width<<16)
+	binary.BigEndian.PutUint32(tkhd[80:], height<<16)
+
+	hdlr := make([]byte, 24)
+	copy(hdlr[8:], handler)
+
+	stsd := make([]byte, 16)
+	binary.BigEndian.PutUint32(stsd[4:], 1)
+	binary.BigEndian.PutUint32(stsd[8:], 8)
+	copy(stsd[12:], codec)
+
+	return mp4Box("trak",
+		mp4Box("tkhd", tkhd),
+		mp4Box("mdia",
+			mp4Box("hdlr", hdlr),
+			mp4Box("minf", mp4Box("stbl", mp4Box("stsd", stsd))),
+		),
+	)
+}
+
+func testMP4() []byte {
+	mvhd := make([]byte, 100)
+	binary.BigEndian.PutUint32(mvhd[12:], 1000)
+	binary.BigEndian.PutUint32(mvhd[16:], 90500)
+
+	return bytes.Join([][]byte{
+		mp4Box("ftyp", []byte("isom\x00\x00\x02\x00")),
+		mp4Box("mdat", make([]byte, 1024)),
+		mp4Box("moov",
+			mp4Box("mvhd", mvhd),
+			mp4TrackBox("vide", "avc1", 1920, 1080),
+			mp4TrackBox("soun", "mp4a", 0, 0),
+		),
+	}, nil)
+}
+
+func ebmlElement(id uint64, data ...[]byte) []byte {
+	payload := bytes.Join(data, nil)
+	var b []byte
+	for shift := 24; shift >= 0; shift -= 8 {
+		if v := byte(id >> shift); v != 0 || len(b) > 0 {
+			b = append(b, v)
+		}
+	}
+	size := make([]byte, 8)
+	binary.BigEndian.PutUint64(size, uint64(len(payload))|1<<56)
+	b = append(b, size...)
+	return append(b, payload...)
+}
+
+func ebmlUint(id uint64, v uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, v)
+	return ebmlElement(id, b)
+}
+
+func testMatroska() []byte {
+	duration := make([]byte, 8)
+	binary.BigEndian.PutUint64(duration, math.Float64bits(12345))
+
+	return bytes.Join([][]byte{
+		ebmlElement(0x1A45DFA3, ebmlElement(0x4282, []byte("webm"))),
+		ebmlElement(mkvSegment,
+			ebmlElement(mkvInfo,
+				ebmlUint(mkvTimecodeScale, 1000000),
+				ebmlElement(mkvDuration, duration),
+			),
+			ebmlElement(mkvTracks,
+				ebmlElement(mkvTrackEntry,
+					ebmlUint(mkvTrackType, mkvTrackTypeVideo),
+					ebmlElement(mkvCodecID, []byte("V_VP9")),
+					ebmlElement(mkvVideo,
+						ebmlUint(mkvPixelWidth, 640),
+						ebmlUint(mkvPixelHeight, 360),
+					),
+				),
+				ebmlElement(mkvTrackEntry,
+					ebmlUint(mkvTrackType, mkvTrackTypeAudio),
+					ebmlElement(mkvCodecID, []byte("A_OPUS")),
+				),
+			),
+			ebmlElement(mkvCluster, make([]byte, 256)),
+		),
+	}, nil)
+}
+
+// testMP3 returns 10 seconds of MPEG1 Layer III 128 kbit/s 44.1 kHz stereo frames after ID3v2 tag
+func testMP3(withXing bool) []byte {
+	frameLen := 144 * 128000 / 44100
+	frame := make([]byte, frameLen)
+	copy(frame, []byte{0xFF, 0xFB, 0x90, 0x00})
+
+	frames := int(10 * 44100 / 1152)
+	data := []byte("ID3\x04\x00\x00\x00\x00\x01\x00")
+	data = append(data, make([]byte, 128)...)
+	for i := 0; i < frames; i++ {
+		f := append([]byte(nil), frame...)
+		if i == 0 && withXing {
+			copy(f[36:], "Xing")
+			binary.BigEndian.PutUint32(f[40:], 1)
+			// pretend the file is twice as long as it is to make sure the header is used
+			binary.BigEndian.PutUint32(f[44:], uint32(frames*2))
+		}
+		data = append(data, f...)
+	}
+	return data
+}
+
+func TestParse(t *testing.T) {
+	t.Run("mp4", func(t *testing.T) {
+		data := testMP4()
+		info, err := Parse(bytes.NewReader(data))
+		require.NoError(t, err)
+
+		assert.Equal(t, 90500*time.Millisecond, info.Duration)
+		assert.Equal(t, "H.264", info.VideoCodec)
+		assert.Equal(t, "AAC", info.AudioCodec)
+		assert.Equal(t, 1920, info.Width)
+		assert.Equal(t, 1080, info.Height)
+		assert.Equal(t, int64(float64(len(data)*8)/90.5), info.Bitrate)
+	})
+
+	t.Run("matroska", func(t *testing.T) {
+		info, err := Parse(bytes.NewReader(testMatroska()))
+		require.NoError(t, err)
+
+		assert.Equal(t, 12345*time.Millisecond, info.Duration)
+		assert.Equal(t, "VP9", info.VideoCodec)
+		assert.Equal(t, "Opus", info.AudioCodec)
+		assert.Equal(t, 640, info.Width)
+		assert.Equal(t, 360, info.Height)
+	})
+
+	t.Run("mp3 cbr", func(t *testing.T) {
+		info, err := Parse(bytes.NewReader(testMP3(false)))
+		require.NoError(t, err)
+
+		assert.Equal(t, "MP3", info.AudioCodec)
+		assert.Equal(t, int64(128000), info.Bitrate)
+		assert.InDelta(t, 10, info.Duration.Seconds(), 0.1)
+	})
+
+	t.Run("mp3 vbr", func(t *testing.T) {
+		info, err := Parse(bytes.NewReader(testMP3(true)))
+		require.NoError(t, err)
+
+		assert.InDelta(t, 20, info.Duration.Seconds(), 0.1)
+		assert.InDelta(t, 64000, info.Bitrate, 1000)
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		_, err := Parse(bytes.NewReader([]byte("just a text file")))
+		assert.ErrorIs(t, err, ErrUnsupportedFormat)
+	})
+}
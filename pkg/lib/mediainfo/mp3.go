@@ -0,0 +1,166 @@
+package mediainfo
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// maxMP3SyncSearch limits how far after the tags we look for the first frame
+const maxMP3SyncSearch = 64 * 1024
+
+var (
+	// bitrates in kbit/s indexed by [version is MPEG1][layer - 1][bitrate index]
+	mp3Bitrates = [2][3][16]int{
+		{ // MPEG2, MPEG2.5
+			{0, 32, 48, 56, 64, 80, 96, 112, 128, 144, 160, 176, 192, 224, 256},
+			{0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160},
+			{0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160},
+		},
+		{ // MPEG1
+			{0, 32, 64, 96, 128, 160, 192, 224, 256, 288, 320, 352, 384, 416, 448},
+			{0, 32, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320, 384},
+			{0, 32, 40, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320},
+		},
+	}
+	mp3SampleRates = [3]int{44100, 48000, 32000}
+)
+
+type mp3Frame struct {
+	mpeg1       bool
+	layer       int
+	bitrate     int // bit/s
+	sampleRate  int
+	mono        bool
+	samples     int
+	sideInfoLen int
+}
+
+func isMP3FrameHeader(b []byte) bool {
+	_, err := parseMP3FrameHeader(b)
+	return err == nil
+}
+
+func parseMP3FrameHeader(b []byte) (*mp3Frame, error) {
+	if len(b) < 4 || b[0] != 0xFF || b[1]&0xE0 != 0xE0 {
+		return nil, errors.New("no frame sync")
+	}
+	version := (b[1] >> 3) & 0x03 // 0: MPEG2.5, 2: MPEG2, 3: MPEG1
+	layerBits := (b[1] >> 1) & 0x03
+	bitrateIdx := b[2] >> 4
+	sampleRateIdx := (b[2] >> 2) & 0x03
+	if version == 1 || layerBits == 0 || bitrateIdx == 0 || bitrateIdx == 15 || sampleRateIdx == 3 {
+		return nil, errors.New("invalid frame header")
+	}
+
+	f := &mp3Frame{
+		mpeg1: version == 3,
+		layer: 4 - int(layerBits),
+		mono:  b[3]>>6 == 3,
+	}
+	versionIdx := 0
+	if f.mpeg1 {
+		versionIdx = 1
+	}
+	f.bitrate = mp3Bitrates[versionIdx][f.layer-1][bitrateIdx] * 1000
+	f.sampleRate = mp3SampleRates[sampleRateIdx]
+	switch version {
+	case 2:
+		f.sampleRate /= 2
+	case 0:
+		f.sampleRate /= 4
+	}
+
+	switch {
+	case f.layer == 1:
+		f.samples = 384
+	case f.layer == 3 && !f.mpeg1:
+		f.samples = 576
+	default:
+		f.samples = 1152
+	}
+
+	switch {
+	case f.mpeg1 && !f.mono:
+		f.sideInfoLen = 32
+	case f.mpeg1 || !f.mono:
+		f.sideInfoLen = 17
+	default:
+		f.sideInfoLen = 9
+	}
+	return f, nil
+}
+
+func parseMP3(r io.ReadSeeker, size int64, info *Info) error {
+	start, err := skipID3v2(r)
+	if err != nil {
+		return err
+	}
+	if _, err = r.Seek(start, io.SeekStart); err != nil {
+		return err
+	}
+
+	buf := make([]byte, maxMP3SyncSearch)
+	n, err := io.ReadFull(r, buf)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return fmt.Errorf("read mp3 frames: %w", err)
+	}
+	buf = buf[:n]
+
+	var frame *mp3Frame
+	for i := 0; i+4 <= len(buf); i++ {
+		if frame, err = parseMP3FrameHeader(buf[i:]); err == nil {
+			buf = buf[i:]
+			start += int64(i)
+			break
+		}
+	}
+	if frame == nil {
+		return errors.New("mp3 frame not found")
+	}
+	info.AudioCodec = fmt.Sprintf("MP%d", frame.layer)
+
+	// VBR files have Xing (or Info) or VBRI header in the first frame with the total number of frames
+	var frames uint32
+	if xing := 4 + frame.sideInfoLen; len(buf) >= xing+12 &&
+		(bytes.Equal(buf[xing:xing+4], []byte("Xing")) || bytes.Equal(buf[xing:xing+4], []byte("Info"))) {
+		if flags := binary.BigEndian.Uint32(buf[xing+4 : xing+8]); flags&0x01 != 0 {
+			frames = binary.BigEndian.Uint32(buf[xing+8 : xing+12])
+		}
+	} else if vbri := 4 + 32; len(buf) >= vbri+18 && bytes.Equal(buf[vbri:vbri+4], []byte("VBRI")) {
+		frames = binary.BigEndian.Uint32(buf[vbri+14 : vbri+18])
+	}
+
+	audioSize := size - start
+	if frames > 0 {
+		seconds := float64(frames) * float64(frame.samples) / float64(frame.sampleRate)
+		info.Duration = time.Duration(seconds * float64(time.Second))
+		info.Bitrate = int64(float64(audioSize*8) / seconds)
+	} else {
+		info.Bitrate = int64(frame.bitrate)
+		info.Duration = time.Duration(float64(audioSize*8) / float64(frame.bitrate) * float64(time.Second))
+	}
+	return nil
+}
+
+// skipID3v2 returns the offset of the data after the ID3v2 tag, if any
+func skipID3v2(r io.ReadSeeker) (int64, error) {
+	header := make([]byte, 10)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return 0, fmt.Errorf("read id3 header: %w", err)
+	}
+	if !bytes.HasPrefix(header, []byte("ID3")) {
+		return 0, nil
+	}
+	// size is a syncsafe integer, 7 bits per byte
+	tagSize := int64(header[6]&0x7F)<<21 | int64(header[7]&0x7F)<<14 | int64(header[8]&0x7F)<<7 | int64(header[9]&0x7F)
+	offset := 10 + tagSize
+	if header[5]&0x10 != 0 {
+		// footer is present
+		offset += 10
+	}
+	return offset, nil
+}
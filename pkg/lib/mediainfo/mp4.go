@@ -0,0 +1,165 @@
+package mediainfo
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// maxMP4LeafBox limits the amount of data read from the boxes we parse, they are small in practice
+const maxMP4LeafBox = 4096
+
+type mp4Track struct {
+	handler string
+	codec   string
+	width   int
+	height  int
+}
+
+type mp4Parser struct {
+	r     io.ReadSeeker
+	info  *Info
+	track *mp4Track
+}
+
+func parseMP4(r io.ReadSeeker, size int64, info *Info) error {
+	p := &mp4Parser{r: r, info: info}
+	return p.parseBoxes(0, size)
+}
+
+// parseBoxes walks the boxes in [start, end) range descending into the containers we are interested in
+func (p *mp4Parser) parseBoxes(start, end int64) error {
+	for offset := start; offset+8 <= end; {
+		if _, err := p.r.Seek(offset, io.SeekStart); err != nil {
+			return err
+		}
+		var header [16]byte
+		if _, err := io.ReadFull(p.r, header[:8]); err != nil {
+			return fmt.Errorf("read box header: %w", err)
+		}
+		boxSize := int64(binary.BigEndian.Uint32(header[:4]))
+		boxType := string(header[4:8])
+		headerSize := int64(8)
+		switch boxSize {
+		case 0:
+			boxSize = end - offset
+		case 1:
+			if _, err := io.ReadFull(p.r, header[8:16]); err != nil {
+				return fmt.Errorf("read box size: %w", err)
+			}
+			boxSize = int64(binary.BigEndian.Uint64(header[8:16]))
+			headerSize = 16
+		}
+		if boxSize < headerSize || offset+boxSize > end {
+			return fmt.Errorf("invalid size of box %q", boxType)
+		}
+
+		if err := p.parseBox(boxType, offset+headerSize, offset+boxSize); err != nil {
+			return err
+		}
+		offset += boxSize
+	}
+	return nil
+}
+
+func (p *mp4Parser) parseBox(boxType string, start, end int64) error {
+	switch boxType {
+	case "moov", "mdia", "minf", "stbl":
+		return p.parseBoxes(start, end)
+	case "trak":
+		p.track = &mp4Track{}
+		if err := p.parseBoxes(start, end); err != nil {
+			return err
+		}
+		p.applyTrack()
+		p.track = nil
+		return nil
+	case "mvhd", "tkhd", "hdlr", "stsd":
+	default:
+		return nil
+	}
+
+	payloadSize := end - start
+	if payloadSize > maxMP4LeafBox {
+		payloadSize = maxMP4LeafBox
+	}
+	payload := make([]byte, payloadSize)
+	if _, err := io.ReadFull(p.r, payload); err != nil {
+		return fmt.Errorf("read %s: %w", boxType, err)
+	}
+
+	var err error
+	switch boxType {
+	case "mvhd":
+		err = p.parseMvhd(payload)
+	case "tkhd":
+		err = p.parseTkhd(payload)
+	case "hdlr":
+		if p.track != nil && len(payload) >= 12 {
+			p.track.handler = string(payload[8:12])
+		}
+	case "stsd":
+		// the first sample entry type defines the codec
+		if p.track != nil && len(payload) >= 16 {
+			p.track.codec = string(payload[12:16])
+		}
+	}
+	return err
+}
+
+func (p *mp4Parser) parseMvhd(b []byte) error {
+	var timescale, duration uint64
+	switch {
+	case len(b) >= 20 && b[0] == 0:
+		timescale = uint64(binary.BigEndian.Uint32(b[12:16]))
+		duration = uint64(binary.BigEndian.Uint32(b[16:20]))
+	case len(b) >= 32 && b[0] == 1:
+		timescale = uint64(binary.BigEndian.Uint32(b[20:24]))
+		duration = binary.BigEndian.Uint64(b[24:32])
+	default:
+		return errors.New("invalid mvhd box")
+	}
+	if timescale > 0 {
+		p.info.Duration = time.Duration(float64(duration) / float64(timescale) * float64(time.Second))
+	}
+	return nil
+}
+
+func (p *mp4Parser) parseTkhd(b []byte) error {
+	if p.track == nil {
+		return nil
+	}
+	// width and height are 16.16 fixed-point numbers at the end of the box
+	if len(b) < 84 {
+		return errors.New("invalid tkhd box")
+	}
+	offset := 76
+	if b[0] == 1 {
+		offset = 88
+	}
+	if len(b) < offset+8 {
+		return errors.New("invalid tkhd box")
+	}
+	p.track.width = int(binary.BigEndian.Uint32(b[offset:offset+4]) >> 16)
+	p.track.height = int(binary.BigEndian.Uint32(b[offset+4:offset+8]) >> 16)
+	return nil
+}
+
+// applyTrack fills the info with the first video and the first audio track found
+func (p *mp4Parser) applyTrack() {
+	t := p.track
+	switch t.handler {
+	case "vide":
+		if p.info.VideoCodec == "" {
+			p.info.VideoCodec = codecName(t.codec)
+			p.info.Width = t.width
+			p.info.Height = t.height
+		}
+	case "soun":
+		if p.info.AudioCodec == "" {
+			p.info.AudioCodec = codecName(t.codec)
+		}
+	}
+}
@@ -0,0 +1,221 @@
+package mill
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+
+	"github.com/disintegration/imaging"
+	"github.com/dsoprea/go-exif/v3"
+	exifcommon "github.com/dsoprea/go-exif/v3/common"
+	jpegstructure "github.com/dsoprea/go-jpeg-image-structure/v2"
+)
+
+const editJPEGQuality = 95
+
+type ImageEditOpts struct {
+	// StripExif removes all the EXIF metadata
+	StripExif bool
+	// StripLocation removes only the GPS data from EXIF
+	StripLocation bool
+	// Rotate is a clockwise rotation in degrees, must be a multiple of 90
+	Rotate int
+	// Crop is applied after the rotation, empty rectangle means no crop
+	Crop image.Rectangle
+	// Format to convert the image to, empty value keeps the original format
+	Format Format
+}
+
+func (o ImageEditOpts) IsEmpty() bool {
+	return !o.StripExif && !o.StripLocation && !o.changesPixels()
+}
+
+func (o ImageEditOpts) changesPixels() bool {
+	return o.Rotate%360 != 0 || !o.Crop.Empty() || o.Format != ""
+}
+
+// ImageEdit applies the edit options to the image and returns the resulting image along with its format.
+// Metadata-only edits of JPEG images are lossless, all other edits re-encode the image and drop its metadata
+func ImageEdit(r io.ReadSeeker, opts ImageEditOpts) (io.ReadSeeker, Format, error) {
+	if opts.Rotate%90 != 0 {
+		return nil, "", fmt.Errorf("invalid rotation %d: must be a multiple of 90", opts.Rotate)
+	}
+	_, formatStr, err := image.DecodeConfig(r)
+	if err != nil {
+		return nil, "", err
+	}
+	format := Format(formatStr)
+	if _, err = r.Seek(0, io.SeekStart); err != nil {
+		return nil, "", err
+	}
+
+	if opts.IsEmpty() {
+		return r, format, nil
+	}
+
+	if !opts.changesPixels() || (opts.Format == format && opts.Rotate%360 == 0 && opts.Crop.Empty()) {
+		return stripMetadata(r, format, opts)
+	}
+	return reencodeImage(r, format, opts)
+}
+
+func stripMetadata(r io.ReadSeeker, format Format, opts ImageEditOpts) (io.ReadSeeker, Format, error) {
+	switch format {
+	case JPEG:
+		if opts.StripExif {
+			orientation, err := jpegOrientation(r)
+			if err != nil {
+				return nil, "", err
+			}
+			if orientation > 1 {
+				// orientation is stored in EXIF, so the pixels have to be rotated before EXIF is removed
+				return reencodeImage(r, format, opts)
+			}
+		}
+		out, err := stripJpegExif(r, opts.StripExif)
+		if err != nil {
+			return nil, "", err
+		}
+		return out, format, nil
+	case PNG:
+		// png can carry EXIF in a separate chunk, lossless re-encoding drops it
+		return reencodeImage(r, format, opts)
+	default:
+		// other formats we support don't carry the location
+		return r, format, nil
+	}
+}
+
+func reencodeImage(r io.ReadSeeker, format Format, opts ImageEditOpts) (io.ReadSeeker, Format, error) {
+	img, err := decodeOriented(r, format)
+	if err != nil {
+		return nil, "", err
+	}
+
+	switch (opts.Rotate%360 + 360) % 360 {
+	case 90:
+		img = imaging.Rotate270(img)
+	case 180:
+		img = imaging.Rotate180(img)
+	case 270:
+		img = imaging.Rotate90(img)
+	}
+
+	if !opts.Crop.Empty() {
+		crop := opts.Crop.Intersect(img.Bounds())
+		if crop.Empty() {
+			return nil, "", fmt.Errorf("crop rectangle %v is out of the image bounds %v", opts.Crop, img.Bounds())
+		}
+		img = imaging.Crop(img, crop)
+	}
+
+	target := opts.Format
+	if target == "" {
+		target = format
+	}
+	buf := &bytes.Buffer{}
+	switch target {
+	case JPEG:
+		err = jpeg.Encode(buf, img, &jpeg.Options{Quality: editJPEGQuality})
+	case PNG:
+		err = png.Encode(buf, img)
+	case GIF:
+		err = gif.Encode(buf, img, nil)
+	default:
+		// formats we can't encode to, e.g. HEIC or WEBP, are converted to JPEG
+		target = JPEG
+		err = jpeg.Encode(buf, img, &jpeg.Options{Quality: editJPEGQuality})
+	}
+	if err != nil {
+		return nil, "", fmt.Errorf("encode %s: %w", target, err)
+	}
+	return bytes.NewReader(buf.Bytes()), target, nil
+}
+
+// decodeOriented decodes the image applying the EXIF orientation, because it will be lost after re-encoding
+func decodeOriented(r io.ReadSeeker, format Format) (image.Image, error) {
+	var orientation int
+	if format == JPEG {
+		var err error
+		if orientation, err = jpegOrientation(r); err != nil {
+			return nil, err
+		}
+	}
+
+	img, _, err := image.Decode(r)
+	if err != nil {
+		return nil, err
+	}
+	if orientation > 1 {
+		img = reverseOrientation(img, orientation)
+	}
+	return img, nil
+}
+
+func jpegOrientation(r io.ReadSeeker) (orientation int, err error) {
+	exifData, err := getExifData(r)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get exif data %s", err.Error())
+	}
+	if exifData != nil {
+		orientation, err = getJpegOrientation(exifData)
+		if err != nil {
+			return 0, fmt.Errorf("failed to get jpeg orientation: %s", err.Error())
+		}
+	}
+	_, err = r.Seek(0, io.SeekStart)
+	return orientation, err
+}
+
+func stripJpegExif(r io.ReadSeeker, all bool) (io.ReadSeeker, error) {
+	if all {
+		out, err := patchReaderRemoveExif(r)
+		if err != nil {
+			return nil, err
+		}
+		b, err := io.ReadAll(out)
+		if err != nil {
+			return nil, err
+		}
+		return bytes.NewReader(b), nil
+	}
+
+	size, err := r.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = r.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	intfc, err := jpegstructure.NewJpegMediaParser().Parse(r, int(size))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file to read exif: %s", err.Error())
+	}
+	sl := intfc.(*jpegstructure.SegmentList)
+
+	if _, _, err = sl.FindExif(); errors.Is(err, exif.ErrNoExif) {
+		_, err = r.Seek(0, io.SeekStart)
+		return r, err
+	}
+	rootIb, err := sl.ConstructExifBuilder()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read exif: %w", err)
+	}
+	if _, err = rootIb.DeleteAll(exifcommon.IfdGpsInfoStandardIfdIdentity.TagId()); err != nil {
+		return nil, fmt.Errorf("failed to remove gps info: %w", err)
+	}
+	if err = sl.SetExif(rootIb); err != nil {
+		return nil, fmt.Errorf("failed to set exif: %w", err)
+	}
+
+	buf := &bytes.Buffer{}
+	if err = sl.Write(buf); err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(buf.Bytes()), nil
+}
@@ -0,0 +1,94 @@
+package mill
+
+import (
+	"image"
+	"os"
+	"testing"
+
+	"github.com/rwcarlsen/goexif/exif"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func editTestImage(t *testing.T, path string, opts ImageEditOpts) (image.Config, Format) {
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	t.Cleanup(func() { file.Close() })
+
+	out, format, err := ImageEdit(file, opts)
+	require.NoError(t, err)
+
+	cfg, formatStr, err := image.DecodeConfig(out)
+	require.NoError(t, err)
+	assert.Equal(t, format, Format(formatStr))
+	return cfg, format
+}
+
+func TestImageEdit(t *testing.T) {
+	t.Run("rotate", func(t *testing.T) {
+		cfg, format := editTestImage(t, "testdata/image.gif", ImageEditOpts{Rotate: 90})
+		assert.Equal(t, GIF, format)
+		assert.Equal(t, 187, cfg.Width)
+		assert.Equal(t, 300, cfg.Height)
+	})
+
+	t.Run("crop after rotation", func(t *testing.T) {
+		cfg, format := editTestImage(t, "testdata/image.jpeg", ImageEditOpts{
+			Rotate: -90,
+			Crop:   image.Rect(10, 20, 110, 70),
+		})
+		assert.Equal(t, JPEG, format)
+		assert.Equal(t, 100, cfg.Width)
+		assert.Equal(t, 50, cfg.Height)
+	})
+
+	t.Run("convert format", func(t *testing.T) {
+		cfg, format := editTestImage(t, "testdata/image.png", ImageEditOpts{Format: JPEG})
+		assert.Equal(t, JPEG, format)
+		assert.Equal(t, 300, cfg.Width)
+	})
+
+	t.Run("strip exif keeps orientation", func(t *testing.T) {
+		file, err := os.Open("testdata/Landscape_8.jpg")
+		require.NoError(t, err)
+		defer file.Close()
+
+		out, format, err := ImageEdit(file, ImageEditOpts{StripExif: true})
+		require.NoError(t, err)
+		assert.Equal(t, JPEG, format)
+
+		exifData, err := getExifData(out)
+		require.NoError(t, err)
+		assert.Nil(t, exifData)
+
+		_, err = out.Seek(0, 0)
+		require.NoError(t, err)
+		cfg, _, err := image.DecodeConfig(out)
+		require.NoError(t, err)
+		assert.Equal(t, 1800, cfg.Width)
+		assert.Equal(t, 1200, cfg.Height)
+	})
+
+	t.Run("strip location keeps other exif", func(t *testing.T) {
+		file, err := os.Open("testdata/image-no-orientation.jpg")
+		require.NoError(t, err)
+		defer file.Close()
+
+		out, _, err := ImageEdit(file, ImageEditOpts{StripLocation: true})
+		require.NoError(t, err)
+
+		exf, err := exif.Decode(out)
+		require.NoError(t, err)
+		_, _, err = exf.LatLong()
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid rotation", func(t *testing.T) {
+		file, err := os.Open("testdata/image.png")
+		require.NoError(t, err)
+		defer file.Close()
+
+		_, _, err = ImageEdit(file, ImageEditOpts{Rotate: 45})
+		assert.Error(t, err)
+	})
+}
@@ -212,8 +212,10 @@ func (m *ObjectLinks) GetOutboundIDs() []string {
 }
 
 type ObjectLinksInfo struct {
-	Inbound  []*ObjectInfo `protobuf:"bytes,1,rep,name=inbound,proto3" json:"inbound,omitempty"`
-	Outbound []*ObjectInfo `protobuf:"bytes,2,rep,name=outbound,proto3" json:"outbound,omitempty"`
+	Inbound            []*ObjectInfo `protobuf:"bytes,1,rep,name=inbound,proto3" json:"inbound,omitempty"`
+	Outbound           []*ObjectInfo `protobuf:"bytes,2,rep,name=outbound,proto3" json:"outbound,omitempty"`
+	InboundBlockLinks  []*BlockLink  `protobuf:"bytes,3,rep,name=inboundBlockLinks,proto3" json:"inboundBlockLinks,omitempty"`
+	OutboundBlockLinks []*BlockLink  `protobuf:"bytes,4,rep,name=outboundBlockLinks,proto3" json:"outboundBlockLinks,omitempty"`
 }
 
 func (m *ObjectLinksInfo) Reset()         { *m = ObjectLinksInfo{} }
@@ -263,6 +265,20 @@ func (m *ObjectLinksInfo) GetOutbound() []*ObjectInfo {
 	return nil
 }
 
+func (m *ObjectLinksInfo) GetInboundBlockLinks() []*BlockLink {
+	if m != nil {
+		return m.InboundBlockLinks
+	}
+	return nil
+}
+
+func (m *ObjectLinksInfo) GetOutboundBlockLinks() []*BlockLink {
+	if m != nil {
+		return m.OutboundBlockLinks
+	}
+	return nil
+}
+
 type ObjectInfoWithLinks struct {
 	Id    string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Info  *ObjectInfo      `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
@@ -575,50 +591,52 @@ func init() {
 }
 
 var fileDescriptor_9c35df71910469a5 = []byte{
-	// 680 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcb, 0x6e, 0xd3, 0x40,
-	0x14, 0xad, 0xf3, 0x68, 0x9a, 0x1b, 0xf5, 0xc1, 0x80, 0xc4, 0x50, 0xc0, 0xb2, 0xa2, 0x0a, 0x59,
-	0x15, 0x24, 0xa2, 0xa5, 0x1b, 0x24, 0x40, 0x6a, 0x2b, 0xa4, 0x42, 0xa5, 0x48, 0xd3, 0x22, 0x24,
-	0x76, 0x76, 0x3c, 0x69, 0x87, 0x4c, 0x3c, 0x96, 0x67, 0x2c, 0x35, 0x0b, 0x96, 0x6c, 0x58, 0x20,
-	0x7e, 0x80, 0x8f, 0xe0, 0x2f, 0x58, 0x76, 0xc9, 0x12, 0xb5, 0x3f, 0x82, 0x3c, 0xe3, 0x24, 0x8e,
-	0x9b, 0xa6, 0x48, 0xb0, 0xf4, 0xb9, 0xe7, 0x1e, 0x9f, 0x73, 0xef, 0x78, 0x0c, 0x6e, 0xd4, 0x3f,
-	0x69, 0x73, 0xe6, 0xb7, 0x23, 0xbf, 0x3d, 0x10, 0x01, 0xe5, 0xed, 0x28, 0x16, 0x4a, 0xc8, 0x36,
-	0x17, 0x5d, 0x8f, 0x4b, 0x25, 0x62, 0xda, 0xd2, 0x08, 0x5a, 0xf6, 0xc2, 0xa1, 0x1a, 0x46, 0xb4,
-	0xa5, 0x69, 0xeb, 0x0f, 0x4e, 0x84, 0x38, 0xe1, 0xd4, 0xd0, 0xfd, 0xa4, 0xd7, 0x96, 0x2a, 0x4e,
-	0xba, 0xca, 0x90, 0xd7, 0x37, 0xae, 0x93, 0xd5, 0x0f, 0xd2, 0xb0, 0x9a, 0x3f, 0x4a, 0x00, 0x1d,
-	0xff, 0x23, 0xed, 0xaa, 0x83, 0xb0, 0x27, 0xd0, 0x0a, 0x94, 0x58, 0x80, 0x2d, 0xc7, 0x72, 0xeb,
-	0xa4, 0xc4, 0x02, 0xf4, 0x08, 0x56, 0x84, 0xae, 0x1e, 0x0f, 0x23, 0xfa, 0x2e, 0xe6, 0x12, 0x97,
-	0x9c, 0xb2, 0x5b, 0x27, 0x05, 0x14, 0x3d, 0x85, 0x5a, 0x40, 0x95, 0xc7, 0xb8, 0xc4, 0x65, 0xc7,
-	0x72, 0x1b, 0x5b, 0x77, 0x5b, 0xc6, 0x5c, 0x6b, 0x64, 0xae, 0x75, 0xa4, 0xcd, 0x91, 0x11, 0x0f,
-	0xed, 0x40, 0x3d, 0xa6, 0xdc, 0x53, 0x4c, 0x84, 0x12, 0x57, 0x9c, 0xb2, 0x6e, 0x9a, 0x0a, 0xd8,
-	0x22, 0x59, 0x9d, 0x4c, 0x98, 0x08, 0x43, 0x4d, 0x86, 0x2c, 0x8a, 0xa8, 0xc2, 0x55, 0x6d, 0x73,
-	0xf4, 0x88, 0x5c, 0x58, 0x3d, 0xf5, 0xe4, 0x41, 0xe8, 0x8b, 0x24, 0x0c, 0x0e, 0x59, 0xd8, 0x97,
-	0x78, 0xd1, 0xb1, 0xdc, 0x25, 0x52, 0x84, 0xd1, 0x0b, 0x80, 0x89, 0x7f, 0x5c, 0x73, 0x2c, 0x77,
-	0x65, 0xeb, 0x61, 0xe1, 0xdd, 0x47, 0x03, 0x2f, 0x56, 0xbb, 0x5c, 0x74, 0xfb, 0x29, 0x89, 0xe4,
-	0x1a, 0x9a, 0xbb, 0xb0, 0x6c, 0x46, 0xb6, 0x9f, 0x45, 0xc9, 0xa5, 0xb7, 0xfe, 0x2e, 0x7d, 0xb3,
-	0x03, 0x0d, 0xa3, 0x61, 0x1c, 0xd9, 0x00, 0xcc, 0x38, 0x3c, 0xd8, 0x4f, 0x45, 0xd2, 0x19, 0xe7,
-	0x10, 0xe4, 0x40, 0x43, 0x24, 0x6a, 0x4c, 0x30, 0x4b, 0xc8, 0x43, 0xcd, 0x4f, 0xb0, 0x9a, 0x13,
-	0xd4, 0xcb, 0xdc, 0x86, 0x5a, 0x26, 0xa1, 0x15, 0x1b, 0x5b, 0xf7, 0x0a, 0x19, 0x27, 0x8b, 0x27,
-	0x23, 0x26, 0xda, 0x81, 0xa5, 0x91, 0xac, 0x7e, 0xcd, 0xdc, 0xae, 0x31, 0xb5, 0xf9, 0xc5, 0x82,
-	0xdb, 0x93, 0xc2, 0x7b, 0xa6, 0x4e, 0x4d, 0xb0, 0xe2, 0x81, 0x7a, 0x02, 0x15, 0x16, 0xf6, 0x04,
-	0x2e, 0xe9, 0x39, 0xcd, 0x91, 0xd6, 0x34, 0xf4, 0x0c, 0xaa, 0x5c, 0x6f, 0xd2, 0x9c, 0x2a, 0x7b,
-	0x26, 0x7f, 0x9c, 0x98, 0x18, 0x72, 0xf3, 0xbb, 0x05, 0xf7, 0xa7, 0xcd, 0x74, 0x32, 0x9f, 0xff,
-	0xc5, 0xd4, 0x2b, 0x58, 0x16, 0x79, 0x3d, 0x5c, 0xbe, 0x69, 0x4e, 0xd3, 0xfc, 0xe6, 0x67, 0x0b,
-	0xec, 0x39, 0xfe, 0xd2, 0x85, 0xff, 0xa3, 0xc5, 0x8d, 0x59, 0x16, 0xeb, 0x45, 0x1f, 0x5f, 0x2b,
-	0x70, 0xc7, 0xb4, 0x1e, 0xa5, 0xb7, 0xcc, 0xde, 0x29, 0xed, 0xf6, 0x65, 0x32, 0x90, 0xa8, 0x05,
-	0xc8, 0x4f, 0xc2, 0x80, 0xd3, 0xa0, 0x33, 0x3e, 0xf6, 0x32, 0x73, 0x33, 0xa3, 0x82, 0x36, 0x61,
-	0x2d, 0x43, 0xc9, 0xf8, 0x93, 0x2e, 0x69, 0xf6, 0x15, 0x3c, 0xbd, 0x52, 0x32, 0xec, 0xd0, 0x1b,
-	0x8a, 0x44, 0x99, 0xdd, 0xd6, 0x49, 0x01, 0x45, 0x2f, 0x61, 0xdd, 0x7c, 0x73, 0xf2, 0xb5, 0x88,
-	0xbb, 0x94, 0x50, 0x16, 0x06, 0xf4, 0x6c, 0x4f, 0x24, 0xa1, 0xa2, 0x31, 0xae, 0x38, 0x96, 0x5b,
-	0x25, 0x73, 0x18, 0xe8, 0x39, 0xe0, 0x1e, 0xe3, 0x74, 0x66, 0x77, 0x55, 0x77, 0x5f, 0x5b, 0x47,
-	0x8f, 0xe1, 0x16, 0x0b, 0xce, 0x08, 0xf5, 0x13, 0xc6, 0x83, 0x51, 0xd3, 0xa2, 0x6e, 0xba, 0x5a,
-	0x48, 0x2f, 0x9e, 0x5e, 0xc2, 0xb9, 0xa2, 0x67, 0x2a, 0xab, 0xe8, 0x3b, 0xa5, 0x4a, 0x8a, 0x70,
-	0x6e, 0x4e, 0xc7, 0x74, 0x10, 0x71, 0x4f, 0x51, 0x89, 0x97, 0xa6, 0xe6, 0x34, 0xc6, 0x73, 0x73,
-	0x32, 0x93, 0x96, 0xb8, 0xae, 0x45, 0x0b, 0x28, 0x7a, 0x03, 0x8e, 0xce, 0x91, 0x6e, 0xf0, 0x2d,
-	0x1d, 0xce, 0xcc, 0x0b, 0xba, 0xf3, 0x46, 0xde, 0xee, 0xe6, 0xcf, 0x0b, 0xdb, 0x3a, 0xbf, 0xb0,
-	0xad, 0xdf, 0x17, 0xb6, 0xf5, 0xed, 0xd2, 0x5e, 0x38, 0xbf, 0xb4, 0x17, 0x7e, 0x5d, 0xda, 0x0b,
-	0x1f, 0xd6, 0x8a, 0x7f, 0x13, 0x7f, 0x51, 0xdf, 0x6d, 0xdb, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0x75, 0xdd, 0x09, 0x69, 0xbf, 0x06, 0x00, 0x00,
+	// 713 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcd, 0x6e, 0xd3, 0x4a,
+	0x14, 0xae, 0xf3, 0xd3, 0x34, 0x27, 0xea, 0xdf, 0xdc, 0x2b, 0xdd, 0xb9, 0xbd, 0x17, 0xcb, 0x8a,
+	0x2a, 0x64, 0x55, 0x90, 0x88, 0x96, 0x6e, 0x90, 0x00, 0xa9, 0xad, 0x2a, 0x0a, 0x95, 0x22, 0x4d,
+	0x8b, 0x90, 0xd8, 0xd9, 0xf1, 0xa4, 0x1d, 0x32, 0xf1, 0x58, 0x9e, 0xb1, 0xd4, 0x3c, 0x00, 0x1b,
+	0x16, 0xc0, 0x0b, 0xf0, 0x10, 0xbc, 0x05, 0xcb, 0x2e, 0x59, 0xa2, 0xf6, 0x45, 0x90, 0x67, 0x9c,
+	0xc4, 0x71, 0xd3, 0x14, 0x09, 0x96, 0xfe, 0xce, 0xf7, 0x7d, 0xfe, 0xce, 0x39, 0xce, 0x09, 0xb8,
+	0x51, 0xff, 0xac, 0xcd, 0x99, 0xdf, 0x8e, 0xfc, 0xf6, 0x40, 0x04, 0x94, 0xb7, 0xa3, 0x58, 0x28,
+	0x21, 0xdb, 0x5c, 0x74, 0x3d, 0x2e, 0x95, 0x88, 0x69, 0x4b, 0x23, 0x68, 0xd9, 0x0b, 0x87, 0x6a,
+	0x18, 0xd1, 0x96, 0xa6, 0x6d, 0xfc, 0x7f, 0x26, 0xc4, 0x19, 0xa7, 0x86, 0xee, 0x27, 0xbd, 0xb6,
+	0x54, 0x71, 0xd2, 0x55, 0x86, 0xbc, 0xb1, 0x79, 0x9b, 0xad, 0x7e, 0x90, 0x86, 0xd5, 0xfc, 0x5a,
+	0x02, 0xe8, 0xf8, 0xef, 0x68, 0x57, 0x1d, 0x85, 0x3d, 0x81, 0x56, 0xa0, 0xc4, 0x02, 0x6c, 0x39,
+	0x96, 0x5b, 0x27, 0x25, 0x16, 0xa0, 0xfb, 0xb0, 0x22, 0x74, 0xf5, 0x74, 0x18, 0xd1, 0xd7, 0x31,
+	0x97, 0xb8, 0xe4, 0x94, 0xdd, 0x3a, 0x29, 0xa0, 0xe8, 0x11, 0xd4, 0x02, 0xaa, 0x3c, 0xc6, 0x25,
+	0x2e, 0x3b, 0x96, 0xdb, 0xd8, 0xfe, 0xa7, 0x65, 0xc2, 0xb5, 0x46, 0xe1, 0x5a, 0x27, 0x3a, 0x1c,
+	0x19, 0xf1, 0xd0, 0x2e, 0xd4, 0x63, 0xca, 0x3d, 0xc5, 0x44, 0x28, 0x71, 0xc5, 0x29, 0x6b, 0xd1,
+	0x54, 0x83, 0x2d, 0x92, 0xd5, 0xc9, 0x84, 0x89, 0x30, 0xd4, 0x64, 0xc8, 0xa2, 0x88, 0x2a, 0x5c,
+	0xd5, 0x31, 0x47, 0x8f, 0xc8, 0x85, 0xd5, 0x73, 0x4f, 0x1e, 0x85, 0xbe, 0x48, 0xc2, 0xe0, 0x98,
+	0x85, 0x7d, 0x89, 0x17, 0x1d, 0xcb, 0x5d, 0x22, 0x45, 0x18, 0x3d, 0x05, 0x98, 0xe4, 0xc7, 0x35,
+	0xc7, 0x72, 0x57, 0xb6, 0xef, 0x15, 0xde, 0x7d, 0x32, 0xf0, 0x62, 0xb5, 0xc7, 0x45, 0xb7, 0x9f,
+	0x92, 0x48, 0x4e, 0xd0, 0xdc, 0x83, 0x65, 0x33, 0xb2, 0x83, 0xac, 0x95, 0x5c, 0xf7, 0xd6, 0xaf,
+	0x75, 0xdf, 0xec, 0x40, 0xc3, 0x78, 0x98, 0x44, 0x36, 0x00, 0x33, 0x09, 0x8f, 0x0e, 0x52, 0x93,
+	0x74, 0xc6, 0x39, 0x04, 0x39, 0xd0, 0x10, 0x89, 0x1a, 0x13, 0xcc, 0x12, 0xf2, 0x50, 0xf3, 0x53,
+	0x09, 0x56, 0x73, 0x8e, 0x7a, 0x9b, 0x3b, 0x50, 0xcb, 0x3c, 0xb4, 0x65, 0x63, 0xfb, 0xdf, 0x42,
+	0x93, 0x93, 0xcd, 0x93, 0x11, 0x13, 0xed, 0xc2, 0xd2, 0xc8, 0x57, 0xbf, 0x67, 0xae, 0x6a, 0x4c,
+	0x45, 0x87, 0xb0, 0x9e, 0x39, 0xe8, 0xa1, 0x99, 0xf9, 0x97, 0xb5, 0x1e, 0x17, 0xf4, 0x63, 0x02,
+	0xb9, 0x29, 0x41, 0x2f, 0x00, 0x8d, 0x3c, 0x73, 0x46, 0x95, 0x3b, 0x8c, 0x66, 0x68, 0x9a, 0x1f,
+	0x2c, 0xf8, 0x6b, 0x12, 0xf5, 0x0d, 0x53, 0xe7, 0xe6, 0x0d, 0xc5, 0x6f, 0xfc, 0x21, 0x54, 0x58,
+	0xd8, 0x13, 0xb8, 0xa4, 0x57, 0x37, 0xa7, 0x59, 0x4d, 0x43, 0x8f, 0xa1, 0xca, 0xb3, 0xe6, 0x52,
+	0xbe, 0x3d, 0x93, 0x3f, 0xde, 0x01, 0x31, 0xe4, 0xe6, 0x17, 0x0b, 0xfe, 0x9b, 0x0e, 0xd3, 0xc9,
+	0x12, 0xff, 0x91, 0x50, 0xcf, 0x61, 0x59, 0xe4, 0xfd, 0xb2, 0xc9, 0xcf, 0xd1, 0x4d, 0xf3, 0x9b,
+	0xef, 0x2d, 0xb0, 0xe7, 0xe4, 0x4b, 0xbf, 0xc1, 0xdf, 0x8c, 0xb8, 0x39, 0x2b, 0x62, 0xbd, 0x98,
+	0xe3, 0x63, 0x05, 0xfe, 0x36, 0xd2, 0x93, 0xf4, 0xf0, 0xed, 0x9f, 0xd3, 0x6e, 0x5f, 0x26, 0x03,
+	0x89, 0x5a, 0x80, 0xfc, 0x24, 0x0c, 0x38, 0x0d, 0x3a, 0xe3, 0x5f, 0xa2, 0xcc, 0xd2, 0xcc, 0xa8,
+	0xa0, 0x2d, 0x58, 0xcb, 0x50, 0x32, 0xbe, 0x32, 0x25, 0xcd, 0xbe, 0x81, 0xa7, 0x57, 0x2e, 0xc3,
+	0x8e, 0xbd, 0xa1, 0x48, 0x94, 0xd9, 0x6d, 0x9d, 0x14, 0x50, 0xf4, 0x0c, 0x36, 0xcc, 0x19, 0x90,
+	0x87, 0x22, 0xee, 0x52, 0x42, 0x59, 0x18, 0xd0, 0x8b, 0x7d, 0x91, 0x84, 0x8a, 0xc6, 0xb8, 0xe2,
+	0x58, 0x6e, 0x95, 0xcc, 0x61, 0xa0, 0x27, 0x80, 0x7b, 0x8c, 0xd3, 0x99, 0xea, 0xaa, 0x56, 0xdf,
+	0x5a, 0x47, 0x0f, 0x60, 0x9d, 0x05, 0x17, 0x84, 0xfa, 0x09, 0xe3, 0xc1, 0x48, 0xb4, 0xa8, 0x45,
+	0x37, 0x0b, 0xe9, 0x2d, 0xec, 0x25, 0x9c, 0x2b, 0x7a, 0xa1, 0xb2, 0x8a, 0x3e, 0x73, 0x55, 0x52,
+	0x84, 0x73, 0x73, 0x3a, 0xa5, 0x83, 0x88, 0x7b, 0x8a, 0x4a, 0xbc, 0x34, 0x35, 0xa7, 0x31, 0x9e,
+	0x9b, 0x93, 0x99, 0xb4, 0xc4, 0x75, 0x6d, 0x5a, 0x40, 0xd1, 0x4b, 0x70, 0x74, 0x1f, 0xe9, 0x06,
+	0x5f, 0xd1, 0xe1, 0xcc, 0x7e, 0x41, 0x2b, 0xef, 0xe4, 0xed, 0x6d, 0x7d, 0xbb, 0xb2, 0xad, 0xcb,
+	0x2b, 0xdb, 0xfa, 0x71, 0x65, 0x5b, 0x9f, 0xaf, 0xed, 0x85, 0xcb, 0x6b, 0x7b, 0xe1, 0xfb, 0xb5,
+	0xbd, 0xf0, 0x76, 0xad, 0xf8, 0x07, 0xe7, 0x2f, 0xea, 0x73, 0xbb, 0xf3, 0x33, 0x00, 0x00, 0xff,
+	0xff, 0xd3, 0xa3, 0x78, 0x2f, 0x52, 0x07, 0x00, 0x00,
 }
 
 func (m *ObjectInfo) Marshal() (dAtA []byte, err error) {
@@ -804,6 +822,34 @@ func (m *ObjectLinksInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OutboundBlockLinks) > 0 {
+		for iNdEx := len(m.OutboundBlockLinks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutboundBlockLinks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLocalstore(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.InboundBlockLinks) > 0 {
+		for iNdEx := len(m.InboundBlockLinks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InboundBlockLinks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLocalstore(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Outbound) > 0 {
 		for iNdEx := len(m.Outbound) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1179,6 +1225,18 @@ func (m *ObjectLinksInfo) Size() (n int) {
 			n += 1 + l + sovLocalstore(uint64(l))
 		}
 	}
+	if len(m.InboundBlockLinks) > 0 {
+		for _, e := range m.InboundBlockLinks {
+			l = e.Size()
+			n += 1 + l + sovLocalstore(uint64(l))
+		}
+	}
+	if len(m.OutboundBlockLinks) > 0 {
+		for _, e := range m.OutboundBlockLinks {
+			l = e.Size()
+			n += 1 + l + sovLocalstore(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1850,6 +1908,74 @@ func (m *ObjectLinksInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundBlockLinks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocalstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocalstore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocalstore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InboundBlockLinks = append(m.InboundBlockLinks, &BlockLink{})
+			if err := m.InboundBlockLinks[len(m.InboundBlockLinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundBlockLinks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocalstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocalstore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocalstore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutboundBlockLinks = append(m.OutboundBlockLinks, &BlockLink{})
+			if err := m.OutboundBlockLinks[len(m.OutboundBlockLinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLocalstore(dAtA[iNdEx:])
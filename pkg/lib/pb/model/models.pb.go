@@ -1005,6 +1005,62 @@ func (BlockContentWidgetLayout) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_98a910b73321e591, []int{1, 1, 16, 0}
 }
 
+type BlockContentDiagramLanguage int32
+
+const (
+	BlockContentDiagram_Mermaid  BlockContentDiagramLanguage = 0
+	BlockContentDiagram_Graphviz BlockContentDiagramLanguage = 1
+	BlockContentDiagram_PlantUML BlockContentDiagramLanguage = 2
+)
+
+var BlockContentDiagramLanguage_name = map[int32]string{
+	0: "Mermaid",
+	1: "Graphviz",
+	2: "PlantUML",
+}
+
+var BlockContentDiagramLanguage_value = map[string]int32{
+	"Mermaid":  0,
+	"Graphviz": 1,
+	"PlantUML": 2,
+}
+
+func (x BlockContentDiagramLanguage) String() string {
+	return proto.EnumName(BlockContentDiagramLanguage_name, int32(x))
+}
+
+func (BlockContentDiagramLanguage) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_98a910b73321e591, []int{1, 1, 18, 0}
+}
+
+type ImageEditOptionsFormat int32
+
+const (
+	ImageEditOptions_Original ImageEditOptionsFormat = 0
+	ImageEditOptions_JPEG     ImageEditOptionsFormat = 1
+	ImageEditOptions_PNG      ImageEditOptionsFormat = 2
+)
+
+var ImageEditOptionsFormat_name = map[int32]string{
+	0: "Original",
+	1: "JPEG",
+	2: "PNG",
+}
+
+var ImageEditOptionsFormat_value = map[string]int32{
+	"Original": 0,
+	"JPEG":     1,
+	"PNG":      2,
+}
+
+func (x ImageEditOptionsFormat) String() string {
+	return proto.EnumName(ImageEditOptionsFormat_name, int32(x))
+}
+
+func (ImageEditOptionsFormat) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_98a910b73321e591, []int{4, 0}
+}
+
 type AccountStatusType int32
 
 const (
@@ -1033,7 +1089,7 @@ func (x AccountStatusType) String() string {
 }
 
 func (AccountStatusType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{4, 0}
+	return fileDescriptor_98a910b73321e591, []int{5, 0}
 }
 
 type LinkPreviewType int32
@@ -1064,7 +1120,7 @@ func (x LinkPreviewType) String() string {
 }
 
 func (LinkPreviewType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{5, 0}
+	return fileDescriptor_98a910b73321e591, []int{6, 0}
 }
 
 type RestrictionsObjectRestriction int32
@@ -1118,7 +1174,7 @@ func (x RestrictionsObjectRestriction) String() string {
 }
 
 func (RestrictionsObjectRestriction) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{6, 0}
+	return fileDescriptor_98a910b73321e591, []int{7, 0}
 }
 
 type RestrictionsDataviewRestriction int32
@@ -1149,7 +1205,7 @@ func (x RestrictionsDataviewRestriction) String() string {
 }
 
 func (RestrictionsDataviewRestriction) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{6, 1}
+	return fileDescriptor_98a910b73321e591, []int{7, 1}
 }
 
 type ObjectTypeLayout int32
@@ -1216,7 +1272,7 @@ func (x ObjectTypeLayout) String() string {
 }
 
 func (ObjectTypeLayout) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{8, 0}
+	return fileDescriptor_98a910b73321e591, []int{9, 0}
 }
 
 type RelationScope int32
@@ -1250,7 +1306,7 @@ func (x RelationScope) String() string {
 }
 
 func (RelationScope) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{11, 0}
+	return fileDescriptor_98a910b73321e591, []int{12, 0}
 }
 
 type RelationDataSource int32
@@ -1281,7 +1337,38 @@ func (x RelationDataSource) String() string {
 }
 
 func (RelationDataSource) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{11, 1}
+	return fileDescriptor_98a910b73321e591, []int{12, 1}
+}
+
+type RelationConstraintViolationType int32
+
+const (
+	RelationConstraintViolation_required   RelationConstraintViolationType = 0
+	RelationConstraintViolation_range      RelationConstraintViolationType = 1
+	RelationConstraintViolation_pattern    RelationConstraintViolationType = 2
+	RelationConstraintViolation_objectType RelationConstraintViolationType = 3
+)
+
+var RelationConstraintViolationType_name = map[int32]string{
+	0: "required",
+	1: "range",
+	2: "pattern",
+	3: "objectType",
+}
+
+var RelationConstraintViolationType_value = map[string]int32{
+	"required":   0,
+	"range":      1,
+	"pattern":    2,
+	"objectType": 3,
+}
+
+func (x RelationConstraintViolationType) String() string {
+	return proto.EnumName(RelationConstraintViolationType_name, int32(x))
+}
+
+func (RelationConstraintViolationType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_98a910b73321e591, []int{15, 0}
 }
 
 // Use such a weird construction due to the issue with imported repeated enum type
@@ -1314,7 +1401,7 @@ func (x InternalFlagValue) String() string {
 }
 
 func (InternalFlagValue) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{15, 0}
+	return fileDescriptor_98a910b73321e591, []int{18, 0}
 }
 
 type SmartBlockSnapshotBase struct {
@@ -1445,6 +1532,8 @@ type Block struct {
 	//	*BlockContentOfTableColumn
 	//	*BlockContentOfTableRow
 	//	*BlockContentOfWidget
+	//	*BlockContentOfSynced
+	//	*BlockContentOfDiagram
 	Content IsBlockContent `protobuf_oneof:"content"`
 }
 
@@ -1538,6 +1627,12 @@ type BlockContentOfTableRow struct {
 type BlockContentOfWidget struct {
 	Widget *BlockContentWidget `protobuf:"bytes,29,opt,name=widget,proto3,oneof" json:"widget,omitempty"`
 }
+type BlockContentOfSynced struct {
+	Synced *BlockContentSynced `protobuf:"bytes,30,opt,name=synced,proto3,oneof" json:"synced,omitempty"`
+}
+type BlockContentOfDiagram struct {
+	Diagram *BlockContentDiagram `protobuf:"bytes,31,opt,name=diagram,proto3,oneof" json:"diagram,omitempty"`
+}
 
 func (*BlockContentOfSmartblock) IsBlockContent()        {}
 func (*BlockContentOfText) IsBlockContent()              {}
@@ -1556,6 +1651,8 @@ func (*BlockContentOfTable) IsBlockContent()             {}
 func (*BlockContentOfTableColumn) IsBlockContent()       {}
 func (*BlockContentOfTableRow) IsBlockContent()          {}
 func (*BlockContentOfWidget) IsBlockContent()            {}
+func (*BlockContentOfSynced) IsBlockContent()            {}
+func (*BlockContentOfDiagram) IsBlockContent()           {}
 
 func (m *Block) GetContent() IsBlockContent {
 	if m != nil {
@@ -1732,6 +1829,20 @@ func (m *Block) GetWidget() *BlockContentWidget {
 	return nil
 }
 
+func (m *Block) GetSynced() *BlockContentSynced {
+	if x, ok := m.GetContent().(*BlockContentOfSynced); ok {
+		return x.Synced
+	}
+	return nil
+}
+
+func (m *Block) GetDiagram() *BlockContentDiagram {
+	if x, ok := m.GetContent().(*BlockContentOfDiagram); ok {
+		return x.Diagram
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Block) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1752,6 +1863,8 @@ func (*Block) XXX_OneofWrappers() []interface{} {
 		(*BlockContentOfTableColumn)(nil),
 		(*BlockContentOfTableRow)(nil),
 		(*BlockContentOfWidget)(nil),
+		(*BlockContentOfSynced)(nil),
+		(*BlockContentOfDiagram)(nil),
 	}
 }
 
@@ -3869,6 +3982,114 @@ func (m *BlockContentWidget) GetViewId() string {
 	return ""
 }
 
+// Synced block shows the same content inside several objects.
+// Source block has empty target and keeps the content as its children.
+// Reference block points to the source block, its children are filled from the source when the object is shown.
+// Synced content belongs to the source object, so clients use targetObjectId as a context to edit it
+type BlockContentSynced struct {
+	TargetObjectId string `protobuf:"bytes,1,opt,name=targetObjectId,proto3" json:"targetObjectId,omitempty"`
+	TargetBlockId  string `protobuf:"bytes,2,opt,name=targetBlockId,proto3" json:"targetBlockId,omitempty"`
+}
+
+func (m *BlockContentSynced) Reset()         { *m = BlockContentSynced{} }
+func (m *BlockContentSynced) String() string { return proto.CompactTextString(m) }
+func (*BlockContentSynced) ProtoMessage()    {}
+func (*BlockContentSynced) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a910b73321e591, []int{1, 1, 17}
+}
+func (m *BlockContentSynced) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockContentSynced) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockContentSynced.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockContentSynced) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockContentSynced.Merge(m, src)
+}
+func (m *BlockContentSynced) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockContentSynced) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockContentSynced.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockContentSynced proto.InternalMessageInfo
+
+func (m *BlockContentSynced) GetTargetObjectId() string {
+	if m != nil {
+		return m.TargetObjectId
+	}
+	return ""
+}
+
+func (m *BlockContentSynced) GetTargetBlockId() string {
+	if m != nil {
+		return m.TargetBlockId
+	}
+	return ""
+}
+
+type BlockContentDiagram struct {
+	Source   string                      `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Language BlockContentDiagramLanguage `protobuf:"varint,2,opt,name=language,proto3,enum=anytype.model.BlockContentDiagramLanguage" json:"language,omitempty"`
+}
+
+func (m *BlockContentDiagram) Reset()         { *m = BlockContentDiagram{} }
+func (m *BlockContentDiagram) String() string { return proto.CompactTextString(m) }
+func (*BlockContentDiagram) ProtoMessage()    {}
+func (*BlockContentDiagram) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a910b73321e591, []int{1, 1, 18}
+}
+func (m *BlockContentDiagram) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockContentDiagram) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockContentDiagram.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockContentDiagram) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockContentDiagram.Merge(m, src)
+}
+func (m *BlockContentDiagram) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockContentDiagram) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockContentDiagram.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockContentDiagram proto.InternalMessageInfo
+
+func (m *BlockContentDiagram) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *BlockContentDiagram) GetLanguage() BlockContentDiagramLanguage {
+	if m != nil {
+		return m.Language
+	}
+	return BlockContentDiagram_Mermaid
+}
+
 // Used to decode block meta only, without the content itself
 type BlockMetaOnly struct {
 	Id     string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -3975,6 +4196,151 @@ func (m *Range) GetTo() int32 {
 	return 0
 }
 
+// Transformations applied to an image before it is added to the file storage
+type ImageEditOptions struct {
+	StripExif     bool                   `protobuf:"varint,1,opt,name=stripExif,proto3" json:"stripExif,omitempty"`
+	StripLocation bool                   `protobuf:"varint,2,opt,name=stripLocation,proto3" json:"stripLocation,omitempty"`
+	Rotate        int32                  `protobuf:"varint,3,opt,name=rotate,proto3" json:"rotate,omitempty"`
+	Crop          *ImageEditOptionsRect  `protobuf:"bytes,4,opt,name=crop,proto3" json:"crop,omitempty"`
+	Format        ImageEditOptionsFormat `protobuf:"varint,5,opt,name=format,proto3,enum=anytype.model.ImageEditOptionsFormat" json:"format,omitempty"`
+}
+
+func (m *ImageEditOptions) Reset()         { *m = ImageEditOptions{} }
+func (m *ImageEditOptions) String() string { return proto.CompactTextString(m) }
+func (*ImageEditOptions) ProtoMessage()    {}
+func (*ImageEditOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a910b73321e591, []int{4}
+}
+func (m *ImageEditOptions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImageEditOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImageEditOptions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ImageEditOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImageEditOptions.Merge(m, src)
+}
+func (m *ImageEditOptions) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImageEditOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImageEditOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImageEditOptions proto.InternalMessageInfo
+
+func (m *ImageEditOptions) GetStripExif() bool {
+	if m != nil {
+		return m.StripExif
+	}
+	return false
+}
+
+func (m *ImageEditOptions) GetStripLocation() bool {
+	if m != nil {
+		return m.StripLocation
+	}
+	return false
+}
+
+func (m *ImageEditOptions) GetRotate() int32 {
+	if m != nil {
+		return m.Rotate
+	}
+	return 0
+}
+
+func (m *ImageEditOptions) GetCrop() *ImageEditOptionsRect {
+	if m != nil {
+		return m.Crop
+	}
+	return nil
+}
+
+func (m *ImageEditOptions) GetFormat() ImageEditOptionsFormat {
+	if m != nil {
+		return m.Format
+	}
+	return ImageEditOptions_Original
+}
+
+type ImageEditOptionsRect struct {
+	X      int32 `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y      int32 `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	Width  int32 `protobuf:"varint,3,opt,name=width,proto3" json:"width,omitempty"`
+	Height int32 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ImageEditOptionsRect) Reset()         { *m = ImageEditOptionsRect{} }
+func (m *ImageEditOptionsRect) String() string { return proto.CompactTextString(m) }
+func (*ImageEditOptionsRect) ProtoMessage()    {}
+func (*ImageEditOptionsRect) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a910b73321e591, []int{4, 0}
+}
+func (m *ImageEditOptionsRect) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImageEditOptionsRect) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImageEditOptionsRect.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ImageEditOptionsRect) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImageEditOptionsRect.Merge(m, src)
+}
+func (m *ImageEditOptionsRect) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImageEditOptionsRect) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImageEditOptionsRect.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImageEditOptionsRect proto.InternalMessageInfo
+
+func (m *ImageEditOptionsRect) GetX() int32 {
+	if m != nil {
+		return m.X
+	}
+	return 0
+}
+
+func (m *ImageEditOptionsRect) GetY() int32 {
+	if m != nil {
+		return m.Y
+	}
+	return 0
+}
+
+func (m *ImageEditOptionsRect) GetWidth() int32 {
+	if m != nil {
+		return m.Width
+	}
+	return 0
+}
+
+func (m *ImageEditOptionsRect) GetHeight() int32 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 // *
 // Contains basic information about a user account
 type Account struct {
@@ -3990,7 +4356,7 @@ func (m *Account) Reset()         { *m = Account{} }
 func (m *Account) String() string { return proto.CompactTextString(m) }
 func (*Account) ProtoMessage()    {}
 func (*Account) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{4}
+	return fileDescriptor_98a910b73321e591, []int{5}
 }
 func (m *Account) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4075,7 +4441,7 @@ func (m *AccountAvatar) Reset()         { *m = AccountAvatar{} }
 func (m *AccountAvatar) String() string { return proto.CompactTextString(m) }
 func (*AccountAvatar) ProtoMessage()    {}
 func (*AccountAvatar) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{4, 0}
+	return fileDescriptor_98a910b73321e591, []int{5, 0}
 }
 func (m *AccountAvatar) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4161,7 +4527,7 @@ func (m *AccountConfig) Reset()         { *m = AccountConfig{} }
 func (m *AccountConfig) String() string { return proto.CompactTextString(m) }
 func (*AccountConfig) ProtoMessage()    {}
 func (*AccountConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{4, 1}
+	return fileDescriptor_98a910b73321e591, []int{5, 1}
 }
 func (m *AccountConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4234,7 +4600,7 @@ func (m *AccountStatus) Reset()         { *m = AccountStatus{} }
 func (m *AccountStatus) String() string { return proto.CompactTextString(m) }
 func (*AccountStatus) ProtoMessage()    {}
 func (*AccountStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{4, 2}
+	return fileDescriptor_98a910b73321e591, []int{5, 2}
 }
 func (m *AccountStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4296,7 +4662,7 @@ func (m *AccountInfo) Reset()         { *m = AccountInfo{} }
 func (m *AccountInfo) String() string { return proto.CompactTextString(m) }
 func (*AccountInfo) ProtoMessage()    {}
 func (*AccountInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{4, 3}
+	return fileDescriptor_98a910b73321e591, []int{5, 3}
 }
 func (m *AccountInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4422,7 +4788,7 @@ func (m *LinkPreview) Reset()         { *m = LinkPreview{} }
 func (m *LinkPreview) String() string { return proto.CompactTextString(m) }
 func (*LinkPreview) ProtoMessage()    {}
 func (*LinkPreview) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{5}
+	return fileDescriptor_98a910b73321e591, []int{6}
 }
 func (m *LinkPreview) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4502,7 +4868,7 @@ func (m *Restrictions) Reset()         { *m = Restrictions{} }
 func (m *Restrictions) String() string { return proto.CompactTextString(m) }
 func (*Restrictions) ProtoMessage()    {}
 func (*Restrictions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{6}
+	return fileDescriptor_98a910b73321e591, []int{7}
 }
 func (m *Restrictions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4554,7 +4920,7 @@ func (m *RestrictionsDataviewRestrictions) Reset()         { *m = RestrictionsDa
 func (m *RestrictionsDataviewRestrictions) String() string { return proto.CompactTextString(m) }
 func (*RestrictionsDataviewRestrictions) ProtoMessage()    {}
 func (*RestrictionsDataviewRestrictions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{6, 0}
+	return fileDescriptor_98a910b73321e591, []int{7, 0}
 }
 func (m *RestrictionsDataviewRestrictions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4604,7 +4970,7 @@ func (m *Object) Reset()         { *m = Object{} }
 func (m *Object) String() string { return proto.CompactTextString(m) }
 func (*Object) ProtoMessage()    {}
 func (*Object) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{7}
+	return fileDescriptor_98a910b73321e591, []int{8}
 }
 func (m *Object) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4641,7 +5007,7 @@ func (m *ObjectChangePayload) Reset()         { *m = ObjectChangePayload{} }
 func (m *ObjectChangePayload) String() string { return proto.CompactTextString(m) }
 func (*ObjectChangePayload) ProtoMessage()    {}
 func (*ObjectChangePayload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{7, 0}
+	return fileDescriptor_98a910b73321e591, []int{8, 0}
 }
 func (m *ObjectChangePayload) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4695,7 +5061,7 @@ func (m *ObjectType) Reset()         { *m = ObjectType{} }
 func (m *ObjectType) String() string { return proto.CompactTextString(m) }
 func (*ObjectType) ProtoMessage()    {}
 func (*ObjectType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{8}
+	return fileDescriptor_98a910b73321e591, []int{9}
 }
 func (m *ObjectType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4811,7 +5177,7 @@ func (m *Layout) Reset()         { *m = Layout{} }
 func (m *Layout) String() string { return proto.CompactTextString(m) }
 func (*Layout) ProtoMessage()    {}
 func (*Layout) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{9}
+	return fileDescriptor_98a910b73321e591, []int{10}
 }
 func (m *Layout) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4870,7 +5236,7 @@ func (m *RelationWithValue) Reset()         { *m = RelationWithValue{} }
 func (m *RelationWithValue) String() string { return proto.CompactTextString(m) }
 func (*RelationWithValue) ProtoMessage()    {}
 func (*RelationWithValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{10}
+	return fileDescriptor_98a910b73321e591, []int{11}
 }
 func (m *RelationWithValue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4941,7 +5307,7 @@ func (m *Relation) Reset()         { *m = Relation{} }
 func (m *Relation) String() string { return proto.CompactTextString(m) }
 func (*Relation) ProtoMessage()    {}
 func (*Relation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{11}
+	return fileDescriptor_98a910b73321e591, []int{12}
 }
 func (m *Relation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5094,7 +5460,7 @@ func (m *RelationOption) Reset()         { *m = RelationOption{} }
 func (m *RelationOption) String() string { return proto.CompactTextString(m) }
 func (*RelationOption) ProtoMessage()    {}
 func (*RelationOption) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{11, 0}
+	return fileDescriptor_98a910b73321e591, []int{12, 0}
 }
 func (m *RelationOption) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5160,7 +5526,7 @@ func (m *RelationLink) Reset()         { *m = RelationLink{} }
 func (m *RelationLink) String() string { return proto.CompactTextString(m) }
 func (*RelationLink) ProtoMessage()    {}
 func (*RelationLink) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{12}
+	return fileDescriptor_98a910b73321e591, []int{13}
 }
 func (m *RelationLink) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5203,22 +5569,28 @@ func (m *RelationLink) GetFormat() RelationFormat {
 	return RelationFormat_longtext
 }
 
-type Relations struct {
-	Relations []*Relation `protobuf:"bytes,1,rep,name=relations,proto3" json:"relations,omitempty"`
+// RelationConstraint restricts the values of the relation in the objects of the type
+type RelationConstraint struct {
+	RelationKey string       `protobuf:"bytes,1,opt,name=relationKey,proto3" json:"relationKey,omitempty"`
+	Required    bool         `protobuf:"varint,2,opt,name=required,proto3" json:"required,omitempty"`
+	Min         *types.Value `protobuf:"bytes,3,opt,name=min,proto3" json:"min,omitempty"`
+	Max         *types.Value `protobuf:"bytes,4,opt,name=max,proto3" json:"max,omitempty"`
+	Pattern     string       `protobuf:"bytes,5,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	ObjectTypes []string     `protobuf:"bytes,6,rep,name=objectTypes,proto3" json:"objectTypes,omitempty"`
 }
 
-func (m *Relations) Reset()         { *m = Relations{} }
-func (m *Relations) String() string { return proto.CompactTextString(m) }
-func (*Relations) ProtoMessage()    {}
-func (*Relations) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{13}
+func (m *RelationConstraint) Reset()         { *m = RelationConstraint{} }
+func (m *RelationConstraint) String() string { return proto.CompactTextString(m) }
+func (*RelationConstraint) ProtoMessage()    {}
+func (*RelationConstraint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a910b73321e591, []int{14}
 }
-func (m *Relations) XXX_Unmarshal(b []byte) error {
+func (m *RelationConstraint) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Relations) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RelationConstraint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Relations.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RelationConstraint.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -5228,23 +5600,170 @@ func (m *Relations) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Relations) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Relations.Merge(m, src)
+func (m *RelationConstraint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelationConstraint.Merge(m, src)
 }
-func (m *Relations) XXX_Size() int {
+func (m *RelationConstraint) XXX_Size() int {
 	return m.Size()
 }
-func (m *Relations) XXX_DiscardUnknown() {
-	xxx_messageInfo_Relations.DiscardUnknown(m)
+func (m *RelationConstraint) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelationConstraint.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Relations proto.InternalMessageInfo
+var xxx_messageInfo_RelationConstraint proto.InternalMessageInfo
 
-func (m *Relations) GetRelations() []*Relation {
+func (m *RelationConstraint) GetRelationKey() string {
 	if m != nil {
-		return m.Relations
+		return m.RelationKey
 	}
-	return nil
+	return ""
+}
+
+func (m *RelationConstraint) GetRequired() bool {
+	if m != nil {
+		return m.Required
+	}
+	return false
+}
+
+func (m *RelationConstraint) GetMin() *types.Value {
+	if m != nil {
+		return m.Min
+	}
+	return nil
+}
+
+func (m *RelationConstraint) GetMax() *types.Value {
+	if m != nil {
+		return m.Max
+	}
+	return nil
+}
+
+func (m *RelationConstraint) GetPattern() string {
+	if m != nil {
+		return m.Pattern
+	}
+	return ""
+}
+
+func (m *RelationConstraint) GetObjectTypes() []string {
+	if m != nil {
+		return m.ObjectTypes
+	}
+	return nil
+}
+
+type RelationConstraintViolation struct {
+	ObjectId    string                          `protobuf:"bytes,1,opt,name=objectId,proto3" json:"objectId,omitempty"`
+	RelationKey string                          `protobuf:"bytes,2,opt,name=relationKey,proto3" json:"relationKey,omitempty"`
+	Type        RelationConstraintViolationType `protobuf:"varint,3,opt,name=type,proto3,enum=anytype.model.RelationConstraintViolationType" json:"type,omitempty"`
+	Description string                          `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (m *RelationConstraintViolation) Reset()         { *m = RelationConstraintViolation{} }
+func (m *RelationConstraintViolation) String() string { return proto.CompactTextString(m) }
+func (*RelationConstraintViolation) ProtoMessage()    {}
+func (*RelationConstraintViolation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a910b73321e591, []int{15}
+}
+func (m *RelationConstraintViolation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelationConstraintViolation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelationConstraintViolation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RelationConstraintViolation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelationConstraintViolation.Merge(m, src)
+}
+func (m *RelationConstraintViolation) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelationConstraintViolation) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelationConstraintViolation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelationConstraintViolation proto.InternalMessageInfo
+
+func (m *RelationConstraintViolation) GetObjectId() string {
+	if m != nil {
+		return m.ObjectId
+	}
+	return ""
+}
+
+func (m *RelationConstraintViolation) GetRelationKey() string {
+	if m != nil {
+		return m.RelationKey
+	}
+	return ""
+}
+
+func (m *RelationConstraintViolation) GetType() RelationConstraintViolationType {
+	if m != nil {
+		return m.Type
+	}
+	return RelationConstraintViolation_required
+}
+
+func (m *RelationConstraintViolation) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+type Relations struct {
+	Relations []*Relation `protobuf:"bytes,1,rep,name=relations,proto3" json:"relations,omitempty"`
+}
+
+func (m *Relations) Reset()         { *m = Relations{} }
+func (m *Relations) String() string { return proto.CompactTextString(m) }
+func (*Relations) ProtoMessage()    {}
+func (*Relations) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a910b73321e591, []int{16}
+}
+func (m *Relations) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Relations) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Relations.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Relations) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Relations.Merge(m, src)
+}
+func (m *Relations) XXX_Size() int {
+	return m.Size()
+}
+func (m *Relations) XXX_DiscardUnknown() {
+	xxx_messageInfo_Relations.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Relations proto.InternalMessageInfo
+
+func (m *Relations) GetRelations() []*Relation {
+	if m != nil {
+		return m.Relations
+	}
+	return nil
 }
 
 type RelationOptions struct {
@@ -5255,7 +5774,7 @@ func (m *RelationOptions) Reset()         { *m = RelationOptions{} }
 func (m *RelationOptions) String() string { return proto.CompactTextString(m) }
 func (*RelationOptions) ProtoMessage()    {}
 func (*RelationOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{14}
+	return fileDescriptor_98a910b73321e591, []int{17}
 }
 func (m *RelationOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5299,7 +5818,7 @@ func (m *InternalFlag) Reset()         { *m = InternalFlag{} }
 func (m *InternalFlag) String() string { return proto.CompactTextString(m) }
 func (*InternalFlag) ProtoMessage()    {}
 func (*InternalFlag) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{15}
+	return fileDescriptor_98a910b73321e591, []int{18}
 }
 func (m *InternalFlag) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5346,13 +5865,14 @@ type ObjectView struct {
 	RelationLinks []*RelationLink         `protobuf:"bytes,10,rep,name=relationLinks,proto3" json:"relationLinks,omitempty"`
 	Restrictions  *Restrictions           `protobuf:"bytes,8,opt,name=restrictions,proto3" json:"restrictions,omitempty"`
 	History       *ObjectViewHistorySize  `protobuf:"bytes,9,opt,name=history,proto3" json:"history,omitempty"`
+	FocusBlockId  string                  `protobuf:"bytes,11,opt,name=focusBlockId,proto3" json:"focusBlockId,omitempty"`
 }
 
 func (m *ObjectView) Reset()         { *m = ObjectView{} }
 func (m *ObjectView) String() string { return proto.CompactTextString(m) }
 func (*ObjectView) ProtoMessage()    {}
 func (*ObjectView) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{16}
+	return fileDescriptor_98a910b73321e591, []int{19}
 }
 func (m *ObjectView) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5437,6 +5957,13 @@ func (m *ObjectView) GetHistory() *ObjectViewHistorySize {
 	return nil
 }
 
+func (m *ObjectView) GetFocusBlockId() string {
+	if m != nil {
+		return m.FocusBlockId
+	}
+	return ""
+}
+
 type ObjectViewDetailsSet struct {
 	Id      string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Details *types.Struct `protobuf:"bytes,2,opt,name=details,proto3" json:"details,omitempty"`
@@ -5447,7 +5974,7 @@ func (m *ObjectViewDetailsSet) Reset()         { *m = ObjectViewDetailsSet{} }
 func (m *ObjectViewDetailsSet) String() string { return proto.CompactTextString(m) }
 func (*ObjectViewDetailsSet) ProtoMessage()    {}
 func (*ObjectViewDetailsSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{16, 0}
+	return fileDescriptor_98a910b73321e591, []int{19, 0}
 }
 func (m *ObjectViewDetailsSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5506,7 +6033,7 @@ func (m *ObjectViewRelationWithValuePerObject) Reset()         { *m = ObjectView
 func (m *ObjectViewRelationWithValuePerObject) String() string { return proto.CompactTextString(m) }
 func (*ObjectViewRelationWithValuePerObject) ProtoMessage()    {}
 func (*ObjectViewRelationWithValuePerObject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{16, 1}
+	return fileDescriptor_98a910b73321e591, []int{19, 1}
 }
 func (m *ObjectViewRelationWithValuePerObject) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5558,7 +6085,7 @@ func (m *ObjectViewHistorySize) Reset()         { *m = ObjectViewHistorySize{} }
 func (m *ObjectViewHistorySize) String() string { return proto.CompactTextString(m) }
 func (*ObjectViewHistorySize) ProtoMessage()    {}
 func (*ObjectViewHistorySize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{16, 2}
+	return fileDescriptor_98a910b73321e591, []int{19, 2}
 }
 func (m *ObjectViewHistorySize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5601,923 +6128,904 @@ func (m *ObjectViewHistorySize) GetRedo() int32 {
 	return 0
 }
 
-func init() {
-	proto.RegisterEnum("anytype.model.SmartBlockType", SmartBlockType_name, SmartBlockType_value)
-	proto.RegisterEnum("anytype.model.RelationFormat", RelationFormat_name, RelationFormat_value)
-	proto.RegisterEnum("anytype.model.BlockPosition", BlockPosition_name, BlockPosition_value)
-	proto.RegisterEnum("anytype.model.BlockAlign", BlockAlign_name, BlockAlign_value)
-	proto.RegisterEnum("anytype.model.BlockVerticalAlign", BlockVerticalAlign_name, BlockVerticalAlign_value)
-	proto.RegisterEnum("anytype.model.BlockContentLayoutStyle", BlockContentLayoutStyle_name, BlockContentLayoutStyle_value)
-	proto.RegisterEnum("anytype.model.BlockContentLinkIconSize", BlockContentLinkIconSize_name, BlockContentLinkIconSize_value)
-	proto.RegisterEnum("anytype.model.BlockContentLinkStyle", BlockContentLinkStyle_name, BlockContentLinkStyle_value)
-	proto.RegisterEnum("anytype.model.BlockContentLinkDescription", BlockContentLinkDescription_name, BlockContentLinkDescription_value)
-	proto.RegisterEnum("anytype.model.BlockContentLinkCardStyle", BlockContentLinkCardStyle_name, BlockContentLinkCardStyle_value)
-	proto.RegisterEnum("anytype.model.BlockContentDivStyle", BlockContentDivStyle_name, BlockContentDivStyle_value)
-	proto.RegisterEnum("anytype.model.BlockContentBookmarkState", BlockContentBookmarkState_name, BlockContentBookmarkState_value)
-	proto.RegisterEnum("anytype.model.BlockContentTextStyle", BlockContentTextStyle_name, BlockContentTextStyle_value)
-	proto.RegisterEnum("anytype.model.BlockContentTextMarkType", BlockContentTextMarkType_name, BlockContentTextMarkType_value)
-	proto.RegisterEnum("anytype.model.BlockContentFileType", BlockContentFileType_name, BlockContentFileType_value)
-	proto.RegisterEnum("anytype.model.BlockContentFileStyle", BlockContentFileStyle_name, BlockContentFileStyle_value)
-	proto.RegisterEnum("anytype.model.BlockContentFileState", BlockContentFileState_name, BlockContentFileState_value)
-	proto.RegisterEnum("anytype.model.BlockContentDataviewViewType", BlockContentDataviewViewType_name, BlockContentDataviewViewType_value)
-	proto.RegisterEnum("anytype.model.BlockContentDataviewViewSize", BlockContentDataviewViewSize_name, BlockContentDataviewViewSize_value)
-	proto.RegisterEnum("anytype.model.BlockContentDataviewRelationDateFormat", BlockContentDataviewRelationDateFormat_name, BlockContentDataviewRelationDateFormat_value)
-	proto.RegisterEnum("anytype.model.BlockContentDataviewRelationTimeFormat", BlockContentDataviewRelationTimeFormat_name, BlockContentDataviewRelationTimeFormat_value)
-	proto.RegisterEnum("anytype.model.BlockContentDataviewSortType", BlockContentDataviewSortType_name, BlockContentDataviewSortType_value)
-	proto.RegisterEnum("anytype.model.BlockContentDataviewFilterOperator", BlockContentDataviewFilterOperator_name, BlockContentDataviewFilterOperator_value)
-	proto.RegisterEnum("anytype.model.BlockContentDataviewFilterCondition", BlockContentDataviewFilterCondition_name, BlockContentDataviewFilterCondition_value)
-	proto.RegisterEnum("anytype.model.BlockContentDataviewFilterQuickOption", BlockContentDataviewFilterQuickOption_name, BlockContentDataviewFilterQuickOption_value)
-	proto.RegisterEnum("anytype.model.BlockContentWidgetLayout", BlockContentWidgetLayout_name, BlockContentWidgetLayout_value)
-	proto.RegisterEnum("anytype.model.AccountStatusType", AccountStatusType_name, AccountStatusType_value)
-	proto.RegisterEnum("anytype.model.LinkPreviewType", LinkPreviewType_name, LinkPreviewType_value)
-	proto.RegisterEnum("anytype.model.RestrictionsObjectRestriction", RestrictionsObjectRestriction_name, RestrictionsObjectRestriction_value)
-	proto.RegisterEnum("anytype.model.RestrictionsDataviewRestriction", RestrictionsDataviewRestriction_name, RestrictionsDataviewRestriction_value)
-	proto.RegisterEnum("anytype.model.ObjectTypeLayout", ObjectTypeLayout_name, ObjectTypeLayout_value)
-	proto.RegisterEnum("anytype.model.RelationScope", RelationScope_name, RelationScope_value)
-	proto.RegisterEnum("anytype.model.RelationDataSource", RelationDataSource_name, RelationDataSource_value)
-	proto.RegisterEnum("anytype.model.InternalFlagValue", InternalFlagValue_name, InternalFlagValue_value)
-	proto.RegisterType((*SmartBlockSnapshotBase)(nil), "anytype.model.SmartBlockSnapshotBase")
-	proto.RegisterType((*Block)(nil), "anytype.model.Block")
-	proto.RegisterType((*BlockRestrictions)(nil), "anytype.model.Block.Restrictions")
-	proto.RegisterType((*BlockContent)(nil), "anytype.model.Block.Content")
-	proto.RegisterType((*BlockContentLayout)(nil), "anytype.model.Block.Content.Layout")
-	proto.RegisterType((*BlockContentLink)(nil), "anytype.model.Block.Content.Link")
-	proto.RegisterType((*BlockContentDiv)(nil), "anytype.model.Block.Content.Div")
-	proto.RegisterType((*BlockContentBookmark)(nil), "anytype.model.Block.Content.Bookmark")
-	proto.RegisterType((*BlockContentIcon)(nil), "anytype.model.Block.Content.Icon")
-	proto.RegisterType((*BlockContentFeaturedRelations)(nil), "anytype.model.Block.Content.FeaturedRelations")
-	proto.RegisterType((*BlockContentText)(nil), "anytype.model.Block.Content.Text")
-	proto.RegisterType((*BlockContentTextMarks)(nil), "anytype.model.Block.Content.Text.Marks")
-	proto.RegisterType((*BlockContentTextMark)(nil), "anytype.model.Block.Content.Text.Mark")
-	proto.RegisterType((*BlockContentFile)(nil), "anytype.model.Block.Content.File")
-	proto.RegisterType((*BlockContentSmartblock)(nil), "anytype.model.Block.Content.Smartblock")
-	proto.RegisterType((*BlockContentDataview)(nil), "anytype.model.Block.Content.Dataview")
-	proto.RegisterType((*BlockContentDataviewView)(nil), "anytype.model.Block.Content.Dataview.View")
-	proto.RegisterType((*BlockContentDataviewRelation)(nil), "anytype.model.Block.Content.Dataview.Relation")
-	proto.RegisterType((*BlockContentDataviewSort)(nil), "anytype.model.Block.Content.Dataview.Sort")
-	proto.RegisterType((*BlockContentDataviewFilter)(nil), "anytype.model.Block.Content.Dataview.Filter")
-	proto.RegisterType((*BlockContentDataviewGroupOrder)(nil), "anytype.model.Block.Content.Dataview.GroupOrder")
-	proto.RegisterType((*BlockContentDataviewViewGroup)(nil), "anytype.model.Block.Content.Dataview.ViewGroup")
-	proto.RegisterType((*BlockContentDataviewObjectOrder)(nil), "anytype.model.Block.Content.Dataview.ObjectOrder")
-	proto.RegisterType((*BlockContentDataviewGroup)(nil), "anytype.model.Block.Content.Dataview.Group")
-	proto.RegisterType((*BlockContentDataviewStatus)(nil), "anytype.model.Block.Content.Dataview.Status")
-	proto.RegisterType((*BlockContentDataviewTag)(nil), "anytype.model.Block.Content.Dataview.Tag")
-	proto.RegisterType((*BlockContentDataviewCheckbox)(nil), "anytype.model.Block.Content.Dataview.Checkbox")
-	proto.RegisterType((*BlockContentDataviewDate)(nil), "anytype.model.Block.Content.Dataview.Date")
-	proto.RegisterType((*BlockContentRelation)(nil), "anytype.model.Block.Content.Relation")
-	proto.RegisterType((*BlockContentLatex)(nil), "anytype.model.Block.Content.Latex")
-	proto.RegisterType((*BlockContentTableOfContents)(nil), "anytype.model.Block.Content.TableOfContents")
-	proto.RegisterType((*BlockContentTable)(nil), "anytype.model.Block.Content.Table")
-	proto.RegisterType((*BlockContentTableColumn)(nil), "anytype.model.Block.Content.TableColumn")
-	proto.RegisterType((*BlockContentTableRow)(nil), "anytype.model.Block.Content.TableRow")
-	proto.RegisterType((*BlockContentWidget)(nil), "anytype.model.Block.Content.Widget")
-	proto.RegisterType((*BlockMetaOnly)(nil), "anytype.model.BlockMetaOnly")
-	proto.RegisterType((*Range)(nil), "anytype.model.Range")
-	proto.RegisterType((*Account)(nil), "anytype.model.Account")
-	proto.RegisterType((*AccountAvatar)(nil), "anytype.model.Account.Avatar")
-	proto.RegisterType((*AccountConfig)(nil), "anytype.model.Account.Config")
-	proto.RegisterType((*AccountStatus)(nil), "anytype.model.Account.Status")
-	proto.RegisterType((*AccountInfo)(nil), "anytype.model.Account.Info")
-	proto.RegisterType((*LinkPreview)(nil), "anytype.model.LinkPreview")
-	proto.RegisterType((*Restrictions)(nil), "anytype.model.Restrictions")
-	proto.RegisterType((*RestrictionsDataviewRestrictions)(nil), "anytype.model.Restrictions.DataviewRestrictions")
-	proto.RegisterType((*Object)(nil), "anytype.model.Object")
-	proto.RegisterType((*ObjectChangePayload)(nil), "anytype.model.Object.ChangePayload")
-	proto.RegisterType((*ObjectType)(nil), "anytype.model.ObjectType")
-	proto.RegisterType((*Layout)(nil), "anytype.model.Layout")
-	proto.RegisterType((*RelationWithValue)(nil), "anytype.model.RelationWithValue")
-	proto.RegisterType((*Relation)(nil), "anytype.model.Relation")
-	proto.RegisterType((*RelationOption)(nil), "anytype.model.Relation.Option")
-	proto.RegisterType((*RelationLink)(nil), "anytype.model.RelationLink")
-	proto.RegisterType((*Relations)(nil), "anytype.model.Relations")
-	proto.RegisterType((*RelationOptions)(nil), "anytype.model.RelationOptions")
-	proto.RegisterType((*InternalFlag)(nil), "anytype.model.InternalFlag")
-	proto.RegisterType((*ObjectView)(nil), "anytype.model.ObjectView")
-	proto.RegisterType((*ObjectViewDetailsSet)(nil), "anytype.model.ObjectView.DetailsSet")
-	proto.RegisterType((*ObjectViewRelationWithValuePerObject)(nil), "anytype.model.ObjectView.RelationWithValuePerObject")
-	proto.RegisterType((*ObjectViewHistorySize)(nil), "anytype.model.ObjectView.HistorySize")
+// Comment attached to a block of an object. Comments are stored as separate objects of the comment type,
+// replies refer to the first comment of the thread
+type Comment struct {
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ThreadId       string                 `protobuf:"bytes,2,opt,name=threadId,proto3" json:"threadId,omitempty"`
+	TargetObjectId string                 `protobuf:"bytes,3,opt,name=targetObjectId,proto3" json:"targetObjectId,omitempty"`
+	TargetBlockId  string                 `protobuf:"bytes,4,opt,name=targetBlockId,proto3" json:"targetBlockId,omitempty"`
+	Range          *Range                 `protobuf:"bytes,5,opt,name=range,proto3" json:"range,omitempty"`
+	Text           string                 `protobuf:"bytes,6,opt,name=text,proto3" json:"text,omitempty"`
+	Marks          *BlockContentTextMarks `protobuf:"bytes,7,opt,name=marks,proto3" json:"marks,omitempty"`
+	Creator        string                 `protobuf:"bytes,8,opt,name=creator,proto3" json:"creator,omitempty"`
+	CreatedDate    int64                  `protobuf:"varint,9,opt,name=createdDate,proto3" json:"createdDate,omitempty"`
+	ModifiedDate   int64                  `protobuf:"varint,10,opt,name=modifiedDate,proto3" json:"modifiedDate,omitempty"`
+	IsResolved     bool                   `protobuf:"varint,11,opt,name=isResolved,proto3" json:"isResolved,omitempty"`
+}
+
+func (m *Comment) Reset()         { *m = Comment{} }
+func (m *Comment) String() string { return proto.CompactTextString(m) }
+func (*Comment) ProtoMessage()    {}
+func (*Comment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a910b73321e591, []int{20}
+}
+func (m *Comment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func init() {
-	proto.RegisterFile("pkg/lib/pb/model/protos/models.proto", fileDescriptor_98a910b73321e591)
+func (m *Comment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Comment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-var fileDescriptor_98a910b73321e591 = []byte{
-	// 5184 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x7b, 0x4d, 0x6c, 0x24, 0xc7,
-	0x75, 0x30, 0xe7, 0x7f, 0xe6, 0x0d, 0xc9, 0x2d, 0x96, 0xa8, 0xd5, 0x7c, 0x2d, 0x79, 0x3f, 0xba,
-	0x23, 0xcb, 0xeb, 0xb5, 0xcc, 0x95, 0xf6, 0xc7, 0x92, 0x9d, 0x48, 0x32, 0x7f, 0x76, 0x4d, 0x42,
-	0xbb, 0x22, 0xdd, 0x43, 0x71, 0x63, 0x21, 0x09, 0x5c, 0x33, 0x5d, 0x9c, 0x69, 0xb1, 0xa7, 0x6b,
-	0xdc, 0x5d, 0xc3, 0x25, 0x0d, 0x04, 0x70, 0xfe, 0x1c, 0x20, 0x87, 0xc0, 0x08, 0x90, 0x63, 0x00,
-	0xe7, 0x9e, 0x5b, 0x60, 0xc4, 0x01, 0x72, 0xc8, 0x25, 0x40, 0x80, 0x1c, 0xe2, 0xdc, 0x02, 0x04,
-	0x48, 0x02, 0xeb, 0x98, 0x43, 0x80, 0x9c, 0x73, 0x08, 0xde, 0xab, 0xea, 0x9e, 0x9e, 0x1f, 0x72,
-	0x87, 0xb2, 0x4f, 0xd3, 0xef, 0xf5, 0x7b, 0xaf, 0x5f, 0x55, 0xbd, 0x7a, 0x7f, 0x55, 0x03, 0xaf,
-	0x0f, 0x4f, 0x7b, 0x77, 0xc3, 0xa0, 0x73, 0x77, 0xd8, 0xb9, 0x3b, 0x50, 0xbe, 0x0c, 0xef, 0x0e,
-	0x63, 0xa5, 0x55, 0x62, 0x80, 0x64, 0x93, 0x20, 0xbe, 0x22, 0xa2, 0x0b, 0x7d, 0x31, 0x94, 0x9b,
-	0x84, 0x75, 0x5e, 0xeb, 0x29, 0xd5, 0x0b, 0xa5, 0x21, 0xed, 0x8c, 0x4e, 0xee, 0x26, 0x3a, 0x1e,
-	0x75, 0xb5, 0x21, 0x76, 0xff, 0xa1, 0x04, 0x37, 0xdb, 0x03, 0x11, 0xeb, 0xed, 0x50, 0x75, 0x4f,
-	0xdb, 0x91, 0x18, 0x26, 0x7d, 0xa5, 0xb7, 0x45, 0x22, 0xf9, 0x9b, 0x50, 0xed, 0x20, 0x32, 0x69,
-	0x15, 0x36, 0x4a, 0xb7, 0x9b, 0xf7, 0xd6, 0x37, 0x27, 0x04, 0x6f, 0x12, 0x87, 0x67, 0x69, 0xf8,
-	0xdb, 0x50, 0xf3, 0xa5, 0x16, 0x41, 0x98, 0xb4, 0x8a, 0x1b, 0x85, 0xdb, 0xcd, 0x7b, 0xaf, 0x6c,
-	0x9a, 0x0f, 0x6f, 0xa6, 0x1f, 0xde, 0x6c, 0xd3, 0x87, 0xbd, 0x94, 0x8e, 0xdf, 0x87, 0xfa, 0x49,
-	0x10, 0xca, 0x0f, 0xe5, 0x45, 0xd2, 0x2a, 0x5d, 0xcd, 0x93, 0x11, 0xf2, 0x0f, 0x60, 0x55, 0x9e,
-	0xeb, 0x58, 0x78, 0x32, 0x14, 0x3a, 0x50, 0x51, 0xd2, 0x2a, 0x93, 0x76, 0xaf, 0x4c, 0x69, 0x97,
-	0xbe, 0xf7, 0xa6, 0xc8, 0xf9, 0x06, 0x34, 0x55, 0xe7, 0x53, 0xd9, 0xd5, 0x47, 0x17, 0x43, 0x99,
-	0xb4, 0x2a, 0x1b, 0xa5, 0xdb, 0x0d, 0x2f, 0x8f, 0xe2, 0xdf, 0x80, 0x66, 0x57, 0x85, 0xa1, 0xec,
-	0x1a, 0xf9, 0xd5, 0xab, 0x55, 0xcb, 0xd3, 0xf2, 0x07, 0xf0, 0x72, 0x2c, 0x07, 0xea, 0x4c, 0xfa,
-	0x3b, 0x19, 0x96, 0xc6, 0x57, 0xa7, 0xcf, 0xcc, 0x7f, 0xc9, 0xb7, 0x60, 0x25, 0xb6, 0xfa, 0x3d,
-	0x09, 0xa2, 0xd3, 0xa4, 0x55, 0xa3, 0x21, 0xbd, 0x7a, 0xc9, 0x90, 0x90, 0xc6, 0x9b, 0xe4, 0x70,
-	0xff, 0x64, 0x07, 0x2a, 0xb4, 0x20, 0x7c, 0x15, 0x8a, 0x81, 0xdf, 0x2a, 0x6c, 0x14, 0x6e, 0x37,
-	0xbc, 0x62, 0xe0, 0xf3, 0xbb, 0x50, 0x3d, 0x09, 0x64, 0xe8, 0xbf, 0x70, 0x5d, 0x2c, 0x19, 0x7f,
-	0x04, 0xcb, 0xb1, 0x4c, 0x74, 0x1c, 0xd8, 0xf1, 0x9b, 0xa5, 0xf9, 0xe2, 0xbc, 0xd5, 0xdf, 0xf4,
-	0x72, 0x84, 0xde, 0x04, 0x1b, 0xce, 0x73, 0xb7, 0x1f, 0x84, 0x7e, 0x2c, 0xa3, 0x7d, 0xdf, 0xac,
-	0x52, 0xc3, 0xcb, 0xa3, 0xf8, 0x6d, 0xb8, 0xd1, 0x11, 0xdd, 0xd3, 0x5e, 0xac, 0x46, 0x11, 0x4e,
-	0x89, 0x8a, 0x5b, 0x15, 0x52, 0x7b, 0x1a, 0xcd, 0xdf, 0x82, 0x8a, 0x08, 0x83, 0x5e, 0x44, 0x6b,
-	0xb1, 0x7a, 0xcf, 0x99, 0xab, 0xcb, 0x16, 0x52, 0x78, 0x86, 0x90, 0xef, 0xc1, 0xca, 0x99, 0x8c,
-	0x75, 0xd0, 0x15, 0x21, 0xe1, 0x5b, 0x35, 0xe2, 0x74, 0xe7, 0x72, 0x1e, 0xe7, 0x29, 0xbd, 0x49,
-	0x46, 0xbe, 0x0f, 0x90, 0xe0, 0x06, 0x21, 0x3b, 0x6f, 0x35, 0x69, 0x32, 0xbe, 0x3c, 0x57, 0xcc,
-	0x8e, 0x8a, 0xb4, 0x8c, 0xf4, 0x66, 0x3b, 0x23, 0xdf, 0x5b, 0xf2, 0x72, 0xcc, 0xfc, 0x1d, 0x28,
-	0x6b, 0x79, 0xae, 0x5b, 0xab, 0x57, 0xcc, 0x68, 0x2a, 0xe4, 0x48, 0x9e, 0xeb, 0xbd, 0x25, 0x8f,
-	0x18, 0x90, 0x11, 0x37, 0x40, 0xeb, 0xc6, 0x02, 0x8c, 0x8f, 0x83, 0x50, 0x22, 0x23, 0x32, 0xf0,
-	0xf7, 0xa0, 0x1a, 0x8a, 0x0b, 0x35, 0xd2, 0x2d, 0x46, 0xac, 0xbf, 0x76, 0x25, 0xeb, 0x13, 0x22,
-	0xdd, 0x5b, 0xf2, 0x2c, 0x13, 0x7f, 0x00, 0x25, 0x3f, 0x38, 0x6b, 0xad, 0x11, 0xef, 0xc6, 0x95,
-	0xbc, 0xbb, 0xc1, 0xd9, 0xde, 0x92, 0x87, 0xe4, 0x7c, 0x07, 0xea, 0x1d, 0xa5, 0x4e, 0x07, 0x22,
-	0x3e, 0x6d, 0x71, 0x62, 0xfd, 0xd2, 0x95, 0xac, 0xdb, 0x96, 0x78, 0x6f, 0xc9, 0xcb, 0x18, 0x71,
-	0xc8, 0x41, 0x57, 0x45, 0xad, 0x97, 0x16, 0x18, 0xf2, 0x7e, 0x57, 0x45, 0x38, 0x64, 0x64, 0x40,
-	0xc6, 0x30, 0x88, 0x4e, 0x5b, 0xeb, 0x0b, 0x30, 0xe2, 0xde, 0x41, 0x46, 0x64, 0x40, 0xb5, 0x7d,
-	0xa1, 0xc5, 0x59, 0x20, 0x9f, 0xb7, 0x5e, 0x5e, 0x40, 0xed, 0x5d, 0x4b, 0x8c, 0x6a, 0xa7, 0x8c,
-	0x28, 0x24, 0xdd, 0x98, 0xad, 0x9b, 0x0b, 0x08, 0x49, 0xf7, 0x34, 0x0a, 0x49, 0x19, 0xf9, 0xef,
-	0xc0, 0xda, 0x89, 0x14, 0x7a, 0x14, 0x4b, 0x7f, 0xec, 0xe6, 0x5e, 0x21, 0x69, 0x9b, 0x57, 0xaf,
-	0xfd, 0x34, 0xd7, 0xde, 0x92, 0x37, 0x2b, 0x8a, 0x7f, 0x13, 0x2a, 0xa1, 0xd0, 0xf2, 0xbc, 0xd5,
-	0x22, 0x99, 0xee, 0x0b, 0x8c, 0x42, 0xcb, 0xf3, 0xbd, 0x25, 0xcf, 0xb0, 0xf0, 0xdf, 0x84, 0x1b,
-	0x5a, 0x74, 0x42, 0x79, 0x70, 0x62, 0x09, 0x92, 0xd6, 0xff, 0x23, 0x29, 0x6f, 0x5e, 0x6d, 0xce,
-	0x93, 0x3c, 0x7b, 0x4b, 0xde, 0xb4, 0x18, 0xd4, 0x8a, 0x50, 0x2d, 0x67, 0x01, 0xad, 0x48, 0x1e,
-	0x6a, 0x45, 0x2c, 0xfc, 0x09, 0x34, 0xe9, 0x61, 0x47, 0x85, 0xa3, 0x41, 0xd4, 0x7a, 0x95, 0x24,
-	0xdc, 0x7e, 0xb1, 0x04, 0x43, 0xbf, 0xb7, 0xe4, 0xe5, 0xd9, 0x71, 0x11, 0x09, 0xf4, 0xd4, 0xf3,
-	0xd6, 0x6b, 0x0b, 0x2c, 0xe2, 0x91, 0x25, 0xc6, 0x45, 0x4c, 0x19, 0x71, 0xeb, 0x3d, 0x0f, 0xfc,
-	0x9e, 0xd4, 0xad, 0x2f, 0x2c, 0xb0, 0xf5, 0x9e, 0x11, 0x29, 0x6e, 0x3d, 0xc3, 0xe4, 0xfc, 0x00,
-	0x96, 0xf3, 0xce, 0x95, 0x73, 0x28, 0xc7, 0x52, 0x18, 0xc7, 0x5e, 0xf7, 0xe8, 0x19, 0x71, 0xd2,
-	0x0f, 0x34, 0x39, 0xf6, 0xba, 0x47, 0xcf, 0xfc, 0x26, 0x54, 0x4d, 0x90, 0x21, 0xbf, 0x5d, 0xf7,
-	0x2c, 0x84, 0xb4, 0x7e, 0x2c, 0x7a, 0xad, 0xb2, 0xa1, 0xc5, 0x67, 0xa4, 0xf5, 0x63, 0x35, 0x3c,
-	0x88, 0xc8, 0xef, 0xd6, 0x3d, 0x0b, 0x39, 0x3f, 0x7b, 0x00, 0x35, 0xab, 0x98, 0xf3, 0x17, 0x05,
-	0xa8, 0x1a, 0xbf, 0xc0, 0x3f, 0x80, 0x4a, 0xa2, 0x2f, 0x42, 0x49, 0x3a, 0xac, 0xde, 0xfb, 0xca,
-	0x02, 0xbe, 0x64, 0xb3, 0x8d, 0x0c, 0x9e, 0xe1, 0x73, 0x3d, 0xa8, 0x10, 0xcc, 0x6b, 0x50, 0xf2,
-	0xd4, 0x73, 0xb6, 0xc4, 0x01, 0xaa, 0x66, 0xce, 0x59, 0x01, 0x91, 0xbb, 0xc1, 0x19, 0x2b, 0x22,
-	0x72, 0x4f, 0x0a, 0x5f, 0xc6, 0xac, 0xc4, 0x57, 0xa0, 0x91, 0xce, 0x6e, 0xc2, 0xca, 0x9c, 0xc1,
-	0x72, 0x6e, 0xdd, 0x12, 0x56, 0x71, 0xfe, 0xa7, 0x0c, 0x65, 0xdc, 0xc6, 0xfc, 0x75, 0x58, 0xd1,
-	0x22, 0xee, 0x49, 0x93, 0xc9, 0xec, 0xa7, 0x21, 0x70, 0x12, 0xc9, 0xdf, 0x4b, 0xc7, 0x50, 0xa4,
-	0x31, 0x7c, 0xf9, 0x85, 0xee, 0x61, 0x62, 0x04, 0xb9, 0x60, 0x5a, 0x5a, 0x2c, 0x98, 0x3e, 0x86,
-	0x3a, 0x7a, 0xa5, 0x76, 0xf0, 0x03, 0x49, 0x53, 0xbf, 0x7a, 0xef, 0xce, 0x8b, 0x3f, 0xb9, 0x6f,
-	0x39, 0xbc, 0x8c, 0x97, 0xef, 0x43, 0xa3, 0x2b, 0x62, 0x9f, 0x94, 0xa1, 0xd5, 0x5a, 0xbd, 0xf7,
-	0xd5, 0x17, 0x0b, 0xda, 0x49, 0x59, 0xbc, 0x31, 0x37, 0x3f, 0x80, 0xa6, 0x2f, 0x93, 0x6e, 0x1c,
-	0x0c, 0xc9, 0x4b, 0x99, 0x90, 0xfa, 0xb5, 0x17, 0x0b, 0xdb, 0x1d, 0x33, 0x79, 0x79, 0x09, 0xfc,
-	0x35, 0x68, 0xc4, 0x99, 0x9b, 0xaa, 0x51, 0x9c, 0x1f, 0x23, 0xdc, 0x77, 0xa0, 0x9e, 0x8e, 0x87,
-	0x2f, 0x43, 0x1d, 0x7f, 0x3f, 0x52, 0x91, 0x64, 0x4b, 0xb8, 0xb6, 0x08, 0xb5, 0x07, 0x22, 0x0c,
-	0x59, 0x81, 0xaf, 0x02, 0x20, 0xf8, 0x54, 0xfa, 0xc1, 0x68, 0xc0, 0x8a, 0xee, 0xaf, 0xa7, 0xd6,
-	0x52, 0x87, 0xf2, 0xa1, 0xe8, 0x21, 0xc7, 0x32, 0xd4, 0x53, 0xaf, 0xcb, 0x0a, 0xc8, 0xbf, 0x2b,
-	0x92, 0x7e, 0x47, 0x89, 0xd8, 0x67, 0x45, 0xde, 0x84, 0xda, 0x56, 0xdc, 0xed, 0x07, 0x67, 0x92,
-	0x95, 0xdc, 0xbb, 0xd0, 0xcc, 0xe9, 0x8b, 0x22, 0xec, 0x47, 0x1b, 0x50, 0xd9, 0xf2, 0x7d, 0xe9,
-	0xb3, 0x02, 0x32, 0xd8, 0x01, 0xb2, 0xa2, 0xfb, 0x55, 0x68, 0x64, 0xb3, 0x85, 0xe4, 0x18, 0x7f,
-	0xd9, 0x12, 0x3e, 0x21, 0x9a, 0x15, 0xd0, 0x2a, 0xf7, 0xa3, 0x30, 0x88, 0x24, 0x2b, 0x3a, 0xdf,
-	0x23, 0x53, 0xe5, 0xbf, 0x31, 0xb9, 0x21, 0xde, 0x78, 0x51, 0x80, 0x9c, 0xdc, 0x0d, 0xaf, 0xe6,
-	0xc6, 0xf7, 0x24, 0x20, 0xe5, 0xea, 0x50, 0xde, 0x55, 0x3a, 0x61, 0x05, 0xe7, 0xbf, 0x8a, 0x50,
-	0x4f, 0xe3, 0x22, 0x67, 0x50, 0x1a, 0xc5, 0xa1, 0x35, 0x68, 0x7c, 0xe4, 0xeb, 0x50, 0xd1, 0x81,
-	0xb6, 0x66, 0xdc, 0xf0, 0x0c, 0x80, 0x29, 0x57, 0x7e, 0x65, 0x4b, 0xf4, 0x6e, 0x7a, 0xa9, 0x82,
-	0x81, 0xe8, 0xc9, 0x3d, 0x91, 0xf4, 0xc9, 0x1e, 0x1b, 0xde, 0x18, 0x81, 0xfc, 0x27, 0xe2, 0x0c,
-	0x6d, 0x8e, 0xde, 0x9b, 0x64, 0x2c, 0x8f, 0xe2, 0xf7, 0xa1, 0x8c, 0x03, 0xb4, 0x46, 0xf3, 0xff,
-	0xa7, 0x06, 0x8c, 0x66, 0x72, 0x18, 0x4b, 0x5c, 0x9e, 0x4d, 0x4c, 0xa5, 0x3d, 0x22, 0xe6, 0x6f,
-	0xc0, 0xaa, 0xd9, 0x84, 0x07, 0x94, 0x64, 0xef, 0xfb, 0x94, 0x8c, 0x35, 0xbc, 0x29, 0x2c, 0xdf,
-	0xc2, 0xe9, 0x14, 0x5a, 0xb6, 0xea, 0x0b, 0xd8, 0x77, 0x3a, 0x39, 0x9b, 0x6d, 0x64, 0xf1, 0x0c,
-	0xa7, 0xfb, 0x10, 0xe7, 0x54, 0x68, 0x89, 0xcb, 0xfc, 0x68, 0x30, 0xd4, 0x17, 0xc6, 0x68, 0x1e,
-	0x4b, 0xdd, 0xed, 0x07, 0x51, 0x8f, 0x15, 0xcc, 0x14, 0xe3, 0x22, 0x12, 0x49, 0x1c, 0xab, 0x98,
-	0x95, 0x1c, 0x07, 0xca, 0x68, 0xa3, 0xe8, 0x24, 0x23, 0x31, 0x90, 0x76, 0xa6, 0xe9, 0xd9, 0x79,
-	0x09, 0xd6, 0x66, 0xc2, 0xaa, 0xf3, 0xb7, 0x55, 0x63, 0x21, 0xc8, 0x41, 0x29, 0x9d, 0xe5, 0xa0,
-	0x6c, 0xed, 0x5a, 0x3e, 0x06, 0xa5, 0x4c, 0xfa, 0x98, 0xf7, 0xa0, 0x82, 0x03, 0x4b, 0x5d, 0xcc,
-	0x02, 0xec, 0x4f, 0x91, 0xdc, 0x33, 0x5c, 0xbc, 0x05, 0xb5, 0x6e, 0x5f, 0x76, 0x4f, 0xa5, 0x6f,
-	0x7d, 0x7d, 0x0a, 0xa2, 0xd1, 0x74, 0x73, 0x59, 0xb6, 0x01, 0xc8, 0x24, 0xba, 0x2a, 0x7a, 0x34,
-	0x50, 0x9f, 0x06, 0xb4, 0xae, 0x68, 0x12, 0x29, 0x22, 0x7d, 0xbb, 0x8f, 0x36, 0x62, 0x97, 0x6d,
-	0x8c, 0x70, 0x1e, 0x41, 0x85, 0xbe, 0x8d, 0x3b, 0xc1, 0xe8, 0x6c, 0x4a, 0xc5, 0x37, 0x16, 0xd3,
-	0xd9, 0xaa, 0xec, 0xfc, 0x55, 0x11, 0xca, 0x08, 0xf3, 0x3b, 0x50, 0x89, 0x45, 0xd4, 0x33, 0x0b,
-	0x30, 0x5b, 0x71, 0x7a, 0xf8, 0xce, 0x33, 0x24, 0xfc, 0x03, 0x6b, 0x8a, 0xc5, 0x05, 0x8c, 0x25,
-	0xfb, 0x62, 0xde, 0x2c, 0xd7, 0xa1, 0x32, 0x14, 0xb1, 0x18, 0xd8, 0x7d, 0x62, 0x00, 0xf7, 0x27,
-	0x05, 0x28, 0x23, 0x11, 0x5f, 0x83, 0x95, 0xb6, 0x8e, 0x83, 0x53, 0xa9, 0xfb, 0xb1, 0x1a, 0xf5,
-	0xfa, 0xc6, 0x92, 0x3e, 0x94, 0x17, 0xc6, 0xdf, 0x18, 0x87, 0xa0, 0x45, 0x18, 0x74, 0x59, 0x11,
-	0xad, 0x6a, 0x5b, 0x85, 0x3e, 0x2b, 0xf1, 0x1b, 0xd0, 0xfc, 0x38, 0xf2, 0x65, 0x9c, 0x74, 0x55,
-	0x2c, 0x7d, 0x56, 0xb6, 0xbb, 0xfb, 0x94, 0x55, 0x28, 0x96, 0xc9, 0x73, 0x4d, 0x25, 0x0d, 0xab,
-	0xf2, 0x97, 0xe0, 0xc6, 0xf6, 0x64, 0x9d, 0xc3, 0x6a, 0xe8, 0x93, 0x9e, 0xca, 0x08, 0x8d, 0x8c,
-	0xd5, 0x8d, 0x11, 0xab, 0x4f, 0x03, 0xd6, 0xc0, 0x8f, 0x99, 0x7d, 0xc2, 0xc0, 0xfd, 0xbb, 0x42,
-	0xea, 0x39, 0x56, 0xa0, 0x71, 0x28, 0x62, 0xd1, 0x8b, 0xc5, 0x10, 0xf5, 0x6b, 0x42, 0xcd, 0x04,
-	0xce, 0xb7, 0x8d, 0x77, 0x33, 0xc0, 0x3d, 0xe3, 0x1b, 0x0d, 0x70, 0x9f, 0x95, 0xc6, 0xc0, 0x03,
-	0x56, 0xc6, 0x6f, 0x7c, 0x67, 0xa4, 0xb4, 0x64, 0x15, 0xf2, 0x75, 0xca, 0x97, 0xac, 0x8a, 0xc8,
-	0x23, 0xf4, 0x28, 0xac, 0x86, 0x63, 0xde, 0x41, 0xfb, 0xe9, 0xa8, 0x73, 0x56, 0x47, 0x35, 0x70,
-	0x1a, 0xa5, 0xcf, 0x1a, 0xf8, 0xe6, 0xa3, 0xd1, 0xa0, 0x23, 0x71, 0x98, 0x80, 0x6f, 0x8e, 0x54,
-	0xaf, 0x17, 0x4a, 0xd6, 0xc4, 0x39, 0xc8, 0x39, 0x5f, 0xb6, 0x4c, 0x9e, 0x56, 0x84, 0xa1, 0x1a,
-	0x69, 0xb6, 0xe2, 0xfc, 0xbc, 0x04, 0x65, 0x2c, 0x52, 0x70, 0xef, 0xf4, 0xd1, 0xcf, 0xd8, 0xbd,
-	0x83, 0xcf, 0xd9, 0x0e, 0x2c, 0x8e, 0x77, 0x20, 0xff, 0xa6, 0x5d, 0xe9, 0xd2, 0x02, 0x5e, 0x16,
-	0x05, 0xe7, 0x17, 0x99, 0x43, 0x79, 0x10, 0x0c, 0xa4, 0xf5, 0x75, 0xf4, 0x8c, 0xb8, 0x04, 0xe3,
-	0x31, 0x6e, 0x83, 0x92, 0x47, 0xcf, 0xb8, 0x6b, 0x04, 0x86, 0x85, 0x2d, 0x4d, 0x7b, 0xa0, 0xe4,
-	0xa5, 0xa0, 0xd9, 0xcd, 0xe8, 0x95, 0x6a, 0x0b, 0xec, 0x66, 0xfa, 0x7c, 0xde, 0x23, 0x8d, 0x9d,
-	0x41, 0x7d, 0x71, 0xf6, 0x5c, 0x90, 0xd8, 0xb5, 0xd6, 0x38, 0x0e, 0x60, 0x75, 0x33, 0x7b, 0xac,
-	0x80, 0xab, 0x44, 0xdb, 0xd0, 0xf8, 0xb2, 0xe3, 0xc0, 0x97, 0x8a, 0x95, 0x28, 0xc0, 0x8d, 0xfc,
-	0x40, 0xb1, 0x32, 0x66, 0x54, 0x87, 0xbb, 0x8f, 0x59, 0xc5, 0x7d, 0x23, 0x17, 0x6a, 0xb6, 0x46,
-	0x5a, 0x19, 0x31, 0x64, 0x96, 0x05, 0x63, 0x65, 0x1d, 0xe9, 0xb3, 0xa2, 0xfb, 0xf5, 0x39, 0xee,
-	0x73, 0x05, 0x1a, 0x1f, 0x0f, 0x43, 0x25, 0xfc, 0x2b, 0xfc, 0xe7, 0x32, 0xc0, 0xb8, 0xe8, 0x75,
-	0x7e, 0xfa, 0x85, 0x71, 0x98, 0xc6, 0x1c, 0x33, 0x51, 0xa3, 0xb8, 0x2b, 0xc9, 0x35, 0x34, 0x3c,
-	0x0b, 0xf1, 0x6f, 0x41, 0x05, 0xdf, 0x27, 0xad, 0x22, 0x79, 0x8c, 0x3b, 0x0b, 0x95, 0x5a, 0x9b,
-	0xc7, 0x81, 0x7c, 0xee, 0x19, 0x46, 0xfe, 0x30, 0x9f, 0x76, 0xbc, 0xa0, 0x09, 0x34, 0xa6, 0xe4,
-	0xb7, 0x00, 0x44, 0x57, 0x07, 0x67, 0x12, 0x65, 0xd9, 0xbd, 0x9f, 0xc3, 0x70, 0x0f, 0x9a, 0xb8,
-	0x25, 0x87, 0x07, 0x31, 0xee, 0xe2, 0xd6, 0x32, 0x09, 0x7e, 0x6b, 0x31, 0xf5, 0xbe, 0x9d, 0x31,
-	0x7a, 0x79, 0x21, 0xfc, 0x63, 0x58, 0x36, 0x0d, 0x26, 0x2b, 0x74, 0x85, 0x84, 0xbe, 0xbd, 0x98,
-	0xd0, 0x83, 0x31, 0xa7, 0x37, 0x21, 0x66, 0xb6, 0x6f, 0x54, 0xb9, 0x6e, 0xdf, 0x08, 0x63, 0xf3,
-	0xd1, 0x64, 0x6c, 0x36, 0x21, 0x60, 0x0a, 0xcb, 0x5d, 0x58, 0x0e, 0x92, 0x71, 0xdb, 0x8a, 0x5a,
-	0x18, 0x75, 0x6f, 0x02, 0xe7, 0xfc, 0xa8, 0x0a, 0x65, 0x9a, 0xc2, 0xe9, 0x16, 0xd4, 0xce, 0x84,
-	0xab, 0xbe, 0xbb, 0xf8, 0x52, 0x4f, 0xed, 0x64, 0xf2, 0x0c, 0xa5, 0x9c, 0x67, 0xf8, 0x16, 0x54,
-	0x12, 0x15, 0xeb, 0x74, 0xf9, 0x17, 0x34, 0xa2, 0xb6, 0x8a, 0xb5, 0x67, 0x18, 0xf9, 0x63, 0xa8,
-	0x9d, 0x04, 0xa1, 0xc6, 0x45, 0x31, 0x93, 0xf7, 0xe6, 0x62, 0x32, 0x1e, 0x13, 0x93, 0x97, 0x32,
-	0xf3, 0x27, 0x79, 0x63, 0xac, 0x92, 0xa4, 0xcd, 0xc5, 0x24, 0xcd, 0xb3, 0xd1, 0x3b, 0xc0, 0xba,
-	0xea, 0x4c, 0xc6, 0xe9, 0xbb, 0x0f, 0xe5, 0x85, 0x0d, 0xbe, 0x33, 0x78, 0xee, 0x40, 0xbd, 0x1f,
-	0xf8, 0x12, 0xf3, 0x17, 0xf2, 0x31, 0x75, 0x2f, 0x83, 0xf9, 0x87, 0x50, 0xa7, 0xbc, 0x1f, 0xbd,
-	0x5d, 0xe3, 0xda, 0x93, 0x6f, 0x4a, 0x90, 0x54, 0x00, 0x7e, 0x88, 0x3e, 0xfe, 0x38, 0xd0, 0x2d,
-	0x30, 0x1f, 0x4a, 0x61, 0x54, 0x98, 0xec, 0x3d, 0xaf, 0x70, 0xd3, 0x28, 0x3c, 0x8d, 0xe7, 0x0f,
-	0xe0, 0x65, 0xc2, 0x4d, 0x05, 0x3f, 0xdc, 0x6a, 0x28, 0x74, 0xfe, 0x4b, 0x4c, 0x44, 0x86, 0xa2,
-	0x27, 0x9f, 0x04, 0x83, 0x40, 0xb7, 0x56, 0x36, 0x0a, 0xb7, 0x2b, 0xde, 0x18, 0xc1, 0xdf, 0x84,
-	0x35, 0x5f, 0x9e, 0x88, 0x51, 0xa8, 0x8f, 0xe4, 0x60, 0x18, 0x0a, 0x2d, 0xf7, 0x7d, 0xb2, 0xd1,
-	0x86, 0x37, 0xfb, 0xc2, 0x7d, 0x60, 0x9d, 0x2a, 0x86, 0x39, 0xac, 0x26, 0x53, 0x77, 0x98, 0x68,
-	0x13, 0x37, 0xbf, 0x2d, 0xc2, 0x50, 0xc6, 0x17, 0xa6, 0x14, 0xfd, 0x50, 0x44, 0x1d, 0x11, 0xb1,
-	0x92, 0x7b, 0x1b, 0xca, 0x34, 0x0f, 0x0d, 0xa8, 0x98, 0x92, 0x85, 0xca, 0x57, 0x5b, 0xae, 0x90,
-	0x1b, 0x7d, 0x82, 0x7b, 0x86, 0x15, 0x9d, 0x9f, 0x95, 0xa0, 0x9e, 0x8e, 0x18, 0x93, 0xf7, 0x53,
-	0x79, 0x91, 0x26, 0xef, 0xa7, 0xf2, 0x82, 0x72, 0xaa, 0xe4, 0x38, 0x48, 0x82, 0x8e, 0xcd, 0x11,
-	0xeb, 0xde, 0x18, 0x81, 0x69, 0xc9, 0xf3, 0xc0, 0xd7, 0x7d, 0x32, 0xf4, 0x8a, 0x67, 0x00, 0x7e,
-	0x1b, 0x6e, 0xf8, 0xa8, 0x7c, 0xd4, 0x0d, 0x47, 0xbe, 0x3c, 0xc2, 0x90, 0x66, 0x6a, 0xf6, 0x69,
-	0x34, 0xff, 0x2e, 0x80, 0x0e, 0x06, 0xf2, 0xb1, 0x8a, 0x07, 0x42, 0xdb, 0x44, 0xfd, 0x1b, 0xd7,
-	0x33, 0xc5, 0xcd, 0xa3, 0x4c, 0x80, 0x97, 0x13, 0x86, 0xa2, 0xf1, 0x6b, 0x56, 0x74, 0xed, 0x73,
-	0x89, 0xde, 0xcd, 0x04, 0x78, 0x39, 0x61, 0xee, 0x6f, 0x01, 0x8c, 0xdf, 0xf0, 0x9b, 0xc0, 0x9f,
-	0xaa, 0x48, 0xf7, 0xb7, 0x3a, 0x9d, 0x78, 0x5b, 0x9e, 0xa8, 0x58, 0xee, 0x0a, 0x8c, 0x45, 0x2f,
-	0xc3, 0x5a, 0x86, 0xdf, 0x3a, 0xd1, 0x32, 0x46, 0x34, 0x4d, 0x7d, 0xbb, 0xaf, 0x62, 0x6d, 0x12,
-	0x1d, 0x7a, 0xfc, 0xb8, 0xcd, 0x4a, 0x18, 0xff, 0xf6, 0xdb, 0x07, 0xac, 0xec, 0xde, 0x06, 0x18,
-	0x0f, 0x89, 0x0a, 0x02, 0x7a, 0x7a, 0xfb, 0x9e, 0x2d, 0x0f, 0x08, 0xba, 0xf7, 0x80, 0x15, 0x9c,
-	0xbf, 0x29, 0x42, 0x19, 0xfd, 0x83, 0xf5, 0x61, 0xd5, 0xcc, 0x87, 0x6d, 0x40, 0x33, 0x6f, 0xdc,
-	0x66, 0x39, 0xf3, 0xa8, 0xcf, 0xe7, 0xe5, 0xf0, 0x5b, 0x79, 0x2f, 0xf7, 0x2e, 0x34, 0xbb, 0xa3,
-	0x44, 0xab, 0x01, 0xb9, 0xf8, 0x56, 0x89, 0x3c, 0xc9, 0xcd, 0x99, 0x2e, 0xc3, 0xb1, 0x08, 0x47,
-	0xd2, 0xcb, 0x93, 0xf2, 0x87, 0x50, 0x3d, 0x31, 0x0b, 0x63, 0xfa, 0x0c, 0x5f, 0xb8, 0x24, 0x0a,
-	0xd8, 0xc9, 0xb7, 0xc4, 0x38, 0xae, 0x60, 0xc6, 0xa8, 0xf2, 0x28, 0xf7, 0x4b, 0x76, 0xb7, 0xd4,
-	0xa0, 0xb4, 0x95, 0x74, 0x6d, 0x95, 0x2a, 0x93, 0xae, 0x49, 0x81, 0x77, 0x48, 0x05, 0x56, 0x74,
-	0xfe, 0xb9, 0x06, 0x55, 0xe3, 0x15, 0xed, 0xdc, 0x35, 0xb2, 0xb9, 0xfb, 0x0e, 0xd4, 0xd5, 0x50,
-	0xc6, 0x42, 0xab, 0xd8, 0x96, 0xca, 0x0f, 0xaf, 0xe3, 0x65, 0x37, 0x0f, 0x2c, 0xb3, 0x97, 0x89,
-	0x99, 0x5e, 0x8e, 0xe2, 0xec, 0x72, 0xdc, 0x01, 0x96, 0x3a, 0xd4, 0xc3, 0x18, 0xf9, 0xf4, 0x85,
-	0x2d, 0x7c, 0x66, 0xf0, 0xfc, 0x08, 0x1a, 0x5d, 0x15, 0xf9, 0x41, 0x56, 0x36, 0xaf, 0xde, 0xfb,
-	0xfa, 0xb5, 0x34, 0xdc, 0x49, 0xb9, 0xbd, 0xb1, 0x20, 0xfe, 0x26, 0x54, 0xce, 0x70, 0x9d, 0x68,
-	0x41, 0x2e, 0x5f, 0x45, 0x43, 0xc4, 0x3f, 0x81, 0xe6, 0xf7, 0x47, 0x41, 0xf7, 0xf4, 0x20, 0xdf,
-	0x96, 0x79, 0xf7, 0x5a, 0x5a, 0x7c, 0x67, 0xcc, 0xef, 0xe5, 0x85, 0xe5, 0x6c, 0xa3, 0xf6, 0x4b,
-	0xd8, 0x46, 0x7d, 0xd6, 0x36, 0x5e, 0x85, 0x7a, 0xba, 0x38, 0x64, 0x1f, 0x91, 0xcf, 0x96, 0x78,
-	0x15, 0x8a, 0x07, 0x31, 0x2b, 0xb8, 0xff, 0x5d, 0x80, 0x46, 0x36, 0x31, 0x93, 0x2d, 0x98, 0x47,
-	0xdf, 0x1f, 0x89, 0x90, 0x15, 0xa8, 0x86, 0x50, 0xda, 0x40, 0xb4, 0x79, 0xbf, 0x1d, 0x4b, 0xa1,
-	0xa9, 0xf3, 0x87, 0x1e, 0x59, 0x26, 0x09, 0x2b, 0x73, 0x0e, 0xab, 0x16, 0x7d, 0x10, 0x1b, 0xd2,
-	0x0a, 0x96, 0x18, 0xf8, 0x36, 0x45, 0x54, 0x8d, 0x03, 0x3f, 0x95, 0xa6, 0x84, 0xfa, 0x48, 0x69,
-	0x02, 0xea, 0xa8, 0xcb, 0x7e, 0xc4, 0x1a, 0xf8, 0xcd, 0x8f, 0x94, 0xde, 0x8f, 0x18, 0x8c, 0x73,
-	0xdb, 0x66, 0xfa, 0x79, 0x82, 0x96, 0x29, 0x73, 0x0e, 0xc3, 0xfd, 0x88, 0xad, 0xd8, 0x17, 0x06,
-	0x5a, 0x45, 0x89, 0x8f, 0xce, 0x45, 0x17, 0xd9, 0x6f, 0xf0, 0x55, 0x00, 0xe4, 0xb1, 0x30, 0xc3,
-	0x3d, 0xf0, 0xe8, 0x3c, 0x48, 0x74, 0xc2, 0xd6, 0xdc, 0x7f, 0x2a, 0x40, 0x33, 0xb7, 0x08, 0x98,
-	0x3b, 0x13, 0x21, 0xba, 0x36, 0x93, 0x4a, 0x7f, 0x57, 0x26, 0x5a, 0xc6, 0x7e, 0xea, 0xb6, 0x8e,
-	0x14, 0x3e, 0x16, 0xf1, 0x7b, 0x47, 0x6a, 0xa0, 0xe2, 0x58, 0x3d, 0x67, 0x25, 0x84, 0x9e, 0x88,
-	0x44, 0x3f, 0x93, 0xf2, 0x94, 0x95, 0x71, 0xa8, 0x3b, 0xa3, 0x38, 0x96, 0x91, 0x41, 0x54, 0x48,
-	0x39, 0x79, 0x6e, 0xa0, 0x2a, 0x0a, 0x45, 0x62, 0xf2, 0x8b, 0xac, 0xc6, 0x19, 0x2c, 0x5b, 0x6a,
-	0x83, 0xa9, 0x23, 0x01, 0x92, 0x1b, 0xb0, 0x81, 0x65, 0xa7, 0x29, 0xdb, 0x0e, 0x4e, 0x76, 0xc5,
-	0x45, 0xb2, 0xd5, 0x53, 0x0c, 0xa6, 0x91, 0x1f, 0xa9, 0xe7, 0xac, 0xe9, 0x8c, 0x00, 0xc6, 0x09,
-	0x2d, 0x26, 0xf2, 0x68, 0x6b, 0x59, 0x63, 0xd5, 0x42, 0xfc, 0x00, 0x00, 0x9f, 0x88, 0x32, 0xcd,
-	0xe6, 0xaf, 0x91, 0x65, 0x10, 0x9f, 0x97, 0x13, 0xe1, 0xfc, 0x2e, 0x34, 0xb2, 0x17, 0x58, 0x97,
-	0x51, 0x3e, 0x90, 0x7d, 0x36, 0x05, 0x31, 0x4e, 0x06, 0x91, 0x2f, 0xcf, 0x69, 0xef, 0x57, 0x3c,
-	0x03, 0xa0, 0x96, 0xfd, 0xc0, 0xf7, 0x65, 0x94, 0xb6, 0xbf, 0x0d, 0x34, 0xef, 0xac, 0xb1, 0x3c,
-	0xf7, 0xac, 0xd1, 0xf9, 0x6d, 0x68, 0xe6, 0x32, 0xee, 0x4b, 0x87, 0x9d, 0x53, 0xac, 0x38, 0xa9,
-	0xd8, 0x6b, 0xd0, 0x50, 0x36, 0x6d, 0x4e, 0xc8, 0x81, 0x37, 0xbc, 0x31, 0x02, 0x03, 0x4c, 0xc5,
-	0x0c, 0x6d, 0x3a, 0x4b, 0x7e, 0x0c, 0x55, 0x2c, 0x19, 0x47, 0xe9, 0x41, 0xed, 0x82, 0x99, 0x68,
-	0x9b, 0x78, 0xf6, 0x96, 0x3c, 0xcb, 0xcd, 0xdf, 0x83, 0x92, 0x16, 0x3d, 0xdb, 0x3d, 0xfa, 0xca,
-	0x62, 0x42, 0x8e, 0x44, 0x6f, 0x6f, 0xc9, 0x43, 0x3e, 0xfe, 0x04, 0xea, 0x5d, 0x5b, 0xf0, 0x5b,
-	0xc7, 0xb5, 0x60, 0x22, 0x9b, 0xb6, 0x09, 0xf6, 0x96, 0xbc, 0x4c, 0x02, 0xff, 0x16, 0x94, 0x31,
-	0xca, 0x93, 0xe7, 0x5d, 0x38, 0x41, 0xc7, 0xed, 0xb2, 0xb7, 0xe4, 0x11, 0xe7, 0x76, 0x0d, 0x2a,
-	0xe4, 0x27, 0x9d, 0x16, 0x54, 0xcd, 0x58, 0xa7, 0x67, 0xce, 0x79, 0x05, 0x4a, 0x47, 0xa2, 0x87,
-	0x99, 0x56, 0xe0, 0x27, 0xb6, 0xce, 0xc4, 0x47, 0xe7, 0xf5, 0x71, 0xf3, 0x22, 0xdf, 0x17, 0x2b,
-	0x4c, 0xf4, 0xc5, 0x9c, 0x2a, 0x94, 0xf1, 0x8b, 0xce, 0x6b, 0x57, 0x65, 0x6d, 0xce, 0xab, 0x98,
-	0xdf, 0x69, 0x79, 0x3e, 0xaf, 0xe5, 0xe7, 0xac, 0xc1, 0x8d, 0xa9, 0x13, 0x2e, 0xa7, 0x66, 0x93,
-	0x4b, 0x67, 0x05, 0x9a, 0xb9, 0x33, 0x0b, 0xe7, 0x0d, 0xa8, 0xa7, 0x27, 0x1a, 0x98, 0x52, 0x07,
-	0x89, 0xe9, 0xc5, 0x58, 0xa5, 0x32, 0xd8, 0xf9, 0xeb, 0x02, 0x54, 0xcd, 0xa9, 0x10, 0xdf, 0xce,
-	0x4e, 0x71, 0x0b, 0x0b, 0x1c, 0x21, 0x18, 0x26, 0x7b, 0x00, 0x93, 0x1d, 0xe5, 0xae, 0x43, 0x25,
-	0xa4, 0xdc, 0xd9, 0x6e, 0x17, 0x02, 0x72, 0xd6, 0x5d, 0xca, 0x5b, 0xb7, 0xfb, 0x4e, 0x76, 0xe8,
-	0x93, 0xf6, 0x09, 0x28, 0xec, 0x1f, 0xc5, 0x52, 0x9a, 0x1e, 0x00, 0x25, 0xcb, 0x45, 0xf2, 0x4d,
-	0x6a, 0x30, 0x14, 0x5d, 0x4d, 0x88, 0x92, 0x7b, 0x02, 0xf5, 0x43, 0x95, 0x4c, 0x7b, 0xfc, 0x1a,
-	0x94, 0x8e, 0xd4, 0xd0, 0x24, 0x0c, 0xdb, 0x4a, 0x53, 0xc2, 0x60, 0x1c, 0xfc, 0x89, 0x36, 0x2d,
-	0x0b, 0x2f, 0xe8, 0xf5, 0xb5, 0x69, 0x47, 0xed, 0x47, 0x91, 0x8c, 0x59, 0x05, 0xbd, 0xae, 0x27,
-	0x87, 0xa1, 0xe8, 0x4a, 0x56, 0x45, 0xaf, 0x4b, 0xf8, 0xc7, 0x41, 0x9c, 0x68, 0x56, 0x73, 0xdf,
-	0x41, 0x5f, 0x1d, 0xf4, 0xc8, 0xc5, 0xd2, 0x03, 0x89, 0x5a, 0x42, 0x85, 0x08, 0xdc, 0x91, 0x11,
-	0x86, 0x11, 0x3a, 0x55, 0x30, 0xc7, 0xfa, 0xf4, 0x81, 0xa2, 0xfb, 0x0c, 0x56, 0x26, 0x8e, 0xfb,
-	0xf9, 0x3a, 0xb0, 0x09, 0x04, 0x2a, 0xba, 0xc4, 0x5f, 0x81, 0x97, 0x26, 0xb0, 0x4f, 0x03, 0xdf,
-	0xa7, 0xa6, 0xcb, 0xf4, 0x8b, 0x74, 0x38, 0xdb, 0x0d, 0xa8, 0x75, 0xcd, 0x0a, 0xb8, 0x87, 0xb0,
-	0x42, 0x4b, 0xf2, 0x54, 0x6a, 0x71, 0x10, 0x85, 0x17, 0xbf, 0xf4, 0x9d, 0x0c, 0xf7, 0xab, 0x50,
-	0xa1, 0xe6, 0x27, 0x1a, 0xdf, 0x49, 0xac, 0x06, 0x24, 0xab, 0xe2, 0xd1, 0x33, 0x4a, 0xd7, 0xca,
-	0xae, 0x6b, 0x51, 0x2b, 0xf7, 0x5f, 0x1a, 0x50, 0xdb, 0xea, 0x76, 0xd5, 0x28, 0xd2, 0x33, 0x5f,
-	0x9e, 0xd7, 0x5f, 0x7b, 0x08, 0x55, 0x71, 0x26, 0xb4, 0x88, 0xad, 0xcf, 0x98, 0xce, 0x0e, 0xac,
-	0xac, 0xcd, 0x2d, 0x22, 0xf2, 0x2c, 0x31, 0xb2, 0x75, 0x55, 0x74, 0x12, 0xf4, 0xac, 0x9b, 0xb8,
-	0x8c, 0x6d, 0x87, 0x88, 0x3c, 0x4b, 0x8c, 0x6c, 0xd6, 0xcd, 0x55, 0xae, 0x64, 0x33, 0x7b, 0x3d,
-	0xf3, 0x6a, 0x77, 0xa1, 0x1c, 0x44, 0x27, 0xca, 0xde, 0xc6, 0x79, 0xf5, 0x12, 0xa6, 0xfd, 0xe8,
-	0x44, 0x79, 0x44, 0xe8, 0x48, 0xa8, 0x1a, 0x85, 0xf9, 0x37, 0xa0, 0x42, 0x67, 0x1c, 0xb6, 0xab,
-	0xbc, 0xd0, 0xf5, 0x09, 0xc3, 0xc1, 0x6f, 0xa6, 0x2d, 0x73, 0x9a, 0x2f, 0xc4, 0x13, 0xb8, 0x5d,
-	0x4f, 0xa7, 0xcc, 0xf9, 0x8f, 0x02, 0x54, 0xcd, 0x08, 0xf9, 0x1b, 0xb0, 0x2a, 0x23, 0xdc, 0xda,
-	0xa9, 0x23, 0xb3, 0x7b, 0x7a, 0x0a, 0x8b, 0x69, 0x95, 0xc5, 0xc8, 0xce, 0xa8, 0x67, 0x2b, 0xc0,
-	0x3c, 0x8a, 0xbf, 0x0b, 0xaf, 0x18, 0xf0, 0x30, 0x96, 0xb1, 0x0c, 0xa5, 0x48, 0xe4, 0x4e, 0x5f,
-	0x44, 0x91, 0x0c, 0x6d, 0x58, 0xbb, 0xec, 0x35, 0x77, 0x61, 0xd9, 0xbc, 0x6a, 0x0f, 0x45, 0x57,
-	0x26, 0xf6, 0x08, 0x60, 0x02, 0xc7, 0xbf, 0x06, 0x15, 0xba, 0x13, 0xd5, 0xf2, 0xaf, 0x36, 0x3e,
-	0x43, 0xe5, 0xa8, 0xcc, 0xef, 0x6e, 0x01, 0x98, 0xd5, 0xc0, 0x7a, 0xc0, 0xfa, 0xa2, 0x2f, 0x5e,
-	0xb9, 0x7c, 0x54, 0xd9, 0xe4, 0x98, 0x50, 0x3f, 0x5f, 0x86, 0x12, 0xfd, 0x03, 0xfa, 0x5c, 0x1a,
-	0x7c, 0xc9, 0x9b, 0xc0, 0x39, 0x7f, 0x5f, 0x82, 0x32, 0x2e, 0x24, 0x12, 0xf7, 0xd5, 0x40, 0x66,
-	0xad, 0x29, 0x63, 0xb4, 0x13, 0x38, 0x0c, 0xec, 0xc2, 0x9c, 0xfa, 0x65, 0x64, 0xc6, 0x95, 0x4d,
-	0xa3, 0x91, 0x72, 0x18, 0xab, 0x93, 0x20, 0x1c, 0x53, 0xda, 0x14, 0x60, 0x0a, 0xcd, 0xbf, 0x0e,
-	0x37, 0x07, 0x22, 0x3e, 0x95, 0x9a, 0xbc, 0xcf, 0x33, 0x15, 0x9f, 0x26, 0x38, 0x73, 0xfb, 0xbe,
-	0xed, 0x69, 0x5c, 0xf2, 0x16, 0xdd, 0xb9, 0x2f, 0xcf, 0x02, 0xa2, 0xac, 0x13, 0x65, 0x06, 0xa3,
-	0x71, 0x08, 0x33, 0x35, 0x6d, 0x2b, 0xcb, 0xd4, 0x47, 0x53, 0x58, 0xcc, 0x1e, 0xcc, 0x0d, 0x80,
-	0x64, 0xdf, 0xa7, 0x36, 0x4b, 0xc3, 0x1b, 0x23, 0xf8, 0x2d, 0x80, 0x9e, 0xd0, 0xf2, 0xb9, 0xb8,
-	0xf8, 0x38, 0x0e, 0x5b, 0xd2, 0x34, 0x2f, 0xc7, 0x18, 0x2c, 0x7a, 0x42, 0xd5, 0x15, 0x61, 0x5b,
-	0xab, 0x58, 0xf4, 0xe4, 0xa1, 0xd0, 0xfd, 0x56, 0xcf, 0x14, 0x3d, 0xd3, 0x78, 0xd4, 0x16, 0x6b,
-	0xfb, 0x4f, 0x54, 0x24, 0x5b, 0x7d, 0xa3, 0x6d, 0x0a, 0xa3, 0x89, 0x8a, 0x48, 0x84, 0x17, 0x3a,
-	0xe8, 0xa2, 0x1e, 0x81, 0x29, 0xaf, 0x72, 0x28, 0xd4, 0x33, 0x92, 0xfa, 0xb9, 0x8a, 0x4f, 0xf7,
-	0xfd, 0xd6, 0xa7, 0x46, 0xcf, 0x0c, 0xe1, 0x1e, 0x00, 0x8c, 0x0d, 0x00, 0xbd, 0xfe, 0x16, 0x35,
-	0x58, 0xd9, 0x12, 0x66, 0x9a, 0x87, 0x32, 0xf2, 0x83, 0xa8, 0xb7, 0x6b, 0xd7, 0x9c, 0x15, 0x10,
-	0xd9, 0xd6, 0x22, 0xd6, 0xd2, 0xcf, 0x90, 0x54, 0x0d, 0x10, 0x24, 0x7d, 0x56, 0x72, 0xff, 0xb7,
-	0x00, 0xcd, 0xdc, 0xf1, 0xe2, 0xaf, 0xf0, 0x48, 0x14, 0x63, 0x30, 0xee, 0x75, 0x9c, 0x50, 0x63,
-	0x0f, 0x19, 0x8c, 0xd3, 0x6d, 0x4f, 0x3f, 0xf1, 0xad, 0xa9, 0x1e, 0x73, 0x98, 0xcf, 0x75, 0x1c,
-	0xea, 0xde, 0xb3, 0xf5, 0x74, 0x13, 0x6a, 0x1f, 0x47, 0xa7, 0x91, 0x7a, 0x1e, 0x99, 0xe0, 0x4a,
-	0x67, 0xdc, 0x13, 0x5d, 0xfd, 0xf4, 0x18, 0xba, 0xe4, 0xfe, 0x59, 0x79, 0xea, 0x3a, 0xc8, 0x23,
-	0xa8, 0x9a, 0x9c, 0x92, 0xd2, 0x9d, 0xd9, 0xf3, 0xfb, 0x3c, 0xb1, 0xed, 0x20, 0xe7, 0x50, 0x9e,
-	0x65, 0xc6, 0x64, 0x2f, 0xbb, 0xf3, 0x54, 0x9c, 0xdb, 0xe9, 0x9e, 0x10, 0x94, 0xba, 0xb0, 0x89,
-	0x6b, 0x7f, 0x99, 0x04, 0xe7, 0x8f, 0x0a, 0xb0, 0x3e, 0x8f, 0x04, 0x73, 0xaf, 0xce, 0xc4, 0xad,
-	0x8c, 0x14, 0xe4, 0xed, 0xa9, 0xcb, 0x86, 0x45, 0x1a, 0xcd, 0xdd, 0x6b, 0x2a, 0x31, 0x79, 0xf5,
-	0xd0, 0xfd, 0x71, 0x01, 0xd6, 0x66, 0xc6, 0x9c, 0x4b, 0x47, 0x00, 0xaa, 0xc6, 0xb2, 0xcc, 0x25,
-	0x82, 0xec, 0x58, 0xd7, 0x34, 0xfc, 0x28, 0x1e, 0x24, 0xe6, 0x9c, 0x6c, 0xd7, 0x5c, 0x55, 0x65,
-	0x65, 0xcc, 0x23, 0x70, 0xd5, 0xd0, 0xcf, 0xf6, 0x24, 0xab, 0x60, 0x9d, 0x65, 0x32, 0x24, 0x8b,
-	0xa9, 0x52, 0x0d, 0x67, 0x7b, 0x8c, 0xac, 0x46, 0x97, 0x13, 0x46, 0xc3, 0x30, 0xe8, 0x22, 0x58,
-	0x77, 0x3d, 0x78, 0x69, 0x8e, 0xde, 0xa4, 0xc9, 0xb1, 0xd5, 0x6a, 0x15, 0x60, 0xf7, 0x38, 0xd5,
-	0x85, 0x15, 0xb0, 0xec, 0xdd, 0x3d, 0xde, 0xa1, 0xc2, 0xd7, 0x1e, 0xfd, 0x99, 0x3d, 0x71, 0x8c,
-	0xd5, 0x51, 0xc2, 0x4a, 0xee, 0xf7, 0xd2, 0x33, 0x41, 0xe7, 0x18, 0x56, 0x8c, 0x1a, 0x87, 0xe2,
-	0x22, 0x54, 0xc2, 0xe7, 0x8f, 0x60, 0x35, 0xc9, 0x6e, 0xf5, 0xe6, 0xbc, 0xf5, 0x74, 0xb0, 0x6d,
-	0x4f, 0x10, 0x79, 0x53, 0x4c, 0xee, 0x9f, 0x56, 0x00, 0x0e, 0xb2, 0x9b, 0xb1, 0x73, 0x36, 0xdd,
-	0xbc, 0x74, 0x62, 0xe6, 0x54, 0xa2, 0x74, 0xed, 0x53, 0x89, 0x77, 0xb3, 0x84, 0xd7, 0xf4, 0xb2,
-	0xa6, 0xaf, 0x1e, 0x8e, 0x75, 0x9a, 0x4e, 0x73, 0x27, 0x4e, 0xb3, 0x2b, 0xd3, 0xa7, 0xd9, 0x1b,
-	0xb3, 0x57, 0x5f, 0xa6, 0xbc, 0xc1, 0xb8, 0x7e, 0xac, 0x4d, 0xd4, 0x8f, 0x0e, 0xd4, 0x63, 0x29,
-	0x7c, 0x15, 0x85, 0x17, 0x69, 0xf3, 0x3b, 0x85, 0xf9, 0x7d, 0xa8, 0x68, 0xba, 0x4b, 0x5c, 0x27,
-	0xe3, 0x7d, 0xc1, 0x1c, 0x1b, 0x5a, 0x74, 0x2d, 0x41, 0x62, 0xef, 0xab, 0x98, 0x58, 0x50, 0xf7,
-	0x72, 0x18, 0xbe, 0x09, 0x3c, 0x88, 0x12, 0x2d, 0xc2, 0x50, 0xfa, 0xdb, 0x17, 0xbb, 0xa6, 0x87,
-	0x4d, 0xf1, 0xa7, 0xee, 0xcd, 0x79, 0xe3, 0x7e, 0x36, 0xbe, 0xa7, 0xd5, 0x80, 0x4a, 0x47, 0x24,
-	0x41, 0xd7, 0x9c, 0x08, 0xdb, 0xe0, 0x66, 0xd2, 0x76, 0xad, 0x7c, 0xc5, 0x8a, 0x98, 0x8f, 0x27,
-	0x12, 0x33, 0xef, 0x55, 0x80, 0xf1, 0xcd, 0x67, 0x56, 0x46, 0x1b, 0x4e, 0x57, 0xc2, 0x1c, 0x08,
-	0x13, 0x2b, 0x35, 0x19, 0xfc, 0xec, 0xaa, 0x4d, 0x0d, 0xbf, 0x40, 0x3e, 0x92, 0xd5, 0x91, 0x26,
-	0x52, 0x5a, 0x9a, 0x16, 0x0b, 0x05, 0x42, 0x06, 0x28, 0x26, 0xbd, 0xc8, 0xc9, 0x9a, 0x98, 0x32,
-	0xa7, 0x42, 0x4d, 0x5f, 0x24, 0xa1, 0x62, 0x61, 0x19, 0x2d, 0x7c, 0xf2, 0x05, 0x5b, 0x41, 0x8d,
-	0xc6, 0x17, 0xaa, 0xd9, 0x2a, 0x8a, 0x42, 0xff, 0xd2, 0x11, 0x89, 0x64, 0xeb, 0xee, 0x9f, 0x8f,
-	0x47, 0xf9, 0x56, 0x96, 0xd9, 0x2e, 0x62, 0x1f, 0x97, 0xe5, 0xbe, 0x8f, 0x60, 0x2d, 0x96, 0xdf,
-	0x1f, 0x05, 0x13, 0x57, 0x2d, 0x4b, 0x57, 0x1f, 0x26, 0xce, 0x72, 0xb8, 0x67, 0xb0, 0x96, 0x02,
-	0xcf, 0x02, 0xdd, 0xa7, 0x82, 0x95, 0xdf, 0xcf, 0xdd, 0x05, 0x2d, 0xd8, 0x54, 0xeb, 0x12, 0x91,
-	0xe3, 0xbb, 0x9f, 0x59, 0xd3, 0xb0, 0xb8, 0x40, 0xd3, 0xd0, 0xfd, 0xf7, 0x6a, 0xae, 0x66, 0x35,
-	0xb9, 0xbe, 0x9f, 0xe5, 0xfa, 0xb3, 0x27, 0x0f, 0xe3, 0x3e, 0x60, 0xf1, 0x3a, 0x7d, 0xc0, 0x79,
-	0x47, 0x6f, 0xdf, 0xc4, 0x44, 0x8e, 0x4c, 0xef, 0x78, 0x81, 0x1e, 0xe7, 0x04, 0x2d, 0xdf, 0xa6,
-	0x73, 0x04, 0xd1, 0x36, 0xe7, 0xc2, 0x95, 0xb9, 0x37, 0xb3, 0xf3, 0x07, 0x06, 0x96, 0xd2, 0xcb,
-	0x71, 0xe5, 0x36, 0x6a, 0x75, 0xde, 0x46, 0xc5, 0xb2, 0xcb, 0x6e, 0xe1, 0x0c, 0x36, 0x2d, 0x61,
-	0xf3, 0x9c, 0x8a, 0xa7, 0x2b, 0xd5, 0x75, 0x6f, 0x06, 0x8f, 0xe9, 0xc4, 0x60, 0x14, 0xea, 0xc0,
-	0x76, 0x3d, 0x0d, 0x30, 0xfd, 0xe7, 0x81, 0xc6, 0xec, 0x9f, 0x07, 0xde, 0x07, 0x48, 0x24, 0x9a,
-	0xef, 0x6e, 0xd0, 0xd5, 0xf6, 0xf4, 0xf8, 0xd6, 0x65, 0x63, 0xb3, 0xbd, 0xda, 0x1c, 0x07, 0xea,
-	0x3f, 0x10, 0xe7, 0x3b, 0x98, 0x12, 0xda, 0x63, 0xae, 0x0c, 0x9e, 0x76, 0x5f, 0xab, 0xb3, 0xee,
-	0xeb, 0x3e, 0x54, 0x92, 0xae, 0x1a, 0x4a, 0xba, 0xfd, 0x7c, 0xf9, 0xfa, 0x6e, 0xb6, 0x91, 0xc8,
-	0x33, 0xb4, 0xd4, 0x19, 0xc1, 0x30, 0xa3, 0x62, 0xba, 0xf7, 0xdc, 0xf0, 0x52, 0xd0, 0xf1, 0xa1,
-	0x6a, 0x3b, 0x99, 0x73, 0xea, 0x48, 0x6a, 0x82, 0x14, 0x73, 0xf7, 0x9e, 0xb2, 0xfb, 0x45, 0xa5,
-	0xfc, 0xfd, 0xa2, 0x0d, 0x68, 0xc6, 0xb9, 0x4e, 0xbd, 0xbd, 0x54, 0x96, 0x43, 0xb9, 0x9f, 0x40,
-	0x85, 0xf4, 0xc1, 0x68, 0x68, 0xa6, 0xd2, 0x24, 0x44, 0xa8, 0x38, 0x2b, 0x60, 0x81, 0x9e, 0x48,
-	0x7d, 0x70, 0x72, 0xd4, 0x97, 0x6d, 0x31, 0x90, 0xe4, 0xa9, 0x8a, 0xbc, 0x05, 0xeb, 0x86, 0x36,
-	0x99, 0x7c, 0x43, 0x61, 0x3b, 0x0c, 0x3a, 0xb1, 0x88, 0x2f, 0x58, 0xd9, 0x7d, 0x9f, 0xce, 0x95,
-	0x52, 0xa3, 0x69, 0x66, 0x7f, 0x52, 0x31, 0xbe, 0xd1, 0x97, 0x31, 0x3a, 0x5b, 0x73, 0xea, 0x67,
-	0x13, 0x71, 0x73, 0xb3, 0x81, 0xb2, 0x65, 0x56, 0x72, 0x9f, 0x61, 0xde, 0x35, 0x0e, 0x4d, 0xbf,
-	0xb2, 0x3d, 0xe5, 0x6e, 0xe7, 0xf2, 0x8e, 0xc9, 0xab, 0x0c, 0x85, 0x45, 0xaf, 0x32, 0xb8, 0x1f,
-	0xc2, 0x0d, 0x6f, 0xd2, 0xb1, 0xf2, 0x77, 0xa1, 0xa6, 0x86, 0x79, 0x39, 0x2f, 0xb2, 0xbd, 0x94,
-	0xdc, 0xfd, 0x69, 0x01, 0x96, 0xf7, 0x23, 0x2d, 0xe3, 0x48, 0x84, 0x8f, 0x43, 0xd1, 0xe3, 0xef,
-	0xa4, 0x9e, 0x68, 0x7e, 0xa1, 0x97, 0xa7, 0x9d, 0x74, 0x4a, 0xa1, 0xed, 0xd8, 0xf1, 0x97, 0x61,
-	0x4d, 0xfa, 0x81, 0x56, 0xb1, 0xc9, 0xb6, 0xd2, 0x1b, 0x25, 0xeb, 0xc0, 0x0c, 0xba, 0x4d, 0x66,
-	0x7f, 0x64, 0x96, 0xb9, 0x05, 0xeb, 0x13, 0xd8, 0x34, 0x95, 0x2a, 0xf2, 0xd7, 0xa0, 0x35, 0x0e,
-	0x09, 0xbb, 0x2a, 0xd2, 0xfb, 0x91, 0x2f, 0xcf, 0x29, 0x53, 0x60, 0x25, 0xf7, 0xdf, 0xb2, 0x1c,
-	0xe5, 0xd8, 0xde, 0x37, 0x89, 0x95, 0xd2, 0xe3, 0x7e, 0xad, 0x81, 0x72, 0xff, 0x66, 0x2a, 0x2e,
-	0xf0, 0x6f, 0xa6, 0xf7, 0xc7, 0xff, 0x66, 0x32, 0xc1, 0xe0, 0xf5, 0xb9, 0x11, 0x86, 0x8e, 0xc9,
-	0x6d, 0x8e, 0xd8, 0x96, 0xb9, 0xbf, 0x36, 0xbd, 0x6d, 0x0b, 0x83, 0xf2, 0x22, 0x59, 0x97, 0x39,
-	0xf9, 0x7b, 0x38, 0x7d, 0x8b, 0x76, 0xb1, 0xeb, 0x2c, 0x33, 0xd9, 0x16, 0x5c, 0x3b, 0xdb, 0xfa,
-	0x60, 0x2a, 0x07, 0xaf, 0xcf, 0x6d, 0xb1, 0x5c, 0xf1, 0x57, 0x9f, 0x0f, 0xa0, 0xd6, 0x0f, 0x12,
-	0xad, 0xe2, 0x0b, 0x4a, 0x64, 0x66, 0xaf, 0xcb, 0xe7, 0x66, 0x6b, 0xcf, 0x10, 0xd2, 0xdd, 0x82,
-	0x94, 0xcb, 0xe9, 0x01, 0x8c, 0x67, 0x71, 0xc6, 0xd7, 0x7c, 0x8e, 0xbf, 0x96, 0xdd, 0x84, 0x6a,
-	0x32, 0xea, 0x8c, 0x1b, 0xf0, 0x16, 0x72, 0xce, 0xc1, 0x99, 0x89, 0xd3, 0x87, 0x32, 0x36, 0xfa,
-	0xa1, 0xef, 0x4d, 0x1b, 0xf5, 0xf6, 0xf3, 0x19, 0xcc, 0xdf, 0xcf, 0x2f, 0x8f, 0x31, 0xa1, 0x8d,
-	0x4b, 0xe6, 0x38, 0x93, 0x9c, 0x5b, 0x27, 0xe7, 0x21, 0x34, 0x73, 0x43, 0x47, 0xff, 0x39, 0x8a,
-	0x7c, 0x95, 0xf6, 0xf1, 0xf0, 0xd9, 0x5c, 0xf1, 0xf7, 0xd3, 0x4e, 0x1e, 0x3d, 0xdf, 0xf9, 0x71,
-	0x11, 0x56, 0x27, 0xcd, 0x85, 0x3a, 0x9a, 0xc6, 0x55, 0x1d, 0x84, 0x7e, 0xae, 0x74, 0x64, 0xfc,
-	0x06, 0x34, 0x0f, 0x4d, 0xb6, 0x47, 0x88, 0x35, 0x7c, 0xb5, 0xa7, 0x06, 0x92, 0x6d, 0xe4, 0x2f,
-	0x47, 0xbf, 0x85, 0x7e, 0xd6, 0x34, 0x89, 0xd9, 0x90, 0x37, 0xec, 0x75, 0xb2, 0x1f, 0x16, 0xf9,
-	0x4a, 0xae, 0x80, 0xf9, 0x49, 0x91, 0xaf, 0xc3, 0x8d, 0xed, 0x51, 0xe4, 0x87, 0xd2, 0xcf, 0xb0,
-	0x7f, 0x99, 0xc7, 0x66, 0xa5, 0xca, 0x0f, 0xb1, 0x3a, 0x6a, 0xb4, 0x47, 0x1d, 0x5b, 0xa6, 0xfc,
-	0x5e, 0x99, 0xdf, 0x84, 0x35, 0x4b, 0x35, 0x4e, 0xc5, 0xd8, 0xef, 0x97, 0xf9, 0x4b, 0xb0, 0xba,
-	0x65, 0xe6, 0xcc, 0x2a, 0xca, 0xfe, 0xa0, 0x8c, 0x2a, 0xd0, 0x01, 0xd9, 0x1f, 0x92, 0x9c, 0xac,
-	0xa1, 0xc2, 0x7e, 0x54, 0xe6, 0x1c, 0x56, 0x9e, 0x06, 0x49, 0x12, 0x44, 0x3d, 0x2b, 0xfb, 0x8f,
-	0xcb, 0x77, 0x7e, 0x5a, 0x80, 0xd5, 0x49, 0xa7, 0x8a, 0x49, 0x62, 0xa8, 0xa2, 0x9e, 0x36, 0x77,
-	0xb6, 0x57, 0xa0, 0x91, 0xf4, 0x55, 0xac, 0x09, 0xa4, 0x9e, 0x73, 0x44, 0x67, 0x5b, 0xa6, 0xbc,
-	0x33, 0xcd, 0x28, 0x73, 0x3b, 0x40, 0x8b, 0x1e, 0x6b, 0xe2, 0x2c, 0xf9, 0xf8, 0xfd, 0x72, 0x96,
-	0xf0, 0xd2, 0x19, 0x5b, 0x7a, 0x86, 0xc1, 0xaa, 0x48, 0x3a, 0x8a, 0x43, 0x93, 0xf8, 0xca, 0x81,
-	0x08, 0x42, 0x73, 0x39, 0x73, 0xd8, 0xc7, 0xca, 0xad, 0x61, 0xb0, 0xea, 0xd3, 0xc0, 0x5c, 0x83,
-	0xb4, 0x21, 0xcc, 0x47, 0x3d, 0xb2, 0xf5, 0x67, 0x72, 0xfb, 0xce, 0x3f, 0xfe, 0xe2, 0x56, 0xe1,
-	0xe7, 0xbf, 0xb8, 0x55, 0xf8, 0xcf, 0x5f, 0xdc, 0x2a, 0xfc, 0xf8, 0xb3, 0x5b, 0x4b, 0x3f, 0xff,
-	0xec, 0xd6, 0xd2, 0xbf, 0x7e, 0x76, 0x6b, 0xe9, 0x13, 0x36, 0xfd, 0xbf, 0xce, 0x4e, 0x95, 0x2c,
-	0xfb, 0xfe, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xe5, 0xa1, 0x33, 0xc5, 0xf2, 0x39, 0x00, 0x00,
+func (m *Comment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Comment.Merge(m, src)
+}
+func (m *Comment) XXX_Size() int {
+	return m.Size()
+}
+func (m *Comment) XXX_DiscardUnknown() {
+	xxx_messageInfo_Comment.DiscardUnknown(m)
 }
 
-func (m *SmartBlockSnapshotBase) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Comment proto.InternalMessageInfo
+
+func (m *Comment) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *SmartBlockSnapshotBase) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Comment) GetThreadId() string {
+	if m != nil {
+		return m.ThreadId
+	}
+	return ""
 }
 
-func (m *SmartBlockSnapshotBase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RemovedCollectionKeys) > 0 {
-		for iNdEx := len(m.RemovedCollectionKeys) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RemovedCollectionKeys[iNdEx])
-			copy(dAtA[i:], m.RemovedCollectionKeys[iNdEx])
-			i = encodeVarintModels(dAtA, i, uint64(len(m.RemovedCollectionKeys[iNdEx])))
-			i--
-			dAtA[i] = 0x42
-		}
+func (m *Comment) GetTargetObjectId() string {
+	if m != nil {
+		return m.TargetObjectId
 	}
-	if len(m.RelationLinks) > 0 {
-		for iNdEx := len(m.RelationLinks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RelationLinks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModels(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
-		}
+	return ""
+}
+
+func (m *Comment) GetTargetBlockId() string {
+	if m != nil {
+		return m.TargetBlockId
 	}
-	if m.Collections != nil {
-		{
-			size, err := m.Collections.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintModels(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
+	return ""
+}
+
+func (m *Comment) GetRange() *Range {
+	if m != nil {
+		return m.Range
 	}
-	if len(m.ObjectTypes) > 0 {
-		for iNdEx := len(m.ObjectTypes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ObjectTypes[iNdEx])
-			copy(dAtA[i:], m.ObjectTypes[iNdEx])
-			i = encodeVarintModels(dAtA, i, uint64(len(m.ObjectTypes[iNdEx])))
-			i--
-			dAtA[i] = 0x2a
-		}
+	return nil
+}
+
+func (m *Comment) GetText() string {
+	if m != nil {
+		return m.Text
 	}
-	if len(m.ExtraRelations) > 0 {
-		for iNdEx := len(m.ExtraRelations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ExtraRelations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModels(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
+	return ""
+}
+
+func (m *Comment) GetMarks() *BlockContentTextMarks {
+	if m != nil {
+		return m.Marks
 	}
-	if m.FileKeys != nil {
-		{
-			size, err := m.FileKeys.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintModels(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	return nil
+}
+
+func (m *Comment) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if m.Details != nil {
-		{
-			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintModels(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *Comment) GetCreatedDate() int64 {
+	if m != nil {
+		return m.CreatedDate
 	}
-	if len(m.Blocks) > 0 {
-		for iNdEx := len(m.Blocks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Blocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModels(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return 0
+}
+
+func (m *Comment) GetModifiedDate() int64 {
+	if m != nil {
+		return m.ModifiedDate
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Block) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Comment) GetIsResolved() bool {
+	if m != nil {
+		return m.IsResolved
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *Block) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Text block with the checkbox style, extracted from the object by the indexer
+type Task struct {
+	ObjectId string   `protobuf:"bytes,1,opt,name=objectId,proto3" json:"objectId,omitempty"`
+	BlockId  string   `protobuf:"bytes,2,opt,name=blockId,proto3" json:"blockId,omitempty"`
+	Text     string   `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	Checked  bool     `protobuf:"varint,4,opt,name=checked,proto3" json:"checked,omitempty"`
+	Mentions []string `protobuf:"bytes,5,rep,name=mentions,proto3" json:"mentions,omitempty"`
+	Dates    []int64  `protobuf:"varint,6,rep,packed,name=dates,proto3" json:"dates,omitempty"`
 }
 
-func (m *Block) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Content != nil {
-		{
-			size := m.Content.Size()
-			i -= size
-			if _, err := m.Content.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+func (m *Task) Reset()         { *m = Task{} }
+func (m *Task) String() string { return proto.CompactTextString(m) }
+func (*Task) ProtoMessage()    {}
+func (*Task) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a910b73321e591, []int{21}
+}
+func (m *Task) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Task) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Task.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.VerticalAlign != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.VerticalAlign))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.Align != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Align))
-		i--
-		dAtA[i] = 0x30
+}
+func (m *Task) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Task.Merge(m, src)
+}
+func (m *Task) XXX_Size() int {
+	return m.Size()
+}
+func (m *Task) XXX_DiscardUnknown() {
+	xxx_messageInfo_Task.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Task proto.InternalMessageInfo
+
+func (m *Task) GetObjectId() string {
+	if m != nil {
+		return m.ObjectId
 	}
-	if len(m.BackgroundColor) > 0 {
-		i -= len(m.BackgroundColor)
-		copy(dAtA[i:], m.BackgroundColor)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.BackgroundColor)))
-		i--
-		dAtA[i] = 0x2a
+	return ""
+}
+
+func (m *Task) GetBlockId() string {
+	if m != nil {
+		return m.BlockId
 	}
-	if len(m.ChildrenIds) > 0 {
-		for iNdEx := len(m.ChildrenIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ChildrenIds[iNdEx])
-			copy(dAtA[i:], m.ChildrenIds[iNdEx])
-			i = encodeVarintModels(dAtA, i, uint64(len(m.ChildrenIds[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
+	return ""
+}
+
+func (m *Task) GetText() string {
+	if m != nil {
+		return m.Text
 	}
-	if m.Restrictions != nil {
-		{
-			size, err := m.Restrictions.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintModels(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *Task) GetChecked() bool {
+	if m != nil {
+		return m.Checked
 	}
-	if m.Fields != nil {
-		{
-			size, err := m.Fields.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintModels(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	return false
+}
+
+func (m *Task) GetMentions() []string {
+	if m != nil {
+		return m.Mentions
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *Task) GetDates() []int64 {
+	if m != nil {
+		return m.Dates
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *BlockContentOfSmartblock) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Tasks struct {
+	Tasks []*Task `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
 }
 
-func (m *BlockContentOfSmartblock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Smartblock != nil {
-		{
-			size, err := m.Smartblock.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintModels(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x5a
-	}
-	return len(dAtA) - i, nil
+func (m *Tasks) Reset()         { *m = Tasks{} }
+func (m *Tasks) String() string { return proto.CompactTextString(m) }
+func (*Tasks) ProtoMessage()    {}
+func (*Tasks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a910b73321e591, []int{22}
 }
-func (m *BlockContentOfText) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Tasks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *BlockContentOfText) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Text != nil {
-		{
-			size, err := m.Text.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintModels(dAtA, i, uint64(size))
+func (m *Tasks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Tasks.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x72
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *BlockContentOfFile) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Tasks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Tasks.Merge(m, src)
 }
-
-func (m *BlockContentOfFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.File != nil {
-		{
-			size, err := m.File.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintModels(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x7a
-	}
-	return len(dAtA) - i, nil
+func (m *Tasks) XXX_Size() int {
+	return m.Size()
 }
-func (m *BlockContentOfLayout) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Tasks) XXX_DiscardUnknown() {
+	xxx_messageInfo_Tasks.DiscardUnknown(m)
 }
 
-func (m *BlockContentOfLayout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Layout != nil {
-		{
-			size, err := m.Layout.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintModels(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
+var xxx_messageInfo_Tasks proto.InternalMessageInfo
+
+func (m *Tasks) GetTasks() []*Task {
+	if m != nil {
+		return m.Tasks
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
-func (m *BlockContentOfDiv) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+// Link from the block of one object to another object or to the specific block of it.
+// Block references have the form of <objectId>#<blockId>
+type BlockLink struct {
+	SourceObjectId string `protobuf:"bytes,1,opt,name=sourceObjectId,proto3" json:"sourceObjectId,omitempty"`
+	SourceBlockId  string `protobuf:"bytes,2,opt,name=sourceBlockId,proto3" json:"sourceBlockId,omitempty"`
+	TargetObjectId string `protobuf:"bytes,3,opt,name=targetObjectId,proto3" json:"targetObjectId,omitempty"`
+	TargetBlockId  string `protobuf:"bytes,4,opt,name=targetBlockId,proto3" json:"targetBlockId,omitempty"`
 }
 
-func (m *BlockContentOfDiv) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Div != nil {
-		{
-			size, err := m.Div.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintModels(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x8a
-	}
-	return len(dAtA) - i, nil
+func (m *BlockLink) Reset()         { *m = BlockLink{} }
+func (m *BlockLink) String() string { return proto.CompactTextString(m) }
+func (*BlockLink) ProtoMessage()    {}
+func (*BlockLink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a910b73321e591, []int{23}
 }
-func (m *BlockContentOfBookmark) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BlockLink) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *BlockContentOfBookmark) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Bookmark != nil {
-		{
-			size, err := m.Bookmark.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintModels(dAtA, i, uint64(size))
+func (m *BlockLink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockLink.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x92
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *BlockContentOfIcon) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BlockLink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockLink.Merge(m, src)
+}
+func (m *BlockLink) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockLink) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockLink.DiscardUnknown(m)
 }
 
-func (m *BlockContentOfIcon) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Icon != nil {
-		{
-			size, err := m.Icon.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintModels(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x9a
+var xxx_messageInfo_BlockLink proto.InternalMessageInfo
+
+func (m *BlockLink) GetSourceObjectId() string {
+	if m != nil {
+		return m.SourceObjectId
 	}
-	return len(dAtA) - i, nil
-}
-func (m *BlockContentOfLink) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return ""
 }
 
-func (m *BlockContentOfLink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Link != nil {
-		{
-			size, err := m.Link.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintModels(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa2
+func (m *BlockLink) GetSourceBlockId() string {
+	if m != nil {
+		return m.SourceBlockId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
-func (m *BlockContentOfDataview) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func (m *BlockLink) GetTargetObjectId() string {
+	if m != nil {
+		return m.TargetObjectId
+	}
+	return ""
 }
 
-func (m *BlockContentOfDataview) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Dataview != nil {
-		{
-			size, err := m.Dataview.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintModels(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xaa
+func (m *BlockLink) GetTargetBlockId() string {
+	if m != nil {
+		return m.TargetBlockId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
-func (m *BlockContentOfRelation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+type BlockLinks struct {
+	Links []*BlockLink `protobuf:"bytes,1,rep,name=links,proto3" json:"links,omitempty"`
 }
 
-func (m *BlockContentOfRelation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Relation != nil {
-		{
-			size, err := m.Relation.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintModels(dAtA, i, uint64(size))
+func (m *BlockLinks) Reset()         { *m = BlockLinks{} }
+func (m *BlockLinks) String() string { return proto.CompactTextString(m) }
+func (*BlockLinks) ProtoMessage()    {}
+func (*BlockLinks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a910b73321e591, []int{24}
+}
+func (m *BlockLinks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockLinks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockLinks.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xb2
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *BlockContentOfFeaturedRelations) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BlockLinks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockLinks.Merge(m, src)
+}
+func (m *BlockLinks) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockLinks) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockLinks.DiscardUnknown(m)
 }
 
-func (m *BlockContentOfFeaturedRelations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.FeaturedRelations != nil {
-		{
-			size, err := m.FeaturedRelations.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintModels(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xba
+var xxx_messageInfo_BlockLinks proto.InternalMessageInfo
+
+func (m *BlockLinks) GetLinks() []*BlockLink {
+	if m != nil {
+		return m.Links
 	}
-	return len(dAtA) - i, nil
-}
-func (m *BlockContentOfLatex) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return nil
 }
 
-func (m *BlockContentOfLatex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Latex != nil {
-		{
-			size, err := m.Latex.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintModels(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xc2
+func init() {
+	proto.RegisterEnum("anytype.model.SmartBlockType", SmartBlockType_name, SmartBlockType_value)
+	proto.RegisterEnum("anytype.model.RelationFormat", RelationFormat_name, RelationFormat_value)
+	proto.RegisterEnum("anytype.model.BlockPosition", BlockPosition_name, BlockPosition_value)
+	proto.RegisterEnum("anytype.model.BlockAlign", BlockAlign_name, BlockAlign_value)
+	proto.RegisterEnum("anytype.model.BlockVerticalAlign", BlockVerticalAlign_name, BlockVerticalAlign_value)
+	proto.RegisterEnum("anytype.model.BlockContentLayoutStyle", BlockContentLayoutStyle_name, BlockContentLayoutStyle_value)
+	proto.RegisterEnum("anytype.model.BlockContentLinkIconSize", BlockContentLinkIconSize_name, BlockContentLinkIconSize_value)
+	proto.RegisterEnum("anytype.model.BlockContentLinkStyle", BlockContentLinkStyle_name, BlockContentLinkStyle_value)
+	proto.RegisterEnum("anytype.model.BlockContentLinkDescription", BlockContentLinkDescription_name, BlockContentLinkDescription_value)
+	proto.RegisterEnum("anytype.model.BlockContentLinkCardStyle", BlockContentLinkCardStyle_name, BlockContentLinkCardStyle_value)
+	proto.RegisterEnum("anytype.model.BlockContentDivStyle", BlockContentDivStyle_name, BlockContentDivStyle_value)
+	proto.RegisterEnum("anytype.model.BlockContentBookmarkState", BlockContentBookmarkState_name, BlockContentBookmarkState_value)
+	proto.RegisterEnum("anytype.model.BlockContentTextStyle", BlockContentTextStyle_name, BlockContentTextStyle_value)
+	proto.RegisterEnum("anytype.model.BlockContentTextMarkType", BlockContentTextMarkType_name, BlockContentTextMarkType_value)
+	proto.RegisterEnum("anytype.model.BlockContentFileType", BlockContentFileType_name, BlockContentFileType_value)
+	proto.RegisterEnum("anytype.model.BlockContentFileStyle", BlockContentFileStyle_name, BlockContentFileStyle_value)
+	proto.RegisterEnum("anytype.model.BlockContentFileState", BlockContentFileState_name, BlockContentFileState_value)
+	proto.RegisterEnum("anytype.model.BlockContentDataviewViewType", BlockContentDataviewViewType_name, BlockContentDataviewViewType_value)
+	proto.RegisterEnum("anytype.model.BlockContentDataviewViewSize", BlockContentDataviewViewSize_name, BlockContentDataviewViewSize_value)
+	proto.RegisterEnum("anytype.model.BlockContentDataviewRelationDateFormat", BlockContentDataviewRelationDateFormat_name, BlockContentDataviewRelationDateFormat_value)
+	proto.RegisterEnum("anytype.model.BlockContentDataviewRelationTimeFormat", BlockContentDataviewRelationTimeFormat_name, BlockContentDataviewRelationTimeFormat_value)
+	proto.RegisterEnum("anytype.model.BlockContentDataviewSortType", BlockContentDataviewSortType_name, BlockContentDataviewSortType_value)
+	proto.RegisterEnum("anytype.model.BlockContentDataviewFilterOperator", BlockContentDataviewFilterOperator_name, BlockContentDataviewFilterOperator_value)
+	proto.RegisterEnum("anytype.model.BlockContentDataviewFilterCondition", BlockContentDataviewFilterCondition_name, BlockContentDataviewFilterCondition_value)
+	proto.RegisterEnum("anytype.model.BlockContentDataviewFilterQuickOption", BlockContentDataviewFilterQuickOption_name, BlockContentDataviewFilterQuickOption_value)
+	proto.RegisterEnum("anytype.model.BlockContentWidgetLayout", BlockContentWidgetLayout_name, BlockContentWidgetLayout_value)
+	proto.RegisterEnum("anytype.model.BlockContentDiagramLanguage", BlockContentDiagramLanguage_name, BlockContentDiagramLanguage_value)
+	proto.RegisterEnum("anytype.model.ImageEditOptionsFormat", ImageEditOptionsFormat_name, ImageEditOptionsFormat_value)
+	proto.RegisterEnum("anytype.model.AccountStatusType", AccountStatusType_name, AccountStatusType_value)
+	proto.RegisterEnum("anytype.model.LinkPreviewType", LinkPreviewType_name, LinkPreviewType_value)
+	proto.RegisterEnum("anytype.model.RestrictionsObjectRestriction", RestrictionsObjectRestriction_name, RestrictionsObjectRestriction_value)
+	proto.RegisterEnum("anytype.model.RestrictionsDataviewRestriction", RestrictionsDataviewRestriction_name, RestrictionsDataviewRestriction_value)
+	proto.RegisterEnum("anytype.model.ObjectTypeLayout", ObjectTypeLayout_name, ObjectTypeLayout_value)
+	proto.RegisterEnum("anytype.model.RelationScope", RelationScope_name, RelationScope_value)
+	proto.RegisterEnum("anytype.model.RelationDataSource", RelationDataSource_name, RelationDataSource_value)
+	proto.RegisterEnum("anytype.model.RelationConstraintViolationType", RelationConstraintViolationType_name, RelationConstraintViolationType_value)
+	proto.RegisterEnum("anytype.model.InternalFlagValue", InternalFlagValue_name, InternalFlagValue_value)
+	proto.RegisterType((*SmartBlockSnapshotBase)(nil), "anytype.model.SmartBlockSnapshotBase")
+	proto.RegisterType((*Block)(nil), "anytype.model.Block")
+	proto.RegisterType((*BlockRestrictions)(nil), "anytype.model.Block.Restrictions")
+	proto.RegisterType((*BlockContent)(nil), "anytype.model.Block.Content")
+	proto.RegisterType((*BlockContentLayout)(nil), "anytype.model.Block.Content.Layout")
+	proto.RegisterType((*BlockContentLink)(nil), "anytype.model.Block.Content.Link")
+	proto.RegisterType((*BlockContentDiv)(nil), "anytype.model.Block.Content.Div")
+	proto.RegisterType((*BlockContentBookmark)(nil), "anytype.model.Block.Content.Bookmark")
+	proto.RegisterType((*BlockContentIcon)(nil), "anytype.model.Block.Content.Icon")
+	proto.RegisterType((*BlockContentFeaturedRelations)(nil), "anytype.model.Block.Content.FeaturedRelations")
+	proto.RegisterType((*BlockContentText)(nil), "anytype.model.Block.Content.Text")
+	proto.RegisterType((*BlockContentTextMarks)(nil), "anytype.model.Block.Content.Text.Marks")
+	proto.RegisterType((*BlockContentTextMark)(nil), "anytype.model.Block.Content.Text.Mark")
+	proto.RegisterType((*BlockContentFile)(nil), "anytype.model.Block.Content.File")
+	proto.RegisterType((*BlockContentSmartblock)(nil), "anytype.model.Block.Content.Smartblock")
+	proto.RegisterType((*BlockContentDataview)(nil), "anytype.model.Block.Content.Dataview")
+	proto.RegisterType((*BlockContentDataviewView)(nil), "anytype.model.Block.Content.Dataview.View")
+	proto.RegisterType((*BlockContentDataviewRelation)(nil), "anytype.model.Block.Content.Dataview.Relation")
+	proto.RegisterType((*BlockContentDataviewSort)(nil), "anytype.model.Block.Content.Dataview.Sort")
+	proto.RegisterType((*BlockContentDataviewFilter)(nil), "anytype.model.Block.Content.Dataview.Filter")
+	proto.RegisterType((*BlockContentDataviewGroupOrder)(nil), "anytype.model.Block.Content.Dataview.GroupOrder")
+	proto.RegisterType((*BlockContentDataviewViewGroup)(nil), "anytype.model.Block.Content.Dataview.ViewGroup")
+	proto.RegisterType((*BlockContentDataviewObjectOrder)(nil), "anytype.model.Block.Content.Dataview.ObjectOrder")
+	proto.RegisterType((*BlockContentDataviewGroup)(nil), "anytype.model.Block.Content.Dataview.Group")
+	proto.RegisterType((*BlockContentDataviewStatus)(nil), "anytype.model.Block.Content.Dataview.Status")
+	proto.RegisterType((*BlockContentDataviewTag)(nil), "anytype.model.Block.Content.Dataview.Tag")
+	proto.RegisterType((*BlockContentDataviewCheckbox)(nil), "anytype.model.Block.Content.Dataview.Checkbox")
+	proto.RegisterType((*BlockContentDataviewDate)(nil), "anytype.model.Block.Content.Dataview.Date")
+	proto.RegisterType((*BlockContentRelation)(nil), "anytype.model.Block.Content.Relation")
+	proto.RegisterType((*BlockContentLatex)(nil), "anytype.model.Block.Content.Latex")
+	proto.RegisterType((*BlockContentTableOfContents)(nil), "anytype.model.Block.Content.TableOfContents")
+	proto.RegisterType((*BlockContentTable)(nil), "anytype.model.Block.Content.Table")
+	proto.RegisterType((*BlockContentTableColumn)(nil), "anytype.model.Block.Content.TableColumn")
+	proto.RegisterType((*BlockContentTableRow)(nil), "anytype.model.Block.Content.TableRow")
+	proto.RegisterType((*BlockContentWidget)(nil), "anytype.model.Block.Content.Widget")
+	proto.RegisterType((*BlockContentSynced)(nil), "anytype.model.Block.Content.Synced")
+	proto.RegisterType((*BlockContentDiagram)(nil), "anytype.model.Block.Content.Diagram")
+	proto.RegisterType((*BlockMetaOnly)(nil), "anytype.model.BlockMetaOnly")
+	proto.RegisterType((*Range)(nil), "anytype.model.Range")
+	proto.RegisterType((*ImageEditOptions)(nil), "anytype.model.ImageEditOptions")
+	proto.RegisterType((*ImageEditOptionsRect)(nil), "anytype.model.ImageEditOptions.Rect")
+	proto.RegisterType((*Account)(nil), "anytype.model.Account")
+	proto.RegisterType((*AccountAvatar)(nil), "anytype.model.Account.Avatar")
+	proto.RegisterType((*AccountConfig)(nil), "anytype.model.Account.Config")
+	proto.RegisterType((*AccountStatus)(nil), "anytype.model.Account.Status")
+	proto.RegisterType((*AccountInfo)(nil), "anytype.model.Account.Info")
+	proto.RegisterType((*LinkPreview)(nil), "anytype.model.LinkPreview")
+	proto.RegisterType((*Restrictions)(nil), "anytype.model.Restrictions")
+	proto.RegisterType((*RestrictionsDataviewRestrictions)(nil), "anytype.model.Restrictions.DataviewRestrictions")
+	proto.RegisterType((*Object)(nil), "anytype.model.Object")
+	proto.RegisterType((*ObjectChangePayload)(nil), "anytype.model.Object.ChangePayload")
+	proto.RegisterType((*ObjectType)(nil), "anytype.model.ObjectType")
+	proto.RegisterType((*Layout)(nil), "anytype.model.Layout")
+	proto.RegisterType((*RelationWithValue)(nil), "anytype.model.RelationWithValue")
+	proto.RegisterType((*Relation)(nil), "anytype.model.Relation")
+	proto.RegisterType((*RelationOption)(nil), "anytype.model.Relation.Option")
+	proto.RegisterType((*RelationLink)(nil), "anytype.model.RelationLink")
+	proto.RegisterType((*RelationConstraint)(nil), "anytype.model.RelationConstraint")
+	proto.RegisterType((*RelationConstraintViolation)(nil), "anytype.model.RelationConstraintViolation")
+	proto.RegisterType((*Relations)(nil), "anytype.model.Relations")
+	proto.RegisterType((*RelationOptions)(nil), "anytype.model.RelationOptions")
+	proto.RegisterType((*InternalFlag)(nil), "anytype.model.InternalFlag")
+	proto.RegisterType((*ObjectView)(nil), "anytype.model.ObjectView")
+	proto.RegisterType((*ObjectViewDetailsSet)(nil), "anytype.model.ObjectView.DetailsSet")
+	proto.RegisterType((*ObjectViewRelationWithValuePerObject)(nil), "anytype.model.ObjectView.RelationWithValuePerObject")
+	proto.RegisterType((*ObjectViewHistorySize)(nil), "anytype.model.ObjectView.HistorySize")
+	proto.RegisterType((*Comment)(nil), "anytype.model.Comment")
+	proto.RegisterType((*Task)(nil), "anytype.model.Task")
+	proto.RegisterType((*Tasks)(nil), "anytype.model.Tasks")
+	proto.RegisterType((*BlockLink)(nil), "anytype.model.BlockLink")
+	proto.RegisterType((*BlockLinks)(nil), "anytype.model.BlockLinks")
+}
+
+func init() {
+	proto.RegisterFile("pkg/lib/pb/model/protos/models.proto", fileDescriptor_98a910b73321e591)
+}
+
+var fileDescriptor_98a910b73321e591 = []byte{
+	// 5809 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x7c, 0x4d, 0x6c, 0x24, 0xc7,
+	0x75, 0x30, 0xe7, 0x7f, 0xe6, 0x0d, 0xc9, 0x2d, 0xd6, 0xae, 0x56, 0xf3, 0xcd, 0xca, 0xeb, 0x75,
+	0x7f, 0xb2, 0xbc, 0x5a, 0xcb, 0x5c, 0x69, 0x57, 0x2b, 0xc9, 0x8e, 0xf5, 0xc3, 0xbf, 0x15, 0x19,
+	0xed, 0x8a, 0x74, 0x0f, 0x97, 0x8a, 0x85, 0x24, 0x70, 0x71, 0xba, 0x38, 0xd3, 0x62, 0x4f, 0xd7,
+	0xb8, 0xbb, 0x86, 0x4b, 0x1a, 0x08, 0xe0, 0x38, 0x89, 0x7d, 0x0b, 0x8c, 0x00, 0xb9, 0x25, 0x80,
+	0x7d, 0xca, 0x25, 0xb7, 0xc4, 0x48, 0x02, 0xe4, 0x90, 0x4b, 0x80, 0x00, 0x39, 0xc4, 0xb9, 0xe5,
+	0x94, 0x04, 0x12, 0x72, 0xca, 0x21, 0x40, 0xce, 0x01, 0x12, 0xbc, 0x57, 0xd5, 0x3d, 0xdd, 0x33,
+	0x43, 0x72, 0x56, 0xd6, 0x89, 0x53, 0xaf, 0xdf, 0x7b, 0x5d, 0x3f, 0xaf, 0xde, 0x7f, 0x13, 0x5e,
+	0x1c, 0x1e, 0xf7, 0xee, 0x06, 0xfe, 0xe1, 0xdd, 0xe1, 0xe1, 0xdd, 0x81, 0xf2, 0x64, 0x70, 0x77,
+	0x18, 0x29, 0xad, 0x62, 0x33, 0x88, 0x57, 0x69, 0xc4, 0x97, 0x44, 0x78, 0xa6, 0xcf, 0x86, 0x72,
+	0x95, 0xa0, 0xed, 0x17, 0x7a, 0x4a, 0xf5, 0x02, 0x69, 0x50, 0x0f, 0x47, 0x47, 0x77, 0x63, 0x1d,
+	0x8d, 0xba, 0xda, 0x20, 0x3b, 0x7f, 0x5f, 0x82, 0xeb, 0x9d, 0x81, 0x88, 0xf4, 0x7a, 0xa0, 0xba,
+	0xc7, 0x9d, 0x50, 0x0c, 0xe3, 0xbe, 0xd2, 0xeb, 0x22, 0x96, 0xfc, 0x15, 0xa8, 0x1e, 0x22, 0x30,
+	0x6e, 0x15, 0x6e, 0x95, 0x6e, 0x37, 0xef, 0x5d, 0x5b, 0xcd, 0x31, 0x5e, 0x25, 0x0a, 0xd7, 0xe2,
+	0xf0, 0xd7, 0xa0, 0xe6, 0x49, 0x2d, 0xfc, 0x20, 0x6e, 0x15, 0x6f, 0x15, 0x6e, 0x37, 0xef, 0x3d,
+	0xbf, 0x6a, 0x5e, 0xbc, 0x9a, 0xbc, 0x78, 0xb5, 0x43, 0x2f, 0x76, 0x13, 0x3c, 0x7e, 0x1f, 0xea,
+	0x47, 0x7e, 0x20, 0x3f, 0x90, 0x67, 0x71, 0xab, 0x74, 0x31, 0x4d, 0x8a, 0xc8, 0xdf, 0x85, 0x65,
+	0x79, 0xaa, 0x23, 0xe1, 0xca, 0x40, 0x68, 0x5f, 0x85, 0x71, 0xab, 0x4c, 0xb3, 0x7b, 0x7e, 0x62,
+	0x76, 0xc9, 0x73, 0x77, 0x02, 0x9d, 0xdf, 0x82, 0xa6, 0x3a, 0xfc, 0x44, 0x76, 0xf5, 0xfe, 0xd9,
+	0x50, 0xc6, 0xad, 0xca, 0xad, 0xd2, 0xed, 0x86, 0x9b, 0x05, 0xf1, 0x6f, 0x42, 0xb3, 0xab, 0x82,
+	0x40, 0x76, 0x0d, 0xff, 0xea, 0xc5, 0x53, 0xcb, 0xe2, 0xf2, 0xd7, 0xe1, 0xb9, 0x48, 0x0e, 0xd4,
+	0x89, 0xf4, 0x36, 0x52, 0x28, 0xad, 0xaf, 0x4e, 0xaf, 0x99, 0xfd, 0x90, 0xaf, 0xc1, 0x52, 0x64,
+	0xe7, 0xf7, 0xc8, 0x0f, 0x8f, 0xe3, 0x56, 0x8d, 0x96, 0x74, 0xe3, 0x9c, 0x25, 0x21, 0x8e, 0x9b,
+	0xa7, 0x70, 0x7e, 0xf2, 0x10, 0x2a, 0x74, 0x20, 0x7c, 0x19, 0x8a, 0xbe, 0xd7, 0x2a, 0xdc, 0x2a,
+	0xdc, 0x6e, 0xb8, 0x45, 0xdf, 0xe3, 0x77, 0xa1, 0x7a, 0xe4, 0xcb, 0xc0, 0xbb, 0xf4, 0x5c, 0x2c,
+	0x1a, 0xdf, 0x82, 0xc5, 0x48, 0xc6, 0x3a, 0xf2, 0xed, 0xfa, 0xcd, 0xd1, 0x7c, 0x65, 0xd6, 0xe9,
+	0xaf, 0xba, 0x19, 0x44, 0x37, 0x47, 0x86, 0xfb, 0xdc, 0xed, 0xfb, 0x81, 0x17, 0xc9, 0x70, 0xc7,
+	0x33, 0xa7, 0xd4, 0x70, 0xb3, 0x20, 0x7e, 0x1b, 0xae, 0x1c, 0x8a, 0xee, 0x71, 0x2f, 0x52, 0xa3,
+	0x10, 0xb7, 0x44, 0x45, 0xad, 0x0a, 0x4d, 0x7b, 0x12, 0xcc, 0x5f, 0x85, 0x8a, 0x08, 0xfc, 0x5e,
+	0x48, 0x67, 0xb1, 0x7c, 0xaf, 0x3d, 0x73, 0x2e, 0x6b, 0x88, 0xe1, 0x1a, 0x44, 0xbe, 0x0d, 0x4b,
+	0x27, 0x32, 0xd2, 0x7e, 0x57, 0x04, 0x04, 0x6f, 0xd5, 0x88, 0xd2, 0x99, 0x49, 0x79, 0x90, 0xc5,
+	0x74, 0xf3, 0x84, 0x7c, 0x07, 0x20, 0xc6, 0x0b, 0x42, 0x72, 0xde, 0x6a, 0xd2, 0x66, 0x7c, 0x6d,
+	0x26, 0x9b, 0x0d, 0x15, 0x6a, 0x19, 0xea, 0xd5, 0x4e, 0x8a, 0xbe, 0xbd, 0xe0, 0x66, 0x88, 0xf9,
+	0x9b, 0x50, 0xd6, 0xf2, 0x54, 0xb7, 0x96, 0x2f, 0xd8, 0xd1, 0x84, 0xc9, 0xbe, 0x3c, 0xd5, 0xdb,
+	0x0b, 0x2e, 0x11, 0x20, 0x21, 0x5e, 0x80, 0xd6, 0x95, 0x39, 0x08, 0x1f, 0xfa, 0x81, 0x44, 0x42,
+	0x24, 0xe0, 0x6f, 0x43, 0x35, 0x10, 0x67, 0x6a, 0xa4, 0x5b, 0x8c, 0x48, 0xff, 0xff, 0x85, 0xa4,
+	0x8f, 0x08, 0x75, 0x7b, 0xc1, 0xb5, 0x44, 0xfc, 0x75, 0x28, 0x79, 0xfe, 0x49, 0x6b, 0x85, 0x68,
+	0x6f, 0x5d, 0x48, 0xbb, 0xe9, 0x9f, 0x6c, 0x2f, 0xb8, 0x88, 0xce, 0x37, 0xa0, 0x7e, 0xa8, 0xd4,
+	0xf1, 0x40, 0x44, 0xc7, 0x2d, 0x4e, 0xa4, 0x5f, 0xbd, 0x90, 0x74, 0xdd, 0x22, 0x6f, 0x2f, 0xb8,
+	0x29, 0x21, 0x2e, 0xd9, 0xef, 0xaa, 0xb0, 0x75, 0x75, 0x8e, 0x25, 0xef, 0x74, 0x55, 0x88, 0x4b,
+	0x46, 0x02, 0x24, 0x0c, 0xfc, 0xf0, 0xb8, 0x75, 0x6d, 0x0e, 0x42, 0xbc, 0x3b, 0x48, 0x88, 0x04,
+	0x38, 0x6d, 0x4f, 0x68, 0x71, 0xe2, 0xcb, 0xa7, 0xad, 0xe7, 0xe6, 0x98, 0xf6, 0xa6, 0x45, 0xc6,
+	0x69, 0x27, 0x84, 0xc8, 0x24, 0xb9, 0x98, 0xad, 0xeb, 0x73, 0x30, 0x49, 0xee, 0x34, 0x32, 0x49,
+	0x08, 0xf9, 0x6f, 0xc3, 0xca, 0x91, 0x14, 0x7a, 0x14, 0x49, 0x6f, 0xac, 0xe6, 0x9e, 0x27, 0x6e,
+	0xab, 0x17, 0x9f, 0xfd, 0x24, 0xd5, 0xf6, 0x82, 0x3b, 0xcd, 0x8a, 0x7f, 0x0b, 0x2a, 0x81, 0xd0,
+	0xf2, 0xb4, 0xd5, 0x22, 0x9e, 0xce, 0x25, 0x42, 0xa1, 0xe5, 0xe9, 0xf6, 0x82, 0x6b, 0x48, 0xf8,
+	0x6f, 0xc0, 0x15, 0x2d, 0x0e, 0x03, 0xb9, 0x7b, 0x64, 0x11, 0xe2, 0xd6, 0xff, 0x23, 0x2e, 0xaf,
+	0x5c, 0x2c, 0xce, 0x79, 0x9a, 0xed, 0x05, 0x77, 0x92, 0x0d, 0xce, 0x8a, 0x40, 0xad, 0xf6, 0x1c,
+	0xb3, 0x22, 0x7e, 0x38, 0x2b, 0x22, 0xe1, 0x8f, 0xa0, 0x49, 0x3f, 0x36, 0x54, 0x30, 0x1a, 0x84,
+	0xad, 0x1b, 0xc4, 0xe1, 0xf6, 0xe5, 0x1c, 0x0c, 0xfe, 0xf6, 0x82, 0x9b, 0x25, 0xc7, 0x43, 0xa4,
+	0xa1, 0xab, 0x9e, 0xb6, 0x5e, 0x98, 0xe3, 0x10, 0xf7, 0x2d, 0x32, 0x1e, 0x62, 0x42, 0x88, 0x57,
+	0xef, 0xa9, 0xef, 0xf5, 0xa4, 0x6e, 0x7d, 0x69, 0x8e, 0xab, 0xf7, 0x11, 0xa1, 0xe2, 0xd5, 0x33,
+	0x44, 0x48, 0x1e, 0x9f, 0x85, 0x5d, 0xe9, 0xb5, 0x6e, 0xce, 0x41, 0xde, 0x21, 0x54, 0x24, 0x37,
+	0x44, 0xfc, 0x3d, 0xa8, 0x79, 0xbe, 0xe8, 0x45, 0x62, 0xd0, 0xfa, 0x32, 0xd1, 0xbf, 0x78, 0xc9,
+	0xed, 0x25, 0xdc, 0xed, 0x05, 0x37, 0x21, 0x6b, 0xff, 0x00, 0x16, 0xb3, 0xda, 0x9d, 0x73, 0x28,
+	0x47, 0x52, 0x18, 0xcb, 0x52, 0x77, 0xe9, 0x37, 0xc2, 0xa4, 0xe7, 0x6b, 0xb2, 0x2c, 0x75, 0x97,
+	0x7e, 0xf3, 0xeb, 0x50, 0x35, 0x56, 0x8e, 0x0c, 0x47, 0xdd, 0xb5, 0x23, 0xc4, 0xf5, 0x22, 0xd1,
+	0x6b, 0x95, 0x0d, 0x2e, 0xfe, 0x46, 0x5c, 0x2f, 0x52, 0xc3, 0xdd, 0x90, 0x14, 0x7f, 0xdd, 0xb5,
+	0xa3, 0xf6, 0x5f, 0xbe, 0x01, 0x35, 0x3b, 0xb5, 0xf6, 0x9f, 0x16, 0xa0, 0x6a, 0x14, 0x13, 0x7f,
+	0x17, 0x2a, 0xb1, 0x3e, 0x0b, 0x24, 0xcd, 0x61, 0xf9, 0xde, 0xcb, 0x73, 0x28, 0xb3, 0xd5, 0x0e,
+	0x12, 0xb8, 0x86, 0xce, 0x71, 0xa1, 0x42, 0x63, 0x5e, 0x83, 0x92, 0xab, 0x9e, 0xb2, 0x05, 0x0e,
+	0x50, 0x35, 0x87, 0xce, 0x0a, 0x08, 0xdc, 0xf4, 0x4f, 0x58, 0x11, 0x81, 0xdb, 0x52, 0x78, 0x32,
+	0x62, 0x25, 0xbe, 0x04, 0x8d, 0xe4, 0x78, 0x63, 0x56, 0xe6, 0x0c, 0x16, 0x33, 0x82, 0x13, 0xb3,
+	0x4a, 0xfb, 0xbf, 0xcb, 0x50, 0x46, 0x3d, 0xc2, 0x5f, 0x84, 0x25, 0x2d, 0xa2, 0x9e, 0x34, 0xae,
+	0xd4, 0x4e, 0x62, 0x83, 0xf3, 0x40, 0xfe, 0x76, 0xb2, 0x86, 0x22, 0xad, 0xe1, 0x6b, 0x97, 0xea,
+	0xa7, 0xdc, 0x0a, 0x32, 0xd6, 0xbc, 0x34, 0x9f, 0x35, 0x7f, 0x08, 0x75, 0x54, 0x8b, 0x1d, 0xff,
+	0x07, 0x92, 0xb6, 0x7e, 0xf9, 0xde, 0x9d, 0xcb, 0x5f, 0xb9, 0x63, 0x29, 0xdc, 0x94, 0x96, 0xef,
+	0x40, 0xa3, 0x2b, 0x22, 0x8f, 0x26, 0x43, 0xa7, 0xb5, 0x7c, 0xef, 0xeb, 0x97, 0x33, 0xda, 0x48,
+	0x48, 0xdc, 0x31, 0x35, 0xdf, 0x85, 0xa6, 0x27, 0xe3, 0x6e, 0xe4, 0x0f, 0x49, 0x4d, 0x1a, 0x9b,
+	0xfe, 0x8d, 0xcb, 0x99, 0x6d, 0x8e, 0x89, 0xdc, 0x2c, 0x07, 0xfe, 0x02, 0x34, 0xa2, 0x54, 0x4f,
+	0xd6, 0xc8, 0xd1, 0x18, 0x03, 0x9c, 0x37, 0xa1, 0x9e, 0xac, 0x87, 0x2f, 0x42, 0x1d, 0xff, 0x7e,
+	0xa8, 0x42, 0xc9, 0x16, 0xf0, 0x6c, 0x71, 0xd4, 0x19, 0x88, 0x20, 0x60, 0x05, 0xbe, 0x0c, 0x80,
+	0xc3, 0xc7, 0xd2, 0xf3, 0x47, 0x03, 0x56, 0x74, 0x7e, 0x2d, 0x91, 0x96, 0x3a, 0x94, 0xf7, 0x44,
+	0x0f, 0x29, 0x16, 0xa1, 0x9e, 0xa8, 0x7d, 0x56, 0x40, 0xfa, 0x4d, 0x11, 0xf7, 0x0f, 0x95, 0x88,
+	0x3c, 0x56, 0xe4, 0x4d, 0xa8, 0xad, 0x45, 0xdd, 0xbe, 0x7f, 0x22, 0x59, 0xc9, 0xb9, 0x0b, 0xcd,
+	0xcc, 0x7c, 0x91, 0x85, 0x7d, 0x69, 0x03, 0x2a, 0x6b, 0x9e, 0x27, 0x3d, 0x56, 0x40, 0x02, 0xbb,
+	0x40, 0x56, 0x74, 0xbe, 0x0e, 0x8d, 0x74, 0xb7, 0x10, 0x1d, 0x1d, 0x00, 0xb6, 0x80, 0xbf, 0x10,
+	0xcc, 0x0a, 0x28, 0x95, 0x3b, 0x61, 0xe0, 0x87, 0x92, 0x15, 0xdb, 0xdf, 0x23, 0x51, 0xe5, 0xdf,
+	0xce, 0x5f, 0x88, 0x97, 0x2e, 0xb3, 0xd0, 0xf9, 0xdb, 0x70, 0x23, 0xb3, 0xbe, 0x47, 0x3e, 0x4d,
+	0xae, 0x0e, 0xe5, 0x4d, 0xa5, 0x63, 0x56, 0x68, 0xff, 0x67, 0x11, 0xea, 0x89, 0x61, 0xe6, 0x0c,
+	0x4a, 0xa3, 0x28, 0xb0, 0x02, 0x8d, 0x3f, 0xf9, 0x35, 0xa8, 0x68, 0x5f, 0x5b, 0x31, 0x6e, 0xb8,
+	0x66, 0x80, 0x3e, 0x5f, 0xf6, 0x64, 0x4b, 0xf4, 0x6c, 0xf2, 0xa8, 0xfc, 0x81, 0xe8, 0xc9, 0x6d,
+	0x11, 0xf7, 0x49, 0x1e, 0x1b, 0xee, 0x18, 0x80, 0xf4, 0x47, 0xe2, 0x04, 0x65, 0x8e, 0x9e, 0x1b,
+	0x6f, 0x30, 0x0b, 0xe2, 0xf7, 0xa1, 0x8c, 0x0b, 0xb4, 0x42, 0xf3, 0xe5, 0x89, 0x05, 0xa3, 0x98,
+	0xec, 0x45, 0x12, 0x8f, 0x67, 0x15, 0x7d, 0x79, 0x97, 0x90, 0xf9, 0x4b, 0xb0, 0x6c, 0x2e, 0xe1,
+	0x2e, 0x79, 0xf9, 0x3b, 0x1e, 0x79, 0x83, 0x0d, 0x77, 0x02, 0xca, 0xd7, 0x70, 0x3b, 0x85, 0x96,
+	0xad, 0xfa, 0x1c, 0xf2, 0x9d, 0x6c, 0xce, 0x6a, 0x07, 0x49, 0x5c, 0x43, 0xe9, 0x3c, 0xc0, 0x3d,
+	0x15, 0x5a, 0xe2, 0x31, 0x6f, 0x0d, 0x86, 0xfa, 0xcc, 0x08, 0xcd, 0x43, 0xa9, 0xbb, 0x7d, 0x3f,
+	0xec, 0xb1, 0x82, 0xd9, 0x62, 0x3c, 0x44, 0x42, 0x89, 0x22, 0x15, 0xb1, 0x52, 0xbb, 0x0d, 0x65,
+	0x94, 0x51, 0x54, 0x92, 0xa1, 0x18, 0x48, 0xbb, 0xd3, 0xf4, 0xbb, 0x7d, 0x15, 0x56, 0xa6, 0xec,
+	0x7a, 0xfb, 0x6f, 0xaa, 0x46, 0x42, 0x90, 0x82, 0x7c, 0x4a, 0x4b, 0x41, 0xee, 0xe2, 0x33, 0xe9,
+	0x18, 0xe4, 0x92, 0xd7, 0x31, 0x6f, 0x43, 0x05, 0x17, 0x96, 0xa8, 0x98, 0x39, 0xc8, 0x1f, 0x23,
+	0xba, 0x6b, 0xa8, 0x78, 0x0b, 0x6a, 0xdd, 0xbe, 0xec, 0x1e, 0x4b, 0xcf, 0xea, 0xfa, 0x64, 0x88,
+	0x42, 0xd3, 0xcd, 0xb8, 0xf9, 0x66, 0x40, 0x22, 0xd1, 0x55, 0xe1, 0xd6, 0x40, 0x7d, 0xe2, 0xd3,
+	0xb9, 0xa2, 0x48, 0x24, 0x80, 0xe4, 0xe9, 0x0e, 0xca, 0x88, 0x3d, 0xb6, 0x31, 0xa0, 0xbd, 0x05,
+	0x15, 0x7a, 0x37, 0xde, 0x04, 0x33, 0x67, 0x13, 0xab, 0xbe, 0x34, 0xdf, 0x9c, 0xed, 0x94, 0xdb,
+	0x7f, 0x5e, 0x84, 0x32, 0x8e, 0xf9, 0x1d, 0xa8, 0x44, 0x22, 0xec, 0x99, 0x03, 0x98, 0x0e, 0x79,
+	0x5d, 0x7c, 0xe6, 0x1a, 0x14, 0xfe, 0xae, 0x15, 0xc5, 0xe2, 0x1c, 0xc2, 0x92, 0xbe, 0x31, 0x2b,
+	0x96, 0xd7, 0xa0, 0x32, 0x14, 0x68, 0xa1, 0xcd, 0x3d, 0x31, 0x03, 0xe7, 0x67, 0x05, 0x28, 0x23,
+	0x12, 0x5f, 0x81, 0xa5, 0x8e, 0x8e, 0xfc, 0x63, 0xa9, 0xfb, 0x91, 0x1a, 0xf5, 0xfa, 0x46, 0x92,
+	0x3e, 0x90, 0x67, 0x46, 0xdf, 0x18, 0x85, 0xa0, 0x45, 0xe0, 0x77, 0x59, 0x11, 0xa5, 0x6a, 0x5d,
+	0x05, 0x1e, 0x2b, 0xf1, 0x2b, 0xd0, 0x7c, 0x12, 0x7a, 0x32, 0x8a, 0xbb, 0x2a, 0x92, 0x1e, 0x2b,
+	0xdb, 0xdb, 0x7d, 0xcc, 0x2a, 0x64, 0xcb, 0xe4, 0xa9, 0xa6, 0x98, 0x8a, 0x55, 0xf9, 0x55, 0xb8,
+	0xb2, 0x9e, 0x0f, 0xb4, 0x58, 0x0d, 0x75, 0xd2, 0x63, 0x19, 0xa2, 0x90, 0xb1, 0xba, 0x11, 0x62,
+	0xf5, 0x89, 0xcf, 0x1a, 0xf8, 0x32, 0x73, 0x4f, 0x18, 0x38, 0x7f, 0x5b, 0x48, 0x34, 0xc7, 0x12,
+	0x34, 0xf6, 0x44, 0x84, 0x0e, 0xc3, 0x10, 0xe7, 0xd7, 0x84, 0x9a, 0x31, 0x9c, 0xaf, 0x19, 0xed,
+	0x66, 0x06, 0xf7, 0x8c, 0x6e, 0x34, 0x83, 0xfb, 0xac, 0x34, 0x1e, 0xbc, 0xce, 0xca, 0xf8, 0x8e,
+	0xef, 0x8c, 0x94, 0x96, 0xac, 0x42, 0xba, 0x4e, 0x79, 0x92, 0x55, 0x11, 0xb8, 0x8f, 0x1a, 0x85,
+	0xd5, 0x70, 0xcd, 0x1b, 0x28, 0x3f, 0x87, 0xea, 0x94, 0xd5, 0x71, 0x1a, 0xb8, 0x8d, 0xd2, 0x63,
+	0x0d, 0x7c, 0xf2, 0xe1, 0x68, 0x70, 0x28, 0x71, 0x99, 0x80, 0x4f, 0xf6, 0x55, 0xaf, 0x17, 0x48,
+	0xd6, 0xc4, 0x3d, 0xc8, 0x28, 0x5f, 0xb6, 0x48, 0x9a, 0x56, 0x04, 0x81, 0x1a, 0x69, 0xb6, 0xd4,
+	0xfe, 0x65, 0x09, 0xca, 0x18, 0x25, 0xe1, 0xdd, 0xe9, 0xa3, 0x9e, 0xb1, 0x77, 0x07, 0x7f, 0xa7,
+	0x37, 0xb0, 0x38, 0xbe, 0x81, 0xfc, 0x5b, 0xf6, 0xa4, 0x4b, 0x73, 0x68, 0x59, 0x64, 0x9c, 0x3d,
+	0x64, 0x0e, 0xe5, 0x81, 0x3f, 0x90, 0x56, 0xd7, 0xd1, 0x6f, 0x84, 0xc5, 0x68, 0x8f, 0xf1, 0x1a,
+	0x94, 0x5c, 0xfa, 0x8d, 0xb7, 0x46, 0xa0, 0x59, 0x58, 0xd3, 0x74, 0x07, 0x4a, 0x6e, 0x32, 0x34,
+	0xb7, 0x19, 0xb5, 0x52, 0x6d, 0x8e, 0xdb, 0x4c, 0xaf, 0xcf, 0x6a, 0xa4, 0xb1, 0x32, 0xa8, 0xcf,
+	0x4f, 0x9e, 0x31, 0x12, 0x9b, 0x56, 0x1a, 0xc7, 0x06, 0xac, 0x6e, 0x76, 0x8f, 0x15, 0xf0, 0x94,
+	0xe8, 0x1a, 0x1a, 0x5d, 0x76, 0xe0, 0x7b, 0x52, 0xb1, 0x12, 0x19, 0xb8, 0x91, 0xe7, 0x2b, 0x56,
+	0x46, 0x8f, 0x6a, 0x6f, 0xf3, 0x21, 0xab, 0x38, 0x2f, 0x65, 0x4c, 0xcd, 0xda, 0x48, 0x2b, 0xc3,
+	0x86, 0xc4, 0xb2, 0x60, 0xa4, 0xec, 0x50, 0x7a, 0xac, 0xe8, 0xbc, 0x31, 0x43, 0x7d, 0x2e, 0x41,
+	0xe3, 0xc9, 0x30, 0x50, 0xc2, 0xbb, 0x40, 0x7f, 0x2e, 0x02, 0x8c, 0xa3, 0xee, 0xf6, 0x2f, 0xbe,
+	0x34, 0x36, 0xd3, 0xe8, 0x63, 0xc6, 0x6a, 0x14, 0x75, 0x25, 0xa9, 0x86, 0x86, 0x6b, 0x47, 0xfc,
+	0x3d, 0xa8, 0xe0, 0xf3, 0xb8, 0x55, 0x24, 0x8d, 0x71, 0x67, 0xae, 0x58, 0x6f, 0xf5, 0xc0, 0x97,
+	0x4f, 0x5d, 0x43, 0xc8, 0x1f, 0x64, 0xdd, 0x8e, 0x4b, 0xb2, 0x50, 0x63, 0x4c, 0x7e, 0x13, 0x40,
+	0x74, 0xb5, 0x7f, 0x22, 0x91, 0x97, 0xbd, 0xfb, 0x19, 0x08, 0x77, 0xa1, 0x89, 0x57, 0x72, 0xb8,
+	0x1b, 0xe1, 0x2d, 0x6e, 0x2d, 0x12, 0xe3, 0x57, 0xe7, 0x9b, 0xde, 0xfb, 0x29, 0xa1, 0x9b, 0x65,
+	0xc2, 0x9f, 0xc0, 0xa2, 0xc9, 0x70, 0x59, 0xa6, 0x4b, 0xc4, 0xf4, 0xb5, 0xf9, 0x98, 0xee, 0x8e,
+	0x29, 0xdd, 0x1c, 0x9b, 0xe9, 0xc4, 0x55, 0xe5, 0x59, 0x13, 0x57, 0x68, 0x9b, 0xf7, 0xf3, 0xb6,
+	0xd9, 0x98, 0x80, 0x09, 0x28, 0x77, 0x60, 0xd1, 0x8f, 0xc7, 0x79, 0x33, 0xca, 0xa1, 0xd4, 0xdd,
+	0x1c, 0xac, 0xfd, 0xe3, 0x2a, 0x94, 0x69, 0x0b, 0x27, 0x73, 0x60, 0x1b, 0x39, 0x55, 0x7d, 0x77,
+	0xfe, 0xa3, 0x9e, 0xb8, 0xc9, 0xa4, 0x19, 0x4a, 0x19, 0xcd, 0xf0, 0x1e, 0x54, 0x62, 0x15, 0xe9,
+	0xe4, 0xf8, 0xe7, 0x14, 0xa2, 0x8e, 0x8a, 0xb4, 0x6b, 0x08, 0xf9, 0x43, 0xa8, 0x1d, 0xf9, 0x81,
+	0xc6, 0x43, 0x31, 0x9b, 0xf7, 0xca, 0x7c, 0x3c, 0x1e, 0x12, 0x91, 0x9b, 0x10, 0xf3, 0x47, 0x59,
+	0x61, 0xac, 0x12, 0xa7, 0xd5, 0xf9, 0x38, 0xcd, 0x92, 0xd1, 0x3b, 0xc0, 0xba, 0xea, 0x44, 0x46,
+	0xc9, 0xb3, 0x0f, 0xe4, 0x99, 0x35, 0xbe, 0x53, 0x70, 0xde, 0x86, 0x7a, 0xdf, 0xf7, 0x24, 0xfa,
+	0x2f, 0xa4, 0x63, 0xea, 0x6e, 0x3a, 0xe6, 0x1f, 0x40, 0x9d, 0xfc, 0x7e, 0xd4, 0x76, 0x8d, 0x67,
+	0xde, 0x7c, 0x13, 0x82, 0x24, 0x0c, 0xf0, 0x45, 0xf4, 0xf2, 0x87, 0xbe, 0x6e, 0x81, 0x79, 0x51,
+	0x32, 0xc6, 0x09, 0x93, 0xbc, 0x67, 0x27, 0xdc, 0x34, 0x13, 0x9e, 0x84, 0xf3, 0xd7, 0xe1, 0x39,
+	0x82, 0x4d, 0x18, 0x3f, 0xbc, 0x6a, 0xc8, 0x74, 0xf6, 0x43, 0x74, 0x44, 0x86, 0xa2, 0x27, 0x1f,
+	0xf9, 0x03, 0x5f, 0xb7, 0x96, 0x6e, 0x15, 0x6e, 0x57, 0xdc, 0x31, 0x80, 0xbf, 0x02, 0x2b, 0x9e,
+	0x3c, 0x12, 0xa3, 0x40, 0xef, 0xcb, 0xc1, 0x30, 0x10, 0x5a, 0xee, 0x78, 0x24, 0xa3, 0x0d, 0x77,
+	0xfa, 0x81, 0xf3, 0xba, 0x55, 0xaa, 0x68, 0xe6, 0x30, 0x9a, 0x4c, 0xd4, 0x61, 0xac, 0x8d, 0xdd,
+	0x7c, 0x5f, 0x04, 0x81, 0x8c, 0xce, 0x4c, 0x28, 0xfa, 0x81, 0x08, 0x0f, 0x45, 0xc8, 0x4a, 0xce,
+	0x6d, 0x28, 0xd3, 0x3e, 0x34, 0xa0, 0x62, 0x42, 0x16, 0x0a, 0x5f, 0x6d, 0xb8, 0x42, 0x6a, 0xf4,
+	0x11, 0xde, 0x19, 0x56, 0x6c, 0xff, 0x75, 0x09, 0xea, 0xc9, 0x8a, 0xd1, 0x79, 0x3f, 0x96, 0x67,
+	0x89, 0xf3, 0x7e, 0x2c, 0xcf, 0xc8, 0xa7, 0x8a, 0x0f, 0xfc, 0xd8, 0x3f, 0xb4, 0x3e, 0x62, 0xdd,
+	0x1d, 0x03, 0xd0, 0x2d, 0x79, 0xea, 0x7b, 0xba, 0x4f, 0x82, 0x5e, 0x71, 0xcd, 0x80, 0xdf, 0x86,
+	0x2b, 0x1e, 0x4e, 0x3e, 0xec, 0x06, 0x23, 0x4f, 0xee, 0xa3, 0x49, 0x33, 0x31, 0xfb, 0x24, 0x98,
+	0x7f, 0x17, 0x40, 0xfb, 0x03, 0xf9, 0x50, 0x45, 0x03, 0xa1, 0xad, 0xa3, 0xfe, 0xcd, 0x67, 0x13,
+	0xc5, 0xd5, 0xfd, 0x94, 0x81, 0x9b, 0x61, 0x86, 0xac, 0xf1, 0x6d, 0x96, 0x75, 0xed, 0x73, 0xb1,
+	0xde, 0x4c, 0x19, 0xb8, 0x19, 0x66, 0xce, 0x6f, 0x02, 0x8c, 0x9f, 0xf0, 0xeb, 0xc0, 0x1f, 0xab,
+	0x50, 0xf7, 0xd7, 0x0e, 0x0f, 0xa3, 0x75, 0x79, 0xa4, 0x22, 0xb9, 0x29, 0xd0, 0x16, 0x3d, 0x07,
+	0x2b, 0x29, 0x7c, 0xed, 0x48, 0xcb, 0x08, 0xc1, 0xb4, 0xf5, 0x9d, 0xbe, 0x8a, 0xb4, 0x71, 0x74,
+	0xe8, 0xe7, 0x93, 0x0e, 0x2b, 0xa1, 0xfd, 0xdb, 0xe9, 0xec, 0xb2, 0xb2, 0x73, 0x1b, 0x60, 0xbc,
+	0x24, 0x0a, 0x08, 0xe8, 0xd7, 0x6b, 0xf7, 0x6c, 0x78, 0x40, 0xa3, 0x7b, 0xaf, 0xb3, 0x42, 0xfb,
+	0xaf, 0x8a, 0x50, 0x46, 0xfd, 0x60, 0x75, 0x58, 0x35, 0xd5, 0x61, 0xb7, 0xa0, 0x99, 0x15, 0x6e,
+	0x73, 0x9c, 0x59, 0xd0, 0xe7, 0xd3, 0x72, 0xf8, 0xae, 0xac, 0x96, 0x7b, 0x0b, 0x9a, 0xdd, 0x51,
+	0xac, 0xd5, 0x80, 0x54, 0x7c, 0xab, 0x44, 0x9a, 0xe4, 0xfa, 0x54, 0x96, 0xe1, 0x40, 0x04, 0x23,
+	0xe9, 0x66, 0x51, 0xf9, 0x03, 0xa8, 0x1e, 0x99, 0x83, 0x31, 0x79, 0x86, 0x2f, 0x9d, 0x63, 0x05,
+	0xec, 0xe6, 0x5b, 0x64, 0x5c, 0x97, 0x3f, 0x25, 0x54, 0x59, 0x90, 0xf3, 0x55, 0x7b, 0x5b, 0x6a,
+	0x50, 0x5a, 0x8b, 0xbb, 0x36, 0x4a, 0x95, 0x71, 0xd7, 0xb8, 0xc0, 0x1b, 0x34, 0x05, 0x56, 0x6c,
+	0xff, 0x53, 0x0d, 0xaa, 0x46, 0x2b, 0xda, 0xbd, 0x6b, 0xa4, 0x7b, 0xf7, 0x1d, 0xa8, 0xab, 0xa1,
+	0x8c, 0x84, 0x56, 0x91, 0x0d, 0x95, 0x1f, 0x3c, 0x8b, 0x96, 0x5d, 0xdd, 0xb5, 0xc4, 0x6e, 0xca,
+	0x66, 0xf2, 0x38, 0x8a, 0xd3, 0xc7, 0x71, 0x07, 0x58, 0xa2, 0x50, 0xf7, 0x22, 0xa4, 0xd3, 0x67,
+	0x36, 0xf0, 0x99, 0x82, 0xf3, 0x7d, 0x68, 0x74, 0x55, 0xe8, 0xf9, 0x69, 0xd8, 0xbc, 0x7c, 0xef,
+	0x8d, 0x67, 0x9a, 0xe1, 0x46, 0x42, 0xed, 0x8e, 0x19, 0xf1, 0x57, 0xa0, 0x72, 0x82, 0xe7, 0x44,
+	0x07, 0x72, 0xfe, 0x29, 0x1a, 0x24, 0xfe, 0x31, 0x34, 0xbf, 0x3f, 0xf2, 0xbb, 0xc7, 0xbb, 0xd9,
+	0xb4, 0xcc, 0x5b, 0xcf, 0x34, 0x8b, 0xef, 0x8c, 0xe9, 0xdd, 0x2c, 0xb3, 0x8c, 0x6c, 0xd4, 0x7e,
+	0x05, 0xd9, 0xa8, 0x4f, 0xcb, 0xc6, 0x0d, 0xa8, 0x27, 0x87, 0x43, 0xf2, 0x11, 0x7a, 0x6c, 0x81,
+	0x57, 0xa1, 0xb8, 0x1b, 0xb1, 0x82, 0xf3, 0x5f, 0x05, 0x68, 0xa4, 0x1b, 0x93, 0x4f, 0xc1, 0x6c,
+	0x7d, 0x7f, 0x24, 0x02, 0x56, 0xa0, 0x18, 0x42, 0x69, 0x33, 0xa2, 0xcb, 0xfb, 0x7e, 0x24, 0x85,
+	0xa6, 0xcc, 0x1f, 0x6a, 0x64, 0x19, 0xc7, 0xac, 0xcc, 0x39, 0x2c, 0x5b, 0xf0, 0x6e, 0x64, 0x50,
+	0x2b, 0x18, 0x62, 0xe0, 0xd3, 0x04, 0x50, 0x35, 0x0a, 0xfc, 0x58, 0x9a, 0x10, 0xea, 0x43, 0xa5,
+	0x69, 0x50, 0xc7, 0xb9, 0xec, 0x84, 0xac, 0x81, 0xef, 0xfc, 0x50, 0xe9, 0x9d, 0x90, 0xc1, 0xd8,
+	0xb7, 0x6d, 0x26, 0xaf, 0xa7, 0xd1, 0x22, 0x79, 0xce, 0x41, 0xb0, 0x13, 0xb2, 0x25, 0xfb, 0xc0,
+	0x8c, 0x96, 0x91, 0xe3, 0xd6, 0xa9, 0xe8, 0x22, 0xf9, 0x15, 0xbe, 0x0c, 0x80, 0x34, 0x76, 0xcc,
+	0xf0, 0x0e, 0x6c, 0x9d, 0xfa, 0xb1, 0x8e, 0xd9, 0x8a, 0xf3, 0x8f, 0x05, 0x68, 0x66, 0x0e, 0x01,
+	0x7d, 0x67, 0x42, 0x44, 0xd5, 0x66, 0x5c, 0xe9, 0xef, 0xca, 0x58, 0xcb, 0xc8, 0x4b, 0xd4, 0xd6,
+	0xbe, 0xc2, 0x9f, 0x45, 0x7c, 0xdf, 0xbe, 0x1a, 0xa8, 0x28, 0x52, 0x4f, 0x59, 0x09, 0x47, 0x8f,
+	0x44, 0xac, 0x3f, 0x92, 0xf2, 0x98, 0x95, 0x71, 0xa9, 0x1b, 0xa3, 0x28, 0x92, 0xa1, 0x01, 0x54,
+	0x68, 0x72, 0xf2, 0xd4, 0x8c, 0xaa, 0xc8, 0x14, 0x91, 0x49, 0x2f, 0xb2, 0x1a, 0x67, 0xb0, 0x68,
+	0xb1, 0x0d, 0xa4, 0x8e, 0x08, 0x88, 0x6e, 0x86, 0x0d, 0x0c, 0x3b, 0x4d, 0xd8, 0xb6, 0x7b, 0xb4,
+	0x29, 0xce, 0xe2, 0xb5, 0x9e, 0x62, 0x30, 0x09, 0xfc, 0x50, 0x3d, 0x65, 0xcd, 0xf6, 0x08, 0x60,
+	0xec, 0xd0, 0xa2, 0x23, 0x8f, 0xb2, 0x96, 0x26, 0x56, 0xed, 0x88, 0xef, 0x02, 0xe0, 0x2f, 0xc2,
+	0x4c, 0xbc, 0xf9, 0x67, 0xf0, 0x32, 0x88, 0xce, 0xcd, 0xb0, 0x68, 0xff, 0x0e, 0x34, 0xd2, 0x07,
+	0x18, 0x97, 0x91, 0x3f, 0x90, 0xbe, 0x36, 0x19, 0xa2, 0x9d, 0xf4, 0x43, 0x4f, 0x9e, 0xd2, 0xdd,
+	0xaf, 0xb8, 0x66, 0x80, 0xb3, 0xec, 0xfb, 0x9e, 0x27, 0xc3, 0x24, 0xfd, 0x6d, 0x46, 0xb3, 0x8a,
+	0x9d, 0xe5, 0x99, 0xc5, 0xce, 0xf6, 0x6f, 0x41, 0x33, 0xe3, 0x71, 0x9f, 0xbb, 0xec, 0xcc, 0xc4,
+	0x8a, 0xf9, 0x89, 0xbd, 0x00, 0x0d, 0x65, 0xdd, 0xe6, 0x98, 0x14, 0x78, 0xc3, 0x1d, 0x03, 0xd0,
+	0xc0, 0x54, 0xcc, 0xd2, 0x26, 0xbd, 0xe4, 0x87, 0x50, 0xc5, 0x90, 0x71, 0x94, 0x54, 0x8a, 0xe7,
+	0xf4, 0x44, 0x3b, 0x44, 0x43, 0xb5, 0x07, 0xfa, 0xc5, 0xdf, 0x86, 0x92, 0x16, 0x3d, 0x9b, 0x3d,
+	0x7a, 0x79, 0x3e, 0x26, 0xfb, 0xa2, 0xb7, 0xbd, 0xe0, 0x22, 0x1d, 0x7f, 0x04, 0xf5, 0xae, 0x0d,
+	0xf8, 0xad, 0xe2, 0x9a, 0xd3, 0x91, 0x4d, 0xd2, 0x04, 0xdb, 0x0b, 0x6e, 0xca, 0x81, 0xbf, 0x07,
+	0x65, 0xb4, 0xf2, 0xa4, 0x79, 0xe7, 0x76, 0xd0, 0xf1, 0xba, 0x6c, 0x2f, 0xb8, 0x44, 0xb9, 0x5e,
+	0x83, 0x0a, 0xe9, 0xc9, 0x76, 0x0b, 0xaa, 0x66, 0xad, 0x93, 0x3b, 0xd7, 0x7e, 0x1e, 0x4a, 0xfb,
+	0xa2, 0x87, 0x9e, 0x96, 0xef, 0xc5, 0x36, 0xce, 0xc4, 0x9f, 0xed, 0x17, 0xc7, 0xc9, 0x8b, 0x6c,
+	0x5e, 0xac, 0x90, 0xcb, 0x8b, 0xb5, 0xab, 0x50, 0xc6, 0x37, 0xb6, 0x5f, 0xb8, 0xc8, 0x6b, 0x6b,
+	0xdf, 0x40, 0xff, 0x4e, 0xcb, 0xd3, 0x59, 0x29, 0xbf, 0xf6, 0x0a, 0x5c, 0x99, 0x28, 0xb1, 0xb5,
+	0x6b, 0xd6, 0xb9, 0x6c, 0x2f, 0x41, 0x33, 0x53, 0xb3, 0x68, 0xbf, 0x04, 0xf5, 0xa4, 0xa2, 0x81,
+	0x2e, 0xb5, 0x1f, 0x9b, 0x5c, 0x8c, 0x9d, 0x54, 0x3a, 0x6e, 0xff, 0x45, 0x01, 0xaa, 0xa6, 0x2c,
+	0xc5, 0xd7, 0xd3, 0x32, 0x72, 0x61, 0x8e, 0x12, 0x82, 0x21, 0xb2, 0x05, 0x98, 0xb4, 0x96, 0x7c,
+	0x0d, 0x2a, 0x01, 0xf9, 0xce, 0xf6, 0xba, 0xd0, 0x20, 0x23, 0xdd, 0xa5, 0xac, 0x74, 0x3b, 0x6f,
+	0xa6, 0x45, 0x9f, 0x24, 0x4f, 0x40, 0x66, 0x7f, 0x3f, 0x92, 0xd2, 0xe4, 0x00, 0xc8, 0x59, 0x2e,
+	0x92, 0x6e, 0x52, 0x83, 0xa1, 0xe8, 0x6a, 0x02, 0x94, 0xda, 0x07, 0x50, 0x35, 0xc5, 0xb0, 0x19,
+	0x59, 0xdf, 0xc2, 0xcc, 0xac, 0xef, 0x54, 0xdd, 0xa6, 0x38, 0xa3, 0x6e, 0xd3, 0xfe, 0x79, 0x01,
+	0x6a, 0xb6, 0x4a, 0x96, 0x4b, 0x29, 0x14, 0x32, 0x29, 0x85, 0x1d, 0xa8, 0x07, 0x22, 0xec, 0x8d,
+	0x44, 0x2f, 0x71, 0xc2, 0xbe, 0x31, 0x4f, 0xd5, 0x6d, 0xf5, 0x91, 0x25, 0x72, 0x53, 0x72, 0xe7,
+	0x3e, 0x6a, 0x60, 0xf3, 0xdb, 0xa4, 0xe4, 0xa2, 0x81, 0xf0, 0x3d, 0xe3, 0x2d, 0xbe, 0x1f, 0x89,
+	0x61, 0xff, 0xc4, 0xff, 0x81, 0x31, 0x5f, 0x7b, 0x81, 0x08, 0xf5, 0x93, 0xc7, 0x8f, 0x58, 0xd1,
+	0x39, 0x82, 0xfa, 0x9e, 0x8a, 0x27, 0xad, 0x5d, 0x0d, 0x4a, 0xfb, 0x6a, 0x68, 0x9c, 0xa5, 0x75,
+	0xa5, 0xc9, 0x59, 0x32, 0xc6, 0xed, 0x48, 0x9b, 0x74, 0x8d, 0xeb, 0xf7, 0xfa, 0xda, 0xa4, 0xe2,
+	0x76, 0xc2, 0x50, 0x46, 0xac, 0x82, 0xef, 0x74, 0xe5, 0x30, 0x10, 0x5d, 0xc9, 0xaa, 0x68, 0x71,
+	0x08, 0xfe, 0xd0, 0x8f, 0x62, 0xcd, 0x6a, 0xce, 0x9b, 0x68, 0xa7, 0xfc, 0x1e, 0x99, 0x17, 0xfa,
+	0x41, 0xac, 0x16, 0xf0, 0x30, 0x68, 0xb8, 0x21, 0x43, 0x34, 0xa1, 0x54, 0x51, 0x31, 0x3d, 0x15,
+	0xf4, 0x82, 0xa2, 0xf3, 0x11, 0x2c, 0xe5, 0x7a, 0x2d, 0xf8, 0x35, 0x60, 0x39, 0x00, 0x4e, 0x74,
+	0x81, 0x3f, 0x0f, 0x57, 0x73, 0xd0, 0xc7, 0xbe, 0xe7, 0x51, 0xc2, 0x69, 0xf2, 0x41, 0xb2, 0x9c,
+	0xf5, 0x06, 0xd4, 0xba, 0x66, 0x53, 0x9d, 0x3d, 0x58, 0xa2, 0x5d, 0x7e, 0x2c, 0xb5, 0xd8, 0x0d,
+	0x83, 0xb3, 0x5f, 0xb9, 0x21, 0xc6, 0xf9, 0x3a, 0x54, 0x28, 0xf1, 0x8b, 0x17, 0xef, 0x28, 0x52,
+	0x03, 0xe2, 0x55, 0x71, 0xe9, 0x37, 0x72, 0xd7, 0xca, 0xca, 0x74, 0x51, 0x2b, 0xe7, 0x3f, 0x8a,
+	0xc0, 0x28, 0x29, 0xb6, 0xe5, 0xf9, 0xda, 0x58, 0x61, 0x8a, 0x1d, 0x63, 0x1d, 0xf9, 0xc3, 0xad,
+	0x53, 0xff, 0xc8, 0xde, 0xb3, 0x31, 0x00, 0x05, 0x90, 0x06, 0x8f, 0x54, 0xd7, 0x34, 0x0e, 0x98,
+	0x90, 0x2c, 0x0f, 0xa4, 0xba, 0xaa, 0xa2, 0x3c, 0xa0, 0x89, 0xcb, 0xec, 0x88, 0xbf, 0x05, 0xe5,
+	0x6e, 0xa4, 0x86, 0x56, 0x55, 0x4e, 0x96, 0x79, 0x27, 0xa7, 0xb2, 0xea, 0xca, 0xae, 0x76, 0x89,
+	0x82, 0xbf, 0x93, 0x3a, 0x65, 0x95, 0x99, 0x89, 0xcd, 0x29, 0xda, 0xbc, 0x77, 0xd6, 0xde, 0x83,
+	0x32, 0x72, 0xe3, 0x8b, 0x50, 0x38, 0xb5, 0x7b, 0x52, 0x38, 0xc5, 0xd1, 0x99, 0xdd, 0x8f, 0xc2,
+	0xd9, 0x39, 0xc1, 0x24, 0x1a, 0x49, 0x89, 0x32, 0x41, 0xb3, 0xae, 0xb8, 0x76, 0xe4, 0xbc, 0x0c,
+	0xd5, 0x71, 0x88, 0xb4, 0x1b, 0xf9, 0x3d, 0x3f, 0x14, 0x81, 0xb9, 0xf9, 0xbf, 0xbe, 0xb7, 0xf5,
+	0xbe, 0xa9, 0xd1, 0xee, 0x7d, 0xf8, 0x3e, 0x2b, 0x3a, 0xff, 0xdc, 0x80, 0xda, 0x5a, 0xb7, 0xab,
+	0x46, 0xa1, 0x9e, 0x3a, 0xe1, 0x59, 0x39, 0xdc, 0x07, 0x50, 0x15, 0x27, 0x42, 0x8b, 0xc8, 0xda,
+	0xa5, 0x49, 0x0f, 0xd4, 0xf2, 0x5a, 0x5d, 0x23, 0x24, 0xd7, 0x22, 0x23, 0x59, 0x57, 0x85, 0x47,
+	0x7e, 0xcf, 0xee, 0xef, 0x79, 0x64, 0x1b, 0x84, 0xe4, 0x5a, 0x64, 0x24, 0xb3, 0xa6, 0xb4, 0x72,
+	0x21, 0x99, 0xb1, 0x27, 0xa9, 0xe5, 0xbc, 0x0b, 0x65, 0x3f, 0x3c, 0x52, 0xb6, 0xe5, 0xec, 0xc6,
+	0x39, 0x44, 0x3b, 0xe1, 0x91, 0x72, 0x09, 0xb1, 0x2d, 0xa1, 0x6a, 0x26, 0xcc, 0xbf, 0x09, 0x15,
+	0xaa, 0xa3, 0xd9, 0xca, 0xc5, 0x5c, 0x3d, 0x42, 0x86, 0x82, 0x5f, 0x4f, 0xca, 0x32, 0xb4, 0x5f,
+	0x08, 0xa7, 0xe1, 0x7a, 0x3d, 0xd9, 0xb2, 0xf6, 0xbf, 0x15, 0xa0, 0x6a, 0x56, 0x88, 0x5a, 0x55,
+	0x86, 0x68, 0x3e, 0x12, 0x63, 0x69, 0xe5, 0x79, 0x02, 0x8a, 0xae, 0xbb, 0x85, 0xc8, 0xc3, 0x51,
+	0xcf, 0x8a, 0x74, 0x16, 0xc4, 0xdf, 0x82, 0xe7, 0xcd, 0x70, 0x2f, 0x92, 0x91, 0x0c, 0xa4, 0x88,
+	0xe5, 0x46, 0x5f, 0x84, 0xa1, 0x0c, 0xac, 0xeb, 0x74, 0xde, 0x63, 0xee, 0xc0, 0xa2, 0x79, 0xd4,
+	0x19, 0x8a, 0xae, 0x8c, 0x6d, 0x99, 0x29, 0x07, 0xe3, 0xdf, 0x80, 0x0a, 0x35, 0xfe, 0xb5, 0xbc,
+	0x8b, 0x2f, 0xb9, 0xc1, 0x6a, 0xab, 0xd4, 0xb6, 0xaf, 0x01, 0x98, 0xd3, 0xc0, 0x98, 0xd3, 0xda,
+	0xbb, 0xaf, 0x5c, 0x78, 0x7c, 0x14, 0x3d, 0x67, 0x88, 0x70, 0x7e, 0x9e, 0x0c, 0x24, 0x5e, 0x1a,
+	0xb4, 0xeb, 0xb4, 0xf8, 0x92, 0x9b, 0x83, 0xb5, 0xff, 0xae, 0x04, 0x65, 0x3c, 0x48, 0x44, 0xee,
+	0xab, 0x81, 0x4c, 0x8d, 0x94, 0x11, 0xda, 0x1c, 0x0c, 0x9d, 0x47, 0x61, 0x2a, 0xcb, 0x29, 0x9a,
+	0x31, 0x97, 0x93, 0x60, 0xc4, 0x1c, 0x46, 0xea, 0xc8, 0x0f, 0xc6, 0x98, 0xd6, 0xcd, 0x9c, 0x00,
+	0xf3, 0x37, 0xe0, 0xfa, 0x40, 0x44, 0xc7, 0x52, 0x93, 0x96, 0xff, 0x48, 0x45, 0xc7, 0x31, 0xee,
+	0xdc, 0x8e, 0x67, 0xf3, 0x66, 0xe7, 0x3c, 0x45, 0x97, 0xc1, 0x93, 0x27, 0x3e, 0x61, 0xd6, 0x09,
+	0x33, 0x1d, 0xa3, 0x70, 0x08, 0xb3, 0x35, 0x1d, 0xcb, 0xcb, 0xc4, 0xe0, 0x13, 0x50, 0xd4, 0x87,
+	0xa6, 0xcd, 0x25, 0xde, 0xf1, 0x28, 0x95, 0xd7, 0x70, 0xc7, 0x00, 0x7e, 0x13, 0xa0, 0x27, 0xb4,
+	0x7c, 0x2a, 0xce, 0x9e, 0x44, 0x41, 0x4b, 0x9a, 0x04, 0xf9, 0x18, 0x82, 0x81, 0x75, 0xa0, 0xba,
+	0x22, 0xe8, 0x68, 0x15, 0x89, 0x9e, 0xdc, 0x13, 0xba, 0xdf, 0xea, 0x99, 0xc0, 0x7a, 0x12, 0x8e,
+	0xb3, 0xd5, 0xfe, 0x40, 0x7e, 0xac, 0x42, 0xd9, 0xea, 0x9b, 0xd9, 0x26, 0x63, 0x14, 0x51, 0x11,
+	0x8a, 0xe0, 0x4c, 0xfb, 0x5d, 0x9c, 0x87, 0x6f, 0x42, 0xf8, 0x0c, 0x08, 0xe7, 0x19, 0x4a, 0xfd,
+	0x54, 0x45, 0xe8, 0x16, 0x7c, 0x62, 0xe6, 0x99, 0x02, 0x9c, 0x5d, 0x80, 0xb1, 0x00, 0xa0, 0x75,
+	0x5d, 0xa3, 0x24, 0x3e, 0x5b, 0xc0, 0x68, 0x66, 0x4f, 0x86, 0x9e, 0x1f, 0xf6, 0x36, 0xed, 0x99,
+	0xb3, 0x02, 0x02, 0x3b, 0x5a, 0x44, 0x5a, 0x7a, 0x29, 0x90, 0x22, 0x4e, 0x1a, 0x49, 0x8f, 0x95,
+	0x9c, 0xff, 0x29, 0x40, 0x33, 0x53, 0xc2, 0xfe, 0x02, 0xcb, 0xee, 0xe8, 0xe7, 0xe1, 0x5d, 0xc7,
+	0x0d, 0x35, 0xf2, 0x90, 0x8e, 0x71, 0xbb, 0x6d, 0x85, 0x1d, 0x9f, 0x9a, 0x0c, 0x45, 0x06, 0xf2,
+	0xb9, 0x4a, 0xee, 0xce, 0x3d, 0x9b, 0xb3, 0x69, 0x42, 0xed, 0x49, 0x78, 0x1c, 0xaa, 0xa7, 0xa1,
+	0x51, 0xe3, 0xd4, 0x47, 0x91, 0xab, 0x1c, 0x25, 0xad, 0x0e, 0x25, 0xe7, 0x8f, 0xca, 0x13, 0x2d,
+	0x47, 0x5b, 0x50, 0x35, 0x71, 0x0b, 0xb9, 0xd4, 0xd3, 0xde, 0x54, 0x16, 0xd9, 0x56, 0x29, 0x32,
+	0x20, 0xd7, 0x12, 0x63, 0x40, 0x91, 0x36, 0xf6, 0x15, 0x67, 0x56, 0x53, 0x72, 0x8c, 0x12, 0x15,
+	0x96, 0xeb, 0x6d, 0x4d, 0x39, 0xb4, 0xff, 0xa0, 0x00, 0xd7, 0x66, 0xa1, 0xa0, 0x7f, 0x7f, 0x98,
+	0xeb, 0xfc, 0x49, 0x86, 0xbc, 0x33, 0xd1, 0x51, 0x5b, 0xa4, 0xd5, 0xdc, 0x7d, 0xc6, 0x49, 0xe4,
+	0xfb, 0x6b, 0x9d, 0x9f, 0x16, 0x60, 0x65, 0x6a, 0xcd, 0x19, 0xb7, 0x0f, 0xa0, 0x6a, 0x24, 0xcb,
+	0x34, 0xaa, 0xa4, 0xad, 0x03, 0x26, 0xa9, 0x4c, 0xf6, 0x20, 0x36, 0xb5, 0xd8, 0x4d, 0xd3, 0x8f,
+	0xcd, 0xca, 0xe8, 0xaf, 0xe1, 0xa9, 0xa1, 0x9e, 0xed, 0x49, 0x56, 0xc1, 0x58, 0xde, 0x78, 0xe1,
+	0x16, 0x52, 0xa5, 0x3c, 0x81, 0xcd, 0x63, 0xb3, 0x1a, 0x35, 0xc0, 0x8c, 0x86, 0x81, 0xdf, 0xc5,
+	0x61, 0xdd, 0x71, 0xe1, 0xea, 0x8c, 0x79, 0xd3, 0x4c, 0x0e, 0xec, 0xac, 0x96, 0x01, 0x36, 0x0f,
+	0x92, 0xb9, 0xb0, 0x02, 0xe7, 0xb0, 0xbc, 0x79, 0xb0, 0x41, 0xc9, 0x15, 0x5b, 0x5e, 0x36, 0x77,
+	0xe2, 0x00, 0x23, 0xf0, 0x98, 0x95, 0x9c, 0xef, 0x25, 0x75, 0xe7, 0xf6, 0x01, 0x2c, 0x99, 0x69,
+	0xec, 0x89, 0xb3, 0x40, 0x09, 0x8f, 0x6f, 0xc1, 0x72, 0x9c, 0xb6, 0xae, 0x67, 0xb4, 0xf5, 0xa4,
+	0xb1, 0xed, 0xe4, 0x90, 0xdc, 0x09, 0x22, 0xe7, 0x0f, 0x2b, 0x00, 0xbb, 0x69, 0xfb, 0xf7, 0x8c,
+	0x4b, 0x37, 0xcb, 0x9d, 0x98, 0xaa, 0x7c, 0x95, 0x9e, 0xb9, 0xf2, 0xf5, 0x56, 0x1a, 0x54, 0x99,
+	0x7c, 0xe9, 0x64, 0x7f, 0xed, 0x78, 0x4e, 0x93, 0xa1, 0x54, 0xae, 0x63, 0xa2, 0x32, 0xd9, 0x31,
+	0x71, 0x6b, 0xba, 0xbd, 0x6a, 0x42, 0x1b, 0x8c, 0x73, 0x14, 0xb5, 0x5c, 0x8e, 0xa2, 0x0d, 0xf5,
+	0x48, 0x0a, 0x4f, 0x85, 0xc1, 0x59, 0x52, 0x60, 0x49, 0xc6, 0xfc, 0x3e, 0x54, 0x34, 0x35, 0xcc,
+	0xd7, 0x49, 0x78, 0x2f, 0xd9, 0x63, 0x83, 0x8b, 0xaa, 0xc5, 0x8f, 0x6d, 0x4f, 0x94, 0xb1, 0x05,
+	0x75, 0x37, 0x03, 0xe1, 0xab, 0xc0, 0xfd, 0x30, 0xd6, 0x22, 0x08, 0xa4, 0xb7, 0x7e, 0xb6, 0x69,
+	0xea, 0x24, 0x64, 0x7f, 0xea, 0xee, 0x8c, 0x27, 0xce, 0x67, 0xe3, 0x5e, 0xc0, 0x06, 0x54, 0x0e,
+	0x45, 0xec, 0x77, 0x4d, 0xd7, 0x81, 0x35, 0x6e, 0x26, 0x34, 0xd4, 0xca, 0x53, 0xac, 0x88, 0xae,
+	0x62, 0x2c, 0x31, 0xc2, 0x59, 0x06, 0x18, 0xb7, 0xf7, 0xb3, 0x32, 0xca, 0x70, 0x72, 0x12, 0xa6,
+	0xe9, 0x80, 0x48, 0x29, 0x91, 0xe5, 0xa5, 0xed, 0x5c, 0x35, 0x7c, 0x03, 0xe9, 0x48, 0x56, 0x47,
+	0x9c, 0x50, 0x69, 0x69, 0xd2, 0x78, 0x64, 0x08, 0x19, 0x20, 0x9b, 0xa4, 0x5b, 0x99, 0x35, 0x31,
+	0x34, 0x49, 0x98, 0x5a, 0x77, 0x99, 0x02, 0xd2, 0x45, 0x94, 0xf0, 0xfc, 0x03, 0xb6, 0x84, 0x33,
+	0x1a, 0x7f, 0x35, 0xc0, 0x96, 0x91, 0x15, 0xea, 0x97, 0x43, 0x11, 0x4b, 0x76, 0xcd, 0xf9, 0xe3,
+	0xf1, 0x2a, 0x5f, 0x4d, 0x3d, 0xdb, 0x79, 0xe4, 0xe3, 0x3c, 0xdf, 0x77, 0x0b, 0x56, 0x22, 0xf9,
+	0xfd, 0x91, 0x9f, 0xeb, 0x27, 0x2e, 0x5d, 0x5c, 0xb0, 0x9e, 0xa6, 0x70, 0x4e, 0x60, 0x25, 0x19,
+	0x7c, 0xe4, 0xeb, 0x3e, 0x25, 0x45, 0xf8, 0xfd, 0x4c, 0xc3, 0x73, 0xc1, 0xba, 0x5a, 0xe7, 0xb0,
+	0x1c, 0x37, 0x38, 0xa7, 0x89, 0xe9, 0xe2, 0x1c, 0x89, 0x69, 0xe7, 0x5f, 0xab, 0x99, 0xbc, 0x88,
+	0xf1, 0xf5, 0xbd, 0xd4, 0xd7, 0x9f, 0xae, 0x6e, 0x8d, 0x73, 0xcd, 0xc5, 0x67, 0xc9, 0x35, 0xcf,
+	0x2a, 0xef, 0x7e, 0x0b, 0x1d, 0x39, 0x12, 0xbd, 0x83, 0x39, 0xf2, 0xe8, 0x39, 0x5c, 0xbe, 0x4e,
+	0xb5, 0x2a, 0xd1, 0x31, 0x89, 0x82, 0xca, 0xcc, 0xcf, 0x0f, 0xb2, 0x45, 0x29, 0x8b, 0xe9, 0x66,
+	0xa8, 0x32, 0x17, 0xb5, 0x3a, 0xeb, 0xa2, 0x62, 0x78, 0x6b, 0xaf, 0x70, 0x3a, 0x36, 0x65, 0x07,
+	0xf3, 0x3b, 0x61, 0x4f, 0xdf, 0x0d, 0xd4, 0xdd, 0x29, 0x38, 0xba, 0x13, 0x83, 0x51, 0xa0, 0x7d,
+	0x9b, 0x59, 0x37, 0x83, 0xc9, 0x2f, 0x64, 0x1a, 0xd3, 0x5f, 0xc8, 0xbc, 0x03, 0x10, 0x4b, 0x14,
+	0xdf, 0x4d, 0xbf, 0xab, 0x6d, 0x87, 0xc2, 0xcd, 0xf3, 0xd6, 0x66, 0xeb, 0x01, 0x19, 0x0a, 0x9c,
+	0xff, 0x40, 0x9c, 0x6e, 0xa0, 0x4b, 0x68, 0x4b, 0xa9, 0xe9, 0x78, 0x52, 0x7d, 0x2d, 0x4f, 0xab,
+	0xaf, 0xfb, 0x50, 0x89, 0xbb, 0x6a, 0x28, 0xa9, 0xc5, 0xff, 0xfc, 0xf3, 0x5d, 0xed, 0x20, 0x92,
+	0x6b, 0x70, 0x29, 0xfb, 0x86, 0x66, 0x46, 0x45, 0xd4, 0xdc, 0xdf, 0x70, 0x93, 0x61, 0xdb, 0x83,
+	0xaa, 0xcd, 0x96, 0xcf, 0x88, 0x23, 0x29, 0xd1, 0x56, 0xcc, 0xf4, 0xd6, 0xa5, 0x3d, 0x6c, 0xa5,
+	0x6c, 0x0f, 0xdb, 0x2d, 0x68, 0x46, 0x99, 0x6a, 0x90, 0x6d, 0x5c, 0xcc, 0x80, 0x9c, 0x8f, 0xa1,
+	0x42, 0xf3, 0x41, 0x6b, 0x68, 0xb6, 0xd2, 0x38, 0x44, 0x38, 0x71, 0x56, 0xe0, 0xd7, 0x80, 0xc5,
+	0x52, 0xef, 0x1e, 0xed, 0xf7, 0x65, 0x47, 0x0c, 0x24, 0x69, 0xaa, 0x22, 0x6f, 0xc1, 0x35, 0x83,
+	0x1b, 0xe7, 0x9f, 0x90, 0xd9, 0x0e, 0xfc, 0xc3, 0x48, 0x44, 0x67, 0xac, 0xec, 0xbc, 0x43, 0xb5,
+	0xcb, 0x44, 0x68, 0x9a, 0xe9, 0x97, 0x58, 0x46, 0x37, 0x7a, 0x32, 0x42, 0x65, 0x6b, 0x2a, 0xcb,
+	0xd6, 0x11, 0x37, 0xdd, 0x33, 0xe4, 0x2d, 0xb3, 0x92, 0xf3, 0x11, 0xfa, 0x5d, 0x63, 0xd3, 0xf4,
+	0x85, 0xdd, 0x29, 0xe7, 0xd3, 0x02, 0xf0, 0xe4, 0xd1, 0x86, 0x0a, 0x63, 0x1d, 0x09, 0xdf, 0x1c,
+	0x71, 0x34, 0x5d, 0xca, 0x8c, 0xf2, 0x3d, 0x05, 0x89, 0xfe, 0xb1, 0xa1, 0x63, 0x3a, 0xe6, 0xb7,
+	0xa1, 0x34, 0xf0, 0x43, 0x1b, 0xc6, 0x9f, 0x77, 0x17, 0x11, 0x85, 0x30, 0xc5, 0xe9, 0x25, 0xb7,
+	0x16, 0x51, 0x50, 0x3a, 0x86, 0x42, 0x6b, 0x19, 0x85, 0xf6, 0xec, 0x92, 0xe1, 0xe4, 0x65, 0xa8,
+	0x4e, 0x5d, 0x06, 0xe7, 0x87, 0x45, 0xb8, 0x31, 0xbd, 0xc8, 0x03, 0x5f, 0xd9, 0x4b, 0xd6, 0x86,
+	0xba, 0xca, 0x67, 0x20, 0xd3, 0xf1, 0xe4, 0x4e, 0x14, 0xa7, 0x77, 0x62, 0x23, 0xd7, 0x7b, 0x76,
+	0xf7, 0x9c, 0x7d, 0x9f, 0xf1, 0xde, 0x6c, 0x51, 0x77, 0xe2, 0x4e, 0x95, 0xa7, 0xee, 0x94, 0xf3,
+	0x6d, 0xeb, 0xaf, 0x2f, 0x8e, 0x37, 0xde, 0x14, 0xca, 0xa8, 0xd7, 0xd1, 0xc8, 0x8e, 0xdd, 0x12,
+	0x56, 0x9c, 0xb0, 0xa8, 0x25, 0x67, 0x3d, 0xe3, 0x5f, 0xe6, 0xdb, 0xa2, 0x0a, 0xf3, 0xb6, 0x45,
+	0x39, 0x1f, 0xc0, 0x15, 0x37, 0x6f, 0x40, 0xf9, 0x5b, 0x50, 0x53, 0xc3, 0x2c, 0x9f, 0xcb, 0x74,
+	0x4c, 0x82, 0xee, 0xfc, 0xa2, 0x00, 0x8b, 0x3b, 0x21, 0xce, 0x56, 0x04, 0x0f, 0x03, 0xd1, 0xe3,
+	0x6f, 0x26, 0x16, 0x67, 0x76, 0x40, 0x9f, 0xc5, 0xcd, 0x1b, 0x9f, 0xc0, 0x66, 0xff, 0xf9, 0x73,
+	0xb0, 0x22, 0x3d, 0x5f, 0xab, 0xc8, 0x78, 0xd5, 0x49, 0x77, 0xda, 0x35, 0x60, 0x06, 0xdc, 0x21,
+	0xf5, 0xb6, 0x6f, 0xae, 0x73, 0x0b, 0xae, 0xe5, 0xa0, 0x89, 0xcb, 0x5c, 0xe4, 0x2f, 0x40, 0x6b,
+	0x6c, 0xfa, 0x37, 0x55, 0xa8, 0x77, 0x42, 0x4f, 0x9e, 0x92, 0x47, 0xc8, 0x4a, 0xce, 0xff, 0xa6,
+	0xbe, 0xe8, 0x81, 0xed, 0x5d, 0x8b, 0x94, 0x1a, 0x0b, 0x8e, 0x1d, 0x65, 0x3e, 0xcd, 0x2c, 0xce,
+	0xf1, 0x69, 0xe6, 0x3b, 0xe3, 0x4f, 0x33, 0x8d, 0xd1, 0x7f, 0x71, 0xa6, 0x27, 0x41, 0x2d, 0x37,
+	0x36, 0x16, 0xe8, 0xc8, 0xcc, 0x77, 0x9a, 0xaf, 0x59, 0x11, 0x2c, 0xcf, 0xe3, 0x5d, 0x1b, 0x81,
+	0x7b, 0x30, 0xd9, 0x91, 0x3f, 0x5f, 0x6b, 0xdc, 0x94, 0x57, 0x0d, 0xcf, 0xec, 0x55, 0xbf, 0x3b,
+	0x11, 0x6b, 0xd5, 0x67, 0xa6, 0xd2, 0x2e, 0xf8, 0x6e, 0xf1, 0x5d, 0xa8, 0xf5, 0xfd, 0x58, 0xab,
+	0xe8, 0x8c, 0x1c, 0xd6, 0xe9, 0x6f, 0x7f, 0x32, 0xbb, 0xb5, 0x6d, 0x10, 0xa9, 0x4f, 0x29, 0xa1,
+	0xe2, 0x0e, 0x2c, 0x1e, 0xa9, 0xee, 0x28, 0x4e, 0xca, 0x09, 0x26, 0x9d, 0x92, 0x83, 0xb5, 0x7b,
+	0x00, 0xe3, 0x9d, 0x9e, 0xb2, 0x3b, 0x9f, 0xe3, 0x5b, 0xda, 0xeb, 0x50, 0x8d, 0x47, 0x87, 0xe3,
+	0x82, 0x9f, 0x1d, 0xb5, 0x4f, 0xa1, 0x3d, 0xe5, 0xb3, 0xed, 0xc9, 0xc8, 0xac, 0xe1, 0x42, 0xd5,
+	0xf4, 0x4e, 0xf6, 0x08, 0x8d, 0x98, 0xdd, 0x3a, 0xe7, 0x1c, 0x52, 0xce, 0x99, 0xb3, 0x6c, 0x3f,
+	0x80, 0x66, 0x66, 0x7b, 0xd0, 0x96, 0x8e, 0x42, 0x4f, 0x25, 0xb9, 0x73, 0xfc, 0x6d, 0x3e, 0x29,
+	0xf2, 0x92, 0xec, 0x39, 0xfd, 0x76, 0x7e, 0x54, 0x82, 0xda, 0x86, 0x1a, 0x0c, 0xe4, 0x8c, 0xbc,
+	0x6e, 0x1b, 0xea, 0xba, 0x8f, 0x4e, 0x4c, 0x9a, 0x26, 0x4b, 0xc7, 0x33, 0xaa, 0x3d, 0xa5, 0xf9,
+	0xaa, 0x3d, 0xe5, 0x59, 0x5f, 0xe9, 0xa4, 0x7d, 0xe0, 0x95, 0xcb, 0xfb, 0xc0, 0x13, 0x2f, 0xa1,
+	0x9a, 0xef, 0xc0, 0x37, 0xed, 0xe8, 0xb5, 0xcf, 0xdd, 0x42, 0x6f, 0x9d, 0x95, 0x7a, 0xce, 0x59,
+	0xa1, 0xaf, 0x6a, 0x29, 0x5a, 0xf6, 0x28, 0xb3, 0xd8, 0xa0, 0xcc, 0x62, 0x16, 0x84, 0xe2, 0x37,
+	0x50, 0x9e, 0x7f, 0xe4, 0x5b, 0x14, 0x30, 0xc9, 0xc7, 0x2c, 0xcc, 0xc4, 0x65, 0xae, 0x8c, 0x55,
+	0x80, 0x71, 0x59, 0x33, 0x89, 0xcb, 0x12, 0x88, 0xf3, 0x27, 0x05, 0x28, 0xef, 0x8b, 0xf8, 0xf8,
+	0x42, 0x01, 0xc9, 0xe4, 0x3b, 0x8a, 0xf9, 0x7c, 0x47, 0xb2, 0x23, 0xa5, 0xcc, 0x8e, 0x9c, 0xff,
+	0x55, 0x00, 0x3a, 0x83, 0xa6, 0xe3, 0x3c, 0xf9, 0x1a, 0x3b, 0x1d, 0xa3, 0xb7, 0xe5, 0x09, 0x6d,
+	0xed, 0x6e, 0xc9, 0x35, 0x03, 0xe7, 0x1e, 0x54, 0x70, 0x76, 0x31, 0x7f, 0x19, 0x2a, 0x1a, 0x7f,
+	0x58, 0xf3, 0x70, 0x75, 0x62, 0x9b, 0x11, 0xc9, 0x35, 0x18, 0xce, 0x9f, 0x15, 0xa0, 0x41, 0xdb,
+	0x4e, 0x1e, 0xce, 0x4b, 0xb0, 0x6c, 0x6a, 0x76, 0x93, 0xb5, 0xc1, 0x3c, 0x94, 0x4a, 0x33, 0x04,
+	0x99, 0xa8, 0x0d, 0xe6, 0x80, 0x5f, 0xac, 0xec, 0x39, 0xdf, 0x06, 0x48, 0x27, 0x1a, 0xf3, 0x55,
+	0xa8, 0x04, 0xa4, 0x0a, 0xcd, 0x12, 0x5b, 0xb3, 0x24, 0x89, 0xf4, 0xa0, 0x41, 0xbb, 0xf3, 0xd3,
+	0x22, 0x2c, 0xe7, 0x55, 0x32, 0x55, 0xe1, 0x8c, 0xdb, 0xb7, 0x1b, 0x78, 0x99, 0x34, 0x1c, 0xe3,
+	0x57, 0xa0, 0xb9, 0x67, 0x22, 0x67, 0x02, 0xac, 0xe0, 0xa3, 0x6d, 0x35, 0x90, 0xec, 0x56, 0xf6,
+	0x63, 0xa6, 0x57, 0xd1, 0x67, 0x35, 0x45, 0x5d, 0x36, 0xe4, 0x0d, 0xdb, 0xfe, 0xfd, 0xc3, 0x22,
+	0x5f, 0xca, 0x24, 0x83, 0x7e, 0x56, 0xe4, 0xd7, 0xe0, 0xca, 0xfa, 0x28, 0xf4, 0x02, 0xe9, 0xa5,
+	0xd0, 0x9f, 0x67, 0xa1, 0x69, 0xda, 0xe7, 0x87, 0x65, 0xbe, 0x0c, 0x8d, 0xce, 0xe8, 0xd0, 0xa6,
+	0x7c, 0x7e, 0xb7, 0xcc, 0xaf, 0xc3, 0x8a, 0xc5, 0x1a, 0x87, 0xb5, 0xec, 0x47, 0x65, 0x7e, 0x15,
+	0x96, 0xd7, 0xcc, 0x82, 0xed, 0x44, 0xd9, 0xef, 0x95, 0x71, 0x0a, 0xd4, 0xd0, 0xf2, 0xfb, 0xc4,
+	0x27, 0x4d, 0x4e, 0xb3, 0x1f, 0x97, 0x39, 0x87, 0xa5, 0xc7, 0x7e, 0x1c, 0xfb, 0x61, 0xcf, 0xf2,
+	0xfe, 0x49, 0xf9, 0xce, 0x2f, 0x0a, 0xb0, 0x9c, 0x77, 0x50, 0xd1, 0xcd, 0x09, 0x54, 0xd8, 0xd3,
+	0xe6, 0x1b, 0xab, 0x25, 0x68, 0xc4, 0x7d, 0x15, 0x69, 0x1a, 0x52, 0x9d, 0x34, 0xa4, 0x5e, 0x14,
+	0x93, 0x2a, 0x33, 0x89, 0x7d, 0xd3, 0xcd, 0xa7, 0x45, 0x8f, 0x35, 0x71, 0x97, 0x50, 0x10, 0xcd,
+	0xb7, 0x15, 0x34, 0x29, 0xea, 0x89, 0x49, 0x7a, 0x0e, 0x58, 0x15, 0x51, 0x47, 0x51, 0x60, 0x92,
+	0x08, 0x72, 0x20, 0xfc, 0xc0, 0x7c, 0x4c, 0x31, 0xec, 0xab, 0xd0, 0x66, 0x11, 0x24, 0x7d, 0x57,
+	0x01, 0x99, 0x70, 0xc0, 0xc3, 0x79, 0xa4, 0xfa, 0x93, 0xc9, 0xf5, 0x3b, 0xff, 0xf0, 0xe9, 0xcd,
+	0xc2, 0x2f, 0x3f, 0xbd, 0x59, 0xf8, 0xf7, 0x4f, 0x6f, 0x16, 0x7e, 0xfa, 0xd9, 0xcd, 0x85, 0x5f,
+	0x7e, 0x76, 0x73, 0xe1, 0x5f, 0x3e, 0xbb, 0xb9, 0xf0, 0x31, 0x9b, 0xfc, 0x47, 0x10, 0x87, 0x55,
+	0xb2, 0x0c, 0xf7, 0xff, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x0c, 0x9b, 0x7d, 0x69, 0x23, 0x42, 0x00,
+	0x00,
+}
+
+func (m *SmartBlockSnapshotBase) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return dAtA[:n], nil
 }
-func (m *BlockContentOfTableOfContents) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *SmartBlockSnapshotBase) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockContentOfTableOfContents) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SmartBlockSnapshotBase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.TableOfContents != nil {
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemovedCollectionKeys) > 0 {
+		for iNdEx := len(m.RemovedCollectionKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemovedCollectionKeys[iNdEx])
+			copy(dAtA[i:], m.RemovedCollectionKeys[iNdEx])
+			i = encodeVarintModels(dAtA, i, uint64(len(m.RemovedCollectionKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.RelationLinks) > 0 {
+		for iNdEx := len(m.RelationLinks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RelationLinks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.Collections != nil {
 		{
-			size, err := m.TableOfContents.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Collections.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6525,22 +7033,34 @@ func (m *BlockContentOfTableOfContents) MarshalToSizedBuffer(dAtA []byte) (int,
 			i = encodeVarintModels(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xca
+		dAtA[i] = 0x32
 	}
-	return len(dAtA) - i, nil
-}
-func (m *BlockContentOfTable) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *BlockContentOfTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Table != nil {
+	if len(m.ObjectTypes) > 0 {
+		for iNdEx := len(m.ObjectTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ObjectTypes[iNdEx])
+			copy(dAtA[i:], m.ObjectTypes[iNdEx])
+			i = encodeVarintModels(dAtA, i, uint64(len(m.ObjectTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ExtraRelations) > 0 {
+		for iNdEx := len(m.ExtraRelations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExtraRelations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.FileKeys != nil {
 		{
-			size, err := m.Table.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.FileKeys.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6548,22 +7068,11 @@ func (m *BlockContentOfTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintModels(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xd2
+		dAtA[i] = 0x1a
 	}
-	return len(dAtA) - i, nil
-}
-func (m *BlockContentOfTableColumn) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *BlockContentOfTableColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.TableColumn != nil {
+	if m.Details != nil {
 		{
-			size, err := m.TableColumn.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6571,22 +7080,83 @@ func (m *BlockContentOfTableColumn) MarshalToSizedBuffer(dAtA []byte) (int, erro
 			i = encodeVarintModels(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xda
+		dAtA[i] = 0x12
+	}
+	if len(m.Blocks) > 0 {
+		for iNdEx := len(m.Blocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
-func (m *BlockContentOfTableRow) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *Block) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Block) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockContentOfTableRow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Block) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.TableRow != nil {
+	_ = i
+	var l int
+	_ = l
+	if m.Content != nil {
 		{
-			size, err := m.TableRow.MarshalToSizedBuffer(dAtA[:i])
+			size := m.Content.Size()
+			i -= size
+			if _, err := m.Content.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.VerticalAlign != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.VerticalAlign))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Align != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Align))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.BackgroundColor) > 0 {
+		i -= len(m.BackgroundColor)
+		copy(dAtA[i:], m.BackgroundColor)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.BackgroundColor)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ChildrenIds) > 0 {
+		for iNdEx := len(m.ChildrenIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ChildrenIds[iNdEx])
+			copy(dAtA[i:], m.ChildrenIds[iNdEx])
+			i = encodeVarintModels(dAtA, i, uint64(len(m.ChildrenIds[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Restrictions != nil {
+		{
+			size, err := m.Restrictions.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6594,22 +7164,40 @@ func (m *BlockContentOfTableRow) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintModels(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x1a
+	}
+	if m.Fields != nil {
+		{
+			size, err := m.Fields.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0xe2
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-func (m *BlockContentOfWidget) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *BlockContentOfSmartblock) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockContentOfWidget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockContentOfSmartblock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.Widget != nil {
+	if m.Smartblock != nil {
 		{
-			size, err := m.Widget.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Smartblock.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6617,183 +7205,85 @@ func (m *BlockContentOfWidget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintModels(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xea
+		dAtA[i] = 0x5a
 	}
 	return len(dAtA) - i, nil
 }
-func (m *BlockRestrictions) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *BlockRestrictions) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockContentOfText) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockRestrictions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockContentOfText) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DropOn {
-		i--
-		if m.DropOn {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.Drag {
-		i--
-		if m.Drag {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Remove {
-		i--
-		if m.Remove {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Edit {
-		i--
-		if m.Edit {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Read {
-		i--
-		if m.Read {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if m.Text != nil {
+		{
+			size, err := m.Text.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x72
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *BlockContent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *BlockContent) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockContentOfFile) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockContent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockContentOfFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *BlockContentLayout) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.File != nil {
+		{
+			size, err := m.File.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
 	}
-	return dAtA[:n], nil
+	return len(dAtA) - i, nil
 }
-
-func (m *BlockContentLayout) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockContentOfLayout) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockContentLayout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockContentOfLayout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Style != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Style))
+	if m.Layout != nil {
+		{
+			size, err := m.Layout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *BlockContentLink) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *BlockContentLink) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockContentOfDiv) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockContentLink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockContentOfDiv) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Relations) > 0 {
-		for iNdEx := len(m.Relations) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Relations[iNdEx])
-			copy(dAtA[i:], m.Relations[iNdEx])
-			i = encodeVarintModels(dAtA, i, uint64(len(m.Relations[iNdEx])))
-			i--
-			dAtA[i] = 0x3a
-		}
-	}
-	if m.Description != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Description))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.CardStyle != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.CardStyle))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.IconSize != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.IconSize))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Fields != nil {
+	if m.Div != nil {
 		{
-			size, err := m.Fields.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Div.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6801,233 +7291,114 @@ func (m *BlockContentLink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintModels(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Style != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Style))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.TargetBlockId) > 0 {
-		i -= len(m.TargetBlockId)
-		copy(dAtA[i:], m.TargetBlockId)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.TargetBlockId)))
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8a
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *BlockContentDiv) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *BlockContentDiv) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockContentOfBookmark) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockContentDiv) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockContentOfBookmark) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Style != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Style))
+	if m.Bookmark != nil {
+		{
+			size, err := m.Bookmark.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *BlockContentBookmark) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *BlockContentBookmark) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockContentOfIcon) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockContentBookmark) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockContentOfIcon) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.State != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.State))
-		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.TargetObjectId) > 0 {
-		i -= len(m.TargetObjectId)
-		copy(dAtA[i:], m.TargetObjectId)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.TargetObjectId)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.Type != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.FaviconHash) > 0 {
-		i -= len(m.FaviconHash)
-		copy(dAtA[i:], m.FaviconHash)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.FaviconHash)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.ImageHash) > 0 {
-		i -= len(m.ImageHash)
-		copy(dAtA[i:], m.ImageHash)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.ImageHash)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Title)))
+	if m.Icon != nil {
+		{
+			size, err := m.Icon.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Url) > 0 {
-		i -= len(m.Url)
-		copy(dAtA[i:], m.Url)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Url)))
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x9a
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *BlockContentIcon) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *BlockContentIcon) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockContentOfLink) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockContentIcon) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockContentOfLink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Name)))
+	if m.Link != nil {
+		{
+			size, err := m.Link.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *BlockContentFeaturedRelations) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *BlockContentFeaturedRelations) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockContentOfDataview) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockContentFeaturedRelations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockContentOfDataview) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *BlockContentText) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.Dataview != nil {
+		{
+			size, err := m.Dataview.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
 	}
-	return dAtA[:n], nil
+	return len(dAtA) - i, nil
 }
-
-func (m *BlockContentText) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockContentOfRelation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockContentText) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockContentOfRelation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.IconImage) > 0 {
-		i -= len(m.IconImage)
-		copy(dAtA[i:], m.IconImage)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.IconImage)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.IconEmoji) > 0 {
-		i -= len(m.IconEmoji)
-		copy(dAtA[i:], m.IconEmoji)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.IconEmoji)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Color) > 0 {
-		i -= len(m.Color)
-		copy(dAtA[i:], m.Color)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Color)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.Checked {
-		i--
-		if m.Checked {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Marks != nil {
+	if m.Relation != nil {
 		{
-			size, err := m.Marks.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Relation.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7035,95 +7406,91 @@ func (m *BlockContentText) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintModels(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Style != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Style))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Text) > 0 {
-		i -= len(m.Text)
-		copy(dAtA[i:], m.Text)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Text)))
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0xb2
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *BlockContentTextMarks) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *BlockContentTextMarks) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockContentOfFeaturedRelations) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockContentTextMarks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockContentOfFeaturedRelations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Marks) > 0 {
-		for iNdEx := len(m.Marks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Marks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModels(dAtA, i, uint64(size))
+	if m.FeaturedRelations != nil {
+		{
+			size, err := m.FeaturedRelations.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *BlockContentTextMark) Marshal() (dAtA []byte, err error) {
+func (m *BlockContentOfLatex) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockContentTextMark) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockContentOfLatex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Latex != nil {
+		{
+			size, err := m.Latex.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BlockContentOfTableOfContents) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockContentTextMark) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockContentOfTableOfContents) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Param) > 0 {
-		i -= len(m.Param)
-		copy(dAtA[i:], m.Param)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Param)))
+	if m.TableOfContents != nil {
+		{
+			size, err := m.TableOfContents.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Type != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Type))
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0xca
 	}
-	if m.Range != nil {
+	return len(dAtA) - i, nil
+}
+func (m *BlockContentOfTable) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockContentOfTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Table != nil {
 		{
-			size, err := m.Range.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Table.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7131,81 +7498,128 @@ func (m *BlockContentTextMark) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintModels(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *BlockContentFile) Marshal() (dAtA []byte, err error) {
+func (m *BlockContentOfTableColumn) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockContentFile) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockContentOfTableColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.TableColumn != nil {
+		{
+			size, err := m.TableColumn.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BlockContentOfTableRow) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockContentFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockContentOfTableRow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Style != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Style))
+	if m.TableRow != nil {
+		{
+			size, err := m.TableRow.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x40
-	}
-	if m.State != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.State))
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x38
+		dAtA[i] = 0xe2
 	}
-	if m.AddedAt != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.AddedAt))
+	return len(dAtA) - i, nil
+}
+func (m *BlockContentOfWidget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockContentOfWidget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Widget != nil {
+		{
+			size, err := m.Widget.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x30
-	}
-	if m.Size_ != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Size_))
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0xea
 	}
-	if len(m.Mime) > 0 {
-		i -= len(m.Mime)
-		copy(dAtA[i:], m.Mime)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Mime)))
+	return len(dAtA) - i, nil
+}
+func (m *BlockContentOfSynced) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockContentOfSynced) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Synced != nil {
+		{
+			size, err := m.Synced.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x22
-	}
-	if m.Type != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Type))
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0xf2
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Name)))
+	return len(dAtA) - i, nil
+}
+func (m *BlockContentOfDiagram) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockContentOfDiagram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Diagram != nil {
+		{
+			size, err := m.Diagram.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Hash)))
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0xfa
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *BlockContentSmartblock) Marshal() (dAtA []byte, err error) {
+func (m *BlockRestrictions) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7215,146 +7629,93 @@ func (m *BlockContentSmartblock) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BlockContentSmartblock) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockRestrictions) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockContentSmartblock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockRestrictions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *BlockContentDataview) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *BlockContentDataview) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *BlockContentDataview) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IsCollection {
+	if m.DropOn {
 		i--
-		if m.IsCollection {
+		if m.DropOn {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x70
-	}
-	if len(m.ObjectOrders) > 0 {
-		for iNdEx := len(m.ObjectOrders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ObjectOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModels(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x6a
-		}
+		dAtA[i] = 0x28
 	}
-	if len(m.GroupOrders) > 0 {
-		for iNdEx := len(m.GroupOrders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.GroupOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModels(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x62
+	if m.Drag {
+		i--
+		if m.Drag {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-	}
-	if len(m.TargetObjectId) > 0 {
-		i -= len(m.TargetObjectId)
-		copy(dAtA[i:], m.TargetObjectId)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.TargetObjectId)))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x20
 	}
-	if len(m.RelationLinks) > 0 {
-		for iNdEx := len(m.RelationLinks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RelationLinks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModels(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
+	if m.Remove {
+		i--
+		if m.Remove {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.Relations) > 0 {
-		for iNdEx := len(m.Relations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Relations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModels(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+	if m.Edit {
+		i--
+		if m.Edit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-	}
-	if len(m.ActiveView) > 0 {
-		i -= len(m.ActiveView)
-		copy(dAtA[i:], m.ActiveView)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.ActiveView)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x10
 	}
-	if len(m.Views) > 0 {
-		for iNdEx := len(m.Views) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Views[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModels(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	if m.Read {
+		i--
+		if m.Read {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.Source) > 0 {
-		for iNdEx := len(m.Source) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Source[iNdEx])
-			copy(dAtA[i:], m.Source[iNdEx])
-			i = encodeVarintModels(dAtA, i, uint64(len(m.Source[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockContent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockContent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockContent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	return len(dAtA) - i, nil
 }
 
-func (m *BlockContentDataviewView) Marshal() (dAtA []byte, err error) {
+func (m *BlockContentLayout) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7364,142 +7725,124 @@ func (m *BlockContentDataviewView) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BlockContentDataviewView) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockContentLayout) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockContentDataviewView) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockContentLayout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.DefaultTemplateId) > 0 {
-		i -= len(m.DefaultTemplateId)
-		copy(dAtA[i:], m.DefaultTemplateId)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.DefaultTemplateId)))
+	if m.Style != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Style))
 		i--
-		dAtA[i] = 0x72
+		dAtA[i] = 0x8
 	}
-	if m.PageLimit != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.PageLimit))
-		i--
-		dAtA[i] = 0x68
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockContentLink) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.GroupBackgroundColors {
-		i--
-		if m.GroupBackgroundColors {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return dAtA[:n], nil
+}
+
+func (m *BlockContentLink) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockContentLink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Relations) > 0 {
+		for iNdEx := len(m.Relations) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Relations[iNdEx])
+			copy(dAtA[i:], m.Relations[iNdEx])
+			i = encodeVarintModels(dAtA, i, uint64(len(m.Relations[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
 		}
+	}
+	if m.Description != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Description))
 		i--
-		dAtA[i] = 0x60
+		dAtA[i] = 0x30
 	}
-	if len(m.GroupRelationKey) > 0 {
-		i -= len(m.GroupRelationKey)
-		copy(dAtA[i:], m.GroupRelationKey)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.GroupRelationKey)))
+	if m.CardStyle != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.CardStyle))
 		i--
-		dAtA[i] = 0x5a
+		dAtA[i] = 0x28
 	}
-	if m.CoverFit {
+	if m.IconSize != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.IconSize))
 		i--
-		if m.CoverFit {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		dAtA[i] = 0x20
+	}
+	if m.Fields != nil {
+		{
+			size, err := m.Fields.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x50
+		dAtA[i] = 0x1a
 	}
-	if m.CardSize != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.CardSize))
+	if m.Style != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Style))
 		i--
-		dAtA[i] = 0x48
+		dAtA[i] = 0x10
 	}
-	if m.HideIcon {
+	if len(m.TargetBlockId) > 0 {
+		i -= len(m.TargetBlockId)
+		copy(dAtA[i:], m.TargetBlockId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.TargetBlockId)))
 		i--
-		if m.HideIcon {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.CoverRelationKey) > 0 {
-		i -= len(m.CoverRelationKey)
-		copy(dAtA[i:], m.CoverRelationKey)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.CoverRelationKey)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Relations) > 0 {
-		for iNdEx := len(m.Relations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Relations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModels(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.Filters) > 0 {
-		for iNdEx := len(m.Filters) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Filters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModels(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.Sorts) > 0 {
-		for iNdEx := len(m.Sorts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Sorts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModels(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0xa
 	}
-	if m.Type != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x10
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockContentDiv) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Id)))
+	return dAtA[:n], nil
+}
+
+func (m *BlockContentDiv) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockContentDiv) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Style != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Style))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BlockContentDataviewRelation) Marshal() (dAtA []byte, err error) {
+func (m *BlockContentBookmark) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7509,62 +7852,72 @@ func (m *BlockContentDataviewRelation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BlockContentDataviewRelation) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockContentBookmark) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockContentDataviewRelation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockContentBookmark) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.DateFormat != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.DateFormat))
+	if m.State != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.State))
 		i--
-		dAtA[i] = 0x38
+		dAtA[i] = 0x40
 	}
-	if m.TimeFormat != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.TimeFormat))
+	if len(m.TargetObjectId) > 0 {
+		i -= len(m.TargetObjectId)
+		copy(dAtA[i:], m.TargetObjectId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.TargetObjectId)))
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x3a
 	}
-	if m.DateIncludeTime {
+	if m.Type != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Type))
 		i--
-		if m.DateIncludeTime {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x30
+	}
+	if len(m.FaviconHash) > 0 {
+		i -= len(m.FaviconHash)
+		copy(dAtA[i:], m.FaviconHash)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.FaviconHash)))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x2a
 	}
-	if m.Width != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Width))
+	if len(m.ImageHash) > 0 {
+		i -= len(m.ImageHash)
+		copy(dAtA[i:], m.ImageHash)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.ImageHash)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x22
 	}
-	if m.IsVisible {
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Description)))
 		i--
-		if m.IsVisible {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x1a
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Title)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Key)))
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Url)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BlockContentDataviewSort) Marshal() (dAtA []byte, err error) {
+func (m *BlockContentIcon) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7574,68 +7927,27 @@ func (m *BlockContentDataviewSort) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BlockContentDataviewSort) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockContentIcon) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockContentDataviewSort) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockContentIcon) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.IncludeTime {
-		i--
-		if m.IncludeTime {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.Format != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Format))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.CustomOrder) > 0 {
-		for iNdEx := len(m.CustomOrder) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CustomOrder[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModels(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.Type != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.RelationKey) > 0 {
-		i -= len(m.RelationKey)
-		copy(dAtA[i:], m.RelationKey)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.RelationKey)))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Name)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BlockContentDataviewFilter) Marshal() (dAtA []byte, err error) {
+func (m *BlockContentFeaturedRelations) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7645,53 +7957,73 @@ func (m *BlockContentDataviewFilter) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BlockContentDataviewFilter) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockContentFeaturedRelations) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockContentDataviewFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockContentFeaturedRelations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if m.IncludeTime {
-		i--
-		if m.IncludeTime {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.Format != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Format))
-		i--
-		dAtA[i] = 0x38
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockContentText) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.QuickOption != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.QuickOption))
+	return dAtA[:n], nil
+}
+
+func (m *BlockContentText) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockContentText) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IconImage) > 0 {
+		i -= len(m.IconImage)
+		copy(dAtA[i:], m.IconImage)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.IconImage)))
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x3a
 	}
-	if len(m.RelationProperty) > 0 {
-		i -= len(m.RelationProperty)
-		copy(dAtA[i:], m.RelationProperty)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.RelationProperty)))
+	if len(m.IconEmoji) > 0 {
+		i -= len(m.IconEmoji)
+		copy(dAtA[i:], m.IconEmoji)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.IconEmoji)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Color) > 0 {
+		i -= len(m.Color)
+		copy(dAtA[i:], m.Color)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Color)))
 		i--
 		dAtA[i] = 0x2a
 	}
-	if m.Value != nil {
+	if m.Checked {
+		i--
+		if m.Checked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Marks != nil {
 		{
-			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Marks.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7699,29 +8031,24 @@ func (m *BlockContentDataviewFilter) MarshalToSizedBuffer(dAtA []byte) (int, err
 			i = encodeVarintModels(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
-	}
-	if m.Condition != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Condition))
-		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x1a
 	}
-	if len(m.RelationKey) > 0 {
-		i -= len(m.RelationKey)
-		copy(dAtA[i:], m.RelationKey)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.RelationKey)))
+	if m.Style != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Style))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if m.Operator != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Operator))
+	if len(m.Text) > 0 {
+		i -= len(m.Text)
+		copy(dAtA[i:], m.Text)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Text)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BlockContentDataviewGroupOrder) Marshal() (dAtA []byte, err error) {
+func (m *BlockContentTextMarks) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7731,20 +8058,20 @@ func (m *BlockContentDataviewGroupOrder) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BlockContentDataviewGroupOrder) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockContentTextMarks) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockContentDataviewGroupOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockContentTextMarks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ViewGroups) > 0 {
-		for iNdEx := len(m.ViewGroups) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Marks) > 0 {
+		for iNdEx := len(m.Marks) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.ViewGroups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Marks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -7752,20 +8079,13 @@ func (m *BlockContentDataviewGroupOrder) MarshalToSizedBuffer(dAtA []byte) (int,
 				i = encodeVarintModels(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0xa
 		}
 	}
-	if len(m.ViewId) > 0 {
-		i -= len(m.ViewId)
-		copy(dAtA[i:], m.ViewId)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.ViewId)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BlockContentDataviewViewGroup) Marshal() (dAtA []byte, err error) {
+func (m *BlockContentTextMark) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7775,49 +8095,44 @@ func (m *BlockContentDataviewViewGroup) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BlockContentDataviewViewGroup) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockContentTextMark) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockContentDataviewViewGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockContentTextMark) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BackgroundColor) > 0 {
-		i -= len(m.BackgroundColor)
-		copy(dAtA[i:], m.BackgroundColor)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.BackgroundColor)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Hidden {
-		i--
-		if m.Hidden {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if len(m.Param) > 0 {
+		i -= len(m.Param)
+		copy(dAtA[i:], m.Param)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Param)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x1a
 	}
-	if m.Index != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Index))
+	if m.Type != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Type))
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.GroupId) > 0 {
-		i -= len(m.GroupId)
-		copy(dAtA[i:], m.GroupId)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.GroupId)))
+	if m.Range != nil {
+		{
+			size, err := m.Range.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BlockContentDataviewObjectOrder) Marshal() (dAtA []byte, err error) {
+func (m *BlockContentFile) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7827,43 +8142,66 @@ func (m *BlockContentDataviewObjectOrder) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BlockContentDataviewObjectOrder) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockContentFile) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockContentDataviewObjectOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockContentFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ObjectIds) > 0 {
-		for iNdEx := len(m.ObjectIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ObjectIds[iNdEx])
-			copy(dAtA[i:], m.ObjectIds[iNdEx])
-			i = encodeVarintModels(dAtA, i, uint64(len(m.ObjectIds[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
+	if m.Style != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Style))
+		i--
+		dAtA[i] = 0x40
 	}
-	if len(m.GroupId) > 0 {
-		i -= len(m.GroupId)
-		copy(dAtA[i:], m.GroupId)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.GroupId)))
+	if m.State != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.AddedAt != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.AddedAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Size_ != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Mime) > 0 {
+		i -= len(m.Mime)
+		copy(dAtA[i:], m.Mime)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Mime)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Type != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Name)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.ViewId) > 0 {
-		i -= len(m.ViewId)
-		copy(dAtA[i:], m.ViewId)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.ViewId)))
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Hash)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BlockContentDataviewGroup) Marshal() (dAtA []byte, err error) {
+func (m *BlockContentSmartblock) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7873,120 +8211,146 @@ func (m *BlockContentDataviewGroup) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BlockContentDataviewGroup) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockContentSmartblock) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockContentDataviewGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockContentSmartblock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Value != nil {
-		{
-			size := m.Value.Size()
-			i -= size
-			if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BlockContentDataviewGroupValueOfStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockContentDataview) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *BlockContentDataviewGroupValueOfStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Status != nil {
-		{
-			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintModels(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return dAtA[:n], nil
 }
-func (m *BlockContentDataviewGroupValueOfTag) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *BlockContentDataview) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockContentDataviewGroupValueOfTag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockContentDataview) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.Tag != nil {
-		{
-			size, err := m.Tag.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintModels(dAtA, i, uint64(size))
+	_ = i
+	var l int
+	_ = l
+	if m.IsCollection {
+		i--
+		if m.IsCollection {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x70
 	}
-	return len(dAtA) - i, nil
-}
-func (m *BlockContentDataviewGroupValueOfCheckbox) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *BlockContentDataviewGroupValueOfCheckbox) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Checkbox != nil {
-		{
-			size, err := m.Checkbox.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.ObjectOrders) > 0 {
+		for iNdEx := len(m.ObjectOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ObjectOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintModels(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.GroupOrders) > 0 {
+		for iNdEx := len(m.GroupOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GroupOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
 		}
+	}
+	if len(m.TargetObjectId) > 0 {
+		i -= len(m.TargetObjectId)
+		copy(dAtA[i:], m.TargetObjectId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.TargetObjectId)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x32
 	}
-	return len(dAtA) - i, nil
-}
-func (m *BlockContentDataviewGroupValueOfDate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *BlockContentDataviewGroupValueOfDate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Date != nil {
-		{
-			size, err := m.Date.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.RelationLinks) > 0 {
+		for iNdEx := len(m.RelationLinks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RelationLinks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintModels(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Relations) > 0 {
+		for iNdEx := len(m.Relations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Relations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
 		}
+	}
+	if len(m.ActiveView) > 0 {
+		i -= len(m.ActiveView)
+		copy(dAtA[i:], m.ActiveView)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.ActiveView)))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x1a
+	}
+	if len(m.Views) > 0 {
+		for iNdEx := len(m.Views) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Views[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Source) > 0 {
+		for iNdEx := len(m.Source) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Source[iNdEx])
+			copy(dAtA[i:], m.Source[iNdEx])
+			i = encodeVarintModels(dAtA, i, uint64(len(m.Source[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
-func (m *BlockContentDataviewStatus) Marshal() (dAtA []byte, err error) {
+
+func (m *BlockContentDataviewView) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7996,16 +8360,131 @@ func (m *BlockContentDataviewStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BlockContentDataviewStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockContentDataviewView) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockContentDataviewStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockContentDataviewView) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.DefaultTemplateId) > 0 {
+		i -= len(m.DefaultTemplateId)
+		copy(dAtA[i:], m.DefaultTemplateId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.DefaultTemplateId)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.PageLimit != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.PageLimit))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.GroupBackgroundColors {
+		i--
+		if m.GroupBackgroundColors {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.GroupRelationKey) > 0 {
+		i -= len(m.GroupRelationKey)
+		copy(dAtA[i:], m.GroupRelationKey)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.GroupRelationKey)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.CoverFit {
+		i--
+		if m.CoverFit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.CardSize != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.CardSize))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.HideIcon {
+		i--
+		if m.HideIcon {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.CoverRelationKey) > 0 {
+		i -= len(m.CoverRelationKey)
+		copy(dAtA[i:], m.CoverRelationKey)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.CoverRelationKey)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Relations) > 0 {
+		for iNdEx := len(m.Relations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Relations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Filters) > 0 {
+		for iNdEx := len(m.Filters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Filters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Sorts) > 0 {
+		for iNdEx := len(m.Sorts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sorts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Type != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.Id) > 0 {
 		i -= len(m.Id)
 		copy(dAtA[i:], m.Id)
@@ -8016,7 +8495,7 @@ func (m *BlockContentDataviewStatus) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *BlockContentDataviewTag) Marshal() (dAtA []byte, err error) {
+func (m *BlockContentDataviewRelation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8026,29 +8505,62 @@ func (m *BlockContentDataviewTag) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BlockContentDataviewTag) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockContentDataviewRelation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockContentDataviewTag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockContentDataviewRelation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Ids) > 0 {
-		for iNdEx := len(m.Ids) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Ids[iNdEx])
-			copy(dAtA[i:], m.Ids[iNdEx])
-			i = encodeVarintModels(dAtA, i, uint64(len(m.Ids[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+	if m.DateFormat != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.DateFormat))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.TimeFormat != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.TimeFormat))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.DateIncludeTime {
+		i--
+		if m.DateIncludeTime {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Width != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Width))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.IsVisible {
+		i--
+		if m.IsVisible {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BlockContentDataviewCheckbox) Marshal() (dAtA []byte, err error) {
+func (m *BlockContentDataviewSort) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8058,30 +8570,68 @@ func (m *BlockContentDataviewCheckbox) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BlockContentDataviewCheckbox) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockContentDataviewSort) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockContentDataviewCheckbox) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockContentDataviewSort) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Checked {
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Id)))
 		i--
-		if m.Checked {
+		dAtA[i] = 0x32
+	}
+	if m.IncludeTime {
+		i--
+		if m.IncludeTime {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x28
+	}
+	if m.Format != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Format))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.CustomOrder) > 0 {
+		for iNdEx := len(m.CustomOrder) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CustomOrder[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Type != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RelationKey) > 0 {
+		i -= len(m.RelationKey)
+		copy(dAtA[i:], m.RelationKey)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.RelationKey)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BlockContentDataviewDate) Marshal() (dAtA []byte, err error) {
+func (m *BlockContentDataviewFilter) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8091,20 +8641,83 @@ func (m *BlockContentDataviewDate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BlockContentDataviewDate) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockContentDataviewFilter) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockContentDataviewDate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockContentDataviewFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.IncludeTime {
+		i--
+		if m.IncludeTime {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Format != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Format))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.QuickOption != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.QuickOption))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.RelationProperty) > 0 {
+		i -= len(m.RelationProperty)
+		copy(dAtA[i:], m.RelationProperty)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.RelationProperty)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Value != nil {
+		{
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Condition != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Condition))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RelationKey) > 0 {
+		i -= len(m.RelationKey)
+		copy(dAtA[i:], m.RelationKey)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.RelationKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Operator != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Operator))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BlockContentRelation) Marshal() (dAtA []byte, err error) {
+func (m *BlockContentDataviewGroupOrder) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8114,27 +8727,41 @@ func (m *BlockContentRelation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BlockContentRelation) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockContentDataviewGroupOrder) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockContentRelation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockContentDataviewGroupOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Key)))
+	if len(m.ViewGroups) > 0 {
+		for iNdEx := len(m.ViewGroups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ViewGroups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ViewId) > 0 {
+		i -= len(m.ViewId)
+		copy(dAtA[i:], m.ViewId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.ViewId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BlockContentLatex) Marshal() (dAtA []byte, err error) {
+func (m *BlockContentDataviewViewGroup) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8144,27 +8771,49 @@ func (m *BlockContentLatex) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BlockContentLatex) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockContentDataviewViewGroup) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockContentLatex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockContentDataviewViewGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Text) > 0 {
-		i -= len(m.Text)
-		copy(dAtA[i:], m.Text)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Text)))
+	if len(m.BackgroundColor) > 0 {
+		i -= len(m.BackgroundColor)
+		copy(dAtA[i:], m.BackgroundColor)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.BackgroundColor)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Hidden {
+		i--
+		if m.Hidden {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Index != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.GroupId) > 0 {
+		i -= len(m.GroupId)
+		copy(dAtA[i:], m.GroupId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.GroupId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BlockContentTableOfContents) Marshal() (dAtA []byte, err error) {
+func (m *BlockContentDataviewObjectOrder) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8174,43 +8823,166 @@ func (m *BlockContentTableOfContents) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BlockContentTableOfContents) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockContentDataviewObjectOrder) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockContentTableOfContents) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockContentDataviewObjectOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *BlockContentTable) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.ObjectIds) > 0 {
+		for iNdEx := len(m.ObjectIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ObjectIds[iNdEx])
+			copy(dAtA[i:], m.ObjectIds[iNdEx])
+			i = encodeVarintModels(dAtA, i, uint64(len(m.ObjectIds[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	return dAtA[:n], nil
+	if len(m.GroupId) > 0 {
+		i -= len(m.GroupId)
+		copy(dAtA[i:], m.GroupId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.GroupId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ViewId) > 0 {
+		i -= len(m.ViewId)
+		copy(dAtA[i:], m.ViewId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.ViewId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *BlockContentTable) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockContentDataviewGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockContentDataviewGroup) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockContentTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockContentDataviewGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Value != nil {
+		{
+			size := m.Value.Size()
+			i -= size
+			if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BlockContentTableColumn) Marshal() (dAtA []byte, err error) {
+func (m *BlockContentDataviewGroupValueOfStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockContentDataviewGroupValueOfStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Status != nil {
+		{
+			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BlockContentDataviewGroupValueOfTag) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockContentDataviewGroupValueOfTag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Tag != nil {
+		{
+			size, err := m.Tag.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BlockContentDataviewGroupValueOfCheckbox) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockContentDataviewGroupValueOfCheckbox) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Checkbox != nil {
+		{
+			size, err := m.Checkbox.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BlockContentDataviewGroupValueOfDate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockContentDataviewGroupValueOfDate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Date != nil {
+		{
+			size, err := m.Date.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BlockContentDataviewStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8220,20 +8992,27 @@ func (m *BlockContentTableColumn) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BlockContentTableColumn) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockContentDataviewStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockContentTableColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockContentDataviewStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BlockContentTableRow) Marshal() (dAtA []byte, err error) {
+func (m *BlockContentDataviewTag) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8243,30 +9022,29 @@ func (m *BlockContentTableRow) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BlockContentTableRow) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockContentDataviewTag) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockContentTableRow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockContentDataviewTag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.IsHeader {
-		i--
-		if m.IsHeader {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.Ids) > 0 {
+		for iNdEx := len(m.Ids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Ids[iNdEx])
+			copy(dAtA[i:], m.Ids[iNdEx])
+			i = encodeVarintModels(dAtA, i, uint64(len(m.Ids[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BlockContentWidget) Marshal() (dAtA []byte, err error) {
+func (m *BlockContentDataviewCheckbox) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8276,37 +9054,30 @@ func (m *BlockContentWidget) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BlockContentWidget) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockContentDataviewCheckbox) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockContentWidget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockContentDataviewCheckbox) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ViewId) > 0 {
-		i -= len(m.ViewId)
-		copy(dAtA[i:], m.ViewId)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.ViewId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Limit != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Limit))
+	if m.Checked {
 		i--
-		dAtA[i] = 0x10
-	}
-	if m.Layout != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Layout))
+		if m.Checked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BlockMetaOnly) Marshal() (dAtA []byte, err error) {
+func (m *BlockContentDataviewDate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8316,39 +9087,20 @@ func (m *BlockMetaOnly) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BlockMetaOnly) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockContentDataviewDate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockMetaOnly) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockContentDataviewDate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Fields != nil {
-		{
-			size, err := m.Fields.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintModels(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Range) Marshal() (dAtA []byte, err error) {
+func (m *BlockContentRelation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8358,30 +9110,27 @@ func (m *Range) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Range) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockContentRelation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Range) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockContentRelation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.To != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.To))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.From != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.From))
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Key)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Account) Marshal() (dAtA []byte, err error) {
+func (m *BlockContentLatex) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8391,82 +9140,27 @@ func (m *Account) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Account) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockContentLatex) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Account) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockContentLatex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Info != nil {
-		{
-			size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintModels(dAtA, i, uint64(size))
-		}
+	if len(m.Text) > 0 {
+		i -= len(m.Text)
+		copy(dAtA[i:], m.Text)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Text)))
 		i--
-		dAtA[i] = 0x32
-	}
-	if m.Status != nil {
-		{
-			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintModels(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.Config != nil {
-		{
-			size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintModels(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Avatar != nil {
-		{
-			size, err := m.Avatar.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintModels(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AccountAvatar) Marshal() (dAtA []byte, err error) {
+func (m *BlockContentTableOfContents) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8476,64 +9170,66 @@ func (m *AccountAvatar) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AccountAvatar) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockContentTableOfContents) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AccountAvatar) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockContentTableOfContents) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Avatar != nil {
-		{
-			size := m.Avatar.Size()
-			i -= size
-			if _, err := m.Avatar.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AccountAvatarAvatarOfImage) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockContentTable) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockContentTable) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AccountAvatarAvatarOfImage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockContentTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.Image != nil {
-		{
-			size, err := m.Image.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintModels(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
+	_ = i
+	var l int
+	_ = l
 	return len(dAtA) - i, nil
 }
-func (m *AccountAvatarAvatarOfColor) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *BlockContentTableColumn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockContentTableColumn) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AccountAvatarAvatarOfColor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockContentTableColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	i -= len(m.Color)
-	copy(dAtA[i:], m.Color)
-	i = encodeVarintModels(dAtA, i, uint64(len(m.Color)))
-	i--
-	dAtA[i] = 0x12
+	_ = i
+	var l int
+	_ = l
 	return len(dAtA) - i, nil
 }
-func (m *AccountConfig) Marshal() (dAtA []byte, err error) {
+
+func (m *BlockContentTableRow) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8543,74 +9239,70 @@ func (m *AccountConfig) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AccountConfig) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockContentTableRow) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AccountConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockContentTableRow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Extra != nil {
-		{
-			size, err := m.Extra.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintModels(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0xa2
-	}
-	if m.EnableSpaces {
+	if m.IsHeader {
 		i--
-		if m.EnableSpaces {
+		if m.IsHeader {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x8
 	}
-	if m.EnablePrereleaseChannel {
-		i--
-		if m.EnablePrereleaseChannel {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockContentWidget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.EnableDebug {
+	return dAtA[:n], nil
+}
+
+func (m *BlockContentWidget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockContentWidget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ViewId) > 0 {
+		i -= len(m.ViewId)
+		copy(dAtA[i:], m.ViewId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.ViewId)))
 		i--
-		if m.EnableDebug {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x1a
+	}
+	if m.Limit != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Limit))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.EnableDataview {
-		i--
-		if m.EnableDataview {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if m.Layout != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Layout))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AccountStatus) Marshal() (dAtA []byte, err error) {
+func (m *BlockContentSynced) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8620,30 +9312,34 @@ func (m *AccountStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AccountStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockContentSynced) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AccountStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockContentSynced) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.DeletionDate != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.DeletionDate))
+	if len(m.TargetBlockId) > 0 {
+		i -= len(m.TargetBlockId)
+		copy(dAtA[i:], m.TargetBlockId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.TargetBlockId)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if m.StatusType != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.StatusType))
+	if len(m.TargetObjectId) > 0 {
+		i -= len(m.TargetObjectId)
+		copy(dAtA[i:], m.TargetObjectId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.TargetObjectId)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AccountInfo) Marshal() (dAtA []byte, err error) {
+func (m *BlockContentDiagram) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8653,177 +9349,74 @@ func (m *AccountInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AccountInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockContentDiagram) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AccountInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockContentDiagram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.NetworkId) > 0 {
-		i -= len(m.NetworkId)
-		copy(dAtA[i:], m.NetworkId)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.NetworkId)))
-		i--
-		dAtA[i] = 0x6
+	if m.Language != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Language))
 		i--
-		dAtA[i] = 0xd2
+		dAtA[i] = 0x10
 	}
-	if len(m.AnalyticsId) > 0 {
-		i -= len(m.AnalyticsId)
-		copy(dAtA[i:], m.AnalyticsId)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.AnalyticsId)))
-		i--
-		dAtA[i] = 0x6
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Source)))
 		i--
-		dAtA[i] = 0xca
+		dAtA[i] = 0xa
 	}
-	if len(m.TimeZone) > 0 {
-		i -= len(m.TimeZone)
-		copy(dAtA[i:], m.TimeZone)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.TimeZone)))
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0xc2
-	}
-	if len(m.LocalStoragePath) > 0 {
-		i -= len(m.LocalStoragePath)
-		copy(dAtA[i:], m.LocalStoragePath)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.LocalStoragePath)))
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0xba
-	}
-	if len(m.GatewayUrl) > 0 {
-		i -= len(m.GatewayUrl)
-		copy(dAtA[i:], m.GatewayUrl)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.GatewayUrl)))
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0xaa
-	}
-	if len(m.MarketplaceWorkspaceId) > 0 {
-		i -= len(m.MarketplaceWorkspaceId)
-		copy(dAtA[i:], m.MarketplaceWorkspaceId)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.MarketplaceWorkspaceId)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if len(m.WidgetsId) > 0 {
-		i -= len(m.WidgetsId)
-		copy(dAtA[i:], m.WidgetsId)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.WidgetsId)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.AccountSpaceId) > 0 {
-		i -= len(m.AccountSpaceId)
-		copy(dAtA[i:], m.AccountSpaceId)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.AccountSpaceId)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.DeviceId) > 0 {
-		i -= len(m.DeviceId)
-		copy(dAtA[i:], m.DeviceId)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.DeviceId)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.ProfileObjectId) > 0 {
-		i -= len(m.ProfileObjectId)
-		copy(dAtA[i:], m.ProfileObjectId)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.ProfileObjectId)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ArchiveObjectId) > 0 {
-		i -= len(m.ArchiveObjectId)
-		copy(dAtA[i:], m.ArchiveObjectId)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.ArchiveObjectId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.HomeObjectId) > 0 {
-		i -= len(m.HomeObjectId)
-		copy(dAtA[i:], m.HomeObjectId)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.HomeObjectId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *LinkPreview) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockMetaOnly) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *LinkPreview) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockMetaOnly) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LinkPreview) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockMetaOnly) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Type != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.FaviconUrl) > 0 {
-		i -= len(m.FaviconUrl)
-		copy(dAtA[i:], m.FaviconUrl)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.FaviconUrl)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.ImageUrl) > 0 {
-		i -= len(m.ImageUrl)
-		copy(dAtA[i:], m.ImageUrl)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.ImageUrl)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Title)))
+	if m.Fields != nil {
+		{
+			size, err := m.Fields.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Url) > 0 {
-		i -= len(m.Url)
-		copy(dAtA[i:], m.Url)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Url)))
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Id)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Restrictions) Marshal() (dAtA []byte, err error) {
+func (m *Range) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8833,52 +9426,30 @@ func (m *Restrictions) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Restrictions) MarshalTo(dAtA []byte) (int, error) {
+func (m *Range) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Restrictions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Range) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Dataview) > 0 {
-		for iNdEx := len(m.Dataview) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Dataview[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModels(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if m.To != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.To))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.Object) > 0 {
-		dAtA39 := make([]byte, len(m.Object)*10)
-		var j38 int
-		for _, num := range m.Object {
-			for num >= 1<<7 {
-				dAtA39[j38] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j38++
-			}
-			dAtA39[j38] = uint8(num)
-			j38++
-		}
-		i -= j38
-		copy(dAtA[i:], dAtA39[:j38])
-		i = encodeVarintModels(dAtA, i, uint64(j38))
+	if m.From != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.From))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RestrictionsDataviewRestrictions) Marshal() (dAtA []byte, err error) {
+func (m *ImageEditOptions) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8888,68 +9459,62 @@ func (m *RestrictionsDataviewRestrictions) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RestrictionsDataviewRestrictions) MarshalTo(dAtA []byte) (int, error) {
+func (m *ImageEditOptions) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RestrictionsDataviewRestrictions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ImageEditOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Restrictions) > 0 {
-		dAtA41 := make([]byte, len(m.Restrictions)*10)
-		var j40 int
-		for _, num := range m.Restrictions {
-			for num >= 1<<7 {
-				dAtA41[j40] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j40++
+	if m.Format != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Format))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Crop != nil {
+		{
+			size, err := m.Crop.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			dAtA41[j40] = uint8(num)
-			j40++
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
 		}
-		i -= j40
-		copy(dAtA[i:], dAtA41[:j40])
-		i = encodeVarintModels(dAtA, i, uint64(j40))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x22
 	}
-	if len(m.BlockId) > 0 {
-		i -= len(m.BlockId)
-		copy(dAtA[i:], m.BlockId)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.BlockId)))
+	if m.Rotate != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Rotate))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x18
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Object) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.StripLocation {
+		i--
+		if m.StripLocation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StripExif {
+		i--
+		if m.StripExif {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Object) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Object) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
 	return len(dAtA) - i, nil
 }
 
-func (m *ObjectChangePayload) Marshal() (dAtA []byte, err error) {
+func (m *ImageEditOptionsRect) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8959,25 +9524,40 @@ func (m *ObjectChangePayload) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ObjectChangePayload) MarshalTo(dAtA []byte) (int, error) {
+func (m *ImageEditOptionsRect) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ObjectChangePayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ImageEditOptionsRect) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.SmartBlockType != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.SmartBlockType))
+	if m.Height != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Width != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Width))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Y != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Y))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.X != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.X))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ObjectType) Marshal() (dAtA []byte, err error) {
+func (m *Account) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8987,106 +9567,63 @@ func (m *ObjectType) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ObjectType) MarshalTo(dAtA []byte) (int, error) {
+func (m *Account) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ObjectType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Account) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.InstalledByDefault {
-		i--
-		if m.InstalledByDefault {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if m.Info != nil {
+		{
+			size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x58
+		dAtA[i] = 0x32
 	}
-	if m.Readonly {
-		i--
-		if m.Readonly {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.IsArchived {
-		i--
-		if m.IsArchived {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x48
-	}
-	if len(m.Types) > 0 {
-		dAtA43 := make([]byte, len(m.Types)*10)
-		var j42 int
-		for _, num := range m.Types {
-			for num >= 1<<7 {
-				dAtA43[j42] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j42++
+	if m.Status != nil {
+		{
+			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			dAtA43[j42] = uint8(num)
-			j42++
-		}
-		i -= j42
-		copy(dAtA[i:], dAtA43[:j42])
-		i = encodeVarintModels(dAtA, i, uint64(j42))
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.Hidden {
-		i--
-		if m.Hidden {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.IconEmoji) > 0 {
-		i -= len(m.IconEmoji)
-		copy(dAtA[i:], m.IconEmoji)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.IconEmoji)))
-		i--
 		dAtA[i] = 0x2a
 	}
-	if m.Layout != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Layout))
+	if m.Config != nil {
+		{
+			size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x22
 	}
-	if len(m.RelationLinks) > 0 {
-		for iNdEx := len(m.RelationLinks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RelationLinks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModels(dAtA, i, uint64(size))
+	if m.Avatar != nil {
+		{
+			size, err := m.Avatar.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x1a
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
 	if len(m.Name) > 0 {
 		i -= len(m.Name)
@@ -9095,17 +9632,17 @@ func (m *ObjectType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Url) > 0 {
-		i -= len(m.Url)
-		copy(dAtA[i:], m.Url)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Url)))
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Id)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Layout) Marshal() (dAtA []byte, err error) {
+func (m *AccountAvatar) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9115,80 +9652,38 @@ func (m *Layout) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Layout) MarshalTo(dAtA []byte) (int, error) {
+func (m *AccountAvatar) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Layout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AccountAvatar) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.RequiredRelations) > 0 {
-		for iNdEx := len(m.RequiredRelations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RequiredRelations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModels(dAtA, i, uint64(size))
+	if m.Avatar != nil {
+		{
+			size := m.Avatar.Size()
+			i -= size
+			if _, err := m.Avatar.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Id != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RelationWithValue) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *RelationWithValue) MarshalTo(dAtA []byte) (int, error) {
+func (m *AccountAvatarAvatarOfImage) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RelationWithValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AccountAvatarAvatarOfImage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Value != nil {
-		{
-			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintModels(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Relation != nil {
+	if m.Image != nil {
 		{
-			size, err := m.Relation.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Image.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9200,8 +9695,21 @@ func (m *RelationWithValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *AccountAvatarAvatarOfColor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
 
-func (m *Relation) Marshal() (dAtA []byte, err error) {
+func (m *AccountAvatarAvatarOfColor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.Color)
+	copy(dAtA[i:], m.Color)
+	i = encodeVarintModels(dAtA, i, uint64(len(m.Color)))
+	i--
+	dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+func (m *AccountConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9211,156 +9719,224 @@ func (m *Relation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Relation) MarshalTo(dAtA []byte) (int, error) {
+func (m *AccountConfig) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Relation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AccountConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Id)))
+	if m.Extra != nil {
+		{
+			size, err := m.Extra.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x6
 		i--
 		dAtA[i] = 0xa2
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Creator)))
+	if m.EnableSpaces {
 		i--
-		dAtA[i] = 0x1
+		if m.EnableSpaces {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0xaa
+		dAtA[i] = 0x20
 	}
-	if m.Scope != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Scope))
+	if m.EnablePrereleaseChannel {
 		i--
-		dAtA[i] = 0x1
+		if m.EnablePrereleaseChannel {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0xa0
+		dAtA[i] = 0x18
 	}
-	if m.ReadOnlyRelation {
+	if m.EnableDebug {
 		i--
-		if m.ReadOnlyRelation {
+		if m.EnableDebug {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x78
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x72
+		dAtA[i] = 0x10
 	}
-	if m.MaxCount != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.MaxCount))
+	if m.EnableDataview {
 		i--
-		dAtA[i] = 0x68
-	}
-	if len(m.SelectDict) > 0 {
-		for iNdEx := len(m.SelectDict) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SelectDict[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModels(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x62
+		if m.EnableDataview {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.ObjectTypes) > 0 {
-		for iNdEx := len(m.ObjectTypes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ObjectTypes[iNdEx])
-			copy(dAtA[i:], m.ObjectTypes[iNdEx])
-			i = encodeVarintModels(dAtA, i, uint64(len(m.ObjectTypes[iNdEx])))
-			i--
-			dAtA[i] = 0x4a
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Multi {
+	return dAtA[:n], nil
+}
+
+func (m *AccountStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeletionDate != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.DeletionDate))
 		i--
-		if m.Multi {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x10
+	}
+	if m.StatusType != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.StatusType))
 		i--
-		dAtA[i] = 0x40
+		dAtA[i] = 0x8
 	}
-	if m.ReadOnly {
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NetworkId) > 0 {
+		i -= len(m.NetworkId)
+		copy(dAtA[i:], m.NetworkId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.NetworkId)))
 		i--
-		if m.ReadOnly {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x6
 		i--
-		dAtA[i] = 0x38
+		dAtA[i] = 0xd2
 	}
-	if m.Hidden {
+	if len(m.AnalyticsId) > 0 {
+		i -= len(m.AnalyticsId)
+		copy(dAtA[i:], m.AnalyticsId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.AnalyticsId)))
 		i--
-		if m.Hidden {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x6
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0xca
 	}
-	if m.DataSource != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.DataSource))
+	if len(m.TimeZone) > 0 {
+		i -= len(m.TimeZone)
+		copy(dAtA[i:], m.TimeZone)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.TimeZone)))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xc2
 	}
-	if m.DefaultValue != nil {
-		{
-			size, err := m.DefaultValue.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintModels(dAtA, i, uint64(size))
-		}
+	if len(m.LocalStoragePath) > 0 {
+		i -= len(m.LocalStoragePath)
+		copy(dAtA[i:], m.LocalStoragePath)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.LocalStoragePath)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xba
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Name)))
+	if len(m.GatewayUrl) > 0 {
+		i -= len(m.GatewayUrl)
+		copy(dAtA[i:], m.GatewayUrl)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.GatewayUrl)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xaa
 	}
-	if m.Format != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Format))
+	if len(m.MarketplaceWorkspaceId) > 0 {
+		i -= len(m.MarketplaceWorkspaceId)
+		copy(dAtA[i:], m.MarketplaceWorkspaceId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.MarketplaceWorkspaceId)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x5a
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Key)))
+	if len(m.WidgetsId) > 0 {
+		i -= len(m.WidgetsId)
+		copy(dAtA[i:], m.WidgetsId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.WidgetsId)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x52
+	}
+	if len(m.AccountSpaceId) > 0 {
+		i -= len(m.AccountSpaceId)
+		copy(dAtA[i:], m.AccountSpaceId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.AccountSpaceId)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.DeviceId) > 0 {
+		i -= len(m.DeviceId)
+		copy(dAtA[i:], m.DeviceId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.DeviceId)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ProfileObjectId) > 0 {
+		i -= len(m.ProfileObjectId)
+		copy(dAtA[i:], m.ProfileObjectId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.ProfileObjectId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ArchiveObjectId) > 0 {
+		i -= len(m.ArchiveObjectId)
+		copy(dAtA[i:], m.ArchiveObjectId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.ArchiveObjectId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.HomeObjectId) > 0 {
+		i -= len(m.HomeObjectId)
+		copy(dAtA[i:], m.HomeObjectId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.HomeObjectId)))
+		i--
+		dAtA[i] = 0x12
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RelationOption) Marshal() (dAtA []byte, err error) {
+func (m *LinkPreview) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9370,48 +9946,60 @@ func (m *RelationOption) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RelationOption) MarshalTo(dAtA []byte) (int, error) {
+func (m *LinkPreview) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RelationOption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LinkPreview) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.RelationKey) > 0 {
-		i -= len(m.RelationKey)
-		copy(dAtA[i:], m.RelationKey)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.RelationKey)))
+	if m.Type != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.FaviconUrl) > 0 {
+		i -= len(m.FaviconUrl)
+		copy(dAtA[i:], m.FaviconUrl)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.FaviconUrl)))
 		i--
 		dAtA[i] = 0x2a
 	}
-	if len(m.Color) > 0 {
-		i -= len(m.Color)
-		copy(dAtA[i:], m.Color)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Color)))
+	if len(m.ImageUrl) > 0 {
+		i -= len(m.ImageUrl)
+		copy(dAtA[i:], m.ImageUrl)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.ImageUrl)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Description)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Text) > 0 {
-		i -= len(m.Text)
-		copy(dAtA[i:], m.Text)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Text)))
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Title)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Id)))
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Url)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RelationLink) Marshal() (dAtA []byte, err error) {
+func (m *Restrictions) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9421,32 +10009,52 @@ func (m *RelationLink) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RelationLink) MarshalTo(dAtA []byte) (int, error) {
+func (m *Restrictions) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RelationLink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Restrictions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Format != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Format))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Relations) Marshal() (dAtA []byte, err error) {
+	if len(m.Dataview) > 0 {
+		for iNdEx := len(m.Dataview) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Dataview[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Object) > 0 {
+		dAtA42 := make([]byte, len(m.Object)*10)
+		var j41 int
+		for _, num := range m.Object {
+			for num >= 1<<7 {
+				dAtA42[j41] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j41++
+			}
+			dAtA42[j41] = uint8(num)
+			j41++
+		}
+		i -= j41
+		copy(dAtA[i:], dAtA42[:j41])
+		i = encodeVarintModels(dAtA, i, uint64(j41))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RestrictionsDataviewRestrictions) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9456,34 +10064,45 @@ func (m *Relations) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Relations) MarshalTo(dAtA []byte) (int, error) {
+func (m *RestrictionsDataviewRestrictions) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Relations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RestrictionsDataviewRestrictions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Relations) > 0 {
-		for iNdEx := len(m.Relations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Relations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModels(dAtA, i, uint64(size))
+	if len(m.Restrictions) > 0 {
+		dAtA44 := make([]byte, len(m.Restrictions)*10)
+		var j43 int
+		for _, num := range m.Restrictions {
+			for num >= 1<<7 {
+				dAtA44[j43] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j43++
 			}
-			i--
-			dAtA[i] = 0xa
+			dAtA44[j43] = uint8(num)
+			j43++
 		}
+		i -= j43
+		copy(dAtA[i:], dAtA44[:j43])
+		i = encodeVarintModels(dAtA, i, uint64(j43))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BlockId) > 0 {
+		i -= len(m.BlockId)
+		copy(dAtA[i:], m.BlockId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.BlockId)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RelationOptions) Marshal() (dAtA []byte, err error) {
+func (m *Object) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9493,34 +10112,20 @@ func (m *RelationOptions) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RelationOptions) MarshalTo(dAtA []byte) (int, error) {
+func (m *Object) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RelationOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Object) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Options) > 0 {
-		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModels(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *InternalFlag) Marshal() (dAtA []byte, err error) {
+func (m *ObjectChangePayload) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9530,25 +10135,25 @@ func (m *InternalFlag) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *InternalFlag) MarshalTo(dAtA []byte) (int, error) {
+func (m *ObjectChangePayload) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *InternalFlag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ObjectChangePayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Value != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Value))
+	if m.SmartBlockType != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.SmartBlockType))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ObjectView) Marshal() (dAtA []byte, err error) {
+func (m *ObjectType) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9558,77 +10163,97 @@ func (m *ObjectView) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ObjectView) MarshalTo(dAtA []byte) (int, error) {
+func (m *ObjectType) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ObjectView) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ObjectType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.RelationLinks) > 0 {
-		for iNdEx := len(m.RelationLinks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RelationLinks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModels(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x52
+	if m.InstalledByDefault {
+		i--
+		if m.InstalledByDefault {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x58
 	}
-	if m.History != nil {
-		{
-			size, err := m.History.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintModels(dAtA, i, uint64(size))
+	if m.Readonly {
+		i--
+		if m.Readonly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x4a
+		dAtA[i] = 0x50
 	}
-	if m.Restrictions != nil {
-		{
-			size, err := m.Restrictions.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if m.IsArchived {
+		i--
+		if m.IsArchived {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Types) > 0 {
+		dAtA46 := make([]byte, len(m.Types)*10)
+		var j45 int
+		for _, num := range m.Types {
+			for num >= 1<<7 {
+				dAtA46[j45] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j45++
 			}
-			i -= size
-			i = encodeVarintModels(dAtA, i, uint64(size))
+			dAtA46[j45] = uint8(num)
+			j45++
 		}
+		i -= j45
+		copy(dAtA[i:], dAtA46[:j45])
+		i = encodeVarintModels(dAtA, i, uint64(j45))
 		i--
 		dAtA[i] = 0x42
 	}
-	if len(m.Relations) > 0 {
-		for iNdEx := len(m.Relations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Relations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModels(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
+	if m.Hidden {
+		i--
+		if m.Hidden {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.Type != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Type))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.IconEmoji) > 0 {
+		i -= len(m.IconEmoji)
+		copy(dAtA[i:], m.IconEmoji)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.IconEmoji)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Layout != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Layout))
 		i--
 		dAtA[i] = 0x20
 	}
-	if len(m.Details) > 0 {
-		for iNdEx := len(m.Details) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.RelationLinks) > 0 {
+		for iNdEx := len(m.RelationLinks) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Details[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.RelationLinks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -9639,31 +10264,24 @@ func (m *ObjectView) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.Blocks) > 0 {
-		for iNdEx := len(m.Blocks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Blocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModels(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.RootId) > 0 {
-		i -= len(m.RootId)
-		copy(dAtA[i:], m.RootId)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.RootId)))
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Url)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ObjectViewDetailsSet) Marshal() (dAtA []byte, err error) {
+func (m *Layout) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9673,48 +10291,46 @@ func (m *ObjectViewDetailsSet) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ObjectViewDetailsSet) MarshalTo(dAtA []byte) (int, error) {
+func (m *Layout) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ObjectViewDetailsSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Layout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SubIds) > 0 {
-		for iNdEx := len(m.SubIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.SubIds[iNdEx])
-			copy(dAtA[i:], m.SubIds[iNdEx])
-			i = encodeVarintModels(dAtA, i, uint64(len(m.SubIds[iNdEx])))
+	if len(m.RequiredRelations) > 0 {
+		for iNdEx := len(m.RequiredRelations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RequiredRelations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
 			i--
 			dAtA[i] = 0x1a
 		}
 	}
-	if m.Details != nil {
-		{
-			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintModels(dAtA, i, uint64(size))
-		}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Name)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Id)))
+	if m.Id != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Id))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ObjectViewRelationWithValuePerObject) Marshal() (dAtA []byte, err error) {
+func (m *RelationWithValue) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9724,41 +10340,44 @@ func (m *ObjectViewRelationWithValuePerObject) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *ObjectViewRelationWithValuePerObject) MarshalTo(dAtA []byte) (int, error) {
+func (m *RelationWithValue) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ObjectViewRelationWithValuePerObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RelationWithValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Relations) > 0 {
-		for iNdEx := len(m.Relations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Relations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModels(dAtA, i, uint64(size))
+	if m.Value != nil {
+		{
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x12
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.ObjectId) > 0 {
-		i -= len(m.ObjectId)
-		copy(dAtA[i:], m.ObjectId)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.ObjectId)))
+	if m.Relation != nil {
+		{
+			size, err := m.Relation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ObjectViewHistorySize) Marshal() (dAtA []byte, err error) {
+func (m *Relation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9768,1539 +10387,1923 @@ func (m *ObjectViewHistorySize) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ObjectViewHistorySize) MarshalTo(dAtA []byte) (int, error) {
+func (m *Relation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ObjectViewHistorySize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Relation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Redo != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Redo))
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Id)))
 		i--
-		dAtA[i] = 0x10
-	}
-	if m.Undo != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Undo))
+		dAtA[i] = 0x6
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa2
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintModels(dAtA []byte, offset int, v uint64) int {
-	offset -= sovModels(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *SmartBlockSnapshotBase) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Scope != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Scope))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
 	}
-	var l int
-	_ = l
-	if len(m.Blocks) > 0 {
-		for _, e := range m.Blocks {
-			l = e.Size()
-			n += 1 + l + sovModels(uint64(l))
+	if m.ReadOnlyRelation {
+		i--
+		if m.ReadOnlyRelation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x78
 	}
-	if m.Details != nil {
-		l = m.Details.Size()
-		n += 1 + l + sovModels(uint64(l))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x72
 	}
-	if m.FileKeys != nil {
-		l = m.FileKeys.Size()
-		n += 1 + l + sovModels(uint64(l))
+	if m.MaxCount != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.MaxCount))
+		i--
+		dAtA[i] = 0x68
 	}
-	if len(m.ExtraRelations) > 0 {
-		for _, e := range m.ExtraRelations {
-			l = e.Size()
-			n += 1 + l + sovModels(uint64(l))
+	if len(m.SelectDict) > 0 {
+		for iNdEx := len(m.SelectDict) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SelectDict[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
 		}
 	}
 	if len(m.ObjectTypes) > 0 {
-		for _, s := range m.ObjectTypes {
-			l = len(s)
-			n += 1 + l + sovModels(uint64(l))
+		for iNdEx := len(m.ObjectTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ObjectTypes[iNdEx])
+			copy(dAtA[i:], m.ObjectTypes[iNdEx])
+			i = encodeVarintModels(dAtA, i, uint64(len(m.ObjectTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
 		}
 	}
-	if m.Collections != nil {
-		l = m.Collections.Size()
-		n += 1 + l + sovModels(uint64(l))
-	}
-	if len(m.RelationLinks) > 0 {
-		for _, e := range m.RelationLinks {
-			l = e.Size()
-			n += 1 + l + sovModels(uint64(l))
+	if m.Multi {
+		i--
+		if m.Multi {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x40
 	}
-	if len(m.RemovedCollectionKeys) > 0 {
-		for _, s := range m.RemovedCollectionKeys {
-			l = len(s)
-			n += 1 + l + sovModels(uint64(l))
+	if m.ReadOnly {
+		i--
+		if m.ReadOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x38
 	}
-	return n
-}
-
-func (m *Block) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
-	}
-	if m.Fields != nil {
-		l = m.Fields.Size()
-		n += 1 + l + sovModels(uint64(l))
+	if m.Hidden {
+		i--
+		if m.Hidden {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.Restrictions != nil {
-		l = m.Restrictions.Size()
-		n += 1 + l + sovModels(uint64(l))
+	if m.DataSource != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.DataSource))
+		i--
+		dAtA[i] = 0x28
 	}
-	if len(m.ChildrenIds) > 0 {
-		for _, s := range m.ChildrenIds {
-			l = len(s)
-			n += 1 + l + sovModels(uint64(l))
+	if m.DefaultValue != nil {
+		{
+			size, err := m.DefaultValue.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.BackgroundColor)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
-	}
-	if m.Align != 0 {
-		n += 1 + sovModels(uint64(m.Align))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.VerticalAlign != 0 {
-		n += 1 + sovModels(uint64(m.VerticalAlign))
+	if m.Format != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Format))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Content != nil {
-		n += m.Content.Size()
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *BlockContentOfSmartblock) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Smartblock != nil {
-		l = m.Smartblock.Size()
-		n += 1 + l + sovModels(uint64(l))
+func (m *RelationOption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *BlockContentOfText) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Text != nil {
-		l = m.Text.Size()
-		n += 1 + l + sovModels(uint64(l))
-	}
-	return n
+
+func (m *RelationOption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *BlockContentOfFile) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *RelationOption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.File != nil {
-		l = m.File.Size()
-		n += 1 + l + sovModels(uint64(l))
-	}
-	return n
-}
-func (m *BlockContentOfLayout) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.RelationKey) > 0 {
+		i -= len(m.RelationKey)
+		copy(dAtA[i:], m.RelationKey)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.RelationKey)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	var l int
-	_ = l
-	if m.Layout != nil {
-		l = m.Layout.Size()
-		n += 2 + l + sovModels(uint64(l))
+	if len(m.Color) > 0 {
+		i -= len(m.Color)
+		copy(dAtA[i:], m.Color)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Color)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
-}
-func (m *BlockContentOfDiv) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Text) > 0 {
+		i -= len(m.Text)
+		copy(dAtA[i:], m.Text)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Text)))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	if m.Div != nil {
-		l = m.Div.Size()
-		n += 2 + l + sovModels(uint64(l))
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *BlockContentOfBookmark) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Bookmark != nil {
-		l = m.Bookmark.Size()
-		n += 2 + l + sovModels(uint64(l))
+
+func (m *RelationLink) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *BlockContentOfIcon) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Icon != nil {
-		l = m.Icon.Size()
-		n += 2 + l + sovModels(uint64(l))
-	}
-	return n
+
+func (m *RelationLink) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *BlockContentOfLink) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *RelationLink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Link != nil {
-		l = m.Link.Size()
-		n += 2 + l + sovModels(uint64(l))
-	}
-	return n
-}
-func (m *BlockContentOfDataview) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Format != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Format))
+		i--
+		dAtA[i] = 0x10
 	}
-	var l int
-	_ = l
-	if m.Dataview != nil {
-		l = m.Dataview.Size()
-		n += 2 + l + sovModels(uint64(l))
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *BlockContentOfRelation) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *RelationConstraint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RelationConstraint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelationConstraint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Relation != nil {
-		l = m.Relation.Size()
-		n += 2 + l + sovModels(uint64(l))
+	if len(m.ObjectTypes) > 0 {
+		for iNdEx := len(m.ObjectTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ObjectTypes[iNdEx])
+			copy(dAtA[i:], m.ObjectTypes[iNdEx])
+			i = encodeVarintModels(dAtA, i, uint64(len(m.ObjectTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	return n
-}
-func (m *BlockContentOfFeaturedRelations) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Pattern) > 0 {
+		i -= len(m.Pattern)
+		copy(dAtA[i:], m.Pattern)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Pattern)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	var l int
-	_ = l
-	if m.FeaturedRelations != nil {
-		l = m.FeaturedRelations.Size()
-		n += 2 + l + sovModels(uint64(l))
+	if m.Max != nil {
+		{
+			size, err := m.Max.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
-}
-func (m *BlockContentOfLatex) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Min != nil {
+		{
+			size, err := m.Min.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	var l int
-	_ = l
-	if m.Latex != nil {
-		l = m.Latex.Size()
-		n += 2 + l + sovModels(uint64(l))
+	if m.Required {
+		i--
+		if m.Required {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.RelationKey) > 0 {
+		i -= len(m.RelationKey)
+		copy(dAtA[i:], m.RelationKey)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.RelationKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
-func (m *BlockContentOfTableOfContents) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *RelationConstraintViolation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RelationConstraintViolation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelationConstraintViolation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TableOfContents != nil {
-		l = m.TableOfContents.Size()
-		n += 2 + l + sovModels(uint64(l))
-	}
-	return n
-}
-func (m *BlockContentOfTable) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x22
 	}
-	var l int
-	_ = l
-	if m.Table != nil {
-		l = m.Table.Size()
-		n += 2 + l + sovModels(uint64(l))
+	if m.Type != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
-}
-func (m *BlockContentOfTableColumn) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.RelationKey) > 0 {
+		i -= len(m.RelationKey)
+		copy(dAtA[i:], m.RelationKey)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.RelationKey)))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	if m.TableColumn != nil {
-		l = m.TableColumn.Size()
-		n += 2 + l + sovModels(uint64(l))
+	if len(m.ObjectId) > 0 {
+		i -= len(m.ObjectId)
+		copy(dAtA[i:], m.ObjectId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.ObjectId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *BlockContentOfTableRow) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TableRow != nil {
-		l = m.TableRow.Size()
-		n += 2 + l + sovModels(uint64(l))
+
+func (m *Relations) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *BlockContentOfWidget) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Widget != nil {
-		l = m.Widget.Size()
-		n += 2 + l + sovModels(uint64(l))
-	}
-	return n
+
+func (m *Relations) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *BlockRestrictions) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *Relations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Read {
-		n += 2
-	}
-	if m.Edit {
-		n += 2
-	}
-	if m.Remove {
-		n += 2
-	}
-	if m.Drag {
-		n += 2
-	}
-	if m.DropOn {
-		n += 2
+	if len(m.Relations) > 0 {
+		for iNdEx := len(m.Relations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Relations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *BlockContent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RelationOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *BlockContentLayout) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Style != 0 {
-		n += 1 + sovModels(uint64(m.Style))
-	}
-	return n
+func (m *RelationOptions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockContentLink) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RelationOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.TargetBlockId)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
-	}
-	if m.Style != 0 {
-		n += 1 + sovModels(uint64(m.Style))
-	}
-	if m.Fields != nil {
-		l = m.Fields.Size()
-		n += 1 + l + sovModels(uint64(l))
-	}
-	if m.IconSize != 0 {
-		n += 1 + sovModels(uint64(m.IconSize))
-	}
-	if m.CardStyle != 0 {
-		n += 1 + sovModels(uint64(m.CardStyle))
-	}
-	if m.Description != 0 {
-		n += 1 + sovModels(uint64(m.Description))
-	}
-	if len(m.Relations) > 0 {
-		for _, s := range m.Relations {
-			l = len(s)
-			n += 1 + l + sovModels(uint64(l))
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *BlockContentDiv) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *InternalFlag) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *InternalFlag) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InternalFlag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Style != 0 {
-		n += 1 + sovModels(uint64(m.Style))
+	if m.Value != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *BlockContentBookmark) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ObjectView) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ObjectView) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ObjectView) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Url)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
-	}
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
-	}
-	l = len(m.ImageHash)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
+	if len(m.FocusBlockId) > 0 {
+		i -= len(m.FocusBlockId)
+		copy(dAtA[i:], m.FocusBlockId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.FocusBlockId)))
+		i--
+		dAtA[i] = 0x5a
 	}
-	l = len(m.FaviconHash)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
+	if len(m.RelationLinks) > 0 {
+		for iNdEx := len(m.RelationLinks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RelationLinks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.History != nil {
+		{
+			size, err := m.History.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Restrictions != nil {
+		{
+			size, err := m.Restrictions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Relations) > 0 {
+		for iNdEx := len(m.Relations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Relations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
 	}
 	if m.Type != 0 {
-		n += 1 + sovModels(uint64(m.Type))
+		i = encodeVarintModels(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.TargetObjectId)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
+	if len(m.Details) > 0 {
+		for iNdEx := len(m.Details) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Details[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.State != 0 {
-		n += 1 + sovModels(uint64(m.State))
+	if len(m.Blocks) > 0 {
+		for iNdEx := len(m.Blocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	return n
+	if len(m.RootId) > 0 {
+		i -= len(m.RootId)
+		copy(dAtA[i:], m.RootId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.RootId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *BlockContentIcon) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
+func (m *ObjectViewDetailsSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *BlockContentFeaturedRelations) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *ObjectViewDetailsSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockContentText) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ObjectViewDetailsSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Text)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
-	}
-	if m.Style != 0 {
-		n += 1 + sovModels(uint64(m.Style))
-	}
-	if m.Marks != nil {
-		l = m.Marks.Size()
-		n += 1 + l + sovModels(uint64(l))
-	}
-	if m.Checked {
-		n += 2
-	}
-	l = len(m.Color)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
+	if len(m.SubIds) > 0 {
+		for iNdEx := len(m.SubIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SubIds[iNdEx])
+			copy(dAtA[i:], m.SubIds[iNdEx])
+			i = encodeVarintModels(dAtA, i, uint64(len(m.SubIds[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = len(m.IconEmoji)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
+	if m.Details != nil {
+		{
+			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.IconImage)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *BlockContentTextMarks) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ObjectViewRelationWithValuePerObject) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ObjectViewRelationWithValuePerObject) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ObjectViewRelationWithValuePerObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Marks) > 0 {
-		for _, e := range m.Marks {
-			l = e.Size()
-			n += 1 + l + sovModels(uint64(l))
+	if len(m.Relations) > 0 {
+		for iNdEx := len(m.Relations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Relations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	if len(m.ObjectId) > 0 {
+		i -= len(m.ObjectId)
+		copy(dAtA[i:], m.ObjectId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.ObjectId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *BlockContentTextMark) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ObjectViewHistorySize) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ObjectViewHistorySize) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ObjectViewHistorySize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Range != nil {
-		l = m.Range.Size()
-		n += 1 + l + sovModels(uint64(l))
-	}
-	if m.Type != 0 {
-		n += 1 + sovModels(uint64(m.Type))
+	if m.Redo != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Redo))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.Param)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
+	if m.Undo != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Undo))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *BlockContentFile) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
-	}
-	if m.Type != 0 {
-		n += 1 + sovModels(uint64(m.Type))
-	}
-	l = len(m.Mime)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
-	}
-	if m.Size_ != 0 {
-		n += 1 + sovModels(uint64(m.Size_))
-	}
-	if m.AddedAt != 0 {
-		n += 1 + sovModels(uint64(m.AddedAt))
-	}
-	if m.State != 0 {
-		n += 1 + sovModels(uint64(m.State))
-	}
-	if m.Style != 0 {
-		n += 1 + sovModels(uint64(m.Style))
+func (m *Comment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *BlockContentSmartblock) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *Comment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockContentDataview) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Comment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Source) > 0 {
-		for _, s := range m.Source {
-			l = len(s)
-			n += 1 + l + sovModels(uint64(l))
+	if m.IsResolved {
+		i--
+		if m.IsResolved {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x58
 	}
-	if len(m.Views) > 0 {
-		for _, e := range m.Views {
-			l = e.Size()
-			n += 1 + l + sovModels(uint64(l))
-		}
+	if m.ModifiedDate != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.ModifiedDate))
+		i--
+		dAtA[i] = 0x50
 	}
-	l = len(m.ActiveView)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
+	if m.CreatedDate != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.CreatedDate))
+		i--
+		dAtA[i] = 0x48
 	}
-	if len(m.Relations) > 0 {
-		for _, e := range m.Relations {
-			l = e.Size()
-			n += 1 + l + sovModels(uint64(l))
-		}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x42
 	}
-	if len(m.RelationLinks) > 0 {
-		for _, e := range m.RelationLinks {
-			l = e.Size()
-			n += 1 + l + sovModels(uint64(l))
+	if m.Marks != nil {
+		{
+			size, err := m.Marks.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = len(m.TargetObjectId)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
+	if len(m.Text) > 0 {
+		i -= len(m.Text)
+		copy(dAtA[i:], m.Text)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Text)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if len(m.GroupOrders) > 0 {
-		for _, e := range m.GroupOrders {
-			l = e.Size()
-			n += 1 + l + sovModels(uint64(l))
+	if m.Range != nil {
+		{
+			size, err := m.Range.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	if len(m.ObjectOrders) > 0 {
-		for _, e := range m.ObjectOrders {
-			l = e.Size()
-			n += 1 + l + sovModels(uint64(l))
-		}
+	if len(m.TargetBlockId) > 0 {
+		i -= len(m.TargetBlockId)
+		copy(dAtA[i:], m.TargetBlockId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.TargetBlockId)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.IsCollection {
-		n += 2
+	if len(m.TargetObjectId) > 0 {
+		i -= len(m.TargetObjectId)
+		copy(dAtA[i:], m.TargetObjectId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.TargetObjectId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.ThreadId) > 0 {
+		i -= len(m.ThreadId)
+		copy(dAtA[i:], m.ThreadId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.ThreadId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *BlockContentDataviewView) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Task) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Task) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Task) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
-	}
-	if m.Type != 0 {
-		n += 1 + sovModels(uint64(m.Type))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
-	}
-	if len(m.Sorts) > 0 {
-		for _, e := range m.Sorts {
-			l = e.Size()
-			n += 1 + l + sovModels(uint64(l))
+	if len(m.Dates) > 0 {
+		dAtA58 := make([]byte, len(m.Dates)*10)
+		var j57 int
+		for _, num1 := range m.Dates {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA58[j57] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j57++
+			}
+			dAtA58[j57] = uint8(num)
+			j57++
 		}
+		i -= j57
+		copy(dAtA[i:], dAtA58[:j57])
+		i = encodeVarintModels(dAtA, i, uint64(j57))
+		i--
+		dAtA[i] = 0x32
 	}
-	if len(m.Filters) > 0 {
-		for _, e := range m.Filters {
-			l = e.Size()
-			n += 1 + l + sovModels(uint64(l))
+	if len(m.Mentions) > 0 {
+		for iNdEx := len(m.Mentions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Mentions[iNdEx])
+			copy(dAtA[i:], m.Mentions[iNdEx])
+			i = encodeVarintModels(dAtA, i, uint64(len(m.Mentions[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
 		}
 	}
-	if len(m.Relations) > 0 {
-		for _, e := range m.Relations {
-			l = e.Size()
-			n += 1 + l + sovModels(uint64(l))
+	if m.Checked {
+		i--
+		if m.Checked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.CoverRelationKey)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
-	}
-	if m.HideIcon {
-		n += 2
+	if len(m.Text) > 0 {
+		i -= len(m.Text)
+		copy(dAtA[i:], m.Text)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Text)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.CardSize != 0 {
-		n += 1 + sovModels(uint64(m.CardSize))
+	if len(m.BlockId) > 0 {
+		i -= len(m.BlockId)
+		copy(dAtA[i:], m.BlockId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.BlockId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.CoverFit {
-		n += 2
+	if len(m.ObjectId) > 0 {
+		i -= len(m.ObjectId)
+		copy(dAtA[i:], m.ObjectId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.ObjectId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.GroupRelationKey)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *Tasks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.GroupBackgroundColors {
-		n += 2
-	}
-	if m.PageLimit != 0 {
-		n += 1 + sovModels(uint64(m.PageLimit))
-	}
-	l = len(m.DefaultTemplateId)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *Tasks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Tasks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tasks) > 0 {
+		for iNdEx := len(m.Tasks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *BlockContentDataviewRelation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BlockLink) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockLink) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockLink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
+	if len(m.TargetBlockId) > 0 {
+		i -= len(m.TargetBlockId)
+		copy(dAtA[i:], m.TargetBlockId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.TargetBlockId)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.IsVisible {
-		n += 2
+	if len(m.TargetObjectId) > 0 {
+		i -= len(m.TargetObjectId)
+		copy(dAtA[i:], m.TargetObjectId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.TargetObjectId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Width != 0 {
-		n += 1 + sovModels(uint64(m.Width))
+	if len(m.SourceBlockId) > 0 {
+		i -= len(m.SourceBlockId)
+		copy(dAtA[i:], m.SourceBlockId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.SourceBlockId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.DateIncludeTime {
-		n += 2
+	if len(m.SourceObjectId) > 0 {
+		i -= len(m.SourceObjectId)
+		copy(dAtA[i:], m.SourceObjectId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.SourceObjectId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.TimeFormat != 0 {
-		n += 1 + sovModels(uint64(m.TimeFormat))
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockLinks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.DateFormat != 0 {
-		n += 1 + sovModels(uint64(m.DateFormat))
+	return dAtA[:n], nil
+}
+
+func (m *BlockLinks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockLinks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Links) > 0 {
+		for iNdEx := len(m.Links) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Links[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *BlockContentDataviewSort) Size() (n int) {
+func encodeVarintModels(dAtA []byte, offset int, v uint64) int {
+	offset -= sovModels(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SmartBlockSnapshotBase) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.RelationKey)
-	if l > 0 {
+	if len(m.Blocks) > 0 {
+		for _, e := range m.Blocks {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	if m.Details != nil {
+		l = m.Details.Size()
 		n += 1 + l + sovModels(uint64(l))
 	}
-	if m.Type != 0 {
-		n += 1 + sovModels(uint64(m.Type))
+	if m.FileKeys != nil {
+		l = m.FileKeys.Size()
+		n += 1 + l + sovModels(uint64(l))
 	}
-	if len(m.CustomOrder) > 0 {
-		for _, e := range m.CustomOrder {
+	if len(m.ExtraRelations) > 0 {
+		for _, e := range m.ExtraRelations {
 			l = e.Size()
 			n += 1 + l + sovModels(uint64(l))
 		}
 	}
-	if m.Format != 0 {
-		n += 1 + sovModels(uint64(m.Format))
-	}
-	if m.IncludeTime {
-		n += 2
+	if len(m.ObjectTypes) > 0 {
+		for _, s := range m.ObjectTypes {
+			l = len(s)
+			n += 1 + l + sovModels(uint64(l))
+		}
 	}
-	l = len(m.Id)
-	if l > 0 {
+	if m.Collections != nil {
+		l = m.Collections.Size()
 		n += 1 + l + sovModels(uint64(l))
 	}
+	if len(m.RelationLinks) > 0 {
+		for _, e := range m.RelationLinks {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	if len(m.RemovedCollectionKeys) > 0 {
+		for _, s := range m.RemovedCollectionKeys {
+			l = len(s)
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *BlockContentDataviewFilter) Size() (n int) {
+func (m *Block) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Operator != 0 {
-		n += 1 + sovModels(uint64(m.Operator))
-	}
-	l = len(m.RelationKey)
+	l = len(m.Id)
 	if l > 0 {
 		n += 1 + l + sovModels(uint64(l))
 	}
-	if m.Condition != 0 {
-		n += 1 + sovModels(uint64(m.Condition))
+	if m.Fields != nil {
+		l = m.Fields.Size()
+		n += 1 + l + sovModels(uint64(l))
 	}
-	if m.Value != nil {
-		l = m.Value.Size()
+	if m.Restrictions != nil {
+		l = m.Restrictions.Size()
 		n += 1 + l + sovModels(uint64(l))
 	}
-	l = len(m.RelationProperty)
+	if len(m.ChildrenIds) > 0 {
+		for _, s := range m.ChildrenIds {
+			l = len(s)
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	l = len(m.BackgroundColor)
 	if l > 0 {
 		n += 1 + l + sovModels(uint64(l))
 	}
-	if m.QuickOption != 0 {
-		n += 1 + sovModels(uint64(m.QuickOption))
-	}
-	if m.Format != 0 {
-		n += 1 + sovModels(uint64(m.Format))
+	if m.Align != 0 {
+		n += 1 + sovModels(uint64(m.Align))
 	}
-	if m.IncludeTime {
-		n += 2
+	if m.VerticalAlign != 0 {
+		n += 1 + sovModels(uint64(m.VerticalAlign))
 	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
+	if m.Content != nil {
+		n += m.Content.Size()
 	}
 	return n
 }
 
-func (m *BlockContentDataviewGroupOrder) Size() (n int) {
+func (m *BlockContentOfSmartblock) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ViewId)
-	if l > 0 {
+	if m.Smartblock != nil {
+		l = m.Smartblock.Size()
 		n += 1 + l + sovModels(uint64(l))
 	}
-	if len(m.ViewGroups) > 0 {
-		for _, e := range m.ViewGroups {
-			l = e.Size()
-			n += 1 + l + sovModels(uint64(l))
-		}
-	}
 	return n
 }
-
-func (m *BlockContentDataviewViewGroup) Size() (n int) {
+func (m *BlockContentOfText) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.GroupId)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
-	}
-	if m.Index != 0 {
-		n += 1 + sovModels(uint64(m.Index))
-	}
-	if m.Hidden {
-		n += 2
-	}
-	l = len(m.BackgroundColor)
-	if l > 0 {
+	if m.Text != nil {
+		l = m.Text.Size()
 		n += 1 + l + sovModels(uint64(l))
 	}
 	return n
 }
-
-func (m *BlockContentDataviewObjectOrder) Size() (n int) {
+func (m *BlockContentOfFile) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ViewId)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
-	}
-	l = len(m.GroupId)
-	if l > 0 {
+	if m.File != nil {
+		l = m.File.Size()
 		n += 1 + l + sovModels(uint64(l))
 	}
-	if len(m.ObjectIds) > 0 {
-		for _, s := range m.ObjectIds {
-			l = len(s)
-			n += 1 + l + sovModels(uint64(l))
-		}
-	}
 	return n
 }
-
-func (m *BlockContentDataviewGroup) Size() (n int) {
+func (m *BlockContentOfLayout) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
+	if m.Layout != nil {
+		l = m.Layout.Size()
+		n += 2 + l + sovModels(uint64(l))
 	}
-	if m.Value != nil {
-		n += m.Value.Size()
+	return n
+}
+func (m *BlockContentOfDiv) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Div != nil {
+		l = m.Div.Size()
+		n += 2 + l + sovModels(uint64(l))
 	}
 	return n
 }
-
-func (m *BlockContentDataviewGroupValueOfStatus) Size() (n int) {
+func (m *BlockContentOfBookmark) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Status != nil {
-		l = m.Status.Size()
-		n += 1 + l + sovModels(uint64(l))
+	if m.Bookmark != nil {
+		l = m.Bookmark.Size()
+		n += 2 + l + sovModels(uint64(l))
 	}
 	return n
 }
-func (m *BlockContentDataviewGroupValueOfTag) Size() (n int) {
+func (m *BlockContentOfIcon) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Tag != nil {
-		l = m.Tag.Size()
-		n += 1 + l + sovModels(uint64(l))
+	if m.Icon != nil {
+		l = m.Icon.Size()
+		n += 2 + l + sovModels(uint64(l))
 	}
 	return n
 }
-func (m *BlockContentDataviewGroupValueOfCheckbox) Size() (n int) {
+func (m *BlockContentOfLink) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Checkbox != nil {
-		l = m.Checkbox.Size()
-		n += 1 + l + sovModels(uint64(l))
+	if m.Link != nil {
+		l = m.Link.Size()
+		n += 2 + l + sovModels(uint64(l))
 	}
 	return n
 }
-func (m *BlockContentDataviewGroupValueOfDate) Size() (n int) {
+func (m *BlockContentOfDataview) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Date != nil {
-		l = m.Date.Size()
-		n += 1 + l + sovModels(uint64(l))
+	if m.Dataview != nil {
+		l = m.Dataview.Size()
+		n += 2 + l + sovModels(uint64(l))
 	}
 	return n
 }
-func (m *BlockContentDataviewStatus) Size() (n int) {
+func (m *BlockContentOfRelation) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
+	if m.Relation != nil {
+		l = m.Relation.Size()
+		n += 2 + l + sovModels(uint64(l))
 	}
 	return n
 }
-
-func (m *BlockContentDataviewTag) Size() (n int) {
+func (m *BlockContentOfFeaturedRelations) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Ids) > 0 {
-		for _, s := range m.Ids {
-			l = len(s)
-			n += 1 + l + sovModels(uint64(l))
-		}
+	if m.FeaturedRelations != nil {
+		l = m.FeaturedRelations.Size()
+		n += 2 + l + sovModels(uint64(l))
 	}
 	return n
 }
-
-func (m *BlockContentDataviewCheckbox) Size() (n int) {
+func (m *BlockContentOfLatex) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Checked {
-		n += 2
+	if m.Latex != nil {
+		l = m.Latex.Size()
+		n += 2 + l + sovModels(uint64(l))
 	}
 	return n
 }
-
-func (m *BlockContentDataviewDate) Size() (n int) {
+func (m *BlockContentOfTableOfContents) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.TableOfContents != nil {
+		l = m.TableOfContents.Size()
+		n += 2 + l + sovModels(uint64(l))
+	}
 	return n
 }
-
-func (m *BlockContentRelation) Size() (n int) {
+func (m *BlockContentOfTable) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
+	if m.Table != nil {
+		l = m.Table.Size()
+		n += 2 + l + sovModels(uint64(l))
 	}
 	return n
 }
-
-func (m *BlockContentLatex) Size() (n int) {
+func (m *BlockContentOfTableColumn) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Text)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
+	if m.TableColumn != nil {
+		l = m.TableColumn.Size()
+		n += 2 + l + sovModels(uint64(l))
 	}
 	return n
 }
-
-func (m *BlockContentTableOfContents) Size() (n int) {
+func (m *BlockContentOfTableRow) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.TableRow != nil {
+		l = m.TableRow.Size()
+		n += 2 + l + sovModels(uint64(l))
+	}
 	return n
 }
-
-func (m *BlockContentTable) Size() (n int) {
+func (m *BlockContentOfWidget) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.Widget != nil {
+		l = m.Widget.Size()
+		n += 2 + l + sovModels(uint64(l))
+	}
 	return n
 }
-
-func (m *BlockContentTableColumn) Size() (n int) {
+func (m *BlockContentOfSynced) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.Synced != nil {
+		l = m.Synced.Size()
+		n += 2 + l + sovModels(uint64(l))
+	}
 	return n
 }
-
-func (m *BlockContentTableRow) Size() (n int) {
+func (m *BlockContentOfDiagram) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.IsHeader {
+	if m.Diagram != nil {
+		l = m.Diagram.Size()
+		n += 2 + l + sovModels(uint64(l))
+	}
+	return n
+}
+func (m *BlockRestrictions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Read {
+		n += 2
+	}
+	if m.Edit {
+		n += 2
+	}
+	if m.Remove {
+		n += 2
+	}
+	if m.Drag {
+		n += 2
+	}
+	if m.DropOn {
 		n += 2
 	}
 	return n
 }
 
-func (m *BlockContentWidget) Size() (n int) {
+func (m *BlockContent) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Layout != 0 {
-		n += 1 + sovModels(uint64(m.Layout))
-	}
-	if m.Limit != 0 {
-		n += 1 + sovModels(uint64(m.Limit))
+	return n
+}
+
+func (m *BlockContentLayout) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	l = len(m.ViewId)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
+	var l int
+	_ = l
+	if m.Style != 0 {
+		n += 1 + sovModels(uint64(m.Style))
 	}
 	return n
 }
 
-func (m *BlockMetaOnly) Size() (n int) {
+func (m *BlockContentLink) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Id)
+	l = len(m.TargetBlockId)
 	if l > 0 {
 		n += 1 + l + sovModels(uint64(l))
 	}
+	if m.Style != 0 {
+		n += 1 + sovModels(uint64(m.Style))
+	}
 	if m.Fields != nil {
 		l = m.Fields.Size()
 		n += 1 + l + sovModels(uint64(l))
 	}
+	if m.IconSize != 0 {
+		n += 1 + sovModels(uint64(m.IconSize))
+	}
+	if m.CardStyle != 0 {
+		n += 1 + sovModels(uint64(m.CardStyle))
+	}
+	if m.Description != 0 {
+		n += 1 + sovModels(uint64(m.Description))
+	}
+	if len(m.Relations) > 0 {
+		for _, s := range m.Relations {
+			l = len(s)
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *Range) Size() (n int) {
+func (m *BlockContentDiv) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.From != 0 {
-		n += 1 + sovModels(uint64(m.From))
-	}
-	if m.To != 0 {
-		n += 1 + sovModels(uint64(m.To))
+	if m.Style != 0 {
+		n += 1 + sovModels(uint64(m.Style))
 	}
 	return n
 }
 
-func (m *Account) Size() (n int) {
+func (m *BlockContentBookmark) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Id)
+	l = len(m.Url)
 	if l > 0 {
 		n += 1 + l + sovModels(uint64(l))
 	}
-	l = len(m.Name)
+	l = len(m.Title)
 	if l > 0 {
 		n += 1 + l + sovModels(uint64(l))
 	}
-	if m.Avatar != nil {
-		l = m.Avatar.Size()
+	l = len(m.Description)
+	if l > 0 {
 		n += 1 + l + sovModels(uint64(l))
 	}
-	if m.Config != nil {
-		l = m.Config.Size()
+	l = len(m.ImageHash)
+	if l > 0 {
 		n += 1 + l + sovModels(uint64(l))
 	}
-	if m.Status != nil {
-		l = m.Status.Size()
+	l = len(m.FaviconHash)
+	if l > 0 {
 		n += 1 + l + sovModels(uint64(l))
 	}
-	if m.Info != nil {
-		l = m.Info.Size()
-		n += 1 + l + sovModels(uint64(l))
+	if m.Type != 0 {
+		n += 1 + sovModels(uint64(m.Type))
 	}
-	return n
-}
-
-func (m *AccountAvatar) Size() (n int) {
-	if m == nil {
-		return 0
+	l = len(m.TargetObjectId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
 	}
-	var l int
-	_ = l
-	if m.Avatar != nil {
-		n += m.Avatar.Size()
+	if m.State != 0 {
+		n += 1 + sovModels(uint64(m.State))
 	}
 	return n
 }
 
-func (m *AccountAvatarAvatarOfImage) Size() (n int) {
+func (m *BlockContentIcon) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Image != nil {
-		l = m.Image.Size()
+	l = len(m.Name)
+	if l > 0 {
 		n += 1 + l + sovModels(uint64(l))
 	}
 	return n
 }
-func (m *AccountAvatarAvatarOfColor) Size() (n int) {
+
+func (m *BlockContentFeaturedRelations) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Color)
-	n += 1 + l + sovModels(uint64(l))
 	return n
 }
-func (m *AccountConfig) Size() (n int) {
+
+func (m *BlockContentText) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.EnableDataview {
-		n += 2
+	l = len(m.Text)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
 	}
-	if m.EnableDebug {
-		n += 2
+	if m.Style != 0 {
+		n += 1 + sovModels(uint64(m.Style))
 	}
-	if m.EnablePrereleaseChannel {
-		n += 2
+	if m.Marks != nil {
+		l = m.Marks.Size()
+		n += 1 + l + sovModels(uint64(l))
 	}
-	if m.EnableSpaces {
+	if m.Checked {
 		n += 2
 	}
-	if m.Extra != nil {
-		l = m.Extra.Size()
-		n += 2 + l + sovModels(uint64(l))
+	l = len(m.Color)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.IconEmoji)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.IconImage)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
 	}
 	return n
 }
 
-func (m *AccountStatus) Size() (n int) {
+func (m *BlockContentTextMarks) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.StatusType != 0 {
-		n += 1 + sovModels(uint64(m.StatusType))
-	}
-	if m.DeletionDate != 0 {
-		n += 1 + sovModels(uint64(m.DeletionDate))
+	if len(m.Marks) > 0 {
+		for _, e := range m.Marks {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *AccountInfo) Size() (n int) {
+func (m *BlockContentTextMark) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.HomeObjectId)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
-	}
-	l = len(m.ArchiveObjectId)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
-	}
-	l = len(m.ProfileObjectId)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
-	}
-	l = len(m.DeviceId)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
-	}
-	l = len(m.AccountSpaceId)
-	if l > 0 {
+	if m.Range != nil {
+		l = m.Range.Size()
 		n += 1 + l + sovModels(uint64(l))
 	}
-	l = len(m.WidgetsId)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
+	if m.Type != 0 {
+		n += 1 + sovModels(uint64(m.Type))
 	}
-	l = len(m.MarketplaceWorkspaceId)
+	l = len(m.Param)
 	if l > 0 {
 		n += 1 + l + sovModels(uint64(l))
 	}
-	l = len(m.GatewayUrl)
-	if l > 0 {
-		n += 2 + l + sovModels(uint64(l))
-	}
-	l = len(m.LocalStoragePath)
-	if l > 0 {
-		n += 2 + l + sovModels(uint64(l))
-	}
-	l = len(m.TimeZone)
-	if l > 0 {
-		n += 2 + l + sovModels(uint64(l))
-	}
-	l = len(m.AnalyticsId)
-	if l > 0 {
-		n += 2 + l + sovModels(uint64(l))
-	}
-	l = len(m.NetworkId)
-	if l > 0 {
-		n += 2 + l + sovModels(uint64(l))
-	}
 	return n
 }
 
-func (m *LinkPreview) Size() (n int) {
+func (m *BlockContentFile) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Url)
+	l = len(m.Hash)
 	if l > 0 {
 		n += 1 + l + sovModels(uint64(l))
 	}
-	l = len(m.Title)
+	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovModels(uint64(l))
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
+	if m.Type != 0 {
+		n += 1 + sovModels(uint64(m.Type))
 	}
-	l = len(m.ImageUrl)
+	l = len(m.Mime)
 	if l > 0 {
 		n += 1 + l + sovModels(uint64(l))
 	}
-	l = len(m.FaviconUrl)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
+	if m.Size_ != 0 {
+		n += 1 + sovModels(uint64(m.Size_))
 	}
-	if m.Type != 0 {
-		n += 1 + sovModels(uint64(m.Type))
+	if m.AddedAt != 0 {
+		n += 1 + sovModels(uint64(m.AddedAt))
+	}
+	if m.State != 0 {
+		n += 1 + sovModels(uint64(m.State))
+	}
+	if m.Style != 0 {
+		n += 1 + sovModels(uint64(m.Style))
 	}
 	return n
 }
 
-func (m *Restrictions) Size() (n int) {
+func (m *BlockContentSmartblock) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Object) > 0 {
-		l = 0
-		for _, e := range m.Object {
-			l += sovModels(uint64(e))
-		}
-		n += 1 + sovModels(uint64(l)) + l
-	}
-	if len(m.Dataview) > 0 {
-		for _, e := range m.Dataview {
-			l = e.Size()
-			n += 1 + l + sovModels(uint64(l))
-		}
-	}
 	return n
 }
 
-func (m *RestrictionsDataviewRestrictions) Size() (n int) {
+func (m *BlockContentDataview) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.BlockId)
+	if len(m.Source) > 0 {
+		for _, s := range m.Source {
+			l = len(s)
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	if len(m.Views) > 0 {
+		for _, e := range m.Views {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	l = len(m.ActiveView)
 	if l > 0 {
 		n += 1 + l + sovModels(uint64(l))
 	}
-	if len(m.Restrictions) > 0 {
-		l = 0
-		for _, e := range m.Restrictions {
-			l += sovModels(uint64(e))
+	if len(m.Relations) > 0 {
+		for _, e := range m.Relations {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
 		}
-		n += 1 + sovModels(uint64(l)) + l
 	}
-	return n
-}
-
-func (m *Object) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.RelationLinks) > 0 {
+		for _, e := range m.RelationLinks {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *ObjectChangePayload) Size() (n int) {
-	if m == nil {
-		return 0
+	l = len(m.TargetObjectId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
 	}
-	var l int
-	_ = l
-	if m.SmartBlockType != 0 {
-		n += 1 + sovModels(uint64(m.SmartBlockType))
+	if len(m.GroupOrders) > 0 {
+		for _, e := range m.GroupOrders {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	if len(m.ObjectOrders) > 0 {
+		for _, e := range m.ObjectOrders {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	if m.IsCollection {
+		n += 2
 	}
 	return n
 }
 
-func (m *ObjectType) Size() (n int) {
+func (m *BlockContentDataviewView) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Url)
+	l = len(m.Id)
 	if l > 0 {
 		n += 1 + l + sovModels(uint64(l))
 	}
+	if m.Type != 0 {
+		n += 1 + sovModels(uint64(m.Type))
+	}
 	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovModels(uint64(l))
 	}
-	if len(m.RelationLinks) > 0 {
-		for _, e := range m.RelationLinks {
+	if len(m.Sorts) > 0 {
+		for _, e := range m.Sorts {
 			l = e.Size()
 			n += 1 + l + sovModels(uint64(l))
 		}
 	}
-	if m.Layout != 0 {
-		n += 1 + sovModels(uint64(m.Layout))
+	if len(m.Filters) > 0 {
+		for _, e := range m.Filters {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
 	}
-	l = len(m.IconEmoji)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
+	if len(m.Relations) > 0 {
+		for _, e := range m.Relations {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
 	}
-	l = len(m.Description)
+	l = len(m.CoverRelationKey)
 	if l > 0 {
 		n += 1 + l + sovModels(uint64(l))
 	}
-	if m.Hidden {
+	if m.HideIcon {
 		n += 2
 	}
-	if len(m.Types) > 0 {
-		l = 0
-		for _, e := range m.Types {
-			l += sovModels(uint64(e))
-		}
-		n += 1 + sovModels(uint64(l)) + l
+	if m.CardSize != 0 {
+		n += 1 + sovModels(uint64(m.CardSize))
 	}
-	if m.IsArchived {
+	if m.CoverFit {
 		n += 2
 	}
-	if m.Readonly {
-		n += 2
+	l = len(m.GroupRelationKey)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
 	}
-	if m.InstalledByDefault {
+	if m.GroupBackgroundColors {
 		n += 2
 	}
+	if m.PageLimit != 0 {
+		n += 1 + sovModels(uint64(m.PageLimit))
+	}
+	l = len(m.DefaultTemplateId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
 	return n
 }
 
-func (m *Layout) Size() (n int) {
+func (m *BlockContentDataviewRelation) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovModels(uint64(m.Id))
-	}
-	l = len(m.Name)
+	l = len(m.Key)
 	if l > 0 {
 		n += 1 + l + sovModels(uint64(l))
 	}
-	if len(m.RequiredRelations) > 0 {
-		for _, e := range m.RequiredRelations {
-			l = e.Size()
-			n += 1 + l + sovModels(uint64(l))
-		}
+	if m.IsVisible {
+		n += 2
+	}
+	if m.Width != 0 {
+		n += 1 + sovModels(uint64(m.Width))
+	}
+	if m.DateIncludeTime {
+		n += 2
+	}
+	if m.TimeFormat != 0 {
+		n += 1 + sovModels(uint64(m.TimeFormat))
+	}
+	if m.DateFormat != 0 {
+		n += 1 + sovModels(uint64(m.DateFormat))
 	}
 	return n
 }
 
-func (m *RelationWithValue) Size() (n int) {
+func (m *BlockContentDataviewSort) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Relation != nil {
-		l = m.Relation.Size()
+	l = len(m.RelationKey)
+	if l > 0 {
 		n += 1 + l + sovModels(uint64(l))
 	}
-	if m.Value != nil {
-		l = m.Value.Size()
+	if m.Type != 0 {
+		n += 1 + sovModels(uint64(m.Type))
+	}
+	if len(m.CustomOrder) > 0 {
+		for _, e := range m.CustomOrder {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	if m.Format != 0 {
+		n += 1 + sovModels(uint64(m.Format))
+	}
+	if m.IncludeTime {
+		n += 2
+	}
+	l = len(m.Id)
+	if l > 0 {
 		n += 1 + l + sovModels(uint64(l))
 	}
 	return n
 }
 
-func (m *Relation) Size() (n int) {
+func (m *BlockContentDataviewFilter) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Key)
+	if m.Operator != 0 {
+		n += 1 + sovModels(uint64(m.Operator))
+	}
+	l = len(m.RelationKey)
 	if l > 0 {
 		n += 1 + l + sovModels(uint64(l))
 	}
-	if m.Format != 0 {
-		n += 1 + sovModels(uint64(m.Format))
+	if m.Condition != 0 {
+		n += 1 + sovModels(uint64(m.Condition))
 	}
-	l = len(m.Name)
-	if l > 0 {
+	if m.Value != nil {
+		l = m.Value.Size()
 		n += 1 + l + sovModels(uint64(l))
 	}
-	if m.DefaultValue != nil {
-		l = m.DefaultValue.Size()
+	l = len(m.RelationProperty)
+	if l > 0 {
 		n += 1 + l + sovModels(uint64(l))
 	}
-	if m.DataSource != 0 {
-		n += 1 + sovModels(uint64(m.DataSource))
+	if m.QuickOption != 0 {
+		n += 1 + sovModels(uint64(m.QuickOption))
 	}
-	if m.Hidden {
-		n += 2
+	if m.Format != 0 {
+		n += 1 + sovModels(uint64(m.Format))
 	}
-	if m.ReadOnly {
+	if m.IncludeTime {
 		n += 2
 	}
-	if m.Multi {
-		n += 2
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
 	}
-	if len(m.ObjectTypes) > 0 {
-		for _, s := range m.ObjectTypes {
-			l = len(s)
-			n += 1 + l + sovModels(uint64(l))
-		}
+	return n
+}
+
+func (m *BlockContentDataviewGroupOrder) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if len(m.SelectDict) > 0 {
-		for _, e := range m.SelectDict {
+	var l int
+	_ = l
+	l = len(m.ViewId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if len(m.ViewGroups) > 0 {
+		for _, e := range m.ViewGroups {
 			l = e.Size()
 			n += 1 + l + sovModels(uint64(l))
 		}
 	}
-	if m.MaxCount != 0 {
-		n += 1 + sovModels(uint64(m.MaxCount))
+	return n
+}
+
+func (m *BlockContentDataviewViewGroup) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	l = len(m.Description)
+	var l int
+	_ = l
+	l = len(m.GroupId)
 	if l > 0 {
 		n += 1 + l + sovModels(uint64(l))
 	}
-	if m.ReadOnlyRelation {
+	if m.Index != 0 {
+		n += 1 + sovModels(uint64(m.Index))
+	}
+	if m.Hidden {
 		n += 2
 	}
-	if m.Scope != 0 {
-		n += 2 + sovModels(uint64(m.Scope))
+	l = len(m.BackgroundColor)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
 	}
-	l = len(m.Creator)
+	return n
+}
+
+func (m *BlockContentDataviewObjectOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ViewId)
 	if l > 0 {
-		n += 2 + l + sovModels(uint64(l))
+		n += 1 + l + sovModels(uint64(l))
 	}
-	l = len(m.Id)
+	l = len(m.GroupId)
 	if l > 0 {
-		n += 2 + l + sovModels(uint64(l))
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if len(m.ObjectIds) > 0 {
+		for _, s := range m.ObjectIds {
+			l = len(s)
+			n += 1 + l + sovModels(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *RelationOption) Size() (n int) {
+func (m *BlockContentDataviewGroup) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -11310,191 +12313,2932 @@ func (m *RelationOption) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovModels(uint64(l))
 	}
-	l = len(m.Text)
-	if l > 0 {
+	if m.Value != nil {
+		n += m.Value.Size()
+	}
+	return n
+}
+
+func (m *BlockContentDataviewGroupValueOfStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != nil {
+		l = m.Status.Size()
 		n += 1 + l + sovModels(uint64(l))
 	}
-	l = len(m.Color)
-	if l > 0 {
+	return n
+}
+func (m *BlockContentDataviewGroupValueOfTag) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tag != nil {
+		l = m.Tag.Size()
 		n += 1 + l + sovModels(uint64(l))
 	}
-	l = len(m.RelationKey)
-	if l > 0 {
+	return n
+}
+func (m *BlockContentDataviewGroupValueOfCheckbox) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Checkbox != nil {
+		l = m.Checkbox.Size()
 		n += 1 + l + sovModels(uint64(l))
 	}
 	return n
 }
-
-func (m *RelationLink) Size() (n int) {
+func (m *BlockContentDataviewGroupValueOfDate) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
+	if m.Date != nil {
+		l = m.Date.Size()
 		n += 1 + l + sovModels(uint64(l))
 	}
-	if m.Format != 0 {
-		n += 1 + sovModels(uint64(m.Format))
+	return n
+}
+func (m *BlockContentDataviewStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
 	}
 	return n
 }
 
-func (m *Relations) Size() (n int) {
+func (m *BlockContentDataviewTag) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Relations) > 0 {
-		for _, e := range m.Relations {
-			l = e.Size()
+	if len(m.Ids) > 0 {
+		for _, s := range m.Ids {
+			l = len(s)
 			n += 1 + l + sovModels(uint64(l))
 		}
 	}
 	return n
 }
 
-func (m *RelationOptions) Size() (n int) {
+func (m *BlockContentDataviewCheckbox) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Options) > 0 {
-		for _, e := range m.Options {
-			l = e.Size()
-			n += 1 + l + sovModels(uint64(l))
-		}
+	if m.Checked {
+		n += 2
 	}
 	return n
 }
 
-func (m *InternalFlag) Size() (n int) {
+func (m *BlockContentDataviewDate) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Value != 0 {
-		n += 1 + sovModels(uint64(m.Value))
+	return n
+}
+
+func (m *BlockContentRelation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
 	}
 	return n
 }
 
-func (m *ObjectView) Size() (n int) {
+func (m *BlockContentLatex) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.RootId)
+	l = len(m.Text)
 	if l > 0 {
 		n += 1 + l + sovModels(uint64(l))
 	}
-	if len(m.Blocks) > 0 {
-		for _, e := range m.Blocks {
-			l = e.Size()
-			n += 1 + l + sovModels(uint64(l))
-		}
+	return n
+}
+
+func (m *BlockContentTableOfContents) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if len(m.Details) > 0 {
-		for _, e := range m.Details {
-			l = e.Size()
+	var l int
+	_ = l
+	return n
+}
+
+func (m *BlockContentTable) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *BlockContentTableColumn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *BlockContentTableRow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsHeader {
+		n += 2
+	}
+	return n
+}
+
+func (m *BlockContentWidget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Layout != 0 {
+		n += 1 + sovModels(uint64(m.Layout))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovModels(uint64(m.Limit))
+	}
+	l = len(m.ViewId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+
+func (m *BlockContentSynced) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TargetObjectId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.TargetBlockId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+
+func (m *BlockContentDiagram) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.Language != 0 {
+		n += 1 + sovModels(uint64(m.Language))
+	}
+	return n
+}
+
+func (m *BlockMetaOnly) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.Fields != nil {
+		l = m.Fields.Size()
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+
+func (m *Range) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.From != 0 {
+		n += 1 + sovModels(uint64(m.From))
+	}
+	if m.To != 0 {
+		n += 1 + sovModels(uint64(m.To))
+	}
+	return n
+}
+
+func (m *ImageEditOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StripExif {
+		n += 2
+	}
+	if m.StripLocation {
+		n += 2
+	}
+	if m.Rotate != 0 {
+		n += 1 + sovModels(uint64(m.Rotate))
+	}
+	if m.Crop != nil {
+		l = m.Crop.Size()
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.Format != 0 {
+		n += 1 + sovModels(uint64(m.Format))
+	}
+	return n
+}
+
+func (m *ImageEditOptionsRect) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.X != 0 {
+		n += 1 + sovModels(uint64(m.X))
+	}
+	if m.Y != 0 {
+		n += 1 + sovModels(uint64(m.Y))
+	}
+	if m.Width != 0 {
+		n += 1 + sovModels(uint64(m.Width))
+	}
+	if m.Height != 0 {
+		n += 1 + sovModels(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *Account) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.Avatar != nil {
+		l = m.Avatar.Size()
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.Config != nil {
+		l = m.Config.Size()
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+
+func (m *AccountAvatar) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Avatar != nil {
+		n += m.Avatar.Size()
+	}
+	return n
+}
+
+func (m *AccountAvatarAvatarOfImage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Image != nil {
+		l = m.Image.Size()
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+func (m *AccountAvatarAvatarOfColor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Color)
+	n += 1 + l + sovModels(uint64(l))
+	return n
+}
+func (m *AccountConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EnableDataview {
+		n += 2
+	}
+	if m.EnableDebug {
+		n += 2
+	}
+	if m.EnablePrereleaseChannel {
+		n += 2
+	}
+	if m.EnableSpaces {
+		n += 2
+	}
+	if m.Extra != nil {
+		l = m.Extra.Size()
+		n += 2 + l + sovModels(uint64(l))
+	}
+	return n
+}
+
+func (m *AccountStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StatusType != 0 {
+		n += 1 + sovModels(uint64(m.StatusType))
+	}
+	if m.DeletionDate != 0 {
+		n += 1 + sovModels(uint64(m.DeletionDate))
+	}
+	return n
+}
+
+func (m *AccountInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HomeObjectId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.ArchiveObjectId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.ProfileObjectId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.DeviceId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.AccountSpaceId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.WidgetsId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.MarketplaceWorkspaceId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.GatewayUrl)
+	if l > 0 {
+		n += 2 + l + sovModels(uint64(l))
+	}
+	l = len(m.LocalStoragePath)
+	if l > 0 {
+		n += 2 + l + sovModels(uint64(l))
+	}
+	l = len(m.TimeZone)
+	if l > 0 {
+		n += 2 + l + sovModels(uint64(l))
+	}
+	l = len(m.AnalyticsId)
+	if l > 0 {
+		n += 2 + l + sovModels(uint64(l))
+	}
+	l = len(m.NetworkId)
+	if l > 0 {
+		n += 2 + l + sovModels(uint64(l))
+	}
+	return n
+}
+
+func (m *LinkPreview) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.ImageUrl)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.FaviconUrl)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovModels(uint64(m.Type))
+	}
+	return n
+}
+
+func (m *Restrictions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Object) > 0 {
+		l = 0
+		for _, e := range m.Object {
+			l += sovModels(uint64(e))
+		}
+		n += 1 + sovModels(uint64(l)) + l
+	}
+	if len(m.Dataview) > 0 {
+		for _, e := range m.Dataview {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RestrictionsDataviewRestrictions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlockId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if len(m.Restrictions) > 0 {
+		l = 0
+		for _, e := range m.Restrictions {
+			l += sovModels(uint64(e))
+		}
+		n += 1 + sovModels(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *Object) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ObjectChangePayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SmartBlockType != 0 {
+		n += 1 + sovModels(uint64(m.SmartBlockType))
+	}
+	return n
+}
+
+func (m *ObjectType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if len(m.RelationLinks) > 0 {
+		for _, e := range m.RelationLinks {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	if m.Layout != 0 {
+		n += 1 + sovModels(uint64(m.Layout))
+	}
+	l = len(m.IconEmoji)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.Hidden {
+		n += 2
+	}
+	if len(m.Types) > 0 {
+		l = 0
+		for _, e := range m.Types {
+			l += sovModels(uint64(e))
+		}
+		n += 1 + sovModels(uint64(l)) + l
+	}
+	if m.IsArchived {
+		n += 2
+	}
+	if m.Readonly {
+		n += 2
+	}
+	if m.InstalledByDefault {
+		n += 2
+	}
+	return n
+}
+
+func (m *Layout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovModels(uint64(m.Id))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if len(m.RequiredRelations) > 0 {
+		for _, e := range m.RequiredRelations {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RelationWithValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Relation != nil {
+		l = m.Relation.Size()
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+
+func (m *Relation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.Format != 0 {
+		n += 1 + sovModels(uint64(m.Format))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.DefaultValue != nil {
+		l = m.DefaultValue.Size()
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.DataSource != 0 {
+		n += 1 + sovModels(uint64(m.DataSource))
+	}
+	if m.Hidden {
+		n += 2
+	}
+	if m.ReadOnly {
+		n += 2
+	}
+	if m.Multi {
+		n += 2
+	}
+	if len(m.ObjectTypes) > 0 {
+		for _, s := range m.ObjectTypes {
+			l = len(s)
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	if len(m.SelectDict) > 0 {
+		for _, e := range m.SelectDict {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	if m.MaxCount != 0 {
+		n += 1 + sovModels(uint64(m.MaxCount))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.ReadOnlyRelation {
+		n += 2
+	}
+	if m.Scope != 0 {
+		n += 2 + sovModels(uint64(m.Scope))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 2 + l + sovModels(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 2 + l + sovModels(uint64(l))
+	}
+	return n
+}
+
+func (m *RelationOption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.Text)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.Color)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.RelationKey)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+
+func (m *RelationLink) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.Format != 0 {
+		n += 1 + sovModels(uint64(m.Format))
+	}
+	return n
+}
+
+func (m *RelationConstraint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RelationKey)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.Required {
+		n += 2
+	}
+	if m.Min != nil {
+		l = m.Min.Size()
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.Max != nil {
+		l = m.Max.Size()
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.Pattern)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if len(m.ObjectTypes) > 0 {
+		for _, s := range m.ObjectTypes {
+			l = len(s)
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RelationConstraintViolation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ObjectId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.RelationKey)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovModels(uint64(m.Type))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+
+func (m *Relations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Relations) > 0 {
+		for _, e := range m.Relations {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RelationOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InternalFlag) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovModels(uint64(m.Value))
+	}
+	return n
+}
+
+func (m *ObjectView) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RootId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if len(m.Blocks) > 0 {
+		for _, e := range m.Blocks {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	if len(m.Details) > 0 {
+		for _, e := range m.Details {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	if m.Type != 0 {
+		n += 1 + sovModels(uint64(m.Type))
+	}
+	if len(m.Relations) > 0 {
+		for _, e := range m.Relations {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	if m.Restrictions != nil {
+		l = m.Restrictions.Size()
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.History != nil {
+		l = m.History.Size()
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if len(m.RelationLinks) > 0 {
+		for _, e := range m.RelationLinks {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	l = len(m.FocusBlockId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+
+func (m *ObjectViewDetailsSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.Details != nil {
+		l = m.Details.Size()
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if len(m.SubIds) > 0 {
+		for _, s := range m.SubIds {
+			l = len(s)
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ObjectViewRelationWithValuePerObject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ObjectId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if len(m.Relations) > 0 {
+		for _, e := range m.Relations {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ObjectViewHistorySize) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Undo != 0 {
+		n += 1 + sovModels(uint64(m.Undo))
+	}
+	if m.Redo != 0 {
+		n += 1 + sovModels(uint64(m.Redo))
+	}
+	return n
+}
+
+func (m *Comment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.ThreadId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.TargetObjectId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.TargetBlockId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.Range != nil {
+		l = m.Range.Size()
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.Text)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.Marks != nil {
+		l = m.Marks.Size()
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.CreatedDate != 0 {
+		n += 1 + sovModels(uint64(m.CreatedDate))
+	}
+	if m.ModifiedDate != 0 {
+		n += 1 + sovModels(uint64(m.ModifiedDate))
+	}
+	if m.IsResolved {
+		n += 2
+	}
+	return n
+}
+
+func (m *Task) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ObjectId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.BlockId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.Text)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.Checked {
+		n += 2
+	}
+	if len(m.Mentions) > 0 {
+		for _, s := range m.Mentions {
+			l = len(s)
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	if len(m.Dates) > 0 {
+		l = 0
+		for _, e := range m.Dates {
+			l += sovModels(uint64(e))
+		}
+		n += 1 + sovModels(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *Tasks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tasks) > 0 {
+		for _, e := range m.Tasks {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BlockLink) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceObjectId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.SourceBlockId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.TargetObjectId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.TargetBlockId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+
+func (m *BlockLinks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Links) > 0 {
+		for _, e := range m.Links {
+			l = e.Size()
 			n += 1 + l + sovModels(uint64(l))
 		}
 	}
-	if m.Type != 0 {
-		n += 1 + sovModels(uint64(m.Type))
+	return n
+}
+
+func sovModels(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozModels(x uint64) (n int) {
+	return sovModels(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SmartBlockSnapshotBase) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SmartBlockSnapshotBase: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SmartBlockSnapshotBase: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blocks = append(m.Blocks, &Block{})
+			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Details == nil {
+				m.Details = &types.Struct{}
+			}
+			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FileKeys == nil {
+				m.FileKeys = &types.Struct{}
+			}
+			if err := m.FileKeys.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraRelations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtraRelations = append(m.ExtraRelations, &Relation{})
+			if err := m.ExtraRelations[len(m.ExtraRelations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectTypes = append(m.ObjectTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collections", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Collections == nil {
+				m.Collections = &types.Struct{}
+			}
+			if err := m.Collections.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelationLinks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelationLinks = append(m.RelationLinks, &RelationLink{})
+			if err := m.RelationLinks[len(m.RelationLinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedCollectionKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemovedCollectionKeys = append(m.RemovedCollectionKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Block) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Block: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Block: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fields == nil {
+				m.Fields = &types.Struct{}
+			}
+			if err := m.Fields.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restrictions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Restrictions == nil {
+				m.Restrictions = &BlockRestrictions{}
+			}
+			if err := m.Restrictions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildrenIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChildrenIds = append(m.ChildrenIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackgroundColor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BackgroundColor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Align", wireType)
+			}
+			m.Align = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Align |= BlockAlign(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerticalAlign", wireType)
+			}
+			m.VerticalAlign = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VerticalAlign |= BlockVerticalAlign(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Smartblock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &BlockContentSmartblock{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Content = &BlockContentOfSmartblock{v}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &BlockContentText{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Content = &BlockContentOfText{v}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &BlockContentFile{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Content = &BlockContentOfFile{v}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Layout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &BlockContentLayout{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Content = &BlockContentOfLayout{v}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Div", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &BlockContentDiv{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Content = &BlockContentOfDiv{v}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bookmark", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &BlockContentBookmark{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Content = &BlockContentOfBookmark{v}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Icon", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &BlockContentIcon{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Content = &BlockContentOfIcon{v}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Link", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &BlockContentLink{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Content = &BlockContentOfLink{v}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dataview", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &BlockContentDataview{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Content = &BlockContentOfDataview{v}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &BlockContentRelation{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Content = &BlockContentOfRelation{v}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeaturedRelations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &BlockContentFeaturedRelations{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Content = &BlockContentOfFeaturedRelations{v}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Latex", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &BlockContentLatex{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Content = &BlockContentOfLatex{v}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableOfContents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &BlockContentTableOfContents{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Content = &BlockContentOfTableOfContents{v}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &BlockContentTable{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Content = &BlockContentOfTable{v}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableColumn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &BlockContentTableColumn{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Content = &BlockContentOfTableColumn{v}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableRow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &BlockContentTableRow{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Content = &BlockContentOfTableRow{v}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Widget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &BlockContentWidget{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Content = &BlockContentOfWidget{v}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Synced", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &BlockContentSynced{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Content = &BlockContentOfSynced{v}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Diagram", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &BlockContentDiagram{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Content = &BlockContentOfDiagram{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockRestrictions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Restrictions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Restrictions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Read", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Read = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Edit", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Edit = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Remove = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Drag", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Drag = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DropOn", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DropOn = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockContent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Content: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Content: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockContentLayout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Layout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Layout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Style", wireType)
+			}
+			m.Style = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Style |= BlockContentLayoutStyle(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockContentLink) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Link: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Link: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetBlockId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Style", wireType)
+			}
+			m.Style = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Style |= BlockContentLinkStyle(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fields == nil {
+				m.Fields = &types.Struct{}
+			}
+			if err := m.Fields.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IconSize", wireType)
+			}
+			m.IconSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IconSize |= BlockContentLinkIconSize(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CardStyle", wireType)
+			}
+			m.CardStyle = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CardStyle |= BlockContentLinkCardStyle(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			m.Description = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Description |= BlockContentLinkDescription(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relations", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relations = append(m.Relations, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	if len(m.Relations) > 0 {
-		for _, e := range m.Relations {
-			l = e.Size()
-			n += 1 + l + sovModels(uint64(l))
+	return nil
+}
+func (m *BlockContentDiv) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-	}
-	if m.Restrictions != nil {
-		l = m.Restrictions.Size()
-		n += 1 + l + sovModels(uint64(l))
-	}
-	if m.History != nil {
-		l = m.History.Size()
-		n += 1 + l + sovModels(uint64(l))
-	}
-	if len(m.RelationLinks) > 0 {
-		for _, e := range m.RelationLinks {
-			l = e.Size()
-			n += 1 + l + sovModels(uint64(l))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Div: wiretype end group for non-group")
 		}
-	}
-	return n
-}
-
-func (m *ObjectViewDetailsSet) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
-	}
-	if m.Details != nil {
-		l = m.Details.Size()
-		n += 1 + l + sovModels(uint64(l))
-	}
-	if len(m.SubIds) > 0 {
-		for _, s := range m.SubIds {
-			l = len(s)
-			n += 1 + l + sovModels(uint64(l))
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Div: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
-	}
-	return n
-}
-
-func (m *ObjectViewRelationWithValuePerObject) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ObjectId)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
-	}
-	if len(m.Relations) > 0 {
-		for _, e := range m.Relations {
-			l = e.Size()
-			n += 1 + l + sovModels(uint64(l))
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Style", wireType)
+			}
+			m.Style = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Style |= BlockContentDivStyle(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *ObjectViewHistorySize) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Undo != 0 {
-		n += 1 + sovModels(uint64(m.Undo))
-	}
-	if m.Redo != 0 {
-		n += 1 + sovModels(uint64(m.Redo))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovModels(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozModels(x uint64) (n int) {
-	return sovModels(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *SmartBlockSnapshotBase) Unmarshal(dAtA []byte) error {
+func (m *BlockContentBookmark) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11517,17 +15261,17 @@ func (m *SmartBlockSnapshotBase) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SmartBlockSnapshotBase: wiretype end group for non-group")
+			return fmt.Errorf("proto: Bookmark: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SmartBlockSnapshotBase: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Bookmark: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -11537,31 +15281,29 @@ func (m *SmartBlockSnapshotBase) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Blocks = append(m.Blocks, &Block{})
-			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Url = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -11571,33 +15313,29 @@ func (m *SmartBlockSnapshotBase) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Details == nil {
-				m.Details = &types.Struct{}
-			}
-			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -11607,33 +15345,29 @@ func (m *SmartBlockSnapshotBase) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FileKeys == nil {
-				m.FileKeys = &types.Struct{}
-			}
-			if err := m.FileKeys.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtraRelations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageHash", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -11643,29 +15377,27 @@ func (m *SmartBlockSnapshotBase) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExtraRelations = append(m.ExtraRelations, &Relation{})
-			if err := m.ExtraRelations[len(m.ExtraRelations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ImageHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectTypes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FaviconHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11693,13 +15425,32 @@ func (m *SmartBlockSnapshotBase) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectTypes = append(m.ObjectTypes, string(dAtA[iNdEx:postIndex]))
+			m.FaviconHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= LinkPreviewType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Collections", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetObjectId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -11709,33 +15460,29 @@ func (m *SmartBlockSnapshotBase) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Collections == nil {
-				m.Collections = &types.Struct{}
-			}
-			if err := m.Collections.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TargetObjectId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelationLinks", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var msglen int
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -11745,29 +15492,64 @@ func (m *SmartBlockSnapshotBase) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.State |= BlockContentBookmarkState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthModels
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthModels
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RelationLinks = append(m.RelationLinks, &RelationLink{})
-			if err := m.RelationLinks[len(m.RelationLinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockContentIcon) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
 			}
-			iNdEx = postIndex
-		case 8:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Icon: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Icon: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemovedCollectionKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11795,7 +15577,7 @@ func (m *SmartBlockSnapshotBase) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemovedCollectionKeys = append(m.RemovedCollectionKeys, string(dAtA[iNdEx:postIndex]))
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11818,7 +15600,7 @@ func (m *SmartBlockSnapshotBase) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Block) Unmarshal(dAtA []byte) error {
+func (m *BlockContentFeaturedRelations) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11841,15 +15623,65 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Block: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeaturedRelations: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Block: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeaturedRelations: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockContentText) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Text: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Text: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11877,13 +15709,13 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Text = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Style", wireType)
 			}
-			var msglen int
+			m.Style = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -11893,31 +15725,14 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Style |= BlockContentTextStyle(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Fields == nil {
-				m.Fields = &types.Struct{}
-			}
-			if err := m.Fields.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restrictions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Marks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11944,16 +15759,36 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Restrictions == nil {
-				m.Restrictions = &BlockRestrictions{}
+			if m.Marks == nil {
+				m.Marks = &BlockContentTextMarks{}
 			}
-			if err := m.Restrictions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Marks.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Checked = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChildrenIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Color", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11981,11 +15816,11 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChildrenIds = append(m.ChildrenIds, string(dAtA[iNdEx:postIndex]))
+			m.Color = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BackgroundColor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IconEmoji", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12013,13 +15848,13 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BackgroundColor = string(dAtA[iNdEx:postIndex])
+			m.IconEmoji = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Align", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IconImage", wireType)
 			}
-			m.Align = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -12029,33 +15864,77 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Align |= BlockAlign(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerticalAlign", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IconImage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockContentTextMarks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.VerticalAlign = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.VerticalAlign |= BlockVerticalAlign(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 11:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Marks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Marks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Smartblock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Marks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12082,15 +15961,64 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &BlockContentSmartblock{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Marks = append(m.Marks, &BlockContentTextMark{})
+			if err := m.Marks[len(m.Marks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Content = &BlockContentOfSmartblock{v}
 			iNdEx = postIndex
-		case 14:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockContentTextMark) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Mark: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Mark: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Range", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12117,17 +16045,18 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &BlockContentText{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Range == nil {
+				m.Range = &Range{}
+			}
+			if err := m.Range.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Content = &BlockContentOfText{v}
 			iNdEx = postIndex
-		case 15:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -12137,32 +16066,16 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Type |= BlockContentTextMarkType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &BlockContentFile{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Content = &BlockContentOfFile{v}
-			iNdEx = postIndex
-		case 16:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Layout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Param", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -12172,32 +16085,79 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &BlockContentLayout{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Param = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			m.Content = &BlockContentOfLayout{v}
-			iNdEx = postIndex
-		case 17:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockContentFile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: File: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: File: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Div", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -12207,32 +16167,29 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &BlockContentDiv{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Content = &BlockContentOfDiv{v}
+			m.Hash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 18:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bookmark", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -12242,32 +16199,48 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &BlockContentBookmark{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Content = &BlockContentOfBookmark{v}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 19:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= BlockContentFileType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Icon", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mime", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -12277,32 +16250,29 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &BlockContentIcon{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Content = &BlockContentOfIcon{v}
+			m.Mime = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 20:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Link", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
 			}
-			var msglen int
+			m.Size_ = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -12312,32 +16282,16 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Size_ |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &BlockContentLink{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Content = &BlockContentOfLink{v}
-			iNdEx = postIndex
-		case 21:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dataview", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedAt", wireType)
 			}
-			var msglen int
+			m.AddedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -12347,32 +16301,16 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.AddedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &BlockContentDataview{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Content = &BlockContentOfDataview{v}
-			iNdEx = postIndex
-		case 22:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relation", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var msglen int
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -12382,32 +16320,16 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.State |= BlockContentFileState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &BlockContentRelation{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Content = &BlockContentOfRelation{v}
-			iNdEx = postIndex
-		case 23:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeaturedRelations", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Style", wireType)
 			}
-			var msglen int
+			m.Style = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -12417,67 +16339,116 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Style |= BlockContentFileStyle(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthModels
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthModels
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &BlockContentFeaturedRelations{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockContentSmartblock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
 			}
-			m.Content = &BlockContentOfFeaturedRelations{v}
-			iNdEx = postIndex
-		case 24:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Latex", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthModels
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Smartblock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Smartblock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthModels
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &BlockContentLatex{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockContentDataview) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
 			}
-			m.Content = &BlockContentOfLatex{v}
-			iNdEx = postIndex
-		case 25:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Dataview: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Dataview: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableOfContents", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -12487,30 +16458,27 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &BlockContentTableOfContents{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Content = &BlockContentOfTableOfContents{v}
+			m.Source = append(m.Source, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 26:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Views", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12537,17 +16505,16 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &BlockContentTable{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Views = append(m.Views, &BlockContentDataviewView{})
+			if err := m.Views[len(m.Views)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Content = &BlockContentOfTable{v}
 			iNdEx = postIndex
-		case 27:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableColumn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveView", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -12557,30 +16524,27 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &BlockContentTableColumn{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Content = &BlockContentOfTableColumn{v}
+			m.ActiveView = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 28:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableRow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Relations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12607,15 +16571,14 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &BlockContentTableRow{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Relations = append(m.Relations, &Relation{})
+			if err := m.Relations[len(m.Relations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Content = &BlockContentOfTableRow{v}
 			iNdEx = postIndex
-		case 29:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Widget", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelationLinks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12642,67 +16605,16 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &BlockContentWidget{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RelationLinks = append(m.RelationLinks, &RelationLink{})
+			if err := m.RelationLinks[len(m.RelationLinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Content = &BlockContentOfWidget{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipModels(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthModels
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BlockRestrictions) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowModels
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Restrictions: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Restrictions: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Read", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetObjectId", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -12712,37 +16624,29 @@ func (m *BlockRestrictions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Read = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Edit", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
 			}
-			m.Edit = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
+			m.TargetObjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupOrders", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -12752,37 +16656,31 @@ func (m *BlockRestrictions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Remove = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Drag", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthModels
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
 			}
-			m.Drag = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DropOn", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
+			m.GroupOrders = append(m.GroupOrders, &BlockContentDataviewGroupOrder{})
+			if err := m.GroupOrders[len(m.GroupOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectOrders", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -12792,117 +16690,31 @@ func (m *BlockRestrictions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DropOn = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipModels(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthModels
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BlockContent) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowModels
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Content: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Content: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipModels(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthModels
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BlockContentLayout) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowModels
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ObjectOrders = append(m.ObjectOrders, &BlockContentDataviewObjectOrder{})
+			if err := m.ObjectOrders[len(m.ObjectOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Layout: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Layout: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 14:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Style", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsCollection", wireType)
 			}
-			m.Style = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -12912,11 +16724,12 @@ func (m *BlockContentLayout) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Style |= BlockContentLayoutStyle(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.IsCollection = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -12938,7 +16751,7 @@ func (m *BlockContentLayout) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockContentLink) Unmarshal(dAtA []byte) error {
+func (m *BlockContentDataviewView) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12961,15 +16774,15 @@ func (m *BlockContentLink) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Link: wiretype end group for non-group")
+			return fmt.Errorf("proto: View: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Link: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: View: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12997,13 +16810,13 @@ func (m *BlockContentLink) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetBlockId = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Style", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.Style = 0
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -13013,16 +16826,16 @@ func (m *BlockContentLink) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Style |= BlockContentLinkStyle(b&0x7F) << shift
+				m.Type |= BlockContentDataviewViewType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -13032,90 +16845,29 @@ func (m *BlockContentLink) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Fields == nil {
-				m.Fields = &types.Struct{}
-			}
-			if err := m.Fields.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IconSize", wireType)
-			}
-			m.IconSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.IconSize |= BlockContentLinkIconSize(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CardStyle", wireType)
-			}
-			m.CardStyle = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CardStyle |= BlockContentLinkCardStyle(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			m.Description = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Description |= BlockContentLinkDescription(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sorts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -13125,79 +16877,31 @@ func (m *BlockContentLink) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Relations = append(m.Relations, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipModels(dAtA[iNdEx:])
-			if err != nil {
+			m.Sorts = append(m.Sorts, &BlockContentDataviewSort{})
+			if err := m.Sorts[len(m.Sorts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthModels
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BlockContentDiv) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowModels
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Div: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Div: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Style", wireType)
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
 			}
-			m.Style = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -13207,66 +16911,31 @@ func (m *BlockContentDiv) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Style |= BlockContentDivStyle(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipModels(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthModels
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BlockContentBookmark) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowModels
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Filters = append(m.Filters, &BlockContentDataviewFilter{})
+			if err := m.Filters[len(m.Filters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Bookmark: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Bookmark: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Relations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -13276,27 +16945,29 @@ func (m *BlockContentBookmark) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Url = string(dAtA[iNdEx:postIndex])
+			m.Relations = append(m.Relations, &BlockContentDataviewRelation{})
+			if err := m.Relations[len(m.Relations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CoverRelationKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13324,13 +16995,13 @@ func (m *BlockContentBookmark) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.CoverRelationKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HideIcon", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -13340,27 +17011,54 @@ func (m *BlockContentBookmark) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModels
+			m.HideIcon = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CardSize", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
+			m.CardSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CardSize |= BlockContentDataviewViewSize(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoverFit", wireType)
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CoverFit = bool(v != 0)
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImageHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupRelationKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13388,13 +17086,13 @@ func (m *BlockContentBookmark) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ImageHash = string(dAtA[iNdEx:postIndex])
+			m.GroupRelationKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FaviconHash", wireType)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupBackgroundColors", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -13404,29 +17102,17 @@ func (m *BlockContentBookmark) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FaviconHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			m.GroupBackgroundColors = bool(v != 0)
+		case 13:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PageLimit", wireType)
 			}
-			m.Type = 0
+			m.PageLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -13436,14 +17122,14 @@ func (m *BlockContentBookmark) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= LinkPreviewType(b&0x7F) << shift
+				m.PageLimit |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetObjectId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultTemplateId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13471,27 +17157,8 @@ func (m *BlockContentBookmark) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetObjectId = string(dAtA[iNdEx:postIndex])
+			m.DefaultTemplateId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
-			}
-			m.State = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.State |= BlockContentBookmarkState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -13513,7 +17180,7 @@ func (m *BlockContentBookmark) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockContentIcon) Unmarshal(dAtA []byte) error {
+func (m *BlockContentDataviewRelation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13536,17 +17203,127 @@ func (m *BlockContentIcon) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Icon: wiretype end group for non-group")
+			return fmt.Errorf("proto: Relation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Icon: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Relation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsVisible", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsVisible = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Width", wireType)
+			}
+			m.Width = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Width |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DateIncludeTime", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DateIncludeTime = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeFormat", wireType)
+			}
+			m.TimeFormat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeFormat |= BlockContentDataviewRelationTimeFormat(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DateFormat", wireType)
 			}
-			var stringLen uint64
+			m.DateFormat = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -13556,74 +17333,11 @@ func (m *BlockContentIcon) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DateFormat |= BlockContentDataviewRelationDateFormat(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipModels(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthModels
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BlockContentFeaturedRelations) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowModels
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FeaturedRelations: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FeaturedRelations: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -13645,7 +17359,7 @@ func (m *BlockContentFeaturedRelations) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockContentText) Unmarshal(dAtA []byte) error {
+func (m *BlockContentDataviewSort) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13668,15 +17382,15 @@ func (m *BlockContentText) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Text: wiretype end group for non-group")
+			return fmt.Errorf("proto: Sort: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Text: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Sort: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelationKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13704,13 +17418,13 @@ func (m *BlockContentText) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Text = string(dAtA[iNdEx:postIndex])
+			m.RelationKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Style", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.Style = 0
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -13720,14 +17434,14 @@ func (m *BlockContentText) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Style |= BlockContentTextStyle(b&0x7F) << shift
+				m.Type |= BlockContentDataviewSortType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Marks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomOrder", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13754,18 +17468,16 @@ func (m *BlockContentText) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Marks == nil {
-				m.Marks = &BlockContentTextMarks{}
-			}
-			if err := m.Marks.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CustomOrder = append(m.CustomOrder, &types.Value{})
+			if err := m.CustomOrder[len(m.CustomOrder)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Checked", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
 			}
-			var v int
+			m.Format = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -13775,17 +17487,16 @@ func (m *BlockContentText) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Format |= RelationFormat(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Checked = bool(v != 0)
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Color", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeTime", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -13795,59 +17506,15 @@ func (m *BlockContentText) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Color = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.IncludeTime = bool(v != 0)
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IconEmoji", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.IconEmoji = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IconImage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13875,7 +17542,7 @@ func (m *BlockContentText) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IconImage = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13898,7 +17565,7 @@ func (m *BlockContentText) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockContentTextMarks) Unmarshal(dAtA []byte) error {
+func (m *BlockContentDataviewFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13921,17 +17588,36 @@ func (m *BlockContentTextMarks) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Marks: wiretype end group for non-group")
+			return fmt.Errorf("proto: Filter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Marks: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Filter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			m.Operator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Operator |= BlockContentDataviewFilterOperator(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Marks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelationKey", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -13941,79 +17627,46 @@ func (m *BlockContentTextMarks) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Marks = append(m.Marks, &BlockContentTextMark{})
-			if err := m.Marks[len(m.Marks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RelationKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipModels(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthModels
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BlockContentTextMark) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowModels
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Condition", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Mark: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Mark: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Condition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Condition |= BlockContentDataviewFilterCondition(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Range", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14040,18 +17693,50 @@ func (m *BlockContentTextMark) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Range == nil {
-				m.Range = &Range{}
+			if m.Value == nil {
+				m.Value = &types.Value{}
 			}
-			if err := m.Range.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelationProperty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelationProperty = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuickOption", wireType)
 			}
-			m.Type = 0
+			m.QuickOption = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -14061,14 +17746,53 @@ func (m *BlockContentTextMark) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= BlockContentTextMarkType(b&0x7F) << shift
+				m.QuickOption |= BlockContentDataviewFilterQuickOption(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			m.Format = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Format |= RelationFormat(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeTime", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeTime = bool(v != 0)
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Param", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14096,7 +17820,7 @@ func (m *BlockContentTextMark) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Param = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14119,7 +17843,7 @@ func (m *BlockContentTextMark) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockContentFile) Unmarshal(dAtA []byte) error {
+func (m *BlockContentDataviewGroupOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14142,15 +17866,15 @@ func (m *BlockContentFile) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: File: wiretype end group for non-group")
+			return fmt.Errorf("proto: GroupOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: File: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GroupOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ViewId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14178,13 +17902,13 @@ func (m *BlockContentFile) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.ViewId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ViewGroups", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -14194,46 +17918,79 @@ func (m *BlockContentFile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ViewGroups = append(m.ViewGroups, &BlockContentDataviewViewGroup{})
+			if err := m.ViewGroups[len(m.ViewGroups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= BlockContentFileType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
 			}
-		case 4:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockContentDataviewViewGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ViewGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ViewGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14261,32 +18018,13 @@ func (m *BlockContentFile) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Mime = string(dAtA[iNdEx:postIndex])
+			m.GroupId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
-			}
-			m.Size_ = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Size_ |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			m.AddedAt = 0
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -14296,16 +18034,16 @@ func (m *BlockContentFile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AddedAt |= int64(b&0x7F) << shift
+				m.Index |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hidden", wireType)
 			}
-			m.State = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -14315,16 +18053,17 @@ func (m *BlockContentFile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= BlockContentFileState(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Style", wireType)
+			m.Hidden = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackgroundColor", wireType)
 			}
-			m.Style = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -14334,61 +18073,24 @@ func (m *BlockContentFile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Style |= BlockContentFileStyle(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipModels(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthModels
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BlockContentSmartblock) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowModels
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Smartblock: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Smartblock: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.BackgroundColor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -14410,7 +18112,7 @@ func (m *BlockContentSmartblock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockContentDataview) Unmarshal(dAtA []byte) error {
+func (m *BlockContentDataviewObjectOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14433,15 +18135,15 @@ func (m *BlockContentDataview) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Dataview: wiretype end group for non-group")
+			return fmt.Errorf("proto: ObjectOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Dataview: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ObjectOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ViewId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14469,13 +18171,13 @@ func (m *BlockContentDataview) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = append(m.Source, string(dAtA[iNdEx:postIndex]))
+			m.ViewId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Views", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -14485,29 +18187,27 @@ func (m *BlockContentDataview) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Views = append(m.Views, &BlockContentDataviewView{})
-			if err := m.Views[len(m.Views)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.GroupId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveView", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14535,13 +18235,63 @@ func (m *BlockContentDataview) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActiveView = string(dAtA[iNdEx:postIndex])
+			m.ObjectIds = append(m.ObjectIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockContentDataviewGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Group: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Group: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -14551,29 +18301,27 @@ func (m *BlockContentDataview) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Relations = append(m.Relations, &Relation{})
-			if err := m.Relations[len(m.Relations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelationLinks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14600,16 +18348,17 @@ func (m *BlockContentDataview) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RelationLinks = append(m.RelationLinks, &RelationLink{})
-			if err := m.RelationLinks[len(m.RelationLinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &BlockContentDataviewStatus{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Value = &BlockContentDataviewGroupValueOfStatus{v}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetObjectId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -14619,27 +18368,30 @@ func (m *BlockContentDataview) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetObjectId = string(dAtA[iNdEx:postIndex])
+			v := &BlockContentDataviewTag{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &BlockContentDataviewGroupValueOfTag{v}
 			iNdEx = postIndex
-		case 12:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupOrders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkbox", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14666,14 +18418,15 @@ func (m *BlockContentDataview) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupOrders = append(m.GroupOrders, &BlockContentDataviewGroupOrder{})
-			if err := m.GroupOrders[len(m.GroupOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &BlockContentDataviewCheckbox{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Value = &BlockContentDataviewGroupValueOfCheckbox{v}
 			iNdEx = postIndex
-		case 13:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectOrders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14700,16 +18453,67 @@ func (m *BlockContentDataview) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectOrders = append(m.ObjectOrders, &BlockContentDataviewObjectOrder{})
-			if err := m.ObjectOrders[len(m.ObjectOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &BlockContentDataviewDate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Value = &BlockContentDataviewGroupValueOfDate{v}
 			iNdEx = postIndex
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsCollection", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockContentDataviewStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Status: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Status: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -14719,12 +18523,24 @@ func (m *BlockContentDataview) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsCollection = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -14746,7 +18562,7 @@ func (m *BlockContentDataview) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockContentDataviewView) Unmarshal(dAtA []byte) error {
+func (m *BlockContentDataviewTag) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14769,15 +18585,15 @@ func (m *BlockContentDataviewView) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: View: wiretype end group for non-group")
+			return fmt.Errorf("proto: Tag: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: View: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Tag: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14805,98 +18621,63 @@ func (m *BlockContentDataviewView) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Ids = append(m.Ids, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= BlockContentDataviewViewType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModels
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthModels
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sorts", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockContentDataviewCheckbox) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sorts = append(m.Sorts, &BlockContentDataviewSort{})
-			if err := m.Sorts[len(m.Sorts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Checkbox: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Checkbox: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checked", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -14906,63 +18687,115 @@ func (m *BlockContentDataviewView) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthModels
+			m.Checked = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthModels
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Filters = append(m.Filters, &BlockContentDataviewFilter{})
-			if err := m.Filters[len(m.Filters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockContentDataviewDate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
 			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relations", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthModels
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Date: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Date: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthModels
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Relations = append(m.Relations, &BlockContentDataviewRelation{})
-			if err := m.Relations[len(m.Relations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockContentRelation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 7:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Relation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Relation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CoverRelationKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14990,70 +18823,61 @@ func (m *BlockContentDataviewView) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CoverRelationKey = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HideIcon", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
 			}
-			m.HideIcon = bool(v != 0)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CardSize", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.CardSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CardSize |= BlockContentDataviewViewSize(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockContentLatex) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CoverFit", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.CoverFit = bool(v != 0)
-		case 11:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Latex: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Latex: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupRelationKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15081,79 +18905,108 @@ func (m *BlockContentDataviewView) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupRelationKey = string(dAtA[iNdEx:postIndex])
+			m.Text = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupBackgroundColors", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
 			}
-			m.GroupBackgroundColors = bool(v != 0)
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PageLimit", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.PageLimit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PageLimit |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockContentTableOfContents) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
 			}
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultTemplateId", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModels
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TableOfContents: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TableOfContents: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthModels
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockContentTable) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DefaultTemplateId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Table: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Table: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -15175,7 +19028,7 @@ func (m *BlockContentDataviewView) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockContentDataviewRelation) Unmarshal(dAtA []byte) error {
+func (m *BlockContentTableColumn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15198,141 +19051,12 @@ func (m *BlockContentDataviewRelation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Relation: wiretype end group for non-group")
+			return fmt.Errorf("proto: TableColumn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Relation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TableColumn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsVisible", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsVisible = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Width", wireType)
-			}
-			m.Width = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Width |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DateIncludeTime", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.DateIncludeTime = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeFormat", wireType)
-			}
-			m.TimeFormat = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TimeFormat |= BlockContentDataviewRelationTimeFormat(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DateFormat", wireType)
-			}
-			m.DateFormat = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DateFormat |= BlockContentDataviewRelationDateFormat(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -15354,7 +19078,7 @@ func (m *BlockContentDataviewRelation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockContentDataviewSort) Unmarshal(dAtA []byte) error {
+func (m *BlockContentTableRow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15377,17 +19101,17 @@ func (m *BlockContentDataviewSort) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Sort: wiretype end group for non-group")
+			return fmt.Errorf("proto: TableRow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Sort: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TableRow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelationKey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsHeader", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -15397,82 +19121,67 @@ func (m *BlockContentDataviewSort) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModels
+			m.IsHeader = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthModels
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RelationKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= BlockContentDataviewSortType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CustomOrder", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockContentWidget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CustomOrder = append(m.CustomOrder, &types.Value{})
-			if err := m.CustomOrder[len(m.CustomOrder)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Widget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Widget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Layout", wireType)
 			}
-			m.Format = 0
+			m.Layout = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -15482,16 +19191,16 @@ func (m *BlockContentDataviewSort) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Format |= RelationFormat(b&0x7F) << shift
+				m.Layout |= BlockContentWidgetLayout(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var v int
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -15501,15 +19210,14 @@ func (m *BlockContentDataviewSort) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Limit |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IncludeTime = bool(v != 0)
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ViewId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15537,7 +19245,7 @@ func (m *BlockContentDataviewSort) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.ViewId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15560,7 +19268,7 @@ func (m *BlockContentDataviewSort) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockContentDataviewFilter) Unmarshal(dAtA []byte) error {
+func (m *BlockContentSynced) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15583,34 +19291,15 @@ func (m *BlockContentDataviewFilter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Filter: wiretype end group for non-group")
+			return fmt.Errorf("proto: Synced: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Filter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Synced: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
-			}
-			m.Operator = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Operator |= BlockContentDataviewFilterOperator(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelationKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetObjectId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15638,32 +19327,13 @@ func (m *BlockContentDataviewFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RelationKey = string(dAtA[iNdEx:postIndex])
+			m.TargetObjectId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Condition", wireType)
-			}
-			m.Condition = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Condition |= BlockContentDataviewFilterCondition(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -15673,31 +19343,77 @@ func (m *BlockContentDataviewFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Value == nil {
-				m.Value = &types.Value{}
-			}
-			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TargetBlockId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 5:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockContentDiagram) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Diagram: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Diagram: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelationProperty", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15725,13 +19441,13 @@ func (m *BlockContentDataviewFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RelationProperty = string(dAtA[iNdEx:postIndex])
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuickOption", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Language", wireType)
 			}
-			m.QuickOption = 0
+			m.Language = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -15741,35 +19457,66 @@ func (m *BlockContentDataviewFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.QuickOption |= BlockContentDataviewFilterQuickOption(b&0x7F) << shift
+				m.Language |= BlockContentDiagramLanguage(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Format = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Format |= RelationFormat(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeTime", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockMetaOnly) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockMetaOnly: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockMetaOnly: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -15779,17 +19526,29 @@ func (m *BlockContentDataviewFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IncludeTime = bool(v != 0)
-		case 9:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -15799,23 +19558,27 @@ func (m *BlockContentDataviewFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fields == nil {
+				m.Fields = &types.Struct{}
+			}
+			if err := m.Fields.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15838,7 +19601,7 @@ func (m *BlockContentDataviewFilter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockContentDataviewGroupOrder) Unmarshal(dAtA []byte) error {
+func (m *Range) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15861,17 +19624,17 @@ func (m *BlockContentDataviewGroupOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GroupOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: Range: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GroupOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Range: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ViewId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
-			var stringLen uint64
+			m.From = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -15881,29 +19644,16 @@ func (m *BlockContentDataviewGroupOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.From |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ViewId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ViewGroups", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
-			var msglen int
+			m.To = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -15913,26 +19663,11 @@ func (m *BlockContentDataviewGroupOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.To |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ViewGroups = append(m.ViewGroups, &BlockContentDataviewViewGroup{})
-			if err := m.ViewGroups[len(m.ViewGroups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -15954,7 +19689,7 @@ func (m *BlockContentDataviewGroupOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockContentDataviewViewGroup) Unmarshal(dAtA []byte) error {
+func (m *ImageEditOptions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15977,17 +19712,17 @@ func (m *BlockContentDataviewViewGroup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ViewGroup: wiretype end group for non-group")
+			return fmt.Errorf("proto: ImageEditOptions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ViewGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ImageEditOptions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StripExif", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -15997,29 +19732,17 @@ func (m *BlockContentDataviewViewGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GroupId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.StripExif = bool(v != 0)
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StripLocation", wireType)
 			}
-			m.Index = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -16029,16 +19752,17 @@ func (m *BlockContentDataviewViewGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.StripLocation = bool(v != 0)
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hidden", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rotate", wireType)
 			}
-			var v int
+			m.Rotate = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -16048,17 +19772,16 @@ func (m *BlockContentDataviewViewGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Rotate |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Hidden = bool(v != 0)
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BackgroundColor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Crop", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -16068,24 +19791,47 @@ func (m *BlockContentDataviewViewGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BackgroundColor = string(dAtA[iNdEx:postIndex])
+			if m.Crop == nil {
+				m.Crop = &ImageEditOptionsRect{}
+			}
+			if err := m.Crop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			m.Format = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Format |= ImageEditOptionsFormat(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -16107,7 +19853,7 @@ func (m *BlockContentDataviewViewGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockContentDataviewObjectOrder) Unmarshal(dAtA []byte) error {
+func (m *ImageEditOptionsRect) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16130,17 +19876,17 @@ func (m *BlockContentDataviewObjectOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ObjectOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: Rect: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ObjectOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Rect: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ViewId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field X", wireType)
 			}
-			var stringLen uint64
+			m.X = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -16150,29 +19896,16 @@ func (m *BlockContentDataviewObjectOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.X |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ViewId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Y", wireType)
 			}
-			var stringLen uint64
+			m.Y = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -16182,29 +19915,16 @@ func (m *BlockContentDataviewObjectOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Y |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GroupId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectIds", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Width", wireType)
 			}
-			var stringLen uint64
+			m.Width = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -16214,24 +19934,30 @@ func (m *BlockContentDataviewObjectOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Width |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ObjectIds = append(m.ObjectIds, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -16253,7 +19979,7 @@ func (m *BlockContentDataviewObjectOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockContentDataviewGroup) Unmarshal(dAtA []byte) error {
+func (m *Account) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16276,10 +20002,10 @@ func (m *BlockContentDataviewGroup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Group: wiretype end group for non-group")
+			return fmt.Errorf("proto: Account: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Group: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Account: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -16316,7 +20042,39 @@ func (m *BlockContentDataviewGroup) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Avatar", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16343,15 +20101,16 @@ func (m *BlockContentDataviewGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &BlockContentDataviewStatus{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Avatar == nil {
+				m.Avatar = &AccountAvatar{}
+			}
+			if err := m.Avatar.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Value = &BlockContentDataviewGroupValueOfStatus{v}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16378,15 +20137,16 @@ func (m *BlockContentDataviewGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &BlockContentDataviewTag{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Config == nil {
+				m.Config = &AccountConfig{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Value = &BlockContentDataviewGroupValueOfTag{v}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Checkbox", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16413,15 +20173,16 @@ func (m *BlockContentDataviewGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &BlockContentDataviewCheckbox{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Status == nil {
+				m.Status = &AccountStatus{}
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Value = &BlockContentDataviewGroupValueOfCheckbox{v}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16448,11 +20209,12 @@ func (m *BlockContentDataviewGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &BlockContentDataviewDate{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Info == nil {
+				m.Info = &AccountInfo{}
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Value = &BlockContentDataviewGroupValueOfDate{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16475,7 +20237,7 @@ func (m *BlockContentDataviewGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockContentDataviewStatus) Unmarshal(dAtA []byte) error {
+func (m *AccountAvatar) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16498,17 +20260,17 @@ func (m *BlockContentDataviewStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Status: wiretype end group for non-group")
+			return fmt.Errorf("proto: Avatar: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Status: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Avatar: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -16518,77 +20280,30 @@ func (m *BlockContentDataviewStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipModels(dAtA[iNdEx:])
-			if err != nil {
+			v := &BlockContentFile{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthModels
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BlockContentDataviewTag) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowModels
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Tag: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Tag: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Avatar = &AccountAvatarAvatarOfImage{v}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Color", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16616,7 +20331,7 @@ func (m *BlockContentDataviewTag) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ids = append(m.Ids, string(dAtA[iNdEx:postIndex]))
+			m.Avatar = &AccountAvatarAvatarOfColor{string(dAtA[iNdEx:postIndex])}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16639,7 +20354,7 @@ func (m *BlockContentDataviewTag) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockContentDataviewCheckbox) Unmarshal(dAtA []byte) error {
+func (m *AccountConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16662,15 +20377,15 @@ func (m *BlockContentDataviewCheckbox) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Checkbox: wiretype end group for non-group")
+			return fmt.Errorf("proto: Config: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Checkbox: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Config: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Checked", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableDataview", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -16687,112 +20402,72 @@ func (m *BlockContentDataviewCheckbox) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Checked = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipModels(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthModels
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BlockContentDataviewDate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowModels
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Date: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Date: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipModels(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			m.EnableDataview = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableDebug", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthModels
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			m.EnableDebug = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnablePrereleaseChannel", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BlockContentRelation) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowModels
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.EnablePrereleaseChannel = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableSpaces", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Relation: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Relation: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.EnableSpaces = bool(v != 0)
+		case 100:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Extra", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -16802,23 +20477,27 @@ func (m *BlockContentRelation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			if m.Extra == nil {
+				m.Extra = &types.Struct{}
+			}
+			if err := m.Extra.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16841,7 +20520,7 @@ func (m *BlockContentRelation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockContentLatex) Unmarshal(dAtA []byte) error {
+func (m *AccountStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16864,17 +20543,17 @@ func (m *BlockContentLatex) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Latex: wiretype end group for non-group")
+			return fmt.Errorf("proto: Status: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Latex: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Status: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusType", wireType)
 			}
-			var stringLen uint64
+			m.StatusType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -16884,24 +20563,30 @@ func (m *BlockContentLatex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StatusType |= AccountStatusType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletionDate", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.DeletionDate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeletionDate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Text = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -16923,7 +20608,7 @@ func (m *BlockContentLatex) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockContentTableOfContents) Unmarshal(dAtA []byte) error {
+func (m *AccountInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16946,167 +20631,81 @@ func (m *BlockContentTableOfContents) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TableOfContents: wiretype end group for non-group")
+			return fmt.Errorf("proto: Info: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TableOfContents: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Info: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipModels(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthModels
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BlockContentTable) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowModels
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HomeObjectId", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Table: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Table: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipModels(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModels
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BlockContentTableColumn) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowModels
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.HomeObjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchiveObjectId", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TableColumn: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TableColumn: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipModels(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModels
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BlockContentTableRow) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowModels
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TableRow: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TableRow: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsHeader", wireType)
+			m.ArchiveObjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProfileObjectId", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -17116,67 +20715,29 @@ func (m *BlockContentTableRow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsHeader = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipModels(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModels
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BlockContentWidget) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowModels
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Widget: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Widget: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Layout", wireType)
+			m.ProfileObjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceId", wireType)
 			}
-			m.Layout = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -17186,16 +20747,29 @@ func (m *BlockContentWidget) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Layout |= BlockContentWidgetLayout(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
 			}
-			m.Limit = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeviceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountSpaceId", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -17205,14 +20779,27 @@ func (m *BlockContentWidget) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountSpaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ViewId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WidgetsId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17240,61 +20827,43 @@ func (m *BlockContentWidget) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ViewId = string(dAtA[iNdEx:postIndex])
+			m.WidgetsId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipModels(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketplaceWorkspaceId", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthModels
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BlockMetaOnly) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowModels
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BlockMetaOnly: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockMetaOnly: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.MarketplaceWorkspaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 101:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayUrl", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17322,13 +20891,13 @@ func (m *BlockMetaOnly) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.GatewayUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 103:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalStoragePath", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -17338,83 +20907,61 @@ func (m *BlockMetaOnly) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Fields == nil {
-				m.Fields = &types.Struct{}
-			}
-			if err := m.Fields.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.LocalStoragePath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipModels(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 104:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeZone", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthModels
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Range) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowModels
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Range: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Range: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			m.TimeZone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 105:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnalyticsId", wireType)
 			}
-			m.From = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -17424,16 +20971,29 @@ func (m *Range) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.From |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
 			}
-			m.To = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AnalyticsId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 106:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkId", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -17443,11 +21003,24 @@ func (m *Range) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.To |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetworkId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -17469,7 +21042,7 @@ func (m *Range) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Account) Unmarshal(dAtA []byte) error {
+func (m *LinkPreview) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17492,15 +21065,15 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Account: wiretype end group for non-group")
+			return fmt.Errorf("proto: LinkPreview: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Account: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LinkPreview: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17528,11 +21101,11 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Url = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17560,13 +21133,13 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Avatar", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -17576,33 +21149,29 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Avatar == nil {
-				m.Avatar = &AccountAvatar{}
-			}
-			if err := m.Avatar.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageUrl", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -17612,33 +21181,29 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Config == nil {
-				m.Config = &AccountConfig{}
-			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ImageUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FaviconUrl", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -17648,31 +21213,165 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Status == nil {
-				m.Status = &AccountStatus{}
+			m.FaviconUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= LinkPreviewType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 6:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Restrictions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Restrictions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Restrictions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v RestrictionsObjectRestriction
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowModels
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= RestrictionsObjectRestriction(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Object = append(m.Object, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowModels
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthModels
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthModels
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Object) == 0 {
+					m.Object = make([]RestrictionsObjectRestriction, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v RestrictionsObjectRestriction
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowModels
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= RestrictionsObjectRestriction(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Object = append(m.Object, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dataview", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17699,10 +21398,8 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Info == nil {
-				m.Info = &AccountInfo{}
-			}
-			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Dataview = append(m.Dataview, &RestrictionsDataviewRestrictions{})
+			if err := m.Dataview[len(m.Dataview)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17727,7 +21424,7 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccountAvatar) Unmarshal(dAtA []byte) error {
+func (m *RestrictionsDataviewRestrictions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17750,17 +21447,17 @@ func (m *AccountAvatar) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Avatar: wiretype end group for non-group")
+			return fmt.Errorf("proto: DataviewRestrictions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Avatar: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DataviewRestrictions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -17770,59 +21467,93 @@ func (m *AccountAvatar) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &BlockContentFile{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Avatar = &AccountAvatarAvatarOfImage{v}
+			m.BlockId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Color", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
+			if wireType == 0 {
+				var v RestrictionsDataviewRestriction
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowModels
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= RestrictionsDataviewRestriction(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.Restrictions = append(m.Restrictions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowModels
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthModels
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthModels
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				if elementCount != 0 && len(m.Restrictions) == 0 {
+					m.Restrictions = make([]RestrictionsDataviewRestriction, 0, elementCount)
 				}
+				for iNdEx < postIndex {
+					var v RestrictionsDataviewRestriction
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowModels
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= RestrictionsDataviewRestriction(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Restrictions = append(m.Restrictions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restrictions", wireType)
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Avatar = &AccountAvatarAvatarOfColor{string(dAtA[iNdEx:postIndex])}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -17844,7 +21575,7 @@ func (m *AccountAvatar) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccountConfig) Unmarshal(dAtA []byte) error {
+func (m *Object) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17867,128 +21598,12 @@ func (m *AccountConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Config: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Config: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableDataview", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.EnableDataview = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableDebug", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.EnableDebug = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnablePrereleaseChannel", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.EnablePrereleaseChannel = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableSpaces", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.EnableSpaces = bool(v != 0)
-		case 100:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Extra", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Extra == nil {
-				m.Extra = &types.Struct{}
-			}
-			if err := m.Extra.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			return fmt.Errorf("proto: Object: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Object: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -18010,7 +21625,7 @@ func (m *AccountConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccountStatus) Unmarshal(dAtA []byte) error {
+func (m *ObjectChangePayload) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18033,36 +21648,17 @@ func (m *AccountStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Status: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChangePayload: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Status: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChangePayload: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StatusType", wireType)
-			}
-			m.StatusType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StatusType |= AccountStatusType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeletionDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SmartBlockType", wireType)
 			}
-			m.DeletionDate = 0
+			m.SmartBlockType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -18072,7 +21668,7 @@ func (m *AccountStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DeletionDate |= int64(b&0x7F) << shift
+				m.SmartBlockType |= SmartBlockType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -18098,7 +21694,7 @@ func (m *AccountStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccountInfo) Unmarshal(dAtA []byte) error {
+func (m *ObjectType) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18121,15 +21717,47 @@ func (m *AccountInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Info: wiretype end group for non-group")
+			return fmt.Errorf("proto: ObjectType: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Info: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ObjectType: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HomeObjectId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18157,11 +21785,64 @@ func (m *AccountInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HomeObjectId = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArchiveObjectId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelationLinks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelationLinks = append(m.RelationLinks, &RelationLink{})
+			if err := m.RelationLinks[len(m.RelationLinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Layout", wireType)
+			}
+			m.Layout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Layout |= ObjectTypeLayout(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IconEmoji", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18189,13 +21870,134 @@ func (m *AccountInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ArchiveObjectId = string(dAtA[iNdEx:postIndex])
+			m.IconEmoji = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProfileObjectId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hidden", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Hidden = bool(v != 0)
+		case 8:
+			if wireType == 0 {
+				var v SmartBlockType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowModels
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= SmartBlockType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Types = append(m.Types, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowModels
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthModels
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthModels
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Types) == 0 {
+					m.Types = make([]SmartBlockType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v SmartBlockType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowModels
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= SmartBlockType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Types = append(m.Types, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Types", wireType)
 			}
-			var stringLen uint64
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsArchived", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -18205,29 +22007,17 @@ func (m *AccountInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProfileObjectId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeviceId", wireType)
+			m.IsArchived = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Readonly", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -18237,29 +22027,17 @@ func (m *AccountInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DeviceId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountSpaceId", wireType)
+			m.Readonly = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstalledByDefault", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -18269,61 +22047,67 @@ func (m *AccountInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModels
+			m.InstalledByDefault = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthModels
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccountSpaceId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WidgetsId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Layout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WidgetsId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketplaceWorkspaceId", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Layout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Layout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -18333,27 +22117,14 @@ func (m *AccountInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= ObjectTypeLayout(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MarketplaceWorkspaceId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 101:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GatewayUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18381,13 +22152,13 @@ func (m *AccountInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GatewayUrl = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 103:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LocalStoragePath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredRelations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -18397,61 +22168,81 @@ func (m *AccountInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LocalStoragePath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 104:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeZone", wireType)
+			m.RequiredRelations = append(m.RequiredRelations, &Relation{})
+			if err := m.RequiredRelations[len(m.RequiredRelations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RelationWithValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TimeZone = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 105:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelationWithValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelationWithValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnalyticsId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Relation", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -18461,29 +22252,33 @@ func (m *AccountInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AnalyticsId = string(dAtA[iNdEx:postIndex])
+			if m.Relation == nil {
+				m.Relation = &Relation{}
+			}
+			if err := m.Relation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 106:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetworkId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -18493,23 +22288,27 @@ func (m *AccountInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NetworkId = string(dAtA[iNdEx:postIndex])
+			if m.Value == nil {
+				m.Value = &types.Value{}
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18532,7 +22331,7 @@ func (m *AccountInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LinkPreview) Unmarshal(dAtA []byte) error {
+func (m *Relation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18555,15 +22354,15 @@ func (m *LinkPreview) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LinkPreview: wiretype end group for non-group")
+			return fmt.Errorf("proto: Relation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LinkPreview: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Relation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18591,13 +22390,13 @@ func (m *LinkPreview) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Url = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
 			}
-			var stringLen uint64
+			m.Format = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -18607,27 +22406,14 @@ func (m *LinkPreview) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Format |= RelationFormat(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Title = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18655,13 +22441,13 @@ func (m *LinkPreview) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImageUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultValue", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -18671,29 +22457,33 @@ func (m *LinkPreview) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ImageUrl = string(dAtA[iNdEx:postIndex])
+			if m.DefaultValue == nil {
+				m.DefaultValue = &types.Value{}
+			}
+			if err := m.DefaultValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FaviconUrl", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSource", wireType)
 			}
-			var stringLen uint64
+			m.DataSource = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -18703,29 +22493,16 @@ func (m *LinkPreview) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DataSource |= RelationDataSource(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FaviconUrl = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hidden", wireType)
 			}
-			m.Type = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -18735,133 +22512,87 @@ func (m *LinkPreview) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= LinkPreviewType(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipModels(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthModels
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Restrictions) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowModels
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Hidden = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadOnly", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Restrictions: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Restrictions: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType == 0 {
-				var v RestrictionsObjectRestriction
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowModels
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= RestrictionsObjectRestriction(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
 				}
-				m.Object = append(m.Object, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowModels
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthModels
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthModels
+			}
+			m.ReadOnly = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multi", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				if elementCount != 0 && len(m.Object) == 0 {
-					m.Object = make([]RestrictionsObjectRestriction, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v RestrictionsObjectRestriction
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowModels
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= RestrictionsObjectRestriction(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Object = append(m.Object, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
 			}
-		case 2:
+			m.Multi = bool(v != 0)
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dataview", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectTypes = append(m.ObjectTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelectDict", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18888,64 +22619,104 @@ func (m *Restrictions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Dataview = append(m.Dataview, &RestrictionsDataviewRestrictions{})
-			if err := m.Dataview[len(m.Dataview)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SelectDict = append(m.SelectDict, &RelationOption{})
+			if err := m.SelectDict[len(m.SelectDict)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipModels(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCount", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			m.MaxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModels
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RestrictionsDataviewRestrictions) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowModels
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadOnlyRelation", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ReadOnlyRelation = bool(v != 0)
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DataviewRestrictions: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DataviewRestrictions: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Scope = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Scope |= RelationScope(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18973,77 +22744,40 @@ func (m *RestrictionsDataviewRestrictions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockId = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType == 0 {
-				var v RestrictionsDataviewRestriction
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowModels
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= RestrictionsDataviewRestriction(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Restrictions = append(m.Restrictions, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowModels
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthModels
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthModels
+		case 100:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				if elementCount != 0 && len(m.Restrictions) == 0 {
-					m.Restrictions = make([]RestrictionsDataviewRestriction, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v RestrictionsDataviewRestriction
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowModels
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= RestrictionsDataviewRestriction(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Restrictions = append(m.Restrictions, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restrictions", wireType)
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -19065,7 +22799,7 @@ func (m *RestrictionsDataviewRestrictions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Object) Unmarshal(dAtA []byte) error {
+func (m *RelationOption) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19088,12 +22822,140 @@ func (m *Object) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Object: wiretype end group for non-group")
+			return fmt.Errorf("proto: Option: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Object: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Option: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Text = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Color", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Color = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelationKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelationKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -19115,7 +22977,7 @@ func (m *Object) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ObjectChangePayload) Unmarshal(dAtA []byte) error {
+func (m *RelationLink) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19138,17 +23000,49 @@ func (m *ObjectChangePayload) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChangePayload: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelationLink: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChangePayload: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelationLink: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SmartBlockType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
 			}
-			m.SmartBlockType = 0
+			m.Format = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -19158,7 +23052,7 @@ func (m *ObjectChangePayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SmartBlockType |= SmartBlockType(b&0x7F) << shift
+				m.Format |= RelationFormat(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -19184,7 +23078,7 @@ func (m *ObjectChangePayload) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ObjectType) Unmarshal(dAtA []byte) error {
+func (m *RelationConstraint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19207,15 +23101,15 @@ func (m *ObjectType) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ObjectType: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelationConstraint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ObjectType: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelationConstraint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelationKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19243,13 +23137,33 @@ func (m *ObjectType) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Url = string(dAtA[iNdEx:postIndex])
+			m.RelationKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Required", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Required = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -19259,27 +23173,31 @@ func (m *ObjectType) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Min == nil {
+				m.Min = &types.Value{}
+			}
+			if err := m.Min.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelationLinks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19306,16 +23224,18 @@ func (m *ObjectType) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RelationLinks = append(m.RelationLinks, &RelationLink{})
-			if err := m.RelationLinks[len(m.RelationLinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Max == nil {
+				m.Max = &types.Value{}
+			}
+			if err := m.Max.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Layout", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pattern", wireType)
 			}
-			m.Layout = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -19325,14 +23245,27 @@ func (m *ObjectType) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Layout |= ObjectTypeLayout(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pattern = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IconEmoji", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectTypes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19360,11 +23293,61 @@ func (m *ObjectType) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IconEmoji = string(dAtA[iNdEx:postIndex])
+			m.ObjectTypes = append(m.ObjectTypes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RelationConstraintViolation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelationConstraintViolation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelationConstraintViolation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19392,13 +23375,13 @@ func (m *ObjectType) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.ObjectId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hidden", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelationKey", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -19408,106 +23391,29 @@ func (m *ObjectType) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Hidden = bool(v != 0)
-		case 8:
-			if wireType == 0 {
-				var v SmartBlockType
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowModels
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= SmartBlockType(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Types = append(m.Types, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowModels
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthModels
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthModels
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				if elementCount != 0 && len(m.Types) == 0 {
-					m.Types = make([]SmartBlockType, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v SmartBlockType
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowModels
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= SmartBlockType(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Types = append(m.Types, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Types", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsArchived", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.IsArchived = bool(v != 0)
-		case 10:
+			m.RelationKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Readonly", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var v int
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -19517,17 +23423,16 @@ func (m *ObjectType) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Type |= RelationConstraintViolationType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Readonly = bool(v != 0)
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstalledByDefault", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -19537,12 +23442,24 @@ func (m *ObjectType) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.InstalledByDefault = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -19564,7 +23481,7 @@ func (m *ObjectType) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Layout) Unmarshal(dAtA []byte) error {
+func (m *Relations) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19586,67 +23503,16 @@ func (m *Layout) Unmarshal(dAtA []byte) error {
 		}
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Layout: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Layout: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= ObjectTypeLayout(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		if wireType == 4 {
+			return fmt.Errorf("proto: Relations: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Relations: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequiredRelations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Relations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19673,8 +23539,8 @@ func (m *Layout) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequiredRelations = append(m.RequiredRelations, &Relation{})
-			if err := m.RequiredRelations[len(m.RequiredRelations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Relations = append(m.Relations, &Relation{})
+			if err := m.Relations[len(m.Relations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19699,7 +23565,7 @@ func (m *Layout) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RelationWithValue) Unmarshal(dAtA []byte) error {
+func (m *RelationOptions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19722,15 +23588,15 @@ func (m *RelationWithValue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RelationWithValue: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelationOptions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RelationWithValue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelationOptions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19757,18 +23623,66 @@ func (m *RelationWithValue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Relation == nil {
-				m.Relation = &Relation{}
-			}
-			if err := m.Relation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Options = append(m.Options, &RelationOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InternalFlag) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InternalFlag: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InternalFlag: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			m.Value = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -19778,28 +23692,11 @@ func (m *RelationWithValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Value |= InternalFlagValue(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Value == nil {
-				m.Value = &types.Value{}
-			}
-			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -19821,7 +23718,7 @@ func (m *RelationWithValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Relation) Unmarshal(dAtA []byte) error {
+func (m *ObjectView) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19844,15 +23741,15 @@ func (m *Relation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Relation: wiretype end group for non-group")
+			return fmt.Errorf("proto: ObjectView: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Relation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ObjectView: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RootId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19880,13 +23777,13 @@ func (m *Relation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.RootId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
 			}
-			m.Format = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -19896,16 +23793,31 @@ func (m *Relation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Format |= RelationFormat(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blocks = append(m.Blocks, &Block{})
+			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -19915,27 +23827,48 @@ func (m *Relation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Details = append(m.Details, &ObjectViewDetailsSet{})
+			if err := m.Details[len(m.Details)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= SmartBlockType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Relations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19962,18 +23895,16 @@ func (m *Relation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DefaultValue == nil {
-				m.DefaultValue = &types.Value{}
-			}
-			if err := m.DefaultValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Relations = append(m.Relations, &Relation{})
+			if err := m.Relations[len(m.Relations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataSource", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restrictions", wireType)
 			}
-			m.DataSource = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -19983,16 +23914,33 @@ func (m *Relation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataSource |= RelationDataSource(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hidden", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthModels
 			}
-			var v int
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Restrictions == nil {
+				m.Restrictions = &Restrictions{}
+			}
+			if err := m.Restrictions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -20002,17 +23950,33 @@ func (m *Relation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Hidden = bool(v != 0)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadOnly", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthModels
 			}
-			var v int
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.History == nil {
+				m.History = &ObjectViewHistorySize{}
+			}
+			if err := m.History.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelationLinks", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -20022,17 +23986,31 @@ func (m *Relation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ReadOnly = bool(v != 0)
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Multi", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelationLinks = append(m.RelationLinks, &RelationLink{})
+			if err := m.RelationLinks[len(m.RelationLinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FocusBlockId", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -20042,15 +24020,77 @@ func (m *Relation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Multi = bool(v != 0)
-		case 9:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FocusBlockId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ObjectViewDetailsSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DetailsSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DetailsSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectTypes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20078,11 +24118,11 @@ func (m *Relation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectTypes = append(m.ObjectTypes, string(dAtA[iNdEx:postIndex]))
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SelectDict", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20109,16 +24149,18 @@ func (m *Relation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SelectDict = append(m.SelectDict, &RelationOption{})
-			if err := m.SelectDict[len(m.SelectDict)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Details == nil {
+				m.Details = &types.Struct{}
+			}
+			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxCount", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubIds", wireType)
 			}
-			m.MaxCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -20128,14 +24170,77 @@ func (m *Relation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxCount |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubIds = append(m.SubIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ObjectViewRelationWithValuePerObject) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelationWithValuePerObject: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelationWithValuePerObject: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20163,13 +24268,13 @@ func (m *Relation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.ObjectId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadOnlyRelation", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relations", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -20179,36 +24284,81 @@ func (m *Relation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ReadOnlyRelation = bool(v != 0)
-		case 20:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthModels
 			}
-			m.Scope = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Scope |= RelationScope(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
 			}
-		case 21:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relations = append(m.Relations, &RelationWithValue{})
+			if err := m.Relations[len(m.Relations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ObjectViewHistorySize) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistorySize: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistorySize: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Undo", wireType)
 			}
-			var stringLen uint64
+			m.Undo = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -20218,29 +24368,16 @@ func (m *Relation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Undo |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 100:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redo", wireType)
 			}
-			var stringLen uint64
+			m.Redo = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -20250,24 +24387,11 @@ func (m *Relation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Redo |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -20289,7 +24413,7 @@ func (m *Relation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RelationOption) Unmarshal(dAtA []byte) error {
+func (m *Comment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20312,10 +24436,10 @@ func (m *RelationOption) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Option: wiretype end group for non-group")
+			return fmt.Errorf("proto: Comment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Option: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Comment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -20352,7 +24476,7 @@ func (m *RelationOption) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20380,11 +24504,11 @@ func (m *RelationOption) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Text = string(dAtA[iNdEx:postIndex])
+			m.ThreadId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Color", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetObjectId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20412,11 +24536,11 @@ func (m *RelationOption) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Color = string(dAtA[iNdEx:postIndex])
+			m.TargetObjectId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelationKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20444,63 +24568,13 @@ func (m *RelationOption) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RelationKey = string(dAtA[iNdEx:postIndex])
+			m.TargetBlockId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipModels(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthModels
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RelationLink) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowModels
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RelationLink: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RelationLink: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Range", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -20510,98 +24584,33 @@ func (m *RelationLink) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
-			}
-			m.Format = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Format |= RelationFormat(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if m.Range == nil {
+				m.Range = &Range{}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipModels(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Range.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthModels
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Relations) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowModels
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Relations: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Relations: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -20611,79 +24620,27 @@ func (m *Relations) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Relations = append(m.Relations, &Relation{})
-			if err := m.Relations[len(m.Relations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipModels(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModels
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RelationOptions) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowModels
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RelationOptions: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RelationOptions: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Text = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Marks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20710,66 +24667,88 @@ func (m *RelationOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Options = append(m.Options, &RelationOption{})
-			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Marks == nil {
+				m.Marks = &BlockContentTextMarks{}
+			}
+			if err := m.Marks.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipModels(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModels
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *InternalFlag) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowModels
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedDate", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.CreatedDate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedDate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModifiedDate", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InternalFlag: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InternalFlag: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ModifiedDate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ModifiedDate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsResolved", wireType)
 			}
-			m.Value = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -20779,11 +24758,12 @@ func (m *InternalFlag) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Value |= InternalFlagValue(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.IsResolved = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -20805,7 +24785,7 @@ func (m *InternalFlag) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ObjectView) Unmarshal(dAtA []byte) error {
+func (m *Task) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20828,15 +24808,15 @@ func (m *ObjectView) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ObjectView: wiretype end group for non-group")
+			return fmt.Errorf("proto: Task: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ObjectView: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Task: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RootId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20864,13 +24844,13 @@ func (m *ObjectView) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RootId = string(dAtA[iNdEx:postIndex])
+			m.ObjectId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -20880,31 +24860,29 @@ func (m *ObjectView) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Blocks = append(m.Blocks, &Block{})
-			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BlockId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -20914,31 +24892,29 @@ func (m *ObjectView) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Details = append(m.Details, &ObjectViewDetailsSet{})
-			if err := m.Details[len(m.Details)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Text = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checked", wireType)
 			}
-			m.Type = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -20948,16 +24924,17 @@ func (m *ObjectView) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= SmartBlockType(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			m.Checked = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mentions", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -20967,101 +24944,153 @@ func (m *ObjectView) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Relations = append(m.Relations, &Relation{})
-			if err := m.Relations[len(m.Relations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Mentions = append(m.Mentions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restrictions", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+		case 6:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowModels
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Restrictions == nil {
-				m.Restrictions = &Restrictions{}
-			}
-			if err := m.Restrictions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
+				m.Dates = append(m.Dates, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowModels
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				if packedLen < 0 {
+					return ErrInvalidLengthModels
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthModels
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Dates) == 0 {
+					m.Dates = make([]int64, 0, elementCount)
 				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowModels
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Dates = append(m.Dates, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dates", wireType)
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthModels
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthModels
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.History == nil {
-				m.History = &ObjectViewHistorySize{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Tasks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
 			}
-			if err := m.History.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 10:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Tasks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Tasks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelationLinks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21088,8 +25117,8 @@ func (m *ObjectView) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RelationLinks = append(m.RelationLinks, &RelationLink{})
-			if err := m.RelationLinks[len(m.RelationLinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tasks = append(m.Tasks, &Task{})
+			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -21114,7 +25143,7 @@ func (m *ObjectView) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ObjectViewDetailsSet) Unmarshal(dAtA []byte) error {
+func (m *BlockLink) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21137,15 +25166,15 @@ func (m *ObjectViewDetailsSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DetailsSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: BlockLink: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DetailsSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BlockLink: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceObjectId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21173,13 +25202,13 @@ func (m *ObjectViewDetailsSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.SourceObjectId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceBlockId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -21189,31 +25218,27 @@ func (m *ObjectViewDetailsSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Details == nil {
-				m.Details = &types.Struct{}
-			}
-			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SourceBlockId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetObjectId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21241,61 +25266,11 @@ func (m *ObjectViewDetailsSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubIds = append(m.SubIds, string(dAtA[iNdEx:postIndex]))
+			m.TargetObjectId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipModels(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthModels
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ObjectViewRelationWithValuePerObject) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowModels
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RelationWithValuePerObject: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RelationWithValuePerObject: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21323,41 +25298,7 @@ func (m *ObjectViewRelationWithValuePerObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relations", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Relations = append(m.Relations, &RelationWithValue{})
-			if err := m.Relations[len(m.Relations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TargetBlockId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -21380,7 +25321,7 @@ func (m *ObjectViewRelationWithValuePerObject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ObjectViewHistorySize) Unmarshal(dAtA []byte) error {
+func (m *BlockLinks) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21403,17 +25344,17 @@ func (m *ObjectViewHistorySize) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HistorySize: wiretype end group for non-group")
+			return fmt.Errorf("proto: BlockLinks: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HistorySize: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BlockLinks: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Undo", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Links", wireType)
 			}
-			m.Undo = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -21423,30 +25364,26 @@ func (m *ObjectViewHistorySize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Undo |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Redo", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthModels
 			}
-			m.Redo = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Redo |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Links = append(m.Links, &BlockLink{})
+			if err := m.Links[len(m.Links)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -48,12 +48,14 @@ func (rl RelationLinks) Copy() RelationLinks {
 	return res
 }
 
+// Diff returns the links added since prev and the keys of the removed links, the links with the changed format are returned as added
 func (rl RelationLinks) Diff(prev RelationLinks) (added []*model.RelationLink, removed []string) {
 	var common = make(map[string]struct{})
 	for _, l := range rl {
-		if !prev.Has(l.Key) {
+		if prevLink := prev.Get(l.Key); prevLink == nil || prevLink.Format != l.Format {
 			added = append(added, l)
-		} else {
+		}
+		if prev.Has(l.Key) {
 			common[l.Key] = struct{}{}
 		}
 	}
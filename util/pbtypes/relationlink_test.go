@@ -0,0 +1,28 @@
+package pbtypes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+func TestRelationLinks_Diff(t *testing.T) {
+	prev := RelationLinks{
+		{Key: "name", Format: model.RelationFormat_shorttext},
+		{Key: "priority", Format: model.RelationFormat_shorttext},
+		{Key: "done", Format: model.RelationFormat_checkbox},
+	}
+	current := RelationLinks{
+		{Key: "name", Format: model.RelationFormat_shorttext},
+		{Key: "priority", Format: model.RelationFormat_status},
+		{Key: "tag", Format: model.RelationFormat_tag},
+	}
+	added, removed := current.Diff(prev)
+	assert.Equal(t, []*model.RelationLink{
+		{Key: "priority", Format: model.RelationFormat_status},
+		{Key: "tag", Format: model.RelationFormat_tag},
+	}, added)
+	assert.Equal(t, []string{"done"}, removed)
+}
@@ -107,6 +107,21 @@ func (mr *MockFileServiceMockRecorder) FileListOffload(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FileListOffload", reflect.TypeOf((*MockFileService)(nil).FileListOffload), arg0, arg1)
 }
 
+// FileDelete mocks base method.
+func (m *MockFileService) FileDelete(arg0 string) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FileDelete", arg0)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FileDelete indicates an expected call of FileDelete.
+func (mr *MockFileServiceMockRecorder) FileDelete(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FileDelete", reflect.TypeOf((*MockFileService)(nil).FileDelete), arg0)
+}
+
 // FileOffload mocks base method.
 func (m *MockFileService) FileOffload(arg0 string, arg1 bool) (uint64, error) {
 	m.ctrl.T.Helper()
@@ -122,6 +137,21 @@ func (mr *MockFileServiceMockRecorder) FileOffload(arg0, arg1 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FileOffload", reflect.TypeOf((*MockFileService)(nil).FileOffload), arg0, arg1)
 }
 
+// GetFilesReport mocks base method.
+func (m *MockFileService) GetFilesReport(arg0 context.Context) (*pb.RpcFileReportResponseReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFilesReport", arg0)
+	ret0, _ := ret[0].(*pb.RpcFileReportResponseReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFilesReport indicates an expected call of GetFilesReport.
+func (mr *MockFileServiceMockRecorder) GetFilesReport(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilesReport", reflect.TypeOf((*MockFileService)(nil).GetFilesReport), arg0)
+}
+
 // GetSpaceUsage mocks base method.
 func (m *MockFileService) GetSpaceUsage(arg0 context.Context) (*pb.RpcFileSpaceUsageResponseUsage, error) {
 	m.ctrl.T.Helper()
@@ -186,6 +216,21 @@ func (mr *MockFileServiceMockRecorder) Init(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Init", reflect.TypeOf((*MockFileService)(nil).Init), arg0)
 }
 
+// ListOrphanedFiles mocks base method.
+func (m *MockFileService) ListOrphanedFiles() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrphanedFiles")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrphanedFiles indicates an expected call of ListOrphanedFiles.
+func (mr *MockFileServiceMockRecorder) ListOrphanedFiles() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrphanedFiles", reflect.TypeOf((*MockFileService)(nil).ListOrphanedFiles))
+}
+
 // Name mocks base method.
 func (m *MockFileService) Name() string {
 	m.ctrl.T.Helper()
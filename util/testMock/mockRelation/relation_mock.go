@@ -10,6 +10,7 @@ import (
 	app "github.com/anyproto/any-sync/app"
 	relation "github.com/anyproto/anytype-heart/core/relation"
 	relationutils "github.com/anyproto/anytype-heart/core/relation/relationutils"
+	model "github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 	pbtypes "github.com/anyproto/anytype-heart/util/pbtypes"
 	types "github.com/gogo/protobuf/types"
 	gomock "go.uber.org/mock/gomock"
@@ -38,6 +39,25 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// ConstraintViolations mocks base method.
+func (m *MockService) ConstraintViolations(arg0 []string, arg1 *types.Struct, arg2 ...string) []*model.RelationConstraintViolation {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ConstraintViolations", varargs...)
+	ret0, _ := ret[0].([]*model.RelationConstraintViolation)
+	return ret0
+}
+
+// ConstraintViolations indicates an expected call of ConstraintViolations.
+func (mr *MockServiceMockRecorder) ConstraintViolations(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConstraintViolations", reflect.TypeOf((*MockService)(nil).ConstraintViolations), varargs...)
+}
+
 // FetchKey mocks base method.
 func (m *MockService) FetchKey(arg0 string, arg1 ...relation.FetchOption) (*relationutils.Relation, error) {
 	m.ctrl.T.Helper()
@@ -139,6 +159,25 @@ func (mr *MockServiceMockRecorder) Name() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockService)(nil).Name))
 }
 
+// ValidateConstraints mocks base method.
+func (m *MockService) ValidateConstraints(arg0 []string, arg1 *types.Struct, arg2 ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ValidateConstraints", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateConstraints indicates an expected call of ValidateConstraints.
+func (mr *MockServiceMockRecorder) ValidateConstraints(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateConstraints", reflect.TypeOf((*MockService)(nil).ValidateConstraints), varargs...)
+}
+
 // ValidateFormat mocks base method.
 func (m *MockService) ValidateFormat(arg0 string, arg1 *types.Value) error {
 	m.ctrl.T.Helper()
@@ -220,6 +220,36 @@ func (mr *MockObjectStoreMockRecorder) GetDetails(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDetails", reflect.TypeOf((*MockObjectStore)(nil).GetDetails), arg0)
 }
 
+// GetFileReferences mocks base method.
+func (m *MockObjectStore) GetFileReferences(arg0 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFileReferences", arg0)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFileReferences indicates an expected call of GetFileReferences.
+func (mr *MockObjectStoreMockRecorder) GetFileReferences(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileReferences", reflect.TypeOf((*MockObjectStore)(nil).GetFileReferences), arg0)
+}
+
+// GetInboundBlockLinks mocks base method.
+func (m *MockObjectStore) GetInboundBlockLinks(arg0 string) ([]*model.BlockLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInboundBlockLinks", arg0)
+	ret0, _ := ret[0].([]*model.BlockLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInboundBlockLinks indicates an expected call of GetInboundBlockLinks.
+func (mr *MockObjectStoreMockRecorder) GetInboundBlockLinks(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInboundBlockLinks", reflect.TypeOf((*MockObjectStore)(nil).GetInboundBlockLinks), arg0)
+}
+
 // GetInboundLinksByID mocks base method.
 func (m *MockObjectStore) GetInboundLinksByID(arg0 string) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -280,6 +310,21 @@ func (mr *MockObjectStoreMockRecorder) GetObjectTypes(arg0 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObjectTypes", reflect.TypeOf((*MockObjectStore)(nil).GetObjectTypes), arg0)
 }
 
+// GetOutboundBlockLinks mocks base method.
+func (m *MockObjectStore) GetOutboundBlockLinks(arg0 string) ([]*model.BlockLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOutboundBlockLinks", arg0)
+	ret0, _ := ret[0].([]*model.BlockLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOutboundBlockLinks indicates an expected call of GetOutboundBlockLinks.
+func (mr *MockObjectStoreMockRecorder) GetOutboundBlockLinks(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOutboundBlockLinks", reflect.TypeOf((*MockObjectStore)(nil).GetOutboundBlockLinks), arg0)
+}
+
 // GetOutboundLinksByID mocks base method.
 func (m *MockObjectStore) GetOutboundLinksByID(arg0 string) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -388,6 +433,21 @@ func (mr *MockObjectStoreMockRecorder) List() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockObjectStore)(nil).List))
 }
 
+// ListTasks mocks base method.
+func (m *MockObjectStore) ListTasks() ([]*model.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTasks")
+	ret0, _ := ret[0].([]*model.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTasks indicates an expected call of ListTasks.
+func (mr *MockObjectStoreMockRecorder) ListTasks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTasks", reflect.TypeOf((*MockObjectStore)(nil).ListTasks))
+}
+
 // ListIDsFromFullTextQueue mocks base method.
 func (m *MockObjectStore) ListIDsFromFullTextQueue() ([]string, error) {
 	m.ctrl.T.Helper()
@@ -618,6 +678,32 @@ func (mr *MockObjectStoreMockRecorder) SubscribeForAll(arg0 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeForAll", reflect.TypeOf((*MockObjectStore)(nil).SubscribeForAll), arg0)
 }
 
+// SubscribeForTasks mocks base method.
+func (m *MockObjectStore) SubscribeForTasks(arg0 func(string, []*model.Task)) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SubscribeForTasks", arg0)
+}
+
+// SubscribeForTasks indicates an expected call of SubscribeForTasks.
+func (mr *MockObjectStoreMockRecorder) SubscribeForTasks(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeForTasks", reflect.TypeOf((*MockObjectStore)(nil).SubscribeForTasks), arg0)
+}
+
+// UpdateObjectBlockLinks mocks base method.
+func (m *MockObjectStore) UpdateObjectBlockLinks(arg0 string, arg1 []*model.BlockLink) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateObjectBlockLinks", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateObjectBlockLinks indicates an expected call of UpdateObjectBlockLinks.
+func (mr *MockObjectStoreMockRecorder) UpdateObjectBlockLinks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateObjectBlockLinks", reflect.TypeOf((*MockObjectStore)(nil).UpdateObjectBlockLinks), arg0, arg1)
+}
+
 // UpdateObjectDetails mocks base method.
 func (m *MockObjectStore) UpdateObjectDetails(arg0 string, arg1 *types.Struct) error {
 	m.ctrl.T.Helper()
@@ -632,6 +718,20 @@ func (mr *MockObjectStoreMockRecorder) UpdateObjectDetails(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateObjectDetails", reflect.TypeOf((*MockObjectStore)(nil).UpdateObjectDetails), arg0, arg1)
 }
 
+// UpdateObjectFileReferences mocks base method.
+func (m *MockObjectStore) UpdateObjectFileReferences(arg0 string, arg1 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateObjectFileReferences", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateObjectFileReferences indicates an expected call of UpdateObjectFileReferences.
+func (mr *MockObjectStoreMockRecorder) UpdateObjectFileReferences(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateObjectFileReferences", reflect.TypeOf((*MockObjectStore)(nil).UpdateObjectFileReferences), arg0, arg1)
+}
+
 // UpdateObjectLinks mocks base method.
 func (m *MockObjectStore) UpdateObjectLinks(arg0 string, arg1 []string) error {
 	m.ctrl.T.Helper()
@@ -660,6 +760,20 @@ func (mr *MockObjectStoreMockRecorder) UpdateObjectSnippet(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateObjectSnippet", reflect.TypeOf((*MockObjectStore)(nil).UpdateObjectSnippet), arg0, arg1)
 }
 
+// UpdateObjectTasks mocks base method.
+func (m *MockObjectStore) UpdateObjectTasks(arg0 string, arg1 []*model.Task) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateObjectTasks", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateObjectTasks indicates an expected call of UpdateObjectTasks.
+func (mr *MockObjectStoreMockRecorder) UpdateObjectTasks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateObjectTasks", reflect.TypeOf((*MockObjectStore)(nil).UpdateObjectTasks), arg0, arg1)
+}
+
 // UpdatePendingLocalDetails mocks base method.
 func (m *MockObjectStore) UpdatePendingLocalDetails(arg0 string, arg1 func(*types.Struct) (*types.Struct, error)) error {
 	m.ctrl.T.Helper()